@@ -0,0 +1,181 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A PluralRule holds the condition and the sample values for a single plural
+// category of a locale. See http://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules
+// for details.
+type PluralRule struct {
+	Category  string // zero, one, two, few, many or other
+	Condition string // the condition in CLDR syntax; empty for other
+
+	// Integer and Decimal hold the integer and decimal sample values
+	// associated with the category.
+	Integer []SampleRange
+	Decimal []SampleRange
+}
+
+// A SampleRange is a range of sample values. Start and End are equal for
+// a single value. The values of a decimal range are incremented by the
+// smallest unit of the precision of Start, so 0.0~0.3 denotes 0.0, 0.1, 0.2
+// and 0.3.
+type SampleRange struct {
+	Start, End string
+}
+
+// ParsePluralRule parses the text of a CLDR pluralRule element for the given
+// category, such as "n = 1 @integer 1 @decimal 1.0, 1.00, …".
+func ParsePluralRule(category, text string) (*PluralRule, error) {
+	r := &PluralRule{Category: category}
+	parts := strings.Split(text, "@")
+	r.Condition = strings.TrimSpace(parts[0])
+	for _, p := range parts[1:] {
+		var list *[]SampleRange
+		switch {
+		case strings.HasPrefix(p, "integer"):
+			list, p = &r.Integer, p[len("integer"):]
+		case strings.HasPrefix(p, "decimal"):
+			list, p = &r.Decimal, p[len("decimal"):]
+		default:
+			return nil, fmt.Errorf("cldr: %s: unknown sample type in %q", category, p)
+		}
+		for _, s := range strings.Split(p, ",") {
+			s = strings.TrimSpace(s)
+			if s == "" || s == "…" || s == "..." {
+				continue
+			}
+			var sr SampleRange
+			if i := strings.Index(s, "~"); i >= 0 {
+				sr = SampleRange{s[:i], s[i+1:]}
+			} else {
+				sr = SampleRange{s, s}
+			}
+			if sr.Start == "" || sr.End == "" {
+				return nil, fmt.Errorf("cldr: %s: invalid sample range %q", category, s)
+			}
+			*list = append(*list, sr)
+		}
+	}
+	return r, nil
+}
+
+// Values returns up to n values of the range, starting at Start.
+// A range that cannot be enumerated only yields Start and End.
+func (sr SampleRange) Values(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	if sr.Start == sr.End {
+		return []string{sr.Start}
+	}
+	prec := 0
+	if i := strings.Index(sr.Start, "."); i >= 0 {
+		prec = len(sr.Start) - i - 1
+	}
+	start, err1 := parseScaled(sr.Start, prec)
+	end, err2 := parseScaled(sr.End, prec)
+	if err1 != nil || err2 != nil || end < start {
+		if n == 1 {
+			return []string{sr.Start}
+		}
+		return []string{sr.Start, sr.End}
+	}
+	var values []string
+	for x := start; x <= end && len(values) < n; x++ {
+		values = append(values, formatScaled(x, prec))
+	}
+	return values
+}
+
+// parseScaled parses s as a decimal number with prec fractional digits and
+// returns it multiplied by 10^prec.
+func parseScaled(s string, prec int) (int64, error) {
+	frac := ""
+	if i := strings.Index(s, "."); i >= 0 {
+		s, frac = s[:i], s[i+1:]
+	}
+	if len(frac) > prec {
+		return 0, fmt.Errorf("cldr: precision of %q exceeds %d", s, prec)
+	}
+	return strconv.ParseInt(s+frac+strings.Repeat("0", prec-len(frac)), 10, 64)
+}
+
+func formatScaled(x int64, prec int) string {
+	s := strconv.FormatInt(x, 10)
+	if prec == 0 {
+		return s
+	}
+	if len(s) <= prec {
+		s = strings.Repeat("0", prec-len(s)+1) + s
+	}
+	return s[:len(s)-prec] + "." + s[len(s)-prec:]
+}
+
+// Samples returns up to n representative numbers for the category. Integer
+// samples are listed before decimal samples and each range contributes at
+// most two values before moving on to the next, so that the result covers
+// as many of the ranges as possible.
+func (r *PluralRule) Samples(n int) []string {
+	var ranges []SampleRange
+	ranges = append(ranges, r.Integer...)
+	ranges = append(ranges, r.Decimal...)
+	var samples []string
+	for perRange := 2; ; perRange = n {
+		samples = samples[:0]
+		for _, sr := range ranges {
+			max := n - len(samples)
+			if perRange < max {
+				max = perRange
+			}
+			samples = append(samples, sr.Values(max)...)
+		}
+		if len(samples) >= n || perRange >= n {
+			break
+		}
+	}
+	return samples
+}
+
+// PluralRules returns the plural rules for locale loc, where typ is either
+// "cardinal" or "ordinal". If there are no rules for loc, the rules for the
+// closest parent locale are returned, falling back to those for root. It
+// returns nil if no rules were found.
+func (s *SupplementalData) PluralRules(typ, loc string) ([]*PluralRule, error) {
+	for {
+		for _, p := range s.Plurals {
+			if t := p.Type; t != typ && !(t == "" && typ == "cardinal") {
+				continue
+			}
+			for _, pr := range p.PluralRules {
+				if !in(strings.Fields(pr.Locales), loc) {
+					continue
+				}
+				rules := []*PluralRule{}
+				for _, x := range pr.PluralRule {
+					r, err := ParsePluralRule(x.Count, x.Data())
+					if err != nil {
+						return nil, err
+					}
+					rules = append(rules, r)
+				}
+				return rules, nil
+			}
+		}
+		if loc == "root" {
+			return nil, nil
+		}
+		if i := strings.LastIndex(loc, "_"); i >= 0 {
+			loc = loc[:i]
+		} else {
+			loc = "root"
+		}
+	}
+}
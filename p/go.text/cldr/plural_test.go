@@ -0,0 +1,154 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePluralRule(t *testing.T) {
+	tests := []struct {
+		in   string
+		cond string
+		ints []SampleRange
+		decs []SampleRange
+		err  bool
+	}{
+		{"", "", nil, nil, false},
+		{"i = 1 and v = 0 @integer 1", "i = 1 and v = 0", []SampleRange{{"1", "1"}}, nil, false},
+		{
+			"n % 10 = 2..4 @integer 2~4, 22~24, 32, … @decimal 2.0, 3.0~3.2, …",
+			"n % 10 = 2..4",
+			[]SampleRange{{"2", "4"}, {"22", "24"}, {"32", "32"}},
+			[]SampleRange{{"2.0", "2.0"}, {"3.0", "3.2"}},
+			false,
+		},
+		{" @integer 0, 5~19", "", []SampleRange{{"0", "0"}, {"5", "19"}}, nil, false},
+		{"n = 1 @foo 1", "", nil, nil, true},
+		{"n = 1 @integer 1~", "", nil, nil, true},
+	}
+	for _, tt := range tests {
+		r, err := ParsePluralRule("few", tt.in)
+		if (err != nil) != tt.err {
+			t.Errorf("%q: error was %v; want %v", tt.in, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if r.Condition != tt.cond {
+			t.Errorf("%q: condition was %q; want %q", tt.in, r.Condition, tt.cond)
+		}
+		if !reflect.DeepEqual(r.Integer, tt.ints) {
+			t.Errorf("%q: integer samples were %v; want %v", tt.in, r.Integer, tt.ints)
+		}
+		if !reflect.DeepEqual(r.Decimal, tt.decs) {
+			t.Errorf("%q: decimal samples were %v; want %v", tt.in, r.Decimal, tt.decs)
+		}
+	}
+}
+
+func TestSampleRangeValues(t *testing.T) {
+	tests := []struct {
+		r   SampleRange
+		n   int
+		out string
+	}{
+		{SampleRange{"1", "1"}, 5, "1"},
+		{SampleRange{"1", "1"}, 0, ""},
+		{SampleRange{"2", "4"}, 5, "2 3 4"},
+		{SampleRange{"2", "4"}, 2, "2 3"},
+		{SampleRange{"0.0", "0.3"}, 10, "0.0 0.1 0.2 0.3"},
+		{SampleRange{"0.00", "0.04"}, 2, "0.00 0.01"},
+		{SampleRange{"1.9", "2.1"}, 10, "1.9 2.0 2.1"},
+		{SampleRange{"1", "1.5"}, 10, "1 1.5"},
+		{SampleRange{"1000000", "1c6"}, 10, "1000000 1c6"},
+	}
+	for _, tt := range tests {
+		if out := strings.Join(tt.r.Values(tt.n), " "); out != tt.out {
+			t.Errorf("%v.Values(%d): was %q; want %q", tt.r, tt.n, out, tt.out)
+		}
+	}
+}
+
+func TestSamples(t *testing.T) {
+	tests := []struct {
+		in  string
+		n   int
+		out string
+	}{
+		{"n = 1 @integer 1 @decimal 1.0, 1.00", 5, "1 1.0 1.00"},
+		{"@integer 2~4, 22~24, 32~34, …", 3, "2 3 22"},
+		{"@integer 2~4, 22~24, 32~34, …", 6, "2 3 22 23 32 33"},
+		{"@integer 2~4, 22~24, 32~34, …", 8, "2 3 4 22 23 24 32 33"},
+		{"@integer 0, 5~19 @decimal 0.0~0.3", 4, "0 5 6 0.0"},
+		{"@integer 0~15", 3, "0 1 2"},
+	}
+	for _, tt := range tests {
+		r, err := ParsePluralRule("other", tt.in)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.in, err)
+		}
+		if out := strings.Join(r.Samples(tt.n), " "); out != tt.out {
+			t.Errorf("%q: Samples(%d) was %q; want %q", tt.in, tt.n, out, tt.out)
+		}
+	}
+}
+
+const pluralsXML = `<supplementalData>
+	<plurals type="cardinal">
+		<pluralRules locales="bm bo ja">
+			<pluralRule count="other"> @integer 0~15, 100, … @decimal 0.0~1.5, …</pluralRule>
+		</pluralRules>
+		<pluralRules locales="de en">
+			<pluralRule count="one">i = 1 and v = 0 @integer 1</pluralRule>
+			<pluralRule count="other"> @integer 0, 2~16, … @decimal 0.0~1.5, …</pluralRule>
+		</pluralRules>
+		<pluralRules locales="root">
+			<pluralRule count="other"> @integer 0~3 @decimal 0.0~0.2</pluralRule>
+		</pluralRules>
+	</plurals>
+	<plurals type="ordinal">
+		<pluralRules locales="en">
+			<pluralRule count="one">n % 10 = 1 and n % 100 != 11 @integer 1, 21, 31, …</pluralRule>
+			<pluralRule count="other"> @integer 0, 4~18, 100, …</pluralRule>
+		</pluralRules>
+	</plurals>
+</supplementalData>`
+
+func TestPluralRules(t *testing.T) {
+	s := &SupplementalData{}
+	if err := xml.Unmarshal([]byte(pluralsXML), s); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		typ, loc string
+		out      string
+	}{
+		{"cardinal", "de", "one: 1; other: 0, 2, 3"},
+		{"cardinal", "de_CH", "one: 1; other: 0, 2, 3"},
+		{"cardinal", "ja", "other: 0, 1, 100"},
+		{"cardinal", "xx_Latn", "other: 0, 1, 0.0"},
+		{"ordinal", "en_US", "one: 1, 21, 31; other: 0, 4, 5"},
+		{"ordinal", "de", ""},
+	}
+	for _, tt := range tests {
+		rules, err := s.PluralRules(tt.typ, tt.loc)
+		if err != nil {
+			t.Errorf("%s:%s: unexpected error: %v", tt.typ, tt.loc, err)
+			continue
+		}
+		out := []string{}
+		for _, r := range rules {
+			out = append(out, r.Category+": "+strings.Join(r.Samples(3), ", "))
+		}
+		if s := strings.Join(out, "; "); s != tt.out {
+			t.Errorf("%s:%s: was %q; want %q", tt.typ, tt.loc, s, tt.out)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Textinfo prints what the go.text packages know about a language tag. It is
+// intended to help diagnose why a program behaves the way it does for a given
+// locale.
+//
+// Usage:
+//
+//	textinfo [-ui=<tag>] [-words=<words>] <tag>...
+//
+// For each tag, textinfo prints
+//   - the tag as parsed and in canonical form,
+//   - its parent chain,
+//   - its likely subtags,
+//   - its names in the language given by -ui and in its own language,
+//   - the locale that package display uses for names in this language,
+//   - the locale whose collation is used by package collate, and
+//   - the collation order of a set of sample words, which can be changed
+//     with -words.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"code.google.com/p/go.text/collate"
+	"code.google.com/p/go.text/display"
+	"code.google.com/p/go.text/language"
+)
+
+var (
+	ui = flag.String("ui", "en",
+		"the language in which to print the display names.")
+	words = flag.String("words", "a A á ä b c ch č d ö o ø ñ n ss ß z",
+		"space-separated list of words to sort with the collator for the tag.")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: textinfo [-ui=<tag>] [-words=<words>] <tag>...\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("textinfo: ")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+	}
+	uiTag, err := language.Parse(*ui)
+	if err != nil {
+		log.Fatalf("invalid -ui tag %q: %v", *ui, err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, ' ', 0)
+	for i, s := range flag.Args() {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		info(w, s, uiTag)
+	}
+	w.Flush()
+}
+
+// info prints the information for the tag s to w.
+func info(w io.Writer, s string, ui language.Tag) {
+	t, err := language.Parse(s)
+	if err != nil {
+		// Parse returns the valid part of the tag along with the error.
+		fmt.Fprintf(w, "error:\t%v\n", err)
+		if t == language.Und {
+			return
+		}
+	}
+	if t.String() != s {
+		fmt.Fprintf(w, "tag:\t%s (parsed from %s)\n", t, s)
+	} else {
+		fmt.Fprintf(w, "tag:\t%s\n", t)
+	}
+	if c, err := language.All.Canonicalize(t); err != nil {
+		fmt.Fprintf(w, "canonical:\t%s (%v)\n", c, err)
+	} else {
+		fmt.Fprintf(w, "canonical:\t%s\n", c)
+	}
+
+	chain := []string{}
+	for p := t.Parent(); ; p = p.Parent() {
+		chain = append(chain, p.String())
+		if p.IsRoot() {
+			break
+		}
+	}
+	fmt.Fprintf(w, "parents:\t%s\n", strings.Join(chain, " → "))
+
+	b, bc := t.Base()
+	scr, sc := t.Script()
+	reg, rc := t.Region()
+	likely, _ := language.Compose(b, scr, reg)
+	fmt.Fprintf(w, "likely:\t%s (language %v, script %v, region %v)\n", likely, bc, sc, rc)
+
+	if n := display.Tags(ui); n != nil {
+		fmt.Fprintf(w, "name (%s):\t%s\n", ui, n.Name(t))
+	} else {
+		fmt.Fprintf(w, "name (%s):\t%v\n", ui, display.Covered(ui))
+	}
+	fmt.Fprintf(w, "self name:\t%s\n", display.Self.Name(t))
+	m := language.NewMatcher(display.Supported.Tags())
+	if tag, _, conf := m.Match(t); conf == language.No {
+		fmt.Fprintf(w, "display:\tno data\n")
+	} else {
+		fmt.Fprintf(w, "display:\t%s (confidence %v)\n", tag, conf)
+	}
+
+	m = language.NewMatcher(collate.Supported())
+	if tag, _, conf := m.Match(t); conf == language.No {
+		fmt.Fprintf(w, "collation:\troot\n")
+	} else {
+		fmt.Fprintf(w, "collation:\t%s (confidence %v)\n", tag, conf)
+	}
+	list := strings.Fields(*words)
+	collate.New(t).SortStrings(list)
+	fmt.Fprintf(w, "sorted:\t%s\n", strings.Join(list, " "))
+}
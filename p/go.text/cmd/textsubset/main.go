@@ -0,0 +1,186 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Textsubset creates copies of go.text packages with tables that only include
+// the data for a given list of locales.
+//
+// Usage:
+//
+//	textsubset -locales=<locales> [-dir=<dir>] [-genflags=<flags>] <package>...
+//
+// For each of the given packages, textsubset copies the non-generated source
+// files of the package to a directory of the same name in dir and runs the
+// table generator of the package to create tables for the given locales
+// in this directory. The application then imports the copy instead of the
+// original package. The supported packages are:
+//
+//	display  names are included only in and for the given locales and
+//	         their parents. The copy has no Dictionaries.
+//	collate  only the tailorings for the given locales are included.
+//
+// Textsubset is designed to be used with go generate. For example, the
+// directive
+//
+//	//go:generate textsubset -locales=en,de,fr-CA -dir=internal display collate
+//
+// creates the packages internal/display and internal/collate.
+//
+// The generators download the CLDR and Unicode data they need. Flags given
+// by -genflags, such as -local, are passed to all generators.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.google.com/p/go.text/language"
+)
+
+var (
+	locales = flag.String("locales", "",
+		"comma-separated list of locales, such as en,de,fr-CA, to include.")
+	dir = flag.String("dir", ".",
+		"directory in which to create the packages.")
+	genflags = flag.String("genflags", "",
+		"space-separated list of additional flags passed to the table generators.")
+)
+
+const textPath = "code.google.com/p/go.text/"
+
+// A subsetter describes how to create a subset of a package.
+type subsetter struct {
+	// flags returns the flags that select the given locales for the
+	// generator of the package.
+	flags func(tags []language.Tag) []string
+
+	// omit lists the source files that are not copied, in addition to
+	// generated files and tests.
+	omit []string
+}
+
+var subsetters = map[string]*subsetter{
+	"display": {
+		flags: func(tags []language.Tag) []string {
+			// Names are inherited from parent locales.
+			all := map[string]bool{}
+			for _, t := range tags {
+				for ; t != language.Und; t = t.Parent() {
+					all[t.String()] = true
+				}
+			}
+			s := []string{}
+			for t := range all {
+				s = append(s, t)
+			}
+			sort.Strings(s)
+			return []string{"-tags=" + strings.Join(s, " "), "-dict="}
+		},
+		// The Dictionaries refer to tables for languages that may not be
+		// included.
+		omit: []string{"dict.go"},
+	},
+	"collate": {
+		flags: func(tags []language.Tag) []string {
+			s := []string{}
+			for _, t := range tags {
+				s = append(s, t.String())
+			}
+			return []string{"-locales=" + strings.Join(s, ",")}
+		},
+	},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: textsubset -locales=<locales> [-dir=<dir>] [-genflags=<flags>] <package>...\n")
+	fmt.Fprintf(os.Stderr, "supported packages: collate, display\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("textsubset: ")
+	flag.Usage = usage
+	flag.Parse()
+	if *locales == "" || flag.NArg() == 0 {
+		usage()
+	}
+	tags := []language.Tag{}
+	for _, s := range strings.Split(*locales, ",") {
+		t, err := language.Parse(strings.TrimSpace(s))
+		if err != nil {
+			log.Fatalf("invalid locale %q: %v", s, err)
+		}
+		tags = append(tags, t)
+	}
+	for _, pkg := range flag.Args() {
+		s := subsetters[pkg]
+		if s == nil {
+			log.Fatalf("unsupported package %q", pkg)
+		}
+		if err := s.subset(pkg, tags); err != nil {
+			log.Fatalf("%s: %v", pkg, err)
+		}
+	}
+}
+
+// subset copies package pkg to dir and generates its tables.
+func (s *subsetter) subset(pkg string, tags []language.Tag) error {
+	src, err := build.Import(textPath+pkg, "", 0)
+	if err != nil {
+		return err
+	}
+	dst, err := filepath.Abs(filepath.Join(*dir, pkg))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	for _, f := range src.GoFiles {
+		if err := s.copyFile(dst, src.Dir, f); err != nil {
+			return err
+		}
+	}
+	args := []string{"run", filepath.Join(src.Dir, "maketables.go"),
+		"-package=" + src.Name,
+		"-output=tables.go",
+	}
+	args = append(args, s.flags(tags)...)
+	args = append(args, strings.Fields(*genflags)...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dst
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("generating tables: %v", err)
+	}
+	return nil
+}
+
+// copyFile copies file to dst unless it is generated or omitted.
+func (s *subsetter) copyFile(dst, dir, file string) error {
+	for _, f := range s.omit {
+		if f == file {
+			return nil
+		}
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		return err
+	}
+	if bytes.HasPrefix(b, []byte("// Generated by running")) {
+		return nil
+	}
+	return ioutil.WriteFile(filepath.Join(dst, file), b, 0644)
+}
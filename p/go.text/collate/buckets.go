@@ -0,0 +1,149 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
+)
+
+// An Index assigns strings to buckets identified by a label, such as the
+// section headers of a contact list or a directory. Strings are assigned to the
+// last bucket whose label sorts before or equal to the string. Labels and
+// strings are compared at the primary level only, so "Émile" and "emile" end
+// up in the same bucket as "E".
+//
+// An Index maintains an internal buffer, shared by Bucket, BucketBytes and
+// Label, and may not be used concurrently by multiple goroutines.
+type Index struct {
+	c      *Collator
+	buf    Buffer
+	labels []string
+	keys   [][]byte
+}
+
+// defaultLabels holds the index characters defined for the root locale in CLDR.
+var defaultLabels = []string{
+	"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M",
+	"N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
+}
+
+// IndexLabels returns the labels that CLDR defines for indexes in the language
+// t, such as the letters A through Z followed by Å, Ä and Ö for Swedish.
+// If no labels are defined for t, the labels of its closest parent with labels
+// are returned, or the Latin letters A through Z if there is no such parent.
+func IndexLabels(t language.Tag) []string {
+	for {
+		if s, ok := indexCharacters[t.String()]; ok {
+			labels := strings.Fields(s)
+			for i, l := range labels {
+				// A trailing '*' in CLDR marks a label that is a prefix of
+				// the strings in the bucket, such as "Sch" in German.
+				labels[i] = strings.TrimSuffix(l, "*")
+			}
+			return labels
+		}
+		p := t.Parent()
+		if p == t {
+			break
+		}
+		t = p
+	}
+	return append([]string(nil), defaultLabels...)
+}
+
+// NewIndex returns an Index that uses the ordering of c to assign strings to
+// the buckets identified by labels. The labels need not be given in collation
+// order. A label that is equal at the primary level to an earlier label is
+// dropped. If no labels are specified, the Latin letters A through Z are used.
+// Use IndexLabels to obtain the labels for a specific language.
+// Changes to c after the call to NewIndex do not affect the returned Index.
+func NewIndex(c *Collator, labels ...string) *Index {
+	if len(labels) == 0 {
+		labels = defaultLabels
+	}
+	x := &Index{
+		c: NewFromTable(c.t),
+	}
+	x.c.Strength = colltab.Primary
+	x.c.Alternate = c.Alternate
+	x.c.Numeric = c.Numeric
+	x.c.variableTop = c.variableTop
+	x.c.reorder = c.reorder
+
+	seen := make(map[string]bool)
+	var buf Buffer
+	for _, l := range labels {
+		k := x.c.KeyFromString(&buf, l)
+		if len(k) == 0 || seen[string(k)] {
+			continue
+		}
+		seen[string(k)] = true
+		x.labels = append(x.labels, l)
+		x.keys = append(x.keys, k)
+	}
+	sort.Sort(labelSorter{x})
+	return x
+}
+
+type labelSorter struct {
+	x *Index
+}
+
+func (s labelSorter) Len() int {
+	return len(s.x.keys)
+}
+
+func (s labelSorter) Swap(i, j int) {
+	s.x.keys[i], s.x.keys[j] = s.x.keys[j], s.x.keys[i]
+	s.x.labels[i], s.x.labels[j] = s.x.labels[j], s.x.labels[i]
+}
+
+func (s labelSorter) Less(i, j int) bool {
+	return bytes.Compare(s.x.keys[i], s.x.keys[j]) == -1
+}
+
+// Labels returns the labels of the buckets of x in collation order. The
+// position of a label in this list corresponds to the bucket number returned
+// by Bucket.
+func (x *Index) Labels() []string {
+	return x.labels
+}
+
+// Bucket returns the number of the bucket to which s is assigned. It returns
+// -1 if s sorts before the first label.
+func (x *Index) Bucket(s string) int {
+	x.buf.Reset()
+	k := x.c.KeyFromString(&x.buf, s)
+	return x.bucket(k)
+}
+
+// BucketBytes returns the number of the bucket to which b is assigned. It
+// returns -1 if b sorts before the first label.
+func (x *Index) BucketBytes(b []byte) int {
+	x.buf.Reset()
+	k := x.c.Key(&x.buf, b)
+	return x.bucket(k)
+}
+
+func (x *Index) bucket(key []byte) int {
+	i := sort.Search(len(x.keys), func(i int) bool {
+		return bytes.Compare(x.keys[i], key) == 1
+	})
+	return i - 1
+}
+
+// Label returns the label of the bucket to which s is assigned or the empty
+// string if s sorts before the first label.
+func (x *Index) Label(s string) string {
+	if i := x.Bucket(s); i >= 0 {
+		return x.labels[i]
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"code.google.com/p/go.text/collate"
+	"code.google.com/p/go.text/language"
+)
+
+func ExampleIndex() {
+	x := collate.NewIndex(collate.New(language.Swedish), "A", "B", "O", "Å", "Ä", "Ö")
+	fmt.Println(x.Labels())
+	for _, s := range []string{"Anna", "Åsa", "Örjan", "Olle", "ölander", "123"} {
+		fmt.Printf("%s: %q\n", s, x.Label(s))
+	}
+	// Output:
+	// [A B O Å Ä Ö]
+	// Anna: "A"
+	// Åsa: "Å"
+	// Örjan: "Ö"
+	// Olle: "O"
+	// ölander: "Ö"
+	// 123: ""
+}
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		tag    language.Tag
+		labels []string
+		in     string
+		bucket int
+	}{
+		{language.English, nil, "apple", 0},
+		{language.English, nil, "Émile", 4},
+		{language.English, nil, "zebra", 25},
+		{language.English, nil, "ßtraße", 18},
+		{language.English, nil, "", -1},
+		{language.English, nil, "!", -1},
+		{language.English, []string{"Z", "A", "M"}, "nobody", 1},
+		// Duplicates at the primary level are dropped.
+		{language.English, []string{"A", "a", "Ä", "B"}, "b", 1},
+		{language.German, []string{"A", "Ä", "B"}, "Ärger", 0},
+		{language.Swedish, []string{"A", "Ä", "B"}, "Ärger", 2},
+	}
+	for i, tt := range tests {
+		x := collate.NewIndex(collate.New(tt.tag), tt.labels...)
+		if b := x.Bucket(tt.in); b != tt.bucket {
+			t.Errorf("%d: Bucket(%q) was %d; want %d (labels %v)", i, tt.in, b, tt.bucket, x.Labels())
+		}
+		if b := x.BucketBytes([]byte(tt.in)); b != tt.bucket {
+			t.Errorf("%d: BucketBytes(%q) was %d; want %d", i, tt.in, b, tt.bucket)
+		}
+	}
+}
+
+func TestIndexLabels(t *testing.T) {
+	tests := []struct {
+		tag   string
+		first string
+		last  string
+		n     int
+	}{
+		{"sv", "A", "Ö", 29},
+		{"sv-SE", "A", "Ö", 29},
+		{"da", "A", "Å", 29},
+		{"de", "A", "Z", 28},
+		{"ru", "А", "Я", 31},
+		{"en-GB", "A", "Z", 26},
+		{"und", "A", "Z", 26},
+		{"cs", "A", "Ž", 31},
+		{"pl", "A", "Ż", 35},
+		{"ja", "あ", "わ", 10},
+		{"mr", "ॐ", "्", 53},
+	}
+	for _, tt := range tests {
+		l := collate.IndexLabels(language.Make(tt.tag))
+		if len(l) != tt.n || l[0] != tt.first || l[len(l)-1] != tt.last {
+			t.Errorf("%s: IndexLabels = %v; want %d labels from %s to %s", tt.tag, l, tt.n, tt.first, tt.last)
+		}
+	}
+	sv := language.Make("sv")
+	x := collate.NewIndex(collate.New(sv), collate.IndexLabels(sv)...)
+	for _, s := range []string{"Åsa", "Ärlig", "Ödman", "Zorn"} {
+		if l := x.Label(s); l != s[:len(l)] {
+			t.Errorf("sv: Label(%q) = %q", s, l)
+		}
+	}
+	de := language.German
+	x = collate.NewIndex(collate.New(de), collate.IndexLabels(de)...)
+	for _, tt := range [][2]string{{"Schubert", "Sch"}, {"Strauss", "St"}, {"Schönberg", "Sch"}, {"Sand", "S"}, {"Tal", "T"}} {
+		if l := x.Label(tt[0]); l != tt[1] {
+			t.Errorf("de: Label(%q) = %q; want %q", tt[0], l, tt[1])
+		}
+	}
+}
@@ -117,33 +117,73 @@ func (b *Builder) Add(runes []rune, colelems [][]int, variables []int) error {
 		}
 	}
 	for i, ce := range elems {
-		p := ce.w[0]
 		isvar := false
 		for _, j := range variables {
 			if i == j {
 				isvar = true
 			}
 		}
-		if isvar {
-			if p >= b.minNonVar && b.minNonVar > 0 {
-				return fmt.Errorf("primary value %X of variable is larger than the smallest non-variable %X", p, b.minNonVar)
-			}
-			if p > b.varTop {
-				b.varTop = p
-			}
-		} else if p > 1 { // 1 is a special primary value reserved for FFFE
-			if p <= b.varTop {
-				return fmt.Errorf("primary value %X of non-variable is smaller than the highest variable %X", p, b.varTop)
-			}
-			if b.minNonVar == 0 || p < b.minNonVar {
-				b.minNonVar = p
-			}
+		if err := b.checkVariable(ce.w[0], isvar); err != nil {
+			return err
 		}
 	}
 	elems, err := convertLargeWeights(elems)
 	if err != nil {
 		return err
 	}
+	return b.add(str, elems)
+}
+
+// AddTable adds all entries defined in w to the collation element table.
+// Unlike with Add, the weights of w are taken as is.  AddTable allows
+// tailoring a compiled table at run time.  It should not be combined with
+// calls to Add.
+func (b *Builder) AddTable(w colltab.Weigher) error {
+	top := int(w.Top())
+	var ces []colltab.Elem
+	for _, str := range w.Domain() {
+		var n int
+		if ces, n = w.AppendNextString(ces[:0], str); n != len(str) {
+			continue
+		}
+		elems := make([]rawCE, len(ces))
+		for i, ce := range ces {
+			p := ce.Primary()
+			if err := b.checkVariable(p, p > 0 && p <= top); err != nil {
+				return err
+			}
+			elems[i] = makeRawCE([]int{p, ce.Secondary(), int(ce.Tertiary()), p}, 0)
+		}
+		if err := b.add(str, elems); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkVariable verifies that the primary weights of variables are all smaller
+// than the primary weights of non-variables.
+func (b *Builder) checkVariable(p int, isvar bool) error {
+	if isvar {
+		if p >= b.minNonVar && b.minNonVar > 0 {
+			return fmt.Errorf("primary value %X of variable is larger than the smallest non-variable %X", p, b.minNonVar)
+		}
+		if p > b.varTop {
+			b.varTop = p
+		}
+	} else if p > 1 { // 1 is a special primary value reserved for FFFE
+		if p <= b.varTop {
+			return fmt.Errorf("primary value %X of non-variable is smaller than the highest variable %X", p, b.varTop)
+		}
+		if b.minNonVar == 0 || p < b.minNonVar {
+			b.minNonVar = p
+		}
+	}
+	return nil
+}
+
+// add adds an entry for str with the given collation elements.
+func (b *Builder) add(str string, elems []rawCE) error {
 	cccs := []uint8{}
 	nfd := norm.NFD.String(str)
 	for i := range nfd {
@@ -167,7 +207,7 @@ func (b *Builder) Add(runes []rune, colelems [][]int, variables []int) error {
 	}
 	// doNorm in collate.go assumes that the following conditions hold.
 	if len(elems) > 1 && len(cccs) > 1 && cccs[0] != 0 && cccs[0] != cccs[len(cccs)-1] {
-		return fmt.Errorf("incompatible CCC values for expansion %X (%d)", runes, cccs)
+		return fmt.Errorf("incompatible CCC values for expansion %X (%d)", []rune(str), cccs)
 	}
 	b.root.newEntry(str, elems)
 	return nil
@@ -416,6 +456,7 @@ func (o *ordering) patchNorm() {
 }
 
 func (b *Builder) buildOrdering(o *ordering) {
+	start := b.size()
 	for _, e := range o.ordered {
 		o.getWeight(e)
 	}
@@ -437,6 +478,40 @@ func (b *Builder) buildOrdering(o *ordering) {
 		}
 	}
 	o.handle = b.index.addTrie(t)
+	o.size = b.size() - start
+}
+
+// size returns the number of bytes of the tables built so far.
+func (b *Builder) size() int {
+	n := len(b.index.lookupBlocks) * blockSize * 2
+	n += len(b.index.valueBlocks) * blockSize * 4
+	n += len(b.t.expandElem) * 4
+	n += len(b.t.contractElem) * 4
+	n += len(b.t.contractTries) * 4
+	return n
+}
+
+// A LocaleSize reports the number of bytes that a locale adds to the
+// generated tables.
+type LocaleSize struct {
+	ID    string // "root" for the root table
+	Bytes int
+}
+
+// Sizes returns the size contributed by the root table and each Tailoring to
+// the tables, in the order in which the Tailorings were created. Blocks of the
+// trie, expansions and contractions shared with previously built tables are
+// not counted again, so the size of a Tailoring is the cost of including it
+// in addition to the root table and the Tailorings before it.
+func (b *Builder) Sizes() ([]LocaleSize, error) {
+	if _, err := b.build(); err != nil {
+		return nil, err
+	}
+	sizes := []LocaleSize{{"root", b.root.size}}
+	for _, t := range b.locale {
+		sizes = append(sizes, LocaleSize{t.id, t.index.size})
+	}
+	return sizes, nil
 }
 
 func (b *Builder) build() (*table, error) {
@@ -479,8 +554,17 @@ func (b *Builder) Build() (colltab.Weigher, error) {
 
 // Build builds a Collator for Tailoring t.
 func (t *Tailoring) Build() (colltab.Weigher, error) {
-	// TODO: implement.
-	return nil, nil
+	tbl, err := t.builder.build()
+	if err != nil {
+		return nil, err
+	}
+	lt := *tbl
+	lt.root = t.index.handle
+	table := colltab.Init(&lt)
+	if table == nil {
+		panic("generated table of incompatible type")
+	}
+	return table, nil
 }
 
 // Print prints the tables for b and all its Tailorings as a Go file
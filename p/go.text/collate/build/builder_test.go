@@ -4,7 +4,12 @@
 
 package build
 
-import "testing"
+import (
+	"testing"
+
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
+)
 
 // cjk returns an implicit collation element for a CJK rune.
 func cjk(r rune) []rawCE {
@@ -288,3 +293,86 @@ func TestContract(t *testing.T) {
 		t.Errorf("len(expandElem)==%d; want %d", len(b.t.contractElem), totalElements)
 	}
 }
+
+func TestSizes(t *testing.T) {
+	b := newBuilder(t, []ducetElem{
+		{"a", pCE(100)},
+		{"b", pCE(200)},
+		{"c", pCE(300)},
+	})
+	b.Tailoring(language.Make("und-x-same"))
+	tl := b.Tailoring(language.Make("und-x-diff"))
+	if err := tl.SetAnchor("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tl.Insert(colltab.Primary, "d", ""); err != nil {
+		t.Fatal(err)
+	}
+	sizes, err := b.Sizes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, id := range []string{"root", "und-x-same", "und-x-diff"} {
+		if i >= len(sizes) || sizes[i].ID != id {
+			t.Fatalf("got %v; want IDs root, und-x-same, und-x-diff", sizes)
+		}
+	}
+	if sizes[0].Bytes == 0 {
+		t.Errorf("size of root is 0")
+	}
+	if sizes[1].Bytes != 0 {
+		t.Errorf("untailored locale adds %d bytes; want 0", sizes[1].Bytes)
+	}
+	if sizes[2].Bytes == 0 {
+		t.Errorf("tailored locale adds 0 bytes")
+	}
+}
+
+func TestAddTable(t *testing.T) {
+	b := NewBuilder()
+	for _, e := range []ducetElem{
+		{"a", pCE(100)},
+		{"b", pCE(200)},
+		{"c", pCE(300)},
+		{"ch", pCE(350)},
+	} {
+		if err := b.Add([]rune(e.str), [][]int{e.ces[0].w}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	root, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b = NewBuilder()
+	if err := b.AddTable(root); err != nil {
+		t.Fatal(err)
+	}
+	tl := b.Tailoring(language.Make("und-x-test"))
+	if err := tl.SetAnchor("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tl.Insert(colltab.Primary, "d", ""); err != nil {
+		t.Fatal(err)
+	}
+	w, err := tl.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary := func(s string) int {
+		ces, n := w.AppendNextString(nil, s)
+		if n != len(s) || len(ces) != 1 {
+			t.Fatalf("%s: got %d elements for %d bytes; want 1 for %d", s, len(ces), n, len(s))
+		}
+		return ces[0].Primary()
+	}
+	if d := root.Domain(); len(d) != 4 || d[2] != "c" || d[3] != "ch" {
+		t.Errorf("Domain() = %q; want [a b c ch]", d)
+	}
+	if a, d, b := primary("a"), primary("d"), primary("b"); !(a < d && d < b) {
+		t.Errorf("primary of d is %X; want between %X and %X", d, a, b)
+	}
+	if p := primary("ch"); p != 350 {
+		t.Errorf("primary of ch is %X; want %X", p, 350)
+	}
+}
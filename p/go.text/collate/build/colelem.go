@@ -153,7 +153,11 @@ func implicitPrimary(r rune) int {
 			// for all characters that do not decompose.
 			return int(r) + commonUnifiedOffset
 		}
-		return int(r) + rareUnifiedOffset
+		// Ideographs beyond the range of rare ideographs were added in
+		// later versions of Unicode and are treated as unassigned runes.
+		if r < otherOffset-rareUnifiedOffset {
+			return int(r) + rareUnifiedOffset
+		}
 	}
 	return int(r) + otherOffset
 }
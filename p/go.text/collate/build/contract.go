@@ -48,7 +48,7 @@ const (
 
 // ctEntry associates to a matching byte an offset and/or next sequence of
 // bytes to check. A ctEntry c is called final if a match means that the
-// longest suffix has been found.  An entry c is final if c.n == 0.
+// longest suffix has been found.  An entry c is final if c.N == 0.
 // A single final entry can match a range of characters to an offset.
 // A non-final entry always matches a single byte. Note that a non-final
 // entry might still resemble a completed suffix.
@@ -69,15 +69,15 @@ const (
 // }
 // See genStateTests in contract_test.go for more examples.
 type ctEntry struct {
-	l uint8 // non-final: byte value to match; final: lowest match in range.
-	h uint8 // non-final: relative index to next block; final: highest match in range.
-	n uint8 // non-final: length of next block; final: final
-	i uint8 // result offset. Will be noIndex if more bytes are needed to complete.
+	L uint8 // non-final: byte value to match; final: lowest match in range.
+	H uint8 // non-final: relative index to next block; final: highest match in range.
+	N uint8 // non-final: length of next block; final: final
+	I uint8 // result offset. Will be noIndex if more bytes are needed to complete.
 }
 
 // contractTrieSet holds a set of contraction tries. The tries are stored
 // consecutively in the entry field.
-type contractTrieSet []struct{ l, h, n, i uint8 }
+type contractTrieSet []struct{ L, H, N, I uint8 }
 
 // ctHandle is used to identify a trie in the trie set, consisting in an offset
 // in the array and the size of the first node.
@@ -123,29 +123,29 @@ func (ct *contractTrieSet) genStates(sis []stridx) (int, error) {
 		c := s[0]
 		if len(s) > 1 {
 			for j := len(*ct) - 1; j >= start; j-- {
-				if (*ct)[j].l == c {
+				if (*ct)[j].L == c {
 					added = true
 					break
 				}
 			}
 			if !added {
-				*ct = append(*ct, ctEntry{l: c, i: noIndex})
+				*ct = append(*ct, ctEntry{L: c, I: noIndex})
 			}
 		} else {
 			for j := len(*ct) - 1; j >= start; j-- {
 				// Update the offset for longer suffixes with the same byte.
-				if (*ct)[j].l == c {
-					(*ct)[j].i = uint8(si.index)
+				if (*ct)[j].L == c {
+					(*ct)[j].I = uint8(si.index)
 					added = true
 				}
 				// Extend range of final ctEntry, if possible.
-				if (*ct)[j].h+1 == c {
-					(*ct)[j].h = c
+				if (*ct)[j].H+1 == c {
+					(*ct)[j].H = c
 					added = true
 				}
 			}
 			if !added {
-				*ct = append(*ct, ctEntry{l: c, h: c, n: final, i: uint8(si.index)})
+				*ct = append(*ct, ctEntry{L: c, H: c, N: final, I: uint8(si.index)})
 			}
 		}
 	}
@@ -154,17 +154,17 @@ func (ct *contractTrieSet) genStates(sis []stridx) (int, error) {
 	sp := 0
 	for i, end := start, len(*ct); i < end; i++ {
 		fe := (*ct)[i]
-		if fe.h == 0 { // uninitialized non-final
+		if fe.H == 0 { // uninitialized non-final
 			ln := len(*ct) - start - n
 			if ln > 0xFF {
 				return 0, fmt.Errorf("genStates: relative block offset too large: %d > 255", ln)
 			}
-			fe.h = uint8(ln)
+			fe.H = uint8(ln)
 			// Find first non-final strings with same byte as current entry.
-			for ; sis[sp].str[0] != fe.l; sp++ {
+			for ; sis[sp].str[0] != fe.L; sp++ {
 			}
 			se := sp + 1
-			for ; se < len(sis) && len(sis[se].str) > 1 && sis[se].str[0] == fe.l; se++ {
+			for ; se < len(sis) && len(sis[se].str) > 1 && sis[se].str[0] == fe.L; se++ {
 			}
 			sl := sis[sp:se]
 			sp = se
@@ -175,7 +175,7 @@ func (ct *contractTrieSet) genStates(sis []stridx) (int, error) {
 			if err != nil {
 				return 0, err
 			}
-			fe.n = uint8(nn)
+			fe.N = uint8(nn)
 			(*ct)[i] = fe
 		}
 	}
@@ -191,7 +191,7 @@ type entrySort contractTrieSet
 func (fe entrySort) Len() int      { return len(fe) }
 func (fe entrySort) Swap(i, j int) { fe[i], fe[j] = fe[j], fe[i] }
 func (fe entrySort) Less(i, j int) bool {
-	return fe[i].l > fe[j].l
+	return fe[i].L > fe[j].L
 }
 
 // stridx is used for sorting suffixes and their associated offsets.
@@ -240,22 +240,22 @@ func (ct *contractTrieSet) lookup(h ctHandle, str []byte) (index, ns int) {
 	for i := 0; i < n && p < len(str); {
 		e := states[i]
 		c := str[p]
-		if c >= e.l {
-			if e.l == c {
+		if c >= e.L {
+			if e.L == c {
 				p++
-				if e.i != noIndex {
-					index, ns = int(e.i), p
+				if e.I != noIndex {
+					index, ns = int(e.I), p
 				}
-				if e.n != final {
+				if e.N != final {
 					// set to new state
-					i, states, n = 0, states[int(e.h)+n:], int(e.n)
+					i, states, n = 0, states[int(e.H)+n:], int(e.N)
 				} else {
 					return
 				}
 				continue
-			} else if e.n == final && c <= e.h {
+			} else if e.N == final && c <= e.H {
 				p++
-				return int(c-e.l) + int(e.i), p
+				return int(c-e.L) + int(e.I), p
 			}
 		}
 		i++
@@ -292,9 +292,9 @@ func (ct contractTrieSet) printArray(w io.Writer, name string) (n, size int, err
 	}
 	size = len(ct) * 4
 	p("// %sCTEntries: %d entries, %d bytes\n", name, len(ct), size)
-	p("var %sCTEntries = [%d]struct{l,h,n,i uint8}{\n", name, len(ct))
+	p("var %sCTEntries = [%d]struct{ L, H, N, I uint8 }{\n", name, len(ct))
 	for _, fe := range ct {
-		p("\t{0x%X, 0x%X, %d, %d},\n", fe.l, fe.h, fe.n, fe.i)
+		p("\t{0x%X, 0x%X, %d, %d},\n", fe.L, fe.H, fe.N, fe.I)
 	}
 	p("}\n")
 	return
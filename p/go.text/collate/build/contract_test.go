@@ -91,7 +91,7 @@ func TestEntrySort(t *testing.T) {
 	for i, et := range entrySortTests {
 		sort.Sort(entrySort(et))
 		for j, fe := range et {
-			if j != int(fe.i) {
+			if j != int(fe.I) {
 				t.Errorf("%dth sort failed %v", i, et)
 				break
 			}
@@ -199,17 +199,17 @@ func TestGenStates(t *testing.T) {
 		}
 		for j, fe := range tt.out {
 			const msg = "%d:%d: value %s=%v; want %v"
-			if fe.l != ct[j].l {
-				t.Errorf(msg, i, j, "l", ct[j].l, fe.l)
+			if fe.L != ct[j].L {
+				t.Errorf(msg, i, j, "l", ct[j].L, fe.L)
 			}
-			if fe.h != ct[j].h {
-				t.Errorf(msg, i, j, "h", ct[j].h, fe.h)
+			if fe.H != ct[j].H {
+				t.Errorf(msg, i, j, "h", ct[j].H, fe.H)
 			}
-			if fe.n != ct[j].n {
-				t.Errorf(msg, i, j, "n", ct[j].n, fe.n)
+			if fe.N != ct[j].N {
+				t.Errorf(msg, i, j, "n", ct[j].N, fe.N)
 			}
-			if fe.i != ct[j].i {
-				t.Errorf(msg, i, j, "i", ct[j].i, fe.i)
+			if fe.I != ct[j].I {
+				t.Errorf(msg, i, j, "i", ct[j].I, fe.I)
 			}
 		}
 	}
@@ -250,7 +250,7 @@ func TestPrintContractionTrieSet(t *testing.T) {
 }
 
 const contractTrieOutput = `// testCTEntries: 8 entries, 32 bytes
-var testCTEntries = [8]struct{l,h,n,i uint8}{
+var testCTEntries = [8]struct{ L, H, N, I uint8 }{
 	{0x62, 0x3, 1, 255},
 	{0x61, 0x0, 1, 255},
 	{0x62, 0x0, 1, 6},
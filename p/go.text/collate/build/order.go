@@ -215,6 +215,7 @@ type ordering struct {
 	entryMap map[string]*entry
 	ordered  []*entry
 	handle   *trieHandle
+	size     int // number of bytes added to the tables by this ordering
 }
 
 // insert inserts e into both entryMap and ordered.
@@ -42,7 +42,7 @@ func (t *table) ExpandElems() []uint32 {
 	return t.expandElem
 }
 
-func (t *table) ContractTries() []struct{ l, h, n, i uint8 } {
+func (t *table) ContractTries() []struct{ L, H, N, I uint8 } {
 	return t.contractTries
 }
 
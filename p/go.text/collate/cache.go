@@ -0,0 +1,89 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"bytes"
+	"container/list"
+	"sort"
+)
+
+// A CachingCollator memoizes the collation keys of the most recently used
+// strings.  It is useful for applications that repeatedly compare or sort
+// the same set of strings.  Like a Collator, a CachingCollator may not be
+// used concurrently.
+type CachingCollator struct {
+	c    *Collator
+	max  int
+	buf  Buffer
+	keys map[string]*list.Element
+	lru  list.List // of *cacheEntry, most recently used first
+}
+
+type cacheEntry struct {
+	str string
+	key []byte
+}
+
+// NewCachingCollator returns a CachingCollator that retains the keys
+// generated by c for at most maxEntries strings.  The settings of c should
+// not be changed while it is used by the CachingCollator, unless Reset is
+// called afterwards.
+func NewCachingCollator(c *Collator, maxEntries int) *CachingCollator {
+	return &CachingCollator{
+		c:    c,
+		max:  maxEntries,
+		keys: make(map[string]*list.Element),
+	}
+}
+
+// Reset clears the cache.
+func (cc *CachingCollator) Reset() {
+	cc.keys = make(map[string]*list.Element)
+	cc.lru.Init()
+}
+
+// Len returns the number of keys in the cache.
+func (cc *CachingCollator) Len() int {
+	return cc.lru.Len()
+}
+
+// KeyFromString returns the collation key for str.  The returned slice
+// must not be modified.
+func (cc *CachingCollator) KeyFromString(str string) []byte {
+	if e, ok := cc.keys[str]; ok {
+		cc.lru.MoveToFront(e)
+		return e.Value.(*cacheEntry).key
+	}
+	cc.buf.Reset()
+	key := append([]byte(nil), cc.c.KeyFromString(&cc.buf, str)...)
+	if cc.max <= 0 {
+		return key
+	}
+	if cc.lru.Len() >= cc.max {
+		e := cc.lru.Back()
+		delete(cc.keys, e.Value.(*cacheEntry).str)
+		cc.lru.Remove(e)
+	}
+	cc.keys[str] = cc.lru.PushFront(&cacheEntry{str, key})
+	return key
+}
+
+// CompareString returns an integer comparing the two strings.
+// The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
+func (cc *CachingCollator) CompareString(a, b string) int {
+	return bytes.Compare(cc.KeyFromString(a), cc.KeyFromString(b))
+}
+
+// SortStrings uses sort.Sort to sort the strings in x using the rules of
+// the underlying Collator.
+func (cc *CachingCollator) SortStrings(x []string) {
+	s := &cc.c.sorter
+	s.init(len(x))
+	for i, str := range x {
+		s.keys[i] = cc.KeyFromString(str)
+	}
+	s.sort(sort.StringSlice(x))
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"code.google.com/p/go.text/language"
+)
+
+func TestCachingCollator(t *testing.T) {
+	c := New(language.English)
+	cc := NewCachingCollator(c, 2)
+	buf := Buffer{}
+	for _, s := range []string{"a", "b", "a", "c", "á", "a"} {
+		if k, want := cc.KeyFromString(s), c.KeyFromString(&buf, s); !bytes.Equal(k, want) {
+			t.Errorf("KeyFromString(%q) = %x; want %x", s, k, want)
+		}
+		if n := cc.Len(); n > 2 {
+			t.Errorf("cache holds %d entries; want at most 2", n)
+		}
+	}
+	if _, ok := cc.keys["b"]; ok {
+		t.Errorf("least recently used entry was not evicted")
+	}
+	for _, tt := range []struct {
+		a, b string
+		res  int
+	}{
+		{"a", "b", -1},
+		{"á", "a", 1},
+		{"b", "b", 0},
+	} {
+		if res := cc.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("CompareString(%q, %q) = %d; want %d", tt.a, tt.b, res, tt.res)
+		}
+	}
+	strs := []string{"ad", "ab", "äb", "ac"}
+	cc.SortStrings(strs)
+	if res, want := fmt.Sprint(strs), "[ab äb ac ad]"; res != want {
+		t.Errorf("SortStrings: found %s; want %s", res, want)
+	}
+	cc.Reset()
+	if n := cc.Len(); n != 0 {
+		t.Errorf("Len() = %d after Reset; want 0", n)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"sort"
+
+	"code.google.com/p/go.text/collate/colltab"
+)
+
+// A ColElemIter iterates over the collation elements of a text and reports,
+// for each character or contraction, the range of bytes of the text from
+// which its elements were derived.  It can be used, for instance, to map
+// the results of comparing collation elements back to the original text.
+//
+// The elements are those defined by the table of the Collator, including
+// the effect of Numeric, but before the handling of variable elements and
+// other settings is applied.  If the combining marks following a character
+// are not in canonical order, their collation elements are reordered as
+// they would be for the normalized text, and the character and its marks
+// are reported as a single unit.
+type ColElemIter struct {
+	t     colltab.Weigher
+	bytes []byte
+	str   string
+
+	ce         []colltab.Elem
+	start, end int
+
+	buf []colltab.Elem // for looking ahead
+}
+
+// ColElems returns an iterator over the collation elements of b.
+func (c *Collator) ColElems(b []byte) *ColElemIter {
+	return &ColElemIter{t: c.weigher(), bytes: b}
+}
+
+// ColElemsString returns an iterator over the collation elements of s.
+func (c *Collator) ColElemsString(s string) *ColElemIter {
+	return &ColElemIter{t: c.weigher(), str: s}
+}
+
+// Next advances the iterator to the next character or contraction.
+// It returns false if the end of the text was reached.
+func (it *ColElemIter) Next() bool {
+	if it.end >= it.len() {
+		it.start, it.ce = it.end, it.ce[:0]
+		return false
+	}
+	it.start = it.end
+	var n int
+	it.ce, n = it.appendNext(it.ce[:0], it.start)
+	it.end += n
+
+	// Look ahead for combining marks that need to be reordered.
+	prevCCC := it.ce[len(it.ce)-1].CCC()
+	inOrder := true
+	it.buf = append(it.buf[:0], it.ce...)
+	p := it.end
+	for i := 0; i < maxCombiningCharacters && p < it.len(); i++ {
+		k := len(it.buf)
+		it.buf, n = it.appendNext(it.buf, p)
+		ccc := it.buf[k].CCC()
+		if ccc == 0 {
+			break
+		}
+		if ccc < prevCCC {
+			inOrder = false
+		}
+		prevCCC = it.buf[len(it.buf)-1].CCC()
+		p += n
+		if !inOrder {
+			it.ce = append(it.ce[:0], it.buf[:len(it.buf)]...)
+			it.end = p
+		}
+	}
+	if !inOrder {
+		sort.Stable(byCCC(it.ce))
+	}
+	return true
+}
+
+// Elems returns the collation elements of the current character or
+// contraction.  The returned slice is only valid until the next call to Next.
+func (it *ColElemIter) Elems() []colltab.Elem {
+	return it.ce
+}
+
+// Pos returns the range of bytes [start, end) of the text from which the
+// collation elements returned by Elems were derived.
+func (it *ColElemIter) Pos() (start, end int) {
+	return it.start, it.end
+}
+
+func (it *ColElemIter) len() int {
+	if it.bytes == nil {
+		return len(it.str)
+	}
+	return len(it.bytes)
+}
+
+func (it *ColElemIter) appendNext(buf []colltab.Elem, p int) ([]colltab.Elem, int) {
+	if it.bytes == nil {
+		return it.t.AppendNextString(buf, it.str[p:])
+	}
+	return it.t.AppendNext(buf, it.bytes[p:])
+}
+
+// byCCC sorts collation elements by their canonical combining class.
+type byCCC []colltab.Elem
+
+func (s byCCC) Len() int           { return len(s) }
+func (s byCCC) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byCCC) Less(i, j int) bool { return s[i].CCC() < s[j].CCC() }
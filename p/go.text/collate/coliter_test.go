@@ -0,0 +1,52 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"reflect"
+	"testing"
+
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
+)
+
+func TestColElemIter(t *testing.T) {
+	tests := []struct {
+		tag string
+		in  string
+		pos [][2]int
+	}{
+		{"en", "", nil},
+		{"en", "abc", [][2]int{{0, 1}, {1, 2}, {2, 3}}},
+		{"en", "a\u0301b", [][2]int{{0, 1}, {1, 3}, {3, 4}}},
+		{"en", "a\u0316\u0301", [][2]int{{0, 1}, {1, 3}, {3, 5}}},
+		// Combining marks out of canonical order.
+		{"en", "a\u0301\u0316b", [][2]int{{0, 5}, {5, 6}}},
+		{"en", "xa\u0301\u0316\u0301", [][2]int{{0, 1}, {1, 8}}},
+		{"en", "\u01C6", [][2]int{{0, 2}}},
+		{"en", "\uAC01", [][2]int{{0, 3}}},
+		{"cs", "chci", [][2]int{{0, 2}, {2, 3}, {3, 4}}},
+		{"en-u-kn-true", "a123b", [][2]int{{0, 1}, {1, 4}, {4, 5}}},
+	}
+	for _, tt := range tests {
+		c := New(language.Make(tt.tag))
+		want := append([]colltab.Elem(nil), c.getColElemsString(tt.in)...)
+		for _, it := range []*ColElemIter{c.ColElemsString(tt.in), c.ColElems([]byte(tt.in))} {
+			var ce []colltab.Elem
+			var pos [][2]int
+			for it.Next() {
+				ce = append(ce, it.Elems()...)
+				start, end := it.Pos()
+				pos = append(pos, [2]int{start, end})
+			}
+			if !reflect.DeepEqual(pos, tt.pos) {
+				t.Errorf("%s:%+q: positions were %v; want %v", tt.tag, tt.in, pos, tt.pos)
+			}
+			if len(ce) != len(want) || len(ce) > 0 && !reflect.DeepEqual(ce, want) {
+				t.Errorf("%s:%+q: elements were %X; want %X", tt.tag, tt.in, ce, want)
+			}
+		}
+	}
+}
@@ -5,11 +5,23 @@
 // Package collate contains types for comparing and sorting Unicode strings
 // according to a given collation order.  Package locale provides a high-level
 // interface to collation. Users should typically use that package instead.
+//
+// By default, the tables for all supported locales are linked into a program.
+// Programs that need only a few locales can generate smaller tables with
+// "make custom LOCALES=en,de" and build with the collate_custom build tag.
+// The make step, which writes tables_custom.go, must be run first: with the
+// build tag set, tables.go is excluded and the package does not compile
+// without a generated tables_custom.go.
+// Alternatively, the tables for individual locales can be loaded at run time
+// using colltab.Load.
 package collate
 
 import (
 	"bytes"
+	"io"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"code.google.com/p/go.text/collate/colltab"
 	"code.google.com/p/go.text/language"
@@ -42,6 +54,8 @@ const (
 
 // Collator provides functionality for comparing strings for a given
 // collation order.
+// A Collator maintains internal state and may not be used concurrently
+// by multiple goroutines.  Use Clone to obtain a Collator for each goroutine.
 type Collator struct {
 	// TODO: hide most of these options. Low-level options are set through the locale
 	// identifier (as defined by LDML) while high-level options are set through SetOptions.
@@ -50,6 +64,11 @@ type Collator struct {
 	// diacritical marks to be ignored but not case without having to fiddle with levels).
 
 	// Strength sets the maximum level to use in comparison.
+	// The quaternary level is derived as described in UCA: variables that are
+	// shifted get their primary weight, and all other elements that are not
+	// ignorable get the maximum weight.  Unless Alternate is AltShifted or
+	// AltShiftTrimmed, the quaternary level thus does not affect the order,
+	// but it is still included in keys.
 	Strength colltab.Level
 
 	// Alternate specifies an alternative handling of variables.
@@ -77,12 +96,38 @@ type Collator struct {
 	// The largest primary value that is considered to be variable.
 	variableTop uint32
 
+	// tertiary maps tertiary weights to the values used for comparison.
+	// It is nil if the weights of the table are used as is.
+	tertiary *[256]uint8
+
+	// caseFirst is UpperFirst or LowerFirst if the corresponding case should
+	// sort first at the tertiary level, or 0 otherwise.
+	caseFirst Option
+
+	// ignoreDiacritics restricts the tertiary level to elements with a
+	// primary weight, so that diacritical marks are ignored entirely.
+	ignoreDiacritics bool
+
+	// force compares strings at the identical level and orders strings that
+	// are equal at all levels, including the identical level, by their bytes.
+	force bool
+
+	// reorder holds the ranges of primary weights that are moved by
+	// SetReorder, sorted by their original position.
+	reorder []reorderRange
+
 	f norm.Form
 
 	t colltab.Weigher
 
+	// numeric wraps t to implement Numeric. It is created on first use.
+	numeric colltab.Weigher
+
 	sorter sorter
 
+	// writeBuf holds the key written by WriteKey. It is created on first use.
+	writeBuf *Buffer
+
 	_iter [2]iter
 }
 
@@ -103,18 +148,170 @@ const (
 )
 
 // SetOptions accepts a Options or-ed together.  All previous calls to SetOptions are ignored.
+// It overwrites the values of Strength, CaseLevel and Numeric.
 func (c *Collator) SetOptions(o Option) {
-	// TODO: implement
+	c.Strength = colltab.Tertiary
+	c.CaseLevel = false
+	c.Numeric = o&Numeric != 0
+	c.force = o&Force != 0
+	c.tertiary = nil
+	c.caseFirst = 0
+	c.ignoreDiacritics = false
+
+	const ignoreTertiary = IgnoreCase | IgnoreWidth
+	if o&IgnoreCase == 0 {
+		c.caseFirst = o & (UpperFirst | LowerFirst)
+	}
+	if o&IgnoreDiacritics != 0 {
+		c.Strength = colltab.Primary
+		if o&ignoreTertiary == ignoreTertiary {
+			return
+		}
+		// Skip the secondary level, but retain the differences in case or
+		// width that are not ignored.
+		c.CaseLevel = true
+		c.ignoreDiacritics = true
+	} else if o&ignoreTertiary == ignoreTertiary {
+		c.Strength = colltab.Secondary
+		return
+	}
+	if o&ignoreTertiary != 0 {
+		c.tertiary = tertiaryMap(o)
+	}
+}
+
+// Tertiary weights used by the DUCET for case and width variants.
+// See http://www.unicode.org/reports/tr10/#Tertiary_Weight_Table.
+const (
+	tertiaryLower       = 0x02
+	tertiaryWide        = 0x03
+	tertiaryUpper       = 0x08 // 0x08-0x0C are upper case variants of 0x02-0x06
+	tertiaryWideUpper   = 0x09
+	tertiaryLastUpper   = 0x0C
+	tertiarySmallNarrow = 0x10 // small narrow katakana
+	tertiaryNarrow      = 0x12 // narrow katakana
+	tertiarySquare      = 0x1C
+	tertiarySquareUpper = 0x1D
+	maxTertiary         = 0x1F
+)
+
+func isUpperTertiary(t uint8) bool {
+	return tertiaryUpper <= t && t <= tertiaryLastUpper || t == tertiarySquareUpper
+}
+
+// tertiaryMap returns a mapping of tertiary weights that implements the
+// case and width options of o.  Non-zero weights are never mapped to zero.
+func tertiaryMap(o Option) *[256]uint8 {
+	m := &[256]uint8{}
+	for i := range m {
+		t := uint8(i)
+		if t == 0 || t > maxTertiary {
+			m[i] = t
+			continue
+		}
+		if o&IgnoreCase != 0 && isUpperTertiary(t) {
+			if t == tertiarySquareUpper {
+				t = tertiarySquare
+			} else {
+				t -= tertiaryUpper - tertiaryLower
+			}
+		}
+		if o&IgnoreWidth != 0 {
+			switch t {
+			case tertiaryWide, tertiaryWideUpper, tertiarySmallNarrow, tertiaryNarrow:
+				t--
+			}
+		}
+		m[i] = t
+	}
+	return m
+}
+
+// The case of the text from which a collation element is derived.
+const (
+	caseNone uint8 = iota // no cased letters
+	caseLower
+	caseMixed
+	caseUpper
+)
+
+// tertiaryWeight returns the tertiary weight of ce used for comparison, where
+// cs is the case of the text from which ce was derived.  If case first is
+// selected, the case of the text takes precedence over the tertiary weights
+// of the table, which for tailored elements, such as Danish "aa" and "Aa",
+// do not necessarily reflect case.  Elements without a primary weight or
+// derived from text without cased letters are classified by their weight.
+func (c *Collator) tertiaryWeight(ce colltab.Elem, cs uint8) int {
+	t := ce.Tertiary()
+	if t == 0 {
+		return 0
+	}
+	if m := c.tertiary; m != nil {
+		t = m[t]
+	}
+	if c.caseFirst == 0 {
+		return int(t)
+	}
+	if ce.Primary() == 0 || cs == caseNone {
+		cs = caseLower
+		if isUpperTertiary(t) {
+			cs = caseUpper
+		}
+	}
+	rank := 1 // caseMixed
+	switch {
+	case cs == caseUpper && c.caseFirst == UpperFirst,
+		cs == caseLower && c.caseFirst == LowerFirst:
+		rank = 0
+	case cs != caseMixed:
+		rank = 2
+	}
+	return rank*(maxTertiary+1) + int(t)
+}
+
+// caseAt returns the case recorded in cs for the element at position k.
+func caseAt(cs []uint8, k int) uint8 {
+	if k < len(cs) {
+		return cs[k]
+	}
+	return caseNone
+}
+
+// Clone returns a copy of c with the same settings.  The copy does not share
+// any mutable state with c and can be used concurrently with c.  Cloning
+// is cheap compared to creating a new Collator, as the tables are shared.
+func (c *Collator) Clone() *Collator {
+	nc := &Collator{}
+	*nc = *c
+	nc.sorter = sorter{}
+	nc.writeBuf = nil
+	nc._iter[0].init(nc)
+	nc._iter[1].init(nc)
+	return nc
 }
 
 func (c *Collator) iter(i int) *iter {
 	// TODO: evaluate performance for making the second iterator optional.
-	return &c._iter[i]
+	it := &c._iter[i]
+	it.t = c.weigher()
+	it.caseFirst = c.caseFirst != 0
+	return it
+}
+
+// weigher returns the Weigher to use for the current settings of c.
+func (c *Collator) weigher() colltab.Weigher {
+	if !c.Numeric {
+		return c.t
+	}
+	if c.numeric == nil {
+		c.numeric = colltab.NewNumericWeigher(c.t)
+	}
+	return c.numeric
 }
 
 // Supported returns the list of languages for which collating differs from its parent.
 func Supported() []language.Tag {
-	ids := strings.Split(availableLocales, ",")
+	ids := tables.localeIDs()
 	tags := make([]language.Tag, len(ids))
 	for i, s := range ids {
 		tags[i] = language.Make(s)
@@ -122,12 +319,111 @@ func Supported() []language.Tag {
 	return tags
 }
 
-var matcher = language.NewMatcher(Supported())
-
 // New returns a new Collator initialized for the given locale.
+// Settings specified in the Unicode extension of t, such as -u-ks-level2
+// or -u-kr-grek, are applied as described for SetFromTag.
+// The collation type may be selected with the -u-co- extension of t,
+// as in "de-u-co-phonebk".  Types for which no table is available, such as
+// the pinyin and stroke orderings for Chinese in the compiled-in tables, fall
+// back to the standard ordering; Info reports the type actually used.
 func New(t language.Tag) *Collator {
-	_, index, _ := matcher.Match(t)
-	return NewFromTable(colltab.Init(locales[index]))
+	w := typeTable(t)
+	if w == nil {
+		w = colltab.Init(tables.locales[tables.match(t)])
+	}
+	c := NewFromTable(w)
+	c.SetFromTag(t)
+	return c
+}
+
+// NewNatural returns a new Collator for the given locale that orders strings
+// as is customary for file names and other names shown in user interfaces.
+// Sequences of digits are ordered by their numeric value, so that "file2.txt"
+// sorts before "file10.txt", and differences in case are ignored.  Punctuation
+// and spaces are not ignored, so that, for example, "file.txt" sorts before
+// "file1.txt".  Strings that are equal according to these rules, such as
+// "File" and "file", are ordered by their code points, so that the order is
+// deterministic.  Settings specified in the Unicode extension of t are
+// overridden.
+func NewNatural(t language.Tag) *Collator {
+	c := New(t)
+	c.SetOptions(Numeric | IgnoreCase | Force)
+	c.Alternate = AltNonIgnorable
+	return c
+}
+
+// SetFromTag configures c with the collation settings specified by the
+// Unicode extension of t, as defined in LDML.  The supported keys are
+// ks (strength), ka (alternate handling), kb (backwards secondary), kc (case
+// level), kf (case first), kh (hiragana quaternary), kn (numeric),
+// kr (script reordering) and kv (maximum variable).  Keys that are absent leave the corresponding
+// settings unchanged, as do unsupported values.  New calls SetFromTag for
+// the tag it is passed.
+func (c *Collator) SetFromTag(t language.Tag) {
+	// The CLDR tailorings for Danish and Maltese sort upper case first.
+	if b, _, _ := t.Raw(); b.String() == "da" || b.String() == "mt" {
+		c.caseFirst = UpperFirst
+	}
+	switch t.TypeForKey("ks") {
+	case "level1":
+		c.Strength = colltab.Primary
+	case "level2":
+		c.Strength = colltab.Secondary
+	case "level3":
+		c.Strength = colltab.Tertiary
+	case "level4":
+		c.Strength = colltab.Quaternary
+	case "identic":
+		c.Strength = colltab.Identity
+	}
+	switch t.TypeForKey("ka") {
+	case "noignore":
+		c.Alternate = AltNonIgnorable
+	case "shifted":
+		c.Alternate = AltShifted
+	}
+	setBool := func(key string, b *bool) {
+		switch t.TypeForKey(key) {
+		case "true":
+			*b = true
+		case "false":
+			*b = false
+		}
+	}
+	setBool("kb", &c.Backwards)
+	setBool("kc", &c.CaseLevel)
+	setBool("kh", &c.HiraganaQuaternary)
+	setBool("kn", &c.Numeric)
+	switch t.TypeForKey("kf") {
+	case "upper":
+		c.caseFirst = UpperFirst
+	case "lower":
+		c.caseFirst = LowerFirst
+	case "false":
+		c.caseFirst = 0
+	}
+	if kr := t.TypeForKey("kr"); kr != "" {
+		c.SetReorder(strings.Split(kr, "-")...)
+	}
+	if m, ok := maxVariables[t.TypeForKey("kv")]; ok {
+		c.SetMaxVariable(m)
+	}
+}
+
+// maxVariables maps the values of the kv key and the maxVariable setting
+// to the corresponding MaxVariable.
+var maxVariables = map[string]MaxVariable{
+	"space":    MaxVariableSpace,
+	"punct":    MaxVariablePunct,
+	"symbol":   MaxVariableSymbol,
+	"currency": MaxVariableCurrency,
+}
+
+// Table returns the collation table used by c, without the modifications
+// for settings such as Numeric.  It may be used to create a Collator with
+// a modified table, for example using colltab.NewFilterWeigher.
+func (c *Collator) Table() colltab.Weigher {
+	return c.t
 }
 
 func NewFromTable(t colltab.Weigher) *Collator {
@@ -142,19 +438,51 @@ func NewFromTable(t colltab.Weigher) *Collator {
 	return c
 }
 
-// Buffer holds keys generated by Key and KeyString.
+const (
+	// defaultBufferSize is the initial size of a Buffer if no size is given.
+	defaultBufferSize = 4096
+
+	// minKeySpace is the minimum number of bytes that should be available
+	// in a Buffer before generating a key without allocating a new block.
+	minKeySpace = 256
+)
+
+// Buffer holds keys generated by Key and KeyFromString.  The zero value is
+// ready to use.  Keys are allocated from blocks of memory that grow as
+// needed.  When a block is full, a new and larger block is allocated; keys
+// in earlier blocks are not copied and remain valid.
+//
+// A Buffer may be reused after calling Reset, which retains the most recently
+// allocated block.  In particular, Buffers may be kept in a sync.Pool, as
+// long as none of the keys they hold are used after the Buffer is returned
+// to the pool.
 type Buffer struct {
-	buf [4096]byte
 	key []byte
 }
 
+// NewBuffer returns a new Buffer with room for about sizeHint bytes of keys
+// before it needs to allocate more memory.
+func NewBuffer(sizeHint int) *Buffer {
+	if sizeHint < minKeySpace {
+		sizeHint = minKeySpace
+	}
+	return &Buffer{key: make([]byte, 0, sizeHint)}
+}
+
+// init prepares b for adding a new key.  It starts a new block if little
+// space is left in the current one, so that growing the current block as
+// the key is appended does not copy earlier keys.
 func (b *Buffer) init() {
 	if b.key == nil {
-		b.key = b.buf[:0]
+		b.key = make([]byte, 0, defaultBufferSize)
+	} else if n := len(b.key); n > 0 && cap(b.key)-n < minKeySpace {
+		b.key = make([]byte, 0, 2*cap(b.key))
 	}
 }
 
-// Reset clears the buffer from previous results generated by Key and KeyString.
+// Reset clears the buffer from previous results generated by Key and
+// KeyFromString.  Keys returned before the call to Reset become invalid,
+// as their memory will be reused.
 func (b *Buffer) Reset() {
 	b.key = b.key[:0]
 }
@@ -162,15 +490,14 @@ func (b *Buffer) Reset() {
 // Compare returns an integer comparing the two byte slices.
 // The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
 func (c *Collator) Compare(a, b []byte) int {
-	// TODO: skip identical prefixes once we have a fast way to detect if a rune is
-	// part of a contraction. This would lead to roughly a 10% speedup for the colcmp regtest.
 	c.iter(0).setInput(a)
 	c.iter(1).setInput(b)
+	c.skipPrefix()
 	if res := c.compare(); res != 0 {
 		return res
 	}
-	if colltab.Identity == c.Strength {
-		return bytes.Compare(a, b)
+	if c.identity() {
+		return c.compareIdentity(a, b)
 	}
 	return 0
 }
@@ -178,23 +505,149 @@ func (c *Collator) Compare(a, b []byte) int {
 // CompareString returns an integer comparing the two strings.
 // The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
 func (c *Collator) CompareString(a, b string) int {
-	// TODO: skip identical prefixes once we have a fast way to detect if a rune is
-	// part of a contraction. This would lead to roughly a 10% speedup for the colcmp regtest.
 	c.iter(0).setInputString(a)
 	c.iter(1).setInputString(b)
+	c.skipPrefix()
 	if res := c.compare(); res != 0 {
 		return res
 	}
-	if colltab.Identity == c.Strength {
-		if a < b {
-			return -1
-		} else if a > b {
-			return 1
+	if c.identity() {
+		return c.compareIdentityString(a, b)
+	}
+	return 0
+}
+
+// Equal reports whether a and b are equal at the configured strength.
+// It is equivalent to, but often faster than, c.Compare(a, b) == 0, as it
+// does not need to determine the order of a and b.
+func (c *Collator) Equal(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	if c.force {
+		return false
+	}
+	c.iter(0).setInput(a)
+	c.iter(1).setInput(b)
+	c.skipPrefix()
+	return c.compare() == 0 && (!c.identity() || c.compareIdentity(a, b) == 0)
+}
+
+// EqualString reports whether a and b are equal at the configured strength.
+// It is equivalent to, but often faster than, c.CompareString(a, b) == 0.
+func (c *Collator) EqualString(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if c.force {
+		return false
+	}
+	c.iter(0).setInputString(a)
+	c.iter(1).setInputString(b)
+	c.skipPrefix()
+	return c.compare() == 0 && (!c.identity() || c.compareIdentityString(a, b) == 0)
+}
+
+// skipPrefix removes the longest common prefix of the inputs of the two
+// iterators that does not affect the result of compare.  The prefix must end
+// before a starter that cannot continue a contraction, so that the collation
+// elements of the remaining inputs are the same as for the entire inputs.
+func (c *Collator) skipPrefix() {
+	// The weights of elements depend on preceding elements for alternate
+	// handling, and backwards secondaries are compared from the end.
+	if c.Alternate != AltNonIgnorable || c.Backwards {
+		return
+	}
+	ci, ok := c.weigher().(colltab.ContractionInfo)
+	if !ok {
+		return
+	}
+	a, b := c.iter(0), c.iter(1)
+	n := 0
+	for m := a.len(); n < m && n < b.len() && a.byteAt(n) == b.byteAt(n); n++ {
+	}
+	for ; n > 0; n = a.prevRuneStart(n) {
+		if canSplit(ci, a, n) && canSplit(ci, b, n) {
+			a.tail(n)
+			b.tail(n)
+			return
 		}
 	}
+}
+
+// canSplit reports whether the collation elements for the input of i before
+// and after position p can be computed independently.
+func canSplit(ci colltab.ContractionInfo, i *iter, p int) bool {
+	if p == i.len() {
+		return true
+	}
+	if !utf8.RuneStart(i.byteAt(p)) {
+		return false
+	}
+	var r rune
+	var prop norm.Properties
+	if i.bytes == nil {
+		r, _ = utf8.DecodeRuneInString(i.str[p:])
+		prop = norm.NFD.PropertiesString(i.str[p:])
+	} else {
+		r, _ = utf8.DecodeRune(i.bytes[p:])
+		prop = norm.NFD.Properties(i.bytes[p:])
+	}
+	return prop.BoundaryBefore() && !ci.MayContinueContraction(r)
+}
+
+// identity reports whether strings that are equal at all other levels
+// should be compared at the identical level.
+func (c *Collator) identity() bool {
+	return c.Strength == colltab.Identity || c.force
+}
+
+// compareIdentity compares a and b at the identical level.  As specified by
+// UCA, this compares the code points of the NFD forms of a and b.  If Force
+// is set, texts with the same NFD form are ordered by their bytes, so that
+// only identical texts compare as equal.
+func (c *Collator) compareIdentity(a, b []byte) int {
+	na, nb := norm.NFD.Bytes(a), norm.NFD.Bytes(b)
+	if c.force && bytes.Equal(na, nb) {
+		na, nb = a, b
+	}
+	return bytes.Compare(na, nb)
+}
+
+// compareIdentityString is like compareIdentity, but for strings.
+func (c *Collator) compareIdentityString(a, b string) int {
+	na, nb := norm.NFD.String(a), norm.NFD.String(b)
+	if c.force && na == nb {
+		na, nb = a, b
+	}
+	switch {
+	case na < nb:
+		return -1
+	case na > nb:
+		return 1
+	}
 	return 0
 }
 
+// appendIdentity appends the identical level of the key for str to key.
+// See compareIdentity.
+func (c *Collator) appendIdentity(key, str []byte) []byte {
+	key = norm.NFD.Append(append(key, 0), str...)
+	if c.force {
+		key = append(append(key, 0), str...)
+	}
+	return key
+}
+
+// appendIdentityString is like appendIdentity, but for strings.
+func (c *Collator) appendIdentityString(key []byte, str string) []byte {
+	key = norm.NFD.AppendString(append(key, 0), str)
+	if c.force {
+		key = append(append(key, 0), str...)
+	}
+	return key
+}
+
 func compareLevel(f func(i *iter) int, a, b *iter) int {
 	a.pce = 0
 	b.pce = 0
@@ -215,15 +668,24 @@ func compareLevel(f func(i *iter) int, a, b *iter) int {
 
 func (c *Collator) compare() int {
 	ia, ib := c.iter(0), c.iter(1)
+	if c.Alternate != AltNonIgnorable {
+		// The weights of an element depend on the elements preceding it,
+		// so we need to process all elements before comparing.
+		ia.nextAll()
+		ib.nextAll()
+		processWeights(c.Alternate, c.variableTop, ia.ce)
+		processWeights(c.Alternate, c.variableTop, ib.ce)
+	}
 	// Process primary level
-	if c.Alternate != AltShifted {
-		// TODO: implement script reordering
-		// TODO: special hiragana handling
-		if res := compareLevel((*iter).nextPrimary, ia, ib); res != 0 {
-			return res
+	// TODO: special hiragana handling
+	f := (*iter).nextPrimary
+	if c.reorder != nil {
+		f = func(i *iter) int {
+			return c.primary(i.nextPrimary())
 		}
-	} else {
-		// TODO: handle shifted
+	}
+	if res := compareLevel(f, ia, ib); res != 0 {
+		return res
 	}
 	if colltab.Secondary <= c.Strength {
 		f := (*iter).nextSecondary
@@ -234,13 +696,29 @@ func (c *Collator) compare() int {
 			return res
 		}
 	}
-	// TODO: special case handling (Danish?)
 	if colltab.Tertiary <= c.Strength || c.CaseLevel {
-		if res := compareLevel((*iter).nextTertiary, ia, ib); res != 0 {
+		f := (*iter).nextTertiary
+		if c.ignoreDiacritics {
+			f = (*iter).nextBaseTertiary
+		}
+		if c.tertiary != nil || c.caseFirst != 0 {
+			next := f
+			f = func(i *iter) int {
+				if next(i) == 0 {
+					return 0
+				}
+				k := i.pce - 1
+				return c.tertiaryWeight(i.ce[k], caseAt(i.cs, k))
+			}
+		}
+		if res := compareLevel(f, ia, ib); res != 0 {
 			return res
 		}
-		// TODO: Not needed for the default value of AltNonIgnorable?
 		if colltab.Quaternary <= c.Strength {
+			if c.Alternate == AltShiftTrimmed {
+				ia.trimQuaternary()
+				ib.trimQuaternary()
+			}
 			if res := compareLevel((*iter).nextQuaternary, ia, ib); res != 0 {
 				return res
 			}
@@ -250,45 +728,222 @@ func (c *Collator) compare() int {
 }
 
 // Key returns the collation key for str.
+// Comparing keys with bytes.Compare yields the same result as comparing the
+// original strings with Compare.
 // Passing the buffer buf may avoid memory allocations.
 // The returned slice will point to an allocation in Buffer and will remain
 // valid until the next call to buf.Reset().
 func (c *Collator) Key(buf *Buffer, str []byte) []byte {
 	// See http://www.unicode.org/reports/tr10/#Main_Algorithm for more details.
 	buf.init()
-	return c.key(buf, c.getColElems(str))
+	kn := len(buf.key)
+	c.key(buf, c.getColElems(str), c._iter[0].cs)
+	if c.identity() {
+		buf.key = c.appendIdentity(buf.key, str)
+	}
+	return buf.key[kn:]
 }
 
 // KeyFromString returns the collation key for str.
 // Passing the buffer buf may avoid memory allocations.
-// The returned slice will point to an allocation in Buffer and will retain
-// valid until the next call to buf.ResetKeys().
+// The returned slice will point to an allocation in Buffer and will remain
+// valid until the next call to buf.Reset().
 func (c *Collator) KeyFromString(buf *Buffer, str string) []byte {
 	// See http://www.unicode.org/reports/tr10/#Main_Algorithm for more details.
 	buf.init()
-	return c.key(buf, c.getColElemsString(str))
+	kn := len(buf.key)
+	c.key(buf, c.getColElemsString(str), c._iter[0].cs)
+	if c.identity() {
+		buf.key = c.appendIdentityString(buf.key, str)
+	}
+	return buf.key[kn:]
+}
+
+// WriteKey writes the collation key for str to w.  It returns the number of
+// bytes written and any error encountered.  Unlike Key, WriteKey does not
+// retain the key, which makes it suitable for writing large numbers of keys,
+// for example to create sorted runs on disk.
+func (c *Collator) WriteKey(w io.Writer, str []byte) (n int, err error) {
+	if c.writeBuf == nil {
+		c.writeBuf = &Buffer{}
+	}
+	c.writeBuf.Reset()
+	return w.Write(c.Key(c.writeBuf, str))
+}
+
+// WriteKeyString is like WriteKey, but for strings.
+func (c *Collator) WriteKeyString(w io.Writer, str string) (n int, err error) {
+	if c.writeBuf == nil {
+		c.writeBuf = &Buffer{}
+	}
+	c.writeBuf.Reset()
+	return w.Write(c.KeyFromString(c.writeBuf, str))
+}
+
+// KeyN returns the collation key for str truncated to at most maxBytes bytes.
+// Keys are ordered level by level, so a truncated key retains as much of the
+// most significant levels as fits.  A prefix of a key is ordered consistently
+// with Compare: if Compare(a, b) < 0, then the truncated key of a compares
+// less than or equal to the truncated key of b using bytes.Compare.  Strings
+// with equal truncated keys need to be compared using Compare to determine
+// their order.
+// KeyN can be used to store keys in indexes of limited size.
+func (c *Collator) KeyN(buf *Buffer, str []byte, maxBytes int) []byte {
+	return buf.truncate(c.Key(buf, str), maxBytes)
+}
+
+// KeyNFromString is like KeyN, but for strings.
+func (c *Collator) KeyNFromString(buf *Buffer, str string, maxBytes int) []byte {
+	return buf.truncate(c.KeyFromString(buf, str), maxBytes)
 }
 
-func (c *Collator) key(buf *Buffer, w []colltab.Elem) []byte {
-	processWeights(c.Alternate, c.t.Top(), w)
+// KeyLevel returns the collation key for str that includes only the weights
+// of the levels up to and including level.  The case level is included only if
+// level is Tertiary or higher.  The returned key is a prefix of the key returned
+// by Key, so it can be used, for instance, to build a case- and
+// accent-insensitive index using a Primary key, while ordering the entries
+// within the index by their full key.
+func (c *Collator) KeyLevel(buf *Buffer, str []byte, level colltab.Level) []byte {
+	defer c.restoreLevel(c.limitLevel(level))
+	return c.Key(buf, str)
+}
+
+// KeyLevelFromString is like KeyLevel, but for strings.
+func (c *Collator) KeyLevelFromString(buf *Buffer, str string, level colltab.Level) []byte {
+	defer c.restoreLevel(c.limitLevel(level))
+	return c.KeyFromString(buf, str)
+}
+
+// RadixKey returns a key of exactly n bytes for str that is suitable for radix
+// sorting.  The key consists of the primary weights of str, each encoded in 3
+// bytes, padded with zeros or truncated to n bytes.  If n is a multiple of 3,
+// each chunk of 3 bytes thus corresponds to a single weight.  Like the keys
+// returned by KeyN, radix keys are ordered consistently with Compare: if
+// Compare(a, b) < 0, then the radix key of a compares less than or equal to
+// the radix key of b.  Strings with equal radix keys need to be compared using
+// Compare or their full keys to determine their order.
+func (c *Collator) RadixKey(buf *Buffer, str []byte, n int) []byte {
+	return c.radixKey(buf, c.getColElems(str), n)
+}
+
+// RadixKeyFromString is like RadixKey, but for strings.
+func (c *Collator) RadixKeyFromString(buf *Buffer, str string, n int) []byte {
+	return c.radixKey(buf, c.getColElemsString(str), n)
+}
+
+func (c *Collator) radixKey(buf *Buffer, ws []colltab.Elem, n int) []byte {
+	buf.init()
 	kn := len(buf.key)
-	c.keyFromElems(buf, w)
+	processWeights(c.Alternate, c.variableTop, ws)
+	for _, v := range ws {
+		if len(buf.key)-kn >= n {
+			break
+		}
+		if w := v.Primary(); w > 0 {
+			if c.reorder != nil {
+				w = c.primary(w)
+			}
+			buf.key = append(buf.key, uint8(w>>16), uint8(w>>8), uint8(w))
+		}
+	}
+	if len(buf.key)-kn > n {
+		buf.key = buf.key[:kn+n]
+	}
+	for len(buf.key)-kn < n {
+		buf.key = append(buf.key, 0)
+	}
 	return buf.key[kn:]
 }
 
+// levelSettings holds the settings of a Collator that are changed by
+// limitLevel.
+type levelSettings struct {
+	strength  colltab.Level
+	caseLevel bool
+	force     bool
+}
+
+// limitLevel changes the settings of c so that no levels above level are
+// considered.  It returns the previous settings.
+func (c *Collator) limitLevel(level colltab.Level) levelSettings {
+	old := levelSettings{c.Strength, c.CaseLevel, c.force}
+	if level < c.Strength {
+		c.Strength = level
+	}
+	if level < colltab.Tertiary {
+		c.CaseLevel = false
+	}
+	if level < colltab.Identity {
+		c.force = false
+	}
+	return old
+}
+
+func (c *Collator) restoreLevel(s levelSettings) {
+	c.Strength, c.CaseLevel, c.force = s.strength, s.caseLevel, s.force
+}
+
+// KeyDesc returns a key for str that sorts in the reverse order of the key
+// returned by Key: bytes.Compare(c.KeyDesc(buf, a), c.KeyDesc(buf, b)) equals
+// c.Compare(b, a).  It can be used to implement descending orderings in
+// systems that only support ascending bytewise comparison.
+// The key is derived from the key returned by Key by complementing all bytes,
+// escaping the resulting 0xFF bytes as 0xFF 0x00, and appending 0xFF 0xFF,
+// so that a key sorts before all keys of which it is a proper prefix.
+func (c *Collator) KeyDesc(buf *Buffer, str []byte) []byte {
+	return buf.descending(c.Key(buf, str))
+}
+
+// KeyDescFromString is like KeyDesc, but for strings.
+func (c *Collator) KeyDescFromString(buf *Buffer, str string) []byte {
+	return buf.descending(c.KeyFromString(buf, str))
+}
+
+// descending replaces key, the last key added to b, with its descending
+// variant.
+func (b *Buffer) descending(key []byte) []byte {
+	start := len(b.key) - len(key)
+	for _, c := range key {
+		if c == 0 {
+			b.key = append(b.key, 0xFF, 0)
+		} else {
+			b.key = append(b.key, ^c)
+		}
+	}
+	b.key = append(b.key, 0xFF, 0xFF)
+	b.key = append(b.key[:start], b.key[start+len(key):]...)
+	return b.key[start:]
+}
+
+// truncate truncates key, the last key added to b, to at most n bytes and
+// releases the space taken by the remainder.
+func (b *Buffer) truncate(key []byte, n int) []byte {
+	if n < 0 {
+		n = 0
+	}
+	if len(key) > n {
+		b.key = b.key[:len(b.key)-len(key)+n]
+		key = key[:n]
+	}
+	return key
+}
+
+func (c *Collator) key(buf *Buffer, w []colltab.Elem, cs []uint8) {
+	processWeights(c.Alternate, c.variableTop, w)
+	c.keyFromElems(buf, w, cs)
+}
+
 func (c *Collator) getColElems(str []byte) []colltab.Elem {
 	i := c.iter(0)
 	i.setInput(str)
-	for i.next() {
-	}
+	i.nextAll()
 	return i.ce
 }
 
 func (c *Collator) getColElemsString(str string) []colltab.Elem {
 	i := c.iter(0)
 	i.setInputString(str)
-	for i.next() {
-	}
+	i.nextAll()
 	return i.ce
 }
 
@@ -304,6 +959,11 @@ type iter struct {
 	prevCCC  uint8
 	pStarter int
 
+	// If caseFirst is set, cs records for each element in ce the case of
+	// the input from which it was derived.
+	caseFirst bool
+	cs        []uint8
+
 	t colltab.Weigher
 }
 
@@ -314,6 +974,7 @@ func (i *iter) init(c *Collator) {
 
 func (i *iter) reset() {
 	i.ce = i.ce[:0]
+	i.cs = i.cs[:0]
 	i.nce = 0
 	i.prevCCC = 0
 	i.pStarter = 0
@@ -333,6 +994,27 @@ func (i *iter) setInputString(s string) *iter {
 	return i
 }
 
+func (i *iter) len() int {
+	if i.bytes == nil {
+		return len(i.str)
+	}
+	return len(i.bytes)
+}
+
+func (i *iter) byteAt(p int) byte {
+	if i.bytes == nil {
+		return i.str[p]
+	}
+	return i.bytes[p]
+}
+
+// prevRuneStart returns the start of the rune preceding position p.
+func (i *iter) prevRuneStart(p int) int {
+	for p--; p > 0 && !utf8.RuneStart(i.byteAt(p)); p-- {
+	}
+	return p
+}
+
 func (i *iter) done() bool {
 	return len(i.str) == 0 && len(i.bytes) == 0
 }
@@ -347,14 +1029,51 @@ func (i *iter) tail(n int) {
 
 func (i *iter) appendNext() int {
 	var sz int
+	n := len(i.ce)
 	if i.bytes == nil {
 		i.ce, sz = i.t.AppendNextString(i.ce, i.str)
 	} else {
 		i.ce, sz = i.t.AppendNext(i.ce, i.bytes)
 	}
+	if i.caseFirst {
+		cs := i.caseOf(sz)
+		for ; n < len(i.ce); n++ {
+			i.cs = append(i.cs, cs)
+		}
+	}
 	return sz
 }
 
+// caseOf returns the case of the next n bytes of the input.
+func (i *iter) caseOf(n int) uint8 {
+	cs := caseNone
+	for p := 0; p < n; {
+		var r rune
+		var sz int
+		if i.bytes == nil {
+			r, sz = utf8.DecodeRuneInString(i.str[p:])
+		} else {
+			r, sz = utf8.DecodeRune(i.bytes[p:])
+		}
+		p += sz
+		rc := caseMixed
+		switch {
+		case unicode.IsUpper(r):
+			rc = caseUpper
+		case unicode.IsLower(r):
+			rc = caseLower
+		case !unicode.IsTitle(r):
+			continue
+		}
+		if cs == caseNone {
+			cs = rc
+		} else if cs != rc {
+			cs = caseMixed
+		}
+	}
+	return cs
+}
+
 // next appends Elems to the internal array until it adds an element with CCC=0.
 // In the majority of cases, a Elem with a primary value > 0 will have
 // a CCC of 0. The CCC values of colation elements are also used to detect if the
@@ -392,6 +1111,12 @@ func (i *iter) next() bool {
 	return false
 }
 
+// nextAll appends the Elems for the remainder of the input.
+func (i *iter) nextAll() {
+	for i.next() {
+	}
+}
+
 // nextPlain is the same as next, but does not "normalize" the collation
 // elements.
 // TODO: remove this function. Using this instead of next does not seem
@@ -427,6 +1152,11 @@ func (i *iter) doNorm(p int, ccc uint8) {
 	i.ce = append(i.ce, i.ce[p:k]...)
 	copy(i.ce[p:], i.ce[k:])
 	i.ce = i.ce[:n]
+	if i.caseFirst {
+		i.cs = append(i.cs, i.cs[p:k]...)
+		copy(i.cs[p:], i.cs[k:])
+		i.cs = i.cs[:n]
+	}
 }
 
 func (i *iter) nextPrimary() int {
@@ -474,6 +1204,20 @@ func (i *iter) nextTertiary() int {
 	return 0
 }
 
+// nextBaseTertiary is like nextTertiary, but skips elements without
+// a primary weight.
+func (i *iter) nextBaseTertiary() int {
+	for ; i.pce < len(i.ce); i.pce++ {
+		if ce := i.ce[i.pce]; ce.Primary() != 0 {
+			if v := ce.Tertiary(); v != 0 {
+				i.pce++
+				return int(v)
+			}
+		}
+	}
+	return 0
+}
+
 func (i *iter) nextQuaternary() int {
 	for ; i.pce < len(i.ce); i.pce++ {
 		if v := i.ce[i.pce].Quaternary(); v != 0 {
@@ -484,6 +1228,21 @@ func (i *iter) nextQuaternary() int {
 	return 0
 }
 
+// trimQuaternary removes the trailing Elems that have the maximum
+// quaternary value, as is done for AltShiftTrimmed.
+func (i *iter) trimQuaternary() {
+	n := 0
+	for k, ce := range i.ce {
+		if q := ce.Quaternary(); q != 0 && q != colltab.MaxQuaternary {
+			n = k + 1
+		}
+	}
+	i.ce = i.ce[:n]
+	if len(i.cs) > n {
+		i.cs = i.cs[:n]
+	}
+}
+
 func appendPrimary(key []byte, p int) []byte {
 	// Convert to variable length encoding; supports up to 23 bits.
 	if p <= 0x7FFF {
@@ -495,10 +1254,14 @@ func appendPrimary(key []byte, p int) []byte {
 }
 
 // keyFromElems converts the weights ws to a compact sequence of bytes.
-// The result will be appended to the byte buffer in buf.
-func (c *Collator) keyFromElems(buf *Buffer, ws []colltab.Elem) {
+// The result will be appended to the byte buffer in buf.  cs holds the case
+// of the text for each element of ws, if it is tracked.
+func (c *Collator) keyFromElems(buf *Buffer, ws []colltab.Elem, cs []uint8) {
 	for _, v := range ws {
 		if w := v.Primary(); w > 0 {
+			if c.reorder != nil {
+				w = c.primary(w)
+			}
 			buf.key = appendPrimary(buf.key, w)
 		}
 	}
@@ -523,19 +1286,24 @@ func (c *Collator) keyFromElems(buf *Buffer, ws []colltab.Elem) {
 	}
 	if colltab.Tertiary <= c.Strength || c.CaseLevel {
 		buf.key = append(buf.key, 0, 0)
-		for _, v := range ws {
-			if w := v.Tertiary(); w > 0 {
+		for k, v := range ws {
+			if c.ignoreDiacritics && v.Primary() == 0 {
+				continue
+			}
+			if w := c.tertiaryWeight(v, caseAt(cs, k)); w > 0 {
 				buf.key = append(buf.key, uint8(w))
 			}
 		}
 		// Derive the quaternary weights from the options and other levels.
+		// Elements that are not shifted have the maximum quaternary weight.
 		// Note that we represent MaxQuaternary as 0xFF. The first byte of the
 		// representation of a primary weight is always smaller than 0xFF,
 		// so using this single byte value will compare correctly.
-		if colltab.Quaternary <= c.Strength && c.Alternate >= AltShifted {
+		if colltab.Quaternary <= c.Strength {
 			if c.Alternate == AltShiftTrimmed {
-				lastNonFFFF := len(buf.key)
+				// Keep the level separator, as further levels may follow.
 				buf.key = append(buf.key, 0)
+				lastNonFFFF := len(buf.key)
 				for _, v := range ws {
 					if w := v.Quaternary(); w == colltab.MaxQuaternary {
 						buf.key = append(buf.key, 0xFF)
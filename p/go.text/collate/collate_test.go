@@ -6,9 +6,12 @@ package collate
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 
 	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
 )
 
 type weightsTest struct {
@@ -310,7 +313,7 @@ func TestKeyFromElems(t *testing.T) {
 		buf.Reset()
 		in := convertFromWeights(tt.in)
 		processWeights(tt.opt.alt, uint32(tt.opt.top), in)
-		tt.opt.collator().keyFromElems(&buf, in)
+		tt.opt.collator().keyFromElems(&buf, in, nil)
 		res := buf.key
 		if len(res) != len(tt.out) {
 			t.Errorf("%d: len(ws) was %d; want %d (%X should be %X)", i, len(res), len(tt.out), res, tt.out)
@@ -408,6 +411,251 @@ func TestKey(t *testing.T) {
 	}
 }
 
+func TestKeyN(t *testing.T) {
+	c := New(language.English)
+	buf := Buffer{}
+	strs := []string{"a", "ab", "aB", "áb", "abc", "abd", "b", "B"}
+	for _, n := range []int{0, 1, 2, 3, 5, 8, 100} {
+		for _, a := range strs {
+			ka := c.KeyN(&buf, []byte(a), n)
+			if len(ka) > n {
+				t.Errorf("%d: len(KeyN(%q)) = %d; want <= %d", n, a, len(ka), n)
+			}
+			if k := c.KeyNFromString(&buf, a, n); !bytes.Equal(k, ka) {
+				t.Errorf("%d: KeyNFromString(%q) = %x; want %x", n, a, k, ka)
+			}
+			for _, b := range strs {
+				kb := c.KeyNFromString(&buf, b, n)
+				if c.CompareString(a, b) < 0 && bytes.Compare(ka, kb) > 0 {
+					t.Errorf("%d: key of %q (%x) > key of %q (%x)", n, a, ka, b, kb)
+				}
+			}
+		}
+	}
+	k := c.KeyN(&buf, []byte("abc"), 100)
+	if full := c.KeyFromString(&buf, "abc"); !bytes.Equal(k, full) {
+		t.Errorf("KeyN with large maxBytes = %x; want %x", k, full)
+	}
+}
+
+func TestKeyLevel(t *testing.T) {
+	c := New(language.English)
+	c.Strength = colltab.Identity
+	buf := Buffer{}
+	strs := []string{"a", "A", "á", "ａ", "ab"}
+	for _, a := range strs {
+		full := c.KeyFromString(&buf, a)
+		for l := colltab.Primary; l <= colltab.Identity; l++ {
+			k := c.KeyLevel(&buf, []byte(a), l)
+			if !bytes.HasPrefix(full, k) {
+				t.Errorf("%v: KeyLevel(%q) = %x; not a prefix of %x", l, a, k, full)
+			}
+			if ks := c.KeyLevelFromString(&buf, a, l); !bytes.Equal(k, ks) {
+				t.Errorf("%v: KeyLevelFromString(%q) = %x; want %x", l, a, ks, k)
+			}
+		}
+		if k := c.KeyLevel(&buf, []byte(a), colltab.Identity); !bytes.Equal(k, full) {
+			t.Errorf("KeyLevel(%q, Identity) = %x; want %x", a, k, full)
+		}
+	}
+	if c.Strength != colltab.Identity {
+		t.Errorf("Strength was changed to %v", c.Strength)
+	}
+	for _, a := range strs[:4] {
+		k := c.KeyLevelFromString(&buf, a, colltab.Primary)
+		if want := c.KeyLevelFromString(&buf, "a", colltab.Primary); !bytes.Equal(k, want) {
+			t.Errorf("primary key of %q = %x; want %x", a, k, want)
+		}
+	}
+	ka := c.KeyLevelFromString(&buf, "a", colltab.Secondary)
+	kA := c.KeyLevelFromString(&buf, "A", colltab.Secondary)
+	if kb := c.KeyLevelFromString(&buf, "á", colltab.Secondary); !bytes.Equal(ka, kA) || bytes.Equal(ka, kb) {
+		t.Errorf("secondary keys of a, A, á = %x, %x, %x; want first two equal", ka, kA, kb)
+	}
+}
+
+func TestKeyDesc(t *testing.T) {
+	strs := []string{"", "a", "A", "ab", "aB", "a b", "ab ", "a-b", "áb", "b", "\u00FF", "\uFFFF"}
+	for _, opt := range []struct {
+		alt      AlternateHandling
+		strength colltab.Level
+	}{
+		{AltNonIgnorable, colltab.Tertiary},
+		{AltShifted, colltab.Quaternary},
+		{AltShiftTrimmed, colltab.Identity},
+	} {
+		c := New(language.English)
+		c.Alternate, c.Strength = opt.alt, opt.strength
+		buf := Buffer{}
+		for _, a := range strs {
+			ka := c.KeyDescFromString(&buf, a)
+			if k := c.KeyDesc(&buf, []byte(a)); !bytes.Equal(k, ka) {
+				t.Errorf("%v: KeyDesc(%q) = %x; want %x", opt, a, k, ka)
+			}
+			for _, b := range strs {
+				kb := c.KeyDescFromString(&buf, b)
+				if res, want := bytes.Compare(ka, kb), c.CompareString(b, a); res != want {
+					t.Errorf("%v: Compare(KeyDesc(%q), KeyDesc(%q)) = %d; want %d", opt, a, b, res, want)
+				}
+			}
+		}
+	}
+}
+
+func TestRadixKey(t *testing.T) {
+	strs := []string{"", "a", "A", "ab", "aB", "áb", "a b", "a-b", "abc", "abd", "b", "B", "\u00FF", "\uFFFF", "\U0002A6D6", "\u4E00a"}
+	settings := []func(c *Collator){
+		func(c *Collator) {},
+		func(c *Collator) { c.Alternate = AltShifted },
+		func(c *Collator) { c.SetOptions(Numeric) },
+		func(c *Collator) { c.SetReorder("Grek", "Latn") },
+	}
+	for i, set := range settings {
+		c := New(language.English)
+		set(c)
+		buf := Buffer{}
+		for _, n := range []int{0, 1, 3, 5, 6, 12, 30} {
+			for _, a := range strs {
+				ka := c.RadixKey(&buf, []byte(a), n)
+				if len(ka) != n {
+					t.Errorf("%d:%d: len(RadixKey(%+q)) = %d; want %d", i, n, a, len(ka), n)
+				}
+				if k := c.RadixKeyFromString(&buf, a, n); !bytes.Equal(k, ka) {
+					t.Errorf("%d:%d: RadixKeyFromString(%+q) = %x; want %x", i, n, a, k, ka)
+				}
+				for _, b := range strs {
+					kb := c.RadixKeyFromString(&buf, b, n)
+					if c.CompareString(a, b) < 0 && bytes.Compare(ka, kb) > 0 {
+						t.Errorf("%d:%d: radix key of %+q (%x) > radix key of %+q (%x)", i, n, a, ka, b, kb)
+					}
+				}
+			}
+		}
+	}
+	c := New(language.English)
+	var buf Buffer
+	if k, want := c.RadixKeyFromString(&buf, "ab", 9), c.KeyLevelFromString(&buf, "ab", colltab.Primary); k[6] != 0 || !bytes.Equal(k[:6], radixPrimaries(want)) {
+		t.Errorf("RadixKey(ab) = %x; want primaries of %x followed by 0", k, want)
+	}
+}
+
+// radixPrimaries converts the primary weights of key, each encoded as 2 or 3
+// bytes, into fixed-width weights of 3 bytes.
+func radixPrimaries(key []byte) []byte {
+	var b []byte
+	for len(key) > 0 && key[0] != 0 {
+		if key[0]&0x80 != 0 {
+			b = append(b, key[0]&^0x80, key[1], key[2])
+			key = key[3:]
+		} else {
+			b = append(b, 0, key[0], key[1])
+			key = key[2:]
+		}
+	}
+	return b
+}
+
+func TestQuaternaryNonIgnorable(t *testing.T) {
+	strs := []string{"", "a", "A", "ab", "a b", "a-b", "\u00E1b", "a\u0301b", "b"}
+	c := New(language.English)
+	buf := Buffer{}
+	for _, s := range strs {
+		c.Strength = colltab.Tertiary
+		k3 := c.KeyFromString(&buf, s)
+		c.Strength = colltab.Quaternary
+		k4 := c.KeyFromString(&buf, s)
+		// The quaternary level holds the maximum weight for each element that
+		// has a tertiary weight.
+		want := append(append([]byte(nil), k3...), 0)
+		for _, w := range k3[bytes.LastIndex(k3, []byte{0, 0})+2:] {
+			if w != 0 {
+				want = append(want, 0xFF)
+			}
+		}
+		if !bytes.Equal(k4, want) {
+			t.Errorf("Key(%+q) = %x; want %x", s, k4, want)
+		}
+		for _, b := range strs {
+			c.Strength = colltab.Tertiary
+			want := c.CompareString(s, b)
+			c.Strength = colltab.Quaternary
+			if res := c.CompareString(s, b); res != want {
+				t.Errorf("Compare(%+q, %+q) = %d; want %d", s, b, res, want)
+			}
+		}
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(b []byte) (int, error) {
+	return 0, fmt.Errorf("write failed")
+}
+
+func TestWriteKey(t *testing.T) {
+	c := New(language.English)
+	c.Strength = colltab.Identity
+	var buf Buffer
+	var w bytes.Buffer
+	for _, s := range []string{"", "a", "ab", "Ab", "áb", strings.Repeat("long ", 2000)} {
+		want := c.KeyFromString(&buf, s)
+		w.Reset()
+		if n, err := c.WriteKey(&w, []byte(s)); err != nil || n != len(want) || !bytes.Equal(w.Bytes(), want) {
+			t.Errorf("WriteKey(%.10q) = %d, %v; wrote %x; want %x", s, n, err, w.Bytes(), want)
+		}
+		w.Reset()
+		if n, err := c.WriteKeyString(&w, s); err != nil || n != len(want) || !bytes.Equal(w.Bytes(), want) {
+			t.Errorf("WriteKeyString(%.10q) = %d, %v; wrote %x; want %x", s, n, err, w.Bytes(), want)
+		}
+	}
+	if _, err := c.WriteKeyString(errWriter{}, "a"); err == nil {
+		t.Errorf("WriteKeyString: expected error")
+	}
+}
+
+func TestBuffer(t *testing.T) {
+	c := New(language.English)
+	for _, buf := range []*Buffer{{}, NewBuffer(0), NewBuffer(100000)} {
+		var keys [][]byte
+		var want [][]byte
+		for i := 0; i < 2000; i++ {
+			s := strings.Repeat(fmt.Sprint(i), i%20)
+			keys = append(keys, c.KeyFromString(buf, s))
+			var b Buffer
+			want = append(want, c.KeyFromString(&b, s))
+		}
+		for i := range keys {
+			if !bytes.Equal(keys[i], want[i]) {
+				t.Fatalf("%d: key was overwritten: got %x; want %x", i, keys[i], want[i])
+			}
+		}
+		buf.Reset()
+		n := testing.AllocsPerRun(10, func() {
+			buf.Reset()
+			for i := 0; i < 20; i++ {
+				c.KeyFromString(buf, "abc")
+			}
+		})
+		if n > 0 {
+			t.Errorf("%v allocations after Reset; want 0", n)
+		}
+	}
+}
+
+func TestSortBuffer(t *testing.T) {
+	c := New(language.English)
+	x := []string{"b", "c", "a", "ab", "abc"}
+	c.SortStrings(x)
+	n := len(c.sorter.buf.key)
+	for i := 0; i < 10; i++ {
+		c.SortStrings(x)
+		c.SortStringsStable(x)
+	}
+	if m := len(c.sorter.buf.key); m != n {
+		t.Errorf("buffer holds %d bytes after repeated sorts; want %d", m, n)
+	}
+}
+
 type compareTest struct {
 	a, b string
 	res  int // comparison result
@@ -514,3 +762,561 @@ func TestDoNorm(t *testing.T) {
 		}
 	}
 }
+
+func TestSetOptions(t *testing.T) {
+	tests := []struct {
+		opt  Option
+		a, b string
+		res  int
+	}{
+		{0, "a", "A", -1},
+		{0, "a", "á", -1},
+		{0, "a", "ａ", -1},
+		{IgnoreCase, "a", "A", 0},
+		{IgnoreCase, "a", "á", -1},
+		{IgnoreCase, "a", "ａ", -1},
+		{IgnoreDiacritics, "a", "á", 0},
+		{IgnoreDiacritics, "a", "A", -1},
+		{IgnoreDiacritics, "A", "á", 1},
+		{IgnoreWidth, "a", "ａ", 0},
+		{IgnoreWidth, "a", "Ａ", -1},
+		{IgnoreWidth | IgnoreCase, "a", "Ａ", 0},
+		{IgnoreWidth | IgnoreCase, "a", "á", -1},
+		{Loose, "a", "Ａ", 0},
+		{Loose, "a", "á", 0},
+		{Loose, "a", "b", -1},
+		{UpperFirst, "a", "A", 1},
+		{UpperFirst, "ab", "Ab", 1},
+		{UpperFirst, "a", "Ａ", 1},
+		{UpperFirst, "a", "b", -1},
+		{LowerFirst, "a", "A", -1},
+		{LowerFirst | IgnoreCase, "a", "A", 0},
+		{Force, "a", "a", 0},
+		{Force, "á", "á", 1},
+		{Force | Loose, "a", "A", 1},
+		{Loose, "á", "á", 0},
+	}
+	c := New(language.English)
+	var buf Buffer
+	for i, tt := range tests {
+		c.SetOptions(tt.opt)
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d: CompareString(%q, %q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		if res := c.Compare([]byte(tt.a), []byte(tt.b)); res != tt.res {
+			t.Errorf("%d: Compare(%q, %q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		buf.Reset()
+		ka := c.KeyFromString(&buf, tt.a)
+		kb := c.Key(&buf, []byte(tt.b))
+		if res := bytes.Compare(ka, kb); res != tt.res {
+			t.Errorf("%d: key(%q) vs key(%q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+	}
+}
+
+func TestForce(t *testing.T) {
+	tests := []struct {
+		opt  Option
+		a, b string
+		res  int
+	}{
+		{Force, "e\u0301", "e\u0301", 0},
+		// Canonically equivalent texts are ordered by their bytes.
+		{Force, "e\u0301", "\u00E9", -1},
+		{Force, "a\u0316\u0301", "a\u0301\u0316", 1},
+		// Otherwise, texts are ordered by their NFD forms.
+		{Force, "a\u0001", "a", 1},
+		{Force, "\u00E9\u0001", "e\u0301", 1},
+		{Force | Loose, "A", "a", -1},
+		{Force | Loose, "\u00C5", "a\u030A", -1},
+		{0, "e\u0301", "\u00E9", 0},
+	}
+	c := New(language.English)
+	var buf Buffer
+	for i, tt := range tests {
+		c.SetOptions(tt.opt)
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d: CompareString(%+q, %+q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		if res := c.Compare([]byte(tt.a), []byte(tt.b)); res != tt.res {
+			t.Errorf("%d: Compare(%+q, %+q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		if eq := c.EqualString(tt.a, tt.b); eq != (tt.res == 0) {
+			t.Errorf("%d: EqualString(%+q, %+q) == %v; want %v", i, tt.a, tt.b, eq, !eq)
+		}
+		buf.Reset()
+		ka := c.KeyFromString(&buf, tt.a)
+		kb := c.Key(&buf, []byte(tt.b))
+		if res := bytes.Compare(ka, kb); res != tt.res {
+			t.Errorf("%d: key(%+q) vs key(%+q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+	}
+	// At the identical level, canonically equivalent texts are equal.
+	c.SetOptions(0)
+	c.Strength = colltab.Identity
+	for _, tt := range []struct {
+		a, b string
+		res  int
+	}{
+		{"e\u0301", "\u00E9", 0},
+		{"a\u0316\u0301", "a\u0301\u0316", 0},
+		{"a\u0001", "a", 1},
+	} {
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("Identity: CompareString(%+q, %+q) == %d; want %d", tt.a, tt.b, res, tt.res)
+		}
+		if eq := c.Equal([]byte(tt.a), []byte(tt.b)); eq != (tt.res == 0) {
+			t.Errorf("Identity: Equal(%+q, %+q) == %v; want %v", tt.a, tt.b, eq, !eq)
+		}
+		buf.Reset()
+		if res := bytes.Compare(c.KeyFromString(&buf, tt.a), c.KeyFromString(&buf, tt.b)); res != tt.res {
+			t.Errorf("Identity: key(%+q) vs key(%+q) == %d; want %d", tt.a, tt.b, res, tt.res)
+		}
+	}
+}
+
+func TestNumeric(t *testing.T) {
+	tests := []struct {
+		a, b string
+		res  int
+	}{
+		{"A-21", "A-123", -1},
+		{"file2.txt", "file10.txt", -1},
+		{"2", "12", -1},
+		{"12", "12", 0},
+		{"012", "12", 1},
+		{"0", "00", -1},
+		{"99", "100", -1},
+		{"1234567890", "999999999", 1},
+		{"٢", "10", -1}, // ARABIC-INDIC DIGIT TWO
+		{"١٠", "9", 1},
+		{"a1", "a", 1},
+		{"1", "a", -1},
+	}
+	c := New(language.English)
+	c.Numeric = true
+	var buf Buffer
+	for i, tt := range tests {
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d: CompareString(%q, %q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		buf.Reset()
+		ka := c.KeyFromString(&buf, tt.a)
+		kb := c.Key(&buf, []byte(tt.b))
+		if res := bytes.Compare(ka, kb); res != tt.res {
+			t.Errorf("%d: key(%q) vs key(%q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+	}
+}
+
+func TestCompareAlternate(t *testing.T) {
+	tests := []struct {
+		alt  AlternateHandling
+		a, b string
+		res  int
+	}{
+		{AltNonIgnorable, "a-b", "ab", -1},
+		{AltNonIgnorable, "a b", "a-b", -1},
+		{AltBlanked, "a-b", "ab", 0},
+		{AltBlanked, "a b", "a-b", 0},
+		{AltBlanked, "a-́b", "ab", 0},
+		{AltShifted, "a-b", "ab", -1},
+		{AltShifted, "a-b", "a-c", -1},
+		{AltShifted, "a b", "a-b", -1},
+		{AltShifted, "a", "a ", -1},
+		{AltShifted, "a-", "a", 1},
+		{AltShiftTrimmed, "a-b", "ab", 1},
+		{AltShiftTrimmed, "a b", "a-b", -1},
+		{AltShiftTrimmed, "a", "a ", -1},
+		{AltShiftTrimmed, "ab", "ab", 0},
+	}
+	c := New(language.English)
+	c.Strength = colltab.Quaternary
+	var buf Buffer
+	for i, tt := range tests {
+		c.Alternate = tt.alt
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d: CompareString(%q, %q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		if res := c.Compare([]byte(tt.a), []byte(tt.b)); res != tt.res {
+			t.Errorf("%d: Compare(%q, %q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		buf.Reset()
+		ka := c.KeyFromString(&buf, tt.a)
+		kb := c.KeyFromString(&buf, tt.b)
+		if res := bytes.Compare(ka, kb); res != tt.res {
+			t.Errorf("%d: key(%q) vs key(%q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+	}
+}
+
+func TestKeyCompareIdentity(t *testing.T) {
+	strs := []string{
+		"", "a", "ä", " ä", "ä ", "a b", "a-b", "ab", "a\u0308", "-", " ", "a ",
+	}
+	c := New(language.English)
+	c.Strength = colltab.Identity
+	var buf Buffer
+	for _, alt := range []AlternateHandling{AltNonIgnorable, AltBlanked, AltShifted, AltShiftTrimmed} {
+		c.Alternate = alt
+		for _, a := range strs {
+			for _, b := range strs {
+				want := c.CompareString(a, b)
+				buf.Reset()
+				ka := c.KeyFromString(&buf, a)
+				kb := c.KeyFromString(&buf, b)
+				if res := bytes.Compare(ka, kb); res != want {
+					t.Errorf("%d: key(%q) vs key(%q) == %d; want %d", alt, a, b, res, want)
+				}
+			}
+		}
+	}
+}
+
+func TestReorder(t *testing.T) {
+	tests := []struct {
+		tag     string
+		reorder []string
+		a, b    string
+		res     int
+	}{
+		{"en", nil, "a", "α", -1},
+		{"en", nil, "α", "б", -1},
+		{"en", []string{"Grek"}, "a", "α", 1},
+		{"en", []string{"Grek"}, "α", "б", -1},
+		{"en", []string{"Grek"}, "б", "a", 1},
+		{"en", []string{"Cyrl", "Grek"}, "б", "α", -1},
+		{"en", []string{"Cyrl", "Grek"}, "α", "a", -1},
+		{"en", []string{"Cyrl", "Grek"}, "a", "ä", -1},
+		{"en", []string{"Cyrl"}, "б", "a", -1},
+		{"en", []string{"Cyrl"}, "α", "a", 1},
+		{"en", []string{"Latn", "Grek"}, "α", "б", -1},
+		{"en", []string{"Hani"}, "中", "a", -1},
+		{"en", []string{"Hani"}, "1", "中", -1},
+		{"en", []string{"Hrkt", "Latn"}, "カ", "a", -1},
+		{"en", []string{"Hrkt", "Latn"}, "か", "a", -1},
+		{"en", []string{"Grek"}, "!", "α", -1},
+		{"en-u-kr-grek-latn", nil, "a", "α", 1},
+		{"en-u-kr-grek", nil, "a", "b", -1},
+		{"en-u-kr-grek", []string{}, "a", "α", -1},
+	}
+	for i, tt := range tests {
+		c := New(language.Make(tt.tag))
+		if tt.reorder != nil {
+			if err := c.SetReorder(tt.reorder...); err != nil {
+				t.Errorf("%d: SetReorder(%v): unexpected error: %v", i, tt.reorder, err)
+			}
+		}
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d: CompareString(%q, %q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+		var buf Buffer
+		ka := c.KeyFromString(&buf, tt.a)
+		kb := c.KeyFromString(&buf, tt.b)
+		if res := bytes.Compare(ka, kb); res != tt.res {
+			t.Errorf("%d: key(%q) vs key(%q) == %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+	}
+	if err := New(language.English).SetReorder("Xxxx"); err == nil {
+		t.Errorf("SetReorder(Xxxx): expected error")
+	}
+}
+
+func TestClone(t *testing.T) {
+	c := New(language.English)
+	c.SetOptions(IgnoreCase)
+	strs := []string{"a", "B", "b", "á", "ab", "A"}
+	want := make([]int, 0, len(strs)*len(strs))
+	for _, a := range strs {
+		for _, b := range strs {
+			want = append(want, c.CompareString(a, b))
+		}
+	}
+	done := make(chan bool)
+	for g := 0; g < 4; g++ {
+		go func(c *Collator) {
+			for n := 0; n < 100; n++ {
+				i := 0
+				for _, a := range strs {
+					for _, b := range strs {
+						if res := c.CompareString(a, b); res != want[i] {
+							t.Errorf("CompareString(%q, %q) = %d; want %d", a, b, res, want[i])
+						}
+						i++
+					}
+				}
+			}
+			done <- true
+		}(c.Clone())
+	}
+	for g := 0; g < 4; g++ {
+		<-done
+	}
+	nc := c.Clone()
+	nc.Strength = colltab.Primary
+	if c.Strength == colltab.Primary {
+		t.Errorf("changing the settings of a clone affected the original")
+	}
+}
+
+func TestCaseFirstFromTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		a, b string
+		res  int
+	}{
+		{"en", "a", "A", -1},
+		{"en-u-kf-upper", "a", "A", 1},
+		{"en-u-kf-upper", "ab", "Ab", 1},
+		{"en-u-kf-upper", "a", "b", -1},
+		{"en-u-kf-lower", "a", "A", -1},
+		{"da", "a", "A", 1},
+		{"da-u-kf-lower", "a", "A", -1},
+		{"mt", "a", "A", 1},
+		{"mt-u-kf-false", "a", "A", -1},
+	}
+	var buf Buffer
+	for i, tt := range tests {
+		c := New(language.Make(tt.tag))
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d:%s: CompareString(%q, %q) == %d; want %d", i, tt.tag, tt.a, tt.b, res, tt.res)
+		}
+		buf.Reset()
+		ka := c.KeyFromString(&buf, tt.a)
+		kb := c.KeyFromString(&buf, tt.b)
+		if res := bytes.Compare(ka, kb); res != tt.res {
+			t.Errorf("%d:%s: key(%q) vs key(%q) == %d; want %d", i, tt.tag, tt.a, tt.b, res, tt.res)
+		}
+	}
+}
+
+func TestSetFromTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		a, b string
+		res  int
+	}{
+		{"en", "a", "A", -1},
+		{"en-u-ks-level1", "a", "Á", 0},
+		{"en-u-ks-level2", "a", "A", 0},
+		{"en-u-ks-level2", "a", "á", -1},
+		{"en-u-ks-level3", "a", "A", -1},
+		{"en-u-ks-identic", "a", "a\u0000", -1},
+		{"en-u-ka-shifted", "a b", "ab", 0},
+		{"en-u-ka-shifted-ks-level4", "a b", "ab", -1},
+		{"en-u-ka-noignore", "a b", "ab", -1},
+		{"fr-u-kb-true", "côte", "coté", -1},
+		{"fr-u-kb-false", "côte", "coté", 1},
+		{"en-u-kc-true-ks-level1", "a", "A", -1},
+		{"en-u-kn-true", "a10", "a9", 1},
+		{"en-u-kn-false", "a10", "a9", -1},
+		{"en-u-kf-upper", "a", "A", 1},
+		{"en-u-ks-invalid", "a", "A", -1},
+	}
+	for i, tt := range tests {
+		c := New(language.Make(tt.tag))
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d:%s: CompareString(%q, %q) == %d; want %d", i, tt.tag, tt.a, tt.b, res, tt.res)
+		}
+	}
+}
+
+func TestDanish(t *testing.T) {
+	// The tailored tertiary weights of Danish do not reflect case, so
+	// case first ordering is derived from the case of the text.
+	want := []string{"z", "Æ", "æ", "Ø", "ø", "Å", "AA", "Aa", "å", "aa"}
+	for _, tag := range []string{"da", "da-u-kf-upper"} {
+		c := New(language.Make(tag))
+		strs := []string{"aa", "Aa", "å", "AA", "Å", "ø", "Ø", "æ", "Æ", "z"}
+		c.SortStrings(strs)
+		if res, w := fmt.Sprint(strs), fmt.Sprint(want); res != w {
+			t.Errorf("%s: found %s; want %s", tag, res, w)
+		}
+		for i := 1; i < len(want); i++ {
+			a, b := want[i-1], want[i]
+			if res := c.CompareString(a, b); res != -1 {
+				t.Errorf("%s: CompareString(%q, %q) = %d; want -1", tag, a, b, res)
+			}
+			res, err := c.CompareReader(strings.NewReader(a), strings.NewReader(b))
+			if err != nil || res != -1 {
+				t.Errorf("%s: CompareReader(%q, %q) = %d, %v; want -1", tag, a, b, res, err)
+			}
+		}
+	}
+	c := New(language.Make("da-u-kf-lower"))
+	for _, tt := range [][2]string{{"å", "aa"}, {"aa", "Aa"}, {"Aa", "Å"}, {"Å", "AA"}} {
+		if res := c.CompareString(tt[0], tt[1]); res != -1 {
+			t.Errorf("da-u-kf-lower: CompareString(%q, %q) = %d; want -1", tt[0], tt[1], res)
+		}
+	}
+}
+
+func TestSkipPrefix(t *testing.T) {
+	pairs := [][2]string{
+		{"ch", "cz"},
+		{"abch", "abcz"},
+		{"abc", "abch"},
+		{"ab", "ab\u0301"},
+		{"ab\u0301", "ab\u0300"},
+		{"a\u0301b", "a\u0301c"},
+		{"a12", "a13"},
+		{"a12", "a112"},
+		{"a9", "a10"},
+		{"aaa", "aa"},
+		{"xaa", "xå"},
+		{"xaA", "xAa"},
+		{"같다", "같이"},
+		{"ab", "ab"},
+	}
+	for _, tag := range []string{"en", "cs", "da", "en-u-kn-true"} {
+		c := New(language.Make(tag))
+		var buf Buffer
+		for _, p := range pairs {
+			a, b := p[0], p[1]
+			buf.Reset()
+			want := bytes.Compare(c.KeyFromString(&buf, a), c.KeyFromString(&buf, b))
+			if res := c.CompareString(a, b); res != want {
+				t.Errorf("%s: CompareString(%q, %q) = %d; want %d", tag, a, b, res, want)
+			}
+			if res := c.Compare([]byte(b), []byte(a)); res != -want {
+				t.Errorf("%s: Compare(%q, %q) = %d; want %d", tag, b, a, res, -want)
+			}
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		tag  string
+		opt  Option
+		a, b string
+		eq   bool
+	}{
+		{"en", 0, "abc", "abc", true},
+		{"en", 0, "abc", "abd", false},
+		{"en", 0, "abc", "Abc", false},
+		{"en", IgnoreCase, "abc", "Abc", true},
+		{"en", IgnoreCase, "abc", "ábc", false},
+		{"en", IgnoreCase | IgnoreDiacritics, "abc", "ÁBC", true},
+		{"en", IgnoreCase | IgnoreDiacritics, "abc", "abd", false},
+		{"en", IgnoreWidth, "abc", "ａｂｃ", true},
+		{"en", 0, "á", "á", true},
+		{"en", Force, "á", "á", false},
+		{"cs", IgnoreCase, "xch", "xc", false},
+	}
+	for i, tt := range tests {
+		c := New(language.Make(tt.tag))
+		c.SetOptions(tt.opt)
+		if eq := c.EqualString(tt.a, tt.b); eq != tt.eq {
+			t.Errorf("%d: EqualString(%q, %q) = %v; want %v", i, tt.a, tt.b, eq, tt.eq)
+		}
+		if eq := c.Equal([]byte(tt.b), []byte(tt.a)); eq != tt.eq {
+			t.Errorf("%d: Equal(%q, %q) = %v; want %v", i, tt.b, tt.a, eq, tt.eq)
+		}
+		if eq := c.CompareString(tt.a, tt.b) == 0; eq != tt.eq {
+			t.Errorf("%d: CompareString(%q, %q) == 0 is %v; want %v", i, tt.a, tt.b, eq, tt.eq)
+		}
+	}
+}
+
+func TestTable(t *testing.T) {
+	c := New(language.English)
+	if c.CompareString("co-op", "coop") == 0 {
+		t.Fatalf("co-op and coop should differ")
+	}
+	w := colltab.NewFilterWeigher(c.Table(), func(r rune, ce []colltab.Elem) []colltab.Elem {
+		if r == '-' {
+			return []colltab.Elem{colltab.Ignore}
+		}
+		return ce
+	})
+	nc := NewFromTable(w)
+	if res := nc.CompareString("co-op", "coop"); res != 0 {
+		t.Errorf("CompareString(%q, %q) = %d; want 0", "co-op", "coop", res)
+	}
+	if res := nc.CompareString("co-op", "cop"); res != -1 {
+		t.Errorf("CompareString(%q, %q) = %d; want -1", "co-op", "cop", res)
+	}
+}
+
+func TestMaxVariable(t *testing.T) {
+	tests := []struct {
+		m    MaxVariable
+		a, b string
+		eq   bool
+	}{
+		{MaxVariableSpace, "a b", "ab", true},
+		{MaxVariableSpace, "a-b", "ab", false},
+		{MaxVariablePunct, "a-b", "ab", true},
+		{MaxVariablePunct, "a+b", "ab", false},
+		{MaxVariableSymbol, "a+b", "ab", true},
+		{MaxVariableSymbol, "a$b", "ab", false},
+		{MaxVariableCurrency, "a$b", "ab", true},
+		{MaxVariableCurrency, "a€ b", "ab", true},
+		{MaxVariableCurrency, "a1b", "ab", false},
+	}
+	c := New(language.English)
+	c.Alternate = AltBlanked
+	if top := maxVariableTop(c.t, MaxVariablePunct); top != c.t.Top() {
+		t.Errorf("top for MaxVariablePunct is %X; want %X", top, c.t.Top())
+	}
+	for i, tt := range tests {
+		c.SetMaxVariable(tt.m)
+		if eq := c.CompareString(tt.a, tt.b) == 0; eq != tt.eq {
+			t.Errorf("%d: CompareString(%q, %q) == 0 is %v; want %v", i, tt.a, tt.b, eq, tt.eq)
+		}
+	}
+	c = New(language.MustParse("en-u-ka-shifted-kv-currency"))
+	c.Strength = colltab.Tertiary
+	if res := c.CompareString("a$b", "ab"); res != 0 {
+		t.Errorf("kv-currency: CompareString(%q, %q) = %d; want 0", "a$b", "ab", res)
+	}
+	c, err := NewFromRules(language.English, "[alternate shifted][maxVariable space]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Strength = colltab.Tertiary
+	if res := c.CompareString("a-b", "ab"); res == 0 {
+		t.Errorf("maxVariable space: CompareString(%q, %q) = 0; want non-zero", "a-b", "ab")
+	}
+}
+
+func TestSetVariableTop(t *testing.T) {
+	c := New(language.English)
+	if top := c.VariableTop(); top != c.t.Top() {
+		t.Errorf("VariableTop() = %X; want %X", top, c.t.Top())
+	}
+	for _, s := range []string{"", "ab", "ǆ", "́", "ab́"} {
+		top := c.VariableTop()
+		if err := c.SetVariableTop(s); err == nil {
+			t.Errorf("SetVariableTop(%q): expected error", s)
+		}
+		if c.VariableTop() != top {
+			t.Errorf("SetVariableTop(%q) changed the variable top on error", s)
+		}
+	}
+	c.Alternate = AltBlanked
+	if err := c.SetVariableTop("$"); err != nil {
+		t.Fatal(err)
+	}
+	if c.VariableTop() != firstPrimary(c.t, '$') {
+		t.Errorf("VariableTop() = %X; want %X", c.VariableTop(), firstPrimary(c.t, '$'))
+	}
+	for _, tt := range []struct {
+		a, b string
+		eq   bool
+	}{
+		{"a$b", "ab", true},
+		{"a+b", "ab", true},
+		{"a€b", "ab", false},
+	} {
+		if eq := c.CompareString(tt.a, tt.b) == 0; eq != tt.eq {
+			t.Errorf("CompareString(%q, %q) == 0 is %v; want %v", tt.a, tt.b, eq, tt.eq)
+		}
+	}
+	c = New(language.Make("cs"))
+	if err := c.SetVariableTop("ch"); err != nil {
+		t.Errorf("SetVariableTop(%q) for contraction: %v", "ch", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxCheckErrors is the maximum number of violations reported by Check.
+const maxCheckErrors = 100
+
+// Check verifies that w satisfies the invariants on which the collation
+// algorithm relies and returns a list of the violations found, or nil if
+// there are none. The domain lists the single characters and contractions
+// for which w defines collation elements, typically the result of w.Domain
+// or the list of entries from which w was built. Check verifies that
+//   - each string of the domain is matched completely by a single call
+//     to AppendNext and AppendNextString, which return the same result;
+//   - the elements of each string of the domain either start with a canonical
+//     combining class of 0 or start and end with the same combining class,
+//     which allows the collator to reorder them as a single block;
+//   - the implicit weights assigned to runes that are not in the domain
+//     increase with the rune value within each group of implicit weights
+//     (common ideographs, rare ideographs and all other runes).
+// Check stops after a fixed number of violations has been found.
+func Check(w Weigher, domain []string) []error {
+	c := &checker{w: w, domain: make(map[string]bool)}
+	for _, s := range domain {
+		c.checkEntry(s)
+	}
+	c.checkImplicit()
+	return c.errs
+}
+
+type checker struct {
+	w      Weigher
+	errs   []error
+	domain map[string]bool
+}
+
+func (c *checker) errorf(format string, args ...interface{}) {
+	if len(c.errs) < maxCheckErrors {
+		c.errs = append(c.errs, fmt.Errorf("colltab: "+format, args...))
+	}
+}
+
+func (c *checker) checkEntry(s string) {
+	c.domain[s] = true
+	if s == "" || !utf8.ValidString(s) {
+		c.errorf("invalid entry %q", s)
+		return
+	}
+	elems, n := c.w.AppendNextString(nil, s)
+	if n != len(s) {
+		c.errorf("%q: matched %d of %d bytes", s, n, len(s))
+		return
+	}
+	if len(elems) == 0 {
+		c.errorf("%q: no collation elements", s)
+		return
+	}
+	if b, n := c.w.AppendNext(nil, []byte(s)); n != len(s) || !equalElems(b, elems) {
+		c.errorf("%q: AppendNext and AppendNextString differ: %X != %X", s, b, elems)
+	}
+	first, last := elems[0].CCC(), elems[len(elems)-1].CCC()
+	if first != 0 && first != last {
+		c.errorf("%q: elements start with combining class %d and end with %d", s, first, last)
+	}
+}
+
+// checkImplicit verifies the implicit weights of all runes that are not in
+// the domain.
+func (c *checker) checkImplicit() {
+	var last [3]int // last weight per group
+	var buf [utf8.UTFMax]byte
+	var elems []Elem
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if r == 0xD800 {
+			r = 0xE000 // skip surrogates
+		}
+		n := utf8.EncodeRune(buf[:], r)
+		if c.domain[string(buf[:n])] {
+			continue
+		}
+		elems, _ = c.w.AppendNext(elems[:0], buf[:n])
+		if len(elems) != 1 {
+			continue
+		}
+		var group int
+		switch p := elems[0].Primary(); {
+		case p < commonUnifiedOffset, p >= illegalOffset:
+			// Not an implicit weight or the weight for an illegal rune.
+			continue
+		case p < rareUnifiedOffset:
+			group = 0
+		case p < otherOffset:
+			group = 1
+		default:
+			group = 2
+		}
+		if p := elems[0].Primary(); p <= last[group] {
+			c.errorf("%U: implicit weight %X not larger than previous weight %X", r, p, last[group])
+		} else {
+			last[group] = p
+		}
+	}
+}
+
+func equalElems(a, b []Elem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
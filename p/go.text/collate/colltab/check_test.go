@@ -0,0 +1,115 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// mapWeigher is a Weigher for testing Check. Strings not in m get implicit
+// weights, using the weight of the rune in remap, if present.
+type mapWeigher struct {
+	m     map[string][]Elem
+	remap map[rune]rune
+	bytes map[string][]Elem // results of AppendNext that differ from m
+}
+
+func (w *mapWeigher) Start(p int, b []byte) int       { return p }
+func (w *mapWeigher) StartString(p int, s string) int { return p }
+func (w *mapWeigher) Domain() []string                { return nil }
+func (w *mapWeigher) Top() uint32                     { return 0 }
+func (w *mapWeigher) AppendNext(buf []Elem, s []byte) ([]Elem, int) {
+	if e, ok := w.bytes[string(s)]; ok {
+		return append(buf, e...), len(s)
+	}
+	return w.AppendNextString(buf, string(s))
+}
+
+func (w *mapWeigher) AppendNextString(buf []Elem, s string) ([]Elem, int) {
+	for n := len(s); n > 0; n-- {
+		if e, ok := w.m[s[:n]]; ok {
+			return append(buf, e...), n
+		}
+	}
+	r, sz := utf8.DecodeRuneInString(s)
+	if x, ok := w.remap[r]; ok {
+		r = x
+	}
+	return append(buf, makeImplicitCE(implicitPrimary(r))), sz
+}
+
+func mkElem(p, s, t int, ccc uint8) Elem {
+	e, err := MakeElem(p, s, t, ccc)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func TestCheck(t *testing.T) {
+	a := mkElem(100, defaultSecondary, defaultTertiary, 0)
+	b := mkElem(200, defaultSecondary, defaultTertiary, 0)
+	acute := mkElem(0, defaultSecondary+1, defaultTertiary, 230)
+	cedilla := mkElem(0, defaultSecondary+2, defaultTertiary, 202)
+	m := map[string][]Elem{
+		"a":      {a},
+		"b":      {b},
+		"ab":     {a, b},
+		"\u0301": {acute},
+		"\u0327": {cedilla},
+	}
+	tests := []struct {
+		desc   string
+		w      *mapWeigher
+		domain []string
+		errs   []string
+	}{{
+		"consistent",
+		&mapWeigher{m: m},
+		[]string{"a", "b", "ab", "\u0301", "\u0327"},
+		nil,
+	}, {
+		"incomplete contraction",
+		&mapWeigher{m: m},
+		[]string{"abc", ""},
+		[]string{`"abc": matched 2 of 3 bytes`, `invalid entry ""`},
+	}, {
+		"AppendNext differs",
+		&mapWeigher{m: m, bytes: map[string][]Elem{"b": {a}}},
+		[]string{"a", "b"},
+		[]string{`"b": AppendNext and AppendNextString differ`},
+	}, {
+		"combining class",
+		&mapWeigher{m: map[string][]Elem{
+			"\u0301\u0327": {acute, cedilla},
+			"\u0327\u0301": {cedilla, cedilla},
+			"a\u0301":      {a, acute},
+		}},
+		[]string{"\u0301\u0327", "\u0327\u0301", "a\u0301"},
+		[]string{`elements start with combining class 230 and end with 202`},
+	}, {
+		"implicit",
+		&mapWeigher{m: m, remap: map[rune]rune{0x4E01: 0x4E00, 0x3402: 0x3400}},
+		[]string{"a"},
+		[]string{
+			"U+3402: implicit weight 23400 not larger than previous weight 23401",
+			"U+4E01: implicit weight 14E00 not larger than previous weight 14E00",
+		},
+	}}
+	for _, tt := range tests {
+		errs := Check(tt.w, tt.domain)
+		if len(errs) != len(tt.errs) {
+			t.Errorf("%s: got %d errors %v; want %d", tt.desc, len(errs), errs, len(tt.errs))
+			continue
+		}
+		for i, err := range errs {
+			if !strings.Contains(err.Error(), tt.errs[i]) {
+				t.Errorf("%s: error %d was %q; want %q", tt.desc, i, err, tt.errs[i])
+			}
+		}
+	}
+}
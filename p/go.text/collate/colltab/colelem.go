@@ -32,6 +32,15 @@ const (
 	MaxQuaternary    = 0x1FFFFF // 21 bits.
 )
 
+// Weights that may be used with MakeElem to create collation elements for
+// characters without accents or case distinctions.  MaxTertiary is the
+// largest tertiary weight that can be combined with a primary weight.
+const (
+	DefaultSecondary = defaultSecondary
+	DefaultTertiary  = defaultTertiary
+	MaxTertiary      = maxTertiary
+)
+
 // Elem is a representation of a collation element. This API provides ways to encode
 // and decode Elems. Implementations of collation tables may use values greater
 // or equal to PrivateUse for their own purposes.  However, these should never be
@@ -176,6 +185,26 @@ func MakeElem(primary, secondary, tertiary int, ccc uint8) (Elem, error) {
 	return ce, nil
 }
 
+// IsExpansion reports whether ce is a reference to a sequence of collation
+// elements stored in a table rather than a collation element.
+// IsExpansion, IsContraction and IsDecomposition are meaningful only for the
+// values stored in tables.  Such values are never returned by AppendNext.
+func (ce Elem) IsExpansion() bool {
+	return ce.ctype() == ceExpansionIndex
+}
+
+// IsContraction reports whether ce is a reference to the contractions that
+// start with a character, stored in a table, rather than a collation element.
+func (ce Elem) IsContraction() bool {
+	return ce.ctype() == ceContractionIndex
+}
+
+// IsDecomposition reports whether ce indicates that the collation elements of
+// a character are to be derived from those of its NFKD decomposition.
+func (ce Elem) IsDecomposition() bool {
+	return ce.ctype() == ceDecompose
+}
+
 // MakeQuaternary returns an Elem with the given quaternary value.
 func MakeQuaternary(v int) Elem {
 	return ceTypeQ | Elem(v<<primaryShift)
@@ -363,7 +392,11 @@ func implicitPrimary(r rune) int {
 			// for all characters that do not decompose.
 			return int(r) + commonUnifiedOffset
 		}
-		return int(r) + rareUnifiedOffset
+		// Ideographs beyond the range of rare ideographs were added in
+		// later versions of Unicode and are treated as unassigned runes.
+		if r < otherOffset-rareUnifiedOffset {
+			return int(r) + rareUnifiedOffset
+		}
 	}
 	return int(r) + otherOffset
 }
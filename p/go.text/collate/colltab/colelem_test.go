@@ -104,6 +104,12 @@ func TestColElem(t *testing.T) {
 		if ce.ctype() != typ {
 			t.Errorf("%d: type is %d; want %d (ColElem: %X)", i, ce.ctype(), typ, ce)
 		}
+		if ce.IsContraction() != (typ == ceContractionIndex) ||
+			ce.IsExpansion() != (typ == ceExpansionIndex) ||
+			ce.IsDecomposition() != (typ == ceDecompose) {
+			t.Errorf("%d: IsContraction, IsExpansion, IsDecomposition = %v, %v, %v; want type %d (ColElem: %X)",
+				i, ce.IsContraction(), ce.IsExpansion(), ce.IsDecomposition(), typ, ce)
+		}
 		for j, a := range tt.arg {
 			if inout[j] != a {
 				t.Errorf("%d: argument %d is %X; want %X (ColElem: %X)", i, j, inout[j], a, ce)
@@ -132,6 +138,9 @@ var implicitTests = []implicitTest{
 	{0xFB00, 0x5FB00},
 	{0x20000, 0x40000},
 	{0x2B81C, 0x4B81C},
+	{0x2FA1D, 0x4FA1D}, // largest rune in rare
+	{0x30000, 0x80000}, // ideographs beyond rare are treated as unassigned
+	{0x3134A, 0x8134A},
 	{unicode.MaxRune, 0x15FFFF}, // maximum primary value
 }
 
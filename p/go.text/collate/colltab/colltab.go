@@ -29,3 +29,22 @@ type Weigher interface {
 	// Top returns the highest variable primary value.
 	Top() uint32
 }
+
+// ContractionInfo is implemented by Weighers that can report which runes
+// may be part of contractions.  It allows code that processes text
+// incrementally, such as for skipping identical prefixes, searching or
+// segmentation, to determine where the collation elements of text can be
+// computed independently of the text that follows.
+type ContractionInfo interface {
+	// MayStartContraction reports whether r may be the first rune of
+	// a contraction.
+	MayStartContraction(r rune) bool
+
+	// MayContinueContraction reports whether r may occur in a contraction
+	// at a position other than the first.
+	MayContinueContraction(r rune) bool
+
+	// MaxContractionLen returns the maximum number of bytes that may be
+	// consumed by a single call to AppendNext, or -1 if there is no limit.
+	MaxContractionLen() int
+}
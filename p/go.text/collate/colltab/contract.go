@@ -8,7 +8,7 @@ import "unicode/utf8"
 
 // For a description of contractTrieSet, see exp/locale/collate/build/contract.go.
 
-type contractTrieSet []struct{ l, h, n, i uint8 }
+type contractTrieSet []struct{ L, H, N, I uint8 }
 
 // ctScanner is used to match a trie to an input sequence.
 // A contraction may match a non-contiguous sequence of bytes in an input string.
@@ -70,17 +70,17 @@ func (s *ctScanner) scan(p int) int {
 		c := str[p]
 		// TODO: a significant number of contractions are of a form that
 		// cannot match discontiguous UTF-8 in a normalized string. We could let
-		// a negative value of e.n mean that we can set s.done = true and avoid
+		// a negative value of e.N mean that we can set s.done = true and avoid
 		// the need for additional matches.
-		if c >= e.l {
-			if e.l == c {
+		if c >= e.L {
+			if e.L == c {
 				p++
-				if e.i != noIndex {
-					s.index = int(e.i)
+				if e.I != noIndex {
+					s.index = int(e.I)
 					s.pindex = p
 				}
-				if e.n != final {
-					i, states, n = 0, states[int(e.h)+n:], int(e.n)
+				if e.N != final {
+					i, states, n = 0, states[int(e.H)+n:], int(e.N)
 					if p >= len(str) || utf8.RuneStart(str[p]) {
 						s.states, s.n, pr = states, n, p
 					}
@@ -89,10 +89,10 @@ func (s *ctScanner) scan(p int) int {
 					return p
 				}
 				continue
-			} else if e.n == final && c <= e.h {
+			} else if e.N == final && c <= e.H {
 				p++
 				s.done = true
-				s.index = int(c-e.l) + int(e.i)
+				s.index = int(c-e.L) + int(e.I)
 				s.pindex = p
 				return p
 			}
@@ -112,17 +112,17 @@ func (s *ctScannerString) scan(p int) int {
 		c := str[p]
 		// TODO: a significant number of contractions are of a form that
 		// cannot match discontiguous UTF-8 in a normalized string. We could let
-		// a negative value of e.n mean that we can set s.done = true and avoid
+		// a negative value of e.N mean that we can set s.done = true and avoid
 		// the need for additional matches.
-		if c >= e.l {
-			if e.l == c {
+		if c >= e.L {
+			if e.L == c {
 				p++
-				if e.i != noIndex {
-					s.index = int(e.i)
+				if e.I != noIndex {
+					s.index = int(e.I)
 					s.pindex = p
 				}
-				if e.n != final {
-					i, states, n = 0, states[int(e.h)+n:], int(e.n)
+				if e.N != final {
+					i, states, n = 0, states[int(e.H)+n:], int(e.N)
 					if p >= len(str) || utf8.RuneStart(str[p]) {
 						s.states, s.n, pr = states, n, p
 					}
@@ -131,10 +131,10 @@ func (s *ctScannerString) scan(p int) int {
 					return p
 				}
 				continue
-			} else if e.n == final && c <= e.h {
+			} else if e.N == final && c <= e.H {
 				p++
 				s.done = true
-				s.index = int(c-e.l) + int(e.i)
+				s.index = int(c-e.L) + int(e.I)
 				s.pindex = p
 				return p
 			}
@@ -143,3 +143,22 @@ func (s *ctScannerString) scan(p int) int {
 	}
 	return pr
 }
+
+// appendSuffixes appends to d all strings consisting of prefix followed by
+// a suffix matched by the n entries of states.
+func (t contractTrieSet) appendSuffixes(d []string, prefix []byte, states contractTrieSet, n int) []string {
+	for _, e := range states[:n] {
+		if e.N == final {
+			for c := int(e.L); c <= int(e.H); c++ {
+				d = append(d, string(append(prefix, byte(c))))
+			}
+			continue
+		}
+		s := append(prefix[:len(prefix):len(prefix)], e.L)
+		if e.I != noIndex {
+			d = append(d, string(s))
+		}
+		d = t.appendSuffixes(d, s, states[int(e.H)+n:], int(e.N))
+	}
+	return d
+}
@@ -29,7 +29,7 @@ type tableInitializer interface {
 	TrieValues() []uint32
 	FirstBlockOffsets() (lookup, value uint16)
 	ExpandElems() []uint32
-	ContractTries() []struct{ l, h, n, i uint8 }
+	ContractTries() []struct{ L, H, N, I uint8 }
 	ContractElems() []uint32
 	MaxContractLen() int
 	VariableTop() uint32
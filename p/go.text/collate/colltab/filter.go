@@ -0,0 +1,69 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import "unicode/utf8"
+
+// A Filter modifies the collation elements computed for a single character
+// or contraction.  It is passed the first rune r of the matched text and the
+// collation elements ce computed for it.  It returns the collation elements
+// to use instead, which may be ce modified in place.  A Filter must return at
+// least one element; characters that should be ignored can be mapped to
+// Ignore.
+type Filter func(r rune, ce []Elem) []Elem
+
+// NewFilterWeigher returns a Weigher that wraps w and passes the collation
+// elements computed by w for each character or contraction through f.
+// It can be used, for example, to ignore punctuation, to assign weights to
+// characters in the private use area, or to log the collation elements.
+// New collation elements can be created using MakeElem.
+//
+// Weighers that need to consider more than a single character or contraction
+// at a time may embed a Weigher in a struct and override AppendNext and
+// AppendNextString.  Such Weighers should also implement ContractionInfo
+// if the returned elements for a sequence of runes depend on the runes that
+// follow.
+func NewFilterWeigher(w Weigher, f Filter) Weigher {
+	return &filterWeigher{w, f}
+}
+
+type filterWeigher struct {
+	Weigher
+	f Filter
+}
+
+func (fw *filterWeigher) AppendNext(buf []Elem, s []byte) (ce []Elem, n int) {
+	k := len(buf)
+	buf, n = fw.Weigher.AppendNext(buf, s)
+	r, _ := utf8.DecodeRune(s)
+	return append(buf[:k], fw.f(r, buf[k:])...), n
+}
+
+func (fw *filterWeigher) AppendNextString(buf []Elem, s string) (ce []Elem, n int) {
+	k := len(buf)
+	buf, n = fw.Weigher.AppendNextString(buf, s)
+	r, _ := utf8.DecodeRuneInString(s)
+	return append(buf[:k], fw.f(r, buf[k:])...), n
+}
+
+// MayStartContraction implements ContractionInfo.
+func (fw *filterWeigher) MayStartContraction(r rune) bool {
+	ci, ok := fw.Weigher.(ContractionInfo)
+	return !ok || ci.MayStartContraction(r)
+}
+
+// MayContinueContraction implements ContractionInfo.
+func (fw *filterWeigher) MayContinueContraction(r rune) bool {
+	ci, ok := fw.Weigher.(ContractionInfo)
+	return !ok || ci.MayContinueContraction(r)
+}
+
+// MaxContractionLen implements ContractionInfo.
+func (fw *filterWeigher) MaxContractionLen() int {
+	if ci, ok := fw.Weigher.(ContractionInfo); ok {
+		return ci.MaxContractionLen()
+	}
+	return -1
+}
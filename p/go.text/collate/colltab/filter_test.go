@@ -0,0 +1,64 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterWeigher(t *testing.T) {
+	a := mkElem(100, defaultSecondary, defaultTertiary, 0)
+	b := mkElem(200, defaultSecondary, defaultTertiary, 0)
+	ch := mkElem(300, defaultSecondary, defaultTertiary, 0)
+	dash := mkElem(10, defaultSecondary, defaultTertiary, 0)
+	pua := mkElem(150, defaultSecondary, defaultTertiary, 0)
+	m := map[string][]Elem{
+		"a":  {a},
+		"b":  {b},
+		"ch": {ch},
+		"-":  {dash},
+	}
+	var seen []rune
+	fw := NewFilterWeigher(&mapWeigher{m: m}, func(r rune, ce []Elem) []Elem {
+		seen = append(seen, r)
+		switch {
+		case r == '-':
+			return []Elem{Ignore}
+		case r == '':
+			return append(ce[:0], pua, pua)
+		}
+		return ce
+	})
+	tests := []struct {
+		in  string
+		n   int
+		out []Elem
+	}{
+		{"a", 1, []Elem{a}},
+		{"-a", 1, []Elem{Ignore}},
+		{"cha", 2, []Elem{ch}},
+		{"b", 3, []Elem{pua, pua}},
+	}
+	for _, tt := range tests {
+		seen = nil
+		ce, n := fw.AppendNextString(nil, tt.in)
+		if n != tt.n || !reflect.DeepEqual(ce, tt.out) {
+			t.Errorf("AppendNextString(%q) = %X, %d; want %X, %d", tt.in, ce, n, tt.out, tt.n)
+		}
+		if r := []rune(tt.in)[0]; len(seen) != 1 || seen[0] != r {
+			t.Errorf("%q: filter called for %q; want %q", tt.in, seen, r)
+		}
+		buf := []Elem{b}
+		ce, n = fw.AppendNext(buf, []byte(tt.in))
+		if n != tt.n || !reflect.DeepEqual(ce[1:], tt.out) || ce[0] != b {
+			t.Errorf("AppendNext(%q) = %X, %d; want %X, %d", tt.in, ce[1:], n, tt.out, tt.n)
+		}
+	}
+	ci := fw.(ContractionInfo)
+	if !ci.MayStartContraction('c') || ci.MaxContractionLen() != -1 {
+		t.Errorf("ContractionInfo should be conservative for Weighers that do not implement it")
+	}
+}
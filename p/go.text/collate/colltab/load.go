@@ -0,0 +1,288 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"code.google.com/p/go.text/unicode/norm"
+)
+
+// The format written by WriteTable consists of the magic string, the format
+// version, the Unicode version for which the table was generated, the
+// variable top, the maximum contraction length, and the trie values, trie
+// index, expansion elements, contraction tries and contraction elements.
+// Strings and slices are prefixed by their length.  All values are stored in
+// little-endian byte order.
+//
+// Only the blocks of the trie that are reachable from the first blocks of
+// the table are written.  In the written trie, the first block for ASCII
+// values starts at offset 0 of the values and the first block for the index
+// is the fourth block of the index, so that both first block offsets are 0.
+const (
+	tableMagic   = "GoColTab"
+	tableVersion = 1
+
+	// maxTableLen limits the size of tables read by Load to protect against
+	// corrupt input.
+	maxTableLen = 1 << 24
+)
+
+var errFormat = errors.New("colltab: invalid table format")
+
+// WriteTable writes the data of t to w in a format that can be read by Load.
+// Only the data needed by t is written, so that a program can include the
+// tables for the locales it needs, or retrieve them at run time, instead of
+// linking in the tables for all locales.  t must be a Weigher returned by
+// Init or Load, such as the table of a Collator returned by collate.New.
+func WriteTable(w io.Writer, t Weigher) error {
+	tab, ok := t.(*table)
+	if !ok {
+		return fmt.Errorf("colltab: cannot write Weigher of type %T", t)
+	}
+	index, err := compactTrie(&tab.index)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	e := &encoder{w: bw}
+	e.string(tableMagic)
+	e.write(uint32(tableVersion))
+	e.string(norm.Version)
+	e.write(tab.variableTop)
+	e.write(uint32(tab.maxContractLen))
+	e.slice(len(index.values), index.values)
+	e.slice(len(index.index), index.index)
+	e.slice(len(tab.expandElem), tab.expandElem)
+	e.slice(len(tab.contractTries), []struct{ L, H, N, I uint8 }(tab.contractTries))
+	e.slice(len(tab.contractElem), tab.contractElem)
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+// Load returns the table stored in b, which must have been written by
+// WriteTable.  It returns an error if the data is malformed or was generated
+// for a different version of Unicode than the one supported by package norm.
+// Load only performs basic consistency checks, so b should be obtained from
+// a trusted source.  The returned Weigher does not refer to b.
+func Load(b []byte) (Weigher, error) {
+	d := &decoder{r: bytes.NewReader(b)}
+	if d.string() != tableMagic || d.err != nil {
+		return nil, errFormat
+	}
+	var version, maxContractLen uint32
+	if d.read(&version); d.err == nil && version != tableVersion {
+		return nil, fmt.Errorf("colltab: unsupported table format version %d", version)
+	}
+	if v := d.string(); d.err == nil && v != norm.Version {
+		return nil, fmt.Errorf("colltab: table is for Unicode %s; want %s", v, norm.Version)
+	}
+	t := &table{}
+	d.read(&t.variableTop)
+	d.read(&maxContractLen)
+	t.maxContractLen = int(maxContractLen)
+	t.index.values = make([]uint32, d.length())
+	d.read(t.index.values)
+	t.index.index = make([]uint16, d.length())
+	d.read(t.index.index)
+	t.expandElem = make([]uint32, d.length())
+	d.read(t.expandElem)
+	ct := make([]struct{ L, H, N, I uint8 }, d.length())
+	d.read(ct)
+	t.contractTries = ct
+	t.contractElem = make([]uint32, d.length())
+	d.read(t.contractElem)
+	if d.err != nil || d.r.Len() != 0 {
+		return nil, errFormat
+	}
+	if len(t.index.values) < 2*blockSize || len(t.index.index) < 4*blockSize {
+		return nil, errFormat
+	}
+	t.index.values0 = t.index.values
+	t.index.index0 = t.index.index
+	// Verify that all blocks reachable from the first blocks are within range.
+	if _, err := compactTrie(&t.index); err != nil {
+		return nil, err
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// validate verifies that the expansions and contractions referred to by the
+// elements of t are within range.
+func (t *table) validate() error {
+	for _, v := range t.index.values {
+		if !t.validElem(Elem(v)) {
+			return errFormat
+		}
+	}
+	for _, v := range t.contractElem {
+		if ce := Elem(v); ce.ctype() == ceContractionIndex || !t.validElem(ce) {
+			return errFormat
+		}
+	}
+	return nil
+}
+
+func (t *table) validElem(ce Elem) bool {
+	switch ce.ctype() {
+	case ceExpansionIndex:
+		i := splitExpandIndex(ce)
+		return i < len(t.expandElem) && i+1+int(t.expandElem[i]) <= len(t.expandElem)
+	case ceContractionIndex:
+		index, n, offset := splitContractIndex(ce)
+		return index+n <= len(t.contractTries) && offset < len(t.contractElem)
+	}
+	return true
+}
+
+// compactTrie returns a copy of t holding only the blocks reachable from the
+// first blocks of t, laid out as described for the format of WriteTable.
+// It returns an error if a reachable block is out of range.
+func compactTrie(t *trie) (*trie, error) {
+	c := &trieCopier{
+		src:    t,
+		index:  make([]uint16, 4*blockSize),
+		values: append([]uint32(nil), t.values0[:2*blockSize]...),
+		iblock: make(map[[2]uint16]uint16),
+		vblock: make(map[uint16]uint16),
+	}
+	for b := t2; b < t5; b++ {
+		i := t.index0[b]
+		switch {
+		case b < t3:
+			i = c.valueBlock(i)
+		case b < t4:
+			i = c.indexBlock(i, 1)
+		default:
+			i = c.indexBlock(i, 2)
+		}
+		c.index[b] = i
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &trie{
+		index0:  c.index,
+		values0: c.values,
+		index:   c.index,
+		values:  c.values,
+	}, nil
+}
+
+// A trieCopier copies the reachable blocks of a trie.  Block n of the index
+// or values, as referred to by the index, holds the entries for continuation
+// bytes and therefore starts at offset n*blockSize+0x80.
+type trieCopier struct {
+	src    *trie
+	index  []uint16
+	values []uint32
+	iblock map[[2]uint16]uint16 // maps index blocks and their depth
+	vblock map[uint16]uint16
+	err    error
+}
+
+// valueBlock copies value block i, if it was not copied before, and returns
+// its new block number.
+func (c *trieCopier) valueBlock(i uint16) uint16 {
+	if n, ok := c.vblock[i]; ok {
+		return n
+	}
+	o := int(i)*blockSize + tx
+	if o+blockSize > len(c.src.values) {
+		c.err = errFormat
+		return 0
+	}
+	n := uint16((len(c.values) - tx) / blockSize)
+	c.vblock[i] = n
+	c.values = append(c.values, c.src.values[o:o+blockSize]...)
+	return n
+}
+
+// indexBlock copies index block i, if it was not copied before, and returns
+// its new block number.  The entries of the block refer to value blocks if
+// depth is 1 and to index blocks of depth-1 otherwise.
+func (c *trieCopier) indexBlock(i uint16, depth int) uint16 {
+	key := [2]uint16{i, uint16(depth)}
+	if n, ok := c.iblock[key]; ok {
+		return n
+	}
+	o := int(i)*blockSize + tx
+	if o+blockSize > len(c.src.index) || c.err != nil {
+		c.err = errFormat
+		return 0
+	}
+	start := len(c.index)
+	n := uint16((start - tx) / blockSize)
+	c.iblock[key] = n
+	c.index = append(c.index, make([]uint16, blockSize)...)
+	for j := 0; j < blockSize; j++ {
+		x := c.src.index[o+j]
+		if depth == 1 {
+			x = c.valueBlock(x)
+		} else {
+			x = c.indexBlock(x, depth-1)
+		}
+		c.index[start+j] = x
+	}
+	return n
+}
+
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *encoder) write(v interface{}) {
+	if e.err == nil {
+		e.err = binary.Write(e.w, binary.LittleEndian, v)
+	}
+}
+
+func (e *encoder) string(s string) {
+	e.write(uint32(len(s)))
+	e.write([]byte(s))
+}
+
+func (e *encoder) slice(n int, v interface{}) {
+	e.write(uint32(n))
+	e.write(v)
+}
+
+type decoder struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (d *decoder) read(v interface{}) {
+	if d.err == nil {
+		d.err = binary.Read(d.r, binary.LittleEndian, v)
+	}
+}
+
+// length reads the length of a string or slice.
+func (d *decoder) length() int {
+	var n uint32
+	if d.read(&n); n > maxTableLen || int(n) > d.r.Len() {
+		d.err = errFormat
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) string() string {
+	b := make([]byte, d.length())
+	d.read(b)
+	return string(b)
+}
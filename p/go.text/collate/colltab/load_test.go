@@ -0,0 +1,36 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestLoadErrors(t *testing.T) {
+	for i, b := range [][]byte{
+		nil,
+		[]byte("GoColTab"),
+		[]byte("\x08\x00\x00\x00GoColTab\x01\x00\x00\x00"),
+		[]byte("\x08\x00\x00\x00GoColTab\x63\x00\x00\x00"),
+		[]byte("\x08\x00\x00\x00GoColTab\x01\x00\x00\x00\x05\x00\x00\x000.0.0"),
+		[]byte("\xFF\xFF\xFF\xFF"),
+	} {
+		if _, err := Load(b); err == nil {
+			t.Errorf("%d: Load(%q) succeeded; want error", i, b)
+		}
+	}
+}
+
+func TestWriteTableError(t *testing.T) {
+	if err := WriteTable(ioutil.Discard, &mapWeigher{}); err == nil {
+		t.Errorf("WriteTable succeeded for mapWeigher; want error")
+	}
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, NewNumericWeigher(&mapWeigher{})); err == nil || buf.Len() != 0 {
+		t.Errorf("WriteTable succeeded for numeric Weigher; want error")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// NewNumericWeigher returns a Weigher that wraps w to sort sequences of
+// decimal digits (category Nd) by their numeric value.  It assumes that w
+// assigns each digit a single collation element with a primary weight,
+// optionally followed by elements without a primary weight, and that the
+// primary weights of the digits of all scripts are those of the digits
+// 0 through 9.
+//
+// A sequence of digits is represented by the number of its significant
+// digits, followed by the collation elements of these digits.  Leading
+// zeros are only distinguished at the secondary level.
+func NewNumericWeigher(w Weigher) Weigher {
+	nw := &numericWeigher{Weigher: w}
+	for i := range nw.digits {
+		ce, _ := w.AppendNextString(nil, string('0'+rune(i)))
+		nw.digits[i] = ce[0]
+	}
+	nw.leadingZero, _ = MakeElem(0, defaultSecondary, defaultTertiary, 0)
+	return nw
+}
+
+type numericWeigher struct {
+	Weigher
+
+	// digits holds the collation elements of the ASCII digits.
+	digits [10]Elem
+
+	// leadingZero is the collation element used for leading zeros.
+	leadingZero Elem
+}
+
+func (nw *numericWeigher) AppendNext(buf []Elem, s []byte) (ce []Elem, n int) {
+	return nw.appendNext(buf, source{bytes: s})
+}
+
+func (nw *numericWeigher) AppendNextString(buf []Elem, s string) (ce []Elem, n int) {
+	return nw.appendNext(buf, source{str: s})
+}
+
+// MayStartContraction implements ContractionInfo.  Sequences of digits
+// are treated as contractions.
+func (nw *numericWeigher) MayStartContraction(r rune) bool {
+	if unicode.IsDigit(r) {
+		return true
+	}
+	ci, ok := nw.Weigher.(ContractionInfo)
+	return !ok || ci.MayStartContraction(r)
+}
+
+// MayContinueContraction implements ContractionInfo.
+func (nw *numericWeigher) MayContinueContraction(r rune) bool {
+	if unicode.IsDigit(r) {
+		return true
+	}
+	ci, ok := nw.Weigher.(ContractionInfo)
+	return !ok || ci.MayContinueContraction(r)
+}
+
+// MaxContractionLen implements ContractionInfo.  Sequences of digits may
+// be of any length.
+func (nw *numericWeigher) MaxContractionLen() int {
+	return -1
+}
+
+// next returns the collation elements of the next rune or contraction in src
+// and whether this is a single decimal digit.
+func (nw *numericWeigher) next(buf []Elem, src source) (ce []Elem, n int, digit bool) {
+	var r rune
+	if src.bytes == nil {
+		r, _ = utf8.DecodeRuneInString(src.str)
+		ce, n = nw.Weigher.AppendNextString(buf, src.str)
+	} else {
+		r, _ = utf8.DecodeRune(src.bytes)
+		ce, n = nw.Weigher.AppendNext(buf, src.bytes)
+	}
+	if !unicode.IsDigit(r) || len(ce) == len(buf) || n != utf8.RuneLen(r) {
+		return ce, n, false
+	}
+	for _, e := range ce[len(buf)+1:] {
+		if e.Primary() != 0 {
+			return ce, n, false
+		}
+	}
+	p := ce[len(buf)].Primary()
+	return ce, n, nw.digits[0].Primary() <= p && p <= nw.digits[9].Primary()
+}
+
+func (nw *numericWeigher) appendNext(buf []Elem, src source) (ce []Elem, n int) {
+	ce, n, digit := nw.next(buf, src)
+	if !digit {
+		return ce, n
+	}
+	src.tail(n)
+	for src.str != "" || len(src.bytes) > 0 {
+		next, sz, digit := nw.next(ce, src)
+		if !digit {
+			break
+		}
+		ce = next
+		n += sz
+		src.tail(sz)
+	}
+	return nw.number(ce, len(buf)), n
+}
+
+// number replaces the elements of the digits in ce[p:] with the
+// representation of the number they form.
+func (nw *numericWeigher) number(ce []Elem, p int) []Elem {
+	last := p // start of the last digit
+	for i := p; i < len(ce); i++ {
+		if ce[i].Primary() != 0 {
+			last = i
+		}
+	}
+	zero := nw.digits[0].Primary()
+	for ; p < last; p++ {
+		if w := ce[p].Primary(); w == zero {
+			ce[p] = nw.leadingZero
+		} else if w != 0 {
+			break
+		}
+	}
+	n := 0
+	for _, e := range ce[p:] {
+		if e.Primary() != 0 {
+			n++
+		}
+	}
+	k := n/9 + 1
+	for i := 0; i < k; i++ {
+		ce = append(ce, 0)
+	}
+	copy(ce[p+k:], ce[p:])
+	for ; n >= 9; n -= 9 {
+		ce[p] = nw.digits[9]
+		p++
+	}
+	ce[p] = nw.digits[n]
+	return ce
+}
@@ -0,0 +1,54 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNumericAppendNext(t *testing.T) {
+	m := map[string][]Elem{}
+	mark := mkElem(0, defaultSecondary+1, defaultTertiary, 0)
+	var d [10]Elem
+	for i := range d {
+		d[i] = mkElem(100+i, defaultSecondary, defaultTertiary, 0)
+		m[string('0'+rune(i))] = []Elem{d[i]}
+		// Arabic-Indic digits differ from ASCII digits at the secondary level.
+		m[string('٠'+rune(i))] = []Elem{d[i], mark}
+	}
+	a := mkElem(200, defaultSecondary, defaultTertiary, 0)
+	m["a"] = []Elem{a}
+	lz := mkElem(0, defaultSecondary, defaultTertiary, 0)
+
+	tests := []struct {
+		in  string
+		n   int
+		out []Elem
+	}{
+		{"a1", 1, []Elem{a}},
+		{"1", 1, []Elem{d[1], d[1]}},
+		{"12a", 2, []Elem{d[2], d[1], d[2]}},
+		{"0", 1, []Elem{d[1], d[0]}},
+		{"000", 3, []Elem{lz, lz, d[1], d[0]}},
+		{"0012", 4, []Elem{lz, lz, d[2], d[1], d[2]}},
+		{"123456789", 9, []Elem{d[9], d[0], d[1], d[2], d[3], d[4], d[5], d[6], d[7], d[8], d[9]}},
+		{"1234567890", 10, []Elem{d[9], d[1], d[1], d[2], d[3], d[4], d[5], d[6], d[7], d[8], d[9], d[0]}},
+		{"1٢2", 4, []Elem{d[3], d[1], d[2], mark, d[2]}},
+		{"٠٠", 4, []Elem{lz, mark, d[1], d[0], mark}},
+	}
+	nw := NewNumericWeigher(&mapWeigher{m: m})
+	for _, tt := range tests {
+		ce, n := nw.AppendNextString(nil, tt.in)
+		if n != tt.n || !reflect.DeepEqual(ce, tt.out) {
+			t.Errorf("AppendNextString(%q) = %X, %d; want %X, %d", tt.in, ce, n, tt.out, tt.n)
+		}
+		buf := []Elem{a}
+		ce, n = nw.AppendNext(buf, []byte(tt.in))
+		if n != tt.n || !reflect.DeepEqual(ce[1:], tt.out) || ce[0] != a {
+			t.Errorf("AppendNext(%q) = %X, %d; want %X, %d", tt.in, ce[1:], n, tt.out, tt.n)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// PrimaryRange returns the smallest and largest primary weight that w assigns
+// to the letters in rt.  Modifier letters, such as iteration marks, are often
+// sorted with symbols and are therefore ignored.  So are letters for which w
+// has no entry and that are not ideographs.  ok is false if none of the
+// letters has a primary weight.
+func PrimaryRange(w Weigher, rt *unicode.RangeTable) (lo, hi int, ok bool) {
+	var buf [utf8.UTFMax]byte
+	var ce []Elem
+	add := func(r rune) {
+		if !unicode.IsLetter(r) || unicode.Is(unicode.Lm, r) {
+			return
+		}
+		ce, _ = w.AppendNext(ce[:0], buf[:utf8.EncodeRune(buf[:], r)])
+		if len(ce) == 0 {
+			return
+		}
+		p := ce[0].Primary()
+		if p == 0 || p >= otherOffset {
+			return
+		}
+		if !ok || p < lo {
+			lo = p
+		}
+		if !ok || p > hi {
+			hi = p
+		}
+		ok = true
+	}
+	for _, rng := range rt.R16 {
+		for r := rune(rng.Lo); r <= rune(rng.Hi); r += rune(rng.Stride) {
+			add(r)
+		}
+	}
+	for _, rng := range rt.R32 {
+		for r := rune(rng.Lo); r <= rune(rng.Hi); r += rune(rng.Stride) {
+			add(r)
+		}
+	}
+	return lo, hi, ok
+}
@@ -0,0 +1,40 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package colltab
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestPrimaryRange(t *testing.T) {
+	w := &mapWeigher{m: map[string][]Elem{
+		"a": {mkElem(100, defaultSecondary, defaultTertiary, 0)},
+		"z": {mkElem(300, defaultSecondary, defaultTertiary, 0)},
+		"ª": {mkElem(100, defaultSecondary, defaultTertiary+1, 0)},
+		"α": {mkElem(400, defaultSecondary, defaultTertiary, 0)},
+		"ω": {mkElem(500, defaultSecondary, defaultTertiary, 0)},
+		"́": {mkElem(0, defaultSecondary+1, defaultTertiary, 230)},
+	}}
+	tests := []struct {
+		desc   string
+		rt     *unicode.RangeTable
+		lo, hi int
+		ok     bool
+	}{
+		// Latin letters other than a and z get implicit weights and are ignored.
+		{"Latin", unicode.Latin, 100, 300, true},
+		{"Greek", unicode.Greek, 400, 500, true},
+		{"Inherited", unicode.Inherited, 0, 0, false},
+		// Ideographs without an entry are included.
+		{"Han", &unicode.RangeTable{R16: []unicode.Range16{{0x4E00, 0x4E01, 1}}}, 0x14E00, 0x14E01, true},
+	}
+	for _, tt := range tests {
+		lo, hi, ok := PrimaryRange(w, tt.rt)
+		if lo != tt.lo || hi != tt.hi || ok != tt.ok {
+			t.Errorf("%s: PrimaryRange = %X, %X, %v; want %X, %X, %v", tt.desc, lo, hi, ok, tt.lo, tt.hi, tt.ok)
+		}
+	}
+}
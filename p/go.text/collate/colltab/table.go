@@ -5,6 +5,9 @@
 package colltab
 
 import (
+	"sync"
+	"unicode"
+	"unicode/utf16"
 	"unicode/utf8"
 
 	"code.google.com/p/go.text/unicode/norm"
@@ -22,6 +25,11 @@ type table struct {
 	contractElem   []uint32
 	maxContractLen int
 	variableTop    uint32
+
+	// suffixes holds the runes that occur in contractions at a position
+	// other than the first. It is computed on first use.
+	suffixOnce sync.Once
+	suffixes   map[rune]bool
 }
 
 func (t *table) AppendNext(w []Elem, b []byte) (res []Elem, n int) {
@@ -42,9 +50,65 @@ func (t *table) StartString(p int, s string) int {
 	panic("not implemented")
 }
 
+// Domain returns all runes and contractions for which the table defines
+// weights. The result is sorted by rune and contains each string only once.
 func (t *table) Domain() []string {
-	// TODO: implement
-	panic("not implemented")
+	var d []string
+	var buf [utf8.UTFMax]byte
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if utf16.IsSurrogate(r) {
+			continue
+		}
+		b := buf[:utf8.EncodeRune(buf[:], r)]
+		ce, sz := t.index.lookup(b)
+		if ce == 0 || sz != len(b) {
+			continue
+		}
+		d = append(d, string(b))
+		if ce.ctype() == ceContractionIndex {
+			index, n, _ := splitContractIndex(ce)
+			d = t.contractTries.appendSuffixes(d, b, t.contractTries[index:], n)
+		}
+	}
+	return d
+}
+
+// MayStartContraction implements ContractionInfo.
+func (t *table) MayStartContraction(r rune) bool {
+	var buf [utf8.UTFMax]byte
+	ce, _ := t.index.lookup(buf[:utf8.EncodeRune(buf[:], r)])
+	return ce.ctype() == ceContractionIndex
+}
+
+// MayContinueContraction implements ContractionInfo.  If it reports false,
+// the collation elements of the text preceding r do not depend on r or any
+// text following it, as long as r is a starter.
+func (t *table) MayContinueContraction(r rune) bool {
+	return t.contractionSuffixRunes()[r]
+}
+
+// MaxContractionLen implements ContractionInfo.
+func (t *table) MaxContractionLen() int {
+	return t.maxContractLen
+}
+
+// contractionSuffixRunes returns the set of runes that occur in contractions
+// of t at a position other than the first.
+func (t *table) contractionSuffixRunes() map[rune]bool {
+	t.suffixOnce.Do(func() {
+		if len(t.contractTries) == 0 {
+			return
+		}
+		t.suffixes = map[rune]bool{}
+		for _, s := range t.Domain() {
+			for i, r := range s {
+				if i > 0 {
+					t.suffixes[r] = true
+				}
+			}
+		}
+	})
+	return t.suffixes
 }
 
 func (t *table) Top() uint32 {
@@ -0,0 +1,350 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"code.google.com/p/go.text/dataversion"
+	"code.google.com/p/go.text/language"
+	"code.google.com/p/go.text/unicode/norm"
+)
+
+func init() {
+	dataversion.Register(dataversion.Info{
+		Package: "code.google.com/p/go.text/collate",
+		CLDR:    CLDRVersion,
+		Unicode: UnicodeVersion,
+	})
+}
+
+// tableData holds the tables from which the Collators returned by New are
+// constructed.
+type tableData struct {
+	label            string // identifies the tables; see TableInfo.Version
+	availableLocales string
+	locales          []tableIndex
+
+	// ids and matcher are used to select a table by locale.  They are
+	// created on first use, so that programs that do not use New, such as
+	// those that only use NewFromTable, do not pay for their creation.
+	once    sync.Once
+	ids     []string
+	matcher language.Matcher
+
+	varTop         uint32
+	maxContractLen int
+	expandElem     []uint32
+	contractElem   []uint32
+	values         []uint32
+	lookup         []uint16
+	ctEntries      []struct{ L, H, N, I uint8 }
+}
+
+// tables holds the tables currently in use. It is initialized with the
+// compiled-in tables and can be replaced with LoadTables.
+var tables = &tableData{
+	label:            CLDRVersion,
+	availableLocales: availableLocales,
+	locales:          locales[:],
+	varTop:           varTop,
+	maxContractLen:   18, // TODO: generate
+	expandElem:       mainExpandElem[:],
+	contractElem:     mainContractElem[:],
+	values:           mainValues[:],
+	lookup:           mainLookup[:],
+	ctEntries:        mainCTEntries[:],
+}
+
+// localeIDs returns the locale identifiers of the tables in t.
+func (t *tableData) localeIDs() []string {
+	t.once.Do(t.setup)
+	return t.ids
+}
+
+// match returns the index of the table that best matches tag.
+func (t *tableData) match(tag language.Tag) int {
+	t.once.Do(t.setup)
+	_, index, _ := t.matcher.Match(tag)
+	return index
+}
+
+func (t *tableData) setup() {
+	t.ids = strings.Split(t.availableLocales, ",")
+	tags := make([]language.Tag, len(t.ids))
+	for i, s := range t.ids {
+		tags[i] = language.Make(s)
+	}
+	t.matcher = language.NewMatcher(tags)
+}
+
+// The external table format consists of the magic string, the format version,
+// the Unicode version for which the tables were generated, a free-form label,
+// and the tables. Strings and slices are prefixed by their length. All values
+// are stored in little-endian byte order. Since version 2, strings are padded
+// with zeros to a multiple of 4 bytes, so that all tables are aligned relative
+// to the start of the data. This allows LoadTablesFile to use the tables in
+// place.
+const (
+	tablesMagic   = "GoTxtCol"
+	tablesVersion = 2
+
+	// blockSize is the size of the blocks of the trie.
+	blockSize = 64
+
+	// maxTableLen limits the size of tables read by LoadTables to protect
+	// against corrupt input.
+	maxTableLen = 1 << 24
+)
+
+var errTableFormat = errors.New("collate: invalid table format")
+
+// WriteTables writes the collation tables currently in use by New to w in the
+// format read by LoadTables. The label is stored with the tables and can be
+// used to identify the data, for instance by the CLDR version from which it
+// was generated.
+func WriteTables(w io.Writer, label string) error {
+	return writeTables(w, label, tablesVersion)
+}
+
+func writeTables(w io.Writer, label string, version uint32) error {
+	bw := bufio.NewWriter(w)
+	e := &tableEncoder{w: bw, version: version}
+	t := tables
+	e.string(tablesMagic)
+	e.write(version)
+	e.string(norm.Version)
+	e.string(label)
+	e.string(t.availableLocales)
+	offsets := make([]uint32, 0, 2*len(t.locales))
+	for _, x := range t.locales {
+		offsets = append(offsets, x.lookupOffset, x.valuesOffset)
+	}
+	e.slice(len(t.locales), offsets)
+	e.write(t.varTop)
+	e.write(uint32(t.maxContractLen))
+	e.slice(len(t.expandElem), t.expandElem)
+	e.slice(len(t.contractElem), t.contractElem)
+	e.slice(len(t.values), t.values)
+	e.slice(len(t.lookup), t.lookup)
+	e.slice(len(t.ctEntries), t.ctEntries)
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+// LoadTables replaces the tables used by New and Supported with the tables read
+// from r, which must have been written by WriteTables. It returns the label
+// stored with the tables. LoadTables returns an error and leaves the tables in
+// use unchanged if the data is malformed or was generated for a different
+// version of Unicode than the one supported by package norm.
+//
+// Collators created before the call to LoadTables continue to use the old
+// tables. LoadTables is not safe for concurrent use with New; it should
+// typically be called once during initialization.
+func LoadTables(r io.Reader) (label string, err error) {
+	return loadTables(&tableDecoder{r: bufio.NewReader(r)})
+}
+
+// LoadTablesFile is like LoadTables, but reads the tables from the named file.
+// Where supported, the file is mapped into memory and, if the tables are in
+// the format of the current version of WriteTables and the byte order of the
+// host is little endian, used in place. Processes that load the same file then
+// share its pages instead of each holding a private copy of the tables.
+// Otherwise the file is read into memory.
+//
+// A mapped file is never unmapped. It must not be modified or truncated for as
+// long as the process runs, even after other tables have been loaded, as
+// existing Collators may still refer to it.
+func LoadTablesFile(name string) (label string, err error) {
+	b, mapped, err := mapFile(name)
+	if err != nil {
+		return "", err
+	}
+	label, err = loadTables(&tableDecoder{r: bytes.NewReader(b), buf: b})
+	if err != nil && mapped {
+		unmapFile(b)
+	}
+	return label, err
+}
+
+func loadTables(d *tableDecoder) (label string, err error) {
+	if d.string() != tablesMagic || d.err != nil {
+		return "", errTableFormat
+	}
+	if d.read(&d.version); d.err == nil && (d.version < 1 || d.version > tablesVersion) {
+		return "", fmt.Errorf("collate: unsupported table format version %d", d.version)
+	}
+	if v := d.string(); d.err == nil && v != norm.Version {
+		return "", fmt.Errorf("collate: tables are for Unicode %s; want %s", v, norm.Version)
+	}
+	label = d.string()
+	t := &tableData{label: label}
+	t.availableLocales = d.string()
+	offsets := make([]uint32, 2*d.length())
+	d.read(offsets)
+	t.locales = make([]tableIndex, len(offsets)/2)
+	for i := range t.locales {
+		t.locales[i] = tableIndex{offsets[2*i], offsets[2*i+1]}
+	}
+	d.read(&t.varTop)
+	var maxContractLen uint32
+	d.read(&maxContractLen)
+	t.maxContractLen = int(maxContractLen)
+	t.expandElem = d.uint32s()
+	t.contractElem = d.uint32s()
+	t.values = d.uint32s()
+	t.lookup = d.uint16s()
+	t.ctEntries = d.ctEntries()
+	if d.err != nil {
+		return "", errTableFormat
+	}
+	if err := t.validate(); err != nil {
+		return "", err
+	}
+	tables = t
+	return label, nil
+}
+
+// validate performs some basic consistency checks that prevent out of range
+// accesses for the first lookup in a table.
+func (t *tableData) validate() error {
+	if n := len(strings.Split(t.availableLocales, ",")); n != len(t.locales) {
+		return fmt.Errorf("collate: %d locale names for %d tables", n, len(t.locales))
+	}
+	for _, x := range t.locales {
+		if int(x.lookupOffset+1)*blockSize > len(t.lookup) ||
+			int(x.valuesOffset+1)*blockSize > len(t.values) {
+			return errTableFormat
+		}
+	}
+	return nil
+}
+
+type tableEncoder struct {
+	w       io.Writer
+	version uint32
+	err     error
+}
+
+func (e *tableEncoder) write(v interface{}) {
+	if e.err == nil {
+		e.err = binary.Write(e.w, binary.LittleEndian, v)
+	}
+}
+
+func (e *tableEncoder) string(s string) {
+	e.write(uint32(len(s)))
+	e.write([]byte(s))
+	if e.version >= 2 {
+		e.write(make([]byte, padding(len(s))))
+	}
+}
+
+// padding returns the number of bytes needed to pad a string of n bytes to a
+// multiple of 4 bytes.
+func padding(n int) int {
+	return -n & 3
+}
+
+func (e *tableEncoder) slice(n int, v interface{}) {
+	e.write(uint32(n))
+	e.write(v)
+}
+
+type tableDecoder struct {
+	r       io.Reader
+	version uint32
+	err     error
+
+	// buf, if not nil, holds all data read by r, which must then be a
+	// *bytes.Reader. Tables in buf are used in place, if possible.
+	buf []byte
+}
+
+func (d *tableDecoder) read(v interface{}) {
+	if d.err == nil {
+		d.err = binary.Read(d.r, binary.LittleEndian, v)
+	}
+}
+
+func (d *tableDecoder) length() int {
+	var n uint32
+	if d.read(&n); n > maxTableLen {
+		d.err = errTableFormat
+		return 0
+	}
+	return int(n)
+}
+
+func (d *tableDecoder) string() string {
+	b := make([]byte, d.length())
+	d.read(b)
+	if d.version >= 2 {
+		d.read(make([]byte, padding(len(b))))
+	}
+	return string(b)
+}
+
+// view returns the next n bytes of buf and advances past them if these bytes
+// can be used in place as a table of elements with the given alignment. It
+// returns nil otherwise.
+func (d *tableDecoder) view(n, align int) []byte {
+	if d.buf == nil || d.err != nil || n == 0 || d.version < 2 || !littleEndian {
+		return nil
+	}
+	r := d.r.(*bytes.Reader)
+	pos := len(d.buf) - r.Len()
+	if n > r.Len() || uintptr(unsafe.Pointer(&d.buf[pos]))%uintptr(align) != 0 {
+		return nil
+	}
+	r.Seek(int64(n), 1)
+	return d.buf[pos : pos+n : pos+n]
+}
+
+func (d *tableDecoder) uint32s() []uint32 {
+	n := d.length()
+	if b := d.view(4*n, 4); b != nil {
+		return (*[maxTableLen]uint32)(unsafe.Pointer(&b[0]))[:n:n]
+	}
+	a := make([]uint32, n)
+	d.read(a)
+	return a
+}
+
+func (d *tableDecoder) uint16s() []uint16 {
+	n := d.length()
+	if b := d.view(2*n, 2); b != nil {
+		return (*[maxTableLen]uint16)(unsafe.Pointer(&b[0]))[:n:n]
+	}
+	a := make([]uint16, n)
+	d.read(a)
+	return a
+}
+
+func (d *tableDecoder) ctEntries() []struct{ L, H, N, I uint8 } {
+	n := d.length()
+	if b := d.view(4*n, 1); b != nil {
+		return (*[maxTableLen]struct{ L, H, N, I uint8 })(unsafe.Pointer(&b[0]))[:n:n]
+	}
+	a := make([]struct{ L, H, N, I uint8 }, n)
+	d.read(a)
+	return a
+}
+
+// littleEndian indicates whether the byte order of the host is little endian.
+var littleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
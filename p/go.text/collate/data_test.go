@@ -0,0 +1,193 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
+)
+
+// checkTables verifies that the tables in use give the expected results.
+func checkTables(t *testing.T, name string) {
+	if len(tables.localeIDs()) != len(tables.locales) || len(Supported()) != len(tables.locales) {
+		t.Errorf("%s: loaded tables are incomplete", name)
+	}
+	for _, tt := range []struct {
+		tag  language.Tag
+		a, b string
+		want int
+	}{
+		{language.English, "a", "b", -1},
+		{language.English, "ä", "b", -1},
+		{language.Swedish, "ä", "z", 1},
+		{language.German, "ä", "z", -1},
+		{language.Spanish, "ñ", "o", -1},
+	} {
+		if got := New(tt.tag).CompareString(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: %v: Compare(%q, %q) was %d; want %d", name, tt.tag, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLoadTables(t *testing.T) {
+	defer func(old *tableData) { tables = old }(tables)
+
+	var buf bytes.Buffer
+	if err := WriteTables(&buf, "CLDR 23"); err != nil {
+		t.Fatalf("WriteTables: %v", err)
+	}
+	blob := buf.Bytes()
+
+	label, err := LoadTables(bytes.NewReader(blob))
+	if err != nil {
+		t.Fatalf("LoadTables: %v", err)
+	}
+	if label != "CLDR 23" {
+		t.Errorf("label was %q; want %q", label, "CLDR 23")
+	}
+	if v := Info(language.Und).Version; v != "CLDR 23" {
+		t.Errorf("Info().Version was %q; want %q", v, "CLDR 23")
+	}
+	checkTables(t, "LoadTables")
+
+	loaded := tables
+	for i, b := range [][]byte{
+		nil,
+		[]byte("NotATable"),
+		blob[:len(blob)/2],
+		append([]byte{}, blob[:len(blob)-1]...),
+	} {
+		if _, err := LoadTables(bytes.NewReader(b)); err == nil {
+			t.Errorf("%d: LoadTables succeeded for malformed input", i)
+		}
+		if tables != loaded {
+			t.Errorf("%d: tables were replaced after failed load", i)
+		}
+	}
+
+	// Data for a different version of Unicode is rejected.
+	bad := append([]byte{}, blob...)
+	bad[4+len(tablesMagic)+4+4] = 'x' // first byte of the Unicode version
+	if _, err := LoadTables(bytes.NewReader(bad)); err == nil {
+		t.Errorf("LoadTables succeeded for tables of a different Unicode version")
+	}
+
+	// Tables written in the format of version 1 can still be loaded.
+	buf.Reset()
+	if err := writeTables(&buf, "v1", 1); err != nil {
+		t.Fatalf("writeTables: %v", err)
+	}
+	if label, err := LoadTables(&buf); err != nil || label != "v1" {
+		t.Errorf("LoadTables(version 1) = %q, %v; want %q, nil", label, err, "v1")
+	}
+	checkTables(t, "LoadTables(version 1)")
+}
+
+func TestLoadTablesFile(t *testing.T) {
+	defer func(old *tableData) { tables = old }(tables)
+
+	dir, err := ioutil.TempDir("", "collate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, version := range []uint32{1, tablesVersion} {
+		var buf bytes.Buffer
+		if err := writeTables(&buf, "file", version); err != nil {
+			t.Fatalf("%d: writeTables: %v", version, err)
+		}
+		name := filepath.Join(dir, "tables")
+		if err := ioutil.WriteFile(name, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if label, err := LoadTablesFile(name); err != nil || label != "file" {
+			t.Fatalf("%d: LoadTablesFile = %q, %v; want %q, nil", version, label, err, "file")
+		}
+		checkTables(t, "LoadTablesFile")
+	}
+
+	if _, err := LoadTablesFile(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("LoadTablesFile succeeded for missing file")
+	}
+}
+
+// TestInPlace verifies that the tables of data in the current format are used
+// in place and that other data is copied.
+func TestInPlace(t *testing.T) {
+	defer func(old *tableData) { tables = old }(tables)
+
+	if !littleEndian {
+		t.Skip("tables are only used in place on little-endian hosts")
+	}
+	for _, version := range []uint32{1, tablesVersion} {
+		var buf bytes.Buffer
+		if err := writeTables(&buf, "", version); err != nil {
+			t.Fatalf("%d: writeTables: %v", version, err)
+		}
+		b := buf.Bytes()
+		if _, err := loadTables(&tableDecoder{r: bytes.NewReader(b), buf: b}); err != nil {
+			t.Fatalf("%d: loadTables: %v", version, err)
+		}
+		start := uintptr(unsafe.Pointer(&b[0]))
+		p := uintptr(unsafe.Pointer(&tables.values[0]))
+		inPlace := start <= p && p < start+uintptr(len(b))
+		if want := version == tablesVersion; inPlace != want {
+			t.Errorf("%d: tables used in place was %v; want %v", version, inPlace, want)
+		}
+		checkTables(t, "loadTables")
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	var all bytes.Buffer
+	if err := WriteTables(&all, ""); err != nil {
+		t.Fatalf("WriteTables: %v", err)
+	}
+	for _, tag := range []string{"und", "de", "sv", "cs", "ja", "zh-Hant", "es-u-co-trad"} {
+		w := New(language.Make(tag)).Table()
+		var buf bytes.Buffer
+		if err := colltab.WriteTable(&buf, w); err != nil {
+			t.Errorf("%s: WriteTable: %v", tag, err)
+			continue
+		}
+		if buf.Len() >= all.Len() {
+			t.Errorf("%s: table was %d bytes; want less than %d", tag, buf.Len(), all.Len())
+		}
+		lw, err := colltab.Load(buf.Bytes())
+		if err != nil {
+			t.Errorf("%s: Load: %v", tag, err)
+			continue
+		}
+		if lw.Top() != w.Top() {
+			t.Errorf("%s: Top was %X; want %X", tag, lw.Top(), w.Top())
+		}
+		for _, s := range append(w.Domain(), "\U0001D11E", "\uAC01", "\U00020000", "\xC0\x80", "\xF4\x8F") {
+			got, n := lw.AppendNextString(nil, s)
+			want, wn := w.AppendNextString(nil, s)
+			if n != wn || !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: AppendNextString(%+q) = %X, %d; want %X, %d", tag, s, got, n, want, wn)
+				break
+			}
+		}
+		b := buf.Bytes()
+		for _, n := range []int{0, 8, len(b) / 2, len(b) - 1} {
+			if _, err := colltab.Load(b[:n]); err == nil {
+				t.Errorf("%s: Load succeeded for table truncated to %d bytes", tag, n)
+			}
+		}
+	}
+	if err := colltab.WriteTable(ioutil.Discard, colltab.NewNumericWeigher(New(language.Und).Table())); err == nil {
+		t.Errorf("WriteTable succeeded for numeric Weigher")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+// This file is maintained by hand. It holds the standard and auxiliary
+// exemplar characters of a subset of the locales of CLDR 23, the release of
+// the collation tables, in the format written by maketables -tables=chars.
+// "make exemplarchars" regenerates it from CLDR for all locales.
+
+type exemplarType int
+
+const (
+	exCharacters exemplarType = iota
+	exContractions
+	exPunctuation
+	exAuxiliary
+	exCurrency
+	exIndex
+	exN
+)
+
+var exemplarCharacters = map[string][exN]string{
+	"cs": [exN]string{
+		0: "a á b c č d ď e é ě f g h ch i í j k l m n ň o ó p q r ř s š t ť u ú ů v w x y ý z ž",
+	},
+	"de": [exN]string{
+		0: "a ä b c d e f g h i j k l m n o ö p q r s ß t u ü v w x y z",
+		3: "á à ă â å ã ā æ ç é è ĕ ê ë ē ğ í ì ĭ î ï İ ī ı ñ ó ò ŏ ô ø ō œ ş ú ù ŭ û ū ÿ",
+	},
+	"el": [exN]string{
+		0: "α ά β γ δ ε έ ζ η ή θ ι ί ϊ ΐ κ λ μ ν ξ ο ό π ρ σ ς τ υ ύ ϋ ΰ φ χ ψ ω ώ",
+	},
+	"en": [exN]string{
+		0: "a b c d e f g h i j k l m n o p q r s t u v w x y z",
+		3: "á à ă â å ä ã ā æ ç é è ĕ ê ë ē í ì ĭ î ï ī ñ ó ò ŏ ô ö ø ō œ ú ù ŭ û ü ū ÿ",
+	},
+	"es": [exN]string{
+		0: "a á b c d e é f g h i í j k l m n ñ o ó p q r s t u ú ü v w x y z",
+	},
+	"fr": [exN]string{
+		0: "a à â æ b c ç d e é è ê ë f g h i î ï j k l m n o ô œ p q r s t u ù û ü v w x y ÿ z",
+		3: "á å ä ã ā ē í ì ī ñ ó ò ö ø ú ǔ",
+	},
+	"pl": [exN]string{
+		0: "a ą b c ć d e ę f g h i j k l ł m n ń o ó p r s ś t u w y z ź ż",
+		3: "q v x",
+	},
+	"ru": [exN]string{
+		0: "а б в г д е ё ж з и й к л м н о п р с т у ф х ц ч ш щ ъ ы ь э ю я",
+	},
+	"sv": [exN]string{
+		0: "a à b c d e é f g h i j k l m n o p q r s t u v w x y z å ä ö",
+	},
+}
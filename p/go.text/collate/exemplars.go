@@ -0,0 +1,42 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"strings"
+
+	"code.google.com/p/go.text/language"
+)
+
+// StandardExemplars returns the exemplar characters that CLDR defines for the
+// language t: the letters, and sequences such as "ch" in Czech, that are
+// required to write the language. They may be used to check whether text is
+// plausibly written in t. If no such characters are defined for t, the
+// characters of its closest parent that has them are returned, or nil if there
+// is no such parent. The index characters are returned by IndexLabels.
+func StandardExemplars(t language.Tag) []string {
+	return exemplars(t, exCharacters)
+}
+
+// AuxiliaryExemplars returns the characters that CLDR lists as occurring in
+// text of the language t, such as in loan words, but that are not required to
+// write it, for example é in English. Parents are consulted as for
+// StandardExemplars.
+func AuxiliaryExemplars(t language.Tag) []string {
+	return exemplars(t, exAuxiliary)
+}
+
+func exemplars(t language.Tag, typ exemplarType) []string {
+	for {
+		if e := exemplarCharacters[t.String()][typ]; e != "" {
+			return strings.Fields(e)
+		}
+		p := t.Parent()
+		if p == t {
+			return nil
+		}
+		t = p
+	}
+}
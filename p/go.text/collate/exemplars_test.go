@@ -0,0 +1,52 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"code.google.com/p/go.text/collate"
+	"code.google.com/p/go.text/language"
+)
+
+func TestExemplars(t *testing.T) {
+	tests := []struct {
+		tag       string
+		standard  string // first and last standard character and their count
+		auxiliary string
+	}{
+		{"en", "a z 26", "á ÿ 38"},
+		{"en-GB", "a z 26", "á ÿ 38"},
+		{"de", "a z 30", "á ÿ 39"},
+		{"de-CH", "a z 30", "á ÿ 39"},
+		{"cs", "a ž 42", ""},
+		{"sv", "a ö 31", ""},
+		{"ru", "а я 33", ""},
+		{"pl", "a ż 32", "q x 3"},
+		{"und", "", ""},
+		{"xx", "", ""},
+	}
+	summary := func(s []string) string {
+		if len(s) == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%s %s %d", s[0], s[len(s)-1], len(s))
+	}
+	for _, tt := range tests {
+		tag := language.Make(tt.tag)
+		if s := summary(collate.StandardExemplars(tag)); s != tt.standard {
+			t.Errorf("%s: StandardExemplars was %q; want %q", tt.tag, s, tt.standard)
+		}
+		if s := summary(collate.AuxiliaryExemplars(tag)); s != tt.auxiliary {
+			t.Errorf("%s: AuxiliaryExemplars was %q; want %q", tt.tag, s, tt.auxiliary)
+		}
+	}
+	cs := collate.StandardExemplars(language.Make("cs"))
+	if !strings.Contains(" "+strings.Join(cs, " ")+" ", " ch ") {
+		t.Errorf("cs: StandardExemplars does not contain the sequence ch: %v", cs)
+	}
+}
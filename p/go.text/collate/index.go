@@ -5,18 +5,19 @@
 package collate
 
 // tableIndex holds information for constructing a table
-// for a certain locale based on the main table.
+// for a certain locale based on the main table. The main table is the one
+// currently held by tables.
 type tableIndex struct {
 	lookupOffset uint32
 	valuesOffset uint32
 }
 
 func (t tableIndex) TrieIndex() []uint16 {
-	return mainLookup[:]
+	return tables.lookup
 }
 
 func (t tableIndex) TrieValues() []uint32 {
-	return mainValues[:]
+	return tables.values
 }
 
 func (t tableIndex) FirstBlockOffsets() (lookup, value uint16) {
@@ -24,21 +25,21 @@ func (t tableIndex) FirstBlockOffsets() (lookup, value uint16) {
 }
 
 func (t tableIndex) ExpandElems() []uint32 {
-	return mainExpandElem[:]
+	return tables.expandElem
 }
 
-func (t tableIndex) ContractTries() []struct{ l, h, n, i uint8 } {
-	return mainCTEntries[:]
+func (t tableIndex) ContractTries() []struct{ L, H, N, I uint8 } {
+	return tables.ctEntries
 }
 
 func (t tableIndex) ContractElems() []uint32 {
-	return mainContractElem[:]
+	return tables.contractElem
 }
 
 func (t tableIndex) MaxContractLen() int {
-	return 18 // TODO: generate
+	return tables.maxContractLen
 }
 
 func (t tableIndex) VariableTop() uint32 {
-	return varTop
+	return tables.varTop
 }
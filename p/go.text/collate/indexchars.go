@@ -0,0 +1,151 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+// indexCharacters holds the index exemplar characters defined in CLDR 23
+// for each locale. The data is taken from the exemplarCharacters elements of
+// type "index" in core.zip and may be refreshed with "make indexchars".
+// CLDR 23 is the release of the collation tables, see CLDRVersion, so that
+// labels and tailorings agree. It is older than the CLDR 25 data of the
+// language and display packages; update both files together.
+var indexCharacters = map[string]string{
+	"aa":         "A B T S E C K X I D Q R F G O L M N U W H Y",
+	"agq":        "A B C D E Ɛ F G H I Ɨ K L M N Ŋ O Ɔ P S T U Ʉ V W Y Z ʔ",
+	"ak":         "A B C D E Ɛ F G H I J K L M N O Ɔ P Q R S T U V W X Y Z",
+	"ar":         "ا ب ت ث ج ح خ د ذ ر ز س ش ص ض ط ظ ع غ ف ق ك ل م ن ه و ي",
+	"asa":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"ast":        "A B C D E F G H I L M N Ñ O P Q R S T U V X Y Z",
+	"bas":        "A B Ɓ C D E Ɛ F G H I J K L M N Ŋ O Ɔ P R S T U V W Y Z",
+	"bem":        "A B C E F G I J K L M N O P S SH T U W Y",
+	"bez":        "A B C D E F G H I J K L M N O P Q R S T U V W Y Z",
+	"bm":         "A B C D E Ɛ F G H I J K L M N Ɲ Ŋ O Ɔ P R S T U W Y Z",
+	"br":         "A B C D E F G H I J K L M N O P R S T U V W X Y Z",
+	"brx":        "अ आ इ ई उ ऊ ऍ ए ऐ ऑ ओ औ क ख ग घ च छ ज झ ञ ट ठ ड ड़ ढ ण त थ द ध न प फ ब भ म य र ल ळ व श ष स ह",
+	"ca":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"cgg":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"chr":        "Ꭰ Ꭶ Ꭽ Ꮃ Ꮉ Ꮎ Ꮖ Ꮜ Ꮣ Ꮬ Ꮳ Ꮹ Ꮿ",
+	"cs":         "A B C Č D E F G H CH I J K L M N O P Q R Ř S Š T U V W X Y Z Ž",
+	"cy":         "A B C CH D DD E F FF G NG H I J L LL M N O P PH R RH S T TH U W Y",
+	"da":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z Æ Ø Å",
+	"dav":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"de":         "A B C D E F G H I J K L M N O P Q R S Sch* St* T U V W X Y Z",
+	"dje":        "A B C D E F G H I J K L M N Ɲ Ŋ O P Q R S T U W X Y Z",
+	"dua":        "A B Ɓ C D Ɗ E Ɛ F G I J K L M N Ŋ O Ɔ P S T U W Y",
+	"dyo":        "A B C D E F G H I J K L M N Ñ Ŋ O P Q R S T U V W X Y",
+	"ebu":        "A B C D E F G H I Ĩ J K L M N O P Q R S T U Ũ V W X Y Z",
+	"ee":         "A B D Ɖ E Ɛ F Ƒ G Ɣ H X I K L M N Ŋ O Ɔ P R S T U V Ʋ W Y Z",
+	"el":         "Α Β Γ Δ Ε Ζ Η Θ Ι Κ Λ Μ Ν Ξ Ο Π Ρ Σ Τ Υ Φ Χ Ψ Ω",
+	"en":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"en-GB":      "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"en-ZA":      "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"eo":         "A B C Ĉ D E F G Ĝ H Ĥ I J Ĵ K L M N O P R S Ŝ T U Ŭ V Z",
+	"es":         "A B C D E F G H I J K L M N Ñ O P Q R S T U V W X Y Z",
+	"et":         "A B C D E F G H I J K L M N O P Q R S Š Z Ž T U V Õ Ä Ö Ü X Y",
+	"eu":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"ewo":        "A B D E Ə Ɛ F G H I K L M N Ŋ O Ɔ P R S T U V W Y Z",
+	"fa":         "آ ا ب پ ت ث ج چ ح خ د ذ ر ز ژ س ش ص ض ط ظ ع غ ف ق ک گ ل م ن و ه ی",
+	"fa-AF":      "آ ا ب پ ت ث ج چ ح خ د ذ ر ز ژ س ش ص ض ط ظ ع غ ف ق ک گ ل م ن و ه ی",
+	"ff":         "A B Ɓ C D Ɗ E F G H I J K L M N Ñ Ŋ O P R S T U W Y Ƴ",
+	"fi":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z Å Ä Ö",
+	"fil":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"gd":         "A B C D E F G H I L M N O P R S T U",
+	"gl":         "A B C D E F G H I J K L M N Ñ O P Q R S T U V W X Y Z",
+	"gu":         "અ આ ઇ ઈ ઉ ઊ ઋ એ ઐ ઓ ઔ ક ખ ગ ઘ ઙ ચ છ જ ઝ ઞ ટ ઠ ડ ઢ ણ ત થ દ ધ ન પ ફ બ ભ મ ય ર લ વ શ ષ સ હ ળ",
+	"guz":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"he":         "א ב ג ד ה ו ז ח ט י כ ל מ נ ס ע פ צ ק ר ש ת",
+	"hr":         "A B C Č Ć D DŽ Đ E F G H I J K L LJ M N NJ O P Q R S Š T U V W X Y Z Ž",
+	"hu":         "A Á B C CS D DZ DZS E É F G GY H I Í J K L LY M N NY O Ó Ö Ő P Q R S SZ T TY U Ú Ü Ű V W X Y Z ZS",
+	"id":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"ig":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"it":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"ja":         "あ か さ た な は ま や ら わ",
+	"jgo":        "A B C D Ɛ F G H I J K L M N Ŋ Ɔ P Pf S Sh T Ts U Ʉ Ʉ̈ V W Ẅ Y Z Ꞌ",
+	"jmc":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"ka":         "ა ბ გ დ ე ვ ზ თ ი კ ლ მ ნ ო პ ჟ რ ს ტ უ ფ ქ ღ ყ შ ჩ ც ძ წ ჭ ხ ჯ ჰ",
+	"kab":        "A B C Č D Ḍ E Ɛ F G Ǧ Ɣ H Ḥ I J K L M N P Q R Ṛ S Ṣ T Ṭ U W X Y Z Ẓ",
+	"kam":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"kde":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"kea":        "A B D E F G H I J K L M N O P R S T U V X Z",
+	"khq":        "A Ã B C D E Ẽ F G H I J K L M N Ɲ Ŋ O Õ P Q R S Š T U W X Y Z Ž",
+	"ki":         "A B C D E G H I J K M N O R T U W Y",
+	"kk":         "А Ә Б В Г Ғ Д Е Ё Ж З И Й К Қ Л М Н Ң О Ө П Р С Т У Ұ Ү Ф Х Һ Ц Ч Ш Щ Ъ Ы І Ь Э Ю Я",
+	"kkj":        "A B Ɓ C D Ɗ Ɗy E Ɛ F G Gb Gw H I I̧ J K Kp Kw L M Mb N Nd ǋ Ny Ŋ Ŋg Ŋgb Ŋgw O Ɔ Ɔ̧ P R S T U U̧ V W Y",
+	"kln":        "A B C D E G H I J K L M N O P R S T U W Y",
+	"ko":         "ㄱ ㄴ ㄷ ㄹ ㅁ ㅂ ㅅ ㅇ ㅈ ㅊ ㅋ ㅌ ㅍ ㅎ",
+	"ksb":        "A B C D E F G H I J K L M N O P S T U V W Y Z",
+	"ksf":        "A B C D E Ǝ Ɛ F G H I J K L M N Ŋ O Ɔ P R S T U V W Y Z",
+	"ksh":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"lag":        "A B C D E F G H I Ɨ J K L M N O P Q R S T U Ʉ V W X Y Z",
+	"lg":         "A B C D E F G I J K L M N Ŋ O P R S T U V W Y Z",
+	"ln":         "A B C D E Ɛ F G Gb H I K L M Mb Mp N Nd Ng Nk Ns Nt Ny Nz O Ɔ P R S T U V W Y Z",
+	"lt":         "A Ą B C Č D E Ę Ė F G H I Į Y J K L M N O P R S Š T U Ų Ū V Z Ž",
+	"lu":         "A B C D E F H I J K L M N O P Q S T U V W Y Z",
+	"luo":        "A B C D E F G H I J K L M N O P R S T U V W Y",
+	"luy":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"lv":         "A B C Č D E F G Ģ H I J K Ķ L Ļ M N Ņ O P Q R S Š T U V W X Y Z Ž",
+	"mas":        "A B C D E Ɛ G H I Ɨ J K L M N Ŋ O Ɔ P R S T U Ʉ W Y",
+	"mer":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"mfe":        "A B C D E F G H I J K L M N O P R S T U V W X Y Z",
+	"mg":         "A B D E F G H I J K L M N O P R S T V Y Z",
+	"mgh":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"mgo":        "A B CH D E Ə F G GH I J K M N Ŋ O Ɔ P R S T U W Y Z ʼ",
+	"mk":         "А Б В Г Д Ѓ Е Ж З Ѕ И Ј К Л Љ М Н Њ О П Р С Т Ќ У Ф Х Ц Ч Џ Ш",
+	"mr":         "ॐ ं ः अ आ इ ई उ ऊ ऋ ऌ ए ऐ ऑ ओ औ क ख ग घ ङ च छ ज झ ञ ट ठ ड ढ ण त थ द ध न प फ ब भ म य र ल व श ष स ह ळ ऽ ॅ ्",
+	"ms":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"mua":        "A B Ɓ C D Ɗ E Ǝ F G H I J K L M N Ŋ O P R S T U V W Y Z",
+	"naq":        "A B C D E F G H I K M N O P Q R S T U W X Y Z",
+	"nb":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z Æ Ø Å",
+	"nd":         "A B C D E F G H I J K L M N O P Q S T U V W X Y Z",
+	"nmg":        "A B Ɓ C D E Ǝ Ɛ F G H I J K L M N Ŋ O Ɔ P R S T U V W Y",
+	"nn":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z Æ Ø Å",
+	"nnh":        "A B C D E Ɛ F G H I J K L M N Ŋ O Ɔ P Pf R S Sh T Ts U Ʉ V W Ẅ Y Ÿ Z ʼ",
+	"nus":        "A B C D E Ɛ F G Ɣ H I J K L M N Ŋ O Ɔ P Q R S T U V W X Y Z",
+	"nyn":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"om":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"os":         "А Ӕ Б В Г Гъ Д Дж Дз Е Ё Ж З И Й К Къ Л М Н О П Пъ Р С Т Тъ У Ф Х Хъ Ц Цъ Ч Чъ Ш Щ Ы Э Ю Я",
+	"pl":         "A Ą B C Ć D E Ę F G H I J K L Ł M N Ń O Ó P Q R S Ś T U V W X Y Z Ź Ż",
+	"pt":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"rn":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"ro":         "A Ă Â B C D E F G H I Î J K L M N O P Q R S Ș T Ț U V W X Y Z",
+	"rof":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"ru":         "А Б В Г Д Е Ё Ж З И Й К Л М Н О П Р С Т У Ф Х Ц Ч Ш Щ Ы Э Ю Я",
+	"rw":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"rwk":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"sah":        "А Б Г Ҕ Д Дь И Й К Л М Н Нь Ҥ О Ө П Р С Т У Ү Х Һ Ч Ы Э",
+	"saq":        "A B C D E G H I J K L M N O P R S T U V W Y",
+	"sbp":        "A B C D E F G H I J K L M N O P S T U V W Y",
+	"seh":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"ses":        "A Ã B C D E Ẽ F G H I J K L M N Ɲ Ŋ O Õ P Q R S Š T U W X Y Z Ž",
+	"sg":         "A B D E F G H I J K L M N O P R S T U V W Y Z",
+	"shi":        "ⴰ ⴱ ⴳ ⴷ ⴹ ⴻ ⴼ ⴽ ⵀ ⵃ ⵄ ⵅ ⵇ ⵉ ⵊ ⵍ ⵎ ⵏ ⵓ ⵔ ⵕ ⵖ ⵙ ⵚ ⵛ ⵜ ⵟ ⵡ ⵢ ⵣ ⵥ",
+	"shi-Latn":   "A B C D Ḍ E Ɛ F G Gʷ Ɣ H Ḥ I J K Kʷ L M N Q R Ṛ S Ṣ T Ṭ U W X Y Z",
+	"si":         "අ ආ ඇ ඈ ඉ ඊ උ ඌ ඍ එ ඒ ඓ ඔ ඕ ඖ ක ඛ ග ඝ ඞ ඟ ච ඡ ජ ඣ ඥ ඤ ට ඨ ඩ ඪ ණ ඬ ත ථ ද ධ න ඳ ප ඵ බ භ ම ඹ ය ර ල ව ශ ෂ ස හ ළ ෆ",
+	"sl":         "A B C Č Ć D Đ E F G H I J K L M N O P Q R S Š T U V W X Y Z Ž",
+	"sn":         "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"ssy":        "A B T S E C K X I D Q R F G O L M N U W H Y",
+	"sv":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z Å Ä Ö",
+	"sv-FI":      "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z Å Ä Ö",
+	"swc":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"te":         "అ ఆ ఇ ఈ ఉ ఊ ఋ ౠ ఎ ఏ ఐ ఒ ఓ ఔ క ఖ గ ఘ ఙ చ ఛ జ ఝ ఞ ట ఠ డ ఢ ణ త థ ద ధ న ప ఫ బ భ మ య ర ఱ ల వ శ ష స హ ళ",
+	"teo":        "A B C D E G H I J K L M N O P R S T U V W X Y",
+	"ti":         "ሀ ለ ሐ መ ሠ ረ ሰ ሸ ቀ ቈ ቐ ቘ በ ቨ ተ ቸ ኀ ኈ ነ ኘ አ ከ ኰ ኸ ዀ ወ ዐ ዘ ዠ የ ደ ጀ ገ ጐ ጠ ጨ ጰ ጸ ፀ ፈ ፐ",
+	"to":         "A E F H I K L M N NG O P S T U V ʻ",
+	"tr":         "A B C Ç D E F G H I İ J K L M N O Ö P Q R S Ş T U Ü V W X Y Z",
+	"tzm":        "A B C D Ḍ E Ɛ F G Ɣ H Ḥ I J K L M N Q R Ṛ S Ṣ T Ṭ U W X Y Z",
+	"uk":         "А Б В Г Ґ Д Е Є Ж З И І Ї Й К Л М Н О П Р С Т У Ф Х Ц Ч Ш Щ Ю Я",
+	"ur":         "ا ب پ ت ٹ ث ج چ ح خ د ڈ ذ ر ڑ ز ژ س ش ص ض ط ظ ع غ ف ق ک گ ل م ن و ہ ھ ء ی ے",
+	"uz-Latn":    "A B CH D E F G Gʻ H I J K L M N O Oʻ P Q R S SH T U V X Y Z",
+	"vai-Latn":   "A B Ɓ C D Ɗ E Ɛ F G H I J K L M N Ŋ O Ɔ P Q R S T U V W X Y Z",
+	"vo":         "A Ä B C D E F G H I J K L M N O Ö P R S T U Ü V X Y Z",
+	"vun":        "A B C D E F G H I J K L M N O P R S T U V W Y Z",
+	"wae":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"xog":        "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"yav":        "A B C D E Ɛ F H I K L M N Ŋ O Ɔ P S T U V W Y",
+	"yo":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"zh":         "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"zh-Hans-MO": "A B C D E F G H I J K L M N O P Q R S T U V W X Y Z",
+	"zh-Hant":    "一 丁 丈 不 且 丞 並 串 乘 乾 亂 亭 傀 僎 僵 儐 償 儳 儷 儻 叢 嚴 囌 囑 廳",
+	"zh-Hant-HK": "一 丁 丈 不 且 丞 並 串 乘 乾 亂 亭 傀 僎 僵 儐 償 儳 儷 儻 叢 嚴 囌 囑 廳",
+}
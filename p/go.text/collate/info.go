@@ -0,0 +1,97 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"sort"
+	"strings"
+
+	"code.google.com/p/go.text/language"
+)
+
+// TableInfo describes the collation that New selects for a language.
+type TableInfo struct {
+	// Tag is the tag of the locale whose table is used.
+	Tag language.Tag
+
+	// Inherited reports whether the table is the same as that of a parent
+	// locale, that is, whether the locale has no tailoring of its own.
+	Inherited bool
+
+	// Types lists the collation types that may be selected with the -u-co-
+	// extension, including "standard".
+	Types []string
+
+	// Type is the collation type that New uses for the tag.  It is "standard"
+	// if the tag does not select a type or selects one that is not in Types,
+	// such as "stroke" for Chinese, in which case New falls back to the
+	// standard ordering.
+	Type string
+
+	// Alternate is the alternate handling used by default.
+	Alternate AlternateHandling
+
+	// Version identifies the tables.  It is CLDRVersion for the compiled-in
+	// tables and the label of the tables for tables loaded with LoadTables.
+	Version string
+}
+
+// Info returns information about the collation that New selects for t.
+func Info(t language.Tag) TableInfo {
+	ids := tables.localeIDs()
+	index := tables.match(t)
+	tag := language.Make(ids[index])
+	info := TableInfo{
+		Tag:       tag,
+		Alternate: New(t).Alternate,
+		Version:   tables.label,
+	}
+	if index != 0 && baseOf(t) == baseOf(tag) {
+		info.Inherited = tables.locales[index] == tables.locales[parentIndex(ids, tag)]
+	} else {
+		info.Inherited = index != 0 || !t.IsRoot()
+	}
+	lang := baseOf(t)
+	info.Types = []string{"standard"}
+	for _, s := range ids {
+		if strings.HasPrefix(s, lang+"-u-co-") {
+			info.Types = append(info.Types, s[len(lang+"-u-co-"):])
+		}
+	}
+	for k := range typeRules {
+		if strings.HasPrefix(k, lang+"-") {
+			info.Types = append(info.Types, k[len(lang+"-"):])
+		}
+	}
+	sort.Strings(info.Types[1:])
+	info.Type = "standard"
+	if co := t.TypeForKey("co"); co != "" {
+		for _, s := range info.Types {
+			if s == co {
+				info.Type = co
+			}
+		}
+	}
+	return info
+}
+
+// baseOf returns the language subtag of t.
+func baseOf(t language.Tag) string {
+	b, _, _ := t.Raw()
+	return b.String()
+}
+
+// parentIndex returns the index in ids of the closest parent of t that has a
+// table.  It returns 0, the index of the root table, if there is none.
+func parentIndex(ids []string, t language.Tag) int {
+	for t = t.Parent(); !t.IsRoot(); t = t.Parent() {
+		for i, s := range ids {
+			if s == t.String() {
+				return i
+			}
+		}
+	}
+	return 0
+}
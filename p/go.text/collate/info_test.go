@@ -0,0 +1,55 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"reflect"
+	"testing"
+
+	"code.google.com/p/go.text/language"
+)
+
+func TestInfo(t *testing.T) {
+	tests := []struct {
+		in        string
+		tag       string
+		inherited bool
+		types     []string
+		typ       string
+		alt       AlternateHandling
+	}{
+		{"und", "und", false, []string{"standard"}, "standard", AltNonIgnorable},
+		{"sv", "sv", false, []string{"standard"}, "standard", AltNonIgnorable},
+		{"de", "de", true, []string{"standard", "phonebk"}, "standard", AltNonIgnorable},
+		{"de-u-co-phonebk", "de", true, []string{"standard", "phonebk"}, "phonebk", AltNonIgnorable},
+		{"es", "es", false, []string{"standard", "trad"}, "standard", AltNonIgnorable},
+		{"es-u-co-trad", "es", false, []string{"standard", "trad"}, "trad", AltNonIgnorable},
+		{"zh-u-co-stroke", "zh", false, []string{"standard"}, "standard", AltNonIgnorable},
+		{"zh-u-co-pinyin", "zh", false, []string{"standard"}, "standard", AltNonIgnorable},
+		{"nl", "und", true, []string{"standard"}, "standard", AltNonIgnorable},
+		{"en-u-ka-shifted", "en", true, []string{"standard"}, "standard", AltShifted},
+	}
+	for _, tt := range tests {
+		info := Info(language.Make(tt.in))
+		if s := info.Tag.String(); s != tt.tag {
+			t.Errorf("%s: Tag was %s; want %s", tt.in, s, tt.tag)
+		}
+		if info.Inherited != tt.inherited {
+			t.Errorf("%s: Inherited was %v; want %v", tt.in, info.Inherited, tt.inherited)
+		}
+		if !reflect.DeepEqual(info.Types, tt.types) {
+			t.Errorf("%s: Types were %v; want %v", tt.in, info.Types, tt.types)
+		}
+		if info.Type != tt.typ {
+			t.Errorf("%s: Type was %q; want %q", tt.in, info.Type, tt.typ)
+		}
+		if info.Alternate != tt.alt {
+			t.Errorf("%s: Alternate was %v; want %v", tt.in, info.Alternate, tt.alt)
+		}
+		if info.Version != CLDRVersion {
+			t.Errorf("%s: Version was %q; want %q", tt.in, info.Version, CLDRVersion)
+		}
+	}
+}
@@ -17,15 +17,14 @@ import (
 	"code.google.com/p/go.text/collate"
 	"code.google.com/p/go.text/collate/build"
 	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/internal/gen"
 	"code.google.com/p/go.text/language"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
-	"net/http"
 	"os"
-	"path"
 	"regexp"
 	"sort"
 	"strconv"
@@ -44,15 +43,13 @@ file containing the file allkeys_CLDR.txt or an allkeys.txt file.`)
 		"URL of CLDR archive.")
 	test = flag.Bool("test", false,
 		"test existing tables; can be used to compare web data with package data.")
-	localFiles = flag.Bool("local", false,
-		"data files have been copied to the current directory; for debugging only.")
 	short = flag.Bool("short", false, `Use "short" alternatives, when available.`)
 	draft = flag.Bool("draft", false, `Use draft versions, when available.`)
 	tags  = flag.String("tags", "", "build tags to be included after +build directive")
 	pkg   = flag.String("package", "collate",
 		"the name of the package in which the generated file is to be included")
 
-	tables = flagStringSetAllowAll("tables", "collate", "collate,chars",
+	tables = flagStringSetAllowAll("tables", "collate", "collate,chars,index",
 		"comma-spearated list of tables to generate.")
 	exclude = flagStringSet("exclude", "zh2", "",
 		"comma-separated list of languages to exclude.")
@@ -60,6 +57,11 @@ file containing the file allkeys_CLDR.txt or an allkeys.txt file.`)
 		"comma-separated list of languages to include. Include trumps exclude.")
 	types = flagStringSetAllowAll("types", "", "",
 		"comma-separated list of types that should be included in addition to the standard type.")
+	locales = flagStringSet("locales", "", "",
+		"comma-separated list of locales, such as de or de-u-co-phonebk, to include. "+
+			"If empty, all locales selected by -include and -exclude are included.")
+	sizes = flag.Bool("sizes", false,
+		"print the number of bytes that each locale adds to the tables to stderr.")
 )
 
 // stringSet implements an ordered set based on a list.  It implements flag.Value
@@ -192,28 +194,8 @@ func failOnError(e error) {
 	}
 }
 
-// openReader opens the URL or file given by url and returns it as an io.ReadCloser
-// or nil on error.
-func openReader(url *string) (io.ReadCloser, error) {
-	if *localFiles {
-		pwd, _ := os.Getwd()
-		*url = "file://" + path.Join(pwd, path.Base(*url))
-	}
-	t := &http.Transport{}
-	t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
-	c := &http.Client{Transport: t}
-	resp, err := c.Get(*url)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf(`bad GET status for "%s": %s`, *url, resp.Status)
-	}
-	return resp.Body, nil
-}
-
-func openArchive(url *string) *zip.Reader {
-	f, err := openReader(url)
+func openArchive(url string) *zip.Reader {
+	f, err := gen.Open(url)
 	failOnError(err)
 	buffer, err := ioutil.ReadAll(f)
 	f.Close()
@@ -230,7 +212,7 @@ func parseUCA(builder *build.Builder) {
 	var r io.ReadCloser
 	var err error
 	if strings.HasSuffix(*root, ".zip") {
-		for _, f := range openArchive(root).File {
+		for _, f := range openArchive(*root).File {
 			if strings.HasSuffix(f.Name, "allkeys_CLDR.txt") {
 				r, err = f.Open()
 			}
@@ -239,7 +221,7 @@ func parseUCA(builder *build.Builder) {
 			err = fmt.Errorf("file allkeys_CLDR.txt not found in archive %q", *root)
 		}
 	} else {
-		r, err = openReader(root)
+		r, err = gen.Open(*root)
 	}
 	failOnError(err)
 	defer r.Close()
@@ -357,8 +339,32 @@ func printExemplarCharacters(w io.Writer) {
 	fmt.Fprintln(w, "}")
 }
 
+func printIndexCharacters(w io.Writer) {
+	fmt.Fprintf(w, "// indexCharacters holds the index exemplar characters defined in CLDR %s\n", cldr.Version)
+	fmt.Fprintln(w, "// for each locale. It must be generated from the same release as CLDRVersion.")
+	fmt.Fprintln(w, "var indexCharacters = map[string]string{")
+	for _, loc := range mainLocales {
+		labels := []string{}
+		for _, s := range localeChars[loc]["index"] {
+			// Format characters, such as the ZERO WIDTH JOINER used in mr,
+			// cannot serve as a label on their own.
+			if strings.TrimFunc(s, isFormat) != "" {
+				labels = append(labels, s)
+			}
+		}
+		if len(labels) != 0 {
+			fmt.Fprintf(w, "\t%q: %q,\n", loc, strings.Join(labels, " "))
+		}
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func isFormat(r rune) bool {
+	return unicode.Is(unicode.Cf, r)
+}
+
 func decodeCLDR(d *cldr.Decoder) *cldr.CLDR {
-	r, err := openReader(cldrzip)
+	r, err := gen.Open(*cldrzip)
 	failOnError(err)
 	data, err := d.DecodeZip(r)
 	failOnError(err)
@@ -466,13 +472,15 @@ func parseCollation(b *build.Builder) {
 		sl.SelectOnePerGroup("alt", altInclude())
 
 		for _, c := range cs {
-			m := make(map[language.Part]string)
-			m[language.TagPart] = loc
+			id, err := language.Parse(loc)
+			failOnError(err)
 			if c.Type != x.Collations.Default() {
-				m[language.Extension('u')] = "co-" + c.Type
+				id, err = id.SetTypeForKey("co", c.Type)
+				failOnError(err)
+			}
+			if locales.Len() > 0 && !locales.contains(id.String()) {
+				continue
 			}
-			id, err := language.Compose(m)
-			failOnError(err)
 			t := b.Tailoring(id)
 			c.Process(processor{t})
 		}
@@ -532,14 +540,41 @@ func testCollator(c *collate.Collator) {
 	fmt.Println("PASS")
 }
 
+// printSizes prints the number of bytes each locale adds to the tables,
+// largest first.
+func printSizes(b *build.Builder) {
+	s, err := b.Sizes()
+	failOnError(err)
+	total := 0
+	for _, x := range s {
+		total += x.Bytes
+	}
+	sort.Sort(bySize(s))
+	for _, x := range s {
+		fmt.Fprintf(os.Stderr, "%-20s %8d\n", x.ID, x.Bytes)
+	}
+	fmt.Fprintf(os.Stderr, "%-20s %8d\n", "total", total)
+}
+
+type bySize []build.LocaleSize
+
+func (s bySize) Len() int      { return len(s) }
+func (s bySize) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s bySize) Less(i, j int) bool {
+	if s[i].Bytes != s[j].Bytes {
+		return s[i].Bytes > s[j].Bytes
+	}
+	return s[i].ID < s[j].ID
+}
+
 func main() {
-	flag.Parse()
+	gen.Init()
 	b := build.NewBuilder()
 	if *root != "" {
 		parseUCA(b)
 	}
 	if *cldrzip != "" {
-		if tables.contains("chars") {
+		if tables.contains("chars") || tables.contains("index") {
 			parseMain()
 		}
 		parseCollation(b)
@@ -547,26 +582,39 @@ func main() {
 
 	c, err := b.Build()
 	failOnError(err)
+	if *sizes {
+		printSizes(b)
+	}
 
 	if *test {
 		testCollator(collate.NewFromTable(c))
 	} else {
-		fmt.Println("// Generated by running")
-		fmt.Printf("//  maketables -root=%s -cldr=%s\n", *root, *cldrzip)
-		fmt.Println("// DO NOT EDIT")
-		fmt.Println("// TODO: implement more compact representation for sparse blocks.")
-		if *tags != "" {
-			fmt.Printf("// +build %s\n", *tags)
-		}
-		fmt.Println("")
-		fmt.Printf("package %s\n", *pkg)
+		w := gen.NewCodeWriter()
+		w.BuildTags = *tags
+		w.WriteComment("TODO: implement more compact representation for sparse blocks.")
 		if tables.contains("collate") {
-			fmt.Println("")
-			_, err = b.Print(os.Stdout)
+			fmt.Fprintln(w, "")
+			w.WriteComment("CLDRVersion is the version of CLDR used to generate the data in this package.")
+			w.WriteConst("CLDRVersion", cldr.Version)
+			fmt.Fprintln(w, "")
+			w.WriteComment("UnicodeVersion is the version of the Unicode Collation Algorithm data used\n" +
+				"to generate the data in this package.")
+			w.WriteConst("UnicodeVersion", unicode.Version)
+			fmt.Fprintln(w, "")
+			_, err = b.Print(w)
+			failOnError(err)
+			sizes, err := b.Sizes()
 			failOnError(err)
+			for _, x := range sizes {
+				w.Size += x.Bytes
+			}
 		}
 		if tables.contains("chars") {
-			printExemplarCharacters(os.Stdout)
+			printExemplarCharacters(w)
+		}
+		if tables.contains("index") {
+			printIndexCharacters(w)
 		}
+		failOnError(w.WriteGoFile(*pkg))
 	}
 }
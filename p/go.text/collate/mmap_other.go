@@ -0,0 +1,18 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package collate
+
+import "io/ioutil"
+
+// mapFile reads the named file into memory. Memory mapping is not supported
+// on this platform.
+func mapFile(name string) (b []byte, mapped bool, err error) {
+	b, err = ioutil.ReadFile(name)
+	return b, false, err
+}
+
+func unmapFile(b []byte) {}
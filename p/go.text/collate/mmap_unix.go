@@ -0,0 +1,40 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package collate
+
+import (
+	"io/ioutil"
+	"os"
+	"syscall"
+)
+
+// mapFile maps the named file into memory for reading. It reads the file into
+// memory instead if it cannot be mapped. mapped indicates whether the file was
+// mapped.
+func mapFile(name string) (b []byte, mapped bool, err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	if size := fi.Size(); size > 0 && size == int64(int(size)) {
+		b, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+		if err == nil {
+			return b, true, nil
+		}
+	}
+	b, err = ioutil.ReadAll(f)
+	return b, false, err
+}
+
+func unmapFile(b []byte) {
+	syscall.Munmap(b)
+}
@@ -0,0 +1,273 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"io"
+
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/unicode/norm"
+)
+
+const (
+	// streamMargin is the number of bytes of lookahead guaranteed to be
+	// available when computing the collation elements for a rune, so that
+	// contractions and sequences of combining marks are matched correctly.
+	streamMargin = 2 * norm.MaxSegmentSize
+	streamChunk  = 4096
+)
+
+// CompareReader returns an integer comparing the texts read from a and b.
+// The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
+// Unlike Compare, CompareReader does not need to hold the entire texts in
+// memory and stops reading as soon as the result is known.  The memory
+// used is proportional to the length of the part for which the texts
+// differ only at a secondary or higher level, or to the length of the texts
+// if Backwards is set.  If Numeric is set, each sequence of digits is held in
+// memory in its entirety.  An error is returned if reading either text fails.
+func (c *Collator) CompareReader(a, b io.Reader) (int, error) {
+	s := [2]*stream{newStream(c, a), newStream(c, b)}
+	var levels [colltab.Identity + 1]levelComparer
+	var raw levelComparer // compares the bytes of the texts if Force is set
+	levels[colltab.Secondary].backwards = c.Backwards
+	primary := &levels[colltab.Primary]
+	for !primary.done && (!s[0].eof || !s[1].eof) {
+		// Read from the text that is behind.
+		side := 0
+		if s[0].eof || !s[1].eof && len(primary.q[0]) > len(primary.q[1]) {
+			side = 1
+		}
+		ces, in := s[side].next()
+		if s[side].err != nil {
+			return 0, s[side].err
+		}
+		c.addWeights(&levels, s[side], side, ces, s[side].it.cs)
+		if c.identity() {
+			for _, b := range s[side].nfd(in) {
+				levels[colltab.Identity].add(side, int(b)+1)
+			}
+		}
+		if c.force {
+			for _, b := range in {
+				raw.add(side, int(b)+1)
+			}
+		}
+	}
+	for _, l := range levels {
+		if res := l.result(); res != 0 {
+			return res, nil
+		}
+	}
+	return raw.result(), nil
+}
+
+// addWeights adds the weights of ces to the levels that are compared for c.
+// cs holds the case of the text for each element of ces, if it is tracked.
+func (c *Collator) addWeights(levels *[colltab.Identity + 1]levelComparer, s *stream, side int, ces []colltab.Elem, cs []uint8) {
+	tertiary := colltab.Tertiary <= c.Strength || c.CaseLevel
+	quaternary := tertiary && colltab.Quaternary <= c.Strength && c.Alternate >= AltShifted
+	for k, ce := range ces {
+		p := ce.Primary()
+		if p != 0 && c.reorder != nil {
+			p = c.primary(p)
+		}
+		levels[colltab.Primary].add(side, p)
+		if colltab.Secondary <= c.Strength {
+			levels[colltab.Secondary].add(side, ce.Secondary())
+		}
+		if tertiary && (p != 0 || !c.ignoreDiacritics) {
+			levels[colltab.Tertiary].add(side, c.tertiaryWeight(ce, caseAt(cs, k)))
+		}
+		if quaternary {
+			q := ce.Quaternary()
+			if c.Alternate == AltShiftTrimmed {
+				// Trailing maximum quaternary values are trimmed, so we hold
+				// them back until we see another value.
+				if q == colltab.MaxQuaternary {
+					s.maxQuaternary++
+					continue
+				} else if q != 0 {
+					for ; s.maxQuaternary > 0; s.maxQuaternary-- {
+						levels[colltab.Quaternary].add(side, colltab.MaxQuaternary)
+					}
+				}
+			}
+			levels[colltab.Quaternary].add(side, q)
+		}
+	}
+}
+
+// A levelComparer compares the non-zero weights of a single level as they
+// become available for two texts.
+type levelComparer struct {
+	q         [2][]int // weights not yet compared
+	res       int
+	done      bool
+	backwards bool // compare all weights in reverse order at the end
+}
+
+func (l *levelComparer) add(side, w int) {
+	if l.done || w == 0 {
+		return
+	}
+	l.q[side] = append(l.q[side], w)
+	if l.backwards {
+		return
+	}
+	for len(l.q[0]) > 0 && len(l.q[1]) > 0 {
+		a, b := l.q[0][0], l.q[1][0]
+		l.q[0], l.q[1] = l.q[0][1:], l.q[1][1:]
+		if a != b {
+			l.res, l.done = 1, true
+			if a < b {
+				l.res = -1
+			}
+			l.q[0], l.q[1] = nil, nil
+			return
+		}
+	}
+}
+
+// result returns the result of the comparison after all weights
+// have been added.
+func (l *levelComparer) result() int {
+	if l.done {
+		return l.res
+	}
+	a, b := l.q[0], l.q[1]
+	if l.backwards {
+		for i, j := len(a)-1, len(b)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+			if a[i] != b[j] {
+				if a[i] < b[j] {
+					return -1
+				}
+				return 1
+			}
+		}
+	}
+	switch {
+	case len(a) > len(b):
+		return 1
+	case len(a) < len(b):
+		return -1
+	}
+	return 0
+}
+
+// A stream computes the collation elements for text read from a Reader
+// in chunks.
+type stream struct {
+	c   *Collator
+	r   io.Reader
+	it  iter
+	buf []byte
+	n   int // number of bytes in buf
+	eof bool
+	err error
+
+	consumed  int  // number of bytes in buf processed by the last call to next
+	unbounded bool // the weigher may match contractions of any length
+
+	ce            []colltab.Elem
+	last          colltab.Elem // last element with a non-zero primary weight
+	maxQuaternary int          // number of held back maximum quaternary values
+
+	pending []byte // input after the last normalization boundary
+	nfdBuf  []byte
+}
+
+func newStream(c *Collator, r io.Reader) *stream {
+	s := &stream{c: c, r: r, buf: make([]byte, streamChunk+streamMargin)}
+	s.it.init(c)
+	s.it.t = c.weigher()
+	if ci, ok := s.it.t.(colltab.ContractionInfo); ok {
+		s.unbounded = ci.MaxContractionLen() < 0
+	}
+	s.it.caseFirst = c.caseFirst != 0
+	return s
+}
+
+// fill reads into the buffer until it is full or the input is exhausted.
+func (s *stream) fill() {
+	for !s.eof && s.n < len(s.buf) {
+		n, err := s.r.Read(s.buf[s.n:])
+		s.n += n
+		if err == io.EOF {
+			s.eof = true
+		} else if err != nil {
+			s.err, s.eof = err, true
+		}
+	}
+}
+
+// next returns the collation elements for the next chunk of input and the
+// bytes from which they were computed.  The returned slices are valid until
+// the next call to next.
+func (s *stream) next() (ces []colltab.Elem, in []byte) {
+	// Move the bytes not processed by the previous call to the front.
+	s.n = copy(s.buf, s.buf[s.consumed:s.n])
+	s.consumed = 0
+	if s.fill(); s.err != nil {
+		return nil, nil
+	}
+	limit := s.n
+	if !s.eof {
+		limit -= streamMargin
+	}
+	it := &s.it
+	for {
+		it.setInput(s.buf[:s.n])
+		for it.next() {
+			if s.n-len(it.bytes) >= limit && it.nce == len(it.ce) {
+				break
+			}
+		}
+		s.consumed = s.n - len(it.bytes)
+		// A weigher without a bound on the length of contractions, such as
+		// the one used for numeric sorting, may have cut a sequence short at
+		// the end of the buffer.  In that case, grow the buffer and retry.
+		if !s.unbounded || s.eof || s.n-s.consumed >= streamMargin {
+			break
+		}
+		s.buf = append(s.buf, make([]byte, len(s.buf))...)
+		if s.fill(); s.err != nil {
+			return nil, nil
+		}
+		if limit = s.n; !s.eof {
+			limit -= streamMargin
+		}
+	}
+	ces = it.ce
+	if s.c.Alternate != AltNonIgnorable {
+		// Prepend the last element with a primary weight of the previous
+		// chunk, as processWeights depends on it.
+		s.ce = append(append(s.ce[:0], s.last), ces...)
+		for _, ce := range ces {
+			if ce.Primary() != 0 {
+				s.last = ce
+			}
+		}
+		processWeights(s.c.Alternate, s.c.variableTop, s.ce)
+		ces = s.ce[1:]
+	}
+	return ces, s.buf[:s.consumed]
+}
+
+// nfd returns the NFD form of the input passed in successive calls up to the
+// last normalization boundary in the input seen so far.  The remaining input
+// is held back until the next call, or returned as well if the end of the
+// input was reached.  The returned slice is valid until the next call to nfd.
+func (s *stream) nfd(in []byte) []byte {
+	s.pending = append(s.pending, in...)
+	p := len(s.pending)
+	if !s.eof {
+		if p = norm.NFD.LastBoundary(s.pending); p < 0 {
+			p = 0
+		}
+	}
+	s.nfdBuf = norm.NFD.Append(s.nfdBuf[:0], s.pending[:p]...)
+	s.pending = append(s.pending[:0], s.pending[p:]...)
+	return s.nfdBuf
+}
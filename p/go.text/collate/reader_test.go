@@ -0,0 +1,69 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
+)
+
+func TestCompareReader(t *testing.T) {
+	long := strings.Repeat("abc de-f ", 1000)
+	// marks has a sequence of combining marks that crosses a chunk boundary.
+	marks := strings.Repeat("a", streamChunk-10) + strings.Repeat("\u0301\u0316", 10)
+	// digits has a sequence of digits that crosses a chunk boundary and is
+	// longer than the margin.
+	digits := strings.Repeat("x", streamChunk-6) + "9"
+	strs := []string{
+		"", "a", "A", "á", "á", "ab", "a-b", "a b", "ab-", "côte", "coté",
+		"a1", "a01", "a10", "ｃ", "ch", "ä", "ạ̈",
+		long, long + "a", long + "A", long + "b", long + "á",
+		"x" + long, "-" + long, long + long,
+		"e\u0301", "\u00E9", "a\u0316\u0301", "a\u0301\u0316", "a\u0001",
+		long + "e\u0301", long + "\u00E9", marks + "\u0316", marks + "\u0317",
+		digits + strings.Repeat("0", 1000), digits + strings.Repeat("9", 900),
+	}
+	settings := []func(c *Collator){
+		func(c *Collator) {},
+		func(c *Collator) { c.Strength = colltab.Primary },
+		func(c *Collator) { c.Strength = colltab.Secondary; c.Backwards = true },
+		func(c *Collator) { c.SetOptions(IgnoreDiacritics) },
+		func(c *Collator) { c.SetOptions(UpperFirst | Numeric) },
+		func(c *Collator) { c.Alternate = AltShifted; c.Strength = colltab.Quaternary },
+		func(c *Collator) { c.Alternate = AltShiftTrimmed; c.Strength = colltab.Quaternary },
+		func(c *Collator) { c.Alternate = AltBlanked },
+		func(c *Collator) { c.Strength = colltab.Identity },
+		func(c *Collator) { c.SetOptions(Force) },
+		func(c *Collator) { c.SetOptions(Loose | Force) },
+	}
+	for i, set := range settings {
+		c := New(language.Make("cs"))
+		set(c)
+		for _, a := range strs {
+			for _, b := range strs {
+				want := c.CompareString(a, b)
+				res, err := c.CompareReader(strings.NewReader(a), iotest.OneByteReader(strings.NewReader(b)))
+				if err != nil {
+					t.Fatalf("%d: unexpected error: %v", i, err)
+				}
+				if res != want {
+					t.Errorf("%d: CompareReader(%.20q, %.20q) = %d; want %d", i, a, b, res, want)
+				}
+			}
+		}
+	}
+}
+
+func TestCompareReaderError(t *testing.T) {
+	c := New(language.English)
+	r := iotest.TimeoutReader(strings.NewReader(strings.Repeat("a", 8000)))
+	if _, err := c.CompareReader(strings.NewReader("a"), r); err == nil {
+		t.Errorf("CompareReader did not return the read error")
+	}
+}
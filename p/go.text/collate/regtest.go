@@ -7,7 +7,6 @@
 package main
 
 import (
-	"archive/zip"
 	"bufio"
 	"bytes"
 	"flag"
@@ -22,27 +21,15 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
-	"unicode/utf8"
 
 	"code.google.com/p/go.text/collate"
 	"code.google.com/p/go.text/collate/build"
-	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/collate/tools/colcmp/conformance"
 )
 
 // This regression test runs tests for the test files in CollationTest.zip
 // (taken from http://www.unicode.org/Public/UCA/<unicode.Version>/).
-//
-// The test files have the following form:
-// # header
-// 0009 0021;	# ('\u0009') <CHARACTER TABULATION>	[| | | 0201 025E]
-// 0009 003F;	# ('\u0009') <CHARACTER TABULATION>	[| | | 0201 0263]
-// 000A 0021;	# ('\u000A') <LINE FEED (LF)>	[| | | 0202 025E]
-// 000A 003F;	# ('\u000A') <LINE FEED (LF)>	[| | | 0202 0263]
-//
-// The part before the semicolon is the hex representation of a sequence
-// of runes. After the hash mark is a comment. The strings
-// represented by rune sequence are in the file in sorted order, as
-// defined by the DUCET.
+// See package conformance for the format of the test files.
 
 var testdata = flag.String("testdata",
 	"http://www.unicode.org/Public/UCA/"+unicode.Version+"/CollationTest.zip",
@@ -54,15 +41,6 @@ var localFiles = flag.Bool("local",
 	false,
 	"data files have been copied to the current directory; for debugging only")
 
-type Test struct {
-	name    string
-	str     [][]byte
-	comment []string
-}
-
-var versionRe = regexp.MustCompile(`# UCA Version: (.*)\n?$`)
-var testRe = regexp.MustCompile(`^([\dA-F ]+);.*# (.*)\n?$`)
-
 func Error(e error) {
 	if e != nil {
 		log.Fatal(e)
@@ -151,117 +129,37 @@ func convHex(line int, s string) int {
 	return int(r)
 }
 
-func loadTestData() []Test {
+func loadTestData() []*conformance.UCATest {
 	f := openReader(*testdata)
 	buffer, err := ioutil.ReadAll(f)
 	f.Close()
 	Error(err)
-	archive, err := zip.NewReader(bytes.NewReader(buffer), int64(len(buffer)))
+	tests, err := conformance.ParseUCATestZip(bytes.NewReader(buffer), int64(len(buffer)))
 	Error(err)
-	tests := []Test{}
-	for _, f := range archive.File {
-		// Skip the short versions, which are simply duplicates of the long versions.
-		if strings.Contains(f.Name, "SHORT") || f.FileInfo().IsDir() {
-			continue
-		}
-		ff, err := f.Open()
-		Error(err)
-		defer ff.Close()
-		scanner := bufio.NewScanner(ff)
-		test := Test{name: path.Base(f.Name)}
-		for scanner.Scan() {
-			line := scanner.Text()
-			if len(line) <= 1 || line[0] == '#' {
-				if m := versionRe.FindStringSubmatch(line); m != nil {
-					if m[1] != unicode.Version {
-						log.Printf("warning:%s: version is %s; want %s", f.Name, m[1], unicode.Version)
-					}
-				}
-				continue
-			}
-			m := testRe.FindStringSubmatch(line)
-			if m == nil || len(m) < 3 {
-				log.Fatalf(`Failed to parse: "%s" result: %#v`, line, m)
-			}
-			str := []byte{}
-			// In the regression test data (unpaired) surrogates are assigned a weight
-			// corresponding to their code point value.  However, utf8.DecodeRune,
-			// which is used to compute the implicit weight, assigns FFFD to surrogates.
-			// We therefore skip tests with surrogates.  This skips about 35 entries
-			// per test.
-			valid := true
-			for _, split := range strings.Split(m[1], " ") {
-				r, err := strconv.ParseUint(split, 16, 64)
-				Error(err)
-				valid = valid && utf8.ValidRune(rune(r))
-				str = append(str, string(rune(r))...)
-			}
-			if valid {
-				test.str = append(test.str, str)
-				test.comment = append(test.comment, m[2])
-			}
-		}
-		if scanner.Err() != nil {
-			log.Fatal(scanner.Err())
+	for _, t := range tests {
+		if t.Version != "" && t.Version != unicode.Version {
+			log.Printf("warning:%s: version is %s; want %s", t.Name, t.Version, unicode.Version)
 		}
-		tests = append(tests, test)
 	}
 	return tests
 }
 
-var errorCount int
-
-func fail(t Test, pattern string, args ...interface{}) {
-	format := fmt.Sprintf("error:%s:%s", t.name, pattern)
-	log.Printf(format, args...)
-	errorCount++
-	if errorCount > 30 {
-		log.Fatal("too many errors")
-	}
-}
-
-func runes(b []byte) []rune {
-	return []rune(string(b))
-}
-
-func doTest(t Test) {
+func main() {
+	flag.Parse()
 	bld := build.NewBuilder()
 	parseUCA(bld)
 	w, err := bld.Build()
 	Error(err)
 	c := collate.NewFromTable(w)
-	c.Strength = colltab.Quaternary
-	c.Alternate = collate.AltShifted
-	b := &collate.Buffer{}
-	if strings.Contains(t.name, "NON_IGNOR") {
-		c.Strength = colltab.Tertiary
-		c.Alternate = collate.AltNonIgnorable
-	}
-	prev := t.str[0]
-	for i := 1; i < len(t.str); i++ {
-		b.Reset()
-		s := t.str[i]
-		ka := c.Key(b, prev)
-		kb := c.Key(b, s)
-		if r := bytes.Compare(ka, kb); r == 1 {
-			fail(t, "%d: Key(%.4X) < Key(%.4X) (%X < %X) == %d; want -1 or 0", i, []rune(string(prev)), []rune(string(s)), ka, kb, r)
-			prev = s
-			continue
-		}
-		if r := c.Compare(prev, s); r == 1 {
-			fail(t, "%d: Compare(%.4X, %.4X) == %d; want -1 or 0", i, runes(prev), runes(s), r)
-		}
-		if r := c.Compare(s, prev); r == -1 {
-			fail(t, "%d: Compare(%.4X, %.4X) == %d; want 1 or 0", i, runes(s), runes(prev), r)
-		}
-		prev = s
-	}
-}
-
-func main() {
-	flag.Parse()
+	errorCount := 0
 	for _, test := range loadTestData() {
-		doTest(test)
+		res := test.Check(c)
+		for _, f := range res.Failures {
+			log.Printf("error:%s:%d: %s(%.4X, %.4X) == %d; want %d", test.Name, f.Index, f.Msg, []rune(string(f.A)), []rune(string(f.B)), f.Got, f.Want)
+			if errorCount++; errorCount > 30 {
+				log.Fatal("too many errors")
+			}
+		}
 	}
 	if errorCount == 0 {
 		fmt.Println("PASS")
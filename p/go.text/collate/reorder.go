@@ -0,0 +1,232 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"code.google.com/p/go.text/collate/colltab"
+)
+
+// scripts maps ISO 15924 script codes to the corresponding Unicode scripts.
+var scripts = map[string][]*unicode.RangeTable{
+	"arab": {unicode.Arabic},
+	"armi": {unicode.Imperial_Aramaic},
+	"armn": {unicode.Armenian},
+	"avst": {unicode.Avestan},
+	"bali": {unicode.Balinese},
+	"bamu": {unicode.Bamum},
+	"batk": {unicode.Batak},
+	"beng": {unicode.Bengali},
+	"bopo": {unicode.Bopomofo},
+	"brah": {unicode.Brahmi},
+	"brai": {unicode.Braille},
+	"bugi": {unicode.Buginese},
+	"buhd": {unicode.Buhid},
+	"cakm": {unicode.Chakma},
+	"cans": {unicode.Canadian_Aboriginal},
+	"cari": {unicode.Carian},
+	"cham": {unicode.Cham},
+	"cher": {unicode.Cherokee},
+	"copt": {unicode.Coptic},
+	"cprt": {unicode.Cypriot},
+	"cyrl": {unicode.Cyrillic},
+	"deva": {unicode.Devanagari},
+	"dsrt": {unicode.Deseret},
+	"egyp": {unicode.Egyptian_Hieroglyphs},
+	"ethi": {unicode.Ethiopic},
+	"geor": {unicode.Georgian},
+	"glag": {unicode.Glagolitic},
+	"goth": {unicode.Gothic},
+	"grek": {unicode.Greek},
+	"gujr": {unicode.Gujarati},
+	"guru": {unicode.Gurmukhi},
+	"hang": {unicode.Hangul},
+	"hani": {unicode.Han},
+	"hano": {unicode.Hanunoo},
+	"hebr": {unicode.Hebrew},
+	"hira": {unicode.Hiragana},
+	"hrkt": {unicode.Hiragana, unicode.Katakana},
+	"ital": {unicode.Old_Italic},
+	"java": {unicode.Javanese},
+	"kali": {unicode.Kayah_Li},
+	"kana": {unicode.Katakana},
+	"khar": {unicode.Kharoshthi},
+	"khmr": {unicode.Khmer},
+	"knda": {unicode.Kannada},
+	"kthi": {unicode.Kaithi},
+	"lana": {unicode.Tai_Tham},
+	"laoo": {unicode.Lao},
+	"latn": {unicode.Latin},
+	"lepc": {unicode.Lepcha},
+	"limb": {unicode.Limbu},
+	"linb": {unicode.Linear_B},
+	"lisu": {unicode.Lisu},
+	"lyci": {unicode.Lycian},
+	"lydi": {unicode.Lydian},
+	"mand": {unicode.Mandaic},
+	"merc": {unicode.Meroitic_Cursive},
+	"mero": {unicode.Meroitic_Hieroglyphs},
+	"mlym": {unicode.Malayalam},
+	"mong": {unicode.Mongolian},
+	"mtei": {unicode.Meetei_Mayek},
+	"mymr": {unicode.Myanmar},
+	"nkoo": {unicode.Nko},
+	"ogam": {unicode.Ogham},
+	"olck": {unicode.Ol_Chiki},
+	"orkh": {unicode.Old_Turkic},
+	"orya": {unicode.Oriya},
+	"osma": {unicode.Osmanya},
+	"phag": {unicode.Phags_Pa},
+	"phli": {unicode.Inscriptional_Pahlavi},
+	"phnx": {unicode.Phoenician},
+	"plrd": {unicode.Miao},
+	"prti": {unicode.Inscriptional_Parthian},
+	"rjng": {unicode.Rejang},
+	"runr": {unicode.Runic},
+	"samr": {unicode.Samaritan},
+	"sarb": {unicode.Old_South_Arabian},
+	"saur": {unicode.Saurashtra},
+	"shaw": {unicode.Shavian},
+	"shrd": {unicode.Sharada},
+	"sinh": {unicode.Sinhala},
+	"sora": {unicode.Sora_Sompeng},
+	"sund": {unicode.Sundanese},
+	"sylo": {unicode.Syloti_Nagri},
+	"syrc": {unicode.Syriac},
+	"tagb": {unicode.Tagbanwa},
+	"takr": {unicode.Takri},
+	"tale": {unicode.Tai_Le},
+	"talu": {unicode.New_Tai_Lue},
+	"taml": {unicode.Tamil},
+	"tavt": {unicode.Tai_Viet},
+	"telu": {unicode.Telugu},
+	"tfng": {unicode.Tifinagh},
+	"tglg": {unicode.Tagalog},
+	"thaa": {unicode.Thaana},
+	"thai": {unicode.Thai},
+	"tibt": {unicode.Tibetan},
+	"ugar": {unicode.Ugaritic},
+	"vaii": {unicode.Vai},
+	"xpeo": {unicode.Old_Persian},
+	"xsux": {unicode.Cuneiform},
+	"yiii": {unicode.Yi},
+}
+
+// A reorderRange maps the primary weights in [lo, hi] to [lo+delta, hi+delta].
+type reorderRange struct {
+	lo, hi, delta int
+}
+
+type byLo []reorderRange
+
+func (s byLo) Len() int           { return len(s) }
+func (s byLo) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byLo) Less(i, j int) bool { return s[i].lo < s[j].lo }
+
+// SetReorder changes the order of scripts at the primary level.  The letters
+// of the given scripts are sorted before those of all other scripts, in the
+// order given.  Scripts are identified by their ISO 15924 code, such as
+// "Latn" or "Cyrl".  Hrkt may be used to denote both Hiragana and Katakana.
+// The order of characters that do not belong to a script, such as digits and
+// punctuation, is not affected.  Calling SetReorder without arguments
+// restores the default order.
+func (c *Collator) SetReorder(codes ...string) error {
+	var groups []reorderRange
+	for _, code := range codes {
+		rts, ok := scripts[strings.ToLower(code)]
+		if !ok {
+			return fmt.Errorf("collate: unsupported script %q", code)
+		}
+		g, found := reorderRange{}, false
+		for _, rt := range rts {
+			if lo, hi, ok := colltab.PrimaryRange(c.t, rt); ok {
+				if !found || lo < g.lo {
+					g.lo = lo
+				}
+				if !found || hi > g.hi {
+					g.hi = hi
+				}
+				found = true
+			}
+		}
+		if found {
+			groups = addGroup(groups, g)
+		}
+	}
+	c.reorder = nil
+	if len(groups) == 0 {
+		return nil
+	}
+	// The groups are moved to the start of the first script in the table,
+	// which is Latin for the DUCET.
+	start, end := groups[0].lo, groups[0].hi
+	if lo, _, ok := colltab.PrimaryRange(c.t, unicode.Latin); ok {
+		start = lo
+	}
+	for _, g := range groups {
+		if g.lo < start {
+			start = g.lo
+		}
+		if g.hi > end {
+			end = g.hi
+		}
+	}
+	// The weights in between the groups follow them in their original order.
+	sorted := append([]reorderRange(nil), groups...)
+	sort.Sort(byLo(sorted))
+	p := start
+	for _, g := range sorted {
+		if p < g.lo {
+			groups = append(groups, reorderRange{lo: p, hi: g.lo - 1})
+		}
+		p = g.hi + 1
+	}
+	p = start
+	for i, g := range groups {
+		groups[i].delta = p - g.lo
+		p += g.hi - g.lo + 1
+	}
+	sort.Sort(byLo(groups))
+	c.reorder = groups
+	return nil
+}
+
+// addGroup adds g to groups.  Scripts that share primary weights are moved
+// together, so overlapping groups are merged into the earliest one.
+func addGroup(groups []reorderRange, g reorderRange) []reorderRange {
+	groups = append(groups, g)
+merge:
+	for i := range groups {
+		for j := i + 1; j < len(groups); j++ {
+			a, b := &groups[i], groups[j]
+			if a.lo <= b.hi && b.lo <= a.hi {
+				if b.lo < a.lo {
+					a.lo = b.lo
+				}
+				if b.hi > a.hi {
+					a.hi = b.hi
+				}
+				groups = append(groups[:j], groups[j+1:]...)
+				goto merge
+			}
+		}
+	}
+	return groups
+}
+
+// primary returns the primary weight p after reordering.
+func (c *Collator) primary(p int) int {
+	i := sort.Search(len(c.reorder), func(i int) bool {
+		return p <= c.reorder[i].hi
+	})
+	if i < len(c.reorder) && c.reorder[i].lo <= p {
+		return p + c.reorder[i].delta
+	}
+	return p
+}
@@ -0,0 +1,412 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"code.google.com/p/go.text/collate/build"
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
+)
+
+// NewFromRules returns a new Collator that tailors the collation of the
+// given base language using rules, which are specified in the basic
+// tailoring syntax of CLDR.  For example, the rules
+//      &z < æ < ø << ö < å
+// sort "æ", "ø" and "å" after "z", as in Danish, where "ö" is a secondary
+// variant of "ø".
+// Rules may also contain settings, such as [strength 2], [alternate shifted],
+// [backwards 2], [caseLevel on], [caseFirst upper], [numericOrdering on],
+// [maxVariable symbol] and [reorder Grek Latn].
+// See http://www.unicode.org/reports/tr35/tr35-collation.html#Rules for
+// details.
+//
+// NewFromRules rebuilds the collation tables at run time, which is slow and
+// uses a lot of memory.  The returned Collator should be reused.
+func NewFromRules(base language.Tag, rules string) (*Collator, error) {
+	w, settings, err := tailor(base, rules)
+	if err != nil {
+		return nil, err
+	}
+	c := NewFromTable(w)
+	c.SetFromTag(base)
+	for _, f := range settings {
+		if err := f(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// tailor builds the table that results from applying rules to the table
+// of base.  It returns the table and the settings specified in rules.
+func tailor(base language.Tag, rules string) (colltab.Weigher, []func(c *Collator) error, error) {
+	b := build.NewBuilder()
+	if err := b.AddTable(New(base).t); err != nil {
+		return nil, nil, err
+	}
+	p := ruleParser{s: rules, t: b.Tailoring(base)}
+	if err := p.parse(); err != nil {
+		return nil, nil, err
+	}
+	w, err := p.t.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	return w, p.settings, nil
+}
+
+// ruleParser drives a build.Tailoring from rules in the basic syntax of
+// CLDR tailorings.
+type ruleParser struct {
+	s        string // remaining input
+	t        *build.Tailoring
+	settings []func(c *Collator) error
+}
+
+// logicalAnchors lists the special reset positions supported by
+// build.Tailoring.
+var logicalAnchors = map[string]bool{
+	"first tertiary ignorable": true,
+	"last tertiary ignorable":  true,
+	"last primary ignorable":   true,
+	"last non ignorable":       true,
+}
+
+func (p *ruleParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("collate: "+format, args...)
+}
+
+func (p *ruleParser) parse() error {
+	for p.skipSpace(); p.s != ""; p.skipSpace() {
+		var err error
+		switch p.s[0] {
+		case '[':
+			var opt string
+			if opt, err = p.bracket(); err == nil {
+				err = p.setting(opt)
+			}
+		case '&':
+			p.s = p.s[1:]
+			err = p.reset()
+		case '<', '=':
+			err = p.relation()
+		default:
+			r, _ := utf8.DecodeRuneInString(p.s)
+			err = p.errorf("unexpected %q in rules", r)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipSpace skips white space and comments.
+func (p *ruleParser) skipSpace() {
+	for p.s != "" {
+		r, sz := utf8.DecodeRuneInString(p.s)
+		switch {
+		case r == '#':
+			if i := strings.IndexByte(p.s, '\n'); i >= 0 {
+				sz = i + 1
+			} else {
+				sz = len(p.s)
+			}
+		case !isSpace(r):
+			return
+		}
+		p.s = p.s[sz:]
+	}
+}
+
+func isSpace(r rune) bool {
+	switch r {
+	case ' ', '\t', '\n', '\r', '\f', '\v', 0x85, 0x200E, 0x200F, 0x2028, 0x2029:
+		return true
+	}
+	return false
+}
+
+// bracket returns the contents of the bracketed expression at the start of
+// the input.
+func (p *ruleParser) bracket() (string, error) {
+	i := strings.IndexByte(p.s, ']')
+	if i == -1 {
+		return "", p.errorf("missing ']' in %q", p.s)
+	}
+	s := strings.TrimSpace(p.s[1:i])
+	p.s = p.s[i+1:]
+	return s, nil
+}
+
+// setting records the setting opt for the Collator that is to be built.
+func (p *ruleParser) setting(opt string) error {
+	f := strings.Fields(opt)
+	if len(f) < 2 {
+		return p.errorf("invalid setting [%s]", opt)
+	}
+	key, args := f[0], f[1:]
+	on := args[0] == "on"
+	switch key {
+	case "caseLevel", "numericOrdering", "hiraganaQ", "normalization":
+		if !on && args[0] != "off" {
+			return p.errorf("invalid value %q for %s", args[0], key)
+		}
+	}
+	var set func(c *Collator) error
+	switch key {
+	case "strength":
+		var s colltab.Level
+		switch args[0] {
+		case "1", "2", "3", "4":
+			s = colltab.Level(args[0][0] - '1')
+		case "I":
+			s = colltab.Identity
+		default:
+			return p.errorf("invalid strength %q", args[0])
+		}
+		set = func(c *Collator) error { c.Strength = s; return nil }
+	case "alternate":
+		var a AlternateHandling
+		switch args[0] {
+		case "non-ignorable":
+			a = AltNonIgnorable
+		case "shifted":
+			a = AltShifted
+		default:
+			return p.errorf("invalid value %q for alternate", args[0])
+		}
+		set = func(c *Collator) error { c.Alternate = a; return nil }
+	case "backwards":
+		if args[0] != "2" {
+			return p.errorf("backwards is only supported for level 2")
+		}
+		set = func(c *Collator) error { c.Backwards = true; return nil }
+	case "caseLevel":
+		set = func(c *Collator) error { c.CaseLevel = on; return nil }
+	case "caseFirst":
+		var o Option
+		switch args[0] {
+		case "upper":
+			o = UpperFirst
+		case "lower":
+			o = LowerFirst
+		case "off":
+		default:
+			return p.errorf("invalid value %q for caseFirst", args[0])
+		}
+		set = func(c *Collator) error { c.caseFirst = o; return nil }
+	case "numericOrdering":
+		set = func(c *Collator) error { c.Numeric = on; return nil }
+	case "hiraganaQ":
+		set = func(c *Collator) error { c.HiraganaQuaternary = on; return nil }
+	case "normalization":
+		// Input is always normalized.
+		return nil
+	case "maxVariable":
+		m, ok := maxVariables[args[0]]
+		if !ok {
+			return p.errorf("invalid value %q for maxVariable", args[0])
+		}
+		set = func(c *Collator) error { c.SetMaxVariable(m); return nil }
+	case "reorder":
+		for _, code := range args {
+			if _, ok := scripts[strings.ToLower(code)]; !ok {
+				return p.errorf("unsupported script %q", code)
+			}
+		}
+		set = func(c *Collator) error { return c.SetReorder(args...) }
+	default:
+		return p.errorf("unsupported setting [%s]", opt)
+	}
+	p.settings = append(p.settings, set)
+	return nil
+}
+
+// reset parses a reset, which is the part of a rule following '&'.
+func (p *ruleParser) reset() error {
+	p.skipSpace()
+	before := false
+	if strings.HasPrefix(p.s, "[before") {
+		opt, err := p.bracket()
+		if err != nil {
+			return err
+		}
+		switch strings.TrimSpace(opt[len("before"):]) {
+		case "1", "2", "3":
+		default:
+			return p.errorf("invalid reset [%s]", opt)
+		}
+		before = true
+		p.skipSpace()
+	}
+	var anchor string
+	if strings.HasPrefix(p.s, "[") {
+		opt, err := p.bracket()
+		if err != nil {
+			return err
+		}
+		if !logicalAnchors[opt] {
+			return p.errorf("unsupported reset position [%s]", opt)
+		}
+		anchor = "[" + opt + "]"
+	} else {
+		var err error
+		if anchor, err = p.str(); err != nil {
+			return err
+		}
+	}
+	if before {
+		return p.t.SetAnchorBefore(anchor)
+	}
+	return p.t.SetAnchor(anchor)
+}
+
+// relation parses a relation, such as "< a", "<<* abc", or "<<< a|b/c".
+func (p *ruleParser) relation() error {
+	var level colltab.Level
+	if p.s[0] == '=' {
+		p.s = p.s[1:]
+		level = colltab.Identity
+	} else {
+		n := 0
+		for ; n < len(p.s) && p.s[n] == '<'; n++ {
+		}
+		if n > 4 {
+			return p.errorf("invalid relation %q", p.s[:n])
+		}
+		p.s = p.s[n:]
+		level = colltab.Level(n - 1)
+	}
+	if strings.HasPrefix(p.s, "*") {
+		p.s = p.s[1:]
+		return p.starred(level)
+	}
+	p.skipSpace()
+	s, err := p.str()
+	if err != nil {
+		return err
+	}
+	var context, extend string
+	if p.skipSpace(); strings.HasPrefix(p.s, "|") {
+		p.s = p.s[1:]
+		p.skipSpace()
+		context = s
+		if s, err = p.str(); err != nil {
+			return err
+		}
+		p.skipSpace()
+	}
+	if strings.HasPrefix(p.s, "/") {
+		p.s = p.s[1:]
+		p.skipSpace()
+		if extend, err = p.str(); err != nil {
+			return err
+		}
+	}
+	return p.t.Insert(level, context+s, context+extend)
+}
+
+// starred inserts each of the runes of the next string at the given level.
+// A '-' between two runes denotes the inclusive range of runes between them.
+func (p *ruleParser) starred(level colltab.Level) error {
+	p.skipSpace()
+	s, err := p.str()
+	if err != nil {
+		return err
+	}
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		lo, hi := runes[i], runes[i]
+		if i+2 < len(runes) && runes[i+1] == '-' {
+			hi = runes[i+2]
+			i += 2
+		}
+		if hi < lo {
+			return p.errorf("invalid range %q-%q", lo, hi)
+		}
+		for r := lo; r <= hi; r++ {
+			if err := p.t.Insert(level, string(r), ""); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// str parses a non-empty string.  Syntax characters and white space need to
+// be quoted or escaped to be part of the string.
+func (p *ruleParser) str() (string, error) {
+	var buf []byte
+	for p.s != "" {
+		r, sz := utf8.DecodeRuneInString(p.s)
+		if isSpace(r) || strings.ContainsRune("&<=|/[]#*", r) {
+			break
+		}
+		switch r {
+		case '\'':
+			i := strings.IndexByte(p.s[1:], '\'')
+			if i == -1 {
+				return "", p.errorf("unterminated quote in %q", p.s)
+			}
+			if i == 0 {
+				buf = append(buf, '\'')
+			} else {
+				buf = append(buf, p.s[1:i+1]...)
+			}
+			sz = i + 2
+		case '\\':
+			var err error
+			if r, sz, err = p.escape(); err != nil {
+				return "", err
+			}
+			var b [utf8.UTFMax]byte
+			buf = append(buf, b[:utf8.EncodeRune(b[:], r)]...)
+		default:
+			buf = append(buf, p.s[:sz]...)
+		}
+		p.s = p.s[sz:]
+	}
+	if len(buf) == 0 {
+		return "", p.errorf("missing string in rules at %q", p.s)
+	}
+	return string(buf), nil
+}
+
+// escape decodes the backslash escape at the start of the input.  It returns
+// the decoded rune and the size of the escape sequence.
+func (p *ruleParser) escape() (r rune, sz int, err error) {
+	if len(p.s) < 2 {
+		return 0, 0, p.errorf("invalid escape sequence at end of rules")
+	}
+	var hex string
+	switch p.s[1] {
+	case 'u':
+		if len(p.s) >= 6 {
+			hex, sz = p.s[2:6], 6
+		}
+	case 'U':
+		if len(p.s) >= 10 {
+			hex, sz = p.s[2:10], 10
+		}
+	case 'x':
+		if i := strings.IndexByte(p.s, '}'); strings.HasPrefix(p.s[2:], "{") && i > 3 {
+			hex, sz = p.s[3:i], i+1
+		}
+	default:
+		r, sz = utf8.DecodeRuneInString(p.s[1:])
+		return r, sz + 1, nil
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || !utf8.ValidRune(rune(v)) {
+		return 0, 0, p.errorf("invalid escape sequence in %q", p.s)
+	}
+	return rune(v), sz, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"testing"
+
+	"code.google.com/p/go.text/language"
+)
+
+func TestNewFromRules(t *testing.T) {
+	c, err := NewFromRules(language.English, `
+		# Danish-like ordering.
+		&z < æ <<< Æ < ø << ö < å
+		&[before 1]a < '!'
+		&c = k
+		[strength 2]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		a, b string
+		res  int
+	}{
+		{"z", "æ", -1},
+		{"æ", "Æ", 0},
+		{"æ", "ø", -1},
+		{"ø", "ö", -1},
+		{"ö", "å", -1},
+		{"zz", "å", -1},
+		{"b", "!", 1},
+		{"!", "a", -1},
+		{"ca", "ka", 0},
+		{"a", "b", -1},
+	}
+	for i, tt := range tests {
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d: Compare(%q, %q) = %d; want %d", i, tt.a, tt.b, res, tt.res)
+		}
+	}
+}
+
+func TestNewFromRulesError(t *testing.T) {
+	for _, rules := range []string{
+		"< a",
+		"&a <",
+		"&a <<<<< b",
+		"&[first variable] < a",
+		"[strength 7]",
+		"[unknown on]",
+		"&a < 'b",
+		`&a < \u12`,
+		"&a ? b",
+	} {
+		if _, err := NewFromRules(language.English, rules); err == nil {
+			t.Errorf("%q: expected error", rules)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import "code.google.com/p/go.text/collate/colltab"
+
+// Index returns the byte offset of the first match of pat in b, or -1 if
+// pat does not occur in b.  A match is a substring of b that is equal to pat
+// under the settings of c.  For example, if c ignores diacritical marks, "o"
+// matches "ö".  A match starts and ends on a character boundary. A character
+// followed by a combining mark that is not ignored by c does not match.
+func (c *Collator) Index(b, pat []byte) int {
+	return c.index(c.iter(0).setInput(b), c.iter(1).setInput(pat))
+}
+
+// IndexString returns the byte offset of the first match of pat in s, or -1
+// if pat does not occur in s.  See Index for details.
+func (c *Collator) IndexString(s, pat string) int {
+	return c.index(c.iter(0).setInputString(s), c.iter(1).setInputString(pat))
+}
+
+// IndexRange returns the byte range [start, end) of the first match of pat
+// in b, or -1, -1 if pat does not occur in b.  The range covers all
+// characters of b that correspond to the match, including combining marks
+// that are ignored and characters that expand to multiple collation elements.
+// For example, if the Loose options are set, "ae" matches the entire "æ"
+// and, if c ignores diacritical marks, "o" matches "o\u0308", the decomposed
+// form of "ö".  At the default settings, "ae" does not match "æ", as the two
+// differ at the tertiary level.  See Index for details.
+func (c *Collator) IndexRange(b, pat []byte) (start, end int) {
+	return c.indexRange(c.iter(0).setInput(b), c.iter(1).setInput(pat))
+}
+
+// IndexRangeString returns the byte range [start, end) of the first match
+// of pat in s, or -1, -1 if pat does not occur in s.  See IndexRange for
+// details.
+func (c *Collator) IndexRangeString(s, pat string) (start, end int) {
+	return c.indexRange(c.iter(0).setInputString(s), c.iter(1).setInputString(pat))
+}
+
+// Contains reports whether pat matches a substring of b.
+func (c *Collator) Contains(b, pat []byte) bool {
+	return c.Index(b, pat) != -1
+}
+
+// ContainsString reports whether pat matches a substring of s.
+func (c *Collator) ContainsString(s, pat string) bool {
+	return c.IndexString(s, pat) != -1
+}
+
+// weights holds the weights of a collation element that are relevant for
+// matching.
+type weights [3]int
+
+// matcher computes the weights of collation elements that are used for
+// matching.
+type matcher struct {
+	c *Collator
+
+	// afterVariable is set if the last element with a primary weight was a
+	// variable.
+	afterVariable bool
+}
+
+// weights returns the weights of ce that are compared for the current
+// settings.  ok is false if ce is ignored.
+func (m *matcher) weights(ce colltab.Elem) (w weights, ok bool) {
+	c := m.c
+	p := ce.Primary()
+	if c.Alternate != AltNonIgnorable {
+		if p != 0 && p <= int(c.variableTop) {
+			m.afterVariable = true
+			return w, false
+		} else if p != 0 {
+			m.afterVariable = false
+		} else if m.afterVariable {
+			return w, false
+		}
+	}
+	w[0] = p
+	if colltab.Secondary <= c.Strength {
+		w[1] = ce.Secondary()
+	}
+	if colltab.Tertiary <= c.Strength || c.CaseLevel {
+		if t := ce.Tertiary(); t != 0 && !(c.ignoreDiacritics && p == 0) {
+			if c.tertiary != nil {
+				t = c.tertiary[t]
+			}
+			w[2] = int(t)
+		}
+	}
+	return w, w != weights{}
+}
+
+// index returns the position of the first match of the input of pat in the
+// input of text.
+func (c *Collator) index(text, pat *iter) int {
+	start, _ := c.indexRange(text, pat)
+	return start
+}
+
+// indexRange returns the range of the first match of the input of pat in
+// the input of text.
+func (c *Collator) indexRange(text, pat *iter) (start, end int) {
+	m := matcher{c: c}
+	var pw []weights
+	for !pat.done() {
+		pat.nextUnit()
+		for _, ce := range pat.ce {
+			if w, ok := m.weights(ce); ok {
+				pw = append(pw, w)
+			}
+		}
+	}
+	if len(pw) == 0 {
+		return 0, 0
+	}
+	for start := 0; !text.done(); start += text.nextUnit() {
+		if n, ok := c.match(text, pw); ok {
+			return start, start + n
+		}
+	}
+	return -1, -1
+}
+
+// match reports whether the input of it starts with a match for pw and
+// returns the number of bytes of the match.  It does not consume any input.
+func (c *Collator) match(it *iter, pw []weights) (n int, ok bool) {
+	bytes, str := it.bytes, it.str
+	size := len(bytes) + len(str)
+	defer func() {
+		it.bytes, it.str = bytes, str
+	}()
+	m := matcher{c: c}
+	for k := 0; k < len(pw); {
+		if it.done() {
+			return 0, false
+		}
+		first := len(it.bytes) == len(bytes) && len(it.str) == len(str)
+		it.nextUnit()
+		if first && (len(it.ce) == 0 || it.ce[0].CCC() != 0) {
+			// Don't start a match at a combining mark.
+			return 0, false
+		}
+		for _, ce := range it.ce {
+			w, ok := m.weights(ce)
+			if !ok {
+				continue
+			}
+			if k == len(pw) || w != pw[k] {
+				return 0, false
+			}
+			k++
+		}
+		if first && k == 0 {
+			// Don't start a match with an ignored character.
+			return 0, false
+		}
+	}
+	// The match must not be followed by a combining mark that is not
+	// ignored.  Ignored marks are included in the match.
+	n = size - it.len()
+	for !it.done() {
+		it.nextUnit()
+		if len(it.ce) > 0 && it.ce[0].CCC() == 0 {
+			break
+		}
+		for _, ce := range it.ce {
+			if _, ok := m.weights(ce); ok {
+				return 0, false
+			}
+		}
+		n = size - it.len()
+	}
+	return n, true
+}
+
+// nextUnit sets i.ce to the elements of the next character or contraction of
+// the input and consumes it.  It returns the number of bytes consumed.
+func (i *iter) nextUnit() int {
+	i.ce = i.ce[:0]
+	i.cs = i.cs[:0]
+	n := i.appendNext()
+	i.tail(n)
+	return n
+}
@@ -0,0 +1,96 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"testing"
+
+	"code.google.com/p/go.text/language"
+)
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		opt    Option
+		alt    AlternateHandling
+		s, pat string
+		index  int
+	}{
+		{0, 0, "Björn", "o", -1},
+		{0, 0, "Björn", "ö", 2},
+		{0, 0, "Bjo\u0308rn", "ö", 2},
+		{0, 0, "Bjo\u0308rn", "o", -1},
+		{0, 0, "Björn", "", 0},
+		{0, 0, "", "a", -1},
+		{0, 0, "Hello World", "world", -1},
+		{IgnoreCase, 0, "Hello World", "world", 6},
+		{IgnoreCase, 0, "Hello World", "WORLDS", -1},
+		{IgnoreDiacritics, 0, "Björn", "o", 2},
+		{IgnoreDiacritics, 0, "Bjo\u0308rn", "o", 2},
+		{IgnoreDiacritics, 0, "Björn", "O", -1},
+		{Loose, 0, "Björn", "O", 2},
+		{Loose, 0, "ＢＪＯＲＮ", "jö", 3},
+		{IgnoreDiacritics, 0, "\u0301a", "a", 2},
+		{0, 0, "a\u0301b", "\u0301", -1},
+		{0, 0, "co-op", "coop", -1},
+		{0, AltShifted, "co-op", "coop", 0},
+		{0, AltShifted, "a coop", "coop", 2},
+		{0, AltShifted, "a -coop", "coop", 3},
+	}
+	c := New(language.English)
+	for i, tt := range tests {
+		c.SetOptions(tt.opt)
+		c.Alternate = tt.alt
+		if x := c.IndexString(tt.s, tt.pat); x != tt.index {
+			t.Errorf("%d: IndexString(%q, %q) = %d; want %d", i, tt.s, tt.pat, x, tt.index)
+		}
+		if x := c.Index([]byte(tt.s), []byte(tt.pat)); x != tt.index {
+			t.Errorf("%d: Index(%q, %q) = %d; want %d", i, tt.s, tt.pat, x, tt.index)
+		}
+		if ok := c.ContainsString(tt.s, tt.pat); ok != (tt.index != -1) {
+			t.Errorf("%d: ContainsString(%q, %q) = %v; want %v", i, tt.s, tt.pat, ok, !ok)
+		}
+	}
+}
+
+func TestIndexRange(t *testing.T) {
+	tests := []struct {
+		opt        Option
+		alt        AlternateHandling
+		s, pat     string
+		start, end int
+	}{
+		{0, 0, "Björn", "ö", 2, 4},
+		{0, 0, "Bjo\u0308rn", "ö", 2, 5},
+		{0, 0, "Björn", "o", -1, -1},
+		{0, 0, "Björn", "", 0, 0},
+		{0, 0, "", "a", -1, -1},
+		{0, 0, "Encyclopædia", "æ", 8, 10},
+		{0, 0, "Encyclopædia", "ae", -1, -1},
+		{Loose, 0, "Encyclopædia", "ae", 8, 10},
+		{Loose, 0, "Straße", "ss", 4, 6},
+		{Loose, 0, "Strasse", "ß", 4, 6},
+		{Loose, 0, "Straße", "s", 0, 1},
+		{Loose, 0, "Straße", "sse", 4, 7},
+		{Loose, 0, "Straße", "as", -1, -1},
+		{Loose, 0, "ﬁsh", "fish", 0, 5},
+		{IgnoreCase, 0, "Hello World", "world", 6, 11},
+		{IgnoreDiacritics, 0, "Björn", "o", 2, 4},
+		{IgnoreDiacritics, 0, "Bjo\u0308rn", "o", 2, 5},
+		{IgnoreDiacritics, 0, "Bjo\u0308\u0301rn", "jo", 1, 7},
+		{Loose, 0, "ＢＪＯＲＮ", "jö", 3, 9},
+		{0, AltShifted, "co-op", "coop", 0, 5},
+	}
+	c := New(language.English)
+	for i, tt := range tests {
+		c.SetOptions(tt.opt)
+		c.Alternate = tt.alt
+		if start, end := c.IndexRangeString(tt.s, tt.pat); start != tt.start || end != tt.end {
+			t.Errorf("%d: IndexRangeString(%q, %q) = %d, %d; want %d, %d", i, tt.s, tt.pat, start, end, tt.start, tt.end)
+		}
+		if start, end := c.IndexRange([]byte(tt.s), []byte(tt.pat)); start != tt.start || end != tt.end {
+			t.Errorf("%d: IndexRange(%q, %q) = %d, %d; want %d, %d", i, tt.s, tt.pat, start, end, tt.start, tt.end)
+		}
+	}
+}
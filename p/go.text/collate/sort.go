@@ -27,8 +27,9 @@ type sorter struct {
 func (s *sorter) init(n int) {
 	if s.buf == nil {
 		s.buf = &Buffer{}
-		s.buf.init()
 	}
+	// The keys of the previous sort are no longer needed.
+	s.buf.Reset()
 	if cap(s.keys) < n {
 		s.keys = make([][]byte, n)
 	}
@@ -40,6 +41,11 @@ func (s *sorter) sort(src swapper) {
 	sort.Sort(s)
 }
 
+func (s *sorter) stable(src swapper) {
+	s.src = src
+	sort.Stable(s)
+}
+
 func (s sorter) Len() int {
 	return len(s.keys)
 }
@@ -63,19 +69,119 @@ type Lister interface {
 
 // Sort uses sort.Sort to sort the strings represented by x using the rules of c.
 func (c *Collator) Sort(x Lister) {
+	c.initKeys(x)
+	c.sorter.sort(x)
+}
+
+// SortStable is like Sort, but uses sort.Stable to keep equal elements in
+// their original order.
+func (c *Collator) SortStable(x Lister) {
+	c.initKeys(x)
+	c.sorter.stable(x)
+}
+
+func (c *Collator) initKeys(x Lister) {
 	n := x.Len()
 	c.sorter.init(n)
 	for i := 0; i < n; i++ {
 		c.sorter.keys[i] = c.Key(c.sorter.buf, x.Bytes(i))
 	}
-	c.sorter.sort(x)
 }
 
 // SortStrings uses sort.Sort to sort the strings in x using the rules of c.
 func (c *Collator) SortStrings(x []string) {
+	c.initStringKeys(x)
+	c.sorter.sort(sort.StringSlice(x))
+}
+
+// SortStringsStable is like SortStrings, but uses sort.Stable to keep equal
+// strings in their original order.
+func (c *Collator) SortStringsStable(x []string) {
+	c.initStringKeys(x)
+	c.sorter.stable(sort.StringSlice(x))
+}
+
+func (c *Collator) initStringKeys(x []string) {
 	c.sorter.init(len(x))
 	for i, s := range x {
 		c.sorter.keys[i] = c.KeyFromString(c.sorter.buf, s)
 	}
-	c.sorter.sort(sort.StringSlice(x))
+}
+
+// Less returns a function that reports whether x[i] sorts before x[j]
+// using the rules of c.  It can be used with sorting functions that take
+// a less function, such as a custom sort.Interface.  The key of each string
+// is computed once, when it is first compared, and held by the returned
+// function.  Like c, the returned function may not be used concurrently.
+func (c *Collator) Less(x []string) func(i, j int) bool {
+	var buf Buffer
+	keys := make(map[string][]byte, len(x))
+	key := func(s string) []byte {
+		k, ok := keys[s]
+		if !ok {
+			k = c.KeyFromString(&buf, s)
+			keys[s] = k
+		}
+		return k
+	}
+	return func(i, j int) bool {
+		return bytes.Compare(key(x[i]), key(x[j])) == -1
+	}
+}
+
+// Min returns the string in x that sorts first using the rules of c, or the
+// empty string if x is empty.  Of strings that compare equal, the first one
+// in x is returned.
+func (c *Collator) Min(x []string) string {
+	if len(x) == 0 {
+		return ""
+	}
+	m := x[0]
+	for _, s := range x[1:] {
+		if c.CompareString(s, m) < 0 {
+			m = s
+		}
+	}
+	return m
+}
+
+// Max returns the string in x that sorts last using the rules of c, or the
+// empty string if x is empty.  Of strings that compare equal, the first one
+// in x is returned.
+func (c *Collator) Max(x []string) string {
+	if len(x) == 0 {
+		return ""
+	}
+	m := x[0]
+	for _, s := range x[1:] {
+		if c.CompareString(s, m) > 0 {
+			m = s
+		}
+	}
+	return m
+}
+
+// Between reports whether x sorts within the half-open range [lo, hi) using
+// the rules of c, that is, whether lo <= x and x < hi.  Half-open ranges can
+// be used to partition the strings by name without overlap.
+func (c *Collator) Between(lo, x, hi string) bool {
+	return c.CompareString(lo, x) <= 0 && c.CompareString(x, hi) < 0
+}
+
+// Unique appends to dst the strings of src, omitting strings that are equal
+// under the rules of c to a string that occurs earlier in src, and returns
+// the resulting slice.  The order of the remaining strings is preserved.
+// dst may be src[:0] to remove the duplicates in place.
+func (c *Collator) Unique(dst, src []string) []string {
+	var buf Buffer
+	seen := make(map[string]bool, len(src))
+	for _, s := range src {
+		buf.Reset()
+		k := c.KeyFromString(&buf, s)
+		if !seen[string(k)] {
+			seen[string(k)] = true
+			dst = append(dst, s)
+		}
+	}
+	return dst
 }
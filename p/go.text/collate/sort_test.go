@@ -6,9 +6,12 @@ package collate_test
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"testing"
 
 	"code.google.com/p/go.text/collate"
+	"code.google.com/p/go.text/collate/colltab"
 	"code.google.com/p/go.text/language"
 )
 
@@ -53,3 +56,145 @@ func TestSort(t *testing.T) {
 		t.Errorf("found %s; want %s", res, want)
 	}
 }
+
+func TestSortStable(t *testing.T) {
+	c := collate.New(language.English)
+	c.Strength = colltab.Primary
+	strings := []string{"b", "a", "B", "á", "A", "c"}
+	c.SortStringsStable(strings)
+	if res, want := fmt.Sprint(strings), "[a á A b B c]"; res != want {
+		t.Errorf("SortStringsStable: found %s; want %s", res, want)
+	}
+	strings = []string{"b", "a", "B", "á", "A", "c"}
+	c.SortStable(sorter(strings))
+	if res, want := fmt.Sprint(strings), "[a á A b B c]"; res != want {
+		t.Errorf("SortStable: found %s; want %s", res, want)
+	}
+}
+
+func TestLess(t *testing.T) {
+	c := collate.New(language.English)
+	strings := []string{"äb", "ab", "ad"}
+	less := c.Less(strings)
+	for _, tt := range []struct {
+		i, j int
+		less bool
+	}{
+		{0, 1, false},
+		{1, 0, true},
+		{0, 2, true},
+		{1, 1, false},
+	} {
+		if res := less(tt.i, tt.j); res != tt.less {
+			t.Errorf("less(%q, %q) = %v; want %v", strings[tt.i], strings[tt.j], res, tt.less)
+		}
+	}
+
+	// Keys must follow the strings as they are swapped.
+	x := []string{"ad", "äb", "ab", "ac"}
+	sort.Sort(lessSorter{x, c.Less(x)})
+	if res, want := fmt.Sprint(x), "[ab äb ac ad]"; res != want {
+		t.Errorf("sorted with Less: found %s; want %s", res, want)
+	}
+}
+
+type lessSorter struct {
+	x    []string
+	less func(i, j int) bool
+}
+
+func (s lessSorter) Len() int           { return len(s.x) }
+func (s lessSorter) Swap(i, j int)      { s.x[i], s.x[j] = s.x[j], s.x[i] }
+func (s lessSorter) Less(i, j int) bool { return s.less(i, j) }
+
+func TestMinMax(t *testing.T) {
+	c := collate.New(language.English)
+	c.Strength = colltab.Secondary
+	for _, tt := range []struct {
+		in       []string
+		min, max string
+	}{
+		{nil, "", ""},
+		{[]string{"b"}, "b", "b"},
+		{[]string{"b", "ä", "c", "a"}, "a", "c"},
+		{[]string{"B", "b", "a", "A"}, "a", "B"},
+		{[]string{"Z", "ö", "z", "o"}, "o", "Z"},
+	} {
+		if m := c.Min(tt.in); m != tt.min {
+			t.Errorf("Min(%q) = %q; want %q", tt.in, m, tt.min)
+		}
+		if m := c.Max(tt.in); m != tt.max {
+			t.Errorf("Max(%q) = %q; want %q", tt.in, m, tt.max)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	c := collate.New(language.Swedish)
+	for _, tt := range []struct {
+		lo, x, hi string
+		in        bool
+	}{
+		{"a", "a", "n", true},
+		{"a", "Müller", "n", true},
+		{"a", "n", "n", false},
+		{"a", "Ölund", "n", false},
+		{"n", "Ölund", "", false},
+		{"z", "Ölund", "ööö", true},
+		{"b", "a", "c", false},
+	} {
+		if in := c.Between(tt.lo, tt.x, tt.hi); in != tt.in {
+			t.Errorf("Between(%q, %q, %q) = %v; want %v", tt.lo, tt.x, tt.hi, in, tt.in)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	for _, tt := range []struct {
+		opt collate.Option
+		in  []string
+		out []string
+	}{
+		{0, nil, nil},
+		{0, []string{"a", "b", "a", "A"}, []string{"a", "b", "A"}},
+		{0, []string{"\u00E1", "a\u0301", "a"}, []string{"\u00E1", "a"}},
+		{collate.Force, []string{"\u00E1", "a\u0301", "\u00E1"}, []string{"\u00E1", "a\u0301"}},
+		{collate.IgnoreCase, []string{"a", "b", "A", "B", "á"}, []string{"a", "b", "á"}},
+		{collate.Loose, []string{"Émile", "emile", "EMILE", "Emil"}, []string{"Émile", "Emil"}},
+	} {
+		c := collate.New(language.English)
+		c.SetOptions(tt.opt)
+		if out := c.Unique(nil, tt.in); fmt.Sprint(out) != fmt.Sprint(tt.out) {
+			t.Errorf("%v: Unique(%q) = %q; want %q", tt.opt, tt.in, out, tt.out)
+		}
+		in := append([]string(nil), tt.in...)
+		if out := c.Unique(in[:0], in); fmt.Sprint(out) != fmt.Sprint(tt.out) {
+			t.Errorf("%v: Unique in place (%q) = %q; want %q", tt.opt, tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestNewNatural(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want []string
+	}{
+		{"en", []string{
+			"File", "file", "file 20.txt", "file.txt", "file1.txt", "file2.txt",
+			"File10.txt", "file10.txt", "file20.txt", "image", "Zebra",
+		}},
+		{"sv", []string{"A10", "a10", "z2", "z10", "\u00E52", "\u00E4"}},
+	}
+	for _, tt := range tests {
+		c := collate.NewNatural(language.Make(tt.tag + "-u-kn-false-ka-shifted"))
+		in := append([]string(nil), tt.want...)
+		for i := range in {
+			j := (i * 7) % len(in)
+			in[i], in[j] = in[j], in[i]
+		}
+		c.SortStrings(in)
+		if !reflect.DeepEqual(in, tt.want) {
+			t.Errorf("%s: got %q; want %q", tt.tag, in, tt.want)
+		}
+	}
+}
@@ -6,9 +6,11 @@ package collate
 
 import (
 	"testing"
+	"unicode"
 
 	"code.google.com/p/go.text/collate/build"
 	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
 	"code.google.com/p/go.text/unicode/norm"
 )
 
@@ -289,3 +291,55 @@ func TestAppendNext(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckTables(t *testing.T) {
+	domain := []string{}
+	for r := rune(0); r < 0x30000; r++ {
+		if unicode.IsPrint(r) {
+			domain = append(domain, string(r))
+		}
+	}
+	for i, loc := range []string{"und", "de", "sv", "zh"} {
+		index := tables.match(language.Make(loc))
+		errs := colltab.Check(colltab.Init(tables.locales[index]), domain)
+		for _, err := range errs {
+			t.Errorf("%d:%s: %v", i, loc, err)
+		}
+	}
+}
+
+func TestContractionInfo(t *testing.T) {
+	ci, ok := New(language.Make("cs")).t.(colltab.ContractionInfo)
+	if !ok {
+		t.Fatal("table does not implement ContractionInfo")
+	}
+	tests := []struct {
+		r           rune
+		start, cont bool
+	}{
+		{'c', true, false},
+		{'C', true, false},
+		{'h', false, true},
+		{'H', false, true},
+		{'b', false, false},
+		{'1', false, false},
+	}
+	for _, tt := range tests {
+		if res := ci.MayStartContraction(tt.r); res != tt.start {
+			t.Errorf("MayStartContraction(%q) = %v; want %v", tt.r, res, tt.start)
+		}
+		if res := ci.MayContinueContraction(tt.r); res != tt.cont {
+			t.Errorf("MayContinueContraction(%q) = %v; want %v", tt.r, res, tt.cont)
+		}
+	}
+	if n := ci.MaxContractionLen(); n <= 0 {
+		t.Errorf("MaxContractionLen() = %d; want > 0", n)
+	}
+	nw := colltab.NewNumericWeigher(New(language.Make("cs")).t).(colltab.ContractionInfo)
+	if !nw.MayStartContraction('1') || !nw.MayContinueContraction('1') || !nw.MayContinueContraction('h') {
+		t.Errorf("numeric weigher: digits and h should be part of contractions")
+	}
+	if n := nw.MaxContractionLen(); n != -1 {
+		t.Errorf("numeric weigher: MaxContractionLen() = %d; want -1", n)
+	}
+}
@@ -3,8 +3,17 @@
 // DO NOT EDIT
 // TODO: implement more compact representation for sparse blocks.
 
+// +build !collate_custom
+
 package collate
 
+// CLDRVersion is the version of CLDR used to generate the data in this package.
+const CLDRVersion = "23"
+
+// UnicodeVersion is the version of the Unicode Collation Algorithm data used
+// to generate the data in this package.
+const UnicodeVersion = "6.2.0"
+
 var availableLocales = "und,aa,af,ar,as,az,be,bg,bn,bs,bs-Cyrl,ca,cs,cy,da,de,dz,ee,el,en,en-US,en-US-posix,eo,es,et,fa,fa-AF,fi,fil,fo,fr,fr-CA,gu,ha,haw,he,hi,hr,hu,hy,ig,is,ja,kk,kl,km,kn,ko,kok,ln,lt,lv,mk,ml,mr,mt,my,nb,nn,nso,om,or,pa,pl,ps,ro,ru,se,si,sk,sl,sq,sr,sr-Latn,ssy,sv,ta,te,th,tn,to,tr,uk,ur,vi,wae,yo,zh,zh-Hant"
 
 const varTop = 0x30e
@@ -70708,7 +70717,7 @@ var mainLookup = [15936]uint16{
 }
 
 // mainCTEntries: 2490 entries, 9960 bytes
-var mainCTEntries = [2490]struct{ l, h, n, i uint8 }{
+var mainCTEntries = [2490]struct{ L, H, N, I uint8 }{
 	{0xCE, 0x1, 1, 255},
 	{0xC2, 0x0, 1, 255},
 	{0xB7, 0xB7, 0, 1},
@@ -303,6 +303,7 @@ var commands = []*Command{
 	cmdSort,
 	cmdBench,
 	cmdRegress,
+	cmdCompare,
 }
 
 const sortHelp = `
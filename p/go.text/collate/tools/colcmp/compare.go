@@ -0,0 +1,137 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+)
+
+var format = flag.String("format", "text", `output format of the compare command: "text", "csv" or "json"`)
+
+const compareHelp = `
+Compare runs the generated input for each of the given locales through each of
+the collators given by -col and reports, per locale and collator, the time spent
+generating keys and sorting, and the number of adjacent pairs in the sorted
+result that are ordered differently by the gold collator.
+
+The results are written as a table, or, with -format=csv or -format=json, in a
+machine-readable form suitable for tracking performance and conformance over
+time. A typical invocation, with a colcmp binary built with the icu tag, is
+    colcmp -col=go,icu -gold=icu -locale=de,fr,ja -format=csv compare
+`
+
+var cmdCompare = &Command{
+	Run:   runCompare,
+	Usage: "compare -gold=<col> -col=<col>[,<col>]* [-format=<format>] [string]*",
+	Short: "compare timing and ordering of collators against a gold standard",
+	Long:  compareHelp,
+}
+
+// compareResult holds the result of comparing a collator for a single locale.
+type compareResult struct {
+	Locale   string
+	Collator string
+	Gold     string
+	N        int     // number of input strings
+	KeySec   float64 // time spent generating keys
+	SortSec  float64 // time spent sorting
+	Diffs    int     // number of pairs ordered differently by the gold collator
+}
+
+func runCompare(ctxt *Context, args []string) {
+	var write func([]compareResult) error
+	switch *format {
+	case "text":
+		write = writeText
+	case "csv":
+		write = writeCSV
+	case "json":
+		write = writeJSON
+	default:
+		log.Fatalf("Unknown format %q.", *format)
+	}
+	input := parseInput(args)
+	results := []compareResult{}
+	for i := 0; i < ctxt.Len(); i++ {
+		t := ctxt.Test(i)
+		if len(input) > 0 {
+			t.Input = append(t.Input, input...)
+		} else {
+			t.GenerateInput()
+		}
+		tkey, tsort, _, _ := t.Sort()
+		results = append(results, compareResult{
+			Locale:   t.Locale,
+			Collator: t.ColName,
+			Gold:     *gold,
+			N:        t.Len(),
+			KeySec:   tkey.Seconds(),
+			SortSec:  tsort.Seconds(),
+			Diffs:    countDiffs(t, getCollator(*gold, t.Locale)),
+		})
+	}
+	failOnError(write(results))
+}
+
+// countDiffs returns the number of adjacent pairs in the sorted input of t
+// for which gold reports a different order.
+func countDiffs(t *Test, gold Collator) int {
+	count := 0
+	for i := 1; i < len(t.Input); i++ {
+		ia, ib := t.Input[i-1], t.Input[i]
+		if bytes.IndexAny(ia.UTF8, *exclude) != -1 || bytes.IndexAny(ib.UTF8, *exclude) != -1 {
+			continue
+		}
+		if t.Col.Compare(ia, ib) != gold.Compare(ia, ib) {
+			count++
+		}
+	}
+	return count
+}
+
+func writeText(results []compareResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, ' ', 0)
+	fmt.Fprintln(w, "LOCALE\tCOLL\tGOLD\tN\tKEYS\tSORT\tDIFFS")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%.3fs\t%.3fs\t%d\n",
+			r.Locale, r.Collator, r.Gold, r.N, r.KeySec, r.SortSec, r.Diffs)
+	}
+	return w.Flush()
+}
+
+func writeCSV(results []compareResult) error {
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{"locale", "collator", "gold", "n", "keysec", "sortsec", "diffs"})
+	for _, r := range results {
+		w.Write([]string{
+			r.Locale,
+			r.Collator,
+			r.Gold,
+			strconv.Itoa(r.N),
+			strconv.FormatFloat(r.KeySec, 'f', 6, 64),
+			strconv.FormatFloat(r.SortSec, 'f', 6, 64),
+			strconv.Itoa(r.Diffs),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func writeJSON(results []compareResult) error {
+	b, err := json.MarshalIndent(results, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(os.Stdout, "%s\n", b)
+	return err
+}
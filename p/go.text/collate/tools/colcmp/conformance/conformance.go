@@ -0,0 +1,149 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package conformance provides functionality for validating collation
+// tables.  It can run the UCA conformance tests in the CollationTest_*.txt
+// files of the Unicode Collation Algorithm and compare the ordering of a
+// collator against that of another implementation, such as ICU, for a set
+// of locales.
+//
+// The ICU collator is only available if the package is built with the icu
+// build tag, which requires cgo and the ICU libraries.
+package conformance
+
+import (
+	"fmt"
+	"sort"
+
+	"code.google.com/p/go.text/collate"
+	"code.google.com/p/go.text/language"
+)
+
+// Collator is the interface implemented by the collators that can be compared.
+type Collator interface {
+	// Compare returns -1 if a < b, 1 if a > b and 0 if a == b.
+	Compare(a, b []byte) int
+}
+
+// A Factory creates a Collator for the given locale.
+type Factory func(locale string) (Collator, error)
+
+var factories = map[string]Factory{}
+
+// Register makes the collator created by f available under the given name.
+// The Go collator is registered as "go" and, if the package is built with
+// the icu build tag, ICU's collator is registered as "icu".
+func Register(name string, f Factory) {
+	factories[name] = f
+}
+
+// Collators returns the names of the registered collators in sorted order.
+func Collators() []string {
+	names := []string{}
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New returns a new Collator of the implementation registered under name
+// for the given locale.
+func New(name, locale string) (Collator, error) {
+	f, ok := factories[name]
+	if !ok {
+		return nil, fmt.Errorf("conformance: unknown collator %q", name)
+	}
+	return f(locale)
+}
+
+func init() {
+	Register("go", func(locale string) (Collator, error) {
+		t, err := language.Parse(locale)
+		if err != nil {
+			return nil, err
+		}
+		return collate.New(t), nil
+	})
+}
+
+// A Failure describes a pair of strings that is ordered incorrectly.
+type Failure struct {
+	Index int    // index of B in the tested sequence
+	A, B  []byte // the strings compared
+	Got   int    // result of comparing A and B for the tested collator
+	Want  int    // expected result
+	Msg   string // describes the failed check
+}
+
+func (f Failure) String() string {
+	return fmt.Sprintf("%d: %s(%+q, %+q) == %d; want %d", f.Index, f.Msg, f.A, f.B, f.Got, f.Want)
+}
+
+// A Result holds the outcome of a single test.
+type Result struct {
+	Name     string // name of the test file or locale
+	N        int    // number of pairs checked
+	Failures []Failure
+}
+
+// Pass reports whether the test passed.
+func (r *Result) Pass() bool {
+	return len(r.Failures) == 0
+}
+
+func (r *Result) fail(i int, a, b []byte, got, want int, msg string) {
+	r.Failures = append(r.Failures, Failure{i, a, b, got, want, msg})
+}
+
+func (r *Result) String() string {
+	if r.Pass() {
+		return fmt.Sprintf("PASS %s (%d)", r.Name, r.N)
+	}
+	return fmt.Sprintf("FAIL %s (%d of %d failed)", r.Name, len(r.Failures), r.N)
+}
+
+type sorter struct {
+	c   Collator
+	str [][]byte
+}
+
+func (s *sorter) Len() int           { return len(s.str) }
+func (s *sorter) Swap(i, j int)      { s.str[i], s.str[j] = s.str[j], s.str[i] }
+func (s *sorter) Less(i, j int) bool { return s.c.Compare(s.str[i], s.str[j]) < 0 }
+
+// Compare sorts a copy of input using the collator test and verifies that
+// gold orders each pair of adjacent strings in the result in the same way.
+// The result is named after locale.
+func Compare(locale string, test, gold Collator, input [][]byte) *Result {
+	s := &sorter{test, append([][]byte(nil), input...)}
+	sort.Sort(s)
+	res := &Result{Name: locale}
+	for i := 1; i < len(s.str); i++ {
+		a, b := s.str[i-1], s.str[i]
+		res.N++
+		if got, want := test.Compare(a, b), gold.Compare(a, b); got != want {
+			res.fail(i, a, b, got, want, "Compare")
+		}
+	}
+	return res
+}
+
+// CompareLocales runs Compare for each of the given locales, using the
+// collators registered under the names test and gold.
+func CompareLocales(test, gold string, locales []string, input [][]byte) ([]*Result, error) {
+	results := []*Result{}
+	for _, loc := range locales {
+		t, err := New(test, loc)
+		if err != nil {
+			return nil, err
+		}
+		g, err := New(gold, loc)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, Compare(loc, t, g, input))
+	}
+	return results, nil
+}
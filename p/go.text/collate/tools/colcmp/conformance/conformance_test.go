@@ -0,0 +1,89 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conformance
+
+import (
+	"strings"
+	"testing"
+
+	"code.google.com/p/go.text/collate"
+	"code.google.com/p/go.text/language"
+)
+
+const ucaTest = `# CollationTest_NON_IGNORABLE.txt
+# UCA Version: 6.3.0
+
+0061;	# ('a') LATIN SMALL LETTER A	[1C47 | 0020 | 0002 |]
+0041;	# ('A') LATIN CAPITAL LETTER A	[1C47 | 0020 | 0008 |]
+00E1;	# ('á') LATIN SMALL LETTER A WITH ACUTE	[1C47 | 0020 0024 | 0002 0002 |]
+0062;	# ('b') LATIN SMALL LETTER B	[1C60 | 0020 | 0002 |]
+D800 0062;	# surrogates are skipped
+0063 0061;	# ('ca') LATIN SMALL LETTER C...	[1C7A 1C47 | 0020 0020 | 0002 0002 |]
+`
+
+func TestParseUCATest(t *testing.T) {
+	ut, err := ParseUCATest("dir/CollationTest_NON_IGNORABLE.txt", strings.NewReader(ucaTest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ut.Name != "CollationTest_NON_IGNORABLE.txt" {
+		t.Errorf("Name = %q", ut.Name)
+	}
+	if ut.Version != "6.3.0" {
+		t.Errorf("Version = %q; want 6.3.0", ut.Version)
+	}
+	want := []string{"a", "A", "á", "b", "ca"}
+	if len(ut.Str) != len(want) || len(ut.Comment) != len(want) {
+		t.Fatalf("got %d strings and %d comments; want %d", len(ut.Str), len(ut.Comment), len(want))
+	}
+	for i, s := range want {
+		if string(ut.Str[i]) != s {
+			t.Errorf("%d: got %q; want %q", i, ut.Str[i], s)
+		}
+	}
+	if _, err := ParseUCATest("bad", strings.NewReader("0061 XYZ;\n")); err == nil {
+		t.Errorf("expected error for malformed input")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ut, err := ParseUCATest("CollationTest_NON_IGNORABLE.txt", strings.NewReader(ucaTest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := collate.New(language.Und)
+	if res := ut.Check(c); !res.Pass() || res.N != len(ut.Str)-1 {
+		t.Errorf("%v: %v", res, res.Failures)
+	}
+	ut.Str[0], ut.Str[3] = ut.Str[3], ut.Str[0]
+	if res := ut.Check(c); res.Pass() {
+		t.Errorf("%v: expected failures", res)
+	}
+}
+
+// reverse is a Collator that orders strings in the reverse order of c.
+type reverse struct{ c Collator }
+
+func (r reverse) Compare(a, b []byte) int { return r.c.Compare(b, a) }
+
+func TestCompare(t *testing.T) {
+	input := [][]byte{[]byte("b"), []byte("a"), []byte("B"), []byte("á")}
+	res, err := CompareLocales("go", "go", []string{"en", "da"}, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range res {
+		if !r.Pass() || r.N != len(input)-1 {
+			t.Errorf("%v: %v", r, r.Failures)
+		}
+	}
+	c, _ := New("go", "en")
+	if r := Compare("en", c, reverse{c}, input); len(r.Failures) != r.N {
+		t.Errorf("%v: got %d failures; want %d", r, len(r.Failures), r.N)
+	}
+	if _, err := New("nonexisting", "en"); err == nil {
+		t.Errorf("expected error for unknown collator")
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build icu
+
+package conformance
+
+/*
+#cgo LDFLAGS: -licui18n -licuuc
+#include <stdlib.h>
+#include <unicode/ucol.h>
+#include <unicode/utypes.h>
+*/
+import "C"
+import (
+	"fmt"
+	"runtime"
+	"unicode/utf16"
+	"unsafe"
+)
+
+func init() {
+	Register("icu", newICUCollator)
+}
+
+// icuCollator implements a Collator based on ICU.
+type icuCollator struct {
+	col *C.UCollator
+}
+
+func newICUCollator(locale string) (Collator, error) {
+	err := C.UErrorCode(0)
+	loc := C.CString(locale)
+	defer C.free(unsafe.Pointer(loc))
+	c := &icuCollator{C.ucol_open(loc, &err)}
+	if err > 0 {
+		return nil, fmt.Errorf("conformance: failed opening ICU collator for %q", locale)
+	}
+	runtime.SetFinalizer(c, func(c *icuCollator) { C.ucol_close(c.col) })
+	return c, nil
+}
+
+func (c *icuCollator) Compare(a, b []byte) int {
+	ua, ub := utf16.Encode([]rune(string(a))), utf16.Encode([]rune(string(b)))
+	return int(C.ucol_strcoll(c.col, icuUCharP(ua), C.int32_t(len(ua)), icuUCharP(ub), C.int32_t(len(ub))))
+}
+
+func icuUCharP(s []uint16) *C.UChar {
+	if len(s) == 0 {
+		return nil
+	}
+	return (*C.UChar)(unsafe.Pointer(&s[0]))
+}
@@ -0,0 +1,151 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conformance
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"code.google.com/p/go.text/collate"
+	"code.google.com/p/go.text/collate/colltab"
+)
+
+// The UCA conformance test files, found in CollationTest.zip at
+// http://www.unicode.org/Public/UCA/<unicode.Version>/, have the following
+// form:
+// # header
+// 0009 0021;	# ('\u0009') <CHARACTER TABULATION>	[| | | 0201 025E]
+// 0009 003F;	# ('\u0009') <CHARACTER TABULATION>	[| | | 0201 0263]
+// 000A 0021;	# ('\u000A') <LINE FEED (LF)>	[| | | 0202 025E]
+// 000A 003F;	# ('\u000A') <LINE FEED (LF)>	[| | | 0202 0263]
+//
+// The part before the semicolon is the hex representation of a sequence
+// of runes. After the hash mark is a comment. The strings
+// represented by rune sequence are in the file in sorted order, as
+// defined by the DUCET.
+
+// A UCATest holds the strings of a UCA conformance test file in the order
+// in which they should be sorted.
+type UCATest struct {
+	Name    string   // base name of the test file
+	Version string   // the UCA version of the test file, if specified
+	Str     [][]byte // strings in sorted order
+	Comment []string // comment for each string
+}
+
+var (
+	versionRe = regexp.MustCompile(`# UCA Version: (.*)\n?$`)
+	testRe    = regexp.MustCompile(`^([\dA-F ]+);.*# (.*)\n?$`)
+)
+
+// ParseUCATest parses a single UCA conformance test file.
+func ParseUCATest(name string, r io.Reader) (*UCATest, error) {
+	t := &UCATest{Name: path.Base(name)}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) <= 1 || line[0] == '#' {
+			if m := versionRe.FindStringSubmatch(line); m != nil {
+				t.Version = m[1]
+			}
+			continue
+		}
+		m := testRe.FindStringSubmatch(line)
+		if m == nil || len(m) < 3 {
+			return nil, fmt.Errorf("conformance: %s: failed to parse %q", t.Name, line)
+		}
+		str := []byte{}
+		// In the regression test data (unpaired) surrogates are assigned a weight
+		// corresponding to their code point value.  However, utf8.DecodeRune,
+		// which is used to compute the implicit weight, assigns FFFD to surrogates.
+		// We therefore skip tests with surrogates.  This skips about 35 entries
+		// per test.
+		valid := true
+		for _, split := range strings.Split(m[1], " ") {
+			r, err := strconv.ParseUint(split, 16, 64)
+			if err != nil {
+				return nil, fmt.Errorf("conformance: %s: %v", t.Name, err)
+			}
+			valid = valid && utf8.ValidRune(rune(r))
+			str = append(str, string(rune(r))...)
+		}
+		if valid {
+			t.Str = append(t.Str, str)
+			t.Comment = append(t.Comment, m[2])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ParseUCATestZip parses the test files in the zip archive r, which is
+// typically CollationTest.zip.  The SHORT variants of the test files, which
+// are duplicates of the other files, are skipped.
+func ParseUCATestZip(r io.ReaderAt, size int64) ([]*UCATest, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	tests := []*UCATest{}
+	for _, f := range archive.File {
+		if strings.Contains(f.Name, "SHORT") || f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		t, err := ParseUCATest(f.Name, rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		tests = append(tests, t)
+	}
+	return tests, nil
+}
+
+// Check verifies that c, configured with the settings prescribed by the
+// test, orders the strings of t correctly.  It checks both the results of
+// Compare and the ordering of the keys.  The settings of c are not modified.
+// The conformance tests assume the ordering of the DUCET, so c should
+// typically be created from a table built from allkeys.txt.
+func (t *UCATest) Check(c *collate.Collator) *Result {
+	c = c.Clone()
+	c.Strength = colltab.Quaternary
+	c.Alternate = collate.AltShifted
+	if strings.Contains(t.Name, "NON_IGNOR") {
+		c.Strength = colltab.Tertiary
+		c.Alternate = collate.AltNonIgnorable
+	}
+	res := &Result{Name: t.Name}
+	b := &collate.Buffer{}
+	for i := 1; i < len(t.Str); i++ {
+		prev, s := t.Str[i-1], t.Str[i]
+		res.N++
+		b.Reset()
+		if r := bytes.Compare(c.Key(b, prev), c.Key(b, s)); r == 1 {
+			res.fail(i, prev, s, r, -1, "Key")
+			continue
+		}
+		if r := c.Compare(prev, s); r == 1 {
+			res.fail(i, prev, s, r, -1, "Compare")
+		}
+		if r := c.Compare(s, prev); r == -1 {
+			res.fail(i, s, prev, r, 1, "Compare")
+		}
+	}
+	return res
+}
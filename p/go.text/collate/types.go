@@ -0,0 +1,68 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"sync"
+
+	"code.google.com/p/go.text/collate/colltab"
+	"code.google.com/p/go.text/language"
+)
+
+// typeRules holds the CLDR rules for collation types that are not included
+// in the compiled tables, keyed by language and type.  As in CLDR, the rules
+// tailor the root collation.  The tables for these types are built on first
+// use.  Types that are too large to express as rules, such as the pinyin,
+// stroke and zhuyin orderings for Chinese, require tables generated with
+// maketables -types.
+var typeRules = map[string]string{
+	"de-phonebk": "&AE << ä <<< Ä &OE << ö <<< Ö &UE << ü <<< Ü",
+	"es-trad":    "&N < ñ <<< Ñ &C < ch <<< cH <<< Ch <<< CH &l < ll <<< lL <<< Ll <<< LL",
+}
+
+// typeTables caches the tables built from typeRules.
+var typeTables struct {
+	sync.Mutex
+	data *tableData // tables from which the cached tables were built
+	m    map[string]colltab.Weigher
+}
+
+// typeTable returns the table for the collation type selected by the -u-co-
+// extension of t.  It returns nil if t does not select a type other than the
+// standard one or if the type is not supported for the language of t.
+func typeTable(t language.Tag) colltab.Weigher {
+	co := t.TypeForKey("co")
+	if co == "" || co == "standard" {
+		return nil
+	}
+	b, _, _ := t.Raw()
+	lang := b.String()
+	id := lang + "-u-co-" + co
+	// Use the table compiled for this type, if available.
+	for i, s := range tables.localeIDs() {
+		if s == id {
+			return colltab.Init(tables.locales[i])
+		}
+	}
+	rules, ok := typeRules[lang+"-"+co]
+	if !ok {
+		return nil
+	}
+	typeTables.Lock()
+	defer typeTables.Unlock()
+	if typeTables.data != tables {
+		typeTables.data = tables
+		typeTables.m = make(map[string]colltab.Weigher)
+	}
+	if w, ok := typeTables.m[id]; ok {
+		return w
+	}
+	w, _, err := tailor(language.Und, rules)
+	if err != nil {
+		return nil
+	}
+	typeTables.m[id] = w
+	return w
+}
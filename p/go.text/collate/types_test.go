@@ -0,0 +1,40 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"testing"
+
+	"code.google.com/p/go.text/language"
+)
+
+func TestCollationType(t *testing.T) {
+	tests := []struct {
+		tag  string
+		a, b string
+		res  int
+	}{
+		{"de", "ä", "ae", -1},
+		{"de", "ä", "af", -1},
+		{"de-u-co-phonebk", "ä", "af", -1},
+		{"de-u-co-phonebk", "ä", "ad", 1},
+		{"de-u-co-phonebk", "ae", "ä", -1},
+		{"de-u-co-phonebk", "Müller", "Muff", -1},
+		{"de-u-co-standard", "Müller", "Muff", 1},
+		{"de-u-co-unknown", "Müller", "Muff", 1},
+		{"es-u-co-trad", "ñ", "o", -1},
+		{"es-u-co-trad", "nz", "ñ", -1},
+		{"es", "cz", "ch", 1},
+		{"es-u-co-trad", "cz", "ch", -1},
+		{"es-u-co-trad", "cz", "d", -1},
+		{"es-u-co-trad", "lz", "ll", -1},
+	}
+	for i, tt := range tests {
+		c := New(language.Make(tt.tag))
+		if res := c.CompareString(tt.a, tt.b); res != tt.res {
+			t.Errorf("%d:%s: CompareString(%q, %q) == %d; want %d", i, tt.tag, tt.a, tt.b, res, tt.res)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collate
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"code.google.com/p/go.text/collate/colltab"
+)
+
+// MaxVariable identifies the last of the groups of characters that are
+// considered variable when Alternate is AltShifted or AltShiftTrimmed.
+// The groups are ordered: MaxVariableSymbol, for example, denotes spaces,
+// punctuation and symbols.  By default, spaces and punctuation are variable.
+// See http://www.unicode.org/reports/tr35/tr35-collation.html#Variable_Weighting.
+type MaxVariable int
+
+const (
+	MaxVariableSpace    MaxVariable = iota // spaces
+	MaxVariablePunct                       // spaces and punctuation
+	MaxVariableSymbol                      // spaces, punctuation and symbols
+	MaxVariableCurrency                    // spaces, punctuation, symbols and currency symbols
+)
+
+// The first characters of the currency and digit groups in the ordering
+// of CLDR.
+const (
+	firstCurrency = '¤'
+	firstDigit    = '0'
+)
+
+// SetMaxVariable sets the last group of characters that is treated as
+// variable.  It only has an effect if Alternate is AltShifted or
+// AltShiftTrimmed.
+func (c *Collator) SetMaxVariable(m MaxVariable) {
+	c.variableTop = maxVariableTop(c.t, m)
+}
+
+// SetVariableTop sets the variable top to the primary weight of s, which must
+// be a single character or contraction with a single primary weight.  All
+// characters with a primary weight smaller than or equal to this weight are
+// treated as variable.  It only has an effect if Alternate is AltShifted or
+// AltShiftTrimmed.
+func (c *Collator) SetVariableTop(s string) error {
+	if s == "" {
+		return fmt.Errorf("collate: variable top must not be empty")
+	}
+	ce, n := c.t.AppendNextString(nil, s)
+	if n != len(s) {
+		return fmt.Errorf("collate: variable top %q is not a single character or contraction", s)
+	}
+	top := uint32(0)
+	for _, e := range ce {
+		if p := e.Primary(); p != 0 {
+			if top != 0 {
+				return fmt.Errorf("collate: variable top %q has more than one primary weight", s)
+			}
+			top = uint32(p)
+		}
+	}
+	if top == 0 {
+		return fmt.Errorf("collate: variable top %q has no primary weight", s)
+	}
+	c.variableTop = top
+	return nil
+}
+
+// VariableTop returns the largest primary weight that is treated as variable.
+func (c *Collator) VariableTop() uint32 {
+	return c.variableTop
+}
+
+// maxVariableTop returns the largest primary weight of the characters of group
+// m and the groups that precede it.  The boundaries are derived from the
+// primary weights that t assigns to the first character of the next group.
+// The boundary of the punctuation group is the variable top of t.
+func maxVariableTop(t colltab.Weigher, m MaxVariable) uint32 {
+	top := t.Top()
+	switch m {
+	case MaxVariableSpace:
+		space := uint32(0)
+		for _, rng := range unicode.White_Space.R16 {
+			for r := rune(rng.Lo); r <= rune(rng.Hi); r += rune(rng.Stride) {
+				if p := firstPrimary(t, r); p <= top && p > space {
+					space = p
+				}
+			}
+		}
+		return space
+	case MaxVariableSymbol:
+		if p := firstPrimary(t, firstCurrency); p > top {
+			return p - 1
+		}
+	case MaxVariableCurrency:
+		if p := firstPrimary(t, firstDigit); p > top {
+			return p - 1
+		}
+	}
+	return top
+}
+
+// firstPrimary returns the primary weight of the first collation element of r.
+func firstPrimary(t colltab.Weigher, r rune) uint32 {
+	var buf [utf8.UTFMax]byte
+	ce, _ := t.AppendNext(nil, buf[:utf8.EncodeRune(buf[:], r)])
+	if len(ce) == 0 {
+		return 0
+	}
+	return uint32(ce[0].Primary())
+}
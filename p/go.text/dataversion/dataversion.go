@@ -0,0 +1,139 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dataversion reports the versions of the CLDR and Unicode data from
+// which the tables of the go.text packages linked into a binary were
+// generated.
+//
+// Packages with generated tables register their versions when they are
+// initialized. Regenerating the tables of only some packages may result in a
+// binary that combines data of different versions, which can cause subtle
+// inconsistencies, for instance between the names returned by package display
+// and the tags supported by package language. Check detects such mismatches.
+package dataversion
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Info describes the data of a single package.
+type Info struct {
+	// Package is the import path of the package.
+	Package string
+
+	// CLDR is the version of CLDR from which the tables were generated or ""
+	// if the package does not contain CLDR data.
+	CLDR string
+
+	// Unicode is the version of Unicode from which the tables were generated
+	// or "" if the package does not contain Unicode Character Database data.
+	Unicode string
+}
+
+var (
+	mu    sync.Mutex
+	infos = map[string]Info{}
+)
+
+// Register records the data versions of a package. It is intended to be
+// called from the init function of packages with generated tables. A later
+// call for the same package replaces the earlier information.
+func Register(info Info) {
+	mu.Lock()
+	infos[info.Package] = info
+	mu.Unlock()
+}
+
+// All returns the information of all registered packages sorted by package
+// path.
+func All() []Info {
+	mu.Lock()
+	defer mu.Unlock()
+	a := make([]Info, 0, len(infos))
+	for _, x := range infos {
+		a = append(a, x)
+	}
+	sort.Sort(byPackage(a))
+	return a
+}
+
+type byPackage []Info
+
+func (a byPackage) Len() int           { return len(a) }
+func (a byPackage) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byPackage) Less(i, j int) bool { return a[i].Package < a[j].Package }
+
+// A MismatchError reports that the registered packages were generated from
+// different versions of the same data.
+type MismatchError struct {
+	// Data is either "CLDR" or "Unicode".
+	Data string
+
+	// Versions maps each version found to the packages using it.
+	Versions map[string][]string
+}
+
+func (e *MismatchError) Error() string {
+	var v []string
+	for version := range e.Versions {
+		v = append(v, version)
+	}
+	sort.Strings(v)
+	for i, version := range v {
+		v[i] = fmt.Sprintf("%s (%s)", version, strings.Join(e.Versions[version], ", "))
+	}
+	return fmt.Sprintf("dataversion: mismatched %s versions: %s", e.Data, strings.Join(v, "; "))
+}
+
+// An ErrorList is returned by Check if more than one kind of data is
+// mismatched. It holds a *MismatchError for each kind.
+type ErrorList []error
+
+// Error implements the error interface.
+func (e ErrorList) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// Check returns a *MismatchError if the registered packages were not all
+// generated from the same version of CLDR or the same version of Unicode.
+// Packages without data of a kind are ignored for that kind. If both kinds
+// of data are mismatched, Check returns an ErrorList holding the errors for
+// CLDR and Unicode, in that order.
+func Check() error {
+	all := All()
+	var errs ErrorList
+	if err := check("CLDR", all, func(x Info) string { return x.CLDR }); err != nil {
+		errs = append(errs, err)
+	}
+	if err := check("Unicode", all, func(x Info) string { return x.Unicode }); err != nil {
+		errs = append(errs, err)
+	}
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	return errs
+}
+
+func check(data string, all []Info, version func(Info) string) error {
+	m := map[string][]string{}
+	for _, x := range all {
+		if v := version(x); v != "" {
+			m[v] = append(m[v], x.Package)
+		}
+	}
+	if len(m) > 1 {
+		return &MismatchError{data, m}
+	}
+	return nil
+}
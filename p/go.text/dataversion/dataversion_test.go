@@ -0,0 +1,65 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dataversion
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	defer func(old map[string]Info) { infos = old }(infos)
+
+	tests := []struct {
+		infos []Info
+		err   string
+	}{
+		{nil, ""},
+		{[]Info{
+			{"a", "25", ""},
+			{"b", "25", "6.3.0"},
+			{"c", "", "6.3.0"},
+		}, ""},
+		{[]Info{
+			{"a", "25", ""},
+			{"b", "23", "6.3.0"},
+			{"c", "25", "6.2.0"},
+		}, "dataversion: mismatched CLDR versions: 23 (b); 25 (a, c); " +
+			"dataversion: mismatched Unicode versions: 6.2.0 (c); 6.3.0 (b)"},
+		{[]Info{
+			{"a", "25", "6.3.0"},
+			{"b", "23", "6.3.0"},
+		}, "dataversion: mismatched CLDR versions: 23 (b); 25 (a)"},
+		{[]Info{
+			{"a", "25", "6.3.0"},
+			{"b", "", "6.2.0"},
+		}, "dataversion: mismatched Unicode versions: 6.2.0 (b); 6.3.0 (a)"},
+		{[]Info{
+			{"a", "23", ""},
+			{"a", "25", ""}, // replaces the previous registration
+			{"b", "25", ""},
+		}, ""},
+	}
+	for i, tt := range tests {
+		infos = map[string]Info{}
+		for _, x := range tt.infos {
+			Register(x)
+		}
+		got := ""
+		if err := Check(); err != nil {
+			got = err.Error()
+		}
+		if got != tt.err {
+			t.Errorf("%d: error was %q; want %q", i, got, tt.err)
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	defer func(old map[string]Info) { infos = old }(infos)
+	infos = map[string]Info{}
+	Register(Info{Package: "z"})
+	Register(Info{Package: "a"})
+	if a := All(); len(a) != 2 || a[0].Package != "a" || a[1].Package != "z" {
+		t.Errorf("All() = %v; want packages a and z in order", a)
+	}
+}
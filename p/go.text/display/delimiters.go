@@ -0,0 +1,84 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package display
+
+import (
+	"strings"
+
+	"code.google.com/p/go.text/language"
+)
+
+// Delimiters holds the quotation marks used in a language, as defined by the
+// delimiters element of CLDR.
+type Delimiters struct {
+	QuotationStart string // the opening quotation mark
+	QuotationEnd   string // the closing quotation mark
+
+	// The alternate quotation marks are used for quotations nested within a
+	// quotation.
+	AlternateQuotationStart string
+	AlternateQuotationEnd   string
+
+	// Space is inserted between the quotation marks and the quoted text, as
+	// is customary in French. It is not part of the CLDR data.
+	Space string
+}
+
+// delimiters holds the delimiters for a subset of the languages of CLDR 25,
+// keyed by tag. It is maintained by hand, as no generator in this tree
+// extracts the delimiters element. Languages without an entry use the
+// delimiters of their closest parent with one, or those of root.
+var delimiters = map[string]Delimiters{
+	"und":     {"“", "”", "‘", "’", ""},
+	"cs":      {"„", "“", "‚", "‘", ""},
+	"de":      {"„", "“", "‚", "‘", ""},
+	"de-CH":   {"«", "»", "‹", "›", ""},
+	"en":      {"“", "”", "‘", "’", ""},
+	"es":      {"«", "»", "“", "”", ""},
+	"fr":      {"«", "»", "«", "»", "\u00a0"},
+	"fr-CH":   {"«", "»", "‹", "›", ""},
+	"ja":      {"「", "」", "『", "』", ""},
+	"pl":      {"„", "”", "«", "»", ""},
+	"pt":      {"“", "”", "‘", "’", ""},
+	"ru":      {"«", "»", "„", "“", ""},
+	"sv":      {"”", "”", "’", "’", ""},
+	"zh":      {"“", "”", "‘", "’", ""},
+	"zh-Hant": {"「", "」", "『", "』", ""},
+}
+
+// QuotationMarks returns the delimiters used in the language t. If no
+// delimiters are defined for t, those of its closest parent with delimiters
+// are returned, or the English quotation marks if there is no such parent.
+func QuotationMarks(t language.Tag) Delimiters {
+	for {
+		if d, ok := delimiters[t.String()]; ok {
+			return d
+		}
+		p := t.Parent()
+		if p == t {
+			return delimiters["und"]
+		}
+		t = p
+	}
+}
+
+// Quote returns s enclosed in the quotation marks of d. Quotation marks of d
+// within s, such as those added by an earlier call to Quote, are replaced by the
+// alternate quotation marks, so that quotations nest correctly.
+func (d Delimiters) Quote(s string) string {
+	if d.QuotationStart != d.AlternateQuotationStart || d.QuotationEnd != d.AlternateQuotationEnd {
+		s = strings.NewReplacer(
+			d.QuotationStart, d.AlternateQuotationStart,
+			d.QuotationEnd, d.AlternateQuotationEnd,
+		).Replace(s)
+	}
+	return d.QuotationStart + d.Space + s + d.Space + d.QuotationEnd
+}
+
+// Quote returns s enclosed in the quotation marks of the language t.
+// For example, it returns „Hallo“ for German and « Bonjour » for French.
+func Quote(t language.Tag, s string) string {
+	return QuotationMarks(t).Quote(s)
+}
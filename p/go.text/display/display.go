@@ -10,11 +10,21 @@
 // large. The display package is designed so that users can reduce the linked-in
 // table sizes by cherry picking the languages one wishes to support. There is a
 // Dictionary defined for a selected set of common languages for this purpose.
+//
+// Programs for which even this is too large can be built with the textminimal
+// build tag. The package then only includes the names in English and in the
+// languages for which there is a Dictionary, and only for these languages.
+// Use Covered to find out whether there is data for a given language.
+//
+// The package also provides the quotation marks used in a language, through
+// QuotationMarks and Quote.
 package display
 
 import (
+	"fmt"
 	"strings"
 
+	"code.google.com/p/go.text/dataversion"
 	"code.google.com/p/go.text/language"
 )
 
@@ -66,6 +76,39 @@ func init() {
 	Supported = language.NewCoverage(tags)
 
 	Values = language.NewCoverage(langTagSet.Tags, supportedScripts, supportedRegions)
+
+	dataversion.Register(dataversion.Info{
+		Package: "code.google.com/p/go.text/display",
+		CLDR:    Version,
+	})
+}
+
+// A NotCoveredError is returned by Covered if the package has no data for a
+// language.
+type NotCoveredError struct {
+	Tag language.Tag
+
+	// Minimal indicates whether the package was built with the reduced data
+	// set of the textminimal build tag.
+	Minimal bool
+}
+
+func (e *NotCoveredError) Error() string {
+	if e.Minimal {
+		return fmt.Sprintf("display: no data for %s in the textminimal build", e.Tag)
+	}
+	return fmt.Sprintf("display: no data for %s", e.Tag)
+}
+
+// Covered returns nil if the package has names in language t or in a language
+// that is a close match for t, such as de for de-CH, and a *NotCoveredError
+// otherwise. Note that Languages and the other Namer factories may still return
+// a Namer for t, using a less suitable language, if t is not covered.
+func Covered(t language.Tag) error {
+	if _, _, conf := matcher.Match(t); conf >= language.High {
+		return nil
+	}
+	return &NotCoveredError{Tag: t, Minimal: minimal}
 }
 
 // Languages returns a Namer for naming languages. It returns nil if there is no
@@ -111,6 +111,107 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestNamePool(t *testing.T) {
+	if s := poolName(0); s != "" {
+		t.Errorf("poolName(0) = %q; want \"\"", s)
+	}
+	seen := make(map[string]bool)
+	for i, b := range nameBlocks {
+		end := len(nameData)
+		if i+1 < len(nameBlocks) {
+			end = int(nameBlocks[i+1].offset)
+		}
+		if i > 0 && b.size <= nameBlocks[i-1].size {
+			t.Errorf("%d: block size %d not larger than previous", i, b.size)
+		}
+		if b.size == 0 {
+			continue
+		}
+		n := (end - int(b.offset)) / int(b.size)
+		for k := 0; k < n; k++ {
+			s := poolName(b.id + uint16(k))
+			if len(s) != int(b.size) {
+				t.Errorf("%d: len(%q) = %d; want %d", b.id+uint16(k), s, len(s), b.size)
+			}
+			if seen[s] {
+				t.Errorf("%d: duplicate name %q", b.id+uint16(k), s)
+			}
+			seen[s] = true
+		}
+	}
+}
+
+func TestCovered(t *testing.T) {
+	tests := []struct {
+		tag     string
+		covered bool
+	}{
+		{"en", true},
+		{"en-GB", true},
+		{"nl", true},
+		{"nl-BE", true},
+		{"agq", !minimal},
+		{"kw", !minimal},
+		{"sr-Latn", !minimal},
+		{"tlh", false},
+	}
+	for _, tt := range tests {
+		err := Covered(language.MustParse(tt.tag))
+		if (err == nil) != tt.covered {
+			t.Errorf("%s: error was %v; want covered %v", tt.tag, err, tt.covered)
+		}
+		if err == nil {
+			if Languages(language.MustParse(tt.tag)) == nil {
+				t.Errorf("%s: Languages returned nil for covered tag", tt.tag)
+			}
+			continue
+		}
+		if e, ok := err.(*NotCoveredError); !ok || e.Minimal != minimal {
+			t.Errorf("%s: error was %#v; want *NotCoveredError with Minimal %v", tt.tag, err, minimal)
+		}
+	}
+}
+
+func TestIndexBlocks(t *testing.T) {
+	numBlocks := len(indexBlocks) / indexBlockSize
+	seen := make(map[[indexBlockSize]uint16]int)
+	for i := 0; i < numBlocks; i++ {
+		var b [indexBlockSize]uint16
+		copy(b[:], indexBlocks[i*indexBlockSize:])
+		if i == 0 && b != [indexBlockSize]uint16{} {
+			t.Errorf("block 0 is %v; want all zeros", b)
+		}
+		if j, ok := seen[b]; ok {
+			t.Errorf("block %d is a duplicate of block %d", i, j)
+		}
+		seen[b] = i
+	}
+	last := nameBlocks[len(nameBlocks)-1]
+	numNames := int(last.id) + (len(nameData)-int(last.offset))/int(last.size)
+	for i, id := range indexBlocks {
+		if int(id) >= numNames {
+			t.Errorf("indexBlocks[%d] = %d; want an ID less than %d", i, id, numNames)
+		} else if id != 0 && poolName(id) == "" {
+			t.Errorf("indexBlocks[%d] = %d refers to an empty name", i, id)
+		}
+	}
+	for k, table := range [][]header{langHeaders[:], scriptHeaders[:], regionHeaders[:], selfHeaders[:]} {
+		for i, h := range table {
+			if h.data != "" || len(h.index) == 0 {
+				continue
+			}
+			if h.index[len(h.index)-1] == 0 {
+				t.Errorf("%d:%d: index ends with empty block", k, i)
+			}
+			for _, x := range h.index {
+				if int(x) >= numBlocks {
+					t.Errorf("%d:%d: block %d out of range", k, i, x)
+				}
+			}
+		}
+	}
+}
+
 func TestIndex(t *testing.T) {
 	notIn := []string{"aa", "xx", "zz", "aaa", "xxx", "zzz", "Aaaa", "Xxxx", "Zzzz"}
 	tests := []tagIndex{
@@ -170,6 +271,9 @@ func TestIndex(t *testing.T) {
 }
 
 func TestTag(t *testing.T) {
+	if minimal {
+		t.Skip("test uses data not included in the textminimal build")
+	}
 	tests := []struct {
 		dict string
 		tag  string
@@ -224,6 +328,9 @@ func TestTag(t *testing.T) {
 }
 
 func TestLanguage(t *testing.T) {
+	if minimal {
+		t.Skip("test uses data not included in the textminimal build")
+	}
 	tests := []struct {
 		dict string
 		tag  string
@@ -276,6 +383,9 @@ func TestLanguage(t *testing.T) {
 }
 
 func TestScript(t *testing.T) {
+	if minimal {
+		t.Skip("test uses data not included in the textminimal build")
+	}
 	tests := []struct {
 		dict string
 		scr  string
@@ -356,6 +466,9 @@ func TestRegion(t *testing.T) {
 }
 
 func TestSelf(t *testing.T) {
+	if minimal {
+		t.Skip("test uses data not included in the textminimal build")
+	}
 	tests := []struct {
 		tag  string
 		name string
@@ -412,3 +525,42 @@ func TestSelf(t *testing.T) {
 		}
 	}
 }
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		tag, in, out string
+	}{
+		{"und", "x", "“x”"},
+		{"en", "x", "“x”"},
+		{"en-GB", "x", "“x”"},
+		{"nl", "x", "“x”"},
+		{"de", "Hallo", "„Hallo“"},
+		{"de-AT", "Hallo", "„Hallo“"},
+		{"de-CH", "Hallo", "«Hallo»"},
+		{"de-u-co-phonebk", "Hallo", "„Hallo“"},
+		{"fr", "Bonjour", "«\u00a0Bonjour\u00a0»"},
+		{"fr-CA", "Bonjour", "«\u00a0Bonjour\u00a0»"},
+		{"fr-CH", "Bonjour", "«Bonjour»"},
+		{"ja", "x", "「x」"},
+		{"zh", "x", "“x”"},
+		{"zh-Hant", "x", "「x」"},
+		{"zh-TW", "x", "「x」"},
+		{"sv", "x", "”x”"},
+		// Nested quotations.
+		{"en", "a “b” c", "“a ‘b’ c”"},
+		{"en", "don’t", "“don’t”"},
+		{"de", "a „b“ c", "„a ‚b‘ c“"},
+		{"ru", "a «b» c", "«a „b“ c»"},
+		{"sv", "a ”b” c", "”a ’b’ c”"},
+		{"fr", "a «\u00a0b\u00a0» c", "«\u00a0a «\u00a0b\u00a0» c\u00a0»"},
+	}
+	for i, tt := range tests {
+		if q := Quote(language.MustParse(tt.tag), tt.in); q != tt.out {
+			t.Errorf("%d:%s: Quote(%q) = %q; want %q", i, tt.tag, tt.in, q, tt.out)
+		}
+	}
+	de := language.MustParse("de")
+	if q := Quote(de, "Er sagte "+Quote(de, "Hallo")+"."); q != "„Er sagte ‚Hallo‘.“" {
+		t.Errorf("nested Quote = %q; want %q", q, "„Er sagte ‚Hallo‘.“")
+	}
+}
@@ -2,6 +2,10 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+// The output of the examples depends on the full tables.
+
+// +build !textminimal
+
 package display_test
 
 import (
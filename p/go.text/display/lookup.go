@@ -89,6 +89,15 @@ func nameTag(langN, scrN, regN namer, x interface{}) string {
 // greater than the number of languages for which header defines a value.
 // A string for a language may be empty, which means the name is undefined. In
 // the above example, the name for fi (Finnish) is undefined.
+//
+// Only the headers of Dictionaries carry their own data, which allows the
+// linker to drop the data of unused Dictionaries. For all other headers data
+// is empty and the IDs of the names in the shared pool of names are stored in
+// a two-level index. The IDs are split in blocks of indexBlockSize entries.
+// Identical blocks, most notably blocks of undefined names, are stored only
+// once in indexBlocks, which is shared by all headers. For these headers, index
+// holds, for each block of IDs, its position in indexBlocks. The ID 0 denotes
+// the empty string.
 type header struct {
 	data  string
 	index []uint16
@@ -96,12 +105,38 @@ type header struct {
 
 // name looks up the name for a tag in the dictionary, given its index.
 func (h *header) name(i int) string {
+	if h.data == "" {
+		if b := i / indexBlockSize; i >= 0 && b < len(h.index) {
+			p := int(h.index[b])*indexBlockSize + i%indexBlockSize
+			return poolName(indexBlocks[p])
+		}
+		return ""
+	}
 	if i < len(h.index)-1 {
 		return h.data[h.index[i]:h.index[i+1]]
 	}
 	return ""
 }
 
+// A nameBlock describes a run of names of equal length in nameData. The names
+// in nameData are sorted by length, so the names of a block are at
+// nameData[offset+k*size:][:size] for the k-th name of the block.
+type nameBlock struct {
+	id     uint16 // ID of the first name in the block
+	size   uint16 // length of each name in the block
+	offset uint32 // offset of the first name in nameData
+}
+
+// poolName returns the name with the given ID from the shared pool of names.
+func poolName(id uint16) string {
+	i := sort.Search(len(nameBlocks), func(i int) bool {
+		return nameBlocks[i].id > id
+	})
+	b := &nameBlocks[i-1]
+	start := b.offset + uint32(id-b.id)*uint32(b.size)
+	return nameData[start : start+uint32(b.size)]
+}
+
 // tagSet is used to find the index of a language in a set of tags.
 type tagSet struct {
 	single tagIndex
@@ -12,14 +12,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
-	"net/http"
-	"os"
-	"path"
 	"reflect"
 	"sort"
 	"strings"
 
 	"code.google.com/p/go.text/cldr"
+	"code.google.com/p/go.text/internal/gen"
 	"code.google.com/p/go.text/language"
 )
 
@@ -32,8 +30,6 @@ var (
 		"URL of IANA language subtag registry.")
 	test = flag.Bool("test", false,
 		"test existing tables; can be used to compare web data with package data.")
-	localFiles = flag.Bool("local", false,
-		"data files have been copied to the current directory; for debugging only.")
 	stats = flag.Bool("stats", false, "prints statistics to stderr")
 
 	short = flag.Bool("short", false, `Use "short" alternatives, when available.`)
@@ -41,47 +37,45 @@ var (
 		`Minimal draft requirements (approved, contributed, provisional, unconfirmed).`)
 	pkg = flag.String("package", "display",
 		"the name of the package in which the generated file is to be included")
+	minimal = flag.Bool("minimal", false,
+		"generate the tables for the textminimal build tag, which only include "+
+			"English and the languages for which there is a Dictionary; "+
+			"-tags defaults to these languages.")
 
 	tags = newTagSet("tags", []language.Tag{},
 		"space-separated list of tags to include or empty for all")
 	dict = newTagSet("dict", dictTags(),
 		"space-separated list or tags for which to include a Dictionary. "+
-			`"" means the common list from go.text/language.`)
+			"Defaults to the common list from go.text/language; "+
+			`"" means no Dictionaries.`)
 )
 
-func dictTags() (tag []language.Tag) {
-	// TODO: replace with language.Common.Tags() once supported.
-	const str = "af am ar ar-001 az bg bn ca cs da de el en en-US en-GB " +
-		"es es-ES es-419 et fa fi fil fr fr-CA gu he hi hr hu hy id is it ja " +
-		"ka kk km kn ko ky lo lt lv mk ml mn mr ms my ne nl no pa pl pt pt-BR " +
-		"pt-PT ro ru si sk sl sq sr sv sw ta te th tr uk ur uz vi zh zh-Hans " +
-		"zh-Hant zu"
-
-	for _, s := range strings.Split(str, " ") {
-		tag = append(tag, language.MustParse(s))
-	}
-	return tag
+func dictTags() []language.Tag {
+	return language.Common.Tags()
 }
 
 func main() {
-	flag.Parse()
+	gen.Init()
+
+	if *minimal {
+		out.BuildTags = "textminimal"
+		if len(tags) == 0 {
+			for t := range dict {
+				tags[t] = true
+			}
+			tags[language.English] = true
+		}
+	} else if len(tags) == 0 {
+		// Tables for a subset of the locales, such as those created by
+		// textsubset, are not used in place of the minimal tables.
+		out.BuildTags = "!textminimal"
+	}
 
 	// Read the CLDR zip file.
-	if *localFiles {
-		pwd, _ := os.Getwd()
-		*url = "file://" + path.Join(pwd, path.Base(*url))
-	}
-	t := &http.Transport{}
-	t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
-	c := &http.Client{Transport: t}
-	resp, err := c.Get(*url)
+	r, err := gen.Open(*url)
 	if err != nil {
-		log.Fatalf("HTTP GET: %v", err)
+		log.Fatal(err)
 	}
-	if resp.StatusCode != 200 {
-		log.Fatalf(`bad GET status for "%q": %q`, *url, resp.Status)
-	}
-	r := resp.Body
 	defer r.Close()
 
 	d := &cldr.Decoder{}
@@ -96,8 +90,15 @@ func main() {
 		group: make(map[string]*group),
 	}
 	b.generate()
+	if err := out.WriteGoFile(*pkg); err != nil {
+		log.Fatal(err)
+	}
 }
 
+// out accumulates the generated source, which is written to the output file
+// by main.
+var out = gen.NewCodeWriter()
+
 const tagForm = language.All
 
 // tagSet is used to parse command line flags of tags. It implements the
@@ -123,8 +124,12 @@ func (f tagSet) String() string {
 	return strings.Join(tags, " ")
 }
 
-// Set implements Set from the flag.Value interface.
+// Set implements Set from the flag.Value interface. The given tags replace the
+// current set, including the default.
 func (f tagSet) Set(s string) error {
+	for t := range f {
+		delete(f, t)
+	}
 	if s != "" {
 		for _, s := range strings.Split(s, " ") {
 			if s != "" {
@@ -162,6 +167,13 @@ type builder struct {
 	// key-value pairs per group
 	group map[string]*group
 
+	// names shared by all headers that are not part of a Dictionary
+	pool stringPool
+
+	// blocks of name IDs shared by all headers that are not part of a
+	// Dictionary
+	blocks indexBlocks
+
 	// statistics
 	sizeIndex int // total size of all indexes of headers
 	sizeData  int // total size of all data of headers
@@ -196,24 +208,26 @@ type header struct {
 	tag   language.Tag
 	data  string
 	index []uint16
-}
-
-var head = `// Generated by running
-//		maketables -url=%s
-// DO NOT EDIT
 
-package %s
+	// names holds the names for each key for headers that use the shared
+	// string pool. These are converted to IDs once the pool is complete.
+	names []string
+}
 
-// Version is the version of CLDR used to generate the data in this package.
+var head = `// Version is the version of CLDR used to generate the data in this package.
 var Version = %#v
 
+// minimal indicates whether these are the reduced tables of the textminimal
+// build.
+const minimal = %v
+
 `
 
 var self = language.MustParse("mul")
 
 // generate builds and writes all tables.
 func (b *builder) generate() {
-	fmt.Printf(head, *url, *pkg, cldr.Version)
+	fmt.Fprintf(out, head, cldr.Version, *minimal)
 
 	b.filter()
 	b.setData("lang", func(g *group, loc language.Tag, ldn *cldr.LocaleDisplayNames) {
@@ -243,16 +257,11 @@ func (b *builder) generate() {
 
 	b.makeSupported()
 
-	n := b.writeParents()
-
-	n += b.writeGroup("lang")
-	n += b.writeGroup("script")
-	n += b.writeGroup("region")
-
-	b.writeSupported()
-
-	n += b.writeDictionaries()
+	b.buildGroup("lang")
+	b.buildGroup("script")
+	b.buildGroup("region")
 
+	supported := b.supported
 	b.supported = []language.Tag{self}
 
 	// Compute the names of locales in their own language. Some of these names
@@ -283,9 +292,27 @@ func (b *builder) generate() {
 		})
 	}
 
+	b.buildGroup("self")
+	b.supported = supported
+
+	b.pool.build()
+
+	n := b.writeParents()
+
+	n += b.writeGroup("lang")
+	n += b.writeGroup("script")
+	n += b.writeGroup("region")
+
+	b.writeSupported()
+
+	n += b.writeDictionaries()
+
 	n += b.writeGroup("self")
 
-	fmt.Printf("// TOTAL %d Bytes (%d KB)", n, n/1000)
+	n += b.pool.write()
+	n += b.blocks.write()
+
+	out.Size += n
 }
 
 func (b *builder) setData(name string, f func(*group, language.Tag, *cldr.LocaleDisplayNames)) {
@@ -376,7 +403,11 @@ func (a tagsSorter) Len() int           { return len(a) }
 func (a tagsSorter) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a tagsSorter) Less(i, j int) bool { return a[i].String() < a[j].String() }
 
-func (b *builder) writeGroup(name string) int {
+// buildGroup computes the headers for all supported tags for the given group.
+// Headers for tags that have a Dictionary get their own data so that the
+// linker can drop the data for unused Dictionaries. The names of all other
+// headers are added to the shared string pool.
+func (b *builder) buildGroup(name string) {
 	g := b.group[name]
 
 	for _, kv := range g.lang {
@@ -394,6 +425,15 @@ func (b *builder) writeGroup(name string) int {
 			g.headers[i].tag = sup
 			continue
 		}
+		if !dict[sup] {
+			names := make([]string, len(g.toTags))
+			for j, t := range g.toTags {
+				names[j] = kv[t]
+				b.pool.add(kv[t])
+			}
+			g.headers[i] = header{tag: sup, names: names}
+			continue
+		}
 		data := []byte{}
 		index := make([]uint16, len(g.toTags), len(g.toTags)+1)
 		for j, t := range g.toTags {
@@ -409,7 +449,22 @@ func (b *builder) writeGroup(name string) int {
 		}
 		index = index[:n]
 
-		g.headers[i] = header{sup, string(data), index}
+		g.headers[i] = header{tag: sup, data: string(data), index: index}
+	}
+}
+
+func (b *builder) writeGroup(name string) int {
+	g := b.group[name]
+	for i := range g.headers {
+		h := &g.headers[i]
+		if h.names == nil {
+			continue
+		}
+		ids := make([]uint16, len(h.names))
+		for j, s := range h.names {
+			ids[j] = b.pool.ids[s]
+		}
+		h.index = b.blocks.add(ids)
 	}
 	return g.writeTable(name)
 }
@@ -428,18 +483,18 @@ func (l tagsBySize) Less(i, j int) bool {
 }
 
 func (b *builder) writeSupported() {
-	fmt.Printf("const numSupported = %d\n", len(b.supported))
-	fmt.Print("const supported = \"\" +\n\t\"")
+	fmt.Fprintf(out, "const numSupported = %d\n", len(b.supported))
+	fmt.Fprint(out, "const supported = \"\" +\n\t\"")
 	n := 0
 	for _, t := range b.supported {
 		s := t.String()
 		if n += len(s) + 1; n > 80 {
 			n = len(s) + 1
-			fmt.Print("\" + \n\t\"")
+			fmt.Fprint(out, "\" + \n\t\"")
 		}
-		fmt.Printf("%s|", s)
+		fmt.Fprintf(out, "%s|", s)
 	}
-	fmt.Println("\"\n")
+	fmt.Fprintln(out, "\"\n")
 }
 
 // parentIndices returns slice a of len(tags) where tags[a[i]] is the parent
@@ -467,15 +522,15 @@ func parentIndices(tags []language.Tag) []int {
 func (b *builder) writeParents() int {
 	parents := parentIndices(b.supported)
 
-	fmt.Printf("// parent relationship: %d entries\n", len(parents))
-	fmt.Printf("var parents = [%d]int16{", len(parents))
+	fmt.Fprintf(out, "// parent relationship: %d entries\n", len(parents))
+	fmt.Fprintf(out, "var parents = [%d]int16{", len(parents))
 	for i, v := range parents {
 		if i%12 == 0 {
-			fmt.Print("\n\t")
+			fmt.Fprint(out, "\n\t")
 		}
-		fmt.Printf("%d, ", v)
+		fmt.Fprintf(out, "%d, ", v)
 	}
-	fmt.Println("}\n")
+	fmt.Fprintln(out, "}\n")
 	return len(parents) * 2
 }
 
@@ -483,8 +538,8 @@ func (b *builder) writeParents() int {
 // tags are assumed to be sorted by length.
 func writeKeys(name string, keys []string) (n int) {
 	n = int(3 * reflect.TypeOf("").Size())
-	fmt.Printf("// Number of keys: %d\n", len(keys))
-	fmt.Printf("var (\n\t%sIndex = tagIndex{\n", name)
+	fmt.Fprintf(out, "// Number of keys: %d\n", len(keys))
+	fmt.Fprintf(out, "var (\n\t%sIndex = tagIndex{\n", name)
 	for i := 2; i <= 4; i++ {
 		sub := []string{}
 		for _, t := range keys {
@@ -495,31 +550,31 @@ func writeKeys(name string, keys []string) (n int) {
 		}
 		s := strings.Join(sub, "")
 		n += len(s)
-		fmt.Printf("\t\t%+q,\n", s)
+		fmt.Fprintf(out, "\t\t%+q,\n", s)
 		keys = keys[len(sub):]
 	}
-	fmt.Println("\t}")
+	fmt.Fprintln(out, "\t}")
 	if len(keys) > 0 {
-		fmt.Printf("\t%sTagsLong = %#v\n", name, keys)
+		fmt.Fprintf(out, "\t%sTagsLong = %#v\n", name, keys)
 		n += len(keys) * int(reflect.TypeOf("").Size())
 		n += len(strings.Join(keys, ""))
 		n += int(reflect.TypeOf([]string{}).Size())
 	}
-	fmt.Println(")\n")
+	fmt.Fprintln(out, ")\n")
 	return n
 }
 
 func writeString(s string) {
 	k := 0
-	fmt.Print("\t\t\"")
+	fmt.Fprint(out, "\t\t\"")
 	for _, r := range s {
-		fmt.Print(string(r))
+		fmt.Fprint(out, string(r))
 		if k++; k == 80 {
-			fmt.Print("\" +\n\t\t\"")
+			fmt.Fprint(out, "\" +\n\t\t\"")
 			k = 0
 		}
 	}
-	fmt.Print(`"`)
+	fmt.Fprint(out, `"`)
 }
 
 func writeUint16Body(a []uint16) {
@@ -532,11 +587,11 @@ func writeUint16Body(a []uint16) {
 		} else {
 			v = nil
 		}
-		fmt.Printf("\t\t\t")
+		fmt.Fprintf(out, "\t\t\t")
 		for _, x := range vv {
-			fmt.Printf("0x%x, ", x)
+			fmt.Fprintf(out, "0x%x, ", x)
 		}
-		fmt.Println()
+		fmt.Fprintln(out)
 	}
 }
 
@@ -551,22 +606,20 @@ func (h *header) writeEntry(name string) int {
 	n += len(h.data)
 	n += len(h.index) * 2
 
-	if len(dict) > 0 && dict.contains(h.tag) {
-		fmt.Printf("\t{ // %s\n", h.tag)
-		fmt.Printf("\t\t%[1]s%[2]sStr,\n\t\t%[1]s%[2]sIdx,\n", identifier(h.tag), name)
+	if dict[h.tag] {
+		fmt.Fprintf(out, "\t{ // %s\n", h.tag)
+		fmt.Fprintf(out, "\t\t%[1]s%[2]sStr,\n\t\t%[1]s%[2]sIdx,\n", identifier(h.tag), name)
 		n += int(reflect.TypeOf(h.index).Size())
-		fmt.Println("\t},")
-	} else if len(h.data) == 0 {
-		fmt.Println("\t\t{}, //", h.tag)
+		fmt.Fprintln(out, "\t},")
+	} else if len(h.index) == 0 {
+		fmt.Fprintln(out, "\t\t{}, //", h.tag)
 	} else {
-		fmt.Printf("\t{ // %s\n", h.tag)
-		writeString(h.data)
-		fmt.Println(",")
-
-		fmt.Printf("\t\t[]uint16{ // %d entries\n", len(h.index))
+		fmt.Fprintf(out, "\t{ // %s\n", h.tag)
+		fmt.Fprintln(out, "\t\t\"\",")
+		fmt.Fprintf(out, "\t\t[]uint16{ // %d entries\n", len(h.index))
 		writeUint16Body(h.index)
-		fmt.Println("\t\t},")
-		fmt.Println("\t},")
+		fmt.Fprintln(out, "\t\t},")
+		fmt.Fprintln(out, "\t},")
 	}
 
 	return n
@@ -575,61 +628,61 @@ func (h *header) writeEntry(name string) int {
 // write the data for the given header as single entries. The size for this data
 // was already accounted for in writeEntry.
 func (h *header) writeSingle(name string) {
-	if len(dict) > 0 && dict.contains(h.tag) {
+	if dict[h.tag] {
 		tag := identifier(h.tag)
-		fmt.Printf("const %s%sStr = \"\" +\n", tag, name)
+		fmt.Fprintf(out, "const %s%sStr = \"\" +\n", tag, name)
 		writeString(h.data)
-		fmt.Println("\n")
+		fmt.Fprintln(out, "\n")
 
 		// Note that we create a slice instead of an array. If we use an array
 		// we need to refer to it as a[:] in other tables, which will cause the
 		// array to always be included by the linker. See Issue 7651.
-		fmt.Printf("var %s%sIdx = []uint16{ // %d entries\n", tag, name, len(h.index))
+		fmt.Fprintf(out, "var %s%sIdx = []uint16{ // %d entries\n", tag, name, len(h.index))
 		writeUint16Body(h.index)
-		fmt.Println("}\n")
+		fmt.Fprintln(out, "}\n")
 	}
 }
 
 // WriteTable writes an entry for a single Namer.
 func (g *group) writeTable(name string) int {
 	n := writeKeys(name, g.toTags)
-	fmt.Printf("var %sHeaders = [%d]header{\n", name, len(g.headers))
+	fmt.Fprintf(out, "var %sHeaders = [%d]header{\n", name, len(g.headers))
 
 	title := strings.Title(name)
 	for _, h := range g.headers {
 		n += h.writeEntry(title)
 	}
-	fmt.Println("}\n")
+	fmt.Fprintln(out, "}\n")
 
 	for _, h := range g.headers {
 		h.writeSingle(title)
 	}
 
-	fmt.Printf("// Total size for %s: %d bytes (%d KB)\n\n", name, n, n/1000)
+	fmt.Fprintf(out, "// Total size for %s: %d bytes (%d KB)\n\n", name, n, n/1000)
 	return n
 }
 
 func (b *builder) writeDictionaries() int {
-	fmt.Println("// Dictionary entries of frequent languages")
-	fmt.Println("var (")
+	fmt.Fprintln(out, "// Dictionary entries of frequent languages")
+	fmt.Fprintln(out, "var (")
 	parents := parentIndices(b.supported)
 
 	for i, t := range b.supported {
-		if dict.contains(t) {
+		if dict[t] {
 			ident := identifier(t)
-			fmt.Printf("\t%s = Dictionary{ // %s\n", ident, t)
+			fmt.Fprintf(out, "\t%s = Dictionary{ // %s\n", ident, t)
 			if p := parents[i]; p == -1 {
-				fmt.Println("\t\tnil,")
+				fmt.Fprintln(out, "\t\tnil,")
 			} else {
-				fmt.Printf("\t\t&%s,\n", identifier(b.supported[p]))
+				fmt.Fprintf(out, "\t\t&%s,\n", identifier(b.supported[p]))
 			}
-			fmt.Printf("\t\theader{%[1]sLangStr, %[1]sLangIdx},\n", ident)
-			fmt.Printf("\t\theader{%[1]sScriptStr, %[1]sScriptIdx},\n", ident)
-			fmt.Printf("\t\theader{%[1]sRegionStr, %[1]sRegionIdx},\n", ident)
-			fmt.Println("\t}")
+			fmt.Fprintf(out, "\t\theader{%[1]sLangStr, %[1]sLangIdx},\n", ident)
+			fmt.Fprintf(out, "\t\theader{%[1]sScriptStr, %[1]sScriptIdx},\n", ident)
+			fmt.Fprintf(out, "\t\theader{%[1]sRegionStr, %[1]sRegionIdx},\n", ident)
+			fmt.Fprintln(out, "\t}")
 		}
 	}
-	fmt.Println(")")
+	fmt.Fprintln(out, ")")
 
 	var s string
 	var a []uint16
@@ -638,8 +691,142 @@ func (b *builder) writeDictionaries() int {
 	sz *= 3
 	sz += reflect.TypeOf(&a).Size()
 	n := int(sz) * len(dict)
-	fmt.Printf("// Total size for %d entries: %d bytes (%d KB)\n\n", len(dict), n, n/1000)
+	fmt.Fprintf(out, "// Total size for %d entries: %d bytes (%d KB)\n\n", len(dict), n, n/1000)
+
+	return n
+}
+
+// stringPool holds the names shared by all headers that are not part of a
+// Dictionary. Each distinct name is stored once. Names are sorted by length,
+// so that the position of a name in the data can be computed from its ID using
+// a small table of blocks of names of equal length.
+type stringPool struct {
+	ids    map[string]uint16
+	names  []string
+	blocks []nameBlock
+	data   string
+}
+
+type nameBlock struct {
+	id     int
+	size   int
+	offset int
+}
+
+func (p *stringPool) add(s string) {
+	if p.ids == nil {
+		p.ids = map[string]uint16{"": 0}
+		p.names = []string{""}
+	}
+	if _, ok := p.ids[s]; !ok {
+		p.ids[s] = 0
+		p.names = append(p.names, s)
+	}
+}
+
+type bySizeAndValue []string
+
+func (l bySizeAndValue) Len() int      { return len(l) }
+func (l bySizeAndValue) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l bySizeAndValue) Less(i, j int) bool {
+	if len(l[i]) != len(l[j]) {
+		return len(l[i]) < len(l[j])
+	}
+	return l[i] < l[j]
+}
+
+// build assigns IDs to all names. The empty string always has ID 0.
+func (p *stringPool) build() {
+	p.add("")
+	if len(p.names) > 1<<16 {
+		log.Fatalf("too many names for 16-bit IDs: %d", len(p.names))
+	}
+	sort.Sort(bySizeAndValue(p.names))
+	data := []byte{}
+	for i, s := range p.names {
+		p.ids[s] = uint16(i)
+		if i == 0 || len(s) != len(p.names[i-1]) {
+			p.blocks = append(p.blocks, nameBlock{i, len(s), len(data)})
+		}
+		data = append(data, s...)
+	}
+	p.data = string(data)
+}
+
+func (p *stringPool) write() int {
+	fmt.Fprintf(out, "// nameData holds %d names shared by the headers.\n", len(p.names))
+	fmt.Fprintln(out, "const nameData = \"\" +")
+	writeString(p.data)
+	fmt.Fprintln(out, "\n")
+
+	fmt.Fprintf(out, "var nameBlocks = [%d]nameBlock{\n", len(p.blocks))
+	for _, b := range p.blocks {
+		fmt.Fprintf(out, "\t{0x%x, 0x%x, 0x%x},\n", b.id, b.size, b.offset)
+	}
+	fmt.Fprintln(out, "}\n")
+
+	n := len(p.data) + len(p.blocks)*8
+	fmt.Fprintf(out, "// Total size for shared names: %d bytes (%d KB)\n\n", n, n/1000)
+	return n
+}
+
+// indexBlockSize is the number of name IDs in a block of the two-level index
+// of headers that use the shared string pool. Smaller blocks are more likely
+// to be shared, but increase the size of the first-level indexes. A size of 8
+// yields the smallest tables.
+const indexBlockSize = 8
+
+type indexBlock [indexBlockSize]uint16
+
+// indexBlocks holds the distinct blocks of name IDs of all headers that use the
+// shared string pool. Block 0 consists of empty names only.
+type indexBlocks struct {
+	ids    map[indexBlock]uint16
+	blocks []indexBlock
+}
+
+// add splits ids in blocks, adds the blocks not seen before, and returns the
+// first-level index for ids. Trailing blocks of empty names are omitted.
+func (x *indexBlocks) add(ids []uint16) []uint16 {
+	if x.ids == nil {
+		x.ids = map[indexBlock]uint16{indexBlock{}: 0}
+		x.blocks = []indexBlock{indexBlock{}}
+	}
+	index := []uint16{}
+	for len(ids) > 0 {
+		var b indexBlock
+		ids = ids[copy(b[:], ids):]
+		id, ok := x.ids[b]
+		if !ok {
+			if len(x.blocks) == 1<<16 {
+				log.Fatalf("too many index blocks for 16-bit indexes")
+			}
+			id = uint16(len(x.blocks))
+			x.ids[b] = id
+			x.blocks = append(x.blocks, b)
+		}
+		index = append(index, id)
+	}
+	// Trim the tail of the index.
+	n := len(index)
+	for ; n > 0 && index[n-1] == 0; n-- {
+	}
+	return index[:n]
+}
+
+func (x *indexBlocks) write() int {
+	a := make([]uint16, 0, len(x.blocks)*indexBlockSize)
+	for _, b := range x.blocks {
+		a = append(a, b[:]...)
+	}
+	fmt.Fprintf(out, "const indexBlockSize = %d\n\n", indexBlockSize)
+	fmt.Fprintf(out, "// indexBlocks holds %d blocks of name IDs shared by the headers.\n", len(x.blocks))
+	fmt.Fprintf(out, "var indexBlocks = [%d]uint16{\n", len(a))
+	writeUint16Body(a)
+	fmt.Fprintln(out, "}\n")
 
+	n := len(a) * 2
+	fmt.Fprintf(out, "// Total size for index blocks: %d bytes (%d KB)\n\n", n, n/1000)
 	return n
 }
 
@@ -0,0 +1,10032 @@
+// This file has the layout written by
+//		maketables -minimal -output=tables_minimal.go
+// but was not produced by a run of it. Its data was converted from the tables
+// generated by
+//		maketables -url=http://www.unicode.org/Public/cldr/25/core.zip
+// by passing the names in those tables to the writers of maketables, as CLDR
+// could not be downloaded. Do not edit it by hand; run "make tables" to
+// regenerate it from CLDR.
+
+// +build textminimal
+
+package display
+
+// Version is the version of CLDR used to generate the data in this package.
+var Version = "25"
+
+// minimal indicates whether these are the reduced tables of the textminimal
+// build.
+const minimal = true
+
+// parent relationship: 73 entries
+var parents = [73]int16{
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	11, -1, 13, -1, -1, -1, -1, -1, 19, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 51, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1}
+
+// Number of keys: 78
+var (
+	langIndex = tagIndex{
+		"afamarazbgbncacsdadeelenesetfafifrguhehihrhuhyidisitjakakkkmknkokyloltlvmkmlmnmrmsmynenlnopaplptrorusiskslsqsrsvswtatethtrukuruzvizhzu",
+		"fil",
+		"",
+	}
+	langTagsLong = []string{"ar-001", "en-GB", "en-US", "es-419", "es-ES", "fr-CA", "pt-BR", "pt-PT", "zh-Hans", "zh-Hant"}
+)
+
+var langHeaders = [73]header{
+	{ // af
+		afLangStr,
+		afLangIdx,
+	},
+	{ // am
+		amLangStr,
+		amLangIdx,
+	},
+	{ // ar
+		arLangStr,
+		arLangIdx,
+	},
+	{ // az
+		azLangStr,
+		azLangIdx,
+	},
+	{ // bg
+		bgLangStr,
+		bgLangIdx,
+	},
+	{ // bn
+		bnLangStr,
+		bnLangIdx,
+	},
+	{ // ca
+		caLangStr,
+		caLangIdx,
+	},
+	{ // cs
+		csLangStr,
+		csLangIdx,
+	},
+	{ // da
+		daLangStr,
+		daLangIdx,
+	},
+	{ // de
+		deLangStr,
+		deLangIdx,
+	},
+	{ // el
+		elLangStr,
+		elLangIdx,
+	},
+	{ // en
+		enLangStr,
+		enLangIdx,
+	},
+	{ // en-GB
+		enGBLangStr,
+		enGBLangIdx,
+	},
+	{ // es
+		esLangStr,
+		esLangIdx,
+	},
+	{ // es-419
+		es419LangStr,
+		es419LangIdx,
+	},
+	{ // et
+		etLangStr,
+		etLangIdx,
+	},
+	{ // fa
+		faLangStr,
+		faLangIdx,
+	},
+	{ // fi
+		fiLangStr,
+		fiLangIdx,
+	},
+	{ // fil
+		filLangStr,
+		filLangIdx,
+	},
+	{ // fr
+		frLangStr,
+		frLangIdx,
+	},
+	{ // fr-CA
+		frCALangStr,
+		frCALangIdx,
+	},
+	{ // gu
+		guLangStr,
+		guLangIdx,
+	},
+	{ // he
+		heLangStr,
+		heLangIdx,
+	},
+	{ // hi
+		hiLangStr,
+		hiLangIdx,
+	},
+	{ // hr
+		hrLangStr,
+		hrLangIdx,
+	},
+	{ // hu
+		huLangStr,
+		huLangIdx,
+	},
+	{ // hy
+		hyLangStr,
+		hyLangIdx,
+	},
+	{ // id
+		idLangStr,
+		idLangIdx,
+	},
+	{ // is
+		isLangStr,
+		isLangIdx,
+	},
+	{ // it
+		itLangStr,
+		itLangIdx,
+	},
+	{ // ja
+		jaLangStr,
+		jaLangIdx,
+	},
+	{ // ka
+		kaLangStr,
+		kaLangIdx,
+	},
+	{ // kk
+		kkLangStr,
+		kkLangIdx,
+	},
+	{ // km
+		kmLangStr,
+		kmLangIdx,
+	},
+	{ // kn
+		knLangStr,
+		knLangIdx,
+	},
+	{ // ko
+		koLangStr,
+		koLangIdx,
+	},
+	{ // ky
+		kyLangStr,
+		kyLangIdx,
+	},
+	{ // lo
+		loLangStr,
+		loLangIdx,
+	},
+	{ // lt
+		ltLangStr,
+		ltLangIdx,
+	},
+	{ // lv
+		lvLangStr,
+		lvLangIdx,
+	},
+	{ // mk
+		mkLangStr,
+		mkLangIdx,
+	},
+	{ // ml
+		mlLangStr,
+		mlLangIdx,
+	},
+	{ // mn
+		mnLangStr,
+		mnLangIdx,
+	},
+	{ // mr
+		mrLangStr,
+		mrLangIdx,
+	},
+	{ // ms
+		msLangStr,
+		msLangIdx,
+	},
+	{ // my
+		myLangStr,
+		myLangIdx,
+	},
+	{ // ne
+		neLangStr,
+		neLangIdx,
+	},
+	{ // nl
+		nlLangStr,
+		nlLangIdx,
+	},
+	{ // no
+		noLangStr,
+		noLangIdx,
+	},
+	{ // pa
+		paLangStr,
+		paLangIdx,
+	},
+	{ // pl
+		plLangStr,
+		plLangIdx,
+	},
+	{ // pt
+		ptLangStr,
+		ptLangIdx,
+	},
+	{ // pt-PT
+		ptPTLangStr,
+		ptPTLangIdx,
+	},
+	{ // ro
+		roLangStr,
+		roLangIdx,
+	},
+	{ // ru
+		ruLangStr,
+		ruLangIdx,
+	},
+	{ // si
+		siLangStr,
+		siLangIdx,
+	},
+	{ // sk
+		skLangStr,
+		skLangIdx,
+	},
+	{ // sl
+		slLangStr,
+		slLangIdx,
+	},
+	{ // sq
+		sqLangStr,
+		sqLangIdx,
+	},
+	{ // sr
+		srLangStr,
+		srLangIdx,
+	},
+	{ // sv
+		svLangStr,
+		svLangIdx,
+	},
+	{ // sw
+		swLangStr,
+		swLangIdx,
+	},
+	{ // ta
+		taLangStr,
+		taLangIdx,
+	},
+	{ // te
+		teLangStr,
+		teLangIdx,
+	},
+	{ // th
+		thLangStr,
+		thLangIdx,
+	},
+	{ // tr
+		trLangStr,
+		trLangIdx,
+	},
+	{ // uk
+		ukLangStr,
+		ukLangIdx,
+	},
+	{ // ur
+		urLangStr,
+		urLangIdx,
+	},
+	{ // uz
+		uzLangStr,
+		uzLangIdx,
+	},
+	{ // vi
+		viLangStr,
+		viLangIdx,
+	},
+	{ // zh
+		zhLangStr,
+		zhLangIdx,
+	},
+	{ // zh-Hant
+		zhHantLangStr,
+		zhHantLangIdx,
+	},
+	{ // zu
+		zuLangStr,
+		zuLangIdx,
+	},
+}
+
+const afLangStr = "" +
+	"AfrikaansAmhariesArabiesAzerbeidjaansBulgaarsBengaalsKatalaansTsjeggiesDeensDuit" +
+	"sGrieksEngelsSpaansEstniesPersiesFinsFransGudjaratiHebreeusHindiKroatiesHongaars" +
+	"ArmeensIndonesiesYslandsItaliaansJapanneesGeorgiesKazakKhmerKannadaKoreaansKirgi" +
+	"siesLaoLitausLettiesMasedoniesMalabaarsMongoolsMarathiMaleisiesBirmaansNepaleesN" +
+	"ederlandsNoorse BokmålPandjabiPoolsPortugeesRoemeensRussiesSinhalaSlowaaksSlowee" +
+	"nsAlbaneesSerwiesSweedsSwahiliTamilTeluguThaisTurksOekraïensOerdoeOezbeeksViëtna" +
+	"meesSjineesZoeloeFilippynsModerne Standaard ArabiesBritse EngelsAmerikaanse Enge" +
+	"lsLatyns-Amerikaanse SpaansEuropese SpaansKanadese FransBrasiliaanse PortugeesEu" +
+	"ropese PortugeesVereenvoudigde ChineesTradisionele Chinees"
+
+var afLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x11, 0x18, 0x25, 0x2d, 0x35, 0x3e, 0x47, 0x4c, 0x51, 0x57,
+	0x5d, 0x63, 0x6a, 0x71, 0x75, 0x7a, 0x83, 0x8b, 0x90, 0x98, 0xa0, 0xa7,
+	0xb1, 0xb8, 0xc1, 0xca, 0xd2, 0xd7, 0xdc, 0xe3, 0xeb, 0xf4, 0xf7, 0xfd,
+	0x104, 0x10e, 0x117, 0x11f, 0x126, 0x12f, 0x137, 0x13f, 0x149, 0x157, 0x15f, 0x164,
+	0x16d, 0x175, 0x17c, 0x183, 0x18b, 0x193, 0x19b, 0x1a2, 0x1a8, 0x1af, 0x1b4, 0x1ba,
+	0x1bf, 0x1c4, 0x1ce, 0x1d4, 0x1dc, 0x1e7, 0x1ee, 0x1f4, 0x1fd, 0x216, 0x223, 0x235,
+	0x24e, 0x25d, 0x26b, 0x281, 0x293, 0x2a9, 0x2bd,
+}
+
+const amLangStr = "" +
+	"አፍሪካንኛአማርኛዐርቢኛአዘርባጃንኛቡልጋሪኛቤንጋሊኛካታላንኛቼክኛዴኒሽጀርመንግሪክኛእንግሊዝኛስፓኒሽኢስቶኒያንኛፐርሺያኛፊኒሽፈረንሳይ" +
+	"ኛጉጃርቲኛዕብራስጥሒንዱኛክሮሽያንኛሀንጋሪኛአርመናዊኢንዶኔዥኛአይስላንድኛጣሊያንኛጃፓንኛጆርጂያንካዛክኛክመርኛ ማእከላዊካናዳኛኮሪያኛ" +
+	"ኪርጊዝኛላውስኛሉቴንያንኛላትቪያንማሴዶንኛማላያላምኛሞንጎላዊኛማራቲኛማላይኛቡርማኛኔፓሊኛደችየኖርዌይ ቦክማልፑንጃብኛፖሊሽኛፖርቹጋልኛ" +
+	"ሮማኒያንራሽኛሲንሃልኛስሎቫክኛስሎቪኛልቤኒኛሰርቢኛስዊድንኛስዋሂሊኛታሚልኛተሉጉኛታይኛቱርክኛዩክሬንኛኡርዱኛኡዝቤክኛቪትናምኛቻይንኛዙሉ" +
+	"ኛፊሊፒንኛዘመናዊ መደበኛ አረብኛየብሪቲሽ እንግሊዝኛየአሜሪካ እንግሊዝኛየላቲን አሜሪካ ስፓኒሽየአውሮፓ እስፓንኛየካናዳ ፈረንሳይኛ" +
+	"የብራዚል ፖርቹጋልኛየአውሮፓ ፖርቹጋልኛቀለል ያለ ቻይንኛባህላዊ ቻይንኛ"
+
+var amLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x1e, 0x2a, 0x3f, 0x4e, 0x5d, 0x6c, 0x75, 0x7e, 0x8a, 0x96,
+	0xa8, 0xb4, 0xc9, 0xd8, 0xe1, 0xf3, 0x102, 0x111, 0x11d, 0x12f, 0x13e, 0x14d,
+	0x15f, 0x174, 0x183, 0x18f, 0x19e, 0x1aa, 0x1c6, 0x1d2, 0x1de, 0x1ed, 0x1f9, 0x20b,
+	0x21a, 0x229, 0x23b, 0x24d, 0x259, 0x265, 0x271, 0x27d, 0x283, 0x29f, 0x2ae, 0x2ba,
+	0x2cc, 0x2db, 0x2e4, 0x2f3, 0x302, 0x30e, 0x31a, 0x326, 0x335, 0x344, 0x350, 0x35c,
+	0x365, 0x371, 0x380, 0x38c, 0x39b, 0x3aa, 0x3b6, 0x3bf, 0x3ce, 0x3f4, 0x416, 0x438,
+	0x45e, 0x47d, 0x49c, 0x4be, 0x4e0, 0x4fd, 0x516,
+}
+
+const arLangStr = "" +
+	"الأفريقيةالأمهريةالعربيةالأذريةالبلغاريةالبنغاليةالكتالانيةالتشيكيةالدانماركيةال" +
+	"ألمانيةاليونانيةالإنجليزيةالإسبانيةالإستونيةالفارسيةالفنلنديةالفرنسيةالغوجاراتية" +
+	"العبريةالهنديةالكرواتيةالهنغاريةالأرمينيةالإندونيسيةالأيسلانديةالإيطاليةالياباني" +
+	"ةالجورجيةالكازاخستانيةالخميريةالكاناداالكوريةالقرغيزيةاللاويةاللتوانيةاللاتفيةال" +
+	"مقدونيةالماليالامالمنغوليةالماراثيلغة الملايوالبورميةالنيباليةالهولنديةالبوكمالي" +
+	"ة النرويجيةالبنجابيةالبولنديةالبرتغاليةالرومانيةالروسيةالسنهاليةالسلوفاكيةالسلوف" +
+	"انيةالألبانيةالصربيةالسويديةالسواحليةالتاميليةالتيلجوالتايلانديةالتركيةالأوكراني" +
+	"ةالأرديةالأوزباكيةالفيتناميةالصينيةالزولوالفلبينيةالعربية الرسمية الحديثةالإنجلي" +
+	"زية البريطانيةالإنجليزية الولايات المتحدةإسبانية أمريكا اللاتينيةالإسبانية الأور" +
+	"وبيةالفرنسية الكنديةالبرتغالية البرازيليةالبرتغالية الأوروبيةالصينية المبسطةالصي" +
+	"نية التقليدية"
+
+var arLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x22, 0x30, 0x3e, 0x50, 0x62, 0x76, 0x86, 0x9c, 0xae, 0xc0,
+	0xd4, 0xe6, 0xf8, 0x108, 0x11a, 0x12a, 0x140, 0x14e, 0x15c, 0x16e, 0x180, 0x192,
+	0x1a8, 0x1be, 0x1d0, 0x1e2, 0x1f2, 0x20c, 0x21c, 0x22c, 0x23a, 0x24c, 0x25a, 0x26c,
+	0x27c, 0x28e, 0x2a2, 0x2b4, 0x2c4, 0x2d9, 0x2e9, 0x2fb, 0x30d, 0x334, 0x346, 0x358,
+	0x36c, 0x37e, 0x38c, 0x39e, 0x3b2, 0x3c6, 0x3d8, 0x3e6, 0x3f6, 0x408, 0x41a, 0x428,
+	0x43e, 0x44c, 0x460, 0x46e, 0x482, 0x496, 0x4a4, 0x4b0, 0x4c2, 0x4ee, 0x517, 0x54b,
+	0x579, 0x59e, 0x5bd, 0x5e6, 0x60d, 0x62a, 0x64b,
+}
+
+const azLangStr = "" +
+	"afrikaansamharərəbazərbaycanbolqarbenqalkatalançexdanimarkaalmanyunaningilisispa" +
+	"nestonfarsfinfransızqucarativrithindixorvatmacarerməniindoneziyaislanditalyanyap" +
+	"ongürcüqazaxkxmerkannadakoreyaqırğızlaoslitvalatışmakedonmalayalammonqolcamarati" +
+	"malaybirmanepalhollandbokmal norveçpəncabpolyakportuqalrumınrussinhalslovakslove" +
+	"nalbanserbisveçsuahilitamilteluqutaytürkukraynaurduözbəkvyetnamçinzulufilippinMo" +
+	"dern Standart ƏrəbcəBritaniya ingiliscəsiAmerika ingiliscəsiLatın Amerikası ispa" +
+	"ncasıKastiliya ispancasıKanada fransızcasıBraziliya portuqalcasıPortuqaliya port" +
+	"uqalcasısadələşmiş çinənənəvi çin"
+
+var azLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0xe, 0x14, 0x1f, 0x25, 0x2b, 0x32, 0x36, 0x3f, 0x44, 0x49,
+	0x50, 0x55, 0x5a, 0x5e, 0x61, 0x69, 0x70, 0x75, 0x7a, 0x80, 0x85, 0x8c,
+	0x96, 0x9c, 0xa3, 0xa8, 0xaf, 0xb4, 0xb9, 0xc0, 0xc6, 0xcf, 0xd3, 0xd8,
+	0xdf, 0xe6, 0xef, 0xf7, 0xfd, 0x102, 0x107, 0x10c, 0x113, 0x121, 0x128, 0x12e,
+	0x136, 0x13c, 0x13f, 0x145, 0x14b, 0x151, 0x156, 0x15a, 0x160, 0x167, 0x16c, 0x172,
+	0x175, 0x17a, 0x181, 0x185, 0x18c, 0x193, 0x197, 0x19b, 0x1a3, 0x1bc, 0x1d2, 0x1e6,
+	0x202, 0x216, 0x22a, 0x241, 0x25a, 0x26d, 0x27c,
+}
+
+const bgLangStr = "" +
+	"африкансамхарскиарабскиазербайджанскибългарскибенгалскикаталонскичешкидатскинемс" +
+	"кигръцкианглийскииспанскиестонскиперсийскифинскифренскигуджаратиивритхиндихърват" +
+	"скиунгарскиарменскииндонезийскиисландскииталианскияпонскигрузинскиказахскикхмерс" +
+	"киканнадакорейскикиргизкилаоскилитовскилатвийскимакедонскималаяламмонголскимарат" +
+	"ималайскибирманскинепалскинидерландскинорвежки букмолпенджабскиполскипортугалски" +
+	"румънскирускисинхалскисловашкисловенскиалбанскисръбскишведскисуахилитамилскителу" +
+	"гутайскитурскиукраинскиурдуузбекскивиетнамскикитайскизулускифилипинскисъвременен" +
+	" стандартен арабскибритански английскиамерикански английскиканадски френскиопрос" +
+	"тен китайскитрадиционен китайски"
+
+var bgLangIdx = []uint16{ // 79 entries
+	0x0, 0x10, 0x20, 0x2e, 0x4a, 0x5c, 0x6e, 0x82, 0x8c, 0x98, 0xa4, 0xb0,
+	0xc2, 0xd2, 0xe2, 0xf4, 0x100, 0x10e, 0x120, 0x12a, 0x134, 0x146, 0x156, 0x166,
+	0x17e, 0x190, 0x1a4, 0x1b2, 0x1c4, 0x1d4, 0x1e4, 0x1f2, 0x202, 0x212, 0x21e, 0x22e,
+	0x240, 0x254, 0x264, 0x276, 0x282, 0x292, 0x2a4, 0x2b4, 0x2cc, 0x2e9, 0x2fd, 0x309,
+	0x31f, 0x32f, 0x339, 0x34b, 0x35b, 0x36d, 0x37d, 0x38b, 0x399, 0x3a7, 0x3b7, 0x3c3,
+	0x3cf, 0x3db, 0x3ed, 0x3f5, 0x405, 0x419, 0x429, 0x437, 0x44b, 0x483, 0x4a8, 0x4d1,
+	0x4d1, 0x4d1, 0x4f0, 0x4f0, 0x4f0, 0x511, 0x538,
+}
+
+const bnLangStr = "" +
+	"আফ্রিকান্সআমহারিকআরবীআজারবাইজানীয়বুলগেরিয়বাংলাকাতালানচেকডেনিশজার্মানগ্রিকইংরেজ" +
+	"িস্পেনীয়এস্তোনীয়ফার্সিফিনিশফরাসিগুজরাটিহিব্রুহিন্দিক্রোয়েশীয়হাঙ্গেরীয়আর্মেন" +
+	"িয়ইন্দোনেশীয়আইসল্যান্ডীয়ইতালীয়জাপানিজর্জিয়ানকাজাখখমেরকান্নাড়ীকোরিয়ানকির্গ" +
+	"িজলাওলিথুয়েনীয়লাত্‌ভীয়ম্যাসেডোনীয়মালেয়ালামমঙ্গোলিয়মারাঠিমালেবর্মিনেপালীডাচ" +
+	"নরওয়ে বোকমালপাঞ্জাবীপোলিশপর্তুগীজরোমানীয়রুশসিংহলীস্লোভাকস্লোভেনীয়আলবেনীয়সার্" +
+	"বীয়সুইডিশসোয়াহিলিতামিলতেলেগুথাইতুর্কীইউক্রেনীয়উর্দুউজবেকীয়ভিয়েতনামীচীনাজুলু" +
+	"ফিলিপিনোআধুনিক আদর্শ আরবীব্রিটিশ ইংরেজিযুক্তরাষ্ট্র ইংরেজিল্যাটিন আমেরিকান স্প্য" +
+	"ানিশইউরোপীয় স্প্যানিশকানাডীয় ফরাসিব্রাজিলীয় পর্তুগীজইউরোপিয় পর্তুগীজসরলীকৃত " +
+	"চীনাঐতিহ্যবাহি চীনা"
+
+var bnLangIdx = []uint16{ // 79 entries
+	0x0, 0x1e, 0x33, 0x3f, 0x66, 0x81, 0x90, 0xa5, 0xae, 0xbd, 0xd2, 0xe1,
+	0xf3, 0x10b, 0x126, 0x138, 0x147, 0x156, 0x16b, 0x17d, 0x18f, 0x1b0, 0x1ce, 0x1e9,
+	0x20a, 0x231, 0x246, 0x258, 0x273, 0x282, 0x28e, 0x2a9, 0x2c1, 0x2d6, 0x2df, 0x300,
+	0x31b, 0x33f, 0x35d, 0x378, 0x38a, 0x396, 0x3a5, 0x3b7, 0x3c0, 0x3e5, 0x3fd, 0x40c,
+	0x424, 0x43c, 0x445, 0x457, 0x46c, 0x48a, 0x4a2, 0x4ba, 0x4cc, 0x4e7, 0x4f6, 0x508,
+	0x511, 0x523, 0x541, 0x550, 0x568, 0x586, 0x592, 0x59e, 0x5b6, 0x5e5, 0x60d, 0x644,
+	0x68e, 0x6c2, 0x6ea, 0x721, 0x752, 0x774, 0x79f,
+}
+
+const caLangStr = "" +
+	"afrikaansamhàricàrabazerbaidjanèsbúlgarbengalícatalàtxecdanèsalemanygrecanglèses" +
+	"panyolestoniàpersafinèsfrancèsgujaratihebreuhindicroathongarèsarmeniindonesiisla" +
+	"ndèsitaliàjaponèsgeorgiàkazakhkhmerkannadacoreàkirguíslaosiàlituàletómacedonimal" +
+	"aiàlammongolmarathimalaibirmànepalèsneerlandèsnoruec bokmålpanjabipolonèsportugu" +
+	"èsromanèsrussingalèseslovaceslovèalbanèsserbisuecsuahilitàmiltelugutailandèsturc" +
+	"ucraïnèsurdúuzbekvietnamitaxinèszulufilipíàrab estàndard modernanglès britànican" +
+	"glès americàespanyol hispanoamericàespanyol europeufrancès canadencportuguès del" +
+	" Brasilportuguès de Portugalxinès simplificatxinès tradicional"
+
+var caLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x11, 0x16, 0x24, 0x2b, 0x33, 0x3a, 0x3e, 0x44, 0x4b, 0x4f,
+	0x56, 0x5e, 0x66, 0x6b, 0x71, 0x79, 0x81, 0x87, 0x8c, 0x91, 0x9a, 0xa0,
+	0xa8, 0xb1, 0xb8, 0xc0, 0xc8, 0xce, 0xd3, 0xda, 0xe0, 0xe8, 0xef, 0xf5,
+	0xfa, 0x102, 0x10c, 0x112, 0x119, 0x11e, 0x124, 0x12c, 0x137, 0x145, 0x14c, 0x154,
+	0x15e, 0x166, 0x169, 0x172, 0x179, 0x180, 0x188, 0x18d, 0x191, 0x198, 0x19e, 0x1a4,
+	0x1ae, 0x1b2, 0x1bc, 0x1c1, 0x1c6, 0x1d0, 0x1d6, 0x1da, 0x1e1, 0x1f8, 0x209, 0x219,
+	0x231, 0x241, 0x252, 0x267, 0x27d, 0x28f, 0x2a1,
+}
+
+const csLangStr = "" +
+	"afrikánštinaamharštinaarabštinaázerbájdžánštinabulharštinabengálštinakatalánštin" +
+	"ačeštinadánštinaněmčinařečtinaangličtinašpanělštinaestonštinaperštinafinštinafra" +
+	"ncouzštinagudžarátštinahebrejštinahindštinachorvatštinamaďarštinaarménštinaindon" +
+	"éštinaislandštinaitalštinajaponštinagruzínštinakazaštinakhmérštinakannadštinakor" +
+	"ejštinakyrgyzštinalaoštinalitevštinalotyštinamakedonštinamalajálamštinamongolšti" +
+	"namaráthštinamalajštinabarmštinanepálštinanizozemštinanorština (bokmål)paňdžábšt" +
+	"inapolštinaportugalštinarumunštinaruštinasinhálštinaslovenštinaslovinštinaalbánš" +
+	"tinasrbštinašvédštinasvahilštinatamilštinatelugštinathajštinaturečtinaukrajinšti" +
+	"naurdštinauzbečtinavietnamštinačínštinazuluštinafilipínštinaarabština (moderní s" +
+	"tandardní)angličtina (USA)španělština (Evropa)portugalština (Evropa)čínština (zj" +
+	"ednodušená)"
+
+var csLangIdx = []uint16{ // 78 entries
+	0x0, 0xe, 0x19, 0x23, 0x38, 0x44, 0x51, 0x5f, 0x68, 0x72, 0x7b, 0x84,
+	0x8f, 0x9d, 0xa8, 0xb1, 0xba, 0xc8, 0xd8, 0xe4, 0xee, 0xfb, 0x107, 0x113,
+	0x120, 0x12c, 0x136, 0x141, 0x14e, 0x158, 0x164, 0x170, 0x17b, 0x187, 0x190, 0x19b,
+	0x1a5, 0x1b2, 0x1c2, 0x1ce, 0x1db, 0x1e6, 0x1f0, 0x1fc, 0x209, 0x21c, 0x22c, 0x235,
+	0x243, 0x24e, 0x256, 0x263, 0x26f, 0x27b, 0x287, 0x290, 0x29c, 0x2a8, 0x2b3, 0x2be,
+	0x2c8, 0x2d2, 0x2df, 0x2e8, 0x2f2, 0x2ff, 0x30a, 0x314, 0x322, 0x343, 0x343, 0x354,
+	0x354, 0x36b, 0x36b, 0x36b, 0x382, 0x39e,
+}
+
+const daLangStr = "" +
+	"afrikaansamhariskarabiskaserbajdsjanskbulgarskbengalicatalansktjekkiskdansktyskg" +
+	"ræskengelskspanskestiskpersiskfinskfranskgujaratihebraiskhindikroatiskungarskarm" +
+	"enskindonesiskislandskitalienskjapanskgeorgiskkasakhiskkhmerkannadakoreanskkirgi" +
+	"sisklaolitauisklettiskmakedonskmalayalammongolskmarathiskmalayburmesisknepalesis" +
+	"khollandsknorsk bokmålpunjabipolskportugisiskrumænskrussisksingalesiskslovakisks" +
+	"lovenskalbanskserbisksvenskswahilitamilskteluguthailandsktyrkiskukrainskurduusbe" +
+	"kiskvietnamesiskkinesiskzulufilippinskmoderne standardarabiskbritisk engelskamer" +
+	"ikansk engelsklatinamerikansk spanskeuropæisk spanskcanadisk franskbrasiliansk p" +
+	"ortugisiskeuropæisk portugisiskforenklet kinesisktraditionelt kinesisk"
+
+var daLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x11, 0x18, 0x26, 0x2e, 0x35, 0x3e, 0x46, 0x4b, 0x4f, 0x55,
+	0x5c, 0x62, 0x68, 0x6f, 0x74, 0x7a, 0x82, 0x8a, 0x8f, 0x97, 0x9e, 0xa5,
+	0xaf, 0xb7, 0xc0, 0xc7, 0xcf, 0xd8, 0xdd, 0xe4, 0xec, 0xf5, 0xf8, 0x100,
+	0x107, 0x110, 0x119, 0x121, 0x12a, 0x12f, 0x138, 0x142, 0x14b, 0x158, 0x15f, 0x164,
+	0x16f, 0x177, 0x17e, 0x189, 0x192, 0x19a, 0x1a1, 0x1a8, 0x1ae, 0x1b5, 0x1bc, 0x1c2,
+	0x1cc, 0x1d3, 0x1db, 0x1df, 0x1e7, 0x1f3, 0x1fb, 0x1ff, 0x209, 0x220, 0x22f, 0x241,
+	0x257, 0x268, 0x277, 0x28e, 0x2a4, 0x2b6, 0x2cb,
+}
+
+const deLangStr = "" +
+	"AfrikaansAmharischArabischAserbaidschanischBulgarischBengalischKatalanischTschec" +
+	"hischDänischDeutschGriechischEnglischSpanischEstnischPersischFinnischFranzösisch" +
+	"GujaratiHebräischHindiKroatischUngarischArmenischIndonesischIsländischItalienisc" +
+	"hJapanischGeorgischKasachischKambodschanischKannadaKoreanischKirgisischLaotischL" +
+	"itauischLettischMazedonischMalayalamMongolischMarathiMalaiischBirmanischNepalesi" +
+	"schNiederländischNorwegisch BokmålPandschabischPolnischPortugiesischRumänischRus" +
+	"sischSinghalesischSlowakischSlowenischAlbanischSerbischSchwedischSuaheliTamilisc" +
+	"hTeluguThailändischTürkischUkrainischUrduUsbekischVietnamesischChinesischZuluFil" +
+	"ipinoModernes HocharabischBritisches EnglischAmerikanisches EnglischLateinamerik" +
+	"anisches SpanischEuropäisches SpanischKanadisches FranzösischBrasilianisches Por" +
+	"tugiesischEuropäisches PortugiesischChinesisch (vereinfacht)Chinesisch (traditio" +
+	"nell)"
+
+var deLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x12, 0x1a, 0x2b, 0x35, 0x3f, 0x4a, 0x55, 0x5d, 0x64, 0x6e,
+	0x76, 0x7e, 0x86, 0x8e, 0x96, 0xa2, 0xaa, 0xb4, 0xb9, 0xc2, 0xcb, 0xd4,
+	0xdf, 0xea, 0xf5, 0xfe, 0x107, 0x111, 0x120, 0x127, 0x131, 0x13b, 0x143, 0x14c,
+	0x154, 0x15f, 0x168, 0x172, 0x179, 0x182, 0x18c, 0x197, 0x1a6, 0x1b8, 0x1c5, 0x1cd,
+	0x1da, 0x1e4, 0x1ec, 0x1f9, 0x203, 0x20d, 0x216, 0x21e, 0x228, 0x22f, 0x238, 0x23e,
+	0x24b, 0x254, 0x25e, 0x262, 0x26b, 0x278, 0x282, 0x286, 0x28e, 0x2a3, 0x2b6, 0x2cd,
+	0x2ea, 0x300, 0x318, 0x335, 0x350, 0x368, 0x381,
+}
+
+const elLangStr = "" +
+	"ΑφρικάανςΑμαρικάΑραβικάΑζερικάΒουλγαρικάΜπενγκάλιΚαταλανικάΤσεχικάΔανικάΓερμανικ" +
+	"άΕλληνικάΑγγλικάΙσπανικάΕσθονικάΠερσικάΦινλανδικάΓαλλικάΓκουγιαράτιΕβραϊκάΧίντιΚ" +
+	"ροατικάΟυγγρικάΑρμενικάΙνδονησιακάΙσλανδικάΙταλικάΙαπωνικάΓεωργιανάΚαζακικάΚαμπο" +
+	"τζιανάΚανάνταΚορεατικάΚυργιζικάΛαοθιανάΛιθουανικάΛετονικάΣλαβομακεδονικάΜαλαγιαλ" +
+	"άμΜογγολικάΜαράθιΜαλάιΒιρμανικάΝεπάλιΟλλανδικάΝορβηγικά ΜποκμάλΠαντζαπικάΠολωνικ" +
+	"άΠορτογαλικάΡουμανικάΡωσικάΣινχαλεζικάΣλοβακικάΣλοβενικάΑλβανικάΣερβικάΣουηδικάΣ" +
+	"ουαχίλιΤαμίλΤελούγκουΤαϊλανδικάΤουρκικάΟυκρανικάΟυρντούΟυζμπεκικάΒιετναμικάΚινεζ" +
+	"ικάΖουλούΦιλιππινεζικάΣύγχρονα Τυπικά ΑραβικάΑγγλικά Ηνωμένου ΒασιλείουΑγγλικά Α" +
+	"μερικήςΙσπανικά Λατινικής ΑμερικήςΙσπανικά ΕυρώπηςΓαλλικά ΚαναδάΠορτογαλικά Βραζ" +
+	"ιλίαςΠορτογαλικά ΕυρώπηςΑπλοποιημένα ΚινεζικάΠαραδοσιακά Κινεζικά"
+
+var elLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x20, 0x2e, 0x3c, 0x50, 0x62, 0x76, 0x84, 0x90, 0xa2, 0xb2,
+	0xc0, 0xd0, 0xe0, 0xee, 0x102, 0x110, 0x126, 0x134, 0x13e, 0x14e, 0x15e, 0x16e,
+	0x184, 0x196, 0x1a4, 0x1b4, 0x1c6, 0x1d6, 0x1ec, 0x1fa, 0x20c, 0x21e, 0x22e, 0x242,
+	0x252, 0x270, 0x284, 0x296, 0x2a2, 0x2ac, 0x2be, 0x2ca, 0x2dc, 0x2fd, 0x311, 0x321,
+	0x337, 0x349, 0x355, 0x36b, 0x37d, 0x38f, 0x39f, 0x3ad, 0x3bd, 0x3cd, 0x3d7, 0x3e9,
+	0x3fd, 0x40d, 0x41f, 0x42d, 0x441, 0x455, 0x465, 0x471, 0x48b, 0x4b7, 0x4e9, 0x508,
+	0x53c, 0x55b, 0x576, 0x59f, 0x5c4, 0x5ed, 0x614,
+}
+
+const enLangStr = "" +
+	"AfrikaansAmharicArabicAzerbaijaniBulgarianBengaliCatalanCzechDanishGermanGreekEn" +
+	"glishSpanishEstonianPersianFinnishFrenchGujaratiHebrewHindiCroatianHungarianArme" +
+	"nianIndonesianIcelandicItalianJapaneseGeorgianKazakhKhmerKannadaKoreanKyrgyzLaoL" +
+	"ithuanianLatvianMacedonianMalayalamMongolianMarathiMalayBurmeseNepaliDutchNorweg" +
+	"ian BokmålPunjabiPolishPortugueseRomanianRussianSinhalaSlovakSlovenianAlbanianSe" +
+	"rbianSwedishSwahiliTamilTeluguThaiTurkishUkrainianUrduUzbekVietnameseChineseZulu" +
+	"FilipinoModern Standard ArabicBritish EnglishAmerican EnglishLatin American Span" +
+	"ishEuropean SpanishCanadian FrenchBrazilian PortugueseEuropean PortugueseSimplif" +
+	"ied ChineseTraditional Chinese"
+
+var enLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x10, 0x16, 0x21, 0x2a, 0x31, 0x38, 0x3d, 0x43, 0x49, 0x4e,
+	0x55, 0x5c, 0x64, 0x6b, 0x72, 0x78, 0x80, 0x86, 0x8b, 0x93, 0x9c, 0xa4,
+	0xae, 0xb7, 0xbe, 0xc6, 0xce, 0xd4, 0xd9, 0xe0, 0xe6, 0xec, 0xef, 0xf9,
+	0x100, 0x10a, 0x113, 0x11c, 0x123, 0x128, 0x12f, 0x135, 0x13a, 0x14b, 0x152, 0x158,
+	0x162, 0x16a, 0x171, 0x178, 0x17e, 0x187, 0x18f, 0x196, 0x19d, 0x1a4, 0x1a9, 0x1af,
+	0x1b3, 0x1ba, 0x1c3, 0x1c7, 0x1cc, 0x1d6, 0x1dd, 0x1e1, 0x1e9, 0x1ff, 0x20e, 0x21e,
+	0x234, 0x244, 0x253, 0x267, 0x27a, 0x28c, 0x29f,
+}
+
+const enGBLangStr = "" +
+	""
+
+var enGBLangIdx = []uint16{ // 0 entries
+}
+
+const esLangStr = "" +
+	"afrikáansamáricoárabeazeríbúlgarobengalícatalánchecodanésalemángriegoinglésespañ" +
+	"olestoniopersafinésfrancésgujaratihebreohindicroatahúngaroarmenioindonesioisland" +
+	"ésitalianojaponésgeorgianokazajojemercanaréscoreanokirghizlaosianolituanoletónma" +
+	"cedoniomalayalammongolmarathimalayobirmanonepalíneerlandésbokmal noruegopunjabíp" +
+	"olacoportuguésrumanorusocingaléseslovacoeslovenoalbanésserbiosuecoswahilitamilte" +
+	"lugutailandésturcoucranianourduuzbekovietnamitachinozulúfilipinoárabe estándar m" +
+	"odernoinglés británicoinglés estadounidenseespañol de Américaespañol de Españafr" +
+	"ancés canadienseportugués de Brasilportugués de Portugalchino simplificadochino " +
+	"tradicional"
+
+var esLangIdx = []uint16{ // 79 entries
+	0x0, 0xa, 0x12, 0x18, 0x1e, 0x26, 0x2e, 0x36, 0x3b, 0x41, 0x48, 0x4e,
+	0x55, 0x5d, 0x64, 0x69, 0x6f, 0x77, 0x7f, 0x85, 0x8a, 0x90, 0x98, 0x9f,
+	0xa8, 0xb1, 0xb9, 0xc1, 0xca, 0xd0, 0xd5, 0xdd, 0xe4, 0xeb, 0xf3, 0xfa,
+	0x100, 0x109, 0x112, 0x118, 0x11f, 0x125, 0x12c, 0x133, 0x13e, 0x14c, 0x154, 0x15a,
+	0x164, 0x16a, 0x16e, 0x177, 0x17f, 0x187, 0x18f, 0x195, 0x19a, 0x1a1, 0x1a6, 0x1ac,
+	0x1b6, 0x1bb, 0x1c4, 0x1c8, 0x1ce, 0x1d8, 0x1dd, 0x1e2, 0x1ea, 0x202, 0x214, 0x22a,
+	0x23e, 0x251, 0x264, 0x278, 0x28e, 0x2a0, 0x2b1,
+}
+
+const es419LangStr = "" +
+	""
+
+var es419LangIdx = []uint16{ // 0 entries
+}
+
+const etLangStr = "" +
+	"afrikaaniamhariaraabiaaserbaidžaanibulgaariabengalikatalaanitšehhitaanisaksakree" +
+	"kainglisehispaaniaeestipärsiasoomeprantsusegudžaratiheebreahindihorvaadiungariar" +
+	"meeniaindoneesiaislandiitaaliajaapanigruusiakasahhikhmeerikannadakoreakirgiisila" +
+	"oleedulätimakedooniamalajalamimongolimarathimalaibirmanepalihollandinorra bokmål" +
+	"pandžabipoolaportugalirumeeniavenesingalislovakisloveenialbaaniaserbiarootsisuah" +
+	"iilitamilitelugutaitürgiukrainaurduusbekivietnamihiinasuulufilipinoaraabia (täna" +
+	"päevane)inglise (Briti)inglise (USA)hispaania (Euroopa)portugali (Euroopa)hiina " +
+	"keel - lihtsustatudhiina keel - traditsiooniline"
+
+var etLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0xf, 0x16, 0x24, 0x2d, 0x34, 0x3d, 0x44, 0x49, 0x4e, 0x54,
+	0x5b, 0x64, 0x69, 0x70, 0x75, 0x7e, 0x88, 0x8f, 0x94, 0x9c, 0xa2, 0xaa,
+	0xb4, 0xbb, 0xc2, 0xc9, 0xd0, 0xd7, 0xde, 0xe5, 0xea, 0xf2, 0xf5, 0xfa,
+	0xff, 0x109, 0x113, 0x11a, 0x121, 0x126, 0x12b, 0x131, 0x139, 0x146, 0x14f, 0x154,
+	0x15d, 0x165, 0x169, 0x170, 0x177, 0x17f, 0x187, 0x18d, 0x193, 0x19b, 0x1a1, 0x1a7,
+	0x1aa, 0x1b0, 0x1b7, 0x1bb, 0x1c1, 0x1c9, 0x1ce, 0x1d3, 0x1db, 0x1f2, 0x201, 0x20e,
+	0x20e, 0x221, 0x221, 0x221, 0x234, 0x24d, 0x26a,
+}
+
+const faLangStr = "" +
+	"آفریکانسامهریعربیترکی آذربایجانیبلغاریبنگالیکاتالانچکیدانمارکیآلمانییونانیانگلیس" +
+	"یاسپانیاییاستونیاییفارسیفنلاندیفرانسویگجراتیعبریهندیکرواتمجاریارمنیاندونزیاییایس" +
+	"لندیایتالیاییژاپنیگرجیقزاقیخمریکاناده‌ایکره‌ایقرقیزیلائوسیلیتوانیاییلتونیاییمقدو" +
+	"نیمالایالامیمغولیمراتیمالاییبرمه‌اینپالیهلندیبوکسمال نروژیپنجابیلهستانیپرتغالیرو" +
+	"مانیاییروسیسینهالیاسلواکیاسلووینیاییآلبانیاییصربیسوئدیسواحلیتامیلیتلوگوییتایلندی" +
+	"ترکی استانبولیاوکراینیاردوازبکیویتنامیچینیزولوییفیلیپینیعربی رسمیانگلیسی بریتانی" +
+	"اانگلیسی امریکااسپانیایی امریکای لاتیناسپانیایی اروپافرانسوی کاناداپرتغالی برزیل" +
+	"پرتغالی اروپاچینی ساده‌شدهچینی سنتی"
+
+var faLangIdx = []uint16{ // 79 entries
+	0x0, 0x10, 0x1a, 0x22, 0x3f, 0x4b, 0x57, 0x65, 0x6b, 0x7b, 0x87, 0x93,
+	0xa1, 0xb3, 0xc5, 0xcf, 0xdd, 0xeb, 0xf7, 0xff, 0x107, 0x111, 0x11b, 0x125,
+	0x139, 0x147, 0x159, 0x163, 0x16b, 0x175, 0x17d, 0x190, 0x19d, 0x1a9, 0x1b5, 0x1c9,
+	0x1d9, 0x1e5, 0x1f9, 0x203, 0x20d, 0x219, 0x228, 0x232, 0x23c, 0x255, 0x261, 0x26f,
+	0x27d, 0x28f, 0x297, 0x2a5, 0x2b3, 0x2c9, 0x2db, 0x2e3, 0x2ed, 0x2f9, 0x305, 0x313,
+	0x321, 0x33c, 0x34c, 0x354, 0x35e, 0x36c, 0x374, 0x380, 0x390, 0x3a1, 0x3c0, 0x3db,
+	0x407, 0x424, 0x43f, 0x458, 0x471, 0x48b, 0x49c,
+}
+
+const fiLangStr = "" +
+	"afrikaansamharaarabiaazeribulgariabengalikatalaanitšekkitanskasaksakreikkaenglan" +
+	"tiespanjavirofarsisuomiranskagudžaratihepreahindikroatiaunkariarmeniaindonesiais" +
+	"lantiitaliajapanigeorgiakazakkikhmerkannadakoreakirgiisilaoliettualatviamakedoni" +
+	"amalajalammongolimarathimalaijiburmanepalihollantinorjan bokmålpandžabipuolaport" +
+	"ugaliromaniavenäjäsinhalaslovakkisloveenialbaniaserbiaruotsiswahilitamilitelugut" +
+	"haiturkkiukrainaurduuzbekkivietnamkiinazulufilipinoyleisarabiabritannianenglanti" +
+	"amerikanenglantiamerikanespanjaespanjanespanjakanadanranskabrasilianportugalipor" +
+	"tugalinportugaliyksinkertaistettu kiinaperinteinen kiina"
+
+var fiLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0xf, 0x15, 0x1a, 0x22, 0x29, 0x32, 0x39, 0x3f, 0x44, 0x4b,
+	0x53, 0x5a, 0x5e, 0x63, 0x68, 0x6e, 0x78, 0x7e, 0x83, 0x8a, 0x90, 0x97,
+	0xa0, 0xa7, 0xad, 0xb3, 0xba, 0xc1, 0xc6, 0xcd, 0xd2, 0xda, 0xdd, 0xe4,
+	0xea, 0xf3, 0xfc, 0x103, 0x10a, 0x111, 0x116, 0x11c, 0x124, 0x132, 0x13b, 0x140,
+	0x149, 0x150, 0x158, 0x15f, 0x167, 0x16f, 0x176, 0x17c, 0x182, 0x189, 0x18f, 0x195,
+	0x199, 0x19f, 0x1a6, 0x1aa, 0x1b1, 0x1b8, 0x1bd, 0x1c1, 0x1c9, 0x1d4, 0x1e6, 0x1f6,
+	0x205, 0x214, 0x221, 0x233, 0x246, 0x25d, 0x26e,
+}
+
+const filLangStr = "" +
+	"AfrikaansAmharicArabicAzerbaijaniBulgarianBengaliCatalanCzechDanishGermanGreekIn" +
+	"glesSpanishEstonianPersianFinnishFrenchGujaratiHebrewHindiCroatianHungarianArmen" +
+	"ianIndonesianIcelandicItalianJapaneseGeorgianKazakhKhmerKannadaKoreanKirghizLaoL" +
+	"ithuanianLatvianMacedonianMalayalamMongolianMarathiMalayBurmeseNepaliDutchNorweg" +
+	"ian BokmalPunjabiPolishPortugueseRomanianRussianSinhalaSlovakSlovenianAlbanianSe" +
+	"rbianSwedishSwahiliTamilTeluguThaiTurkishUkranianUrduUzbekVietnameseChineseZuluF" +
+	"ilipinoModern Standard ArabicIngles (UK)Ingles (US)Latin American SpanishEuropea" +
+	"n SpanishCanadian FrenchBrazilian PortugueseEuropean PortugueseSimplified Chines" +
+	"eTraditional Chinese"
+
+var filLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x10, 0x16, 0x21, 0x2a, 0x31, 0x38, 0x3d, 0x43, 0x49, 0x4e,
+	0x54, 0x5b, 0x63, 0x6a, 0x71, 0x77, 0x7f, 0x85, 0x8a, 0x92, 0x9b, 0xa3,
+	0xad, 0xb6, 0xbd, 0xc5, 0xcd, 0xd3, 0xd8, 0xdf, 0xe5, 0xec, 0xef, 0xf9,
+	0x100, 0x10a, 0x113, 0x11c, 0x123, 0x128, 0x12f, 0x135, 0x13a, 0x14a, 0x151, 0x157,
+	0x161, 0x169, 0x170, 0x177, 0x17d, 0x186, 0x18e, 0x195, 0x19c, 0x1a3, 0x1a8, 0x1ae,
+	0x1b2, 0x1b9, 0x1c1, 0x1c5, 0x1ca, 0x1d4, 0x1db, 0x1df, 0x1e7, 0x1fd, 0x208, 0x213,
+	0x229, 0x239, 0x248, 0x25c, 0x26f, 0x281, 0x294,
+}
+
+const frLangStr = "" +
+	"afrikaansamhariquearabeazéribulgarebengalicatalantchèquedanoisallemandgrecanglai" +
+	"sespagnolestonienpersanfinnoisfrançaisgujaratihébreuhindicroatehongroisarménieni" +
+	"ndonésienislandaisitalienjaponaisgéorgienkazakhkhmerkannadacoréenkirghizelaolitu" +
+	"anienlettonmacédonienmalayalammongolmarathemalaisbirmannépalaisnéerlandaisnorvég" +
+	"ien bokmålpendjabipolonaisportugaisroumainrussecinghalaisslovaqueslovènealbanais" +
+	"serbesuédoisswahilitamoultélougouthaïturcukrainienourdououzbekvietnamienchinoisz" +
+	"ouloufilipinoarabe standard moderneanglais britanniqueanglais américainespagnol " +
+	"latino-américainespagnol d’Europefrançais canadienportugais brésilienportugais d" +
+	"’Europechinois simplifiéchinois traditionnel"
+
+var frLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x12, 0x17, 0x1d, 0x24, 0x2b, 0x32, 0x3a, 0x40, 0x48, 0x4c,
+	0x53, 0x5b, 0x63, 0x69, 0x70, 0x79, 0x81, 0x88, 0x8d, 0x93, 0x9b, 0xa4,
+	0xaf, 0xb8, 0xbf, 0xc7, 0xd0, 0xd6, 0xdb, 0xe2, 0xe9, 0xf1, 0xf4, 0xfd,
+	0x103, 0x10e, 0x117, 0x11d, 0x124, 0x12a, 0x130, 0x139, 0x145, 0x157, 0x15f, 0x167,
+	0x170, 0x177, 0x17c, 0x186, 0x18e, 0x196, 0x19e, 0x1a3, 0x1ab, 0x1b2, 0x1b8, 0x1c1,
+	0x1c6, 0x1ca, 0x1d3, 0x1d9, 0x1df, 0x1e9, 0x1f0, 0x1f6, 0x1fe, 0x214, 0x227, 0x239,
+	0x253, 0x266, 0x278, 0x28c, 0x2a0, 0x2b2, 0x2c6,
+}
+
+const frCALangStr = "" +
+	"goudjarâtîsinghalaisespagnol ibériqueportugais ibérique"
+
+var frCALangIdx = []uint16{ // 77 entries
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc,
+	0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc,
+	0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc, 0xc,
+	0xc, 0xc, 0xc, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16,
+	0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16, 0x16,
+	0x16, 0x28, 0x28, 0x28, 0x3b,
+}
+
+const guLangStr = "" +
+	"આફ્રિકન્સએમ્હારિકઅરબીઅઝરબૈજાનીબલ્ગેરિયનબંગાળીકતલાનચેકડેનિશજર્મનગ્રીકઅંગ્રેજીસ્પે" +
+	"નિશએસ્ટોનિયનપર્શિયનફિનિશફ્રેન્ચગુજરાતીહીબ્રુહિન્દીક્રોએશિયનહંગેરિયનઆર્મેનિયનઇન્ડ" +
+	"ોનેશિયનઆઇસલેન્ડિકઇટાલિયનજાપાનીઝજ્યોર્જિઅનકઝાકખ્મેરકન્નડાકોરિયનકિર્ગીઝલાઓથિયનલિથુ" +
+	"નિયનલાતવિયનમેસેડોનિયનમલયાલમમોંગોલિયનમરાઠીમલયબર્મીઝનેપાળીડચનોર્વેજીયન બોકમાલપંજાબ" +
+	"ીપોલીશપોર્ટુગીઝરોમાનિયનરશિયનસિંહલીસ્લોવૅકસ્લોવેનિયનઅલ્બેનિયનસર્બિયનસ્વીડિશસ્વાહિ" +
+	"લીતમિલતેલુગુથાઈટર્કીશયુક્રેનિયનઉર્દુઉઝ્બેકવિયેતનામીસચાઇનીઝઝુલુફિલિપિનોમોડર્ન સ્ટ" +
+	"ાન્ડર્ડ અરબીબ્રિટિશ અંગ્રેજીઅમેરિકન અંગ્રેજીલેટિન અમેરિકન સ્પેનિશયુરોપિયન સ્પેનિ" +
+	"શકેનેડિયન ફ્રેંચબ્રાઝિલીયન પોર્ટુગીઝયુરોપિયન પોર્ટુગીઝસરળીકૃત ચાઇનીઝપારંપરિક ચાઇ" +
+	"નીઝ"
+
+var guLangIdx = []uint16{ // 79 entries
+	0x0, 0x1b, 0x33, 0x3f, 0x5a, 0x75, 0x87, 0x96, 0x9f, 0xae, 0xbd, 0xcc,
+	0xe4, 0xf9, 0x114, 0x129, 0x138, 0x14d, 0x162, 0x174, 0x186, 0x1a1, 0x1b9, 0x1d4,
+	0x1f5, 0x213, 0x228, 0x23d, 0x25b, 0x267, 0x276, 0x288, 0x29a, 0x2af, 0x2c4, 0x2dc,
+	0x2f1, 0x30f, 0x321, 0x33c, 0x34b, 0x354, 0x366, 0x378, 0x37e, 0x3af, 0x3c1, 0x3d0,
+	0x3eb, 0x403, 0x412, 0x424, 0x439, 0x457, 0x472, 0x487, 0x49c, 0x4b4, 0x4c0, 0x4d2,
+	0x4db, 0x4ed, 0x50b, 0x51a, 0x52c, 0x54a, 0x55c, 0x568, 0x580, 0x5be, 0x5ec, 0x61a,
+	0x655, 0x683, 0x6ae, 0x6e8, 0x71c, 0x744, 0x76f,
+}
+
+const heLangStr = "" +
+	"אפריקאנסאמהריתערביתאזריתבולגריתבנגליתקטלאניתצ׳כיתדניתגרמניתיווניתאנגליתספרדיתאסט" +
+	"וניתפרסיתפיניתצרפתיתגוג׳ראטיתעבריתהינדיקרואטיתהונגריתארמניתאינדונזיתאיסלנדיתאיטל" +
+	"קיתיפניתגאורגיתקזחיתקמריתקנאדהקוריאניתקירגיזיתלאיתליטאיתלטביתמקדוניתמלאיאלםמונגו" +
+	"ליתמרטהימלאיתבורמזיתנפאליתהולנדית‏נורבגית ספרותיתפנג׳אביתפולניתפורטוגליתרומניתרו" +
+	"סיתסינהלהסלובקיתסלובניתאלבניתסרביתשוודיתסווהיליתטמיליתטלוגותאיתטורקיתאוקראיניתאו" +
+	"רדואוזבקיתויאטנמיתסיניתזולופיליפיניתערבית ספרותיתאנגלית בריטיתאנגלית אמריקניתספר" +
+	"דית לטינו־אמריקאיתספרדית אירופאיתצרפתית קנדיתפורטוגזית ברזילאיתפורטוגזית אירופאי" +
+	"תסינית (מפושטת)סינית מסורתית"
+
+var heLangIdx = []uint16{ // 79 entries
+	0x0, 0x10, 0x1c, 0x26, 0x30, 0x3e, 0x4a, 0x58, 0x62, 0x6a, 0x76, 0x82,
+	0x8e, 0x9a, 0xa8, 0xb2, 0xbc, 0xc8, 0xda, 0xe4, 0xee, 0xfc, 0x10a, 0x116,
+	0x128, 0x138, 0x146, 0x150, 0x15e, 0x168, 0x172, 0x17c, 0x18c, 0x19c, 0x1a4, 0x1b0,
+	0x1ba, 0x1c8, 0x1d6, 0x1e6, 0x1f0, 0x1fa, 0x208, 0x214, 0x222, 0x242, 0x252, 0x25e,
+	0x270, 0x27c, 0x286, 0x292, 0x2a0, 0x2ae, 0x2ba, 0x2c4, 0x2d0, 0x2e0, 0x2ec, 0x2f6,
+	0x2fe, 0x30a, 0x31c, 0x326, 0x334, 0x344, 0x34e, 0x356, 0x368, 0x381, 0x39a, 0x3b7,
+	0x3e0, 0x3fd, 0x414, 0x437, 0x45a, 0x473, 0x48c,
+}
+
+const hiLangStr = "" +
+	"अफ़्रीकीअम्हेरीअरबीअज़रबैजानीबुल्गारियाईबंगालीकातालानचेकडेनिशजर्मनयूनानीअंग्रेज़" +
+	"ीस्पेनीएस्टोनियाईफ़ारसीफ़िनिशफ़्रेंचगुजरातीहिब्रूहिंदीक्रोएशियाईहंगेरियाईआर्मेनि" +
+	"याईइंडोनेशियाईआइसलैंडीइतालवीजापानीजॉर्जियाईकज़ाख़खमेरकन्नड़कोरियाईकिर्गीज़लाओलिथ" +
+	"ुआनियाईलातवियाईमैसिडोनियाईमलयालममंगोलीयाईमराठीमलयबर्मीज़नेपालीडचनॉर्वेजियाई बोकम" +
+	"ालपंजाबीपोलिशपुर्तगालीरोमानियाईरूसीसिंहलीस्लोवाकस्लोवेनियाईअल्बानियाईसर्बियाईस्व" +
+	"ीडिशस्वाहिलीतमिलतेलुगूथाईतुर्कीयूक्रेनियाईउर्दूउज़्बेकवियतनामीचीनीज़ुलूफ़िलिपीनो" +
+	"आधुनिक मानक अरबीब्रिटिश अंग्रेज़ीलैटिन अमेरिकी स्पेनीयूरोपीय स्पेनीकनाडाई फ़्रें" +
+	"चब्राज़ीली पुर्तगालीयूरोपीय पुर्तगालीसरलीकृत चीनीपारंपरिक चीनी"
+
+var hiLangIdx = []uint16{ // 79 entries
+	0x0, 0x18, 0x2d, 0x39, 0x57, 0x78, 0x8a, 0x9f, 0xa8, 0xb7, 0xc6, 0xd8,
+	0xf3, 0x105, 0x123, 0x135, 0x147, 0x15c, 0x171, 0x183, 0x192, 0x1b0, 0x1cb, 0x1e9,
+	0x20a, 0x222, 0x234, 0x246, 0x261, 0x273, 0x27f, 0x291, 0x2a6, 0x2be, 0x2c7, 0x2e5,
+	0x2fd, 0x31e, 0x330, 0x34b, 0x35a, 0x363, 0x378, 0x38a, 0x390, 0x3c4, 0x3d6, 0x3e5,
+	0x400, 0x41b, 0x427, 0x439, 0x44e, 0x46f, 0x48d, 0x4a5, 0x4ba, 0x4d2, 0x4de, 0x4f0,
+	0x4f9, 0x50b, 0x52c, 0x53b, 0x550, 0x568, 0x574, 0x583, 0x59e, 0x5ca, 0x5fb, 0x5fb,
+	0x633, 0x65b, 0x683, 0x6ba, 0x6eb, 0x70d, 0x732,
+}
+
+const hrLangStr = "" +
+	"afrikaansamharskiarapskiazerbajdžanskibugarskibengalskikatalonskičeškidanskinjem" +
+	"ačkigrčkiengleskišpanjolskiestonskiperzijskifinskifrancuskigudžaratskihebrejskih" +
+	"indskihrvatskimađarskiarmenskiindonezijskiislandskitalijanskijapanskigruzijskika" +
+	"zaškikmerskikannadskikorejskikirgiškilaoskilitavskilatvijskimakedonskimalajalams" +
+	"kimongolskimarathskimalajskiburmanskinepalskinizozemskiknjiževni norveškipandžap" +
+	"skipoljskiportugalskirumunjskiruskisinhaleškislovačkislovenskialbanskisrpskišved" +
+	"skisvahilitamilskitelugutajlandskiturskiukrajinskiurdskiuzbečkivijetnamskikinesk" +
+	"izulufilipinomoderni standardni arapskibritanski engleskiamerički engleskilatino" +
+	"američki španjolskieuropski španjolskikanadski francuskibrazilski portugalskieur" +
+	"opski portugalskikineski (pojednostavljeni)kineski (tradicionalni)"
+
+var hrLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x11, 0x18, 0x27, 0x2f, 0x38, 0x42, 0x49, 0x4f, 0x58, 0x5e,
+	0x66, 0x71, 0x79, 0x82, 0x88, 0x91, 0x9d, 0xa6, 0xad, 0xb5, 0xbe, 0xc6,
+	0xd2, 0xdb, 0xe5, 0xed, 0xf6, 0xfe, 0x105, 0x10e, 0x116, 0x11f, 0x125, 0x12d,
+	0x136, 0x140, 0x14c, 0x155, 0x15e, 0x166, 0x16f, 0x177, 0x181, 0x195, 0x1a0, 0x1a7,
+	0x1b2, 0x1bb, 0x1c0, 0x1cb, 0x1d4, 0x1dd, 0x1e5, 0x1eb, 0x1f3, 0x1fa, 0x202, 0x208,
+	0x212, 0x218, 0x222, 0x228, 0x230, 0x23b, 0x242, 0x246, 0x24e, 0x268, 0x27a, 0x28c,
+	0x2a7, 0x2bb, 0x2cd, 0x2e2, 0x2f6, 0x310, 0x327,
+}
+
+const huLangStr = "" +
+	"afrikaansamharaarabazerbajdzsánibolgárbengálikataláncsehdánnémetgörögangolspanyo" +
+	"lésztperzsafinnfranciagudzsaratihéberhindihorvátmagyarörményindonézizlandiolaszj" +
+	"apángrúzkazahkambodzsaikannadakoreaikirgizlaoszilitvánlettmacedónmalajálammongol" +
+	"marathimalájburmainepálihollandnorvég bokmalpandzsábilengyelportugálrománoroszsz" +
+	"ingalézszlovákszlovénalbánszerbsvédszuahélitamilteluguthaitörökukránurduüzbégvie" +
+	"tnamikínaizulufilippínómodern szabányos arabbrit angolamerikai angollatin-amerik" +
+	"ai spanyoleurópai spanyolkanadai franciabrazíliai portugáleurópai portugálegysze" +
+	"rűsített kínaihagyományos kínai"
+
+var huLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0xf, 0x13, 0x21, 0x28, 0x30, 0x38, 0x3c, 0x40, 0x46, 0x4d,
+	0x52, 0x59, 0x5e, 0x64, 0x68, 0x6f, 0x79, 0x7f, 0x84, 0x8b, 0x91, 0x99,
+	0xa1, 0xa8, 0xad, 0xb3, 0xb8, 0xbd, 0xc7, 0xce, 0xd4, 0xda, 0xe0, 0xe7,
+	0xeb, 0xf3, 0xfd, 0x103, 0x10a, 0x110, 0x116, 0x11d, 0x124, 0x132, 0x13c, 0x143,
+	0x14c, 0x152, 0x157, 0x161, 0x169, 0x171, 0x177, 0x17c, 0x181, 0x18a, 0x18f, 0x195,
+	0x199, 0x1a0, 0x1a6, 0x1aa, 0x1b1, 0x1b9, 0x1bf, 0x1c3, 0x1ce, 0x1e4, 0x1ee, 0x1fc,
+	0x212, 0x222, 0x231, 0x245, 0x257, 0x26e, 0x281,
+}
+
+const hyLangStr = "" +
+	"աֆրիկաանսամհարերենարաբերենադրբեջաներենբուլղարերենբենգալերենկատալաներենչեխերենդան" +
+	"իերենգերմաներենհունարենանգլերենիսպաներենէստոներենպարսկերենֆիններենֆրանսերենգուջա" +
+	"րաթիեբրայերենհինդիխորվաթերենհունգարերենհայերենինդոնեզերենիսլանդերենիտալերենճապոն" +
+	"երենվրացերենղազախերենքմերերենկաննադակորեերենղրղզերենլաոսերենլիտվերենլատվիերենմակ" +
+	"եդոներենմալայալամմարաթիմալայերենբիրմայերեննեպալերենհոլանդերեննորվեգերեն բուկմոլփ" +
+	"ենջաբերենլեհերենպորտուգալերենռումիներենռուսերենսինհալերենսլովակերենսլովեներենալբ" +
+	"աներենսերբերենշվեդերենսուահիլիթամիլերենթելուգութայերենթուրքերենուկրաիներենուրդու" +
+	"ուզբեկերենվիետնամերենչինարենզուլուսերենֆիլիպիներենժամանակակից ստանդարտ արաբերենբ" +
+	"րիտանական անգլերենամերիկյան անգլերենլատինաամերիկյան իսպաներենեվրոպական իսպաներեն" +
+	"կանադական ֆրանսերենբրազիլական պորտուգալերենեվրոպական պորտուգալերենպարզեցված չինա" +
+	"րենավանդական չինարեն"
+
+var hyLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x24, 0x34, 0x4c, 0x62, 0x76, 0x8c, 0x9a, 0xaa, 0xbe, 0xce,
+	0xde, 0xf0, 0x102, 0x114, 0x124, 0x136, 0x148, 0x15a, 0x164, 0x178, 0x18e, 0x19c,
+	0x1b2, 0x1c6, 0x1d6, 0x1e8, 0x1f8, 0x20a, 0x21a, 0x228, 0x238, 0x248, 0x258, 0x268,
+	0x27a, 0x290, 0x2a2, 0x2a2, 0x2ae, 0x2c0, 0x2d4, 0x2e6, 0x2fa, 0x31d, 0x331, 0x33f,
+	0x359, 0x36d, 0x37d, 0x391, 0x3a5, 0x3b9, 0x3cb, 0x3db, 0x3eb, 0x3fb, 0x40d, 0x41d,
+	0x42b, 0x43d, 0x453, 0x45f, 0x473, 0x489, 0x497, 0x4ad, 0x4c3, 0x4fb, 0x520, 0x543,
+	0x574, 0x599, 0x5be, 0x5ed, 0x61a, 0x63b, 0x65c,
+}
+
+const idLangStr = "" +
+	"AfrikaansAmharikArabAzerbaijanBulgarBengaliKatalanCheskaDanskJermanYunaniInggris" +
+	"SpanyolEstiPersiaSuomiPrancisGujaratiIbraniHindiKroasiaHungariaArmeniaBahasa Ind" +
+	"onesiaIslandiaItaliaJepangGeorgiaKazakhKhmerKannadaKoreaKirgizLaoLituaviLatviMak" +
+	"edoniaMalayalamMongoliaMarathiMelayuBurmaNepaliBelandaBokmål NorwegiaPunjabiPols" +
+	"kiPortugisRumaniaRusiaSinhalaSlovakSlovenAlbaniaSerbSwediaSwahiliTamilTeluguThai" +
+	"TurkiUkrainaUrduUzbekVietnamChinaZuluFilipinoArab Standar ModernInggris InggrisI" +
+	"nggris AmerikaSpanyol Amerika LatinSpanyol EropaPrancis KanadaPortugis BrasilPor" +
+	"tugis EropaChina (Aksara Sederhana)China (Aksara Tradisional)"
+
+var idLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x10, 0x14, 0x1e, 0x24, 0x2b, 0x32, 0x38, 0x3d, 0x43, 0x49,
+	0x50, 0x57, 0x5b, 0x61, 0x66, 0x6d, 0x75, 0x7b, 0x80, 0x87, 0x8f, 0x96,
+	0xa6, 0xae, 0xb4, 0xba, 0xc1, 0xc7, 0xcc, 0xd3, 0xd8, 0xde, 0xe1, 0xe8,
+	0xed, 0xf6, 0xff, 0x107, 0x10e, 0x114, 0x119, 0x11f, 0x126, 0x136, 0x13d, 0x143,
+	0x14b, 0x152, 0x157, 0x15e, 0x164, 0x16a, 0x171, 0x175, 0x17b, 0x182, 0x187, 0x18d,
+	0x191, 0x196, 0x19d, 0x1a1, 0x1a6, 0x1ad, 0x1b2, 0x1b6, 0x1be, 0x1d1, 0x1e0, 0x1ef,
+	0x204, 0x211, 0x21f, 0x22e, 0x23c, 0x254, 0x26e,
+}
+
+const isLangStr = "" +
+	"afríkanskaamharískaarabískaaserskabúlgarskabengalskakatalónskatékkneskadanskaþýs" +
+	"kagrískaenskaspænskaeistneskapersneskafinnskafranskagújaratíhebreskahindíkróatís" +
+	"kaungverskaarmenskaindónesískaíslenskaítalskajapanskageorgískakasakskakmerkannad" +
+	"akóreskakirgiskalaólitháískalettneskamakedónskamalajalammongólskamaratímalaískab" +
+	"urmneskanepalskahollenskanorskt bókmálpúnjabípólskaportúgalskarúmenskarússneskas" +
+	"inhalaslóvakískaslóvenskaalbanskaserbneskasænskasvahílítamílskatelúgútaílenskaty" +
+	"rkneskaúkraínskaúrdúúsbekskavíetnamskakínverskasúlúfilippseyskastöðluð nútímaara" +
+	"bískabresk enskabandarísk enskarómanskamerísk spænskaevrópsk spænskakanadísk fra" +
+	"nskabrasílísk portúgalskaevrópsk portúgalskakínverska (einfölduð)kínverska (hefð" +
+	"bundin)"
+
+var isLangIdx = []uint16{ // 79 entries
+	0x0, 0xb, 0x15, 0x1e, 0x25, 0x2f, 0x38, 0x43, 0x4d, 0x53, 0x5a, 0x61,
+	0x66, 0x6e, 0x77, 0x80, 0x87, 0x8e, 0x98, 0xa0, 0xa6, 0xb1, 0xba, 0xc2,
+	0xcf, 0xd8, 0xe0, 0xe8, 0xf2, 0xfa, 0xfe, 0x105, 0x10d, 0x115, 0x119, 0x124,
+	0x12d, 0x138, 0x141, 0x14b, 0x152, 0x15b, 0x164, 0x16c, 0x175, 0x184, 0x18d, 0x194,
+	0x1a0, 0x1a9, 0x1b3, 0x1ba, 0x1c6, 0x1d0, 0x1d8, 0x1e1, 0x1e8, 0x1f1, 0x1fa, 0x202,
+	0x20c, 0x215, 0x220, 0x226, 0x22f, 0x23a, 0x244, 0x24a, 0x256, 0x272, 0x27d, 0x28d,
+	0x2a6, 0x2b7, 0x2c8, 0x2e0, 0x2f5, 0x30d, 0x325,
+}
+
+const itLangStr = "" +
+	"afrikaansamaricoaraboazerbaigianobulgarobengalesecatalanocecodanesetedescogrecoi" +
+	"nglesespagnoloestonepersianofinlandesefrancesegujaratiebraicohindicroatoungheres" +
+	"earmenoindonesianoislandeseitalianogiapponesegeorgianokazakokhmerkannadacoreanok" +
+	"irghisolaolituanolettonemacedonemalayalammongolomarathimalesebirmanonepaleseolan" +
+	"desenorvegese bokmalpunjabipolaccoportogheserumenorussosingaleseslovaccoslovenoa" +
+	"lbaneseserbosvedeseswahilitamilteluguthaiturcoucrainourduusbecovietnamitacinesez" +
+	"ulufilippinoarabo moderno standardinglese britannicoinglese americanospagnolo la" +
+	"tinoamericanospagnolo europeofrancese canadeseportoghese brasilianoportoghese eu" +
+	"ropeocinese semplificatocinese tradizionale"
+
+var itLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x10, 0x15, 0x21, 0x28, 0x31, 0x39, 0x3d, 0x43, 0x4a, 0x4f,
+	0x56, 0x5e, 0x64, 0x6c, 0x76, 0x7e, 0x86, 0x8d, 0x92, 0x98, 0xa1, 0xa7,
+	0xb2, 0xbb, 0xc3, 0xcd, 0xd6, 0xdc, 0xe1, 0xe8, 0xef, 0xf7, 0xfa, 0x101,
+	0x108, 0x110, 0x119, 0x120, 0x127, 0x12d, 0x134, 0x13c, 0x144, 0x154, 0x15b, 0x162,
+	0x16c, 0x172, 0x177, 0x180, 0x188, 0x18f, 0x197, 0x19c, 0x1a3, 0x1aa, 0x1af, 0x1b5,
+	0x1b9, 0x1be, 0x1c5, 0x1c9, 0x1cf, 0x1d9, 0x1df, 0x1e3, 0x1ec, 0x202, 0x214, 0x225,
+	0x23d, 0x24d, 0x25e, 0x273, 0x285, 0x298, 0x2ab,
+}
+
+const jaLangStr = "" +
+	"アフリカーンス語アムハラ語アラビア語アゼルバイジャン語ブルガリア語ベンガル語カタロニア語チェコ語デンマーク語ドイツ語ギリシャ語英語スペイン語エストニア語ペルシア" +
+	"語フィンランド語フランス語グジャラート語ヘブライ語ヒンディー語クロアチア語ハンガリー語アルメニア語インドネシア語アイスランド語イタリア語日本語グルジア語カザフ語" +
+	"クメール語カンナダ語韓国語キルギス語ラオ語リトアニア語ラトビア語マケドニア語マラヤーラム語モンゴル語マラーティー語マレー語ビルマ語ネパール語オランダ語ノルウェー" +
+	"語(ブークモール)パンジャブ語ポーランド語ポルトガル語ルーマニア語ロシア語シンハラ語スロバキア語スロベニア語アルバニア語セルビア語スウェーデン語スワヒリ語タミル" +
+	"語テルグ語タイ語トルコ語ウクライナ語ウルドゥー語ウズベク語ベトナム語中国語ズールー語フィリピノ語イギリス英語アメリカ英語スペイン語(イベリア半島)ポルトガル語(" +
+	"イベリア半島)簡体中国語繁体中国語"
+
+var jaLangIdx = []uint16{ // 79 entries
+	0x0, 0x18, 0x27, 0x36, 0x51, 0x63, 0x72, 0x84, 0x90, 0xa2, 0xae, 0xbd,
+	0xc3, 0xd2, 0xe4, 0xf3, 0x108, 0x117, 0x12c, 0x13b, 0x14d, 0x15f, 0x171, 0x183,
+	0x198, 0x1ad, 0x1bc, 0x1c5, 0x1d4, 0x1e0, 0x1ef, 0x1fe, 0x207, 0x216, 0x21f, 0x231,
+	0x240, 0x252, 0x267, 0x276, 0x28b, 0x297, 0x2a3, 0x2b2, 0x2c1, 0x2e7, 0x2f9, 0x30b,
+	0x31d, 0x32f, 0x33b, 0x34a, 0x35c, 0x36e, 0x380, 0x38f, 0x3a4, 0x3b3, 0x3bf, 0x3cb,
+	0x3d4, 0x3e0, 0x3f2, 0x404, 0x413, 0x422, 0x42b, 0x43a, 0x44c, 0x44c, 0x45e, 0x470,
+	0x470, 0x493, 0x493, 0x493, 0x4b9, 0x4c8, 0x4d7,
+}
+
+const kaLangStr = "" +
+	"აფრიკაანსიამჰარულიარაბულიაზერბაიჯანულიბულგარულიბენგალურიკატალანურიჩეხურიდანიურიგ" +
+	"ერმანულიბერძნულიინგლისურიესპანურიესტონურისპარსულიფინურიფრანგულიგუჯარათიებრაულიჰი" +
+	"ნდიხორვატულიუნგრულისომხურიინდონეზიურიისლანდიურიიტალიურიიაპონურიქართულიყაზახურიქმ" +
+	"ერულიკანადაკორეულიყირგიზულილაოსურილიტვურილატვიურიმაკედონურიმალაიალამურიმონღოლური" +
+	"მარათჰიმალაიურიბირმულინეპალურინიდერლანდურინორვეგიული ბუკმოლიპენჯაბურიპოლონურიპორ" +
+	"ტუგალიურირუმინულირუსულისინჰალურისლოვაკურისლოვენურიალბანურისერბულიშვედურისუაჰილიტ" +
+	"ამილურიტელუგუტაითურქულიუკრაინულიურდუუზბეკურივიეტნამურიჩინურიზულუფილიპინურითანამე" +
+	"დროვე სტანდარტული არაბულიბრიტანული ინგლისურიამერიკული ინგლისურილათინურ ამერიკული" +
+	" ესპანურიევროპული ესპანურიკანადური ფრანგულიბრაზილიური პორტუგალიურიევროპული პორტუ" +
+	"გალიურიგამარტივებული ჩინურიტრადიციული ჩინური"
+
+var kaLangIdx = []uint16{ // 79 entries
+	0x0, 0x1e, 0x36, 0x4b, 0x72, 0x8d, 0xa8, 0xc6, 0xd8, 0xed, 0x108, 0x120,
+	0x13b, 0x153, 0x16b, 0x183, 0x195, 0x1ad, 0x1c5, 0x1da, 0x1e9, 0x204, 0x219, 0x22e,
+	0x24f, 0x26d, 0x285, 0x29d, 0x2b2, 0x2ca, 0x2df, 0x2f1, 0x306, 0x321, 0x336, 0x34b,
+	0x363, 0x381, 0x3a5, 0x3c0, 0x3d5, 0x3ed, 0x402, 0x41a, 0x43e, 0x472, 0x48d, 0x4a5,
+	0x4c9, 0x4e1, 0x4f3, 0x50e, 0x529, 0x544, 0x55c, 0x571, 0x586, 0x59b, 0x5b3, 0x5c5,
+	0x5ce, 0x5e3, 0x5fe, 0x60a, 0x622, 0x640, 0x652, 0x65e, 0x67c, 0x6d5, 0x70c, 0x743,
+	0x78d, 0x7be, 0x7ef, 0x832, 0x86f, 0x8a9, 0x8da,
+}
+
+const kkLangStr = "" +
+	"африкаансамхарарабәзірбайжанболгарбенгалкаталанчехдатнеміс тілігрекағылшын тіліи" +
+	"спан тіліэстонпарсыфинфранцуз тілігуджаратиивритхиндихорватвенгерармяниндонезияи" +
+	"сландитальян тіліжапон тілігрузинқазақ тілікхмерканнадакәрісқырғызлаослитвалатыш" +
+	"македонмалайяламмаратхималайбирманнепалголланднорвегиялық букмолпанджабполякпорт" +
+	"угал тілірумынорыс тілісингалсловаксловеналбансербшведсуахилитамилтелугутайтүрік" +
+	"украинурдуөзбеквьетнамқытай тілізулуфилиппинқазіргі стандартты араб тілібритания" +
+	"лық ағылшын тіліамерикандық ағылшын тілілатын американдық испан тіліибериялық ис" +
+	"пан тіліканадалық француз тілібразилиялық португал тіліеуропалық португал тіліқа" +
+	"рапайым қытай тілідәстүрлік қытай тілі"
+
+var kkLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x1c, 0x24, 0x38, 0x44, 0x50, 0x5e, 0x64, 0x6a, 0x7d, 0x85,
+	0x9c, 0xaf, 0xb9, 0xc3, 0xc9, 0xe0, 0xf2, 0xfc, 0x106, 0x112, 0x11e, 0x128,
+	0x13a, 0x146, 0x15d, 0x170, 0x17c, 0x18f, 0x199, 0x1a7, 0x1b1, 0x1bd, 0x1c5, 0x1cf,
+	0x1d9, 0x1e7, 0x1f9, 0x1f9, 0x207, 0x211, 0x21d, 0x227, 0x235, 0x258, 0x266, 0x270,
+	0x289, 0x293, 0x2a4, 0x2b0, 0x2bc, 0x2c8, 0x2d2, 0x2da, 0x2e2, 0x2f0, 0x2fa, 0x306,
+	0x30c, 0x316, 0x322, 0x32a, 0x334, 0x342, 0x355, 0x35d, 0x36d, 0x3a2, 0x3d0, 0x3fe,
+	0x433, 0x459, 0x483, 0x4b3, 0x4df, 0x505, 0x52b,
+}
+
+const kmLangStr = "" +
+	"អាហ្វ្រីកាអានអាមហារីចអារ៉ាប់អាហ៊្សែរបែហ្សង់ប៊ុលហ្ការីបង់ក្លាដែសកាតាឡានឆេកដាណឺម៉ា" +
+	"កអាល្លឺម៉ង់ក្រិចអង់គ្លេសអេស្ប៉ាញអេស្តូនីភឺសៀនហ្វាំងឡង់បារាំងហ្កុយ៉ារាទីអ៊ីស្រាអែ" +
+	"លហិណ្ឌូក្រូអាតហុងគ្រីអារមេនីឥណ្ឌូណេស៊ីអ៊ីស្លង់អ៊ីតាលីជប៉ុនហ្សក​ហ្ស៊ីកាហ្សាក់ស្តង" +
+	"់់ខ្មែរកន្នដកូរ៉េគៀរហ្គីស្តង់ឡាវលីទុយអានីឡាតវីយ៉ាម៉ាសេដូនីមលយាល័មភាសាម៉ុងហ្គោលីម" +
+	"រាធិម៉ាឡេស៊ីភូមានេប៉ាល់ហុល្លង់ន័រវែស បុកម៉ាល់បឹនជាពិប៉ូឡូញព័រទុយហ្កាល់រូម៉ានីរុស" +
+	"្ស៊ីស្រីលង្កាស្លូវ៉ាគីស្លូវ៉ានីអាល់បានីសើបស៊ុយអែដស្វាហ៊ីលីតាមីលតេលុគុថៃទួរគីអ៊ុយ" +
+	"ក្រែនអ៊ូរឌូអ៊ូហ្សបេគីស្តង់វៀតណាមចិនសូលូហ្វីលីពីនអារ៉ាប់​ស្តង់ដារ​ទំនើបអង់គ្លេស ច" +
+	"ក្រភព​អង់គ្លេសអង់គ្លេស អាមេរិកអេស្ប៉ាញ អាមេរីកឡាតាំងអេស្ប៉ាញ អឺរ៉ុបព័រទុយហ្គាល់ " +
+	"ប្រេស៊ីលព័រទុយហ្គាល់ អឺរ៉ុបអក្សរ​ចិន​កាត់អក្សរ​ចិន​ពេញ"
+
+var kmLangIdx = []uint16{ // 79 entries
+	0x0, 0x27, 0x3f, 0x54, 0x81, 0x9f, 0xbd, 0xd2, 0xdb, 0xf3, 0x111, 0x120,
+	0x138, 0x150, 0x168, 0x177, 0x192, 0x1a4, 0x1c5, 0x1e3, 0x1f5, 0x20a, 0x21f, 0x234,
+	0x252, 0x26a, 0x27f, 0x28e, 0x2ac, 0x2d6, 0x2e5, 0x2f4, 0x303, 0x327, 0x330, 0x34b,
+	0x363, 0x37e, 0x393, 0x3bd, 0x3cc, 0x3e4, 0x3f0, 0x405, 0x41a, 0x445, 0x45a, 0x46c,
+	0x490, 0x4a5, 0x4ba, 0x4d5, 0x4f0, 0x50b, 0x523, 0x52c, 0x541, 0x55c, 0x56b, 0x57d,
+	0x583, 0x592, 0x5ad, 0x5bf, 0x5ec, 0x5fe, 0x607, 0x613, 0x62e, 0x670, 0x6b6, 0x6e4,
+	0x724, 0x74f, 0x74f, 0x78c, 0x7c3, 0x7ed, 0x814,
+}
+
+const knLangStr = "" +
+	"ಆಫ್ರಿಕಾನ್ಸ್ಅಂಹರಿಕ್ಅರೇಬಿಕ್ಅಜೆರ್ಬೈಜಾನಿಬಲ್ಗೇರಿಯನ್ಬೆಂಗಾಲಿಕೆಟಲಾನ್ಜೆಕ್ಡ್ಯಾನಿಶ್ಜರ್ಮನ್ಗ್" +
+	"ರೀಕ್ಇಂಗ್ಲೀಷ್ಸ್ಪ್ಯಾನಿಷ್ಎಸ್ಟೊನಿಯನ್ಪರ್ಶಿಯನ್ಫಿನ್ನಿಶ್ಫ್ರೆಂಚ್ಗುಜರಾತಿಹೀಬ್ರ್ಯೂಹಿಂದಿಕ್ರೊಯ" +
+	"ೇಶಿಯನ್ಹಂಗೇರಿಯನ್ಅರ್ಮೇನಿಯನ್ಇಂಡೋನೇಶಿಯನ್ಐಸ್ಲಾಂಡಿಕ್ಇಟಾಲಿಯನ್ಜಾಪನೀಸ್ಜಾರ್ಜಿಯನ್ಕಝಕ್ಖಮೇರ್ಕ" +
+	"ನ್ನಡಕೋರಿಯನ್ಕಿರ್ಜಿಝ್ಲಾವೋಲಿಥುವೇನಿಯನ್ಲಟ್ವಿಯನ್ಮೆಸಿಡೋನಿಯನ್ಮಲಯಾಳಂಮಂಗೋಲಿಯನ್ಮರಾಠಿಮಲಯಬರ್ಮ" +
+	"ೀಸ್ನೇಪಾಳಿಡಚ್ನಾರ್ವೇಜಿಯನ್ ಬೊಕ್ಮಲ್ಪಂಜಾಬಿಪೋಲಿಶ್ಪೋರ್ಚುಗೀಸ್ರೊಮೇನಿಯನ್ರಶಿಯನ್ಸಿಂಹಳಸ್ಲೋವಾಕ" +
+	"್ಸ್ಲೋವೇನಿಯನ್ಅಲ್ಬೇನಿಯನ್ಸರ್ಬಿಯನ್ಸ್ವೀಡಿಷ್ಸ್ವಹಿಲಿತಮಿಳುತೆಲುಗುಥಾಯ್ಟರ್ಕಿಶ್ಉಕ್ರೈನಿಯನ್ಉರ್" +
+	"ದುಉಜ್ಬೇಕ್ವಿಯೇಟ್ನಾಮೀಸ್ಚೈನೀಸ್ಜುಲುಫಿಲಿಪಿನೊಆಧುನಿಕ ಪ್ರಮಾಣಿತ ಅರೇಬಿಕ್ಬ್ರಿಟಿಷ್ ಇಂಗ್ಲೀಷ್ಯ" +
+	"ು.ಎಸ್. ಇಂಗ್ಲೀಷ್ಲ್ಯಾಟಿನ್ ಅಮೇರಿಕನ್ ಸ್ಪ್ಯಾನಿಶ್ಯುರೋಪಿಯನ್ ಸ್ಪ್ಯಾನಿಷ್ಕೆನೆಡಿಯನ್ ಫ್ರೆಂಚ್" +
+	"ಬ್ರೆಜಿಲಿಯನ್ ಪೋರ್ಚುಗೀಸ್ಯೂರೋಪಿಯನ್ ಪೋರ್ಚುಗೀಸ್ಸಿಂಪ್ಲಿಫೈಡ್ ಚೈನೀಸ್ಸಾಂಪ್ರದಾಯಿಕ ಚೈನೀಸ್"
+
+var knLangIdx = []uint16{ // 79 entries
+	0x0, 0x21, 0x36, 0x4b, 0x6c, 0x8a, 0x9f, 0xb4, 0xc0, 0xd8, 0xea, 0xfc,
+	0x114, 0x132, 0x150, 0x168, 0x180, 0x195, 0x1aa, 0x1c2, 0x1d1, 0x1f2, 0x20d, 0x22b,
+	0x24c, 0x26a, 0x282, 0x297, 0x2b2, 0x2be, 0x2cd, 0x2dc, 0x2f1, 0x309, 0x315, 0x336,
+	0x34e, 0x36f, 0x381, 0x39c, 0x3ab, 0x3b4, 0x3c9, 0x3db, 0x3e4, 0x41b, 0x42d, 0x43f,
+	0x45d, 0x478, 0x48a, 0x499, 0x4b1, 0x4d2, 0x4f0, 0x508, 0x520, 0x535, 0x544, 0x556,
+	0x562, 0x577, 0x595, 0x5a4, 0x5b9, 0x5dd, 0x5ef, 0x5fb, 0x613, 0x654, 0x685, 0x6af,
+	0x6ff, 0x739, 0x76a, 0x7aa, 0x7e4, 0x818, 0x84c,
+}
+
+const koLangStr = "" +
+	"남아공 공용어암하라어아랍어아제르바이잔어불가리아어벵골어카탈로니아어체코어덴마크어독일어그리스어영어스페인어에스토니아어페르시아어핀란드어프랑스어구자라트어히" +
+	"브리어힌디어크로아티아어헝가리어아르메니아어인도네시아어아이슬란드어이탈리아어일본어조지아어카자흐어캄보디아어칸나다어한국어키르기스어라오어리투아니아어라트비아" +
+	"어마케도니아어말라얄람어몽고어마라티어말레이어버마어네팔어네덜란드어노르웨이어(보크말)펀잡어폴란드어포르투갈어루마니아어러시아어스리랑카어슬로바키아어슬로베니" +
+	"아어알바니아어세르비아어스웨덴어스와힐리어타밀어텔루구어태국어터키어우크라이나어우르두어우즈베크어베트남어중국어줄루어필리핀어현대 표준 아랍어영어 (영국식)" +
+	"영어 (미국식)중남미 스페인어유럽식 스페인어프랑스어 (캐나다)포르투갈어 (브라질)유럽식 포르투갈어중국어(간체)중국어(번체)"
+
+var koLangIdx = []uint16{ // 79 entries
+	0x0, 0x13, 0x1f, 0x28, 0x3d, 0x4c, 0x55, 0x67, 0x70, 0x7c, 0x85, 0x91,
+	0x97, 0xa3, 0xb5, 0xc4, 0xd0, 0xdc, 0xeb, 0xf7, 0x100, 0x112, 0x11e, 0x130,
+	0x142, 0x154, 0x163, 0x16c, 0x178, 0x184, 0x193, 0x19f, 0x1a8, 0x1b7, 0x1c0, 0x1d2,
+	0x1e1, 0x1f3, 0x202, 0x20b, 0x217, 0x223, 0x22c, 0x235, 0x244, 0x25e, 0x267, 0x273,
+	0x282, 0x291, 0x29d, 0x2ac, 0x2be, 0x2d0, 0x2df, 0x2ee, 0x2fa, 0x309, 0x312, 0x31e,
+	0x327, 0x330, 0x342, 0x34e, 0x35d, 0x369, 0x372, 0x37b, 0x387, 0x39e, 0x3b0, 0x3c2,
+	0x3d8, 0x3ee, 0x406, 0x421, 0x43a, 0x44b, 0x45c,
+}
+
+const kyLangStr = "" +
+	"африкаанчаамхарчаАрабчаазербайжанчаболгариячабангладешчекаталанчачех тилидатчане" +
+	"мисчегрекчеанглисчеиспанчаэстончоФарзчафинчефранцузчагужаратчаеврейчеиндусчахорв" +
+	"атчавенгриячаармянчаиндонезиячаисландчаиталиянчаяпончогрузинчеказакчакмерчеканна" +
+	"дачакорейчекыргызчалаочолитвачалатвиячамакедониячамалайаламчамаратичемалайчабурм" +
+	"ачанепалчаголландчанорвежче букмалчапунжабичеполякчапортугалчарумыниячаорусчасин" +
+	"галачасловакчасловенчеалбанчасербчешведчесуахиличетамилчетелугучатайчатуркчоукра" +
+	"инчеурдучаозбекчевьетнамчакытайчазулучатагалчаазыркы араб адабий тилиндебританча" +
+	" англисчеамерикалык англисчелатын америкалык испанчаевропалык испанчаканадалык ф" +
+	"ранцузчабразилиялык португалчаевропалык португалчажөнөкөйлөтүлгөн кытайчасалттуу" +
+	" кытайча"
+
+var kyLangIdx = []uint16{ // 79 entries
+	0x0, 0x14, 0x22, 0x2e, 0x46, 0x5a, 0x70, 0x82, 0x91, 0x9b, 0xa9, 0xb5,
+	0xc5, 0xd3, 0xe1, 0xed, 0xf7, 0x109, 0x11b, 0x129, 0x137, 0x147, 0x159, 0x167,
+	0x17d, 0x18d, 0x19f, 0x1ab, 0x1bb, 0x1c9, 0x1d5, 0x1e7, 0x1f5, 0x205, 0x20f, 0x21d,
+	0x22d, 0x243, 0x259, 0x259, 0x269, 0x277, 0x285, 0x293, 0x2a5, 0x2c6, 0x2d8, 0x2e6,
+	0x2fa, 0x30c, 0x318, 0x32a, 0x33a, 0x34a, 0x358, 0x364, 0x370, 0x382, 0x390, 0x3a0,
+	0x3aa, 0x3b6, 0x3c6, 0x3d2, 0x3e0, 0x3f2, 0x400, 0x40c, 0x41a, 0x44b, 0x46c, 0x491,
+	0x4bf, 0x4e0, 0x505, 0x530, 0x557, 0x584, 0x5a1,
+}
+
+const loLangStr = "" +
+	"ອາຟຣິການອຳຮາຣິກອາຣັບອາເຊີໄບຈານິບັງກາຣຽນເບັງກາລີຄາຕາລານເຊກແດນິຊເຢຍລະມັນກຣີກອັງກິດ" +
+	"ສະແປນນິຊເອສໂຕນຽນເປີຊຽນຟິນນິຊຝຣັ່ງກູຈາຣາຕິຮີບຣິວຮິນດິໂຄຣຕຽນຮັງກາຣຽນອາເມນຽນອິນໂດເນ" +
+	"ຊຽນໄອສແລນດິກອິຕາລຽນຍີ່ປຸ່ນຈໍຈຽນຄາຊັກຂະເໝນຄັນນາດາເກົາຫລີເກຍກີສລາວລີທົວນຽນລັດວຽນແມ" +
+	"ັກເຊໂດນຽນມາເລອາລຳມ່ອງໂກເລ້ຍມາຣາທິມາເລມຽນມາເນປາລີດັຊນໍເວຈຽນ ບັອກມອລປັນຈາບີໂປລິຊປໍ" +
+	"ຕູກີສໂຣແມນຽນຣັດຊຽນສິນຫາລາສະໂລແວັກສະໂລເວນຽນອານບານຽນເຊີບຽນສະວີດິຊຊວາຮີລິທາມິວເຕລູກ" +
+	"ູໄທເທີຄິຊຢູເຄຣນຽນອູຣະດູອຸສເບກຫວຽດນາມຈີນຊູລູຟີລິປີໂນອາຣາບິກມາດຕະຖານສະໄໝໃໝ່ອັງກິດ " +
+	"(ບຣິທິຊ)ອັງກິດ (ອາເມລິກັນ)ລາຕິນ ອາເມຣິກັນ ສະແປນນິຊຢູໂຣປຽນ ສະແປນນິຊເຄເນດຽນ ຝຣັ່ງບ" +
+	"ຣາຊິລຽນ ປໍຕູກີສຢູໂຣປຽນ ປໍຕູກີສຈີນແບບຮຽບງ່າຍຈີນແບບດັ້ງເດີມ"
+
+var loLangIdx = []uint16{ // 79 entries
+	0x0, 0x18, 0x2d, 0x3c, 0x5d, 0x75, 0x8d, 0xa2, 0xab, 0xba, 0xd2, 0xde,
+	0xf0, 0x108, 0x120, 0x132, 0x144, 0x153, 0x16b, 0x17d, 0x18c, 0x19e, 0x1b6, 0x1cb,
+	0x1e9, 0x204, 0x219, 0x22e, 0x23d, 0x24c, 0x25b, 0x270, 0x285, 0x297, 0x2a0, 0x2b8,
+	0x2ca, 0x2eb, 0x303, 0x321, 0x333, 0x33f, 0x34e, 0x360, 0x369, 0x394, 0x3a9, 0x3b8,
+	0x3cd, 0x3e2, 0x3f4, 0x409, 0x421, 0x43c, 0x454, 0x466, 0x47b, 0x490, 0x49f, 0x4b1,
+	0x4b7, 0x4c9, 0x4e1, 0x4f3, 0x505, 0x51a, 0x523, 0x52f, 0x547, 0x589, 0x5b0, 0x5e0,
+	0x624, 0x652, 0x677, 0x6a5, 0x6d0, 0x6f7, 0x721,
+}
+
+const ltLangStr = "" +
+	"afrikanųamharųarabųazerbaidžaniečiųbulgarųbengalųkatalonųčekųdanųvokiečiųgraikųa" +
+	"nglųispanųestųpersųsuomiųprancūzųgudžaratųhebrajųhindikroatųvengrųarmėnųindonezi" +
+	"ečiųislandųitalųjaponųgruzinųkazachųkhmerųkanadųkorėjiečiųkirgizųlaosiečiųlietuv" +
+	"iųlatviųmakedonųmalajaliųmongolųmaratųmalajiečiųbirmiečiųnepaliečiųolandųNorvegi" +
+	"jos rašytinė – būkmolųpendžabųlenkųportugalųrumunųrusųsinhalųslovakųslovėnųalban" +
+	"ųserbųšvedųsuahiliųtamilųtelugųtajųturkųukrainiečiųurdųuzbekųvietnamiečiųkinųzul" +
+	"ųfilipiniečiųšiuolaikinė standartinė arabųDidžiosios Britanijos anglųJungtinių V" +
+	"alstijų anglųLotynų Amerikos ispanųEuropos ispanųKanados prancūzųBrazilijos port" +
+	"ugalųEuropos portugalųsupaprastintoji kinųtradicinė kinų"
+
+var ltLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x10, 0x16, 0x29, 0x31, 0x39, 0x42, 0x48, 0x4d, 0x57, 0x5e,
+	0x64, 0x6b, 0x70, 0x76, 0x7d, 0x87, 0x92, 0x9a, 0x9f, 0xa6, 0xad, 0xb5,
+	0xc3, 0xcb, 0xd1, 0xd8, 0xe0, 0xe8, 0xef, 0xf6, 0x103, 0x10b, 0x116, 0x11f,
+	0x126, 0x12f, 0x139, 0x141, 0x148, 0x154, 0x15f, 0x16b, 0x172, 0x195, 0x19f, 0x1a5,
+	0x1af, 0x1b6, 0x1bb, 0x1c3, 0x1cb, 0x1d4, 0x1db, 0x1e1, 0x1e8, 0x1f1, 0x1f8, 0x1ff,
+	0x204, 0x20a, 0x217, 0x21c, 0x223, 0x231, 0x236, 0x23b, 0x249, 0x26a, 0x287, 0x2a2,
+	0x2ba, 0x2c9, 0x2db, 0x2f0, 0x302, 0x317, 0x327,
+}
+
+const lvLangStr = "" +
+	"afrikanduamharuarābuazerbaidžāņubulgārubengāļukatalāņučehudāņuvācugrieķuangļuspā" +
+	"ņuigauņupersiešusomufrančugudžaratuivritshindihorvātuungāruarmēņuindonēziešuīsla" +
+	"ndiešuitāļujapāņugruzīnukazahukhmerukannadukorejiešukirgīzulaosiešulietuviešulat" +
+	"viešumaķedoniešumalajalumongoļumaratumalajiešubirmiešunepāliešuholandiešunorvēģu" +
+	" bukmolspandžabupoļuportugāļurumāņukrievusingāļuslovākuslovēņualbāņuserbuzviedru" +
+	"svahilitamilutelugutajuturkuukraiņuurduuzbekuvjetnamiešuķīniešuzulufilipīniešumū" +
+	"sdienu standarta arābuLielbritānijas angļuASV angļuLatīņamerikas spāņuEiropas sp" +
+	"āņuKanādas frančuBrazīlijas portugāļuEiropas portugāļuķīniešu vienkāršotāķīniešu" +
+	" tradicionālā"
+
+var lvLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0xf, 0x15, 0x24, 0x2c, 0x35, 0x3f, 0x44, 0x4a, 0x4f, 0x56,
+	0x5c, 0x63, 0x6a, 0x73, 0x77, 0x7e, 0x88, 0x8e, 0x93, 0x9b, 0xa2, 0xaa,
+	0xb7, 0xc3, 0xca, 0xd2, 0xda, 0xe0, 0xe6, 0xed, 0xf7, 0xff, 0x108, 0x113,
+	0x11c, 0x129, 0x131, 0x139, 0x13f, 0x149, 0x152, 0x15d, 0x168, 0x179, 0x182, 0x187,
+	0x192, 0x19a, 0x1a0, 0x1a9, 0x1b1, 0x1ba, 0x1c2, 0x1c7, 0x1ce, 0x1d5, 0x1db, 0x1e1,
+	0x1e5, 0x1ea, 0x1f2, 0x1f6, 0x1fc, 0x208, 0x212, 0x216, 0x223, 0x23d, 0x253, 0x25d,
+	0x274, 0x283, 0x293, 0x2aa, 0x2bd, 0x2d6, 0x2ef,
+}
+
+const mkLangStr = "" +
+	"африкансамхарскиарапскиазербејџанскибугарскибенгалскикаталонскичешкиданскигерман" +
+	"скигрчкианглискишпанскиестонскиперсискифинскифранцускигуџаратихебрејскихиндихрва" +
+	"тскиунгарскиерменскииндонезискиисландскииталијанскијапонскигрузискиказакстанскик" +
+	"мерскиканнадакорејскикиргистанскилаошкилитванскилатвискимакедонскималајаламмонго" +
+	"лскимаратималезискибурманскинепалскихоландскинорвешки букмолпанџабиполскипортуга" +
+	"лскироманскирускисинхалскисловачкисловенечкиалбанскисрпскишведскисвахилитамилски" +
+	"телугутајландскитурскиукраинскиурдуузбекистанскивиетнамскикинескизулуфилипинскил" +
+	"итературен арапскианглиски (во Велика Британија)американски англискишпански (во " +
+	"Латинска Америка)шпански (во Европа)француски (во Канада)португалски (во Бразил)" +
+	"португалски (во Европа)поедноставен кинескитрадиционален кинески"
+
+var mkLangIdx = []uint16{ // 79 entries
+	0x0, 0x10, 0x20, 0x2e, 0x48, 0x58, 0x6a, 0x7e, 0x88, 0x94, 0xa6, 0xb0,
+	0xc0, 0xce, 0xde, 0xee, 0xfa, 0x10c, 0x11c, 0x12e, 0x138, 0x148, 0x158, 0x168,
+	0x17e, 0x190, 0x1a6, 0x1b6, 0x1c6, 0x1de, 0x1ec, 0x1fa, 0x20a, 0x222, 0x22e, 0x240,
+	0x250, 0x264, 0x276, 0x288, 0x294, 0x2a6, 0x2b8, 0x2c8, 0x2da, 0x2f7, 0x305, 0x311,
+	0x327, 0x337, 0x341, 0x353, 0x363, 0x377, 0x387, 0x393, 0x3a1, 0x3af, 0x3bf, 0x3cb,
+	0x3df, 0x3eb, 0x3fd, 0x405, 0x41f, 0x433, 0x441, 0x449, 0x45d, 0x482, 0x4b9, 0x4e0,
+	0x515, 0x537, 0x55d, 0x587, 0x5b1, 0x5d8, 0x601,
+}
+
+const mlLangStr = "" +
+	"ആഫ്രിക്കാൻസ്അമാറിക്അറബിക്അസർബൈജാനിബൾഗേറിയൻബംഗാളികറ്റാലൻചെക്ക്ഡാനിഷ്ജർമ്മൻഗ്രീക്ക" +
+	"്ഇംഗ്ലീഷ്സ്‌പാനിഷ്എസ്റ്റോണിയൻപേർഷ്യൻഫിന്നിഷ്ഫ്രഞ്ച്ഗുജറാത്തിഹീബ്രുഹിന്ദിക്രൊയേഷ്" +
+	"യൻഹംഗേറിയൻഅർമേനിയൻഇൻഡോനേഷ്യൻഐസ്‌ലാൻഡിക്ഇറ്റാലിയൻജപ്പാനീസ്ജോർജിയൻകസാഖ്കംബോഡിയൻകന്" +
+	"നഡകൊറിയൻകിർഗിസ്ലാവോലിത്വേനിയൻലാത്വിയൻമാസിഡോണിയൻമലയാളംമംഗോളിയൻമറാഠിമലയബർമീസ്നേപ്പ" +
+	"ാളിഡച്ച്നോർവീജിയൻ ബുക്‌മൽപഞ്ചാബിപോളിഷ്പോർച്ചുഗീസ്റുമേനിയൻറഷ്യൻസിംഹളസ്ലോവാക്ക്സ്ല" +
+	"ോവേനിയൻഅൽബേനിയൻസെർബിയൻസ്വീഡിഷ്സ്വാഹിലിതമിഴ്തെലുങ്ക്തായ്തുർക്കിഉക്രേനിയൻഉറുദുഉസ്‌" +
+	"ബെക്ക്വിയറ്റ്നാമീസ്ചൈനീസ്സുലുഫിലിപ്പിനോആധുനിക സ്റ്റാൻഡേർഡ് അറബിക്ബ്രിട്ടീഷ് ഇംഗ്" +
+	"ലീഷ്അമേരിക്കൻ ഇംഗ്ലീഷ്ലാറ്റിൻ അമേരിക്കൻ സ്‌പാനിഷ്ഇബേറിയൻ സ്‌പാനിഷ്കനേഡിയൻ ഫ്രഞ്ച" +
+	"്ബ്രസീലിയൻ പോർച്ചുഗീസ്ഇബേറിയൻ പോർച്ചുഗീസ്ചീനഭാഷ-ലളിതലിപിചീനഭാഷ-പരമ്പരാഗതലിപി"
+
+var mlLangIdx = []uint16{ // 79 entries
+	0x0, 0x24, 0x39, 0x4b, 0x66, 0x7e, 0x90, 0xa5, 0xb7, 0xc9, 0xdb, 0xf3,
+	0x10b, 0x126, 0x147, 0x15c, 0x174, 0x189, 0x1a4, 0x1b6, 0x1c8, 0x1e6, 0x1fe, 0x216,
+	0x234, 0x255, 0x270, 0x28b, 0x2a0, 0x2af, 0x2c7, 0x2d6, 0x2e8, 0x2fd, 0x309, 0x327,
+	0x33f, 0x35d, 0x36f, 0x387, 0x396, 0x39f, 0x3b1, 0x3c9, 0x3d8, 0x409, 0x41e, 0x430,
+	0x451, 0x469, 0x478, 0x487, 0x4a5, 0x4c3, 0x4db, 0x4f0, 0x508, 0x520, 0x52f, 0x547,
+	0x553, 0x568, 0x583, 0x592, 0x5b0, 0x5d7, 0x5e9, 0x5f5, 0x613, 0x65d, 0x694, 0x6c8,
+	0x715, 0x746, 0x771, 0x7ae, 0x7e5, 0x810, 0x84a,
+}
+
+const mnLangStr = "" +
+	"африкамхарарабазербайжанболгарбенгалкаталанчехданигермангреканглииспаниэстонипер" +
+	"сфинляндфранцгужаратиеврейхиндихорватунгарармениндонезиисландиталияпонгүржхасагк" +
+	"амбожканнадасолонгоскиргизлаослитвалатвимакедонмалайламмонголмаратималайбирмбалб" +
+	"аголланднорвегийн букмолпанжабпольшпортугалрумынороссинхаласловаксловеналбанисер" +
+	"бшведсвахилитамилтэлүгүтайтуркукрайнурдуузбеквьетнамхятадзулуфилиппинстандарт ар" +
+	"аббританийн англиамерикийн англилатин америкийн испаниевропын испаниканад францп" +
+	"ортугал (бразил)европын португалхялбаршуулсан хятадуламжлалт хятад"
+
+var mnLangIdx = []uint16{ // 79 entries
+	0x0, 0xa, 0x14, 0x1c, 0x30, 0x3c, 0x48, 0x56, 0x5c, 0x64, 0x70, 0x78,
+	0x82, 0x8e, 0x9a, 0xa2, 0xb0, 0xba, 0xca, 0xd4, 0xde, 0xea, 0xf4, 0xfe,
+	0x10e, 0x11a, 0x124, 0x12c, 0x134, 0x13e, 0x14a, 0x158, 0x168, 0x174, 0x17c, 0x186,
+	0x190, 0x19e, 0x1ae, 0x1ba, 0x1c6, 0x1d0, 0x1d8, 0x1e2, 0x1f0, 0x20f, 0x21b, 0x225,
+	0x235, 0x23f, 0x247, 0x255, 0x261, 0x26d, 0x279, 0x281, 0x289, 0x297, 0x2a1, 0x2ad,
+	0x2b3, 0x2bb, 0x2c7, 0x2cf, 0x2d9, 0x2e7, 0x2f1, 0x2f9, 0x309, 0x322, 0x33f, 0x35c,
+	0x386, 0x3a1, 0x3b6, 0x3d5, 0x3f4, 0x419, 0x436,
+}
+
+const mrLangStr = "" +
+	"अफ्रिकान्सअम्हारिकअरबीअझरबैजानीबल्गेरियनबंगालीकॅटलानझेकडॅनिशजर्मनग्रीकइंग्रजीस्प" +
+	"ॅनिशइस्टोनियनफारसीफिन्निशफ्रेंचगुजरातीहिब्रूहिंदीक्रोएशियनहंगेरियनआर्मेनियनइंडोन" +
+	"ेशियनआईसलँडिकइटालियनजपानीजॉर्जियनकझाकख्मेरकन्नडकोरियनकिरगीझलाओलिथुआनियनलाट्‌वियन" +
+	"मॅसेडोनियनमल्याळममंगोलियनमराठीमलयबर्मीनेपाळीडचनॉर्वेजियन बोकमालपंजाबीपोलिशपोर्तु" +
+	"गीजरोमानियनरशियनसिंहलास्लोव्हाकस्लोव्हेनियनअल्बानियनसर्बियनस्वीडिशस्वाहिलीतामिळत" +
+	"ेलगूथाईतुर्कीयुक्रेनियनउर्दूउझ्बेकव्हिएतनामीचीनीझुलूफिलिपिनोआधुनिक प्रमाणित अरबी" +
+	"इंग्रजी (यूके)यू.एस. इंग्रजीलॅटिन अमेरिकन स्पॅनिशयुरोपियन स्पॅनिशकॅनडियन फ्रेंचब" +
+	"्राझिलियन पोर्तुगीजयुरोपियन पोर्तुगीजसरलीकृत चीनीपारंपारिक चीनी"
+
+var mrLangIdx = []uint16{ // 79 entries
+	0x0, 0x1e, 0x36, 0x42, 0x5d, 0x78, 0x8a, 0x9c, 0xa5, 0xb4, 0xc3, 0xd2,
+	0xe7, 0xfc, 0x117, 0x126, 0x13b, 0x14d, 0x162, 0x174, 0x183, 0x19e, 0x1b6, 0x1d1,
+	0x1ef, 0x207, 0x21c, 0x22b, 0x243, 0x24f, 0x25e, 0x26d, 0x27f, 0x291, 0x29a, 0x2b5,
+	0x2d0, 0x2ee, 0x303, 0x31b, 0x32a, 0x333, 0x342, 0x354, 0x35a, 0x38b, 0x39d, 0x3ac,
+	0x3c7, 0x3df, 0x3ee, 0x400, 0x41b, 0x43f, 0x45a, 0x46f, 0x484, 0x49c, 0x4ab, 0x4ba,
+	0x4c3, 0x4d5, 0x4f3, 0x502, 0x514, 0x532, 0x53e, 0x54a, 0x562, 0x59a, 0x5be, 0x5e2,
+	0x61d, 0x64b, 0x673, 0x6ad, 0x6e1, 0x703, 0x72b,
+}
+
+const msLangStr = "" +
+	"AfrikaansAmharicArabAzerbaijanBulgariaBenggalaCataloniaCzechDenmarkJermanGreekIn" +
+	"ggerisSepanyolEstoniaParsiFinlandPerancisGujeratIbraniHindiCroatHungaryArmeniaIn" +
+	"donesiaIcelandItaliJepunGeorgiaKazakhstanKhmerKannadaKoreaKirghizLaosLithuaniaLa" +
+	"tviaMacedoniaMalayalamMongoliaMarathiBahasa MelayuBurmaNepalBelandaBokmål Norway" +
+	"PunjabiPolandPortugisRomaniaRusiaSinhalaSlovakSloveniaAlbaniaSerbiaSwedenSwahili" +
+	"TamilTeluguThaiTurkiUkraineUrduUzbekistanVietnamCinaZuluFilipinaArab Standard Mo" +
+	"denInggeris BritishInggeris ASSepanyol Amerika LatinSepanyol EropahPerancis Kana" +
+	"daPortugis BrazilPortugis EropahCina RingkasCina Tradisional"
+
+var msLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x10, 0x14, 0x1e, 0x26, 0x2e, 0x37, 0x3c, 0x43, 0x49, 0x4e,
+	0x56, 0x5e, 0x65, 0x6a, 0x71, 0x79, 0x80, 0x86, 0x8b, 0x90, 0x97, 0x9e,
+	0xa7, 0xae, 0xb3, 0xb8, 0xbf, 0xc9, 0xce, 0xd5, 0xda, 0xe1, 0xe5, 0xee,
+	0xf4, 0xfd, 0x106, 0x10e, 0x115, 0x122, 0x127, 0x12c, 0x133, 0x141, 0x148, 0x14e,
+	0x156, 0x15d, 0x162, 0x169, 0x16f, 0x177, 0x17e, 0x184, 0x18a, 0x191, 0x196, 0x19c,
+	0x1a0, 0x1a5, 0x1ac, 0x1b0, 0x1ba, 0x1c1, 0x1c5, 0x1c9, 0x1d1, 0x1e4, 0x1f4, 0x1ff,
+	0x215, 0x224, 0x233, 0x242, 0x251, 0x25d, 0x26d,
+}
+
+const myLangStr = "" +
+	"အာဖရိကန်းစ်အန်ဟာရစျချအာရေဗီအော်ဇောဘိုင်ဂျောနီဘူဂေးရီးယားဘင်္ဂါလီကာတာလန်ချက်ဒိန်း" +
+	"မတ်ဂျာမန်ဂရိအင်္ဂလိပ်စပိန်အက်စ်တိုးနီးရန်းပါရှန်ဖင်နစ်ရှ်ပြင်သစ်ဂူဂျာရသီဟီးဘရူးဟ" +
+	"ိန္ဒီခရိုအေရှန်ဟန်ဂေရီအာမေနီအန်အင်ဒိုနီးရှားအိုင်စ်လန္ဒီအီတလီဂျပန်ဂျော်ဂျီယန်ခါဇ" +
+	"ါခ်ခမာကန္နာဒါကိုးရီးယားခရူဂစ်လာအိုလစ္သူအာနီယံလက္ဘီအံမာစီဒိုနီယံမလေးရာလမ်မွန်ဂိုလ" +
+	"ီးယန်းမာရသီမလေးဗမာနီပါလီဒတ်ချ်ဘွတ်မော်လ်ပန်ချာပီပိုလန်ပေါ်တူဂီရိုမေနီယားရုရှဆင်ဟ" +
+	"ာလစလိုဗက်စလိုဗေးနီးယမ်းအယ်လ်ဘေးနီးယန်းဆားဗီးယန်းဆွီဒင်ဆြာဟီလီတမီးလ်တီလီဂုထိုင်းတ" +
+	"ာကစ်ယူကရိန်းအော်ဒူဦးဇ်ဘက်ဗီယက်နမ်တရုတ်ဇူလူဖိလစ်ပီနိုအရေဗီ(ပုံမှန်)ဗြိတိသျှ အင်္ဂ" +
+	"လိပ်အမေရိကန် အင်္ဂလိပ်လက်တင်အမေရိက စပိန်စပိန်(ဥရောပ)ကနေဒါ ပြင်သစ်ဘရာဇီး ပေါ်တူဂီ" +
+	"ရိုးရှင်းသော တရုတ်ရှေးရိုးစဉ်လာ တရုတ်"
+
+var myLangIdx = []uint16{ // 79 entries
+	0x0, 0x21, 0x3f, 0x51, 0x87, 0xa8, 0xc0, 0xd5, 0xe1, 0xf9, 0x10b, 0x114,
+	0x12f, 0x13e, 0x16e, 0x180, 0x19b, 0x1b0, 0x1c8, 0x1dd, 0x1ef, 0x20d, 0x222, 0x23d,
+	0x264, 0x288, 0x297, 0x2a6, 0x2c7, 0x2d9, 0x2e2, 0x2f7, 0x315, 0x327, 0x336, 0x357,
+	0x36c, 0x38d, 0x3a8, 0x3d2, 0x3e1, 0x3ed, 0x3f6, 0x408, 0x41a, 0x438, 0x450, 0x462,
+	0x47a, 0x498, 0x4a4, 0x4b6, 0x4cb, 0x4f5, 0x522, 0x540, 0x552, 0x567, 0x579, 0x58b,
+	0x59d, 0x5ac, 0x5c4, 0x5d6, 0x5eb, 0x603, 0x612, 0x61e, 0x63c, 0x662, 0x696, 0x6ca,
+	0x6fe, 0x71e, 0x743, 0x76e, 0x76e, 0x7a2, 0x7d9,
+}
+
+const neLangStr = "" +
+	"अफ्रिकान्सअम्हारिकअरबीअजरबैजानीबुल्गेरियालीबंगालीक्याटालनचेकडेनिशजर्मनग्रीकअंग्र" +
+	"ेजीस्पेनिसइस्टोनियालीफारसीफिनिशफ्रान्सेलीगुजरातीहिब्रुहिन्दीक्रोएशियालीहंग्रीयाल" +
+	"ीआर्मेनियालीइन्डोनेसियालीआइसल्यान्डिकइटालियनजापानीजर्जियालीकाजाखखमेरकन्नाडाकोरिय" +
+	"ालीकिर्गिजलाओलिथुआनियालीलात्भियालीम्याकेडोनियनमलयालममराठीमलायावर्मेलीनेपालीडचनर्" +
+	"वेली बोकमालपंजाबीपोलिशपोर्तुगीरोमानियालीरूसीसिन्हालास्लोभाकियालीस्लोभेनियालीअल्ब" +
+	"ेनियनसर्बियालीस्विडिशस्वाहिलीतामिलतेलुगुथाईटर्किशयुक्रेनीउर्दुउज्बेकीभियतनामीचिन" +
+	"ियाँजुलुफिलिपिनीअधुनिक प्रमाणिक अरबीवेलायती अंग्रेजीअमेरिकी अंग्रेजील्याटिन अमेर" +
+	"िकी स्पेनिसयुरोपेली स्पेनिसक्यानाडाली फ्रान्सेलीपोर्तुगी (युरोप)सरलिकृत चिनियाँप" +
+	"रम्परागत चिनियाँ"
+
+var neLangIdx = []uint16{ // 79 entries
+	0x0, 0x1e, 0x36, 0x42, 0x5d, 0x81, 0x93, 0xab, 0xb4, 0xc3, 0xd2, 0xe1,
+	0xf9, 0x10e, 0x12f, 0x13e, 0x14d, 0x16b, 0x180, 0x192, 0x1a4, 0x1c5, 0x1e3, 0x204,
+	0x22b, 0x24f, 0x264, 0x276, 0x291, 0x2a0, 0x2ac, 0x2c1, 0x2d9, 0x2ee, 0x2f7, 0x318,
+	0x336, 0x35a, 0x36c, 0x36c, 0x37b, 0x38a, 0x39f, 0x3b1, 0x3b7, 0x3df, 0x3f1, 0x400,
+	0x418, 0x436, 0x442, 0x45a, 0x47e, 0x4a2, 0x4bd, 0x4d8, 0x4ed, 0x505, 0x514, 0x526,
+	0x52f, 0x541, 0x559, 0x568, 0x57d, 0x595, 0x5aa, 0x5b6, 0x5ce, 0x606, 0x634, 0x662,
+	0x6a3, 0x6d1, 0x70e, 0x70e, 0x738, 0x763, 0x794,
+}
+
+const nlLangStr = "" +
+	"AfrikaansAmhaarsArabischAzerbeidzjaansBulgaarsBengaalsCatalaansTsjechischDeensDu" +
+	"itsGrieksEngelsSpaansEstlandsPerzischFinsFransGujaratiHebreeuwsHindiKroatischHon" +
+	"gaarsArmeensIndonesischIJslandsItaliaansJapansGeorgischKazachsKhmerKannadaKoreaa" +
+	"nsKirgizischLaotiaansLitouwsLetlandsMacedonischMalayalamMongoolsMarathiMaleisBir" +
+	"meesNepaleesNederlandsNoors - BokmålPunjabiPoolsPortugeesRoemeensRussischSingale" +
+	"esSlowaaksSloveensAlbaneesServischZweedsSwahiliTamilTeluguThaisTurksOekraïensUrd" +
+	"uOezbeeksVietnameesChineesZuluFilipijnsModern standaard ArabischBrits EngelsAmer" +
+	"ikaans EngelsLatijns-Amerikaans SpaansEuropees SpaansCanadees FransBraziliaans P" +
+	"ortugeesEuropees PortugeesVereenvoudigd ChineesTraditioneel Chinees"
+
+var nlLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x10, 0x18, 0x26, 0x2e, 0x36, 0x3f, 0x49, 0x4e, 0x53, 0x59,
+	0x5f, 0x65, 0x6d, 0x75, 0x79, 0x7e, 0x86, 0x8f, 0x94, 0x9d, 0xa5, 0xac,
+	0xb7, 0xbf, 0xc8, 0xce, 0xd7, 0xde, 0xe3, 0xea, 0xf2, 0xfc, 0x105, 0x10c,
+	0x114, 0x11f, 0x128, 0x130, 0x137, 0x13d, 0x144, 0x14c, 0x156, 0x165, 0x16c, 0x171,
+	0x17a, 0x182, 0x18a, 0x193, 0x19b, 0x1a3, 0x1ab, 0x1b3, 0x1b9, 0x1c0, 0x1c5, 0x1cb,
+	0x1d0, 0x1d5, 0x1df, 0x1e3, 0x1eb, 0x1f5, 0x1fc, 0x200, 0x209, 0x222, 0x22e, 0x23f,
+	0x258, 0x267, 0x275, 0x28a, 0x29c, 0x2b1, 0x2c5,
+}
+
+const noLangStr = "" +
+	"afrikaansamhariskarabiskaserbajdsjanskbulgarskbengalikatalansktsjekkiskdansktysk" +
+	"greskengelskspanskestiskpersiskfinskfranskgujaratihebraiskhindikroatiskungarskar" +
+	"menskindonesiskislandskitalienskjapanskgeorgiskkasakhiskkhmerkannadakoreanskkirg" +
+	"isisklaotisklitauisklatviskmakedonskmalayalammongolskmarathimalayiskburmesisknep" +
+	"alsknederlandsknorsk bokmålpanjabipolskportugisiskrumenskrussisksingalesiskslova" +
+	"kiskslovenskalbanskserbisksvenskswahilitamilteluguthaityrkiskukrainskurduusbekis" +
+	"kvietnamesiskkinesiskzulufilippinskmoderne standard arabiskbritisk engelskamerik" +
+	"ansk engelsklatinamerikansk spanskeuropeisk spanskcanadisk franskbrasiliansk por" +
+	"tugisiskeuropeisk portugisiskforenklet kinesisktradisjonell kinesisk"
+
+var noLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x11, 0x18, 0x26, 0x2e, 0x35, 0x3e, 0x47, 0x4c, 0x50, 0x55,
+	0x5c, 0x62, 0x68, 0x6f, 0x74, 0x7a, 0x82, 0x8a, 0x8f, 0x97, 0x9e, 0xa5,
+	0xaf, 0xb7, 0xc0, 0xc7, 0xcf, 0xd8, 0xdd, 0xe4, 0xec, 0xf5, 0xfc, 0x104,
+	0x10b, 0x114, 0x11d, 0x125, 0x12c, 0x134, 0x13d, 0x144, 0x14f, 0x15c, 0x163, 0x168,
+	0x173, 0x17a, 0x181, 0x18c, 0x195, 0x19d, 0x1a4, 0x1ab, 0x1b1, 0x1b8, 0x1bd, 0x1c3,
+	0x1c7, 0x1ce, 0x1d6, 0x1da, 0x1e2, 0x1ee, 0x1f6, 0x1fa, 0x204, 0x21c, 0x22b, 0x23d,
+	0x253, 0x263, 0x272, 0x289, 0x29e, 0x2b0, 0x2c5,
+}
+
+const paLangStr = "" +
+	"ਅਫ਼ਰੀਕੀਅਮਹਾਰਿਕਅਰਬੀਅਜ਼ਰਬੈਜਾਨੀਬੁਲਗਾਰੀਅਨਬੰਗਾਲੀਕੈਟਾਲਾਨਚੈਕਡੈਨਿਸ਼ਜਰਮਨਗ੍ਰੀਕਅੰਗਰੇਜ਼ੀਸਪੈਨ" +
+	"ਿਸ਼ਇਸਟੋਨੀਅਨਫ਼ਾਰਸੀਫਿਨਿਸ਼ਫ੍ਰੈਂਚਗੁਜਰਾਤੀਯਹੂਦੀਹਿੰਦੀਕ੍ਰੋਏਸ਼ਿਆਈਹੰਗੇਰੀਅਨਆਰਮੀਨੀਅਨਇੰਡੋਨੇਸ਼" +
+	"ੀਆਈਆਈਸਲੈਂਡਿਕਇਤਾਲਵੀਜਾਪਾਨੀਜਾਰਜੀਅਨਕਜ਼ਾਖ਼ਖਮੇਰਕੰਨੜਕੋਰੀਆਈਕਿਰਗੀਜ਼ਲਾਓਲਿਥੁਆਨੀਅਨਲਾਟਵਿਅਨਮੈਕ" +
+	"ਡੋਨੀਅਨਮਲਿਆਲਮਮਰਾਠੀਮਲਯਬਰਮੀਨੇਪਾਲੀਡੱਚਨੌਰਵੇਜਿਅਨ ਬੋਕਮਲਪੰਜਾਬੀਪੋਲਿਸ਼ਪੁਰਤਗਾਲੀਰੋਮਾਨੀਆਈਰੂਸੀ" +
+	"ਸਿੰਹਾਲਾਸਲੋਵਾਕਸਲੋਵੇਨੀਅਨਅਲਬਾਨੀਅਨਸਰਬਿਆਈਸਵੀਡਿਸ਼ਸਵਾਹਿਲੀਤਮਿਲਤੇਲਗੂਥਾਈਤੁਰਕਿਸ਼ਯੂਕਰੇਨੀਅਨਉਰ" +
+	"ਦੂਉਜ਼ਬੇਕਵਿਯਤਨਾਮੀਚੀਨੀਜ਼ੁਲੂਫਿਲੀਪਿਨੋਆਧੁਨਿਕ ਸਟੈਂਡਰਡ ਅਰਬੀਬ੍ਰਿਟਿਸ਼ ਅੰਗਰੇਜ਼ੀਅਮਰੀਕੀ ਅੰਗਰ" +
+	"ੇਜ਼ੀਲੈਟਿਨ ਅਮਰੀਕੀ ਸਪੈਨਿਸ਼ਯੂਰੋਪੀਅਨ ਸਪੈਨਿਸ਼ਕਨੈਡੀਅਨ ਫ੍ਰੈਂਚਬ੍ਰਾਜ਼ਿਲੀਅਨ ਪੁਰਤਗਾਲੀਯੂਰੋਪੀ" +
+	"ਅਨ ਪੁਰਤਗਾਲੀਸਰਲ ਕੀਤੀ ਚੀਨੀਰਵਾਇਤੀ ਚੀਨੀ"
+
+var paLangIdx = []uint16{ // 79 entries
+	0x0, 0x15, 0x2a, 0x36, 0x54, 0x6f, 0x81, 0x96, 0x9f, 0xb1, 0xbd, 0xcc,
+	0xe4, 0xf9, 0x111, 0x123, 0x135, 0x147, 0x15c, 0x16b, 0x17a, 0x198, 0x1b0, 0x1c8,
+	0x1e9, 0x204, 0x216, 0x228, 0x23d, 0x24f, 0x25b, 0x267, 0x279, 0x28e, 0x297, 0x2b2,
+	0x2c7, 0x2e2, 0x2f4, 0x2f4, 0x303, 0x30c, 0x318, 0x32a, 0x333, 0x35e, 0x370, 0x382,
+	0x39a, 0x3b2, 0x3be, 0x3d3, 0x3e5, 0x400, 0x418, 0x42a, 0x43f, 0x454, 0x460, 0x46f,
+	0x478, 0x48d, 0x4a8, 0x4b4, 0x4c6, 0x4de, 0x4ea, 0x4f9, 0x511, 0x546, 0x577, 0x5a2,
+	0x5da, 0x608, 0x630, 0x66a, 0x69b, 0x6be, 0x6dd,
+}
+
+const plLangStr = "" +
+	"afrikaansamharskiarabskiazerskibułgarskibengalskikatalońskiczeskiduńskiniemiecki" +
+	"greckiangielskihiszpańskiestońskiperskifińskifrancuskigudźarackihebrajskihindich" +
+	"orwackiwęgierskiormiańskiindonezyjskiislandzkiwłoskijapońskigruzińskikazachskikh" +
+	"merskikannadakoreańskikirgiskilaotańskilitewskiłotewskimacedońskimalajalammongol" +
+	"skimarathimalajskibirmańskinepalskiniderlandzkinorweski (bokmål)pendżabskipolski" +
+	"portugalskirumuńskirosyjskisyngaleskisłowackisłoweńskialbańskiserbskiszwedzkisua" +
+	"hilitamilskitelugutajskitureckiukraińskiurduuzbeckiwietnamskichińskizulufilipino" +
+	"współczesny arabskibrytyjski angielskiamerykański angielskiamerykański hiszpańsk" +
+	"ieuropejski hiszpańskikanadyjski francuskibrazylijski portugalskieuropejski port" +
+	"ugalskichiński (uproszczony)chiński (tradycyjny)"
+
+var plLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x11, 0x18, 0x1f, 0x29, 0x32, 0x3d, 0x43, 0x4a, 0x53, 0x59,
+	0x62, 0x6d, 0x76, 0x7c, 0x83, 0x8c, 0x97, 0xa0, 0xa5, 0xae, 0xb8, 0xc2,
+	0xce, 0xd7, 0xde, 0xe7, 0xf1, 0xfa, 0x102, 0x109, 0x113, 0x11b, 0x125, 0x12d,
+	0x136, 0x141, 0x14a, 0x153, 0x15a, 0x162, 0x16c, 0x174, 0x180, 0x192, 0x19d, 0x1a3,
+	0x1ae, 0x1b7, 0x1bf, 0x1c9, 0x1d2, 0x1dd, 0x1e6, 0x1ed, 0x1f5, 0x1fc, 0x204, 0x20a,
+	0x210, 0x217, 0x221, 0x225, 0x22c, 0x236, 0x23e, 0x242, 0x24a, 0x25f, 0x272, 0x288,
+	0x2a0, 0x2b6, 0x2ca, 0x2e1, 0x2f7, 0x30d, 0x322,
+}
+
+const ptLangStr = "" +
+	"africâneramáricoárabeazerbaijanobúlgarobengalicatalãotchecodinamarquêsalemãogreg" +
+	"oinglêsespanholestonianopersafinlandêsfrancêsguzeratehebraicohíndicroatahúngaroa" +
+	"rmênioindonésioislandêsitalianojaponêsgeorgianocazaquecmercanarêscoreanoquirguiz" +
+	"laosianolituanoletãomacedôniomalaialamongolmaratamalaiobirmanêsnepaliholandêsbok" +
+	"mål norueguêspanjabipolonêsportuguêsromenorussocingalêseslovacoeslovenoalbanêssé" +
+	"rviosuecosuaílitâmiltelugutailandêsturcoucranianourduusbequevietnamitachinêszulu" +
+	"filipinoárabe modernoinglês britânicoinglês americanoespanhol latino-americanoes" +
+	"panhol europeufrancês canadenseportuguês do Brasilportuguês europeuchinês simpli" +
+	"ficadochinês tradicional"
+
+var ptLangIdx = []uint16{ // 79 entries
+	0x0, 0xa, 0x12, 0x18, 0x23, 0x2b, 0x32, 0x3a, 0x40, 0x4c, 0x53, 0x58,
+	0x5f, 0x67, 0x70, 0x75, 0x7f, 0x87, 0x8f, 0x97, 0x9d, 0xa3, 0xab, 0xb3,
+	0xbd, 0xc6, 0xce, 0xd6, 0xdf, 0xe6, 0xea, 0xf2, 0xf9, 0x101, 0x109, 0x110,
+	0x116, 0x120, 0x128, 0x12e, 0x134, 0x13a, 0x143, 0x149, 0x152, 0x164, 0x16b, 0x173,
+	0x17d, 0x183, 0x188, 0x191, 0x199, 0x1a1, 0x1a9, 0x1b0, 0x1b5, 0x1bc, 0x1c2, 0x1c8,
+	0x1d2, 0x1d7, 0x1e0, 0x1e4, 0x1eb, 0x1f5, 0x1fc, 0x200, 0x208, 0x216, 0x228, 0x239,
+	0x252, 0x262, 0x274, 0x288, 0x29a, 0x2ae, 0x2c1,
+}
+
+const ptPTLangStr = "" +
+	"afrikaansamáricoárabeazerbaijanobúlgarobengalicatalãochecodinamarquêsalemãogrego" +
+	"inglêsespanholestóniopersafinlandêsfrancêsguzeratehebraicohindicroatahúngaroarmé" +
+	"nioindonésioislandêsitalianojaponêsgeorgianocazaquecmercanarimcoreanoquirguizlao" +
+	"sianolituanoletãomacedóniomalaialamongolmaratamalaiobirmanêsnepaliholandêsnorueg" +
+	"uês bokmålpanjabipolacoportuguêsromenorussocingalêseslovacoeslovenoalbanêssérvio" +
+	"suecosuailitâmiltelugutailandêsturcoucranianourduusbequevietnamitachinêszulufili" +
+	"pinoinglês britânicoinglês americanoespanhol latino-americanoespanhol europeufra" +
+	"ncês canadianoportuguês do Brasilportuguês europeuchinês simplificadochinês trad" +
+	"icional"
+
+var ptPTLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x11, 0x17, 0x22, 0x2a, 0x31, 0x39, 0x3e, 0x4a, 0x51, 0x56,
+	0x5d, 0x65, 0x6d, 0x72, 0x7c, 0x84, 0x8c, 0x94, 0x99, 0x9f, 0xa7, 0xaf,
+	0xb9, 0xc2, 0xca, 0xd2, 0xdb, 0xe2, 0xe6, 0xed, 0xf4, 0xfc, 0x104, 0x10b,
+	0x111, 0x11b, 0x123, 0x129, 0x12f, 0x135, 0x13e, 0x144, 0x14d, 0x15f, 0x166, 0x16c,
+	0x176, 0x17c, 0x181, 0x18a, 0x192, 0x19a, 0x1a2, 0x1a9, 0x1ae, 0x1b4, 0x1ba, 0x1c0,
+	0x1ca, 0x1cf, 0x1d8, 0x1dc, 0x1e3, 0x1ed, 0x1f4, 0x1f8, 0x200, 0x200, 0x212, 0x223,
+	0x23c, 0x24c, 0x25e, 0x272, 0x284, 0x298, 0x2ab,
+}
+
+const roLangStr = "" +
+	"afrikaansamharicăarabăazerăbulgarăbengalezăcatalanăcehădanezăgermanăgreacăenglez" +
+	"ăspaniolăestonianăpersanăfinlandezăfrancezăgujaratiebraicăhindicroatămaghiarăarm" +
+	"eanăindonezianăislandezăitalianăjaponezăgeorgianăkazahăkhmerăkannadacoreeanăkârg" +
+	"âzălaoțianălituanianăletonămacedoneanămalayalammongolămarathimalaybirmanezănepal" +
+	"ezăolandezănorvegiană bokmålpunjabipolonezăportughezăromânărusăsinghalezăslovacă" +
+	"slovenăalbanezăsârbăsuedezăswahilitamilăteluguthailandezăturcăucraineanăurduuzbe" +
+	"căvietnamezăchinezăzulufilipinezăarabă standard modernăengleză britanicăengleză " +
+	"americanăspaniolă latino-americanăspaniolă europeanăfranceză canadianăportugheză" +
+	" brazilianăportugheză europeanăchineză simplificatăchineză tradițională"
+
+var roLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x12, 0x18, 0x1e, 0x26, 0x30, 0x39, 0x3e, 0x45, 0x4d, 0x54,
+	0x5c, 0x65, 0x6f, 0x77, 0x82, 0x8b, 0x93, 0x9b, 0xa0, 0xa7, 0xb0, 0xb8,
+	0xc4, 0xce, 0xd7, 0xe0, 0xea, 0xf1, 0xf8, 0xff, 0x108, 0x112, 0x11c, 0x127,
+	0x12e, 0x13a, 0x143, 0x14b, 0x152, 0x157, 0x161, 0x16a, 0x173, 0x186, 0x18d, 0x196,
+	0x1a1, 0x1a9, 0x1ae, 0x1b9, 0x1c1, 0x1c9, 0x1d2, 0x1d9, 0x1e1, 0x1e8, 0x1ef, 0x1f5,
+	0x201, 0x207, 0x212, 0x216, 0x21d, 0x228, 0x230, 0x234, 0x23f, 0x257, 0x26a, 0x27d,
+	0x298, 0x2ac, 0x2c0, 0x2d7, 0x2ed, 0x303, 0x31a,
+}
+
+const ruLangStr = "" +
+	"африкаансамхарскийарабскийазербайджанскийболгарскийбенгальскийкаталанскийчешский" +
+	"датскийнемецкийгреческийанглийскийиспанскийэстонскийперсидскийфинскийфранцузский" +
+	"гуджаратиивритхиндихорватскийвенгерскийармянскийиндонезийскийисландскийитальянск" +
+	"ийяпонскийгрузинскийказахскийкхмерскийканнадакорейскийкиргизскийлаосскийлитовски" +
+	"йлатышскиймакедонскиймалаяламмонгольскиймаратхималайскийбирманскийнепальскийголл" +
+	"андскийнорвежский букмолпанджабипольскийпортугальскийрумынскийрусскийсингальский" +
+	"словацкийсловенскийалбанскийсербскийшведскийсуахилитамильскийтелугутайскийтурецк" +
+	"ийукраинскийурдуузбекскийвьетнамскийкитайскийзулуфилиппинскийарабский литературн" +
+	"ыйбританский английскийамериканский английскийлатиноамериканский испанскийевропе" +
+	"йский испанскийканадский французскийбразильский португальскийевропейский португа" +
+	"льскийупрощенный китайскийтрадиционный китайский"
+
+var ruLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x24, 0x34, 0x52, 0x66, 0x7c, 0x92, 0xa0, 0xae, 0xbe, 0xd0,
+	0xe4, 0xf6, 0x108, 0x11c, 0x12a, 0x140, 0x152, 0x15c, 0x166, 0x17a, 0x18e, 0x1a0,
+	0x1ba, 0x1ce, 0x1e4, 0x1f4, 0x208, 0x21a, 0x22c, 0x23a, 0x24c, 0x260, 0x270, 0x282,
+	0x294, 0x2aa, 0x2ba, 0x2d0, 0x2de, 0x2f0, 0x304, 0x318, 0x32e, 0x34f, 0x35f, 0x36f,
+	0x389, 0x39b, 0x3a9, 0x3bf, 0x3d1, 0x3e5, 0x3f7, 0x407, 0x417, 0x425, 0x439, 0x445,
+	0x453, 0x463, 0x477, 0x47f, 0x491, 0x4a7, 0x4b9, 0x4c1, 0x4d9, 0x502, 0x52b, 0x558,
+	0x58f, 0x5b8, 0x5e1, 0x612, 0x643, 0x66a, 0x695,
+}
+
+const siLangStr = "" +
+	"අප්‍රිකානුඇම්හැරික්අරාබිඅසර්බයිජාන්බල්ගේරියානුබෙංගාලිකැටලන්චෙත්ඩැනිශ්ජර්මන්ග්‍රී" +
+	"කඉංග්‍රීසිස්පාඤ්ඤඑස්තෝනියානුපර්සියානුෆින්ලන්තප්‍රංශගුජරාටිහීබෲහින්දික්‍රෝයේශියාන" +
+	"ුහන්ගේරියානුආර්මේනියානුඉන්දුනීසියානුඅයිස්ලන්තඉතාලිජපන්ජෝර්ජියානුකසක්කමර්කණ්ණඩකොර" +
+	"ියානුකිර්ගිස්ලාඕලිතුවේනියානුලැට්වියානුමැසිඩෝනියානුමලයාලම්මරාතිමැලේබුරුමනේපාලලන්ද" +
+	"ේසිනෝවේජියානු බොක්මාල්ජන්ජාබිපෝලන්තපෘතුගීසිරොමේනියානුරුසියානුසිංහලස්ලෝවැක්ස්ලෝවේ" +
+	"නියානුඇල්බේනියානුසර්බියානුස්වීඩන්ස්වාහිලිදෙමළතෙළිඟුතායිතුර්කියුක්රේනියානුඋර්දුඋස" +
+	"්බෙක්වියට්නාම්චීනසුලුපිලිපීනනවීන සම්මත අරාබිබ්‍රිතාන්‍ය ඉංග්‍රීසිඇමෙරිකානු ඉංග්‍" +
+	"රීසිලතින් ඇමරිකානු ස්පාඤ්ඤයුරෝපීය ස්පාඤ්ඤකැනේඩියානු ප්‍රංශබ්‍රසීල පෘතුගීසියුරෝපී" +
+	"ය පෘතුගීසිසුළුකළ චීනසාම්ප්‍රදායික චීන"
+
+var siLangIdx = []uint16{ // 79 entries
+	0x0, 0x1e, 0x39, 0x48, 0x69, 0x8a, 0x9f, 0xb1, 0xbd, 0xcf, 0xe1, 0xf3,
+	0x10e, 0x123, 0x144, 0x15f, 0x177, 0x189, 0x19e, 0x1aa, 0x1bc, 0x1e3, 0x204, 0x225,
+	0x24c, 0x267, 0x276, 0x282, 0x2a0, 0x2ac, 0x2b8, 0x2c7, 0x2df, 0x2f7, 0x300, 0x324,
+	0x342, 0x366, 0x37b, 0x37b, 0x38a, 0x396, 0x3a5, 0x3b4, 0x3c9, 0x400, 0x415, 0x427,
+	0x43f, 0x45d, 0x475, 0x484, 0x49c, 0x4c0, 0x4e1, 0x4fc, 0x511, 0x529, 0x535, 0x547,
+	0x553, 0x565, 0x589, 0x598, 0x5ad, 0x5c8, 0x5d1, 0x5dd, 0x5f2, 0x61e, 0x65b, 0x692,
+	0x6d0, 0x6fb, 0x72c, 0x75a, 0x788, 0x7a4, 0x7d5,
+}
+
+const skLangStr = "" +
+	"afrikánčinaamharčinaarabčinaazerbajdžančinabulharčinabengálčinakatalánčinačeštin" +
+	"adánčinanemčinagréčtinaangličtinašpanielčinaestónčinaperzštinafínčinafrancúzštin" +
+	"agudžarátčinahebrejčinahindčinachorvátčinamaďarčinaarménčinaindonézštinaislandči" +
+	"nataliančinajapončinagruzínčinakazaštinakambodžská khmérčinakannadčinakórejčinak" +
+	"irgizštinalaoštinalitovčinalotyštinamacedónčinamalajálamčinamongolčinamaráthčina" +
+	"malajčinabarmčinanepálčinaholandčinanórsky bokmålpandžábčinapoľštinaportugalčina" +
+	"rumunčinaruštinasinhalčinaslovenčinaslovinčinaalbánčinasrbčinašvédčinaswahilčina" +
+	"tamilčinatelugčinathajčinaturečtinaukrajinčinaurdčinauzbečtinavietnamčinačínštin" +
+	"azuluštinafilipínčinamoderná štandardná arabčinabritská angličtinaamerická angli" +
+	"čtinalatinskoamerická španielčinaiberská španielčinakanadská francúzštinabrazíls" +
+	"ka portugalčinaiberská portugalčinazjednodušená čínštinatradičná čínština"
+
+var skLangIdx = []uint16{ // 79 entries
+	0x0, 0xd, 0x17, 0x20, 0x31, 0x3c, 0x48, 0x55, 0x5e, 0x67, 0x6f, 0x79,
+	0x84, 0x91, 0x9c, 0xa6, 0xaf, 0xbd, 0xcc, 0xd7, 0xe0, 0xed, 0xf8, 0x103,
+	0x111, 0x11c, 0x127, 0x131, 0x13d, 0x147, 0x15f, 0x16a, 0x175, 0x181, 0x18a, 0x194,
+	0x19e, 0x1ab, 0x1ba, 0x1c5, 0x1d1, 0x1db, 0x1e4, 0x1ef, 0x1fa, 0x209, 0x217, 0x221,
+	0x22e, 0x238, 0x240, 0x24b, 0x256, 0x261, 0x26c, 0x274, 0x27f, 0x28a, 0x294, 0x29e,
+	0x2a7, 0x2b1, 0x2bd, 0x2c5, 0x2cf, 0x2db, 0x2e6, 0x2f0, 0x2fd, 0x31c, 0x330, 0x345,
+	0x364, 0x37a, 0x392, 0x3aa, 0x3c0, 0x3da, 0x3f0,
+}
+
+const slLangStr = "" +
+	"afrikanščinaamharščinaarabščinaazerbajdžanščinabolgarščinabengalščinakatalonščin" +
+	"ačeščinadanščinanemščinagrščinaangleščinašpanščinaestonščinaperzijščinafinščinaf" +
+	"rancoščinagudžaratščinahebrejščinahindujščinahrvaščinamadžarščinaarmenščinaindon" +
+	"ezijščinaislandščinaitalijanščinajaponščinagruzinščinakazaščinakmerščinakanadako" +
+	"rejščinakirgiščinalaoščinalitovščinalatvijščinamakedonščinamalajalamščinamongolš" +
+	"činamaratščinamalajščinaburmanščinanepalščinanizozemščinaknjižna norveščinapandž" +
+	"abščinapoljščinaportugalščinaromunščinaruščinasingalščinaslovaščinaslovenščinaal" +
+	"banščinasrbščinašvedščinasvahilitamilščinatelugijščinatajščinaturščinaukrajinšči" +
+	"naurdujščinauzbeščinavietnamščinakitajščinazulujščinafilipinščinasodobna standar" +
+	"dna arabščinaangleščina (VB)angleščina (ZDA)latinskoameriška španščinaiberska šp" +
+	"anščinakanadska francoščinabrazilska portugalščinaiberska portugalščinapoenostav" +
+	"ljena kitajščinatradicionalna kitajščina"
+
+var slLangIdx = []uint16{ // 79 entries
+	0x0, 0xe, 0x1a, 0x25, 0x38, 0x45, 0x52, 0x60, 0x6a, 0x74, 0x7e, 0x87,
+	0x93, 0x9f, 0xab, 0xb8, 0xc2, 0xcf, 0xdf, 0xec, 0xf9, 0x104, 0x112, 0x11e,
+	0x12e, 0x13b, 0x14a, 0x156, 0x163, 0x16e, 0x179, 0x17f, 0x18b, 0x197, 0x1a1, 0x1ad,
+	0x1ba, 0x1c8, 0x1d8, 0x1e5, 0x1f1, 0x1fd, 0x20a, 0x216, 0x224, 0x239, 0x248, 0x253,
+	0x262, 0x26e, 0x277, 0x284, 0x290, 0x29d, 0x2a9, 0x2b3, 0x2bf, 0x2c6, 0x2d2, 0x2e0,
+	0x2ea, 0x2f4, 0x302, 0x30e, 0x319, 0x327, 0x333, 0x33f, 0x34d, 0x36b, 0x37c, 0x38e,
+	0x3ac, 0x3c0, 0x3d6, 0x3ef, 0x406, 0x421, 0x43b,
+}
+
+const sqLangStr = "" +
+	"AfrikaneAmarikeArabishtAzereBullgarishtBengalishtKatalonishtÇekishtDanishtGjerma" +
+	"nishtGreqishtAnglishtSpanjishtEstonishtPersishtFinlandishtFrëngjishtGuxharatisht" +
+	"HebraishtIndishtKroatishtHungarishtArmenishtIndonezishtIslandishtItalishtJaponis" +
+	"htGjeorgjishtKazakeKmere (Kamboxhiane)KanadaKoreanishtKirgizeLaosishtLituanishtL" +
+	"etonishtMaqedonishtMalajalameMaratishtMalajishtBirmanishtNepalishtHolandishtBokm" +
+	"alisht (Norvegji)PanxhabePolonishtPortugalishtRumanishtRusishtSinhaleSllovakisht" +
+	"SllovenishtShqipSerbishtSuedishtSuahiliTamileTelugeTajlandishtTurqishtUkrainisht" +
+	"UrduUzbekeVietnamishtKinezishtZuluFilipinaseArabishte Standarde ModerneAnglisht " +
+	"(Britani)Spanjisht (Europë)Portugalisht (Europë)Kinezçe e ThjeshtuarKinezçe Trad" +
+	"icionale"
+
+var sqLangIdx = []uint16{ // 79 entries
+	0x0, 0x8, 0xf, 0x17, 0x1c, 0x27, 0x31, 0x3c, 0x44, 0x4b, 0x56, 0x5e,
+	0x66, 0x6f, 0x78, 0x80, 0x8b, 0x96, 0xa2, 0xab, 0xb2, 0xbb, 0xc5, 0xce,
+	0xd9, 0xe3, 0xeb, 0xf4, 0xff, 0x105, 0x118, 0x11e, 0x128, 0x12f, 0x137, 0x141,
+	0x14a, 0x155, 0x15f, 0x15f, 0x168, 0x171, 0x17b, 0x184, 0x18e, 0x1a3, 0x1ab, 0x1b4,
+	0x1c0, 0x1c9, 0x1d0, 0x1d7, 0x1e2, 0x1ed, 0x1f2, 0x1fa, 0x202, 0x209, 0x20f, 0x215,
+	0x220, 0x228, 0x232, 0x236, 0x23c, 0x247, 0x250, 0x254, 0x25e, 0x279, 0x28b, 0x28b,
+	0x28b, 0x29e, 0x29e, 0x29e, 0x2b4, 0x2c9, 0x2de,
+}
+
+const srLangStr = "" +
+	"АфриканерскиАмхарскиАрапскиАзербејџанскиБугарскиБенгласкиКаталонскиЧешкиДанскиНе" +
+	"мачкиГрчкиЕнглескиШпанскиЕстонскиПерсијскиФинскиФранцускиГуџаратиХебрејскиХиндиХ" +
+	"рватскиМађарскиЈерменскиИндонежанскиИсландскиИталијанскиЈапанскиГрузијскиКозачки" +
+	"КмерскиКанадаКорејскиКиргискиЛаоскиЛитванскиЛетонскиМакедонскиМалајаламМонголски" +
+	"МаратиМалајскиБурманскиНепалскиХоландскиНорвешки бокмалПанџабскиПољскиПортугалск" +
+	"иРумунскиРускиСингалескиСловачкиСловеначкиАлбанскиСрпскиШведскиСвахилиТамилскиТе" +
+	"лугуТајландскиТурскиУкрајинскиУрдуУзбечкиВијетнамскиКинескиЗулуФилипинскимодеран" +
+	" стандардни арапскиБритански енглескиСАД енглескиЛатино-амерички шпанскиИберијск" +
+	"и шпанскиКанадски францускиБразилски португалскиИберијски португалскиКинески (по" +
+	"једностављен)Кинески (традиционални)"
+
+var srLangIdx = []uint16{ // 79 entries
+	0x0, 0x18, 0x28, 0x36, 0x50, 0x60, 0x72, 0x86, 0x90, 0x9c, 0xaa, 0xb4,
+	0xc4, 0xd2, 0xe2, 0xf4, 0x100, 0x112, 0x122, 0x134, 0x13e, 0x14e, 0x15e, 0x170,
+	0x188, 0x19a, 0x1b0, 0x1c0, 0x1d2, 0x1e0, 0x1ee, 0x1fa, 0x20a, 0x21a, 0x226, 0x238,
+	0x248, 0x25c, 0x26e, 0x280, 0x28c, 0x29c, 0x2ae, 0x2be, 0x2d0, 0x2ed, 0x2ff, 0x30b,
+	0x321, 0x331, 0x33b, 0x34f, 0x35f, 0x373, 0x383, 0x38f, 0x39d, 0x3ab, 0x3bb, 0x3c7,
+	0x3db, 0x3e7, 0x3fb, 0x403, 0x411, 0x427, 0x435, 0x43d, 0x451, 0x483, 0x4a6, 0x4bd,
+	0x4e9, 0x50a, 0x52d, 0x556, 0x57f, 0x5ac, 0x5d7,
+}
+
+const svLangStr = "" +
+	"afrikaansamhariskaarabiskaazerbajdzjanskabulgariskabengalikatalanskatjeckiskadan" +
+	"skatyskagrekiskaengelskaspanskaestniskapersiskafinskafranskagujaratihebreiskahin" +
+	"dikroatiskaungerskaarmeniskaindonesiskaisländskaitalienskajapanskageorgiskakazak" +
+	"iskakambodjanskakannadakoreanskakirgisiskalaotiskalitauiskalettiskamakedonskamal" +
+	"ayalammongoliskamarathimalajiskaburmesiskanepalesiskanederländskanorskt bokmålpu" +
+	"njabipolskaportugisiskarumänskaryskasingalesiskaslovakiskaslovenskaalbanskaserbi" +
+	"skasvenskaswahilitamiltelugiskathailändskaturkiskaukrainskaurduuzbekiskavietname" +
+	"siskakinesiskazulufilippinskamodern standardarabiskabrittisk engelskaamerikansk " +
+	"engelskalatinamerikansk spanskaeuropeisk spanskakanadensisk franskabrasiliansk p" +
+	"ortugisiskaeuropeisk portugisiskaförenklad kinesiskatraditionell kinesiska"
+
+var svLangIdx = []uint16{ // 79 entries
+	0x0, 0x9, 0x12, 0x1a, 0x29, 0x33, 0x3a, 0x44, 0x4d, 0x53, 0x58, 0x60,
+	0x68, 0x6f, 0x77, 0x7f, 0x85, 0x8c, 0x94, 0x9d, 0xa2, 0xab, 0xb3, 0xbc,
+	0xc7, 0xd1, 0xdb, 0xe3, 0xec, 0xf5, 0x101, 0x108, 0x111, 0x11b, 0x123, 0x12c,
+	0x134, 0x13e, 0x147, 0x151, 0x158, 0x161, 0x16b, 0x176, 0x183, 0x191, 0x198, 0x19e,
+	0x1aa, 0x1b3, 0x1b8, 0x1c4, 0x1ce, 0x1d7, 0x1df, 0x1e7, 0x1ee, 0x1f5, 0x1fa, 0x203,
+	0x20f, 0x217, 0x220, 0x224, 0x22d, 0x23a, 0x243, 0x247, 0x252, 0x269, 0x27a, 0x28d,
+	0x2a4, 0x2b5, 0x2c8, 0x2e0, 0x2f6, 0x30a, 0x320,
+}
+
+const swLangStr = "" +
+	"KiafrikanaKiamhariKiarabuKiazabajaniKibulgariaKibanglaKikatalaniKichekiKidenmaki" +
+	"KijerumaniKigirikiKiingerezaKihispaniaKiestoniaKiajemiKifinlandiKifaransaKigujar" +
+	"atiKiebraniaKihindiKroeshiaKihungariKiarmeniaKiindonesiaKiaisilandiKiitalianoKij" +
+	"apaniKijojiaKikazakiKikambodiaKikannadaKikoreaKirigiziKilaosiKilithuaniaKilatvia" +
+	"KimasedoniaKimalayalamMongoliaKimarathiKimalesiaKiburmaKinepaliKiholanziKibokmal" +
+	" cha NorweKipunjabiKipolandiKirenoKiromaniaKirusiKisinhalaKislovakiaKisloveniaKi" +
+	"albaniaKiserbiaKiswidiKiswahiliKitamilKiteluguKitailandiKiturukiKiukraniaKiurduK" +
+	"iuzbekiKivietinamuKichinaKizuluKifilipinoKiingereza cha UingerezaKiingereza cha " +
+	"MarekaniKihispania cha Amerika ya KilatininiKihispania cha UlayaKifaransa cha Ka" +
+	"nadaKireno cha BrazilKireno cha UlayaKichina RahisiKichina cha Jadi"
+
+var swLangIdx = []uint16{ // 79 entries
+	0x0, 0xa, 0x12, 0x19, 0x24, 0x2e, 0x36, 0x40, 0x47, 0x50, 0x5a, 0x62,
+	0x6c, 0x76, 0x7f, 0x86, 0x90, 0x99, 0xa3, 0xac, 0xb3, 0xbb, 0xc4, 0xcd,
+	0xd8, 0xe3, 0xed, 0xf5, 0xfc, 0x104, 0x10e, 0x117, 0x11e, 0x126, 0x12d, 0x138,
+	0x140, 0x14b, 0x156, 0x15e, 0x167, 0x170, 0x177, 0x17f, 0x188, 0x19a, 0x1a3, 0x1ac,
+	0x1b2, 0x1bb, 0x1c1, 0x1ca, 0x1d4, 0x1de, 0x1e7, 0x1ef, 0x1f6, 0x1ff, 0x206, 0x20e,
+	0x218, 0x220, 0x229, 0x22f, 0x237, 0x242, 0x249, 0x24f, 0x259, 0x259, 0x271, 0x288,
+	0x2ac, 0x2c0, 0x2d4, 0x2e5, 0x2f5, 0x303, 0x313,
+}
+
+const taLangStr = "" +
+	"ஆஃப்ரிகான்ஸ்அம்ஹாரிக்அரபுஅசர்பாய்ஜானிபல்கேரியன்வங்காளம்காடலான்செக்டேனிஷ்ஜெர்மன்க" +
+	"ிரேக்கம்ஆங்கிலம்ஸ்பானிஷ்எஸ்டோனியன்பர்ஸியன்பின்னிஷ்பிரெஞ்சுகுஜராத்திஹுப்ருஇந்திகு" +
+	"ரோஷியன்ஹங்கேரியன்ஆர்மேனியன்இந்தோனேஷியன்ஐஸ்லென்டிக்இத்தாலியன்ஜப்பானீஸ்ஜியோர்ஜியன்" +
+	"கசாக்கெமெர்கன்னடம்கொரியன்கிர்கிஸ்லாவோலிதுவேனியன்லேட்வியன்மாஸிடோனியன்மலையாளம்மங்க" +
+	"ோலியன்மராத்திமலாய்பர்மிஸ்நேபாளிடச்சுநார்வே பொக்மால்பஞ்சாபிபோலிஷ்போர்ச்சுக்கீஸ்ரோ" +
+	"மேனியன்ரஷியன்சிங்களம்ஸ்லோவாக்ஸ்லோவேனியன்அல்பெனியன்செர்பியன்ஸ்வீடிஷ்சுவாஹிலிதமிழ்" +
+	"தெலுங்குதாய்டர்கிஷ்உக்ரைனியன்உருதுஉஸ்பெக்வியட்நாமிஸ்சீனம்ஜூலூஃபிலிபினோமாடர்ன் ஸ்" +
+	"டாண்டர்ட் அரபிக்ஆங்கிலம் (யூகே)அமெரிக்க ஆங்கிலம்லத்தின் அமெரிக்கன் ஸ்பானிஷ்ஐரோப்" +
+	"பிய ஸ்பானிஷ்கெனடியன் பிரன்சுபோர்ச்சுகீஸ் (பிரேசில்)ஐரோப்பிய போர்த்துகீசியம்எளிதா" +
+	"க்கப்பட்ட சீனம்பாரம்பரிய சீனம்"
+
+var taLangIdx = []uint16{ // 79 entries
+	0x0, 0x24, 0x3f, 0x4b, 0x6f, 0x8d, 0xa5, 0xba, 0xc6, 0xd8, 0xed, 0x108,
+	0x120, 0x138, 0x156, 0x16e, 0x186, 0x19e, 0x1b9, 0x1cb, 0x1da, 0x1f5, 0x213, 0x231,
+	0x255, 0x276, 0x294, 0x2af, 0x2d0, 0x2df, 0x2f1, 0x306, 0x31b, 0x333, 0x33f, 0x360,
+	0x37b, 0x39c, 0x3b4, 0x3d2, 0x3e7, 0x3f6, 0x40b, 0x41d, 0x42c, 0x457, 0x46c, 0x47e,
+	0x4a8, 0x4c3, 0x4d5, 0x4ed, 0x505, 0x526, 0x544, 0x55f, 0x577, 0x58f, 0x59e, 0x5b6,
+	0x5c2, 0x5d7, 0x5f5, 0x604, 0x619, 0x63a, 0x649, 0x655, 0x670, 0x6ba, 0x6e1, 0x712,
+	0x75f, 0x790, 0x7be, 0x7fd, 0x843, 0x87d, 0x8a8,
+}
+
+const teLangStr = "" +
+	"ఆఫ్రికాన్స్అమ్హారిక్అరబిక్అజర్బైజానిబల్గేరియన్బెంగాలీకెటలాన్చెక్డేనిష్జర్మన్గ్రీ" +
+	"క్ఆంగ్లంస్పానిష్ఈస్టొనియన్పర్షియన్ఫిన్నిష్ఫ్రెంచ్గుజరాతిహీబ్రుహిందీక్రొయెషియన్హన" +
+	"్గేరియన్ఆర్మేనియన్ఇండోనేషియన్ఐస్లాండిక్ఇటాలియన్జాపనీస్జార్జియన్కజఖ్ఖ్మేర్కన్నడకొ" +
+	"రియన్కిర్గిజ్లావోలిథుయేనియన్లాట్వియన్మసడోనియన్మలయాళంమంగోలియన్మరాఠీమలేయ్బర్మీస్నే" +
+	"పాలిడచ్నార్వీజియన్ బొక్మాల్పంజాబీపోలిష్పోర్చుగీస్రోమానియన్రష్యన్సింహళంస్లోవాక్స్" +
+	"లోవేనియాన్అల్బేనియన్సెర్బియన్స్వీడిష్స్వాహిలితమిళముతెలుగుథాయ్టర్కిష్ఉక్రేనియన్ఉర" +
+	"్దూఉజ్బెక్వియత్నామీస్చైనీస్జూలూఫిలిపినోఆధునిక ప్రామాణిక అరబిక్బ్రిటిష్ ఇంగ్లీష్అ" +
+	"మెరికన్ ఇంగ్లీష్లాటిన్ అమెరికెన్ స్పానిష్యూరోపియన్ స్పానిష్కెనడియెన్ ఫ్రెంచ్బ్రె" +
+	"జీలియన్ పోర్చుగీస్యూరోపియన్ పోర్చుగీస్సరళీకృత చైనీస్సాంప్రదాయ చైనీస్"
+
+var teLangIdx = []uint16{ // 79 entries
+	0x0, 0x21, 0x3c, 0x4e, 0x6c, 0x8a, 0x9f, 0xb4, 0xc0, 0xd2, 0xe4, 0xf6,
+	0x108, 0x120, 0x13e, 0x156, 0x16e, 0x183, 0x198, 0x1aa, 0x1b9, 0x1da, 0x1f8, 0x216,
+	0x237, 0x255, 0x26d, 0x282, 0x29d, 0x2a9, 0x2bb, 0x2ca, 0x2df, 0x2f7, 0x303, 0x324,
+	0x33f, 0x35a, 0x36c, 0x387, 0x396, 0x3a5, 0x3ba, 0x3cc, 0x3d5, 0x40f, 0x421, 0x433,
+	0x451, 0x46c, 0x47e, 0x490, 0x4a8, 0x4cc, 0x4ea, 0x505, 0x51d, 0x535, 0x547, 0x559,
+	0x565, 0x57a, 0x598, 0x5a7, 0x5bc, 0x5dd, 0x5ef, 0x5fb, 0x613, 0x654, 0x685, 0x6b6,
+	0x6fd, 0x731, 0x762, 0x7a2, 0x7dc, 0x804, 0x832,
+}
+
+const thLangStr = "" +
+	"แอฟริกานส์อัมฮาราอาหรับอาเซอร์ไบจานบัลแกเรียเบงกาลีกาตาลังเช็กเดนมาร์กเยอรมันกรี" +
+	"กอังกฤษสเปนเอสโตเนียเปอร์เซียฟินแลนด์ฝรั่งเศสคุชราตฮิบรูฮินดีโครเอเชียฮังการีอาร" +
+	"์เมเนียอินโดนีเชียไอซ์แลนด์อิตาลีญี่ปุ่นจอร์เจียคาซัคเขมรกันนาดาเกาหลีคีร์กีซลาว" +
+	"ลิทัวเนียลัตเวียมาซิโดเนียมาลายาลัมมองโกเลียมราฐีมาเลย์พม่าเนปาลดัตช์นอร์เวย์บุค" +
+	"มอลปัญจาบโปแลนด์โปรตุเกสโรมาเนียรัสเซียสิงหลสโลวักสโลวีเนียแอลเบเนียเซอร์เบียสวี" +
+	"เดนสวาฮีลีทมิฬเตลูกูไทยตุรกียูเครนอูรดูอุซเบกเวียดนามจีนซูลูฟิลิปปินส์อาหรับมาตร" +
+	"ฐานสมัยใหม่อังกฤษ - สหราชอาณาจักรอังกฤษ - อเมริกันสเปน (ยุโรป)โปรตุเกส - บราซิลโ" +
+	"ปรตุเกส​ (ยุโรป)จีนตัวย่อจีนตัวเต็ม"
+
+var thLangIdx = []uint16{ // 79 entries
+	0x0, 0x1e, 0x33, 0x45, 0x69, 0x84, 0x99, 0xae, 0xba, 0xd2, 0xe7, 0xf3,
+	0x105, 0x111, 0x12c, 0x147, 0x15f, 0x177, 0x189, 0x198, 0x1a7, 0x1c2, 0x1d7, 0x1f5,
+	0x216, 0x231, 0x243, 0x258, 0x270, 0x27f, 0x28b, 0x2a0, 0x2b2, 0x2c7, 0x2d0, 0x2eb,
+	0x300, 0x31e, 0x339, 0x354, 0x363, 0x375, 0x381, 0x390, 0x39f, 0x3c9, 0x3db, 0x3f0,
+	0x408, 0x420, 0x435, 0x444, 0x456, 0x471, 0x48c, 0x4a7, 0x4b9, 0x4ce, 0x4da, 0x4ec,
+	0x4f5, 0x504, 0x516, 0x525, 0x537, 0x54f, 0x558, 0x564, 0x582, 0x5c1, 0x5fd, 0x62a,
+	0x62a, 0x648, 0x648, 0x675, 0x6a2, 0x6bd, 0x6db,
+}
+
+const trLangStr = "" +
+	"AfrikaancaAmharcaArapçaAzericeBulgarcaBengalceKatalancaÇekçeDancaAlmancaYunancaİ" +
+	"ngilizceİspanyolcaEstoncaFarsçaFinceFransızcaGüceratçaİbraniceHintçeHırvatçaMaca" +
+	"rcaErmeniceEndonezceİzlandacaİtalyancaJaponcaGürcüceKazakçaKmerceKannadaKoreceKı" +
+	"rgızcaLaocaLitvanyacaLetoncaMakedoncaMalayalamMoğolcaMarathiMalaycaBurmacaNepalc" +
+	"eHollandacaNorveççe BokmålPencapçaLehçePortekizceRomenceRusçaSeylancaSlovakçaSlo" +
+	"venceArnavutçaSırpçaİsveççeSvahiliTamilceTelugu DiliTaycaTürkçeUkraynacaUrducaÖz" +
+	"bekçeVietnamcaÇinceZulucaFilipinceModern Standart Arapçaİngiliz İngilizcesiAmeri" +
+	"kan İngilizcesiLatin Amerika İspanyolcasıAvrupa İspanyolcasıKanada FransızcasıBr" +
+	"ezilya PortekizcesiAvrupa PortekizcesiBasitleştirilmiş ÇinceGeleneksel Çince"
+
+var trLangIdx = []uint16{ // 79 entries
+	0x0, 0xa, 0x11, 0x18, 0x1f, 0x27, 0x2f, 0x38, 0x3f, 0x44, 0x4b, 0x52,
+	0x5c, 0x67, 0x6e, 0x75, 0x7a, 0x84, 0x8f, 0x98, 0x9f, 0xa9, 0xb0, 0xb8,
+	0xc1, 0xcb, 0xd5, 0xdc, 0xe5, 0xed, 0xf3, 0xfa, 0x100, 0x10a, 0x10f, 0x119,
+	0x120, 0x129, 0x132, 0x13a, 0x141, 0x148, 0x14f, 0x156, 0x160, 0x172, 0x17b, 0x181,
+	0x18b, 0x192, 0x198, 0x1a0, 0x1a9, 0x1b1, 0x1bb, 0x1c3, 0x1cd, 0x1d4, 0x1db, 0x1e6,
+	0x1eb, 0x1f3, 0x1fc, 0x202, 0x20b, 0x214, 0x21a, 0x220, 0x229, 0x240, 0x255, 0x26a,
+	0x286, 0x29b, 0x2af, 0x2c4, 0x2d7, 0x2f0, 0x301,
+}
+
+const ukLangStr = "" +
+	"африкаансамхарськаарабськаазербайджанськаболгарськабенгальськакаталонськачеськад" +
+	"анськанімецькагрецькаанглійськаіспанськаестонськаперськафінськафранцузькагуджара" +
+	"тіівритгіндіхорватськаугорськавірменськаіндонезійськаісландськаіталійськаяпонськ" +
+	"агрузинськаказахськакхмерськаканнадакорейськакиргизькалаоськалитовськалатвійська" +
+	"македонськамалаяламмонгольськамаратхімалайськабірманськанепальськаголландськанор" +
+	"везька букмолпанджабіпольськапортугальськарумунськаросійськасингальськасловацька" +
+	"словенськаалбанськасербськашведськасуахілітамільськателугутайськатурецькаукраїнс" +
+	"ькаурдуузбецькавʼєтнамськакитайськазулуськафіліппінськасучасна стандартна арабсь" +
+	"каанглійська британськаіспанська (Європа)португальська (Європа)китайська спрощен" +
+	"акитайська традиційна"
+
+var ukLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x24, 0x34, 0x52, 0x66, 0x7c, 0x92, 0x9e, 0xac, 0xbc, 0xca,
+	0xde, 0xf0, 0x102, 0x110, 0x11e, 0x132, 0x144, 0x14e, 0x158, 0x16c, 0x17c, 0x190,
+	0x1aa, 0x1be, 0x1d2, 0x1e2, 0x1f6, 0x208, 0x21a, 0x228, 0x23a, 0x24c, 0x25a, 0x26c,
+	0x280, 0x296, 0x2a6, 0x2bc, 0x2ca, 0x2dc, 0x2f0, 0x304, 0x31a, 0x339, 0x349, 0x359,
+	0x373, 0x385, 0x397, 0x3ad, 0x3bf, 0x3d3, 0x3e5, 0x3f5, 0x405, 0x413, 0x427, 0x433,
+	0x441, 0x451, 0x465, 0x46d, 0x47d, 0x493, 0x4a5, 0x4b5, 0x4cd, 0x501, 0x52a, 0x52a,
+	0x52a, 0x54b, 0x54b, 0x54b, 0x574, 0x597, 0x5be,
+}
+
+const urLangStr = "" +
+	"ایفریکانزامہاریعربیآزربائیجانیبلغاریبنگالیکاٹالانینچیکڈینشجرمنیونانیانگریزیہسپان" +
+	"ویاسٹونینفارسیفینیشفرانسیسیگجراتیعبرانیہندیکراتیہنگیرینارمینیانڈونیثیائیآئس لینڈ" +
+	" کا باشندہاطالویجاپانیجارجیقزاخخمیرکنّاڈاکورینکرغیزیلاؤلتھُواینینلیٹوینمقدونیائی" +
+	"مالایالممنگؤلیمراٹهیمالائیبرمینیپالیڈچنارویجین بوکملپنجابیپولستانیپُرتگالیرومنیر" +
+	"وسیسنہالاسلوواکسلووینیائیالبانیصربیسویڈشسواحلیتملتیلگوتھائیترکییوکرینیائیاردوازب" +
+	"یکویتنامیچینیزولوفلیپینوماڈرن اسٹینڈرڈ عربیبرطانوی انگریزیامریکی انگریزیلاطینی ا" +
+	"مریکی ہسپانوییورپی ہسپانویکینیڈین فرانسیسیبرازیلی پرتگالییورپی پرتگالیچینی (آسان" +
+	" کردہ)روایتی چینی"
+
+var urLangIdx = []uint16{ // 79 entries
+	0x0, 0x12, 0x1e, 0x26, 0x3c, 0x48, 0x54, 0x66, 0x6c, 0x74, 0x7c, 0x88,
+	0x96, 0xa4, 0xb2, 0xbc, 0xc6, 0xd6, 0xe2, 0xee, 0xf6, 0x100, 0x10e, 0x11a,
+	0x130, 0x151, 0x15d, 0x169, 0x173, 0x17b, 0x183, 0x18f, 0x199, 0x1a5, 0x1ab, 0x1bf,
+	0x1cb, 0x1dd, 0x1ed, 0x1f9, 0x205, 0x211, 0x219, 0x225, 0x229, 0x244, 0x250, 0x260,
+	0x270, 0x27a, 0x282, 0x28e, 0x29a, 0x2ae, 0x2ba, 0x2c2, 0x2cc, 0x2d8, 0x2de, 0x2e8,
+	0x2f2, 0x2fa, 0x30e, 0x316, 0x320, 0x32e, 0x336, 0x33e, 0x34c, 0x370, 0x38d, 0x3a8,
+	0x3d0, 0x3e9, 0x408, 0x425, 0x43e, 0x45a, 0x46f,
+}
+
+const uzLangStr = "" +
+	"afrikanchaamxarchaarabchaozarbayjonchabolgarchabengalchakatalanchachexchadaniyac" +
+	"haolmonchagrekchainglizchaispanchaestonchaforschafinchafransuzchagujaratiibroniy" +
+	"hindchaxorvatchavengrchaarmanchaindoneziyachaislandchaitalyanchayaponchagruzinch" +
+	"aqozoqchaxmerchakannadakoreyschaqirgʻizchalaolitvachalatishchamakedonchamalayala" +
+	"mmaratimalaychabirmanchanepalchagollandchanorvegcha bokmalpanjobchapolyakchaport" +
+	"ugalcharumincharuschasinxalaslovakchaslovenchaalbanchaserbchashvedchasuaxilitami" +
+	"lchatelugutaychaturkchaukrainchaurduoʻzbekchavyetnamchaxitoychazulufilipinozamon" +
+	"avij standart arabchaBritaniya inglizchasiAQSh inglizchasiLotin Amerika ispancha" +
+	"siYevropa ispanchasiKanada fransuzchasiBraziliya portugalchasiYevropa portugalch" +
+	"asisoddalashtirilgan xitoychaanʼanaviy xitoycha"
+
+var uzLangIdx = []uint16{ // 79 entries
+	0x0, 0xa, 0x12, 0x19, 0x26, 0x2f, 0x38, 0x42, 0x49, 0x52, 0x5a, 0x61,
+	0x6a, 0x72, 0x7a, 0x81, 0x87, 0x91, 0x99, 0xa0, 0xa7, 0xb0, 0xb8, 0xc0,
+	0xcd, 0xd6, 0xe0, 0xe8, 0xf1, 0xf9, 0x100, 0x107, 0x110, 0x11b, 0x11e, 0x126,
+	0x12f, 0x139, 0x142, 0x142, 0x148, 0x150, 0x159, 0x161, 0x16b, 0x17b, 0x184, 0x18d,
+	0x198, 0x1a0, 0x1a6, 0x1ad, 0x1b6, 0x1bf, 0x1c7, 0x1ce, 0x1d6, 0x1dd, 0x1e5, 0x1eb,
+	0x1f1, 0x1f8, 0x201, 0x205, 0x20f, 0x219, 0x221, 0x225, 0x22d, 0x247, 0x25c, 0x26c,
+	0x284, 0x296, 0x2a9, 0x2c0, 0x2d5, 0x2ef, 0x302,
+}
+
+const viLangStr = "" +
+	"Tiếng Nam PhiTiếng AmharicTiếng Ả RậpTiếng AzerbaijanTiếng BulgariaTiếng Bengali" +
+	"Tiếng CatalanTiếng SécTiếng Đan MạchTiếng ĐứcTiếng Hy LạpTiếng AnhTiếng Tây Ban " +
+	"NhaTiếng EstoniaTiếng Ba TưTiếng Phần LanTiếng PhápTiếng GujaratiTiếng Do TháiTi" +
+	"ếng HindiTiếng CroatiaTiếng HungaryTiếng ArmeniaTiếng IndonesiaTiếng IcelandTiến" +
+	"g ÝTiếng NhậtTiếng GruziaTiếng KazakhTiếng CampuchiaTiếng KannadaTiếng Hàn QuốcT" +
+	"iếng KyrgyzTiếng LàoTiếng Lít-vaTiếng LatviaTiếng MacedoniaTiếng MalayalamTiếng " +
+	"Mông CổTiếng MarathiTiếng MalaysiaTiếng Miến ĐiệnTiếng NepalTiếng Hà LanTiếng Na" +
+	" Uy (Bokmål)Tiếng PunjabTiếng Ba LanTiếng Bồ Đào NhaTiếng RomaniaTiếng NgaTiếng " +
+	"SinhalaTiếng SlovakTiếng SloveniaTiếng An-ba-niTiếng SerbiaTiếng Thụy ĐiểnTiếng " +
+	"SwahiliTiếng TamilTiếng TeluguTiếng TháiTiếng Thổ Nhĩ KỳTiếng UcrainaTiếng UđuTi" +
+	"ếng UzbekTiếng ViệtTiếng TrungTiếng ZuluTiếng PhilipinTiếng Ả Rập Hiện đạiTiếng " +
+	"Anh (Anh)Tiếng Anh (Mỹ)Tiếng Tây Ban Nha (Mỹ La tinh)Tiếng Tây Ban Nha (I-bê-ri)" +
+	"Tiếng Bồ Đào Nha (Braxin)Tiếng Bồ Đào Nha (I-bê-ri)Tiếng Trung (Giản thể)Tiếng T" +
+	"rung (Phồn thể)"
+
+var viLangIdx = []uint16{ // 79 entries
+	0x0, 0xf, 0x1e, 0x2f, 0x41, 0x51, 0x60, 0x6f, 0x7b, 0x8e, 0x9c, 0xac,
+	0xb7, 0xcb, 0xda, 0xe8, 0xfa, 0x107, 0x117, 0x127, 0x134, 0x143, 0x152, 0x161,
+	0x172, 0x181, 0x18b, 0x199, 0x1a7, 0x1b5, 0x1c6, 0x1d5, 0x1e8, 0x1f6, 0x202, 0x211,
+	0x21f, 0x230, 0x241, 0x253, 0x262, 0x272, 0x288, 0x295, 0x2a4, 0x2bb, 0x2c9, 0x2d7,
+	0x2ed, 0x2fc, 0x307, 0x316, 0x324, 0x334, 0x344, 0x352, 0x368, 0x377, 0x384, 0x392,
+	0x39f, 0x3b6, 0x3c5, 0x3d1, 0x3de, 0x3ec, 0x3f9, 0x405, 0x415, 0x434, 0x445, 0x457,
+	0x47a, 0x499, 0x499, 0x4b8, 0x4d9, 0x4f5, 0x511,
+}
+
+const zhLangStr = "" +
+	"南非荷兰文阿姆哈拉文阿拉伯文阿塞拜疆文保加利亚文孟加拉文加泰罗尼亚文捷克文丹麦文德文希腊文英文西班牙文爱沙尼亚文波斯文芬兰文法文古吉拉特文希伯来文印地文克罗地亚" +
+	"文匈牙利文亚美尼亚文印度尼西亚文冰岛文意大利文日文格鲁吉亚文哈萨克文高棉文卡纳达文韩文吉尔吉斯文老挝文立陶宛文拉脱维亚文马其顿文马拉雅拉姆文蒙古文马拉地文马来文" +
+	"缅甸文尼泊尔文荷兰文挪威博克马尔文旁遮普文波兰文葡萄牙文罗马尼亚文俄文僧伽罗文斯洛伐克文斯洛文尼亚文阿尔巴尼亚文塞尔维亚文瑞典文斯瓦希里文泰米尔文泰卢固文泰文土" +
+	"耳其文乌克兰文乌尔都文乌兹别克文越南文中文祖鲁文菲律宾文现代标准阿拉伯文英式英文美式英文拉丁美洲西班牙文欧洲西班牙文加拿大法文巴西葡萄牙文欧洲葡萄牙文简体中文繁" +
+	"体中文"
+
+var zhLangIdx = []uint16{ // 79 entries
+	0x0, 0xf, 0x1e, 0x2a, 0x39, 0x48, 0x54, 0x66, 0x6f, 0x78, 0x7e, 0x87,
+	0x8d, 0x99, 0xa8, 0xb1, 0xba, 0xc0, 0xcf, 0xdb, 0xe4, 0xf3, 0xff, 0x10e,
+	0x120, 0x129, 0x135, 0x13b, 0x14a, 0x156, 0x15f, 0x16b, 0x171, 0x180, 0x189, 0x195,
+	0x1a4, 0x1b0, 0x1c2, 0x1cb, 0x1d7, 0x1e0, 0x1e9, 0x1f5, 0x1fe, 0x213, 0x21f, 0x228,
+	0x234, 0x243, 0x249, 0x255, 0x264, 0x276, 0x288, 0x297, 0x2a0, 0x2af, 0x2bb, 0x2c7,
+	0x2cd, 0x2d9, 0x2e5, 0x2f1, 0x300, 0x309, 0x30f, 0x318, 0x324, 0x33c, 0x348, 0x354,
+	0x36c, 0x37e, 0x38d, 0x39f, 0x3b1, 0x3bd, 0x3c9,
+}
+
+const zhHantLangStr = "" +
+	"南非荷蘭文阿姆哈拉文阿拉伯文亞塞拜然文保加利亞文孟加拉文加泰羅尼亞文捷克文丹麥文德文希臘文英文西班牙文愛沙尼亞文波斯文芬蘭文法文古吉拉特文希伯來文北印度文克羅埃" +
+	"西亞文匈牙利文亞美尼亞文印尼文冰島文義大利文日文喬治亞文哈薩克文高棉文坎那達文韓文吉爾吉斯文寮文立陶宛文拉脫維亞文馬其頓文馬來亞拉姆文蒙古文馬拉地文馬來文緬甸文" +
+	"尼泊爾文荷蘭文巴克摩挪威文旁遮普文波蘭文葡萄牙文羅馬尼亞文俄文僧伽羅文斯洛伐克文斯洛維尼亞文阿爾巴尼亞文塞爾維亞文瑞典文史瓦希里文坦米爾文泰盧固文泰文土耳其文烏" +
+	"克蘭文烏都文烏茲別克文越南文中文祖魯文菲律賓文現代標準阿拉伯文簡體中文繁體中文"
+
+var zhHantLangIdx = []uint16{ // 79 entries
+	0x0, 0xf, 0x1e, 0x2a, 0x39, 0x48, 0x54, 0x66, 0x6f, 0x78, 0x7e, 0x87,
+	0x8d, 0x99, 0xa8, 0xb1, 0xba, 0xc0, 0xcf, 0xdb, 0xe7, 0xf9, 0x105, 0x114,
+	0x11d, 0x126, 0x132, 0x138, 0x144, 0x150, 0x159, 0x165, 0x16b, 0x17a, 0x180, 0x18c,
+	0x19b, 0x1a7, 0x1b9, 0x1c2, 0x1ce, 0x1d7, 0x1e0, 0x1ec, 0x1f5, 0x207, 0x213, 0x21c,
+	0x228, 0x237, 0x23d, 0x249, 0x258, 0x26a, 0x27c, 0x28b, 0x294, 0x2a3, 0x2af, 0x2bb,
+	0x2c1, 0x2cd, 0x2d9, 0x2e2, 0x2f1, 0x2fa, 0x300, 0x309, 0x315, 0x32d, 0x32d, 0x32d,
+	0x32d, 0x32d, 0x32d, 0x32d, 0x32d, 0x339, 0x345,
+}
+
+const zuLangStr = "" +
+	"isi-Afrikaansisi-Amharicisi-Arabicisi-Azerbaijaniisi-Bulgariisi-Bengaliisi-Catal" +
+	"anisi-Czechisi-Danishisi-Germanisi-GreekisiNgisiisi-Spanishisi-Estoniaisi-Persia" +
+	"nisi-FinnishisiFulentshiisi-Gujaratiisi-Hebrewisi-Hindiisi-Croatianisi-Hungarian" +
+	"isi-Armeniaisi-Indonesianisi-Icelandicisi-Italianisi-Japaneseisi-Georgianisi-Kaz" +
+	"akhisi-Khmerisi-Kannadaisi-Koreanisi-Kirghizi-Laoisi-Lithuanianisi-Latviaisi-Mac" +
+	"edoniaisi-Malayalamisi-Mongolianisi-Marathiisi-Malayisi-Burmeseisi-Nepalii-Dutch" +
+	"i-Norwegian Bokmålisi-Punjabiisi-Polishisi-Portugueseisi-Romanianisi-Russiani-Si" +
+	"nhalaisi-Slovakisi-Slovenianisi-Albaniaisi-Serbianisi-SwedishisiSwahiliisi-Tamil" +
+	"isi-Teluguisi-Thaiisi-Turkishisi-Ukrainianisi-Urduisi-Uzbekisi-Vietnameseisi-Chi" +
+	"neseisiZuluisi-Filipinoi-British Englishi-U.S. Englishisi-Latin American Spanish" +
+	"Isipenishi saseYurophui-Canadian Frenchisi-Brazillian PortugueseIsiputukezi sase" +
+	"Yurophuisi-Sipmlified Chineseisi-Traditional Chinese"
+
+var zuLangIdx = []uint16{ // 79 entries
+	0x0, 0xd, 0x18, 0x22, 0x31, 0x3c, 0x47, 0x52, 0x5b, 0x65, 0x6f, 0x78,
+	0x80, 0x8b, 0x96, 0xa1, 0xac, 0xb8, 0xc4, 0xce, 0xd7, 0xe3, 0xf0, 0xfb,
+	0x109, 0x116, 0x121, 0x12d, 0x139, 0x143, 0x14c, 0x157, 0x161, 0x16c, 0x171, 0x17f,
+	0x189, 0x196, 0x1a3, 0x1b0, 0x1bb, 0x1c4, 0x1cf, 0x1d9, 0x1e0, 0x1f3, 0x1fe, 0x208,
+	0x216, 0x222, 0x22d, 0x236, 0x240, 0x24d, 0x258, 0x263, 0x26e, 0x278, 0x281, 0x28b,
+	0x293, 0x29e, 0x2ab, 0x2b3, 0x2bc, 0x2ca, 0x2d5, 0x2dc, 0x2e8, 0x2e8, 0x2f9, 0x307,
+	0x321, 0x337, 0x348, 0x361, 0x378, 0x38e, 0x3a5,
+}
+
+// Total size for lang: 100024 bytes (100 KB)
+
+// Number of keys: 158
+var (
+	scriptIndex = tagIndex{
+		"",
+		"",
+		"AfakArabArmiArmnAvstBaliBamuBassBatkBengBlisBopoBrahBraiBugiBuhdCakmCansCariChamCherCirtCoptCprtCyrlCyrsDevaDsrtDuplEgydEgyhEgypEthiGeokGeorGlagGothGranGrekGujrGuruHangHaniHanoHansHantHebrHiraHluwHmngHrktHungIndsItalJavaJpanJurcKaliKanaKharKhmrKhojKndaKoreKpelKthiLanaLaooLatfLatgLatnLepcLimbLinaLinbLisuLomaLyciLydiMandManiMayaMendMercMeroMlymMongMoonMrooMteiMymrNarbNbatNkgbNkooNshuOgamOlckOrkhOryaOsmaPalmPermPhagPhliPhlpPhlvPhnxPlrdPrtiRjngRoroRunrSamrSaraSarbSaurSgnwShawShrdSindSinhSoraSundSyloSyrcSyreSyrjSyrnTagbTakrTaleTaluTamlTangTavtTeluTengTfngTglgThaaThaiTibtTirhUgarVaiiVispWaraWoleXpeoXsuxYiiiZinhZmthZsymZxxxZyyyZzzz",
+	}
+)
+
+var scriptHeaders = [73]header{
+	{ // af
+		afScriptStr,
+		afScriptIdx,
+	},
+	{ // am
+		amScriptStr,
+		amScriptIdx,
+	},
+	{ // ar
+		arScriptStr,
+		arScriptIdx,
+	},
+	{ // az
+		azScriptStr,
+		azScriptIdx,
+	},
+	{ // bg
+		bgScriptStr,
+		bgScriptIdx,
+	},
+	{ // bn
+		bnScriptStr,
+		bnScriptIdx,
+	},
+	{ // ca
+		caScriptStr,
+		caScriptIdx,
+	},
+	{ // cs
+		csScriptStr,
+		csScriptIdx,
+	},
+	{ // da
+		daScriptStr,
+		daScriptIdx,
+	},
+	{ // de
+		deScriptStr,
+		deScriptIdx,
+	},
+	{ // el
+		elScriptStr,
+		elScriptIdx,
+	},
+	{ // en
+		enScriptStr,
+		enScriptIdx,
+	},
+	{ // en-GB
+		enGBScriptStr,
+		enGBScriptIdx,
+	},
+	{ // es
+		esScriptStr,
+		esScriptIdx,
+	},
+	{ // es-419
+		es419ScriptStr,
+		es419ScriptIdx,
+	},
+	{ // et
+		etScriptStr,
+		etScriptIdx,
+	},
+	{ // fa
+		faScriptStr,
+		faScriptIdx,
+	},
+	{ // fi
+		fiScriptStr,
+		fiScriptIdx,
+	},
+	{ // fil
+		filScriptStr,
+		filScriptIdx,
+	},
+	{ // fr
+		frScriptStr,
+		frScriptIdx,
+	},
+	{ // fr-CA
+		frCAScriptStr,
+		frCAScriptIdx,
+	},
+	{ // gu
+		guScriptStr,
+		guScriptIdx,
+	},
+	{ // he
+		heScriptStr,
+		heScriptIdx,
+	},
+	{ // hi
+		hiScriptStr,
+		hiScriptIdx,
+	},
+	{ // hr
+		hrScriptStr,
+		hrScriptIdx,
+	},
+	{ // hu
+		huScriptStr,
+		huScriptIdx,
+	},
+	{ // hy
+		hyScriptStr,
+		hyScriptIdx,
+	},
+	{ // id
+		idScriptStr,
+		idScriptIdx,
+	},
+	{ // is
+		isScriptStr,
+		isScriptIdx,
+	},
+	{ // it
+		itScriptStr,
+		itScriptIdx,
+	},
+	{ // ja
+		jaScriptStr,
+		jaScriptIdx,
+	},
+	{ // ka
+		kaScriptStr,
+		kaScriptIdx,
+	},
+	{ // kk
+		kkScriptStr,
+		kkScriptIdx,
+	},
+	{ // km
+		kmScriptStr,
+		kmScriptIdx,
+	},
+	{ // kn
+		knScriptStr,
+		knScriptIdx,
+	},
+	{ // ko
+		koScriptStr,
+		koScriptIdx,
+	},
+	{ // ky
+		kyScriptStr,
+		kyScriptIdx,
+	},
+	{ // lo
+		loScriptStr,
+		loScriptIdx,
+	},
+	{ // lt
+		ltScriptStr,
+		ltScriptIdx,
+	},
+	{ // lv
+		lvScriptStr,
+		lvScriptIdx,
+	},
+	{ // mk
+		mkScriptStr,
+		mkScriptIdx,
+	},
+	{ // ml
+		mlScriptStr,
+		mlScriptIdx,
+	},
+	{ // mn
+		mnScriptStr,
+		mnScriptIdx,
+	},
+	{ // mr
+		mrScriptStr,
+		mrScriptIdx,
+	},
+	{ // ms
+		msScriptStr,
+		msScriptIdx,
+	},
+	{ // my
+		myScriptStr,
+		myScriptIdx,
+	},
+	{ // ne
+		neScriptStr,
+		neScriptIdx,
+	},
+	{ // nl
+		nlScriptStr,
+		nlScriptIdx,
+	},
+	{ // no
+		noScriptStr,
+		noScriptIdx,
+	},
+	{ // pa
+		paScriptStr,
+		paScriptIdx,
+	},
+	{ // pl
+		plScriptStr,
+		plScriptIdx,
+	},
+	{ // pt
+		ptScriptStr,
+		ptScriptIdx,
+	},
+	{ // pt-PT
+		ptPTScriptStr,
+		ptPTScriptIdx,
+	},
+	{ // ro
+		roScriptStr,
+		roScriptIdx,
+	},
+	{ // ru
+		ruScriptStr,
+		ruScriptIdx,
+	},
+	{ // si
+		siScriptStr,
+		siScriptIdx,
+	},
+	{ // sk
+		skScriptStr,
+		skScriptIdx,
+	},
+	{ // sl
+		slScriptStr,
+		slScriptIdx,
+	},
+	{ // sq
+		sqScriptStr,
+		sqScriptIdx,
+	},
+	{ // sr
+		srScriptStr,
+		srScriptIdx,
+	},
+	{ // sv
+		svScriptStr,
+		svScriptIdx,
+	},
+	{ // sw
+		swScriptStr,
+		swScriptIdx,
+	},
+	{ // ta
+		taScriptStr,
+		taScriptIdx,
+	},
+	{ // te
+		teScriptStr,
+		teScriptIdx,
+	},
+	{ // th
+		thScriptStr,
+		thScriptIdx,
+	},
+	{ // tr
+		trScriptStr,
+		trScriptIdx,
+	},
+	{ // uk
+		ukScriptStr,
+		ukScriptIdx,
+	},
+	{ // ur
+		urScriptStr,
+		urScriptIdx,
+	},
+	{ // uz
+		uzScriptStr,
+		uzScriptIdx,
+	},
+	{ // vi
+		viScriptStr,
+		viScriptIdx,
+	},
+	{ // zh
+		zhScriptStr,
+		zhScriptIdx,
+	},
+	{ // zh-Hant
+		zhHantScriptStr,
+		zhHantScriptIdx,
+	},
+	{ // zu
+		zuScriptStr,
+		zuScriptIdx,
+	},
+}
+
+const afScriptStr = "" +
+	"ArabiesArmeensBengaalsBopomofoBrailleSirilliesDevanagariEtiopiesGeorgiesGrieksGu" +
+	"djaratiGurmukhiHangulHanVereenvoudigde HanTradisionele HanHebreeusHiraganaJapann" +
+	"eesKatakanaKhmerKannadaKoreaansLaoLatynMalabaarsMongoolsMianmarOriyaSinhalaTamil" +
+	"TeluguThaanaThaiTibettaansSimboleOngeskreweAlgemeenOnbekende skryfstelsel"
+
+var afScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x7, 0x7, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0x16, 0x16,
+	0x1e, 0x1e, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25,
+	0x25, 0x2e, 0x2e, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x40, 0x40, 0x48,
+	0x48, 0x48, 0x48, 0x4e, 0x57, 0x5f, 0x65, 0x68, 0x68, 0x7a, 0x8a, 0x92,
+	0x9a, 0x9a, 0x9a, 0x9a, 0x9a, 0x9a, 0x9a, 0x9a, 0xa3, 0xa3, 0xa3, 0xab,
+	0xab, 0xb0, 0xb0, 0xb7, 0xbf, 0xbf, 0xbf, 0xbf, 0xc2, 0xc2, 0xc2, 0xc7,
+	0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7,
+	0xc7, 0xc7, 0xd0, 0xd8, 0xd8, 0xd8, 0xd8, 0xdf, 0xdf, 0xdf, 0xdf, 0xdf,
+	0xdf, 0xdf, 0xdf, 0xdf, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4,
+	0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4, 0xe4,
+	0xe4, 0xe4, 0xeb, 0xeb, 0xeb, 0xeb, 0xeb, 0xeb, 0xeb, 0xeb, 0xeb, 0xeb,
+	0xeb, 0xeb, 0xf0, 0xf0, 0xf0, 0xf6, 0xf6, 0xf6, 0xf6, 0xfc, 0x100, 0x10a,
+	0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x111,
+	0x11b, 0x123, 0x139,
+}
+
+const amScriptStr = "" +
+	"ዓረብኛአርሜንያዊቤንጋሊቦፖሞፎብሬይልሲይሪልክደቫንጋሪኢትዮፒክጆርጂያዊግሪክጉጃራቲጉርሙኪሐንጉልሃንቀለል ያለ ሃንባህላዊ ሃንእብራይስ" +
+	"ጥሂራጋናጃፓንኛካታካናክህመርካንአዳኮሪያኛላኦላቲንማላያልምሞንጎሊያዊምያንማርኦሪያሲንሃላታሚልተልጉታናታይቲቤታንምልክቶችያልተጻፈየጋራ" +
+	"ያልታወቀ ስክሪፕት"
+
+var amScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xc, 0xc, 0x1e, 0x1e, 0x1e, 0x1e, 0x1e, 0x1e, 0x2a, 0x2a,
+	0x36, 0x36, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42,
+	0x42, 0x51, 0x51, 0x60, 0x60, 0x60, 0x60, 0x60, 0x60, 0x6f, 0x6f, 0x7e,
+	0x7e, 0x7e, 0x7e, 0x87, 0x93, 0x9f, 0xab, 0xb1, 0xb1, 0xc8, 0xdb, 0xed,
+	0xf9, 0xf9, 0xf9, 0xf9, 0xf9, 0xf9, 0xf9, 0xf9, 0x105, 0x105, 0x105, 0x111,
+	0x111, 0x11d, 0x11d, 0x129, 0x135, 0x135, 0x135, 0x135, 0x13b, 0x13b, 0x13b, 0x144,
+	0x144, 0x144, 0x144, 0x144, 0x144, 0x144, 0x144, 0x144, 0x144, 0x144, 0x144, 0x144,
+	0x144, 0x144, 0x153, 0x165, 0x165, 0x165, 0x165, 0x174, 0x174, 0x174, 0x174, 0x174,
+	0x174, 0x174, 0x174, 0x174, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d,
+	0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d, 0x17d,
+	0x17d, 0x17d, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189,
+	0x189, 0x189, 0x192, 0x192, 0x192, 0x19b, 0x19b, 0x19b, 0x19b, 0x1a1, 0x1a7, 0x1b3,
+	0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1b3, 0x1c2,
+	0x1d1, 0x1da, 0x1f9,
+}
+
+const arScriptStr = "" +
+	"العربيةالأرمينيةالباليةالباتاكالبنغاليةرموز بليسالبوبوموفوالهندوسيةالبرايلالبجين" +
+	"يزالبهيديةمقاطع كندية أصلية موحدةالكاريةالتشاميةالشيروكيالسيرثالقبطيةالقبرصيةالس" +
+	"يريليةالسيريلية السلافية الكنسية القديمةالديفاناجاريالديسيريتالديموطيقيةالهيراطي" +
+	"قيةالهيروغليفيةالأثيوبيةالأبجدية الجورجية - أسومتافرلي و نسخريالجورجيةالجلاجوليت" +
+	"يكالقوطيةاليونانيةالتاغجراتيةالجرمخيالهانغولالهانالهانونوالهان المبسطةالهان التق" +
+	"ليديةالعبريةالهيراجاناالباهوه همونجالكتكانا أو الهيراجاناالمجرية القديمةاندس - ه" +
+	"ارابانالإيطالية القديمةالجاويةاليابانيةالكياه لىالكتكاناالخاروشتىالخميريةالكاناد" +
+	"االكوريةالانااللاواللاتينية - متغير فراكتراللاتينية - متغير غيلىاللاتينيةالليبتش" +
+	"ا - رونجالليمبوالخطية أالخطية بالليسيةالليديةالمانداينيةالمايا الهيروغليفيةالمير" +
+	"ويتيكالماليالامالمغوليةمونالميانمارالعربية الشمالية القديمةأنكوالأوجهامالأورخونا" +
+	"لأورياالأوسمانياالبيرميكية القديمةالفاجسباالفينيقيةالصوتيات الجماءرنجورنجوالروني" +
+	"الساراتيالعربية الجنوبية القديمةالشوانيالسينهالاالسوندانيةالسيلوتي ناغريالسرياني" +
+	"ةالسريانية الأسترنجيليةالسريانية الغربيةالسريانية الشرقيةالتاجبانواالتاي ليالتاى" +
+	" لى الجديدالتاميليةالتيلجوالتينجوارالتيفيناغالتغالوغيةالثعنةالتايلانديةالتبتيةال" +
+	"أجاريتيكيةالفايالكلام المرئيالفارسية القديمةالكتابة المسمارية الأكدية السومريةال" +
+	"ييالموروثرموزغير مكتوبعامنظام كتابة غير معروف"
+
+var arScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xe, 0xe, 0x20, 0x20, 0x2e, 0x2e, 0x2e, 0x3c, 0x4e, 0x5f,
+	0x73, 0x85, 0x93, 0xa3, 0xb3, 0xb3, 0xde, 0xec, 0xfc, 0x10c, 0x118, 0x126,
+	0x136, 0x148, 0x189, 0x1a1, 0x1b3, 0x1b3, 0x1c9, 0x1df, 0x1f7, 0x209, 0x24f, 0x25f,
+	0x277, 0x285, 0x285, 0x297, 0x2ad, 0x2bb, 0x2cb, 0x2d5, 0x2e5, 0x2fe, 0x31b, 0x329,
+	0x33d, 0x33d, 0x356, 0x380, 0x39d, 0x3b6, 0x3d7, 0x3e5, 0x3f7, 0x3f7, 0x408, 0x418,
+	0x42a, 0x43a, 0x43a, 0x44a, 0x458, 0x458, 0x458, 0x462, 0x46c, 0x498, 0x4c0, 0x4d2,
+	0x4ed, 0x4fb, 0x50a, 0x519, 0x519, 0x519, 0x527, 0x535, 0x54b, 0x54b, 0x570, 0x570,
+	0x570, 0x584, 0x598, 0x5a8, 0x5ae, 0x5ae, 0x5ae, 0x5c0, 0x5ee, 0x5ee, 0x5ee, 0x5f6,
+	0x5f6, 0x606, 0x606, 0x616, 0x624, 0x638, 0x638, 0x65b, 0x66b, 0x66b, 0x66b, 0x66b,
+	0x67d, 0x69a, 0x69a, 0x69a, 0x6aa, 0x6b6, 0x6b6, 0x6c6, 0x6f4, 0x6f4, 0x6f4, 0x702,
+	0x702, 0x702, 0x714, 0x714, 0x728, 0x743, 0x755, 0x780, 0x7a1, 0x7c2, 0x7d6, 0x7d6,
+	0x7e5, 0x801, 0x813, 0x813, 0x813, 0x821, 0x833, 0x845, 0x859, 0x865, 0x87b, 0x889,
+	0x889, 0x8a1, 0x8ab, 0x8c4, 0x8c4, 0x8c4, 0x8e3, 0x924, 0x92c, 0x93a, 0x93a, 0x942,
+	0x953, 0x959, 0x97e,
+}
+
+const azScriptStr = "" +
+	"ərəbarmierməniavestanbalibatakbenqalblissymbolsbopomofobrahmibraylbuqinbuhidkakm" +
+	"birləşmiş kanada yerli yazısıkariyançamçirokisirtkoptikkiprkirilqədimi kilsa kir" +
+	"ilidevanaqarideseretmisir demotikmisir hiyeratikmisir hiyeroqlifefiopgürcü xutsu" +
+	"rigürcüqlaqolitikqotikyunanqucaratqurmuxihanqılhanhanunuSadələşdirilmiş HanƏnənə" +
+	"vi Hanyəhudiiraqanapahav monqkatakana vəya hiraqanaqədimi macarhindistanqədimi i" +
+	"talyalıcavayaponkayax likatakanaxaroştikxmerkannadakoreyaktilannalaofraktur latı" +
+	"nıgael latınılatınlepçəlimbulusianludianmandayenmaniçayenmaya hiyeroqlifimeroyti" +
+	"kmalayalammonqolmunmeytey mayekmyanmarnkooğamol çikiorxonoriyaosmanyaqədimi perm" +
+	"ikfaqs-pafliflpkitab paxlavifoenikpolard fonetikprtirecəngronqoronqoruniksamarit" +
+	"ansaratisaurastraişarət yazısışavyansinhalsundansiloti nəqrisiryakestrangela sür" +
+	"yanicetaqbanvatay letəzə tay lutamiltavtteluqutengvartifinaqtaqaloqthanataytibet" +
+	"uqaritvaydanışma səsləriqədimi farssumer-akadyan kuneyformyizmthsimvollaryazısız" +
+	"ümumi yazınaməlum skript"
+
+var azScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0xa, 0x11, 0x18, 0x1c, 0x1c, 0x1c, 0x21, 0x27, 0x32,
+	0x3a, 0x40, 0x45, 0x4a, 0x4f, 0x53, 0x75, 0x7c, 0x80, 0x87, 0x8b, 0x91,
+	0x95, 0x9a, 0xae, 0xb8, 0xbf, 0xbf, 0xcc, 0xdb, 0xeb, 0xf0, 0xff, 0x106,
+	0x110, 0x115, 0x115, 0x11a, 0x121, 0x128, 0x12f, 0x132, 0x138, 0x14f, 0x15d, 0x164,
+	0x16b, 0x16b, 0x175, 0x18c, 0x199, 0x1a2, 0x1b3, 0x1b7, 0x1bc, 0x1bc, 0x1c4, 0x1cc,
+	0x1d4, 0x1d9, 0x1d9, 0x1e0, 0x1e6, 0x1e6, 0x1e9, 0x1ee, 0x1f1, 0x201, 0x20e, 0x214,
+	0x21b, 0x220, 0x220, 0x220, 0x220, 0x220, 0x226, 0x22c, 0x234, 0x23e, 0x24e, 0x24e,
+	0x24e, 0x256, 0x25f, 0x265, 0x268, 0x268, 0x274, 0x27b, 0x27b, 0x27b, 0x27b, 0x27e,
+	0x27e, 0x283, 0x28b, 0x290, 0x295, 0x29c, 0x29c, 0x2aa, 0x2b1, 0x2b4, 0x2b7, 0x2c4,
+	0x2ca, 0x2d8, 0x2dc, 0x2e3, 0x2ed, 0x2f2, 0x2fb, 0x301, 0x301, 0x30a, 0x31b, 0x322,
+	0x322, 0x322, 0x328, 0x328, 0x32e, 0x33b, 0x341, 0x356, 0x356, 0x356, 0x35e, 0x35e,
+	0x364, 0x371, 0x376, 0x376, 0x37a, 0x380, 0x387, 0x38e, 0x395, 0x39a, 0x39d, 0x3a2,
+	0x3a2, 0x3a8, 0x3ab, 0x3be, 0x3be, 0x3be, 0x3ca, 0x3e1, 0x3e3, 0x3e3, 0x3e7, 0x3f0,
+	0x3f9, 0x405, 0x414,
+}
+
+const bgScriptStr = "" +
+	"арабскаАрамейскаарменскаАвестанскаБалийскиБатакскабенгалскаБлис символибопомофоБ" +
+	"рахмиБрайловаБугинскаБухидЧакмаУнифицирани символи на канадски аборигениКарийска" +
+	"ХамитскаЧерокиКиртКоптскаКипърскакирилицадеванагариДезеретЕгипетско демотично пи" +
+	"смоЕгипетско йератично писмоЕгипетски йероглифиетиопскаГрузинска хуцуригрузинска" +
+	"ГлаголическаГотическагръцкагуджаратигурмукхихангълкитайскаХанунуопростен китайск" +
+	"итрадиционен китайскиивритхираганаПахау хмонгКатакана или ХираганаСтароунгарскаХ" +
+	"арапскаДревно италийскаЯванскаяпонскаКая ЛикатаканаКхароштхикхмерскаканнадакорей" +
+	"скаКайтхиЛанналаоскаЛатинска фрактураГалска латинскалатиницаЛепчаЛимбуЛинейна АЛ" +
+	"инейна БЛицийскаЛидийскаМандаринскаМанихейскаЙероглифи на МаитеМероитскамалаялам" +
+	"монголскаМунМанипурибирманскаН'КоОгамическаОл ЧикиОрхоно-енисейскаорияОсманскаДр" +
+	"евно пермскаФагс-паПахлавскаФиникийскаПисменост ПолардРонго-ронгоРуническаСамари" +
+	"танскаСаратиСаураштрасинхалскаСунданскаСилоти НагриСирийскаСирийска естрангелоЗа" +
+	"падна сирийскаИзточна сирийскаТагбанваТай ЛеНова Тай ЛетамилскателугуТагалогтаан" +
+	"атайскатибетскаУгаритскаВайскаВидима речСтароперсийскаШумеро-акадски клинописЙиМ" +
+	"атематически символисимволибез писменостобщанепозната писменост"
+
+var bgScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xe, 0x20, 0x30, 0x44, 0x54, 0x54, 0x54, 0x64, 0x76, 0x8d,
+	0x9d, 0xa9, 0xb9, 0xc9, 0xd3, 0xdd, 0x12b, 0x13b, 0x14b, 0x157, 0x15f, 0x16d,
+	0x17d, 0x18d, 0x18d, 0x1a1, 0x1af, 0x1af, 0x1df, 0x20f, 0x234, 0x244, 0x263, 0x275,
+	0x28d, 0x29f, 0x29f, 0x2ab, 0x2bd, 0x2cd, 0x2d9, 0x2e9, 0x2f5, 0x316, 0x33d, 0x347,
+	0x357, 0x357, 0x36c, 0x394, 0x3ae, 0x3be, 0x3dd, 0x3eb, 0x3f9, 0x3f9, 0x404, 0x414,
+	0x426, 0x436, 0x436, 0x444, 0x454, 0x454, 0x460, 0x46a, 0x476, 0x497, 0x4b4, 0x4c4,
+	0x4ce, 0x4d8, 0x4e9, 0x4fa, 0x4fa, 0x4fa, 0x50a, 0x51a, 0x530, 0x544, 0x566, 0x566,
+	0x566, 0x578, 0x588, 0x59a, 0x5a0, 0x5a0, 0x5b0, 0x5c2, 0x5c2, 0x5c2, 0x5c2, 0x5c9,
+	0x5c9, 0x5dd, 0x5ea, 0x609, 0x611, 0x621, 0x621, 0x63c, 0x649, 0x649, 0x649, 0x65b,
+	0x66f, 0x68e, 0x68e, 0x68e, 0x6a3, 0x6b5, 0x6cd, 0x6d9, 0x6d9, 0x6eb, 0x6eb, 0x6eb,
+	0x6eb, 0x6eb, 0x6fd, 0x6fd, 0x70f, 0x726, 0x736, 0x75b, 0x77a, 0x799, 0x7a9, 0x7a9,
+	0x7b4, 0x7c8, 0x7d8, 0x7d8, 0x7d8, 0x7e4, 0x7e4, 0x7e4, 0x7f2, 0x7fc, 0x808, 0x818,
+	0x818, 0x82a, 0x836, 0x849, 0x849, 0x849, 0x865, 0x891, 0x895, 0x895, 0x8be, 0x8cc,
+	0x8e5, 0x8ed, 0x912,
+}
+
+const bnScriptStr = "" +
+	"আরবিআরমিআর্মেনীয়আভেসতানবালীয়বাটাকবাংলাব্লিসপ্রতীকবোপোমোফোব্রাহ্মীব্রেইলবুগিবুহিড" +
+	"চাকমাসংযুক্ত কানাডিয়ান অ্যাব্রোজিনিয়ান সিলেবিক্সক্যারিয়ানচ্যামচেরোকিকির্টকোপ্টিক" +
+	"সাইপ্রোয়েটসিরিলিকপ্রাচীন চার্চ স্লাভোনিক সিরিলিকদেবনাগরিদেসেরাতমিশরীয় ডেমোটিকমিশ" +
+	"রীয় হায়রেটিকমিশরীয় হায়ারোগ্লিপইথিওপিয়জর্জিয় খুৎসুরিজর্জিয়ানগ্লাগোলিটিকগোথিকগ্রিক" +
+	"গুজরাটিগুরুমুখিহাঙ্গুলহ্যানহ্যানুনুসরলিকৃত হ্যানঐতিহ্যবাহী হ্যানহিব্রুহিরাগানাফা" +
+	"হাও মঙকাটাকানা অথবা হিরাগানাপুরোনো হাঙ্গেরীয়সিন্ধুপ্রাচীন ইতালিজাভানিজজাপানীকায়া" +
+	"হ লিকাটাকানাখরোষ্ঠীখমেরকানাড়াকোরিয়ানকাইথিলান্নালাওফ্রাক্টুর ল্যাটিনগ্যালিক ল্যা" +
+	"টিনল্যাটিনলেপ্চালিম্বুলিনিয়ার এলিনিয়ার বিলাইসিয়ানলাইডিয়ানম্যান্ডায়ীনম্যানিচাইনমা" +
+	"য়ান হায়ারোগ্লিপমেরোইটিকমালায়ালামমোঙ্গোলীয়মুনমেইটেই মায়েকমায়ানমারএনকোওঘামওল চিকিঅ" +
+	"র্খোনওড়িয়াওসমানিয়প্রাচীন পার্মিকফাগ্স-পাখদিত পাহলভিসল্টার পাহলভিপুস্তক পাহলভিফ" +
+	"িনিশিয়পোলার্ড ধ্বনিকপার্থিয়নরেজ্যাঙ্গরোঙ্গোরোঙ্গোরুনিকসমেরিটনসারাতিসৌরাষ্ট্রচিহ্" +
+	"ন লিখনসাভিয়ানসিংহলিসান্দানিজসিলেটি নাগরিসিরিয়াকএস্ট্রেঙ্গেলো সিরিয়াকপশ্চিমাঞ্চল" +
+	"ীয় সিরিয়াকপূর্বাঞ্চলীয় সিরিয়াকটাগোওয়ানাতাইলেনতুন তাই লুতামিলতাই ভিয়েৎতেলেগুতেঙ্গ" +
+	"োয়ারতিফিনাগটাগালগথানাথাইতিব্বতিউগারিটিকভাইদৃশ্যমান ভাষাপ্রাচীন ফার্সিসুমের-আক্কা" +
+	"দীয় কীলকরূপউইকাইগানিতিক চিহ্নপ্রতীকসমুহঅলিখিতসাধারনঅজানা লিপি"
+
+var bnScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xc, 0x18, 0x30, 0x45, 0x54, 0x54, 0x54, 0x63, 0x72, 0x93,
+	0xab, 0xc3, 0xd5, 0xe1, 0xf0, 0xff, 0x17a, 0x195, 0x1a4, 0x1b6, 0x1c5, 0x1da,
+	0x1f8, 0x20d, 0x264, 0x27c, 0x291, 0x291, 0x2b9, 0x2e4, 0x318, 0x32d, 0x355, 0x36d,
+	0x38e, 0x39d, 0x39d, 0x3ac, 0x3c1, 0x3d9, 0x3ee, 0x3fd, 0x415, 0x43a, 0x468, 0x47a,
+	0x492, 0x492, 0x4a8, 0x4e6, 0x514, 0x526, 0x54b, 0x560, 0x572, 0x572, 0x588, 0x5a0,
+	0x5b5, 0x5c1, 0x5c1, 0x5d6, 0x5eb, 0x5eb, 0x5fa, 0x60c, 0x615, 0x646, 0x671, 0x686,
+	0x698, 0x6aa, 0x6c3, 0x6df, 0x6df, 0x6df, 0x6f7, 0x70f, 0x730, 0x74e, 0x77f, 0x77f,
+	0x77f, 0x797, 0x7b2, 0x7cd, 0x7d6, 0x7d6, 0x7f8, 0x810, 0x810, 0x810, 0x810, 0x81c,
+	0x81c, 0x828, 0x83b, 0x84d, 0x862, 0x877, 0x877, 0x8a2, 0x8b8, 0x8d7, 0x8fc, 0x921,
+	0x936, 0x95e, 0x976, 0x991, 0x9b5, 0x9c4, 0x9d9, 0x9eb, 0x9eb, 0xa06, 0xa22, 0xa37,
+	0xa37, 0xa37, 0xa49, 0xa49, 0xa64, 0xa86, 0xa9b, 0xadb, 0xb18, 0xb52, 0xb6d, 0xb6d,
+	0xb7c, 0xb99, 0xba8, 0xba8, 0xbc1, 0xbd3, 0xbee, 0xc03, 0xc15, 0xc21, 0xc2a, 0xc3f,
+	0xc3f, 0xc57, 0xc60, 0xc85, 0xc85, 0xc85, 0xcad, 0xceb, 0xcf1, 0xcfa, 0xd1f, 0xd3d,
+	0xd4f, 0xd61, 0xd7d,
+}
+
+const caScriptStr = "" +
+	"afakaàrabarameu imperialarmeniavèsticbalinèsbamumbassa vahbatakbengalísímbols Bl" +
+	"issbopomofobrahmibraillebuginèsbuhidchakmasíl·labes dels aborígens canadencs uni" +
+	"ficatscariàchamcherokeecirthcoptexipriotaciríl·licciríl·lic de l'antic eslau ecl" +
+	"esiàsticdevanagarideserettaquigrafia Duployédemòtic egipcihieràtic egipcijeroglí" +
+	"fic egipcietiòpicgeorgià hucurigeorgiàglagolíticgòticgranthagrecgujaratigurmukhi" +
+	"hangulhanhanunoohan simplificathan tradicionalhebreuhiraganajeroglífic anatolipa" +
+	"hawh hmongkatakana o hiraganahongarès anticescriptura de la vall de l'Induscursi" +
+	"va antigajavanèsjaponèsjürchenkayah likatakanakharosthikhmerkhojakannadacoreàkpe" +
+	"llekaithilannalaollatí frakturllatí gaèlicllatílepchalimbulineal Alineal Blisulo" +
+	"malicilidimandaicmaniqueujeroglífics maiesmendecursiva meroíticameroíticmalaiàla" +
+	"mmongolmoonmromanipuríbirmàantic nord-aràbicnabateugeban’Konü shuoghamsantaliork" +
+	"honoriyaosmanyapalmirèantic pèrmicphagspapahlavi inscripcionalpsalter pahlavipah" +
+	"lavifenicipollard miaoparthià inscripcionalrejangrongo-rongorúnicsamaritàsaratis" +
+	"ud-aràbic anticsaurashtraescriptura de signesshaviàshradadevangarisingalèssora s" +
+	"ompengsundanèssyloti nagrisiríacsiríac estrangelosiríac occidentalsiríac orienta" +
+	"ltagbanwatakritai lenou tai luetàmiltanguttai viettelugutengwartifinaghtagàlogth" +
+	"aanatailandèstibetàtirhutugaríticvaillenguatge visiblevarang kshitiwoleaipersa a" +
+	"nticcuneïforme sumeri-accadiyiheretatnotació matemàticasímbolssense escripturaco" +
+	"múescriptura desconeguda"
+
+var caScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0xa, 0x19, 0x1f, 0x27, 0x2f, 0x34, 0x3d, 0x42, 0x4a, 0x58,
+	0x60, 0x66, 0x6d, 0x75, 0x7a, 0x80, 0xaf, 0xb5, 0xb9, 0xc1, 0xc6, 0xcb,
+	0xd3, 0xde, 0x107, 0x111, 0x118, 0x12c, 0x13b, 0x14b, 0x15d, 0x165, 0x174, 0x17c,
+	0x187, 0x18d, 0x194, 0x198, 0x1a0, 0x1a8, 0x1ae, 0x1b1, 0x1b8, 0x1c7, 0x1d6, 0x1dc,
+	0x1e4, 0x1f7, 0x203, 0x216, 0x225, 0x245, 0x253, 0x25b, 0x263, 0x26b, 0x273, 0x27b,
+	0x284, 0x289, 0x28e, 0x295, 0x29b, 0x2a1, 0x2a7, 0x2ac, 0x2af, 0x2bd, 0x2cb, 0x2d1,
+	0x2d7, 0x2dc, 0x2e4, 0x2ec, 0x2f0, 0x2f4, 0x2f8, 0x2fc, 0x303, 0x30b, 0x31d, 0x322,
+	0x334, 0x33d, 0x347, 0x34d, 0x351, 0x354, 0x35d, 0x363, 0x375, 0x37c, 0x380, 0x386,
+	0x38d, 0x392, 0x399, 0x39f, 0x3a4, 0x3ab, 0x3b3, 0x3c0, 0x3c7, 0x3dc, 0x3eb, 0x3f2,
+	0x3f8, 0x404, 0x41a, 0x420, 0x42b, 0x431, 0x43a, 0x440, 0x451, 0x45b, 0x46f, 0x476,
+	0x47c, 0x485, 0x48e, 0x49a, 0x4a3, 0x4af, 0x4b6, 0x4c8, 0x4da, 0x4ea, 0x4f2, 0x4f7,
+	0x4fd, 0x508, 0x50e, 0x514, 0x51c, 0x522, 0x529, 0x531, 0x539, 0x53f, 0x549, 0x550,
+	0x556, 0x55f, 0x562, 0x574, 0x581, 0x587, 0x592, 0x5ab, 0x5ad, 0x5b4, 0x5c8, 0x5d0,
+	0x5e0, 0x5e5, 0x5fb,
+}
+
+const csScriptStr = "" +
+	"afakaarabskéaramejské (imperiální)arménskéavestánskébalijskébamumskébassa vahbat" +
+	"ackébengálskéblissovo písmobopomofobráhmíbraillovo písmobuginskébuhidskéčakmasla" +
+	"bičné písmo kanadských domorodcůkarijskéčamčerokíkirtkoptskékyperskécyrilicecyri" +
+	"lce - staroslověnskádévanágárídeseretDuployého těsnopisegyptské démotickéegyptsk" +
+	"é hieratickéegyptské hieroglyfyetiopskégruzínské chutsurigruzínskéhlaholicegotic" +
+	"kégranthařeckégudžarátígurmukhihangulhanhanunóohan - zjednodušenéhan - tradičníh" +
+	"ebrejskéhiraganaanatolské hieroglyfyhmongskéjaponské slabičnéstaromaďarskéharapp" +
+	"skéetruskéjavánskéjaponskédžürčenskékayah likatakanakháróšthíkhmerskékannadskéko" +
+	"rejskékaithilannalaoskélatinka - lomenálatinka - galskálatinkalepčskélimbulineár" +
+	"ní Alineární BFraserovolomalýkijskélýdskémandejskémanichejskémayské hieroglyfyme" +
+	"ndskémeroitické psacímeroitickémalajlámskémongolskéMoonovomromejtej majek (manip" +
+	"urské)myanmarskéstaroseveroarabskénabatejskén’koogamskésantálské (ol chiki)orcho" +
+	"nskéurískéosmansképalmýrskéstaropermsképhags-papahlavské klínovépahlavské žalmov" +
+	"épahlavské knižnífénicképollardova fonetická abecedaparthské klínovéredžanskéron" +
+	"gorongorunovésamařskéstarojihoarabskésaurášterskéShawova abecedašáradásinhálskés" +
+	"ora sompengsundánskésylhetskésyrskésyrské - estrangelosyrské - západnísyrské - v" +
+	"ýchodnítagbanwatakrítai letai lü novétamilskétanguttai viettelugskétengwarberber" +
+	"skétagalskéthaanathajskétibetskéugaritské klínovévaividitelná řečstaroperské klí" +
+	"nové písmosumero-akkadské klínové písmoyimatematický zápissymbolybez zápisuobecn" +
+	"éneznámé písmo"
+
+var csScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0xd, 0x26, 0x30, 0x3c, 0x45, 0x4e, 0x57, 0x5f, 0x6a, 0x79,
+	0x81, 0x89, 0x99, 0xa2, 0xab, 0xb1, 0xd9, 0xe2, 0xe6, 0xee, 0xf2, 0xfa,
+	0x103, 0x10b, 0x125, 0x133, 0x13a, 0x14e, 0x163, 0x178, 0x18c, 0x195, 0x1a9, 0x1b4,
+	0x1bd, 0x1c5, 0x1cc, 0x1d3, 0x1df, 0x1e7, 0x1ed, 0x1f0, 0x1f8, 0x20c, 0x21c, 0x226,
+	0x22e, 0x243, 0x24c, 0x260, 0x26f, 0x279, 0x281, 0x28b, 0x294, 0x2a2, 0x2aa, 0x2b2,
+	0x2bf, 0x2c8, 0x2c8, 0x2d2, 0x2db, 0x2db, 0x2e1, 0x2e6, 0x2ed, 0x2fe, 0x30f, 0x316,
+	0x31f, 0x324, 0x330, 0x33c, 0x345, 0x349, 0x353, 0x35b, 0x365, 0x371, 0x383, 0x38b,
+	0x39d, 0x3a8, 0x3b5, 0x3bf, 0x3c6, 0x3c9, 0x3e3, 0x3ee, 0x401, 0x40c, 0x40c, 0x412,
+	0x412, 0x41a, 0x430, 0x43a, 0x442, 0x44b, 0x456, 0x463, 0x46b, 0x47f, 0x493, 0x4a6,
+	0x4af, 0x4cc, 0x4df, 0x4ea, 0x4f4, 0x4fb, 0x505, 0x505, 0x516, 0x525, 0x525, 0x534,
+	0x53d, 0x53d, 0x548, 0x554, 0x55f, 0x569, 0x570, 0x584, 0x597, 0x5ab, 0x5b3, 0x5b9,
+	0x5bf, 0x5cc, 0x5d5, 0x5db, 0x5e3, 0x5ec, 0x5f3, 0x5fd, 0x606, 0x60c, 0x614, 0x61d,
+	0x61d, 0x631, 0x634, 0x644, 0x644, 0x644, 0x661, 0x682, 0x684, 0x684, 0x697, 0x69e,
+	0x6a9, 0x6b0, 0x6c0,
+}
+
+const daScriptStr = "" +
+	"afakaarabiskarmiarmenskavestanskbalinesiskbamumbassabatakbengaliblissymbolerbopo" +
+	"mofobramiskbrailleskriftbuginesiskbuhidcakmoprindelige canadiske symbolerkarians" +
+	"kchamcherokeecirtkoptiskcypriotiskkyrilliskkyrillisk - oldkirkeslavisk variantde" +
+	"vanagarideseretDuploya-stenografiegyptisk demotiskegyptisk hieratiskegyptiske hi" +
+	"eroglyfferetiopiskgeorgisk kutsurigeorgiskglagolitiskgotiskgranthagræskgujaratig" +
+	"urmukhihangulhanhanunooforenklet hantraditionelt hanhebraiskhiraganaanatolske hi" +
+	"eroglyfferpahawh hmongkatakana eller hiraganaoldungarskindusOlditaliskjavanesisk" +
+	"japanskjurchenkaya likatakanakharoshtikhmerkhojkikannadakoreanskkpellekthilannal" +
+	"aolatinsk - frakturvariantlatinsk - gælisk variantlatinsklepchalimbulineær Aline" +
+	"ær Blisulomalykisklydiskmandaiskmanikæiskmayahieroglyffermendemetroitisk sammenh" +
+	"ængendemeroitiskmalayalammongolskmoonmroomeitei-mayekburmesiskgammelt nordarabis" +
+	"knabateisknakhi geban'konüshuoghamol-chikiorkhonoriyaosmanniskpalmyrenskoldpermi" +
+	"skphags-paphliphlppahlavifønikiskpollardtegnprtirejangrongo-rongorunersamaritans" +
+	"ksaratioldsørarabisksaurashtrategnskriftshavisksharadakhudawadisingalesisksorasu" +
+	"ndanesisksyloti nagrisyrisksyrisk - estrangelovariantvestsyriskøstsyriakisktagba" +
+	"nwatakritai letai luetamilsktanguttavttelugutengwartifinaghtagalogthaanathailand" +
+	"sktibetansktirhutaugaritiskvaisynlig talevarang kshitiwoleaioldpersisksumero-akk" +
+	"adisk cuneiformyiarvetzmthzsymuden skriftsprogfællesukendt skriftsprog"
+
+var daScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0xc, 0x10, 0x17, 0x20, 0x2a, 0x2f, 0x34, 0x39, 0x40, 0x4c,
+	0x54, 0x5b, 0x68, 0x72, 0x77, 0x7b, 0x99, 0xa1, 0xa5, 0xad, 0xb1, 0xb8,
+	0xc2, 0xcb, 0xee, 0xf8, 0xff, 0x111, 0x122, 0x134, 0x14a, 0x152, 0x162, 0x16a,
+	0x175, 0x17b, 0x182, 0x188, 0x190, 0x198, 0x19e, 0x1a1, 0x1a8, 0x1b5, 0x1c5, 0x1cd,
+	0x1d5, 0x1eb, 0x1f7, 0x20e, 0x218, 0x21d, 0x227, 0x231, 0x238, 0x23f, 0x246, 0x24e,
+	0x257, 0x25c, 0x262, 0x269, 0x271, 0x277, 0x27b, 0x280, 0x283, 0x29b, 0x2b4, 0x2bb,
+	0x2c1, 0x2c6, 0x2cf, 0x2d8, 0x2dc, 0x2e0, 0x2e6, 0x2ec, 0x2f4, 0x2fe, 0x30e, 0x313,
+	0x32d, 0x336, 0x33f, 0x347, 0x34b, 0x34f, 0x35b, 0x364, 0x377, 0x380, 0x38a, 0x38e,
+	0x394, 0x399, 0x3a1, 0x3a7, 0x3ac, 0x3b5, 0x3bf, 0x3c9, 0x3d1, 0x3d5, 0x3d9, 0x3e0,
+	0x3e9, 0x3f4, 0x3f8, 0x3fe, 0x409, 0x40e, 0x419, 0x41f, 0x42d, 0x437, 0x441, 0x448,
+	0x44f, 0x458, 0x463, 0x467, 0x472, 0x47e, 0x484, 0x49e, 0x4a8, 0x4b5, 0x4bd, 0x4c2,
+	0x4c8, 0x4cf, 0x4d6, 0x4dc, 0x4e0, 0x4e6, 0x4ed, 0x4f5, 0x4fc, 0x502, 0x50c, 0x515,
+	0x51c, 0x525, 0x528, 0x533, 0x540, 0x546, 0x550, 0x569, 0x56b, 0x570, 0x574, 0x578,
+	0x588, 0x58f, 0x5a1,
+}
+
+const deScriptStr = "" +
+	"ArabischArmiArmenischAvestischBalinesischBattakischBengalischBliss-SymboleBopomo" +
+	"foBrahmiBlindenschriftBuginesischBuhidUCASKarischChamCherokeeCirthKoptischZyprio" +
+	"tischKyrillischAltkirchenslawischDevanagariDeseretÄgyptisch - DemotischÄgyptisch" +
+	" - HieratischÄgyptische HieroglyphenÄthiopischKhutsuriGeorgischGlagolitischGotis" +
+	"chGriechischGujaratiGurmukhiHangulChinesischHanunooVereinfachtes ChinesischTradi" +
+	"tionelles ChinesischHebräischHiraganaPahawh HmongKatakana oder HiraganaAltungari" +
+	"schIndus-SchriftAltitalischJavanesischJapanischKayah LiKatakanaKharoshthiKhmerKa" +
+	"nnadaKoreanischLannaLaotischLateinisch - Fraktur-VarianteLateinisch - Gälische V" +
+	"arianteLateinischLepchaLimbuLinear ALinear BLykischLydischMandäischManichäischMa" +
+	"ya-HieroglyphenMeroitischMalaysischMongolischMoonMeitei MayekBirmanischN’KoOgham" +
+	"Ol ChikiOrchon-RunenOriyaOsmanischAltpermischPhags-paPahlaviPhönizischPollard Ph" +
+	"onetischRejangRongorongoRunenschriftSamaritanischSaratiSaurashtraGebärdensprache" +
+	"Shaw-AlphabetSinghalesischSundanesischSyloti NagriSyrischSyrisch - Estrangelo-Va" +
+	"rianteWestsyrischOstsyrischTagbanwaTai LeTai LueTamilischTeluguTengwarTifinaghTa" +
+	"galogThaanaThaiTibetischUgaritischVaiSichtbare SpracheAltpersischSumerisch-akkad" +
+	"ische KeilschriftYiGeerbter SchriftwertSymboleSchriftlosUnbestimmtUnbekannte Sch" +
+	"rift"
+
+var deScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0xc, 0x15, 0x1e, 0x29, 0x29, 0x29, 0x33, 0x3d, 0x4a,
+	0x52, 0x58, 0x66, 0x71, 0x76, 0x76, 0x7a, 0x81, 0x85, 0x8d, 0x92, 0x9a,
+	0xa5, 0xaf, 0xc1, 0xcb, 0xd2, 0xd2, 0xe8, 0xff, 0x117, 0x122, 0x12a, 0x133,
+	0x13f, 0x146, 0x146, 0x150, 0x158, 0x160, 0x166, 0x170, 0x177, 0x18f, 0x1a8, 0x1b2,
+	0x1ba, 0x1ba, 0x1c6, 0x1dc, 0x1e8, 0x1f5, 0x200, 0x20b, 0x214, 0x214, 0x21c, 0x224,
+	0x22e, 0x233, 0x233, 0x23a, 0x244, 0x244, 0x244, 0x249, 0x251, 0x26e, 0x28d, 0x297,
+	0x29d, 0x2a2, 0x2aa, 0x2b2, 0x2b2, 0x2b2, 0x2b9, 0x2c0, 0x2ca, 0x2d6, 0x2e7, 0x2e7,
+	0x2e7, 0x2f1, 0x2fb, 0x305, 0x309, 0x309, 0x315, 0x31f, 0x31f, 0x31f, 0x31f, 0x325,
+	0x325, 0x32a, 0x332, 0x33e, 0x343, 0x34c, 0x34c, 0x357, 0x35f, 0x35f, 0x35f, 0x366,
+	0x371, 0x383, 0x383, 0x389, 0x393, 0x39f, 0x3ac, 0x3b2, 0x3b2, 0x3bc, 0x3cc, 0x3d9,
+	0x3d9, 0x3d9, 0x3e6, 0x3e6, 0x3f2, 0x3fe, 0x405, 0x422, 0x42d, 0x437, 0x43f, 0x43f,
+	0x445, 0x44c, 0x455, 0x455, 0x455, 0x45b, 0x462, 0x46a, 0x471, 0x477, 0x47b, 0x484,
+	0x484, 0x48e, 0x491, 0x4a2, 0x4a2, 0x4a2, 0x4ad, 0x4cd, 0x4cf, 0x4e3, 0x4e3, 0x4ea,
+	0x4f4, 0x4fe, 0x510,
+}
+
+const elScriptStr = "" +
+	"ΑραβικόΑυτοκρατορικό ΑραμαϊκόΑρμενικόΑβεστάνΜπαλινίζΜπατάκΜπενγκάλιΣύμβολα Bliss" +
+	"ΜποπομόφοΜπραχμίΜπράιγΜπούγκιςΜπουχίντΤσάκμαΕνοποιημένοι Καναδεζικοί Συλλαβισμοί" +
+	" ΙθαγενώνΚαριάνΤσαμΤσερόκιΣερθΚοπτικόΚυπριακόΚυριλλικόΠαλαιό Εκκλησιαστικό Σλαβι" +
+	"κό ΚυριλλικόΝτεβαναγκάριΝτεσερέΛαϊκό ΑιγυπτιακόΙερατικό ΑιγυπτιακόΑιγυπτιακά Ιερ" +
+	"ογλυφικάΑιθιοπικόΓεωργιανό ΚχουτσούριΓεωργιανόΓκλαγκολιτικόΓοτθικόΕλληνικόΓκουγι" +
+	"αράτιΓκουρμουκχίΧανγκούλΧανΧανούνουΑπλοποιημένο ΧανΠαραδοσιακό ΧανΕβραϊκόΧιραγκά" +
+	"ναΠαχάχ ΧμονγκΚατακάνα ή ΧιραγκάναΠαλαιό ΟυγγρικόΊνδουςΠαλαιό ΙταλικόΙαβανεζικόΙ" +
+	"απωνικόΚαγιάχ ΛιΚατακάναΚαρόσθιΧμερΚανάνταΚορεατικόΚαϊθίΛάνναΛάοςΦράκτουρ Λατινι" +
+	"κόΓαελικό ΛατινικόΛατινικόΛέπτσαΛιμπούΓραμμικό ΑΓραμμικό ΒΛυκιανικόΛυδιανικόΜανδ" +
+	"αϊκόΜανιχαϊκόΙερογλυφικά ΜάγιαΜεροϊτικόΜαλαγιάλαμΜογγολικόΜουνΜεϊτέι ΜάγεκΜιανμά" +
+	"ρΝ'ΚοΌγκχαμΟλ ΤσίκιΌρκχονΟρίγιαΟσμάνγιαΠαλαιό ΠερμικόΠαγκς-παΕπιγραφικό ΠαχλάβιΨ" +
+	"άλτερ ΠαχλάβιΜπουκ ΠαχλαβίΦοινικικόΦωνητικό ΠόλαρντΕπιγραφικό ΠαρθιάνΡετζάνγκΡον" +
+	"γκορόνγκοΡουνίκΣαμαριτικόΣαράθιΣαουράστραΝοηματική γραφήΣαβιανόΣινχάλαΣουδανεζικ" +
+	"όΣυλότι ΝάγκριΣυριακόΕστραντζέλο ΣυριακόΔυτικό ΣυριακόΑνατολικό ΣυριακόΤαγκμάνγο" +
+	"υαΤάι ΛεΝέο Τάι ΛούεΤαμίλΤάι ΒιέτΤελούγκουΤεγνγουάρΤιφινάγκΤαγκαλόγκΘαανάΤαϊλανδ" +
+	"ικόΘιβετιανόΟυγκαριτικόΒάιΟρατή ομιλίαΠαλαιό ΠερσικόΣούμερο-Ακάντιαν ΚουνεϊφόρμΓ" +
+	"ιΚληρονομημένοΜαθηματική παράστασηΣύμβολαΆγραφοΚοινόΆγνωστη γραφή"
+
+var elScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xe, 0x39, 0x49, 0x57, 0x67, 0x67, 0x67, 0x73, 0x85, 0x99,
+	0xab, 0xb9, 0xc5, 0xd5, 0xe5, 0xf1, 0x148, 0x154, 0x15c, 0x16a, 0x172, 0x180,
+	0x190, 0x1a2, 0x1eb, 0x203, 0x211, 0x211, 0x230, 0x255, 0x280, 0x292, 0x2b9, 0x2cb,
+	0x2e5, 0x2f3, 0x2f3, 0x303, 0x319, 0x32f, 0x33f, 0x345, 0x355, 0x374, 0x391, 0x39f,
+	0x3b1, 0x3b1, 0x3c8, 0x3ee, 0x40b, 0x417, 0x432, 0x446, 0x456, 0x456, 0x467, 0x477,
+	0x485, 0x48d, 0x48d, 0x49b, 0x4ad, 0x4ad, 0x4b7, 0x4c1, 0x4c9, 0x4ea, 0x509, 0x519,
+	0x525, 0x531, 0x544, 0x557, 0x557, 0x557, 0x569, 0x57b, 0x58b, 0x59d, 0x5be, 0x5be,
+	0x5be, 0x5d0, 0x5e4, 0x5f6, 0x5fe, 0x5fe, 0x615, 0x623, 0x623, 0x623, 0x623, 0x62a,
+	0x62a, 0x636, 0x645, 0x651, 0x65d, 0x66d, 0x66d, 0x688, 0x697, 0x6ba, 0x6d5, 0x6ee,
+	0x700, 0x71f, 0x742, 0x752, 0x76a, 0x776, 0x78a, 0x796, 0x796, 0x7aa, 0x7c7, 0x7d5,
+	0x7d5, 0x7d5, 0x7e3, 0x7e3, 0x7f9, 0x812, 0x820, 0x845, 0x860, 0x881, 0x897, 0x897,
+	0x8a2, 0x8b8, 0x8c2, 0x8c2, 0x8d1, 0x8e3, 0x8f5, 0x905, 0x917, 0x921, 0x935, 0x947,
+	0x947, 0x95d, 0x963, 0x97a, 0x97a, 0x97a, 0x995, 0x9c9, 0x9cd, 0x9e7, 0xa0e, 0xa1c,
+	0xa28, 0xa32, 0xa4b,
+}
+
+const enScriptStr = "" +
+	"AfakaArabicImperial AramaicArmenianAvestanBalineseBamumBassa VahBatakBengaliBlis" +
+	"symbolsBopomofoBrahmiBrailleBugineseBuhidChakmaUnified Canadian Aboriginal Sylla" +
+	"bicsCarianChamCherokeeCirthCopticCypriotCyrillicOld Church Slavonic CyrillicDeva" +
+	"nagariDeseretDuployan shorthandEgyptian demoticEgyptian hieraticEgyptian hierogl" +
+	"yphsEthiopicGeorgian KhutsuriGeorgianGlagoliticGothicGranthaGreekGujaratiGurmukh" +
+	"iHangulHanHanunooSimplified HanTraditional HanHebrewHiraganaAnatolian Hieroglyph" +
+	"sPahawh HmongJapanese syllabariesOld HungarianIndusOld ItalicJavaneseJapaneseJur" +
+	"chenKayah LiKatakanaKharoshthiKhmerKhojkiKannadaKoreanKpelleKaithiLannaLaoFraktu" +
+	"r LatinGaelic LatinLatinLepchaLimbuLinear ALinear BFraserLomaLycianLydianMandaea" +
+	"nManichaeanMayan hieroglyphsMendeMeroitic CursiveMeroiticMalayalamMongolianMoonM" +
+	"roMeitei MayekMyanmarOld North ArabianNabataeanNaxi GebaN’KoNüshuOghamOl ChikiOr" +
+	"khonOriyaOsmanyaPalmyreneOld PermicPhags-paInscriptional PahlaviPsalter PahlaviB" +
+	"ook PahlaviPhoenicianPollard PhoneticInscriptional ParthianRejangRongorongoRunic" +
+	"SamaritanSaratiOld South ArabianSaurashtraSignWritingShavianSharadaKhudawadiSinh" +
+	"alaSora SompengSundaneseSyloti NagriSyriacEstrangelo SyriacWestern SyriacEastern" +
+	" SyriacTagbanwaTakriTai LeNew Tai LueTamilTangutTai VietTeluguTengwarTifinaghTag" +
+	"alogThaanaThaiTibetanTirhutaUgariticVaiVisible SpeechVarang KshitiWoleaiOld Pers" +
+	"ianSumero-Akkadian CuneiformYiInheritedMathematical NotationSymbolsUnwrittenComm" +
+	"onUnknown Script"
+
+var enScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0xb, 0x1b, 0x23, 0x2a, 0x32, 0x37, 0x40, 0x45, 0x4c, 0x57,
+	0x5f, 0x65, 0x6c, 0x74, 0x79, 0x7f, 0xa4, 0xaa, 0xae, 0xb6, 0xbb, 0xc1,
+	0xc8, 0xd0, 0xec, 0xf6, 0xfd, 0x10f, 0x11f, 0x130, 0x144, 0x14c, 0x15d, 0x165,
+	0x16f, 0x175, 0x17c, 0x181, 0x189, 0x191, 0x197, 0x19a, 0x1a1, 0x1af, 0x1be, 0x1c4,
+	0x1cc, 0x1e1, 0x1ed, 0x201, 0x20e, 0x213, 0x21d, 0x225, 0x22d, 0x234, 0x23c, 0x244,
+	0x24e, 0x253, 0x259, 0x260, 0x266, 0x26c, 0x272, 0x277, 0x27a, 0x287, 0x293, 0x298,
+	0x29e, 0x2a3, 0x2ab, 0x2b3, 0x2b9, 0x2bd, 0x2c3, 0x2c9, 0x2d1, 0x2db, 0x2ec, 0x2f1,
+	0x301, 0x309, 0x312, 0x31b, 0x31f, 0x322, 0x32e, 0x335, 0x346, 0x34f, 0x358, 0x35e,
+	0x364, 0x369, 0x371, 0x377, 0x37c, 0x383, 0x38c, 0x396, 0x39e, 0x3b3, 0x3c2, 0x3ce,
+	0x3d8, 0x3e8, 0x3fe, 0x404, 0x40e, 0x413, 0x41c, 0x422, 0x433, 0x43d, 0x448, 0x44f,
+	0x456, 0x45f, 0x466, 0x472, 0x47b, 0x487, 0x48d, 0x49e, 0x4ac, 0x4ba, 0x4c2, 0x4c7,
+	0x4cd, 0x4d8, 0x4dd, 0x4e3, 0x4eb, 0x4f1, 0x4f8, 0x500, 0x507, 0x50d, 0x511, 0x518,
+	0x51f, 0x527, 0x52a, 0x538, 0x545, 0x54b, 0x556, 0x56f, 0x571, 0x57a, 0x58f, 0x596,
+	0x59f, 0x5a5, 0x5b3,
+}
+
+const enGBScriptStr = "" +
+	"Thai"
+
+var enGBScriptIdx = []uint16{ // 143 entries
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4,
+}
+
+const esScriptStr = "" +
+	"árabearmenioavésticobalinésbatakbengalísímbolos blisbopomofobrahmibraillebuginés" +
+	"buhidsímbolos aborígenes canadienses unificadoscariochamcherokeecirthcoptochipri" +
+	"otacirílicocirílico del antiguo eslavo eclesiásticodevanagarideseretegipcio demó" +
+	"ticoegipcio hieráticojeroglíficos egipciosetiópicogeorgiano eclesiásticogeorgian" +
+	"oglagolíticogóticogriegogujaratigurmujihangulhanhanunoohanzi simplificadohanzi t" +
+	"radicionalhebreohiraganapahawh hmongkatakana o hiraganahúngaro antiguoIndio (har" +
+	"appan)antigua bastardillajavanésjaponéskayah likatakanakharosthijemercanaréscore" +
+	"anolannalaolatino frakturlatino gaélicolatínlepchalimbulineal Alineal Bliciolidi" +
+	"omandeojeroglíficos mayasmeroíticomalayálammongolmoonmanipuribirmanon’kooghamol " +
+	"cikiorkhonoriyaosmaniyapermiano antiguophags-pafenicioPollard Miaorejangrongo-ro" +
+	"ngorúnicosaratisaurashtraSignWritingshavianobinhalasundanéssyloti nagrisiriacosi" +
+	"riaco estrangelosiriaco occidentalsiriaco orientaltagbanúatai lenuevo tai luetam" +
+	"iltelugutengwartifinaghtagalothaanatailandéstibetanougaríticovailenguaje visible" +
+	"persa antiguocuneiforme sumerio-acadioyiheredadosímbolosno escritocomúnalfabeto " +
+	"desconocido"
+
+var esScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0x6, 0xd, 0x16, 0x1e, 0x1e, 0x1e, 0x23, 0x2b, 0x39,
+	0x41, 0x47, 0x4e, 0x56, 0x5b, 0x5b, 0x87, 0x8c, 0x90, 0x98, 0x9d, 0xa2,
+	0xab, 0xb4, 0xde, 0xe8, 0xef, 0xef, 0x100, 0x112, 0x128, 0x131, 0x148, 0x151,
+	0x15d, 0x164, 0x164, 0x16a, 0x172, 0x179, 0x17f, 0x182, 0x189, 0x19b, 0x1ac, 0x1b2,
+	0x1ba, 0x1ba, 0x1c6, 0x1d9, 0x1e9, 0x1f9, 0x20c, 0x214, 0x21c, 0x21c, 0x224, 0x22c,
+	0x235, 0x23a, 0x23a, 0x242, 0x249, 0x249, 0x249, 0x24e, 0x251, 0x25f, 0x26e, 0x274,
+	0x27a, 0x27f, 0x287, 0x28f, 0x28f, 0x28f, 0x294, 0x299, 0x29f, 0x29f, 0x2b2, 0x2b2,
+	0x2b2, 0x2bc, 0x2c6, 0x2cc, 0x2d0, 0x2d0, 0x2d8, 0x2df, 0x2df, 0x2df, 0x2df, 0x2e5,
+	0x2e5, 0x2ea, 0x2f1, 0x2f7, 0x2fc, 0x304, 0x304, 0x314, 0x31c, 0x31c, 0x31c, 0x31c,
+	0x323, 0x32f, 0x32f, 0x335, 0x340, 0x347, 0x347, 0x34d, 0x34d, 0x357, 0x362, 0x36a,
+	0x36a, 0x36a, 0x371, 0x371, 0x37a, 0x386, 0x38d, 0x39f, 0x3b1, 0x3c1, 0x3ca, 0x3ca,
+	0x3d0, 0x3dd, 0x3e2, 0x3e2, 0x3e2, 0x3e8, 0x3ef, 0x3f7, 0x3fd, 0x403, 0x40d, 0x415,
+	0x415, 0x41f, 0x422, 0x432, 0x432, 0x432, 0x43f, 0x458, 0x45a, 0x462, 0x462, 0x46b,
+	0x475, 0x47b, 0x48f,
+}
+
+const es419ScriptStr = "" +
+	"Han simplificadoHan tradicional"
+
+var es419ScriptIdx = []uint16{ // 47 entries
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x10, 0x1f,
+}
+
+const etScriptStr = "" +
+	"araabiaarmeeniabalibatakibengaliBlissi sümbolidbopomofobrahmipunktkiribugibuhidi" +
+	"Kanada põlisrahvaste ühtlustatud silpkiritšamitšerokiicirthkoptimuinasküprosekir" +
+	"illitsakirikuslaavi kirillitsadevanaagarideseretegiptuse demootilineegiptuse hie" +
+	"raatilineegiptuse hieroglüüfkirietioopiagruusia hutsurigruusiaglagoolitsagootikr" +
+	"eekagudžaratigurmukhihangulihiina hanhanunoolihtsustatud hantraditsiooniline han" +
+	"heebreahiraganakatakana või hiraganavanaungariindusevanaitalijaavajaapanikaja li" +
+	"katakanakharoshthikhmeerikannadakoreanilaoladina fraktuurkiriladina keldi kirila" +
+	"dinaleptšalimbulineaar-Alineaar-Bmandeamaaja hieroglüüfkirimeroemalajalamimongol" +
+	"ibirmankooogamorhonioriaosmanjavanapermiphags-pafoiniikiaPollardi miaorongorongo" +
+	"ruunikirisaratiShaw tähestiksingalisilotinagriassüüriaassüüria estrangelolääneas" +
+	"süüriaidaassüüriatagbanwatai lelihtsustatud tai luetamilitelugutengwartifinagita" +
+	"galogithaanataitiibetiugaritivaihäälduskirivanapärsiasumeri kiilkirijiipäritudsü" +
+	"mbolidkirjakeeletaüldinemääramata"
+
+var etScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x7, 0x7, 0xf, 0xf, 0x13, 0x13, 0x13, 0x19, 0x20, 0x30,
+	0x38, 0x3e, 0x47, 0x4b, 0x51, 0x51, 0x7c, 0x7c, 0x82, 0x8b, 0x90, 0x95,
+	0xa3, 0xad, 0xc4, 0xcf, 0xd6, 0xd6, 0xea, 0xff, 0x118, 0x120, 0x12f, 0x136,
+	0x141, 0x146, 0x146, 0x14c, 0x156, 0x15e, 0x165, 0x16e, 0x175, 0x185, 0x199, 0x1a0,
+	0x1a8, 0x1a8, 0x1a8, 0x1be, 0x1c8, 0x1ce, 0x1d7, 0x1dc, 0x1e3, 0x1e3, 0x1ea, 0x1f2,
+	0x1fc, 0x203, 0x203, 0x20a, 0x211, 0x211, 0x211, 0x211, 0x214, 0x227, 0x238, 0x23e,
+	0x245, 0x24a, 0x253, 0x25c, 0x25c, 0x25c, 0x25c, 0x25c, 0x262, 0x262, 0x278, 0x278,
+	0x278, 0x27d, 0x287, 0x28e, 0x28e, 0x28e, 0x28e, 0x293, 0x293, 0x293, 0x293, 0x297,
+	0x297, 0x29b, 0x29b, 0x2a1, 0x2a5, 0x2ac, 0x2ac, 0x2b5, 0x2bd, 0x2bd, 0x2bd, 0x2bd,
+	0x2c6, 0x2d3, 0x2d3, 0x2d3, 0x2dd, 0x2e6, 0x2e6, 0x2ec, 0x2ec, 0x2ec, 0x2ec, 0x2fa,
+	0x2fa, 0x2fa, 0x301, 0x301, 0x301, 0x30c, 0x316, 0x32b, 0x33c, 0x349, 0x351, 0x351,
+	0x357, 0x36b, 0x371, 0x371, 0x371, 0x377, 0x37e, 0x386, 0x38e, 0x394, 0x397, 0x39e,
+	0x39e, 0x3a5, 0x3a8, 0x3b5, 0x3b5, 0x3b5, 0x3c0, 0x3cf, 0x3d2, 0x3da, 0x3da, 0x3e3,
+	0x3ef, 0x3f6, 0x401,
+}
+
+const faScriptStr = "" +
+	"عربیآرامی هخامنشیارمنیاوستاییبالیاییباتاکیبنگالینمادهای بلیسبوپوموفوبراهمیبریلبو" +
+	"گیاییبوهیدکاکمکاریچمیچروکیاییکرتقبطیقبرسیسیریلیدوناگریدیسرتیکاهنی مصریهیروگلیف م" +
+	"صریاتیوپیاییگرجی خوتسوریگرجیگلاگولیتیکگوتیکیونانیگجراتیگورومخیهانگولهانهانونوییه" +
+	"ان ساده‌شدههان سنتیعبریهیراگاناهیروگلیف‌های آناتولیسیلابی‌های ژاپنیمجاری باستانا" +
+	"یندوسایتالی باستانجاوه‌ایژاپنیکایالیکاتاکاناخمریخواجکیکاناده‌ایکره‌ایکثیلاناییلا" +
+	"ئوسیلاتینی فراکتورلاتینی گیلیلاتینیلیمباییخطی الفخطی بلسیاییلدیاییمنده‌ایمانویهی" +
+	"روگلیف مایاییمروییتیمالایالامیمغولیمونیمایک میتیمیانمارعربی شمالی باستاننبطیاوگا" +
+	"میاورخونیاوریه‌ایپالمیراییپرمی باستانپهلوی کتیبه‌ایپهلوی زبوریپهلوی کتابیفنیقیپا" +
+	"رتی کتیبه‌ایرجنگیرونیسامریساراتیعربی جنوبی باستانسوراشتراییشاویسینهالیسیلوتی نگا" +
+	"ریسریانیسریانی سطرنجیلیسریانی غربیسریانی شرقیتگبنواییتامیلیتلوگوییتنگوارتیفیناغی" +
+	"تاگالوگیتانه‌ایتایلندیتبتیاوگاریتیویاییگفتار قابل مشاهدهفارسی باستانمیخی سومری‐آ" +
+	"کدیییموروثیعلائم ریاضیعلائمنانوشتهمشترکخط نامشخص"
+
+var faScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0x21, 0x2b, 0x39, 0x47, 0x47, 0x47, 0x53, 0x5f, 0x76,
+	0x86, 0x92, 0x9a, 0xa8, 0xb2, 0xba, 0xba, 0xc2, 0xc8, 0xd8, 0xde, 0xe6,
+	0xf0, 0xfc, 0xfc, 0x10a, 0x116, 0x116, 0x116, 0x129, 0x142, 0x154, 0x16b, 0x173,
+	0x187, 0x191, 0x191, 0x19d, 0x1a9, 0x1b7, 0x1c3, 0x1c9, 0x1d9, 0x1f1, 0x200, 0x208,
+	0x218, 0x240, 0x240, 0x260, 0x277, 0x283, 0x29c, 0x2ab, 0x2b5, 0x2b5, 0x2c1, 0x2d1,
+	0x2d1, 0x2d9, 0x2e5, 0x2f8, 0x305, 0x305, 0x30b, 0x317, 0x323, 0x33e, 0x353, 0x35f,
+	0x35f, 0x36d, 0x37a, 0x383, 0x383, 0x383, 0x38f, 0x39b, 0x3aa, 0x3b4, 0x3d1, 0x3d1,
+	0x3d1, 0x3df, 0x3f3, 0x3fd, 0x405, 0x405, 0x416, 0x424, 0x444, 0x44c, 0x44c, 0x44c,
+	0x44c, 0x458, 0x458, 0x466, 0x477, 0x477, 0x489, 0x49e, 0x49e, 0x4ba, 0x4cf, 0x4e4,
+	0x4ee, 0x4ee, 0x50a, 0x514, 0x514, 0x51c, 0x526, 0x532, 0x552, 0x566, 0x566, 0x56e,
+	0x56e, 0x56e, 0x57c, 0x57c, 0x57c, 0x593, 0x59f, 0x5bc, 0x5d1, 0x5e6, 0x5f6, 0x5f6,
+	0x5f6, 0x5f6, 0x602, 0x602, 0x602, 0x610, 0x61c, 0x62c, 0x63c, 0x64b, 0x659, 0x661,
+	0x661, 0x671, 0x67b, 0x69b, 0x69b, 0x69b, 0x6b2, 0x6d0, 0x6d4, 0x6e0, 0x6f5, 0x6ff,
+	0x70d, 0x717, 0x728,
+}
+
+const fiScriptStr = "" +
+	"afakaarabialainenvaltakunnanaramealainenarmenialainenavestalainenbalilainenbamum" +
+	"bassabatakilainenbengalilainenbliss-symbolitbopomofobrahmibraille-pistekirjoitus" +
+	"bugilainenbuhidilainenchakmalainenkanadalaisten alkuperäiskansojen yhtenäistetty" +
+	" tavukirjoituskaarialainentšamilainencherokeelainencirthkoptilainenmuinaiskypros" +
+	"lainenkyrillinenkyrillinen muinaiskirkkoslaavimuunnelmadevanagarideseretDuployén" +
+	" pikakirjoitusegyptiläinen demoottinenegyptiläinen hieraattinenegyptiläiset hier" +
+	"oglyfitetiopialainenmuinaisgeorgialainengeorgialainenglagoliittinengoottilaineng" +
+	"ranthakreikkalainengudžaratilainengurmukhihangulkiinalainen hanhanunoolainenyksi" +
+	"nkertaistettu hanperinteinen hanheprealainenhiraganaanatolialaiset hieroglyfitpa" +
+	"hawh hmonghiragana tai katakanamuinaisunkarilaineninduslainenmuinaisitalialainen" +
+	"jaavalainenjapanilainendžurtšenkayah likatakanakharosthikhmeriläinenkhojkikannad" +
+	"alainenkorealainenkpellekaithilannalaolainenlatinalainen fraktuuramuunnelmalatin" +
+	"alainen gaelimuunnelmalatinalainenlepchalainenlimbulainenlineaari-Alineaari-BFra" +
+	"serin aakkosetlomalyykialainenlyydialainenmandealainenmanikealainenmaya-hierogly" +
+	"fitmendemeroiittinen kursiivikirjoitusmeroiittinenmalajalamilainenmongolilainenm" +
+	"oon-kohokirjoitusmromeiteiburmalainenmuinaispohjoisarabialainennabatealainennaxi" +
+	" geban'konüshuogamol chikiorkhonorijalainenosmanjalainenpalmyralainenmuinaisperm" +
+	"iläinenphags-papiirtokirjoituspahlavilainenpsalttaripahlavilainenkirjapahlavilai" +
+	"nenfoinikialainenPollardin foneettinenpiirtokirjoitusparthialainenrejangrongoron" +
+	"goriimukirjoitussamarianaramealainensaratimuinaiseteläarabialainensaurashtraSign" +
+	"Writingshaw’lainensaradakhudabadisinhalilainensorang sompengsundalainensyloti na" +
+	"grisyyrialainensyyrialainen estrangelo-muunnelmasyyrialainen läntinen muunnelmas" +
+	"yyrialainen itäinen muunnelmatagbanwalainentakritailelainenuusi tailuelainentami" +
+	"lilainentanguttai viettelugulainentengwartifinaghtagalogilainenthaanathailainent" +
+	"iibetiläinentirhutaugaritilainenvailainennäkyvä puhevarang kshitiwoleaimuinaispe" +
+	"rsialainensumerilais-akkadilainen nuolenpääkirjoitusyiläinenperittymatemaattinen" +
+	"symbolitkirjoittamatonmäärittämätöntuntematon kirjoitusjärjestelmä"
+
+var fiScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0x11, 0x28, 0x35, 0x41, 0x4b, 0x50, 0x55, 0x61, 0x6e, 0x7c,
+	0x84, 0x8a, 0xa0, 0xaa, 0xb6, 0xc2, 0x100, 0x10c, 0x118, 0x126, 0x12b, 0x136,
+	0x149, 0x153, 0x17a, 0x184, 0x18b, 0x1a2, 0x1bb, 0x1d5, 0x1ee, 0x1fb, 0x20f, 0x21c,
+	0x22a, 0x236, 0x23d, 0x24a, 0x25a, 0x262, 0x268, 0x277, 0x284, 0x299, 0x2a8, 0x2b4,
+	0x2bc, 0x2d6, 0x2e2, 0x2f7, 0x30a, 0x315, 0x328, 0x333, 0x33f, 0x349, 0x351, 0x359,
+	0x362, 0x36f, 0x375, 0x382, 0x38d, 0x393, 0x399, 0x39e, 0x3a7, 0x3c6, 0x3e1, 0x3ed,
+	0x3f9, 0x404, 0x40e, 0x418, 0x429, 0x42d, 0x439, 0x445, 0x451, 0x45e, 0x46e, 0x473,
+	0x491, 0x49d, 0x4ad, 0x4ba, 0x4cc, 0x4cf, 0x4d5, 0x4e0, 0x4fa, 0x507, 0x510, 0x514,
+	0x51a, 0x51e, 0x526, 0x52c, 0x537, 0x544, 0x551, 0x564, 0x56c, 0x588, 0x59e, 0x5b0,
+	0x5be, 0x5d3, 0x5ef, 0x5f5, 0x5ff, 0x60d, 0x621, 0x627, 0x640, 0x64a, 0x655, 0x662,
+	0x668, 0x671, 0x67e, 0x68c, 0x697, 0x6a3, 0x6af, 0x6d0, 0x6f0, 0x70f, 0x71d, 0x722,
+	0x72d, 0x73e, 0x74a, 0x750, 0x758, 0x764, 0x76b, 0x773, 0x781, 0x787, 0x791, 0x79f,
+	0x7a6, 0x7b3, 0x7bc, 0x7c9, 0x7d6, 0x7dc, 0x7ef, 0x81b, 0x824, 0x82b, 0x838, 0x840,
+	0x84e, 0x860, 0x881,
+}
+
+const filScriptStr = "" +
+	"ArabicArmenianBengaliBopomofoBrailleCyrillicDevanagariEthiopicGeorgianGreekGujar" +
+	"atiGurmukhiHangulHanSimplified HanTraditional HanHebrewHiraganaJapaneseKatakanaK" +
+	"hmerKannadaKoreanLaoLatinMalayalamMongolianMyanmarOriyaSinhalaTamilTeluguThaanaT" +
+	"haiTibetanMga SimboloUnwrittenCommonHindi Kilalang Script"
+
+var filScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0x6, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0x15, 0x15,
+	0x1d, 0x1d, 0x24, 0x24, 0x24, 0x24, 0x24, 0x24, 0x24, 0x24, 0x24, 0x24,
+	0x24, 0x2c, 0x2c, 0x36, 0x36, 0x36, 0x36, 0x36, 0x36, 0x3e, 0x3e, 0x46,
+	0x46, 0x46, 0x46, 0x4b, 0x53, 0x5b, 0x61, 0x64, 0x64, 0x72, 0x81, 0x87,
+	0x8f, 0x8f, 0x8f, 0x8f, 0x8f, 0x8f, 0x8f, 0x8f, 0x97, 0x97, 0x97, 0x9f,
+	0x9f, 0xa4, 0xa4, 0xab, 0xb1, 0xb1, 0xb1, 0xb1, 0xb4, 0xb4, 0xb4, 0xb9,
+	0xb9, 0xb9, 0xb9, 0xb9, 0xb9, 0xb9, 0xb9, 0xb9, 0xb9, 0xb9, 0xb9, 0xb9,
+	0xb9, 0xb9, 0xc2, 0xcb, 0xcb, 0xcb, 0xcb, 0xd2, 0xd2, 0xd2, 0xd2, 0xd2,
+	0xd2, 0xd2, 0xd2, 0xd2, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7,
+	0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7, 0xd7,
+	0xd7, 0xd7, 0xde, 0xde, 0xde, 0xde, 0xde, 0xde, 0xde, 0xde, 0xde, 0xde,
+	0xde, 0xde, 0xe3, 0xe3, 0xe3, 0xe9, 0xe9, 0xe9, 0xe9, 0xef, 0xf3, 0xfa,
+	0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0x105,
+	0x10e, 0x114, 0x129,
+}
+
+const frScriptStr = "" +
+	"arabearaméen impérialarménienavestiquebalinaisbatakbengalisymboles Blissbopomofo" +
+	"brâhmîbraillebouguisbouhidechakmasyllabaire autochtone canadien unifiécariencham" +
+	"cherokeecirthcoptesyllabaire chypriotecyrilliquecyrillique (variante slavonne)dé" +
+	"vanâgarîdéséretdémotique égyptienhiératique égyptienhiéroglyphes égyptienséthiop" +
+	"iquegéorgien khoutsourigéorgienglagolitiquegotiquegrecgoudjarâtîgourmoukhîhangûl" +
+	"sinogrammeshanounóosinogrammes simplifiéssinogrammes traditionnelshébreuhiragana" +
+	"pahawh hmongkatakana ou hiraganaancien hongroisindusancien italiquejavanaisjapon" +
+	"aiskayah likatakanakharochthîkhmerkannaracoréenkaithîlannalaolatin (variante bri" +
+	"sée)latin (variante gaélique)latinlepchalimboulinéaire Alinéaire Blycienlydienma" +
+	"ndéenmanichéenhiéroglyphes mayasméroïtiquemalayalammongolmoonmeitei mayekbirmann" +
+	"’koogamol tchikiorkhonoriyaosmanaisancien permienphags papehlevi des inscription" +
+	"spehlevi des psautierspehlevi des livresphénicienphonétique de Pollardparthe des" +
+	" inscriptionsrejangrongorongoruniquesamaritainsaratisaurashtraécriture des signe" +
+	"sshaviencinghalaissundanaissylotî nâgrîsyriaquesyriaque estranghélosyriaque occi" +
+	"dentalsyriaque orientaltagbanouataï-lenouveau taï-luetamoultaï viêttélougoutengw" +
+	"artifinaghtagalthânathaïtibétainougaritiquevaïparole visiblecunéiforme persépoli" +
+	"taincunéiforme suméro-akkadienyihériténotation mathématiquesymbolesnon écritcomm" +
+	"unécriture inconnue"
+
+var frScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x5, 0x17, 0x20, 0x29, 0x31, 0x31, 0x31, 0x36, 0x3d, 0x4b,
+	0x53, 0x5b, 0x62, 0x69, 0x70, 0x76, 0x9c, 0xa2, 0xa6, 0xae, 0xb3, 0xb8,
+	0xcc, 0xd6, 0xf4, 0x101, 0x10a, 0x10a, 0x11e, 0x133, 0x14b, 0x156, 0x16a, 0x173,
+	0x17f, 0x186, 0x186, 0x18a, 0x196, 0x1a1, 0x1a8, 0x1b3, 0x1bc, 0x1d3, 0x1ec, 0x1f3,
+	0x1fb, 0x1fb, 0x207, 0x21b, 0x22a, 0x22f, 0x23e, 0x246, 0x24e, 0x24e, 0x256, 0x25e,
+	0x269, 0x26e, 0x26e, 0x275, 0x27c, 0x27c, 0x283, 0x288, 0x28b, 0x2a3, 0x2bd, 0x2c2,
+	0x2c8, 0x2ce, 0x2d9, 0x2e4, 0x2e4, 0x2e4, 0x2ea, 0x2f0, 0x2f8, 0x302, 0x315, 0x315,
+	0x315, 0x321, 0x32a, 0x330, 0x334, 0x334, 0x340, 0x346, 0x346, 0x346, 0x346, 0x34c,
+	0x34c, 0x350, 0x359, 0x35f, 0x364, 0x36c, 0x36c, 0x37a, 0x382, 0x39a, 0x3af, 0x3c1,
+	0x3cb, 0x3e1, 0x3f8, 0x3fe, 0x408, 0x40f, 0x419, 0x41f, 0x41f, 0x429, 0x43d, 0x444,
+	0x444, 0x444, 0x44e, 0x44e, 0x457, 0x466, 0x46e, 0x483, 0x496, 0x4a7, 0x4b0, 0x4b0,
+	0x4b7, 0x4c7, 0x4cd, 0x4cd, 0x4d7, 0x4e0, 0x4e7, 0x4ef, 0x4f4, 0x4fa, 0x4ff, 0x508,
+	0x508, 0x513, 0x517, 0x525, 0x525, 0x525, 0x53f, 0x55b, 0x55d, 0x565, 0x57b, 0x583,
+	0x58d, 0x593, 0x5a5,
+}
+
+const frCAScriptStr = "" +
+	"bengâglîchinois simplifiéchinois traditionnelmalayâlamoriyâcingalais"
+
+var frCAScriptIdx = []uint16{ // 123 entries
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0xa,
+	0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa,
+	0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa,
+	0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0xa, 0x1c, 0x30, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x3a, 0x3a, 0x3a, 0x3a, 0x3a, 0x3a, 0x3a, 0x3a, 0x3a, 0x3a,
+	0x3a, 0x3a, 0x3a, 0x3a, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40,
+	0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40,
+	0x40, 0x40, 0x49,
+}
+
+const guScriptStr = "" +
+	"અરેબીઇમ્પિરિયલ આર્મનિકઅર્મેનિયનઅવેસ્તનબાલીનીઝબટાકબંગાળીબ્લિસિમ્બોલ્સબોપોમોફોબ્રહ" +
+	"્મીબ્રેલબગિનીસબુહિદચકમાયુનાઇટેડ કેનેડિયન એબોરિજનલ સિલેબિક્સકરૈનચેરોકીસિર્થકોપ્ટિ" +
+	"કસિપ્રાયટસિરિલિકઓલ્ડ ચર્ચ સ્લાવોનિક સિરિલિકદેવનાગરીડેસરેટઇજિપ્શિયન ડેમોટિકઇજિપ્શ" +
+	"િયન હાઇરેટિકઇજિપ્શિયન હાઇરોગ્લિફ્સઇથિયોપિકજ્યોર્જિઅન ખુતસુરીજ્યોર્જિઅનગ્લેગોલિટિ" +
+	"કગોથિકગ્રીકગુજરાતીગુરૂમુખીહંગુલહાનહનુનૂસરળીકૃત હેનપરંપરાગત હાનહીબ્રુહિરાગાનાપહાઉ" +
+	" મોન્ગકતાકના અને હિરાગનાઓલ્ડ હંગેરિયનસિન્ધુજૂનુ ઇટાલિકજાવાનીસજાપાનીકાયાહ લીકટાકા" +
+	"નાખારોશ્થીખ્મેરકન્નડાકોરિયનકૈથીલાનાલાઓફ્રેકતુર લેટિનગૈલિક લેટિનલેટિનલેપચાલિમ્બૂલ" +
+	"ીનિયર અલીનિયર બીલિશિયનલિડિયનમાન્ડાયીનમાનીચાયીનમયાન હાઇરોગ્લિફ્સમેરોઇટિકમલયાલમમોં" +
+	"ગોલિયનમૂનમેઇતેઇ માયેકમ્યાંમારએન' કોઓઘામઓલ ચિકીઓરખોનઉડિયાઓસ્માન્યાઓલ્ડ પરમિકફાગ્સ" +
+	"-પાઇન્સ્ક્રિપ્શનલ પહલવીસાલટર પહલવીબુક પહલવીફોનિશિયનપોલાર્ડ ફોનેટિકઇન્સ્ક્રિપ્શનલ" +
+	" પાર્થિયનરીજાંગરોંગોરોંગોરૂનિકસમરિટાનસરાતીસૌરાષ્ટ્રસંકેત લિપીશાવિયાનસિંહલીસુદાની" +
+	"ઝસિલોતી નાગરીસિરિયેકએસ્ત્રેન્જેલો સિરિયાકપશ્ચિમ સિરિયાકપૂર્વ સિરિયાકતગબન્વાતાઇ લ" +
+	"ીનવીન તાઇ લૂતમિલતાઇ વેઇતતેલુગુતેન્ગવારતિફિનાઘટેગાલોગથાનાથાઇટિબેટીયુગાતિટિકવાઇવિસ" +
+	"િબલ સ્પીચજુની ફારસીસુમેરો અક્કાદિયન સુનિફોર્મયીવંશાગતગણિતીય સંકેતલિપિપ્રતીક લિપી" +
+	"અલિખિતસામાન્યઅજ્ઞાત લિપિ"
+
+var guScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xf, 0x40, 0x5b, 0x70, 0x85, 0x85, 0x85, 0x91, 0xa3, 0xca,
+	0xe2, 0xf7, 0x106, 0x118, 0x127, 0x133, 0x199, 0x1a5, 0x1a5, 0x1b7, 0x1c6, 0x1db,
+	0x1f3, 0x208, 0x253, 0x26b, 0x27d, 0x27d, 0x2ae, 0x2e2, 0x322, 0x33a, 0x36e, 0x38c,
+	0x3ad, 0x3bc, 0x3bc, 0x3cb, 0x3e0, 0x3f8, 0x407, 0x410, 0x41f, 0x43e, 0x460, 0x472,
+	0x48a, 0x48a, 0x4a6, 0x4d8, 0x4fd, 0x50f, 0x52e, 0x543, 0x555, 0x555, 0x56b, 0x580,
+	0x598, 0x5a7, 0x5a7, 0x5b9, 0x5cb, 0x5cb, 0x5d7, 0x5e3, 0x5ec, 0x614, 0x633, 0x642,
+	0x651, 0x663, 0x679, 0x692, 0x692, 0x692, 0x6a4, 0x6b6, 0x6d1, 0x6ec, 0x71d, 0x71d,
+	0x71d, 0x735, 0x747, 0x762, 0x76b, 0x76b, 0x78d, 0x7a5, 0x7a5, 0x7a5, 0x7a5, 0x7b3,
+	0x7b3, 0x7bf, 0x7d2, 0x7e1, 0x7f0, 0x80b, 0x80b, 0x827, 0x83d, 0x877, 0x896, 0x8af,
+	0x8c7, 0x8f2, 0x935, 0x947, 0x965, 0x974, 0x989, 0x998, 0x998, 0x9b3, 0x9cf, 0x9e4,
+	0x9e4, 0x9e4, 0x9f6, 0x9f6, 0xa0b, 0xa2d, 0xa42, 0xa7f, 0xaa7, 0xacc, 0xae1, 0xae1,
+	0xaf1, 0xb0e, 0xb1a, 0xb1a, 0xb30, 0xb42, 0xb5a, 0xb6f, 0xb84, 0xb90, 0xb99, 0xbab,
+	0xbab, 0xbc6, 0xbcf, 0xbf1, 0xbf1, 0xbf1, 0xc0d, 0xc57, 0xc5d, 0xc6f, 0xc9d, 0xcbc,
+	0xcce, 0xce3, 0xd02,
+}
+
+const heScriptStr = "" +
+	"ערביארמניבאלינזיבנגליבופומופובריילצ׳אםצ׳ירוקיקופטיקפריסאיקיריליקירילי סלאבוני כנ" +
+	"סייתי עתיקדוואנגריכתב חרטומיםאתיופיגאורגיגותייווניגוג׳רטיגורמוקיהאנגולהאןכתב האן" +
+	" פשוטכתב האן מסורתיעבריהירגאנההונגרי עתיקאינדוסאיטלקי עתיקג׳אוונזייפניקטקאנהקמרי" +
+	"תקאנדהקוריאנילאיתלטיני גאלילטינימאיהמליאלאםמונגולימיאנמראורייהפיניקירוניסינהלהסו" +
+	"ריסורי מערביסורי מזרחיטמילטלוגוטגלוגכתב טאנהתאיטיבטיאוגריתיפרסי עתיקמורשסימון מת" +
+	"מטיסמליםלא כתוברגילכתב שאינו ידוע"
+
+var heScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0x8, 0x12, 0x12, 0x20, 0x20, 0x20, 0x20, 0x2a, 0x2a,
+	0x3a, 0x3a, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x4c, 0x5a, 0x5a, 0x64,
+	0x72, 0x7e, 0xb1, 0xc1, 0xc1, 0xc1, 0xc1, 0xc1, 0xd6, 0xe2, 0xe2, 0xee,
+	0xee, 0xf6, 0xf6, 0x100, 0x10e, 0x11c, 0x128, 0x12e, 0x12e, 0x144, 0x15e, 0x166,
+	0x174, 0x174, 0x174, 0x174, 0x189, 0x195, 0x1aa, 0x1ba, 0x1c2, 0x1c2, 0x1c2, 0x1ce,
+	0x1ce, 0x1d8, 0x1d8, 0x1e2, 0x1f0, 0x1f0, 0x1f0, 0x1f0, 0x1f8, 0x1f8, 0x20b, 0x215,
+	0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x21d, 0x21d,
+	0x21d, 0x21d, 0x22b, 0x239, 0x239, 0x239, 0x239, 0x245, 0x245, 0x245, 0x245, 0x245,
+	0x245, 0x245, 0x245, 0x245, 0x251, 0x251, 0x251, 0x251, 0x251, 0x251, 0x251, 0x251,
+	0x25d, 0x25d, 0x25d, 0x25d, 0x25d, 0x265, 0x265, 0x265, 0x265, 0x265, 0x265, 0x265,
+	0x265, 0x265, 0x271, 0x271, 0x271, 0x271, 0x279, 0x279, 0x28c, 0x29f, 0x29f, 0x29f,
+	0x29f, 0x29f, 0x2a7, 0x2a7, 0x2a7, 0x2b1, 0x2b1, 0x2b1, 0x2bb, 0x2ca, 0x2d0, 0x2da,
+	0x2da, 0x2e8, 0x2e8, 0x2e8, 0x2e8, 0x2e8, 0x2f9, 0x2f9, 0x2f9, 0x301, 0x316, 0x320,
+	0x32d, 0x335, 0x34f,
+}
+
+const hiScriptStr = "" +
+	"अरबीइम्पिरियल आर्मेनिकआर्मेनियाईअवेस्तनबालीबटकीबंगालीब्लिसिम्बॉल्सबोपोमोफ़ोब्रह्" +
+	"मीब्रेलबगिनीसबुहिदचकमायुनिफाइड कैनेडियन एबोरिजनल सिलेबिक्सकरैनचामचेरोकीकिर्थकॉप्" +
+	"टिककाइप्रायटसिरिलिकओल्ड चर्च स्लावोनिक सिरिलिकदेवनागरीडेसरेटइजिप्शियन डेमोटिकइजि" +
+	"प्शियन हाइरेटिकइजिप्शियन हाइरोग्लिफ्सइथियोपियाईजॉर्जियन खुतसुरीजॉर्जियनग्लेगोलिट" +
+	"िकगोथिकग्रन्थयूनानीगुजरातीगुरमुखीहंगुलहानहनुनूसरलीकृत हानपारंपरिक हानहिब्रूहिराग" +
+	"ानापाहो ह्मोन्गकचाकना और हिरागनाऑल्ड हंगेरियनसिन्धुपुरानी इटलीजावानीसजापानीकायाह" +
+	" लीकाताकानाखारोशथीख़मेरकन्नड़कोरियाईकैथीलानालाओफ़्रैक्टुर लातिनीगेली लातिनीलैटिन" +
+	"लेपचालिम्बूलीनियर Aलीनियर बीलिशियनलिडियनमनडेनमनीशीनमयान हाइरोग्लिफ्समेरोइटिकमलया" +
+	"लममंगोलियाईमूनमेइतेइ मायेकम्यांमारएन्'कोओगमऑल चिकीओरखोनउड़ियाओस्मान्याओल्ड परमिक" +
+	"फाग्स-पाइंस्क्रिपश्नल पाहलवीसॉल्टर पाहलवीबुक पाहलवीफोनिशियनपॉलार्ड फोनेटिकइंस्क्" +
+	"रिपश्नल पार्थियनरीजांगरोन्गोरोन्गोरूनिकसमरिटनसरातीसौराष्ट्रसांकेतिक लेखशावियानसि" +
+	"ंहलीसूडानीसिलोती नागरीसिरियेकएस्त्रेन्जेलो सिरिएकपश्चिम सिरिएकपूर्व सिरिएकतगबन्व" +
+	"ाताई लीनया ताई लुतमिलताई विएततेलुगूतेन्गवारतिफिनाघटैगालोगथानाथाईतिब्बतीयुगारिटिक" +
+	"वाईविसिबल स्पीचपुरानी फारसीसुमेरो अक्कादियन सुनिफॉर्मयीविरासतगणितीय संकेतनचिह्नअ" +
+	"लिखितसामान्यअज्ञात लिपि"
+
+var hiScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xc, 0x40, 0x5e, 0x73, 0x7f, 0x7f, 0x7f, 0x8b, 0x9d, 0xc4,
+	0xdf, 0xf4, 0x103, 0x115, 0x124, 0x130, 0x196, 0x1a2, 0x1ab, 0x1bd, 0x1cc, 0x1e1,
+	0x1fc, 0x211, 0x25c, 0x274, 0x286, 0x286, 0x2b7, 0x2eb, 0x32b, 0x349, 0x377, 0x38f,
+	0x3b0, 0x3bf, 0x3d1, 0x3e3, 0x3f8, 0x40d, 0x41c, 0x425, 0x434, 0x453, 0x475, 0x487,
+	0x49f, 0x49f, 0x4c1, 0x4f0, 0x515, 0x527, 0x546, 0x55b, 0x56d, 0x56d, 0x583, 0x59b,
+	0x5b0, 0x5bf, 0x5bf, 0x5d1, 0x5e6, 0x5e6, 0x5f2, 0x5fe, 0x607, 0x638, 0x657, 0x666,
+	0x675, 0x687, 0x69b, 0x6b4, 0x6b4, 0x6b4, 0x6c6, 0x6d8, 0x6e7, 0x6f9, 0x72a, 0x72a,
+	0x72a, 0x742, 0x754, 0x76f, 0x778, 0x778, 0x79a, 0x7b2, 0x7b2, 0x7b2, 0x7b2, 0x7c2,
+	0x7c2, 0x7cb, 0x7de, 0x7ed, 0x7ff, 0x81a, 0x81a, 0x836, 0x84c, 0x886, 0x8ab, 0x8c7,
+	0x8df, 0x90a, 0x94a, 0x95c, 0x980, 0x98f, 0x9a1, 0x9b0, 0x9b0, 0x9cb, 0x9ed, 0xa02,
+	0xa02, 0xa02, 0xa14, 0xa14, 0xa26, 0xa48, 0xa5d, 0xa97, 0xabc, 0xade, 0xaf3, 0xaf3,
+	0xb03, 0xb1d, 0xb29, 0xb29, 0xb3f, 0xb51, 0xb69, 0xb7e, 0xb93, 0xb9f, 0xba8, 0xbbd,
+	0xbbd, 0xbd8, 0xbe1, 0xc03, 0xc03, 0xc03, 0xc25, 0xc6f, 0xc75, 0xc87, 0xcac, 0xcbb,
+	0xccd, 0xce2, 0xd01,
+}
+
+const hrScriptStr = "" +
+	"afaka pismoarapsko pismoaramejsko pismoarmensko pismoavestansko pismobalijsko pi" +
+	"smobamum pismobassa vah pismobatak pismobengalsko pismoblissymbolsbopomofo pismo" +
+	"brahmi pismobrajicabuginsko pismobuhid pismochakma pismounificirani kanadski abo" +
+	"riđinski slogovikarijsko pismočamsko pismočeroki pismocirth pismokoptsko pismocy" +
+	"priot pismoćirilicastaroslavenska crkvena čirilicadevangari pismodeseret pismoeg" +
+	"ipatsko narodno pismoegipatsko hijeratsko pismoegipatski hijeroglifietiopsko pim" +
+	"ogruzijsko khutsuri pismogruzijsko pismoglagoljicagotičko pismograntha pismogrčk" +
+	"o pismogudžaratsko pismogurmukhi pismohangul pismohan pismohanunoo pismopojednos" +
+	"tavljeno hansko pismotradicionalno hansko pismohebrejsko pismohiragana pismoanat" +
+	"olijski hijeroglifipahawh hmong pismokatakana ili hiragana pismostaro mađarsko p" +
+	"ismoindijsko pismostaro talijansko pismojavansko pismojapansko pismojurchen pism" +
+	"okayah li pismokatakana pismokharoshthi pismokmersko pismokhojki pismokannada pi" +
+	"smokorejsko pismokpelle pismokaithi pismolanna pismolaosko pismofraktur latinica" +
+	"keltska latinicalatinicalepcha pismolimbu pismolinear A pismolinear B pismofrase" +
+	"r pismoloma pismolikijsko pismolidijsko pismomandai pismomanihejsko pismomajansk" +
+	"i hijeroglifimende pismomeroitski kurzivmeroitic pismomalajalamsko pismomongolsk" +
+	"o pismomoon pismomro pismomeitei mayek pismomjanmarsko pismostaro sjevernoarapsk" +
+	"o pismonabatejsko pismonaxi geba pismon'ko pismonushu pismoogham pismool chiki p" +
+	"ismoorkhon pismoorijsko pismoosmanya pismopalmyrene pismostaro permic pismophags" +
+	"-pa pismopisani pahlavipsalter pahlavipahlavi pismofeničko pismopollard fonetsko" +
+	" pismopisani parthianrejang pismorongorongo pismorunsko pismosamaritansko pismos" +
+	"arati pismostaro južnoarapsko pismosaurashtra pismoznakovno pismoshavian pismosh" +
+	"arada pismokhudawadi pismosingaleško pismosora sompeng pismosudansko pismosyloti" +
+	" nagri pismosirijsko pismosirijsko estrangelo pismopismo zapadne Sirijepismo ist" +
+	"očne Sirijetagbanwa pismotakri pismotai le pismonovo tai lue pismotamilsko pismo" +
+	"tangut pismotai viet pismotelugu pismotengwar pismotifinartagalog pismothaana pi" +
+	"smotajlandsko pismotibetansko pismotirhuta pismougaritsko pismovai pismoVisible " +
+	"Speechvarang kshiti pismowoleai pismostaro perzijsko pismosumersko-akadsko cunei" +
+	"form pismoYi pismonasljedno pismomatematičko znakovljesimbolijezik bez pismenost" +
+	"izajedničko pismonepoznato pismo"
+
+var hrScriptIdx = []uint16{ // 159 entries
+	0x0, 0xb, 0x18, 0x27, 0x35, 0x45, 0x53, 0x5e, 0x6d, 0x78, 0x87, 0x92,
+	0xa0, 0xac, 0xb3, 0xc1, 0xcc, 0xd8, 0x101, 0x10f, 0x11c, 0x129, 0x134, 0x141,
+	0x14e, 0x157, 0x177, 0x186, 0x193, 0x193, 0x1aa, 0x1c4, 0x1d9, 0x1e6, 0x1fe, 0x20d,
+	0x217, 0x225, 0x232, 0x23e, 0x250, 0x25e, 0x26a, 0x273, 0x280, 0x29d, 0x2b7, 0x2c6,
+	0x2d4, 0x2eb, 0x2fd, 0x318, 0x32d, 0x33b, 0x351, 0x35f, 0x36d, 0x37a, 0x388, 0x396,
+	0x3a6, 0x3b3, 0x3bf, 0x3cc, 0x3da, 0x3e6, 0x3f2, 0x3fd, 0x409, 0x419, 0x429, 0x431,
+	0x43d, 0x448, 0x456, 0x464, 0x470, 0x47a, 0x488, 0x496, 0x4a2, 0x4b2, 0x4c6, 0x4d1,
+	0x4e1, 0x4ef, 0x501, 0x510, 0x51a, 0x523, 0x535, 0x545, 0x560, 0x570, 0x57f, 0x589,
+	0x594, 0x59f, 0x5ad, 0x5b9, 0x5c6, 0x5d3, 0x5e2, 0x5f4, 0x602, 0x610, 0x61f, 0x62c,
+	0x63a, 0x650, 0x65f, 0x66b, 0x67b, 0x687, 0x699, 0x6a5, 0x6be, 0x6ce, 0x6dc, 0x6e9,
+	0x6f6, 0x705, 0x716, 0x728, 0x736, 0x748, 0x756, 0x76f, 0x783, 0x798, 0x7a6, 0x7b1,
+	0x7bd, 0x7cf, 0x7dd, 0x7e9, 0x7f7, 0x803, 0x810, 0x817, 0x824, 0x830, 0x840, 0x850,
+	0x85d, 0x86c, 0x875, 0x883, 0x896, 0x8a2, 0x8b7, 0x8d7, 0x8df, 0x8ee, 0x904, 0x90b,
+	0x91f, 0x930, 0x93f,
+}
+
+const huScriptStr = "" +
+	"ArabBirodalmi arámiÖrményAvesztánBalinézBatakBengáliBliss jelképrendszerBopomofo" +
+	"BrámiVakírásBuginézBuhidCsakmaEgyesített kanadai őslakos jelekKariCsámCserokiKop" +
+	"tCiprusiCirillÓegyházi szláv cirillDevanagáriDeseretEgyiptomi demotikusEgyiptomi" +
+	" hieratikusEgyiptomi hieroglifákEtiópGrúz kucsuriGrúzGlagolitikusGótGörögGudzsar" +
+	"átiGurmukiHangulHanHanunooEgyszerűsített HanHagyományos kínaiHéberHiraganaPahawh" +
+	" hmongKatakana vagy hiraganaÓmagyarIndusRégi olaszJávaiJapánKajah liKatakanaKhar" +
+	"oshthiKhmerKannadaKoreaiKaithiLannaLaoFraktur latinGael latinLatinLepchaLimbuLin" +
+	"eáris ALineáris BLíciaiLídiaiMandaiManicheusMaja hieroglifákMeroitikusMalajálamM" +
+	"ongolMoonMeitei mayekBurmaiN'koOghamOl chikiOrhonOriyaOszmánÓpermikusPhags-paFel" +
+	"riatos pahlaviPsalter pahlaviKönyv pahlaviFőniciaiPollard fonetikusFeliratos par" +
+	"thianRedzsangRongorongoRunikusSzamaritánSzaratiSzaurastraJelírásShaw ábécéSzinga" +
+	"lézSzundanézSylheti nagáriSzíriaiEstrangelo szíriaiNyugat-szíriaiKelet-szíriaiTa" +
+	"gbanwaTai LeÚj tai lueTamilTai vietTeluguTengwarBerberTagalogThaanaThaiTibetiUga" +
+	"riVaiLátható beszédÓperzsaÉkírásos suméro-akkádJiSzármaztatottMatematikai jelren" +
+	"dszerSzimbólumÍratlan nyelvek kódjaMeghatározatlanIsmeretlen írásrendszer"
+
+var huScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x4, 0x14, 0x1c, 0x25, 0x2d, 0x2d, 0x2d, 0x32, 0x3a, 0x4f,
+	0x57, 0x5d, 0x66, 0x6e, 0x73, 0x79, 0x9b, 0x9f, 0xa4, 0xab, 0xab, 0xaf,
+	0xb6, 0xbc, 0xd4, 0xdf, 0xe6, 0xe6, 0xf9, 0x10d, 0x123, 0x129, 0x136, 0x13b,
+	0x147, 0x14b, 0x14b, 0x152, 0x15d, 0x164, 0x16a, 0x16d, 0x174, 0x188, 0x19b, 0x1a1,
+	0x1a9, 0x1a9, 0x1b5, 0x1cb, 0x1d3, 0x1d8, 0x1e3, 0x1e9, 0x1ef, 0x1ef, 0x1f7, 0x1ff,
+	0x209, 0x20e, 0x20e, 0x215, 0x21b, 0x21b, 0x221, 0x226, 0x229, 0x236, 0x240, 0x245,
+	0x24b, 0x250, 0x25b, 0x266, 0x266, 0x266, 0x26d, 0x274, 0x27a, 0x283, 0x294, 0x294,
+	0x294, 0x29e, 0x2a8, 0x2ae, 0x2b2, 0x2b2, 0x2be, 0x2c4, 0x2c4, 0x2c4, 0x2c4, 0x2c8,
+	0x2c8, 0x2cd, 0x2d5, 0x2da, 0x2df, 0x2e6, 0x2e6, 0x2f0, 0x2f8, 0x309, 0x318, 0x326,
+	0x32f, 0x340, 0x352, 0x35a, 0x364, 0x36b, 0x376, 0x37d, 0x37d, 0x387, 0x390, 0x39d,
+	0x39d, 0x39d, 0x3a7, 0x3a7, 0x3b1, 0x3c0, 0x3c8, 0x3db, 0x3ea, 0x3f8, 0x400, 0x400,
+	0x406, 0x411, 0x416, 0x416, 0x41e, 0x424, 0x42b, 0x431, 0x438, 0x43e, 0x442, 0x448,
+	0x448, 0x44d, 0x450, 0x461, 0x461, 0x461, 0x469, 0x483, 0x485, 0x493, 0x4aa, 0x4b4,
+	0x4cb, 0x4db, 0x4f4,
+}
+
+const hyScriptStr = "" +
+	"արաբականհայկականբենգալականբոպոմոֆոբրայլիկյուրեղագիրդեվանագարիեթովպականվրացականհո" +
+	"ւնականգուջարաթիգուրմուխիհանգուլչինականպարզեցված չինականավանդական չինականեբրայակա" +
+	"նհիրագանաճապոնականկատականաքմերականկաննադակորեականլաոսականլատինականմալայալամմոնղո" +
+	"լականմյանմարականօրիյասինհալականթամիլականթելուգութաանաթայականտիբեթականնշաններչգրվ" +
+	"ածընդհանուրանհայտ գիր"
+
+var hyScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x10, 0x10, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x34, 0x34,
+	0x44, 0x44, 0x50, 0x50, 0x50, 0x50, 0x50, 0x50, 0x50, 0x50, 0x50, 0x50,
+	0x50, 0x66, 0x66, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a, 0x8c, 0x8c, 0x9c,
+	0x9c, 0x9c, 0x9c, 0xac, 0xbe, 0xd0, 0xde, 0xec, 0xec, 0x10d, 0x12e, 0x140,
+	0x150, 0x150, 0x150, 0x150, 0x150, 0x150, 0x150, 0x150, 0x162, 0x162, 0x162, 0x172,
+	0x172, 0x182, 0x182, 0x190, 0x1a0, 0x1a0, 0x1a0, 0x1a0, 0x1b0, 0x1b0, 0x1b0, 0x1c2,
+	0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2, 0x1c2,
+	0x1c2, 0x1c2, 0x1d4, 0x1e8, 0x1e8, 0x1e8, 0x1e8, 0x1fe, 0x1fe, 0x1fe, 0x1fe, 0x1fe,
+	0x1fe, 0x1fe, 0x1fe, 0x1fe, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208,
+	0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208, 0x208,
+	0x208, 0x208, 0x21c, 0x21c, 0x21c, 0x21c, 0x21c, 0x21c, 0x21c, 0x21c, 0x21c, 0x21c,
+	0x21c, 0x21c, 0x22e, 0x22e, 0x22e, 0x23e, 0x23e, 0x23e, 0x23e, 0x248, 0x256, 0x268,
+	0x268, 0x268, 0x268, 0x268, 0x268, 0x268, 0x268, 0x268, 0x268, 0x268, 0x268, 0x276,
+	0x282, 0x294, 0x2a7,
+}
+
+const idScriptStr = "" +
+	"AfakaArabAram ImperialArmeniaAvestaBaliBamumBassa VahBatakBengaliBlissymbolBopom" +
+	"ofoBrahmiBrailleBugisBuhidChakmaSimbol Aborigin Kanada KesatuanKariaChamCherokee" +
+	"CirthKoptikSiprusSirilikGereja Slavonia Sirilik LamaDevanagariDeseretStenografi " +
+	"DuployanDemotik MesirHieratik MesirHieroglip MesirEtiopiaGeorgian KhutsuriGeorgi" +
+	"aGlagoliticGothicGranthaYunaniGujaratiGurmukhiHangulHanHanunooHan SederhanaHan T" +
+	"radisionalIbraniHiraganaHieroglif AnatoliaPahawh HmongKatakana atau HiraganaHung" +
+	"aria KunoIndusItalia LamaJawaJepangJurchenKayah LiKatakanaKharoshthiKhmerKhojkiK" +
+	"annadaKoreaKpelleKaithiLannaLaosLatin FrakturLatin GaelikLatinLepchaLimbuLinear " +
+	"ALinear BLisuLomaLyciaLydiaMandaeManikheiHieroglip MayaMendeKursif MeroitikMeroi" +
+	"tikMalayalamMongoliaMoonMroMeitei MayekMyanmarArab Utara KunoNabataeaNaxi GebaN'" +
+	"KoNushuOghamChiki LamaOrkhonOriyaOsmanyaPalmiraPermik KunoPhags-paPahleviMazmur " +
+	"PahleviKitab PahleviPhoenixFonetik PollardPrasasti ParthiaRejangRongorongoRunikS" +
+	"amariaSaratiArab Selatan KunoSaurashtraTulisan IsyaratShaviaSharadaKhudawadiSinh" +
+	"alaSora SompengSundaSyloti NagriSuriahSuriah EstrangeloSuriah BaratSuriah TimurT" +
+	"agbanwaTakriTai LeTai Lue BaruTamilTangutTai VietTeluguTenghwarTifinaghTagalogTh" +
+	"aanaThaiTibetTirhutaUgaritikVaiUcapan TerlihatVarang KshitiWoleaiPersia KunoCune" +
+	"iform Sumero-AkkadiaYiWarisanNotasi MatematikaSimbolTidak TertulisUmumSkrip Tak " +
+	"Dikenal"
+
+var idScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0x9, 0x16, 0x1d, 0x23, 0x27, 0x2c, 0x35, 0x3a, 0x41, 0x4b,
+	0x53, 0x59, 0x60, 0x65, 0x6a, 0x70, 0x8f, 0x94, 0x98, 0xa0, 0xa5, 0xab,
+	0xb1, 0xb8, 0xd4, 0xde, 0xe5, 0xf8, 0x105, 0x113, 0x122, 0x129, 0x13a, 0x141,
+	0x14b, 0x151, 0x158, 0x15e, 0x166, 0x16e, 0x174, 0x177, 0x17e, 0x18b, 0x19a, 0x1a0,
+	0x1a8, 0x1ba, 0x1c6, 0x1dc, 0x1e9, 0x1ee, 0x1f9, 0x1fd, 0x203, 0x20a, 0x212, 0x21a,
+	0x224, 0x229, 0x22f, 0x236, 0x23b, 0x241, 0x247, 0x24c, 0x250, 0x25d, 0x269, 0x26e,
+	0x274, 0x279, 0x281, 0x289, 0x28d, 0x291, 0x296, 0x29b, 0x2a1, 0x2a9, 0x2b7, 0x2bc,
+	0x2cb, 0x2d3, 0x2dc, 0x2e4, 0x2e8, 0x2eb, 0x2f7, 0x2fe, 0x30d, 0x315, 0x31e, 0x322,
+	0x327, 0x32c, 0x336, 0x33c, 0x341, 0x348, 0x34f, 0x35a, 0x362, 0x369, 0x377, 0x384,
+	0x38b, 0x39a, 0x3aa, 0x3b0, 0x3ba, 0x3bf, 0x3c6, 0x3cc, 0x3dd, 0x3e7, 0x3f6, 0x3fc,
+	0x403, 0x40c, 0x413, 0x41f, 0x424, 0x430, 0x436, 0x447, 0x453, 0x45f, 0x467, 0x46c,
+	0x472, 0x47e, 0x483, 0x489, 0x491, 0x497, 0x49f, 0x4a7, 0x4ae, 0x4b4, 0x4b8, 0x4bd,
+	0x4c4, 0x4cc, 0x4cf, 0x4de, 0x4eb, 0x4f1, 0x4fc, 0x514, 0x516, 0x51d, 0x52e, 0x534,
+	0x542, 0x546, 0x557,
+}
+
+const isScriptStr = "" +
+	"arabísktarmensktbengalsktbopomofoblindraleturkyrillísktdevanagarieþíópísktgeorgí" +
+	"sktgrísktgújaratígurmukhihangulkínverskteinfaldað hanhefðbundið hanhebreskthirag" +
+	"anakatakana eða hiraganajapansktkatakanakmerkannadakóresktlaolatnesktmalalajammo" +
+	"ngólsktmjanmarsktoriyasinhalatamílskttelúgúthaanataílenskttíbeskttáknóskrifaðalm" +
+	"enntóþekkt letur"
+
+var isScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x9, 0x9, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x1a, 0x1a,
+	0x22, 0x22, 0x2e, 0x2e, 0x2e, 0x2e, 0x2e, 0x2e, 0x2e, 0x2e, 0x2e, 0x2e,
+	0x2e, 0x39, 0x39, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x50, 0x50, 0x5a,
+	0x5a, 0x5a, 0x5a, 0x61, 0x6b, 0x73, 0x79, 0x83, 0x83, 0x91, 0xa1, 0xa9,
+	0xb1, 0xb1, 0xb1, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xcf, 0xcf, 0xcf, 0xd7,
+	0xd7, 0xdb, 0xdb, 0xe2, 0xea, 0xea, 0xea, 0xea, 0xed, 0xed, 0xed, 0xf5,
+	0xf5, 0xf5, 0xf5, 0xf5, 0xf5, 0xf5, 0xf5, 0xf5, 0xf5, 0xf5, 0xf5, 0xf5,
+	0xf5, 0xf5, 0xfe, 0x108, 0x108, 0x108, 0x108, 0x112, 0x112, 0x112, 0x112, 0x112,
+	0x112, 0x112, 0x112, 0x112, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117,
+	0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117, 0x117,
+	0x117, 0x117, 0x11e, 0x11e, 0x11e, 0x11e, 0x11e, 0x11e, 0x11e, 0x11e, 0x11e, 0x11e,
+	0x11e, 0x11e, 0x127, 0x127, 0x127, 0x12f, 0x12f, 0x12f, 0x12f, 0x135, 0x13f, 0x147,
+	0x147, 0x147, 0x147, 0x147, 0x147, 0x147, 0x147, 0x147, 0x147, 0x147, 0x147, 0x14c,
+	0x156, 0x15d, 0x16b,
+}
+
+const itScriptStr = "" +
+	"afakaaraboaramaico imperialearmenoavesticobalinesebamumBassa Vahbatakbengalisimb" +
+	"oli blissbopomofobrahmibraillebuginesebuhidchakmasimboli aborigeni canadesi unif" +
+	"icaticarianchamcherokeecirthcoptocipriotacirillicocirillico antica chiesa slavon" +
+	"icadevanagarideseretstenografia duployanegiziano demoticoieratico egizianogerogl" +
+	"ifici egizianietiopekutsurigeorgianoglagoliticogoticogranthagrecogujaratigurmukh" +
+	"ihangulhanhanunoohan semplificatohan tradizionaleebraicohiraganageroglifici anat" +
+	"olicipahawn hmongkatanaka o hiraganaantico unghereseinduitalico anticojavanesegi" +
+	"apponesejurchenkayah likatakanakharoshthikhmerkhojkikannadacoreanoKpellekaithila" +
+	"nnalaovariante fraktur del latinovariante gaelica del latinolatinolepchalimbulin" +
+	"eare Alineare Blisulomalycilydimandaicomanicheogeroglifici mayamendecorsivo mero" +
+	"iticomeroiticomalayalammongolomoonmromeetei mayekmyanmararabo settentrionale ant" +
+	"iconabateogeba naxin'konushuoghamol chikiorkhonoriyaosmanyapalmirenopermico anti" +
+	"cophags-papahlavi delle iscrizionipahlavi psalterpahlavi bookfeniciofonetica di " +
+	"pollardpartico delle iscrizionirejangrongorongorunicosamaritanosaratiarabo merid" +
+	"ionale anticosaurashtralinguaggio dei segnishavianosharadakhudawadisingalesesora" +
+	" sompengsundanesesyloti nagrisirianosiriaco estrangelosiriaco occidentalesiriaco" +
+	" orientaletagbanwatakritai letai luetamiltanguttai viettelugutengwartifinaghtaga" +
+	"logthaanatailandesetibetanotirhutaugaritavaiialfabeto visivovarang kshitiwoleaip" +
+	"ersiano anticosumero-accadiano cuneiformeyiereditatonotazione matematicasimbolin" +
+	"on scrittocomuneignoto o non valido"
+
+var itScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0xa, 0x1c, 0x22, 0x2a, 0x32, 0x37, 0x40, 0x45, 0x4c, 0x59,
+	0x61, 0x67, 0x6e, 0x76, 0x7b, 0x81, 0xa5, 0xab, 0xaf, 0xb7, 0xbc, 0xc1,
+	0xc9, 0xd2, 0xf3, 0xfd, 0x104, 0x118, 0x129, 0x13a, 0x14e, 0x154, 0x15b, 0x164,
+	0x16f, 0x175, 0x17c, 0x181, 0x189, 0x191, 0x197, 0x19a, 0x1a1, 0x1b1, 0x1c1, 0x1c8,
+	0x1d0, 0x1e5, 0x1f1, 0x204, 0x214, 0x218, 0x226, 0x22e, 0x238, 0x23f, 0x247, 0x24f,
+	0x259, 0x25e, 0x264, 0x26b, 0x272, 0x278, 0x27e, 0x283, 0x286, 0x2a1, 0x2bc, 0x2c2,
+	0x2c8, 0x2cd, 0x2d6, 0x2df, 0x2e3, 0x2e7, 0x2eb, 0x2ef, 0x2f7, 0x2ff, 0x30f, 0x314,
+	0x325, 0x32e, 0x337, 0x33e, 0x342, 0x345, 0x351, 0x358, 0x373, 0x37a, 0x383, 0x387,
+	0x38c, 0x391, 0x399, 0x39f, 0x3a4, 0x3ab, 0x3b4, 0x3c2, 0x3ca, 0x3e2, 0x3f1, 0x3fd,
+	0x404, 0x417, 0x42f, 0x435, 0x43f, 0x445, 0x44f, 0x455, 0x46d, 0x477, 0x48b, 0x493,
+	0x49a, 0x4a3, 0x4ac, 0x4b8, 0x4c1, 0x4cd, 0x4d4, 0x4e6, 0x4f9, 0x50a, 0x512, 0x517,
+	0x51d, 0x524, 0x529, 0x52f, 0x537, 0x53d, 0x544, 0x54c, 0x553, 0x559, 0x563, 0x56b,
+	0x572, 0x579, 0x57d, 0x58c, 0x599, 0x59f, 0x5ae, 0x5c9, 0x5cb, 0x5d4, 0x5e8, 0x5ef,
+	0x5fa, 0x600, 0x613,
+}
+
+const jaScriptStr = "" +
+	"アファカ文字アラビア文字帝国アラム文字アルメニア文字アヴェスター文字バリ文字バムン文字バサ文字バタク文字ベンガル文字ブリスシンボル注音字母ブラーフミー文字ブライ" +
+	"ユ点字ブギス文字ブヒッド文字チャクマ文字統合カナダ先住民音節文字カリア文字チャム文字チェロキー文字キアス文字コプト文字キプロス文字キリル文字古代教会スラブ語キリ" +
+	"ル文字デーバナーガリー文字デセレット文字デュプロワエ式速記エジプト民衆文字エジプト神官文字エジプト聖刻文字エチオピア文字グルジア文字(フツリ)グルジア文字グラゴ" +
+	"ル文字ゴート文字グランタ文字ギリシャ文字グジャラート文字グルムキー文字ハングル漢字ハヌノオ文字漢字(簡体字)漢字(繁体字)ヘブライ文字ひらがなアナトリア象形文字" +
+	"パハウ・フモン文字仮名古代ハンガリー文字インダス文字古イタリア文字ジャワ文字日本語の文字女真文字カヤー文字カタカナカローシュティー文字クメール文字ホジャ文字カン" +
+	"ナダ文字韓国語の文字クペレ文字カイティ文字ラーンナー文字ラオ文字ラテン文字(ドイツ文字)ラテン文字 (ゲール文字)ラテン文字レプチャ文字リンブ文字線文字A線文字" +
+	"Bフレイザー文字ロマ文字リキア文字リディア文字マンダ文字マニ文字マヤ象形文字メンデ文字メロエ文字草書体メロエ文字マラヤーラム文字モンゴル文字ムーン文字ムロ文字メ" +
+	"イテイ文字ミャンマー文字古代北アラビア文字ナバテア文字ナシ族ゲバ文字ンコ文字女書オガム文字オルチキ文字オルホン文字オリヤー文字オスマニア文字パルミラ文字古ぺルム" +
+	"文字パスパ文字碑文パフラヴィー文字詩編用パフラヴィー文字書物用パフラヴィー文字フェニキア文字ポラード音声記号碑文パルティア文字ルジャン文字ロンゴロンゴ文字ルーン" +
+	"文字サマリア文字サラティ文字古代南アラビア文字サウラーシュトラ文字手話文字ショー文字シャーラダー文字クダワディ文字シンハラ文字ソラング・ソンペング文字スンダ文字" +
+	"シロティ・ナグリ文字シリア文字シリア文字(エストランゲロ文字)シリア文字(西方シリア文字)シリア文字(東方シリア文字)タグバンワ文字タークリー文字タイ・レ文字新" +
+	"タイ・ルー文字タミール文字西夏文字タイ・ヴェト文字テルグ文字テングワール文字ティフナグ文字タガログ文字ターナ文字タイ文字チベット文字ティルフータ文字ウガリット文" +
+	"字ヴァイ文字視話法バラン・クシティ文字ウォレアイ文字古代ペルシア文字シュメール＝アッカド語楔形文字イ文字基底文字の種別を継承する結合文字数学記号記号文字非表記未" +
+	"解読文字未定義文字"
+
+var jaScriptIdx = []uint16{ // 159 entries
+	0x0, 0x12, 0x24, 0x39, 0x4e, 0x66, 0x72, 0x81, 0x8d, 0x9c, 0xae, 0xc3,
+	0xcf, 0xe7, 0xf9, 0x108, 0x11a, 0x12c, 0x150, 0x15f, 0x16e, 0x183, 0x192, 0x1a1,
+	0x1b3, 0x1c2, 0x1e9, 0x207, 0x21c, 0x237, 0x24f, 0x267, 0x27f, 0x294, 0x2b1, 0x2c3,
+	0x2d5, 0x2e4, 0x2f6, 0x308, 0x320, 0x335, 0x341, 0x347, 0x359, 0x36a, 0x37b, 0x38d,
+	0x399, 0x3b4, 0x3cf, 0x3d5, 0x3f0, 0x402, 0x417, 0x426, 0x438, 0x444, 0x453, 0x45f,
+	0x47d, 0x48f, 0x49e, 0x4b0, 0x4c2, 0x4d1, 0x4e3, 0x4f8, 0x504, 0x524, 0x545, 0x554,
+	0x566, 0x575, 0x57f, 0x589, 0x59e, 0x5aa, 0x5b9, 0x5cb, 0x5da, 0x5e6, 0x5f8, 0x607,
+	0x61f, 0x62e, 0x646, 0x658, 0x667, 0x673, 0x685, 0x69a, 0x6b5, 0x6c7, 0x6dc, 0x6e8,
+	0x6ee, 0x6fd, 0x70f, 0x721, 0x733, 0x748, 0x75a, 0x76c, 0x77b, 0x799, 0x7ba, 0x7db,
+	0x7f0, 0x808, 0x823, 0x835, 0x84d, 0x85c, 0x86e, 0x880, 0x89b, 0x8b9, 0x8c5, 0x8d4,
+	0x8ec, 0x901, 0x913, 0x937, 0x946, 0x964, 0x973, 0x99f, 0x9c5, 0x9eb, 0xa00, 0xa15,
+	0xa27, 0xa3f, 0xa51, 0xa5d, 0xa75, 0xa84, 0xa9c, 0xab1, 0xac3, 0xad2, 0xade, 0xaf0,
+	0xb08, 0xb1d, 0xb2c, 0xb35, 0xb53, 0xb68, 0xb80, 0xbad, 0xbb6, 0xbe6, 0xbf2, 0xbfe,
+	0xc07, 0xc16, 0xc25,
+}
+
+const kaScriptStr = "" +
+	"აფაკაარაბულიიმპერიული არამეულისომხურიავესტურიბალიურიბამუმიბასა ვაჰიბატაკიბენგალუ" +
+	"რიბლისსიმბოლოებიბოპომოფობრაჰმიბრაილიბუჰიდიჩაკმაკარიულიჩამიჩეროკიკირთიკოპტურიკვიპ" +
+	"როსულიკირილიცაძველი სლავური კირილიცადევანაგარიდეზერეტისდუპლოის სტენოგრაფიაეგვიპტ" +
+	"ური დემოტიკურიეგვიპტური იერატიკულიეგვიპტური იეროგლიფურიეთიოპიურიხუცურიქართულიგლა" +
+	"გოლიცაგოთურიგრანთაბერძნულიგუჯარათულიგურმუხიჰანგულიჰანიჰანუნოოგამარტივებული ჰანიტ" +
+	"რადიციული ჰანიებრაულიჰირაგანაანატოლიური იეროგლიფურიფაჰაუ-მონიიაპონური კანაძველი " +
+	"უნგრულიიავურიიაპონურიჯურჯენულიკაიაჰ-ლიკატაკანაქაროშთიქმერულიქოჯკიკანადაკორეულიკპ" +
+	"ელეკაითილაოსურიგელური ლათინურილათინურილიმბუA-ხაზოვანიB-ხაზოვანილომალიკიურილიდიურ" +
+	"იმანდეურიმანიქეურიმაიას იეროგლიფებიმენდემეროიტული კურსივიმეროიტულიმალაიალამურიმო" +
+	"ნღოლურიმრომიანმურიძველი ჩრდილოეთ-არაბულინაბატეურინკონუშუოღამიოლ-ჩიკიორხონულიორია" +
+	"ოსმანიაპალმირულიძველი პერმულიფაგსპამონუმენტური ფალაურიფსალმუნური ფალაურიწიგნური " +
+	"ფალაურიფინიკიურიმონუმენტური პართულირეჯანგირონგორონგორუნულისამარიულისარატიძველი ს" +
+	"ამხრეთ-არაბულისაურაშტრაჟესტთაშარადაქუდავადისინჰალურისორან-სომპენისუნდანურისილოტი" +
+	" ნაგრისირიულისირიული ესტრანგელოდასავლეთი სირიულიაღმოსავლეთი სირიულიტაგბანვატაკრი" +
+	"ტაი ლეახალი ტაი ლიუტამილურიტანღუტურიტაი-ვიეტიტელუგუტენგვარიტიფინაღითაანატაიტიბეტ" +
+	"ურიტირჰუტაუგარითულივაიხილული მეტყველებავარანგ-კშიტივოლეაიძველი სპარსულიშუმერულ-ა" +
+	"ქადური ლურსმნულიგადაღებულიმათემატიკური ნოტაციასიმბოლოებიუმწერლობოზოგადიუცნობი და" +
+	"მწერლობა"
+
+var kaScriptIdx = []uint16{ // 159 entries
+	0x0, 0xf, 0x24, 0x58, 0x6d, 0x85, 0x9a, 0xac, 0xc5, 0xd7, 0xf2, 0x11c,
+	0x134, 0x146, 0x158, 0x158, 0x16a, 0x179, 0x179, 0x18e, 0x19a, 0x1ac, 0x1bb, 0x1d0,
+	0x1ee, 0x206, 0x244, 0x262, 0x27d, 0x2b4, 0x2ee, 0x328, 0x365, 0x380, 0x392, 0x3a7,
+	0x3c2, 0x3d4, 0x3e6, 0x3fe, 0x41c, 0x431, 0x446, 0x452, 0x467, 0x49b, 0x4c6, 0x4db,
+	0x4f3, 0x533, 0x54f, 0x574, 0x599, 0x599, 0x599, 0x5ab, 0x5c3, 0x5de, 0x5f4, 0x60c,
+	0x621, 0x636, 0x645, 0x657, 0x66c, 0x67b, 0x68a, 0x68a, 0x69f, 0x69f, 0x6ca, 0x6e2,
+	0x6e2, 0x6f1, 0x70b, 0x725, 0x725, 0x731, 0x746, 0x75b, 0x773, 0x78e, 0x7bf, 0x7ce,
+	0x7ff, 0x81a, 0x83e, 0x859, 0x859, 0x862, 0x862, 0x87a, 0x8b8, 0x8d3, 0x8d3, 0x8dc,
+	0x8e8, 0x8f7, 0x90a, 0x922, 0x92e, 0x943, 0x95e, 0x983, 0x995, 0x9cc, 0xa00, 0xa2b,
+	0xa46, 0xa46, 0xa7d, 0xa92, 0xab0, 0xac2, 0xadd, 0xaef, 0xb2a, 0xb45, 0xb57, 0xb57,
+	0xb69, 0xb81, 0xb9c, 0xbc1, 0xbdc, 0xbfe, 0xc13, 0xc47, 0xc78, 0xcaf, 0xcc7, 0xcd6,
+	0xce6, 0xd09, 0xd21, 0xd3c, 0xd55, 0xd67, 0xd7f, 0xd97, 0xd97, 0xda6, 0xdaf, 0xdc7,
+	0xddc, 0xdf7, 0xe00, 0xe31, 0xe53, 0xe65, 0xe8d, 0xed4, 0xed4, 0xef2, 0xf2c, 0xf4a,
+	0xf65, 0xf77, 0xfa8,
+}
+
+const kkScriptStr = "" +
+	"араб жазылуыармян жазуыбенгалБопомофоБрайлякириллицаДеванагари жазуыЭфиопия жазу" +
+	"ыГрузин жазуыГрек жазуыГуджаратиГурмукхихангулқытай жазуықарапайым қытай иерогли" +
+	"фыдәстүрлі қытай иероглифыИвритХираганажапонкатаканаКхмерКаннадаКорей жазуыЛаос " +
+	"жазуылатын жазылуыМалаяламмонғолМаянмарОрияСингальТамильТелугуТаанаТайТибет жазу" +
+	"ыБелгілержазылуысызжалпыбелгісіз жазылуы"
+
+var kkScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x17, 0x17, 0x2c, 0x2c, 0x2c, 0x2c, 0x2c, 0x2c, 0x38, 0x38,
+	0x48, 0x48, 0x54, 0x54, 0x54, 0x54, 0x54, 0x54, 0x54, 0x54, 0x54, 0x54,
+	0x54, 0x66, 0x66, 0x85, 0x85, 0x85, 0x85, 0x85, 0x85, 0x9e, 0x9e, 0xb5,
+	0xb5, 0xb5, 0xb5, 0xc8, 0xda, 0xea, 0xf6, 0x10b, 0x10b, 0x13b, 0x169, 0x173,
+	0x183, 0x183, 0x183, 0x183, 0x183, 0x183, 0x183, 0x183, 0x18d, 0x18d, 0x18d, 0x19d,
+	0x19d, 0x1a7, 0x1a7, 0x1b5, 0x1ca, 0x1ca, 0x1ca, 0x1ca, 0x1dd, 0x1dd, 0x1dd, 0x1f6,
+	0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6, 0x1f6,
+	0x1f6, 0x1f6, 0x206, 0x212, 0x212, 0x212, 0x212, 0x220, 0x220, 0x220, 0x220, 0x220,
+	0x220, 0x220, 0x220, 0x220, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228,
+	0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228, 0x228,
+	0x228, 0x228, 0x236, 0x236, 0x236, 0x236, 0x236, 0x236, 0x236, 0x236, 0x236, 0x236,
+	0x236, 0x236, 0x242, 0x242, 0x242, 0x24e, 0x24e, 0x24e, 0x24e, 0x258, 0x25e, 0x273,
+	0x273, 0x273, 0x273, 0x273, 0x273, 0x273, 0x273, 0x273, 0x273, 0x273, 0x273, 0x283,
+	0x297, 0x2a1, 0x2c0,
+}
+
+const kmScriptStr = "" +
+	"អារ៉ាប់អារមេនីបង់ក្លាដែសបូផូម៉ូហ្វូអក្សរ​សម្រាប់មនុស្ស​ពិការ​ភ្នែកស៊ីរីដាវ៉ាន់ណា" +
+	"ការិអេត្យូពីហ្សកហ្ស៊ីក្រិចកុដ្យារ៉ាទីកុមុយឃីហាំងកុលហានអក្សរ​ហាន​កាត់អក្សរ​ហាន​ពេ" +
+	"ញអ៊ីស្រាអែលហ៊ីរ៉ាកាណាជប៉ុនកតាកាណាខ្មែរកណាដាកូរ៉េឡាវឡាតាំងមលយាល័មម៉ុងហ្គោលីភូមាអូ" +
+	"រីយ៉ាស្រីលង្ការតាមីលតេលុគុថាណាថៃតៃប៉ិនិមិត្តសញ្ញាគ្មានការ​សរសេរធម្មតាមិន​ស្គាល់​" +
+	"អក្សរ"
+
+var kmScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x15, 0x15, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x48, 0x48,
+	0x69, 0x69, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6,
+	0xc6, 0xd5, 0xd5, 0xfc, 0xfc, 0xfc, 0xfc, 0xfc, 0xfc, 0x114, 0x114, 0x12f,
+	0x12f, 0x12f, 0x12f, 0x13e, 0x15f, 0x174, 0x189, 0x192, 0x192, 0x1bc, 0x1e3, 0x201,
+	0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x22e, 0x22e, 0x22e, 0x243,
+	0x243, 0x252, 0x252, 0x261, 0x270, 0x270, 0x270, 0x270, 0x279, 0x279, 0x279, 0x28b,
+	0x28b, 0x28b, 0x28b, 0x28b, 0x28b, 0x28b, 0x28b, 0x28b, 0x28b, 0x28b, 0x28b, 0x28b,
+	0x28b, 0x28b, 0x2a0, 0x2be, 0x2be, 0x2be, 0x2be, 0x2ca, 0x2ca, 0x2ca, 0x2ca, 0x2ca,
+	0x2ca, 0x2ca, 0x2ca, 0x2ca, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df,
+	0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df, 0x2df,
+	0x2df, 0x2df, 0x2fd, 0x2fd, 0x2fd, 0x2fd, 0x2fd, 0x2fd, 0x2fd, 0x2fd, 0x2fd, 0x2fd,
+	0x2fd, 0x2fd, 0x30c, 0x30c, 0x30c, 0x31e, 0x31e, 0x31e, 0x31e, 0x32a, 0x330, 0x33f,
+	0x33f, 0x33f, 0x33f, 0x33f, 0x33f, 0x33f, 0x33f, 0x33f, 0x33f, 0x33f, 0x33f, 0x363,
+	0x38d, 0x39f, 0x3cf,
+}
+
+const knScriptStr = "" +
+	"ಅರೇಬಿಕ್ಇಂಪೀರಿಯಲ್ ಅರೆಮಾಯಿಕ್ಅರ್ಮೇನಿಯನ್ಅವೆಸ್ತಾನ್ಬಾಲಿನೀಸ್ಬಾಟಕ್ಬೆಂಗಾಲಿಬ್ಲಿಸ್‌ಸಿಂಬಲ್ಸ್" +
+	"ಬೋಪೊಮೋಫೋಬ್ರಾಹ್ಮಿಬ್ರೈಲಿಬಗಿನೀಸ್ಬುಹಿದ್ಕಾಕಂಯುನಿಟೆಡ್ ಕೆನೆಡಿಯನ್ ಅಬೊರಿಜಿನಲ್ ಸಿಲ್ಯಾಬಿಕ್ಸ" +
+	"್ಕರೇನ್ಚಾಮ್ಚೆರೋಕೀಸಿರ್ಥ್ಕಾಪ್ಟಿಕ್ಸಿಪ್ರಿಯಾಟ್ಸಿರಿಲಿಕ್ಪ್ರಾಚೀನ ಚರ್ಚ್ ಸ್ಲೋವಾನಿಕ್ ಸಿರಿಲಿಕ" +
+	"್ದೇವನಾಗರಿಡಸರ್ಟ್ಈಜಿಪ್ಟಿಯನ್ ಡೆಮೋಟಿಕ್ಈಜಿಪ್ಟಿಯನ್ ಹಯಾರಿಟಿಕ್ಈಜಿಪ್ಟಿಯನ್ ಹೀರೋಗ್ಲಿಫ್ಸ್ಇಥಿ" +
+	"ಯೋಪಿಯಾಜಾರ್ಜಿಯನ್ ಖುಸ್ತುರಿಜಾರ್ಜಿಯನ್ಗ್ಲಾಗೋಲಿಟಿಕ್ಗೋತಿಕ್ಗ್ರೀಕ್ಗುಜರಾತಿಗುರುಮುಖಿಹ್ಯಾಂಗುಲ" +
+	"್ಹಾನ್ಹನೂನೂಸರಳೀಕೃತ ಹಾನ್ಸಾಂಪ್ರದಾಯಿಕ ಹಾನ್ಹೀಬ್ರೂಹಿರಗಾನಾಪಹವ್ ಹ್ಮೋಂಗ್ಕಟಕಾನಾ ಅಥವಾ ಹಿರಗಾ" +
+	"ನಾಪ್ರಾಚೀನ ಹಂಗೇರಿಯನ್ಸಿಂಧೂಪ್ರಾಚೀನ್ ಇಟಾಲಿಕ್ಜಾವನೀಸ್ಜಾಪನೀಸ್ಕೆಯಾ ಲಿಕಟಕಾನಾಖರೋಶ್ತಿಖಮೇಕನ್" +
+	"ನಡಕೊರಿಯನ್ಕೈಥಿಲಾನಾಲಾವೋಫ್ರಾಕ್ತರ್ ಲ್ಯಾಟಿನ್ಗೇಲಿಕ್ ಲ್ಯಾಟಿನ್ಲ್ಯಾಟಿನ್ಲೆಪ್ಚಾಲಿಂಬುಲೀನಯರ್ " +
+	"ಎಲೀನಯರ್ ಬಿಲೈಸಿಯನ್ಲಿಡಿಯನ್ಮಂಡೇಯನ್ಮನಿಚೈಯನ್ಮಯಾನ್ ಹೀರೋಗ್ಲಿಫ್ಸ್ಮೆರೊಯಿಟಿಕ್ಮಲಯಾಳಂಮಂಗೋಲಿಯ" +
+	"ನ್ಮೂನ್ಮೈತಿ ಮಯೆಕ್ಮ್ಯಾನ್ಮಾರ್ಎನ್‍ಕೋಓಘಮ್ಓಲ್ ಚಿಕಿಓರ್ಖೋನ್ಒರಿಯಾಓಸ್ಮಾನ್ಯಾಪ್ರಾಚೀನ ಪೆರ್ಮಿಕ" +
+	"್ಫಾಗ್ಸ್-ಪಾಇನ್ಸ್‌ಕ್ರಿಪ್ಶನಲ್ ಪಾಹ್ಲವಿಸಾಲ್ಟರ್ ಪಾಹ್ಲವಿಬುಕ್ ಪಾಹ್ಲವಿಫೀನಿಶಿಯನ್ಪೊಲ್ಲಾರ್ಡ್" +
+	" ಫೊನೆಟಿಕ್ಇನ್ಸ್‌ಕ್ರಿಪ್ಶನಲ್ ಪಾರ್ಥಿಯನ್ರೆಜಾಂಗ್ರೋಂಗೋರೋಂಗೋರೂನಿಕ್ಸಮಾರಿಟನ್ಸರಾಟಿಸೌರಾಷ್ಟ್ರ" +
+	"ಸೈನ್‌ರೈಟಿಂಗ್ಶಾವಿಯಾನ್ಸಿಂಹಳಸುಂಡಾನೀಸ್ಸೈಲೋಟಿ ನಗ್ರಿಸಿರಿಯಾಕ್ಎಸ್ಟ್ರಾಂಜಿಲೋ ಸಿರಿಯಾಕ್ಪಶ್ಚಿ" +
+	"ಮ ಸಿರಿಯಾಕ್ಪೂರ್ವ ಸಿರಿಯಾಕ್ಟಾಗ್ಬಾನವಾಥಾಯ್ ಲಿನ್ಯೂ ಥಾಯ್ ಲುಇತಮಿಳುಥಾಯ್ ವಿಯೆಟ್ತೆಲುಗುತೆಂಗ್" +
+	"‌ವಾರ್ಟಿಫಿನಾಘ್ಟ್ಯಾಗಲೋಗ್ಥಾನಾಥಾಯ್ಟಿಬೇಟನ್ಉಗಾರಿಟಿಕ್ವಾಯ್ವಿಸಿಬಲ್ ಸ್ಪೀಚ್ಪ್ರಾಚೀನ ಪರ್ಶಿಯನ್" +
+	"ಸುಮೇರೋ-ಅಕ್ಕಾಡಿಯನ್ ಕ್ಯೂನಿಫಾರ್ಮ್ಯಿಇನ್‍ಹೆರಿಟೆಡ್ಗಣೀತ ಸಂಕೇತಲಿಪಿಸಂಕೇತಗಳುಅಲಿಖಿತಸಾಮಾನ್ಯಅ" +
+	"ಜ್ಞಾತ ಅಥವ ಅಮಾನ್ಯವಾದ ಲಿಪಿ"
+
+var knScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x15, 0x4c, 0x6a, 0x85, 0x9d, 0x9d, 0x9d, 0xac, 0xc1, 0xee,
+	0x106, 0x11e, 0x130, 0x145, 0x157, 0x163, 0x1db, 0x1ea, 0x1f6, 0x208, 0x21a, 0x232,
+	0x250, 0x268, 0x2c5, 0x2dd, 0x2ef, 0x2ef, 0x326, 0x360, 0x3a3, 0x3be, 0x3f2, 0x40d,
+	0x431, 0x443, 0x443, 0x455, 0x46a, 0x482, 0x49d, 0x4a9, 0x4b8, 0x4da, 0x508, 0x51a,
+	0x52f, 0x52f, 0x551, 0x586, 0x5b7, 0x5c6, 0x5f4, 0x609, 0x61e, 0x61e, 0x631, 0x643,
+	0x658, 0x661, 0x661, 0x670, 0x685, 0x685, 0x691, 0x69d, 0x6a9, 0x6dd, 0x708, 0x720,
+	0x732, 0x741, 0x757, 0x770, 0x770, 0x770, 0x785, 0x79a, 0x7af, 0x7c7, 0x7fb, 0x7fb,
+	0x7fb, 0x819, 0x82b, 0x846, 0x852, 0x852, 0x86e, 0x88c, 0x88c, 0x88c, 0x88c, 0x89e,
+	0x89e, 0x8aa, 0x8c0, 0x8d5, 0x8e4, 0x8ff, 0x8ff, 0x92d, 0x946, 0x98c, 0x9b7, 0x9d9,
+	0x9f4, 0xa2b, 0xa77, 0xa8c, 0xaaa, 0xabc, 0xad4, 0xae3, 0xae3, 0xafe, 0xb22, 0xb3a,
+	0xb3a, 0xb3a, 0xb49, 0xb49, 0xb64, 0xb86, 0xb9e, 0xbdb, 0xc06, 0xc2e, 0xc49, 0xc49,
+	0xc5c, 0xc7f, 0xc8e, 0xc8e, 0xcad, 0xcbf, 0xcdd, 0xcf5, 0xd10, 0xd1c, 0xd28, 0xd3d,
+	0xd3d, 0xd58, 0xd64, 0xd8c, 0xd8c, 0xd8c, 0xdba, 0xe10, 0xe16, 0xe3a, 0xe62, 0xe7a,
+	0xe8c, 0xea1, 0xee6,
+}
+
+const koScriptStr = "" +
+	"아파카 문자아랍 문자아랍제국 문자아르메니아 문자아베스타 문자발리 문자바뭄 문자바사바흐 문자바타크 문자벵골 문자블리스기호 문자주음부호브라미브라유 " +
+	"점자부기 문자부히드 문자차크마 문자통합 캐나다 토착어카리 문자칸 고어체로키 문자키르쓰콥트 문자키프로스 문자키릴 문자고대교회슬라브어 키릴문자데바나" +
+	"가리 문자디저렛 문자듀플로이안 문자고대 이집트 민중문자고대 이집트 신관문자고대 이집트 신성문자에티오피아 문자그루지야 쿠츠리 문자그루지야 문자글라" +
+	"골 문자고트 문자그란타 문자그리스 문자구쟈라티 문자구르무키 문자한글한자하누누 문자한자 간체한자 번체히브리 문자히라가나아나톨리아 상형문자파하우 몽" +
+	" 문자가나고대 헝가리 문자인더스 문자고대 이탈리아 문자자바 문자일본 문자줄첸 문자카야 리 문자가타카나카로슈티 문자크메르 문자코즈키 문자칸나다 문" +
+	"자한국어크펠레 문자카이시 문자란나 문자라오 문자독일식 로마자아일랜드식 로마자로마자렙차 문자림부 문자선형 문자(A)선형 문자(B)프레이저 문자로마" +
+	" 문자리키아 문자리디아 문자만다이아 문자마니교 문자마야 상형 문자멘데 문자메로에 필기체메로에 문자말라얄람 문자몽골 문자문 문자므로 문자메이테이 " +
+	"마옉 문자미얀마 문자옛 북부 아라비아 문자나바테아 문자나시 게바 문자응코 문자누슈 문자오검 문자올 치키 문자오르혼어오리야 문자오스마니아 문자팔미" +
+	"라 문자고대 페름 문자파스파 문자명문 팔라비 문자솔터 팔라비 문자북 팔라비 문자페니키아 문자폴라드 표음 문자명문 파라티아 문자레장 문자롱고롱고룬" +
+	" 문자사마리아 문자사라티옛 남부 아라비아 문자사우라슈트라 문자수화 문자샤비안 문자사라다 문자쿠다와디 문자신할라 문자소라 솜펭 문자순다 문자실헤티" +
+	" 나가리시리아 문자에스트랑겔로식 시리아 문자서부 시리아 문자동부 시리아 문자타그반와 문자타크리 문자타이 레 문자신 타이 루에타밀 문자탕구트 문자" +
+	"태국 베트남 문자텔루구 문자텡과르 문자티피나그 문자타갈로그 문자타나 문자타이 문자티베트 문자티르후타 문자우가리트 문자바이 문자시화법바랑 크시티 " +
+	"문자울레아이고대 페르시아 문자수메르-아카드어 설형문자이 문자구전 문자수학 기호기호구전일반 문자기록되지 않은 문자(구전)"
+
+var koScriptIdx = []uint16{ // 159 entries
+	0x0, 0x10, 0x1d, 0x30, 0x46, 0x59, 0x66, 0x73, 0x86, 0x96, 0xa3, 0xb9,
+	0xc5, 0xce, 0xde, 0xeb, 0xfb, 0x10b, 0x125, 0x132, 0x13c, 0x14c, 0x155, 0x162,
+	0x175, 0x182, 0x1a7, 0x1bd, 0x1cd, 0x1e3, 0x200, 0x21d, 0x23a, 0x250, 0x26d, 0x280,
+	0x290, 0x29d, 0x2ad, 0x2bd, 0x2d0, 0x2e3, 0x2e9, 0x2ef, 0x2ff, 0x30c, 0x319, 0x329,
+	0x335, 0x351, 0x365, 0x36b, 0x382, 0x392, 0x3ac, 0x3b9, 0x3c6, 0x3d3, 0x3e4, 0x3f0,
+	0x403, 0x413, 0x423, 0x433, 0x43c, 0x44c, 0x45c, 0x469, 0x476, 0x489, 0x4a2, 0x4ab,
+	0x4b8, 0x4c5, 0x4d5, 0x4e5, 0x4f8, 0x505, 0x515, 0x525, 0x538, 0x548, 0x55c, 0x569,
+	0x57c, 0x58c, 0x59f, 0x5ac, 0x5b6, 0x5c3, 0x5dd, 0x5ed, 0x60b, 0x61e, 0x632, 0x63f,
+	0x64c, 0x659, 0x66a, 0x676, 0x686, 0x69c, 0x6ac, 0x6c0, 0x6d0, 0x6e7, 0x6fe, 0x712,
+	0x725, 0x73c, 0x756, 0x763, 0x76f, 0x779, 0x78c, 0x795, 0x7b3, 0x7cc, 0x7d9, 0x7e9,
+	0x7f9, 0x80c, 0x81c, 0x830, 0x83d, 0x850, 0x860, 0x886, 0x89d, 0x8b4, 0x8c7, 0x8d7,
+	0x8e8, 0x8f9, 0x906, 0x916, 0x92d, 0x93d, 0x94d, 0x960, 0x973, 0x980, 0x98d, 0x99d,
+	0x9b0, 0x9c3, 0x9d0, 0x9d9, 0x9f0, 0x9fc, 0xa16, 0xa39, 0xa43, 0xa50, 0xa5d, 0xa63,
+	0xa69, 0xa76, 0xa98,
+}
+
+const kyScriptStr = "" +
+	"АрапАрмянБенгалБопомофоБрейлКириллДеванагариЭфиопГрузинГрекГужаратиГурмухиХангул" +
+	"ХаниЖөн. КытайСалт. КытайИвритХираганаЖапанКатаканаКмерКаннадаКорейЛаоЛатынМалай" +
+	"аламМонголМйанмарОрийаСингалаТамилТелуТаанаТайТибетСимволдорЖазылбаганЖалпыБелги" +
+	"сиз жазуу"
+
+var kyScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0x8, 0x12, 0x12, 0x12, 0x12, 0x12, 0x12, 0x1e, 0x1e,
+	0x2e, 0x2e, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38,
+	0x38, 0x44, 0x44, 0x58, 0x58, 0x58, 0x58, 0x58, 0x58, 0x62, 0x62, 0x6e,
+	0x6e, 0x6e, 0x6e, 0x76, 0x86, 0x94, 0xa0, 0xa8, 0xa8, 0xba, 0xce, 0xd8,
+	0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xf2, 0xf2, 0xf2, 0x102,
+	0x102, 0x10a, 0x10a, 0x118, 0x122, 0x122, 0x122, 0x122, 0x128, 0x128, 0x128, 0x132,
+	0x132, 0x132, 0x132, 0x132, 0x132, 0x132, 0x132, 0x132, 0x132, 0x132, 0x132, 0x132,
+	0x132, 0x132, 0x144, 0x150, 0x150, 0x150, 0x150, 0x15e, 0x15e, 0x15e, 0x15e, 0x15e,
+	0x15e, 0x15e, 0x15e, 0x15e, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168,
+	0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168,
+	0x168, 0x168, 0x176, 0x176, 0x176, 0x176, 0x176, 0x176, 0x176, 0x176, 0x176, 0x176,
+	0x176, 0x176, 0x180, 0x180, 0x180, 0x188, 0x188, 0x188, 0x188, 0x192, 0x198, 0x1a2,
+	0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1a2, 0x1b4,
+	0x1c8, 0x1d2, 0x1ed,
+}
+
+const loScriptStr = "" +
+	"ອັບຟາກາອາຣາບິກອິມພີຮຽນ ອາເມອິກອາເມນຽນອະເວສຕະບາລີບາມູມບັດຊາບາຕັກເບັງກາລິບລິກຊິມໂບ" +
+	"ລສຈູ້ອິນພຮາຫມີເບຣວບູກິສບູຮິດຊາກມາສັນຍາລັກຊົນເຜົ່າພື້ນເມືອງແຄນນາດາຄາເຮຍຈາມເຊໂຮກີເ" +
+	"ຊີຮຄອບຕິກໄຊເປຍຊີຣິວລິກເຊຮັດສລາ ໂວນິກຊີຮິກລິກໂບຮານດີວານາກາຣີເດເຊເຮຊົວເລດັບໂລຍັນດີ" +
+	"ໂມຕິກອີຍິບເຮຍຮາຕິກອີຍິບເຮຍໂຮກລິຟອີຍິບອີທິໂອປິກຄອດຊູຮີຈໍເຈຍຈໍຈຽນກລາໂກລິຕິກໂກຮິກເຄ" +
+	"ນທາກຣີກຈູຈາຣາທີກົວມູຄີຮັນກູນຮານຮານູໂນໂອຈີນ (ແບບງ່າຍ)ຈີນ (ດັ້ງເດີມ)ຮີບຣິວຮິຣາການາ" +
+	"ອັກລຮະອານາໂຕເລຍປາເຮາເມັງຄະຕະກະນະຫຮືຮີຮະງະນະຮັງກາຮີໂບຮານອິນດັດອີຕາລີໂບຮານຈາວາຍີ່ປ" +
+	"ຸ່ນຈູຮເຊັນຄຍາຄາຕະການາຂໍໂຮກສີຂະແມຄໍຈຄີແຄນາດາເກົາຫຼີເປລເລກາຍຕິລ້ານນາລາວລາຕິນ-ຟຮັ່ງ" +
+	"ເຕຣລາຕິນ-ແກລິກລາຕິນເລຊາລິມບູລີເນຍລີເນຍຣເຟຣເຊຮໂລມາໄລເຊຍລີເດຍແມນດຽນມານິແຊນມາຍາໄຮໂຮ" +
+	"ກລິບເມນເດເຄເລີຊີເມໂຮອິຕິກເມໂຮຕິກມາເລອາລຳມົງໂກລຽນມູນເມໂຮເມເທມາເຍກມຽນມາອາຮະເບຍເໜືອ" +
+	"ໂບຮານນາບາທາທຽນກີບາ-ນາຊີເອັນໂກນຸຊຸອອກຄອນໂອຊິກິອອກສມັນຍາໂອຣິຢາພາລໄມຮິນເພີມີໂບຮານຟາ" +
+	"ກສ-ປາປະຫລາວີອິນສຄິບຊັນແນລປະຫລາວີຊອດເຕຮ໌ປະຫລາວີບຸກຟີນິເຊຍສັດຕະສາດພໍຮລາພາຮ໌ເທຍອິນສ" +
+	"ຄຮິປຊັນແນລເຮຈັງຮອງໂກຮອງໂກຮູນິກຊາມາເລຍຊາຮາຕິອາລະເບຍໃຕ້ໂບຮານໂສຮັດຕຣະໄຊນ໌ໄຮຕີ້ງຊອວຽ" +
+	"ນຊາຮາດາດຸດາວາດີສິນຫາລາໂສຮາສົມເປັງຊຸນດາຊີໂລຕິນາກຣີຊີເຮຍຊີເຮຍເອສທຮານຈີໂລຊີເຮຍຕາເວັ" +
+	"ນຕົກຊີເຮຍຕາເວັນອອກຕັກບັນວາທາຄຮີໄທເລໄທລື້ໃໝ່ທາມິວຕັນກັນໄທຫວຽດເຕລູກູເທງກວາຮທີຟີນາກ" +
+	"ຕາກາລອກທານາໄທທິເບທັນເທຮຸທາຍູກາຮິດໄວຄຳເວົ້າທີ່ເບີ່ງເຫັນໄດ້ວາຮັງກສິຕິໂອລີເອເປຮເຊຍໂ" +
+	"ບຮານອັກສອນຮູບປລີ່ມສຸເມເຮຍ-ອັດຄາເດຍຍີອິນເຮຮິດເຄື່ອງໝາຍທາງຄະນິດສາດສັນຍາລັກບໍ່ມີພາສ" +
+	"າຂຽນສາມັນການຂຽນທີ່ບໍ່ຮູ້ຈັກ"
+
+var loScriptIdx = []uint16{ // 159 entries
+	0x0, 0x15, 0x2a, 0x58, 0x6d, 0x82, 0x8e, 0x9d, 0xac, 0xbb, 0xd3, 0xf4,
+	0x106, 0x118, 0x124, 0x133, 0x142, 0x151, 0x1b1, 0x1c0, 0x1c9, 0x1db, 0x1e7, 0x1f9,
+	0x208, 0x220, 0x26f, 0x28d, 0x29f, 0x2c6, 0x2ea, 0x311, 0x33b, 0x356, 0x37a, 0x389,
+	0x3a7, 0x3b6, 0x3c5, 0x3d1, 0x3e9, 0x3fe, 0x410, 0x419, 0x431, 0x452, 0x476, 0x488,
+	0x4a0, 0x4cd, 0x4e8, 0x521, 0x545, 0x557, 0x578, 0x584, 0x599, 0x5ae, 0x5b7, 0x5cf,
+	0x5e4, 0x5f0, 0x5ff, 0x611, 0x626, 0x635, 0x644, 0x656, 0x65f, 0x687, 0x6a6, 0x6b5,
+	0x6c1, 0x6d0, 0x6df, 0x6f1, 0x703, 0x70f, 0x71e, 0x72d, 0x73f, 0x754, 0x778, 0x787,
+	0x7b7, 0x7cc, 0x7e4, 0x7fc, 0x805, 0x811, 0x82c, 0x83b, 0x86b, 0x886, 0x89f, 0x8b1,
+	0x8bd, 0x8cf, 0x8e1, 0x8fc, 0x90e, 0x90e, 0x926, 0x944, 0x957, 0x993, 0x9bd, 0x9db,
+	0x9f0, 0xa17, 0xa56, 0xa65, 0xa83, 0xa92, 0xaa7, 0xab9, 0xae6, 0xafe, 0xb1c, 0xb2b,
+	0xb3d, 0xb55, 0xb6a, 0xb8b, 0xb9a, 0xbbb, 0xbca, 0xbfa, 0xc24, 0xc4e, 0xc66, 0xc75,
+	0xc81, 0xc99, 0xca8, 0xcba, 0xccc, 0xcde, 0xcf3, 0xd08, 0xd1d, 0xd29, 0xd2f, 0xd44,
+	0xd56, 0xd6b, 0xd71, 0xdb3, 0xdd1, 0xde3, 0xe04, 0xe5c, 0xe62, 0xe7a, 0xeb6, 0xece,
+	0xef2, 0xf01, 0xf37,
+}
+
+const ltScriptStr = "" +
+	"arabųimperinė aramaikųarmėnųavestanobatakbengalų„Bliss“ simboliaibopomofobrahmib" +
+	"railiobuginezųbuhidčakmasuvienodinti Kanados aborigenų silabiniaikariųčamčerokių" +
+	"kirtkoptųkiprokirilicasenoji bažnytinė slavų kirilicadevanagarideseretasEgipto l" +
+	"iaudiesEgipto žyniųegipto hieroglifaietiopųgruzinų kutsurigruzinųglagolitikgotųg" +
+	"raikųgudžaratųgurmukihangulhanhanunosupaprastinti hantradiciniai hanhebrajųhirag" +
+	"anapahav hmongkatakana / hiraganasenasis vengrųindussenasis italųjaviečiųjaponųk" +
+	"ajah likatakanakaroštikhmerųkanadųkorėjiečiųkaithilanalaosiečiųfraktur lotynųgėl" +
+	"ų lotynųlotynųlepčalimbulinijiniai Alinijiniai Blicianlidianmandėjųmaničųmalų hi" +
+	"eroglifaimeroitikmalajaliųmongolųmūnmeitei majekbirmiečiųenkooghamol čikiorkonor" +
+	"ijųosmanųsenieji permėspagsa parašytiniai pahlavipselter pahlavibuk pahvalifoeni" +
+	"kųpolard fonetinėrašytiniai partųrejangrongorongorunųsamariečiųsaratisauraštraže" +
+	"nklų raštasšaviųsinhalųsundųsyloti nagrisirųestrangelo siriečiųvakarų sirųrytų s" +
+	"irųtagbanvatai lenaujasis Tailando luetamilųtai vettelugųtengvartifinagtagalogųh" +
+	"anatajųtibetiečiųugaritikvaimatoma kalbasenieji persųŠumero Akado dantiraštisjip" +
+	"aveldėtasmatematiniai simboliaisimboliųneparašytabendrinežinomi rašmenys"
+
+var ltScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0x19, 0x21, 0x29, 0x29, 0x29, 0x29, 0x2e, 0x36, 0x4b,
+	0x53, 0x59, 0x60, 0x69, 0x6e, 0x74, 0x9e, 0xa4, 0xa8, 0xb1, 0xb5, 0xbb,
+	0xc0, 0xc8, 0xea, 0xf4, 0xfd, 0xfd, 0x10c, 0x11a, 0x12c, 0x133, 0x143, 0x14b,
+	0x155, 0x15a, 0x15a, 0x161, 0x16c, 0x173, 0x179, 0x17c, 0x182, 0x193, 0x1a2, 0x1aa,
+	0x1b2, 0x1b2, 0x1bd, 0x1d0, 0x1df, 0x1e4, 0x1f2, 0x1fc, 0x203, 0x203, 0x20b, 0x213,
+	0x21b, 0x222, 0x222, 0x229, 0x236, 0x236, 0x23c, 0x240, 0x24b, 0x25a, 0x268, 0x26f,
+	0x275, 0x27a, 0x286, 0x292, 0x292, 0x292, 0x298, 0x29e, 0x2a7, 0x2af, 0x2c0, 0x2c0,
+	0x2c0, 0x2c8, 0x2d2, 0x2da, 0x2de, 0x2de, 0x2ea, 0x2f5, 0x2f5, 0x2f5, 0x2f5, 0x2f9,
+	0x2f9, 0x2fe, 0x306, 0x30b, 0x311, 0x318, 0x318, 0x327, 0x32f, 0x342, 0x351, 0x35c,
+	0x364, 0x374, 0x386, 0x38c, 0x396, 0x39b, 0x3a7, 0x3ad, 0x3ad, 0x3b7, 0x3c7, 0x3ce,
+	0x3ce, 0x3ce, 0x3d6, 0x3d6, 0x3dc, 0x3e8, 0x3ed, 0x402, 0x40f, 0x41a, 0x422, 0x422,
+	0x428, 0x43d, 0x444, 0x444, 0x44b, 0x452, 0x459, 0x460, 0x469, 0x46d, 0x472, 0x47e,
+	0x47e, 0x486, 0x489, 0x495, 0x495, 0x495, 0x4a3, 0x4bd, 0x4bf, 0x4ca, 0x4e0, 0x4e9,
+	0x4f4, 0x4fa, 0x50d,
+}
+
+const lvScriptStr = "" +
+	"arābuaramiešuarmēņubaliešubengāļubopomofobrahmiBraila rakstsirokēzukoptukirilica" +
+	"senslāvudevānagāridemotiskais rakstshierātiskais rakstsēģiptiešu hieroglifietiop" +
+	"iešugruzīnugotugrieķugudžaratupandžabuhangilaķīniešuhanu vienkāršotāhanu tradici" +
+	"onālāivritshiraganakatakana vai hiraganasenungāruvecitāļujaviešujapāņukatakanakh" +
+	"merukannarukorejiešulaosiešulatīņulineārā Alineārā BlīdiešumaijumalajalumongoļuM" +
+	"ūna rakstsbirmiešuogamiskais rakstsorijuosmaņu turkufeniķiešurongorongorūnu raks" +
+	"tssamariešusingāļuzundusīriešurietumsīriešuaustrumsīriešutamilutelugutagalutaana" +
+	"tajutibetiešusenperiešušumeru-akadiešu ķīļrakstsjimantotāmatemātiskais pieraksts" +
+	"simbolibez rakstībasvispārējānezināma rakstība"
+
+var lvScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0xf, 0x17, 0x17, 0x1f, 0x1f, 0x1f, 0x1f, 0x28, 0x28,
+	0x30, 0x36, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x4b, 0x4b, 0x50,
+	0x50, 0x58, 0x61, 0x6d, 0x6d, 0x6d, 0x7f, 0x93, 0xaa, 0xb4, 0xb4, 0xbc,
+	0xbc, 0xc0, 0xc0, 0xc7, 0xd1, 0xda, 0xe1, 0xeb, 0xeb, 0xfe, 0x111, 0x117,
+	0x11f, 0x11f, 0x11f, 0x134, 0x13e, 0x13e, 0x148, 0x150, 0x158, 0x158, 0x158, 0x160,
+	0x160, 0x166, 0x166, 0x16d, 0x177, 0x177, 0x177, 0x177, 0x180, 0x180, 0x180, 0x188,
+	0x188, 0x188, 0x193, 0x19e, 0x19e, 0x19e, 0x19e, 0x1a7, 0x1a7, 0x1a7, 0x1ac, 0x1ac,
+	0x1ac, 0x1ac, 0x1b4, 0x1bc, 0x1c8, 0x1c8, 0x1c8, 0x1d1, 0x1d1, 0x1d1, 0x1d1, 0x1d1,
+	0x1d1, 0x1e2, 0x1e2, 0x1e2, 0x1e7, 0x1f4, 0x1f4, 0x1f4, 0x1f4, 0x1f4, 0x1f4, 0x1f4,
+	0x1ff, 0x1ff, 0x1ff, 0x1ff, 0x209, 0x215, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f,
+	0x21f, 0x21f, 0x228, 0x228, 0x22d, 0x22d, 0x236, 0x236, 0x245, 0x255, 0x255, 0x255,
+	0x255, 0x255, 0x25b, 0x25b, 0x25b, 0x261, 0x261, 0x261, 0x267, 0x26c, 0x270, 0x27a,
+	0x27a, 0x27a, 0x27a, 0x27a, 0x27a, 0x27a, 0x285, 0x2a3, 0x2a5, 0x2ad, 0x2c5, 0x2cc,
+	0x2da, 0x2e6, 0x2f9,
+}
+
+const mkScriptStr = "" +
+	"арапско писмоерменско писмобенгалско писмобопомофобрајово писмокирилско писмодев" +
+	"анагариетиопско писмогрузиско писмогрчко писмогуџаратигурмукихангулханско писмоп" +
+	"оедноставено ханско писмотрадиционално ханско писмохебрејско писмохираганајапонс" +
+	"ко писмокатаканакмерско писмоканнадакорејско писмолаошко писмолатинично писмомал" +
+	"ајаламско писмомонголско писмомјанмарско писмооријанско писмосинхалско писмотами" +
+	"лско писмотелугутанатајландско писмотибетско писмосимболибез писмоопштонепознато" +
+	" писмо"
+
+var mkScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x19, 0x19, 0x34, 0x34, 0x34, 0x34, 0x34, 0x34, 0x51, 0x51,
+	0x61, 0x61, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a, 0x7a,
+	0x7a, 0x95, 0x95, 0xa9, 0xa9, 0xa9, 0xa9, 0xa9, 0xa9, 0xc4, 0xc4, 0xdf,
+	0xdf, 0xdf, 0xdf, 0xf4, 0x104, 0x112, 0x11e, 0x135, 0x135, 0x167, 0x199, 0x1b6,
+	0x1c6, 0x1c6, 0x1c6, 0x1c6, 0x1c6, 0x1c6, 0x1c6, 0x1c6, 0x1e1, 0x1e1, 0x1e1, 0x1f1,
+	0x1f1, 0x20a, 0x20a, 0x218, 0x233, 0x233, 0x233, 0x233, 0x24a, 0x24a, 0x24a, 0x267,
+	0x267, 0x267, 0x267, 0x267, 0x267, 0x267, 0x267, 0x267, 0x267, 0x267, 0x267, 0x267,
+	0x267, 0x267, 0x28a, 0x2a7, 0x2a7, 0x2a7, 0x2a7, 0x2c6, 0x2c6, 0x2c6, 0x2c6, 0x2c6,
+	0x2c6, 0x2c6, 0x2c6, 0x2c6, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3,
+	0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3, 0x2e3,
+	0x2e3, 0x2e3, 0x300, 0x300, 0x300, 0x300, 0x300, 0x300, 0x300, 0x300, 0x300, 0x300,
+	0x300, 0x300, 0x31b, 0x31b, 0x31b, 0x327, 0x327, 0x327, 0x327, 0x32f, 0x34e, 0x369,
+	0x369, 0x369, 0x369, 0x369, 0x369, 0x369, 0x369, 0x369, 0x369, 0x369, 0x369, 0x377,
+	0x388, 0x392, 0x3af,
+}
+
+const mlScriptStr = "" +
+	"അറബിഅർമിഅർമേനിയൻഅവെസ്ഥൻബാലിനീസ്ബട്ടക്ബംഗാളിബ്ലിസ് ചിത്ര ലിപിബോപ്പോമോഫോബ്രാഹ്മിബ്" +
+	"രെയ്‌ലിബുഗിനീസ്ബുഹിഡ്ചകംഏകീകൃത കനേഡിയൻ ഗോത്രലിപിചരിയൻഛംചെറോക്കിചിർത്ത്കോപ്റ്റിക്" +
+	"സൈപ്രിയോട്ട്സിറിലിക്പുരാതന ചർച്ച് സ്ലവോണിക് സിറിലിക്ദേവനാഗരിഡെസെർട്ട്ഈജിപ്ഷ്യൻ ഡ" +
+	"ിമോട്ടിക്ഈജിപ്ഷ്യൻ ഹിരാറ്റിക്ഈജിപ്ഷ്യൻ ചിത്രലിപിഎത്യോപിക്ജോർജ്ജിയൻ ഖുട്സുരിജോർജ്" +
+	"ജിയൻഗ്ലഗോലിറ്റിക്ഗോഥിക്ഗ്രീക്ക്ഗുജറാത്തിഗുരുമുഖിഹാംഗുൾഹാൻഹനുനൂലളിതവൽക്കരിച്ച ഹാൻ" +
+	"പരമ്പരാഗത ഹാൻഹീബ്രുഹിരാഗാനപഹ്വാ ഹമോംഗ്കടകാനയോ ഹിരാഗാനയോപുരാതന ഹംഗേറിയൻസിന്ധുപഴയ " +
+	"ഇറ്റാലിയൻജാവനീസ്ജാപ്പനീസ്കയാ ലികടകാനഖരോഷ്ടിഖമർകന്നഡകൊറിയൻക്തിലന്നലാവോഫ്രാക്ടുർ ല" +
+	"ാറ്റിൻഗെയ്‌ലിക് ലാറ്റിൻലാറ്റിൻലെപ്ചലിംബുസമരേഖയിലുള്ള എലീനിയർ ബിലൈസിൻലൈഡിയൻമൻഡേയൻ" +
+	"മണിചേയൻമായൻ ചിത്രലിപിമെറോയിറ്റിക്മലയാളംമംഗോളിയൻമൂൺമേറ്റി മായക്മ്യാൻമാർഎൻകോഒഖാംഒൽ" +
+	" ചിക്കിഒർഖോൺഒഡിയഒസ്‌മാനിയപുരാതന പെർമിക്ഫഗസ് പഎഴുത്തു പഹൽവിസാൾട്ടർ പഹൽവിപഹൽവി ലിപ" +
+	"ിഫിനീഷ്യൻപൊള്ളാർഡ് ശബ്ദലിപിപൃതിറെജാംഗ്റൊംഗോറൊംഗോറുണിക്സമരിയസരതിസൗരാഷ്ട്രചിഹ്നലിപ" +
+	"ിഷാവിയൻസിംഹളസന്താനീസ്സൈലോതി നാഗരിസിറിയക്ക്എസ്റ്റ്രാംഗ്ലോ സിറിയക്പശ്ചിമസുറിയാനികി" +
+	"ഴക്കൻ സിറിയക്തഗ്ബൻവാതായ് ലേപുതിയ തായ് ല്യൂതമിഴ്ത്വട്തെലുങ്ക്തെംഗ്വർതിഫിനാഗ്തഗലോഗ" +
+	"്ഥാനതായ്ടിബറ്റൻഉഗ്രൈറ്റിക്വൈദൃശ്യഭാഷപഴയ പേർഷ്യൻസുമേറോ അക്കാഡിയൻ ക്യുണിഫോംയിപാരമ്" +
+	"പര്യമായഗണിത രൂപംചിഹ്നങ്ങൾഎഴുതപ്പെടാത്തത്സാധാരണഅറിയപ്പെടാത്തതോ നിലവിലില്ലാത്തതോ ആ" +
+	"യ ലിപി"
+
+var mlScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xc, 0x18, 0x30, 0x45, 0x5d, 0x5d, 0x5d, 0x6f, 0x81, 0xb0,
+	0xce, 0xe6, 0x101, 0x119, 0x12b, 0x134, 0x178, 0x187, 0x18d, 0x1a5, 0x1ba, 0x1d8,
+	0x1fc, 0x214, 0x26e, 0x286, 0x2a1, 0x2a1, 0x2db, 0x315, 0x34c, 0x367, 0x39b, 0x3b6,
+	0x3dd, 0x3ef, 0x3ef, 0x407, 0x422, 0x43a, 0x44c, 0x455, 0x464, 0x498, 0x4bd, 0x4cf,
+	0x4e4, 0x4e4, 0x506, 0x537, 0x562, 0x574, 0x599, 0x5ae, 0x5c9, 0x5c9, 0x5d9, 0x5e8,
+	0x5fd, 0x606, 0x606, 0x615, 0x627, 0x627, 0x633, 0x63f, 0x64b, 0x67c, 0x6ad, 0x6c2,
+	0x6d1, 0x6e0, 0x708, 0x721, 0x721, 0x721, 0x730, 0x742, 0x754, 0x769, 0x791, 0x791,
+	0x791, 0x7b5, 0x7c7, 0x7df, 0x7e8, 0x7e8, 0x80a, 0x822, 0x822, 0x822, 0x822, 0x82e,
+	0x82e, 0x83a, 0x853, 0x862, 0x86e, 0x889, 0x889, 0x8b1, 0x8c1, 0x8e6, 0x90b, 0x927,
+	0x93f, 0x973, 0x97f, 0x994, 0x9b2, 0x9c4, 0x9d3, 0x9df, 0x9df, 0x9fa, 0xa15, 0xa27,
+	0xa27, 0xa27, 0xa36, 0xa36, 0xa51, 0xa73, 0xa8e, 0xace, 0xaf8, 0xb23, 0xb38, 0xb38,
+	0xb4b, 0xb74, 0xb83, 0xb83, 0xb92, 0xbaa, 0xbbf, 0xbd7, 0xbe9, 0xbf2, 0xbfe, 0xc13,
+	0xc13, 0xc34, 0xc3a, 0xc52, 0xc52, 0xc52, 0xc71, 0xcbb, 0xcc1, 0xce5, 0xcfe, 0xd19,
+	0xd46, 0xd58, 0xdca,
+}
+
+const mnScriptStr = "" +
+	"арабарменбенгалвопомофобрайлкирилдеванагариэтиопгүржгрекгүжаратигурмукхихангулха" +
+	"нхялбаршуулсан ханзуламжлалт ханзеврейхираганаяпонкатаканакхмерканнадасолонгосла" +
+	"ослатинмалаяламмонгол бичигмьянмарориясинхалатамилтэлүгүтанатайтөвдтэмдэгбичигдэ" +
+	"эгүйнийтлэгтодорхойгүй бичиг"
+
+var mnScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0x8, 0x12, 0x12, 0x12, 0x12, 0x12, 0x12, 0x1e, 0x1e,
+	0x2e, 0x2e, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38,
+	0x38, 0x42, 0x42, 0x56, 0x56, 0x56, 0x56, 0x56, 0x56, 0x60, 0x60, 0x68,
+	0x68, 0x68, 0x68, 0x70, 0x80, 0x90, 0x9c, 0xa2, 0xa2, 0xc5, 0xe0, 0xea,
+	0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa, 0x102, 0x102, 0x102, 0x112,
+	0x112, 0x11c, 0x11c, 0x12a, 0x13a, 0x13a, 0x13a, 0x13a, 0x142, 0x142, 0x142, 0x14c,
+	0x14c, 0x14c, 0x14c, 0x14c, 0x14c, 0x14c, 0x14c, 0x14c, 0x14c, 0x14c, 0x14c, 0x14c,
+	0x14c, 0x14c, 0x15c, 0x173, 0x173, 0x173, 0x173, 0x181, 0x181, 0x181, 0x181, 0x181,
+	0x181, 0x181, 0x181, 0x181, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189,
+	0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189, 0x189,
+	0x189, 0x189, 0x197, 0x197, 0x197, 0x197, 0x197, 0x197, 0x197, 0x197, 0x197, 0x197,
+	0x197, 0x197, 0x1a1, 0x1a1, 0x1a1, 0x1ad, 0x1ad, 0x1ad, 0x1ad, 0x1b5, 0x1bb, 0x1c3,
+	0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1c3, 0x1cf,
+	0x1e5, 0x1f3, 0x214,
+}
+
+const mrScriptStr = "" +
+	"अरबीइम्पिरियल आर्मेनिकअर्मेनियनअवेस्तानबालीबटाकबंगालीब्लिसिम्बॉल्सबोपोमोफोब्रह्म" +
+	"ीब्रेलबूगीबुहिदचकमायूनिफाइड कॅनेडियन अ‍ॅबोरिदनल सिलॅबिक्सकॅरियनचामचेरोकीकिर्थकॉप" +
+	"्टिकसायप्रिऑटसीरिलिकपुरातन चर्च स्लाव्होनिक सिरिलिकदेवनागरीडेसर्टइजिप्शियन डेमोट" +
+	"िकइजिप्शियन हायरेटिकइजिप्शियन हायरोग्लिफ्सईथिओपिकजॉर्जियन खुत्सुरीजॉर्जियनग्लॅगो" +
+	"लिटिकगोथिकग्रीकगुजरातीगुरुमुखीहंगुलहानहनुनूसरलीकृत हानपारंपारिक हानहिब्रूहिरागान" +
+	"ापहाउ मंगकॅटाकना आणि हिरागानापुरातन हंगेरियनसिन्धुजुनी इटालिकजावानीसजपानीकायाह ल" +
+	"ीकॅटाकानाखारोश्थीख्मेरकन्नडकोरियनकाइथीलानालाओफ्रॅक्तुर लॅटिनगाएलिक लेटिनलॅटिनलेप" +
+	"चालिम्बूलीनियार अलीनियर बीलायशियानलायडियानमान्डायीनमानीचायीनमायान हाइरोग्लिफ्समे" +
+	"रोइटिकमल्याळममंगोलियनमूनमेइतेइ मायेकम्यानमारएन्'कोओघामओल चिकिओर्खोनउडियाउस्मानिय" +
+	"ापुरातन पर्मिकफाग्स-पाइन्स्क्रिप्शनल पाहलवीसॉल्टर पाहलवीबुक पाहलवीफोनिशियनपोलार्" +
+	"ड फोनेटिकइन्स्क्रिप्शनल पर्थियनरीजांगरोन्गोरोन्गोरूनिकसमरिटानसरातीसौराष्ट्रसंकेत" +
+	" लिपीशॅव्हियनसिंहलासूदानीसिलोती नागरीसिरीयाकएस्त्ट्रेन्जेलो सिरियाकपश्चिमी सिरिय" +
+	"ाकपूर्वी सिरियाकतगोआन्वाताई लीनवीन ताई लूतामिळताई विएततेलगुतेन्गवारतिफिनाघटागालो" +
+	"गथानाथाईतिबेटीयुगारिटिकवाईदृश्य संवादपुरातन फारसीदृश्यमान भाषायीवंशपरंपरागतगणिती" +
+	" संकेतलिपीप्रतीकअलिखितसामान्यअज्ञात लिपी"
+
+var mrScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xc, 0x40, 0x5b, 0x73, 0x7f, 0x7f, 0x7f, 0x8b, 0x9d, 0xc4,
+	0xdc, 0xf1, 0x100, 0x10c, 0x11b, 0x127, 0x193, 0x1a5, 0x1ae, 0x1c0, 0x1cf, 0x1e4,
+	0x1ff, 0x214, 0x26b, 0x283, 0x295, 0x295, 0x2c6, 0x2fa, 0x33a, 0x34f, 0x380, 0x398,
+	0x3b9, 0x3c8, 0x3c8, 0x3d7, 0x3ec, 0x404, 0x413, 0x41c, 0x42b, 0x44a, 0x46f, 0x481,
+	0x499, 0x499, 0x4af, 0x4e7, 0x512, 0x524, 0x543, 0x558, 0x567, 0x567, 0x57d, 0x595,
+	0x5ad, 0x5bc, 0x5bc, 0x5cb, 0x5dd, 0x5dd, 0x5ec, 0x5f8, 0x601, 0x62c, 0x64e, 0x65d,
+	0x66c, 0x67e, 0x697, 0x6b0, 0x6b0, 0x6b0, 0x6c8, 0x6e0, 0x6fb, 0x716, 0x74a, 0x74a,
+	0x74a, 0x762, 0x777, 0x78f, 0x798, 0x798, 0x7ba, 0x7d2, 0x7d2, 0x7d2, 0x7d2, 0x7e2,
+	0x7e2, 0x7ee, 0x801, 0x813, 0x822, 0x83d, 0x83d, 0x862, 0x878, 0x8b5, 0x8da, 0x8f6,
+	0x90e, 0x939, 0x979, 0x98b, 0x9af, 0x9be, 0x9d3, 0x9e2, 0x9e2, 0x9fd, 0xa19, 0xa31,
+	0xa31, 0xa31, 0xa43, 0xa43, 0xa55, 0xa77, 0xa8c, 0xacf, 0xafa, 0xb22, 0xb3a, 0xb3a,
+	0xb4a, 0xb67, 0xb76, 0xb76, 0xb8c, 0xb9b, 0xbb3, 0xbc8, 0xbdd, 0xbe9, 0xbf2, 0xc04,
+	0xc04, 0xc1f, 0xc28, 0xc47, 0xc47, 0xc47, 0xc69, 0xc8e, 0xc94, 0xcb5, 0xce0, 0xcf2,
+	0xd04, 0xd19, 0xd38,
+}
+
+const msScriptStr = "" +
+	"ArabArmeniaBengaliBopomofoBrailleCyrilDevanagariEthiopiaGeorgiaGreekGujaratGurmu" +
+	"khiHangulHanHan RingkasHan TradisionalIbraniHiraganaJepunKatakanaKhmerKannadaKor" +
+	"eaLaoLatinMalayalamMongoliaMyammarOriyaSinhalaTamilTeluguThaanaThaiTibetSimbolTi" +
+	"dak ditulisBiasaSkrip Tidak Diketahui"
+
+var msScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x4, 0x4, 0xb, 0xb, 0xb, 0xb, 0xb, 0xb, 0x12, 0x12,
+	0x1a, 0x1a, 0x21, 0x21, 0x21, 0x21, 0x21, 0x21, 0x21, 0x21, 0x21, 0x21,
+	0x21, 0x26, 0x26, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x38, 0x38, 0x3f,
+	0x3f, 0x3f, 0x3f, 0x44, 0x4b, 0x53, 0x59, 0x5c, 0x5c, 0x67, 0x76, 0x7c,
+	0x84, 0x84, 0x84, 0x84, 0x84, 0x84, 0x84, 0x84, 0x89, 0x89, 0x89, 0x91,
+	0x91, 0x96, 0x96, 0x9d, 0xa2, 0xa2, 0xa2, 0xa2, 0xa5, 0xa5, 0xa5, 0xaa,
+	0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa,
+	0xaa, 0xaa, 0xb3, 0xbb, 0xbb, 0xbb, 0xbb, 0xc2, 0xc2, 0xc2, 0xc2, 0xc2,
+	0xc2, 0xc2, 0xc2, 0xc2, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7,
+	0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7,
+	0xc7, 0xc7, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce,
+	0xce, 0xce, 0xd3, 0xd3, 0xd3, 0xd9, 0xd9, 0xd9, 0xd9, 0xdf, 0xe3, 0xe8,
+	0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xee,
+	0xfb, 0x100, 0x115,
+}
+
+const myScriptStr = "" +
+	"အာရေဗျအာမေးနီးယားဘင်္ဂါလီဘိုပိုဗြဟ္မမီဘရေစစ်ရိလစ်ဒီဗနာဂရီအီသီယိုးပီးယားဂျော်ဂျီယ" +
+	"န်ဂရိဂုဂျာရသီဂူရူဟန်ဂူးလ်ဟန်ရိုးရှင်းသော ဟန်ရှေးရိုးစဉ်လာဟန်ဟီဗရူးဟိရဂဏခတခဏ သို့" +
+	"မဟုတ် ဟိရဂဏဂျာဗားနီးစ်ဂျပန်ကယားလီခတခဏခမာခန္နာဒါကိုးရီးယားလာအိုလက်တင်မာလာရာလန်မွန" +
+	"်ဂိုလီးယားမြန်မာအိုရာဆင်ဟာလဆူဒန်တိုင်လီတမီးလ်တီလုတဂလော့ဂ်သာအ်ထိုင်းတိဘက်မြင်နိုင" +
+	"်သော စကားပါရှန် အဟောင်းရီသင်္ကေတမရေးထားသောအများနှင့်သက်ဆိုင်သောမသိ သို့မဟုတ် မရှ" +
+	"ိသော စကားလုံး"
+
+var myScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x12, 0x12, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x4b, 0x4b,
+	0x5d, 0x72, 0x7b, 0x7b, 0x7b, 0x7b, 0x7b, 0x7b, 0x7b, 0x7b, 0x7b, 0x7b,
+	0x7b, 0x93, 0x93, 0xab, 0xab, 0xab, 0xab, 0xab, 0xab, 0xd5, 0xd5, 0xf6,
+	0xf6, 0xf6, 0xf6, 0xff, 0x117, 0x123, 0x13b, 0x144, 0x144, 0x172, 0x1a2, 0x1b4,
+	0x1c3, 0x1c3, 0x1c3, 0x1fb, 0x1fb, 0x1fb, 0x1fb, 0x21c, 0x22b, 0x22b, 0x23d, 0x249,
+	0x249, 0x252, 0x252, 0x267, 0x285, 0x285, 0x285, 0x285, 0x294, 0x294, 0x294, 0x2a6,
+	0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6, 0x2a6,
+	0x2a6, 0x2a6, 0x2c1, 0x2e8, 0x2e8, 0x2e8, 0x2e8, 0x2fa, 0x2fa, 0x2fa, 0x2fa, 0x2fa,
+	0x2fa, 0x2fa, 0x2fa, 0x2fa, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309,
+	0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309, 0x309,
+	0x309, 0x309, 0x31b, 0x31b, 0x32a, 0x32a, 0x32a, 0x32a, 0x32a, 0x32a, 0x32a, 0x32a,
+	0x33f, 0x33f, 0x351, 0x351, 0x351, 0x35d, 0x35d, 0x35d, 0x375, 0x381, 0x393, 0x3a2,
+	0x3a2, 0x3a2, 0x3a2, 0x3d3, 0x3d3, 0x3d3, 0x3fb, 0x3fb, 0x401, 0x401, 0x401, 0x416,
+	0x434, 0x473, 0x4c7,
+}
+
+const neScriptStr = "" +
+	"अरबीआर्मीआर्मेनियालीआभेस्टानबालीबाटकबङ्गालीब्लिजसिम्बोल्सबोपोमोफोब्राह्मीब्रेलबु" +
+	"गिनिजबुहिदकाक्म्कारियनचामचेरोकीकिर्थकप्टिककप्रियटसिरिलिकदेवानागरीडेसेरेटइजिप्टिय" +
+	"न डेमोटिकइजिप्टियन हाइरटिकइजिप्टियन हाइरोग्लिफ्सइथियोपिकग्रुजियाली खुट्सुरीजोर्ज" +
+	"ियनग्लागोलिटिकगोथिकग्रीकगुजरातीगुरूमुखीहान्गुलहानहानुनुसरलिकृत चिनीपरम्परागत चिन" +
+	"ीहिब्रुहिरागनापहावह हमोङ्गकाताकाना वा हिरागानापुरानो हङ्गेरियालीइन्दुसपुरानो इटा" +
+	"लिकजाभानीजापानीकायाहलीकाताकानाखारोस्थितिखमेरकान्नाडाकोरियनक्थीलान्नालाओफ्राक्टुर" +
+	" ल्याटिनग्यालिक ल्याटिनल्याटिनलेप्चालिम्बुलाइसियनलाइडियनमान्डाएनमानिकाएनमाया हाइ" +
+	"रोग्लिफ्समेरियोटिकमलायालममङ्गोलजूनमाइटेइ मायेकम्यान्मारएन्कोओघामओलचिकीओर्खोनओडिय" +
+	"ाओस्मान्यापुरानो पर्मिकफाग्स-पाफ्लिफ्ल्पबुक पहल्भीफोनिसियनपोल्लार्ड फोनेटिकपिआरट" +
+	"ीरेजाङरोङ्गोरोङ्गोरूनिकसमारिटनसारतीसौराष्ट्रसाइनराइटिङशाभियनसिन्हालासुडानीस्ल्यो" +
+	"टी नाग्रीसिरियाकइस्ट्रेनजेलो सिरियाकपश्चिमी सिरियाकपूर्वी सिरियाकटाग्वान्वाटाइले" +
+	"न्यू टाइ लुइतामिलटाभ्टतेलेगुटेङ्वारटिफिनाघटागालोगथानाथाईतिब्बतीयुगारिटिकभाइदृश्य" +
+	"मय वाणीपुरानो पर्सियनयीइन्हेरिटेडजमथप्रतीकहरूनलेखिएकोसाझाअज्ञात लिपि"
+
+var neScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xc, 0x1b, 0x3c, 0x54, 0x60, 0x60, 0x60, 0x6c, 0x81, 0xab,
+	0xc3, 0xdb, 0xea, 0xff, 0x10e, 0x120, 0x120, 0x132, 0x13b, 0x14d, 0x15c, 0x16e,
+	0x183, 0x198, 0x198, 0x1b3, 0x1c8, 0x1c8, 0x1f9, 0x22a, 0x26a, 0x282, 0x2b9, 0x2d1,
+	0x2f2, 0x301, 0x301, 0x310, 0x325, 0x33d, 0x352, 0x35b, 0x36d, 0x38f, 0x3b7, 0x3c9,
+	0x3de, 0x3de, 0x400, 0x438, 0x46c, 0x47e, 0x4a3, 0x4b5, 0x4c7, 0x4c7, 0x4dc, 0x4f4,
+	0x512, 0x51e, 0x51e, 0x536, 0x548, 0x548, 0x554, 0x566, 0x56f, 0x5a0, 0x5cb, 0x5e0,
+	0x5f2, 0x604, 0x604, 0x604, 0x604, 0x604, 0x619, 0x62e, 0x646, 0x65e, 0x68f, 0x68f,
+	0x68f, 0x6aa, 0x6bf, 0x6d1, 0x6da, 0x6da, 0x6fc, 0x717, 0x717, 0x717, 0x717, 0x726,
+	0x726, 0x732, 0x744, 0x756, 0x765, 0x780, 0x780, 0x7a5, 0x7bb, 0x7c7, 0x7d6, 0x7f2,
+	0x80a, 0x83b, 0x84d, 0x85c, 0x880, 0x88f, 0x8a4, 0x8b3, 0x8b3, 0x8ce, 0x8ec, 0x8fe,
+	0x8fe, 0x8fe, 0x916, 0x916, 0x928, 0x953, 0x968, 0x9a2, 0x9cd, 0x9f5, 0xa13, 0xa13,
+	0xa22, 0xa42, 0xa51, 0xa51, 0xa60, 0xa72, 0xa87, 0xa9c, 0xab1, 0xabd, 0xac6, 0xadb,
+	0xadb, 0xaf6, 0xaff, 0xb21, 0xb21, 0xb21, 0xb49, 0xb49, 0xb4f, 0xb6d, 0xb76, 0xb91,
+	0xba9, 0xbb5, 0xbd4,
+}
+
+const nlScriptStr = "" +
+	"DefakaArabischKeizerlijk ArameesArmeensAvestaansBalineesBamounBassa VahBatakBeng" +
+	"aleesBlissymbolenBopomofoBrahmiBrailleBugineesBuhidChakmaVerenigde Canadese Abor" +
+	"iginal-symbolenCarischChamCherokeeCirthKoptischCyprischCyrillischOudkerkslavisch" +
+	" CyrillischDevanagariDeseretDuployan snelschriftEgyptisch demotischEgyptisch hië" +
+	"ratischEgyptische hiërogliefenEthiopischGeorgisch KhutsuriGeorgischGlagolitischG" +
+	"othischGranthaGrieksGujaratiGurmukhiHangulHanHanunooVereenvoudigd ChineesTraditi" +
+	"oneel ChineesHebreeuwsHiraganaAnatolische hiërogliefenPahawh HmongKatakana of Hi" +
+	"raganaOudhongaarsIndusOud-italischJavaansJapansJurchenKayah LiKatakanaKharoshthi" +
+	"KhmerKhojkiKannadaKoreaansKpelleKaithiLannaLaoGotisch LatijnGaelisch LatijnLatij" +
+	"nLepchaLimbuLineair ALineair BFraserLomaLycischLydischMandaeansManicheaansMayahi" +
+	"ërogliefenMendeMeroitisch cursiefMeroïtischMalayalamMongoolsMoonMroMeiteiMyanmar" +
+	"Oud Noord-ArabischNabateaansNaxi GebaN’KoNüshuOghamOl ChikiOrkhonOdiaOsmanyaPalm" +
+	"yreensOudpermischPhags-paInscriptioneel PahlaviPsalmen PahlaviBoek PahlaviFoenic" +
+	"ischPollard-fonetischInscriptioneel ParthischRejangRongorongoRunicSamaritaansSar" +
+	"atiOud Zuid-ArabischSaurashtraSignWritingShavianSharadaSindhiSinhalaSora Sompeng" +
+	"SoendaneesSyloti NagriSyriacEstrangelo ArameesWest-ArameesOost-ArameesTagbanwaTa" +
+	"kriTai LeNieuw Tai LueTamilTangutTai VietTeluguTengwarTifinaghTagalogThaanaThais" +
+	"TibetaansTirhutaUgaritischVaiZichtbare spraakVarang KshitiWoleaiOudperzischSumer" +
+	"o-Akkadian CuneiformYiOvergeërfdWiskundige notatieSymbolenOngeschrevenAlgemeenOn" +
+	"bekend schriftsysteem"
+
+var nlScriptIdx = []uint16{ // 159 entries
+	0x0, 0x6, 0xe, 0x20, 0x27, 0x30, 0x38, 0x3e, 0x47, 0x4c, 0x55, 0x61,
+	0x69, 0x6f, 0x76, 0x7e, 0x83, 0x89, 0xaf, 0xb6, 0xba, 0xc2, 0xc7, 0xcf,
+	0xd7, 0xe1, 0xfb, 0x105, 0x10c, 0x120, 0x133, 0x148, 0x160, 0x16a, 0x17c, 0x185,
+	0x191, 0x199, 0x1a0, 0x1a6, 0x1ae, 0x1b6, 0x1bc, 0x1bf, 0x1c6, 0x1db, 0x1ef, 0x1f8,
+	0x200, 0x219, 0x225, 0x239, 0x244, 0x249, 0x255, 0x25c, 0x262, 0x269, 0x271, 0x279,
+	0x283, 0x288, 0x28e, 0x295, 0x29d, 0x2a3, 0x2a9, 0x2ae, 0x2b1, 0x2bf, 0x2ce, 0x2d4,
+	0x2da, 0x2df, 0x2e8, 0x2f1, 0x2f7, 0x2fb, 0x302, 0x309, 0x312, 0x31d, 0x32e, 0x333,
+	0x345, 0x350, 0x359, 0x361, 0x365, 0x368, 0x36e, 0x375, 0x387, 0x391, 0x39a, 0x3a0,
+	0x3a6, 0x3ab, 0x3b3, 0x3b9, 0x3bd, 0x3c4, 0x3ce, 0x3d9, 0x3e1, 0x3f7, 0x406, 0x412,
+	0x41c, 0x42d, 0x445, 0x44b, 0x455, 0x45a, 0x465, 0x46b, 0x47c, 0x486, 0x491, 0x498,
+	0x49f, 0x4a5, 0x4ac, 0x4b8, 0x4c2, 0x4ce, 0x4d4, 0x4e6, 0x4f2, 0x4fe, 0x506, 0x50b,
+	0x511, 0x51e, 0x523, 0x529, 0x531, 0x537, 0x53e, 0x546, 0x54d, 0x553, 0x558, 0x561,
+	0x568, 0x572, 0x575, 0x585, 0x592, 0x598, 0x5a3, 0x5bc, 0x5be, 0x5c9, 0x5db, 0x5e3,
+	0x5ef, 0x5f7, 0x60e,
+}
+
+const noScriptStr = "" +
+	"afakaarabiskarameiskarmenskavestiskbalinesiskbamumbassa vahbatakbengalskblissymb" +
+	"olbopomofobrahmibraillebuginesiskbuhidchakmafelles kanadiske urspråksstavelserka" +
+	"riskchamcherokeecirthkoptiskkypriotiskkyrilliskkirkeslavisk kyrilliskdevanagarid" +
+	"eseretduployan stenografiegyptisk demotiskegyptisk hieratiskegyptiske hieroglyfe" +
+	"retiopiskgeorgisk khutsurigeorgiskglagolittiskgotiskgammeltamilskgreskgujaratigu" +
+	"rmukhihangulhanhanunooforenklet hantradisjonell hanhebraiskhiraganaanatoliske hi" +
+	"eroglyferpahawh hmongkatakana eller hiraganagammelungarskindusgammelitaliskjavan" +
+	"esiskjapanskjurchenkayah likatakanakharoshthikhmerkhojkikannadakoreanskkpellekai" +
+	"thisklannalaotiskfrakturlatinskgælisk latinsklatinsklepchalimbulineær Alineær Bf" +
+	"raserlomalykisklydiskmandaiskmanikeiskmaya-hieroglyfermendemeroitisk kursivmeroi" +
+	"tiskmalayalammongolskmoonmromeitei-mayekmyanmargammelnordarabisknabataeansknaxi " +
+	"geban'konüshuoghamol-chikiorkhonoriyaosmanyapalmyrenskgammelpermiskphags-painskr" +
+	"ipsjonspahlavipsalter pahlavipahlavifønikiskpollard-fonetiskinskripsjonsparthisk" +
+	"rejangrongorongorunersamaritansksaratigammelsørarabisksaurashtrategnskriftshavis" +
+	"ksharadakhudawadisinhalasora sompengsundanesisksyloti nagrisyriskestrangelosyria" +
+	"kiskvestlig syriakiskøstlig syriakisktagbanwatakritai leny tai luetamiltanguttai" +
+	" viettelugutengwartifinaghtagalogthaanathaitibetansktirhutaugaritiskvaisynlig ta" +
+	"levarang kshitiwoleaigammelpersisksumersk-akkadisk kileskriftyinedarvetmatematis" +
+	"k notasjonsymbolerspråk uten skriftfellesukjent skrift"
+
+var noScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0xc, 0x14, 0x1b, 0x23, 0x2d, 0x32, 0x3b, 0x40, 0x48, 0x52,
+	0x5a, 0x60, 0x67, 0x71, 0x76, 0x7c, 0x9f, 0xa5, 0xa9, 0xb1, 0xb6, 0xbd,
+	0xc7, 0xd0, 0xe6, 0xf0, 0xf7, 0x10a, 0x11b, 0x12d, 0x142, 0x14a, 0x15b, 0x163,
+	0x16f, 0x175, 0x182, 0x187, 0x18f, 0x197, 0x19d, 0x1a0, 0x1a7, 0x1b4, 0x1c4, 0x1cc,
+	0x1d4, 0x1ea, 0x1f6, 0x20d, 0x21a, 0x21f, 0x22c, 0x236, 0x23d, 0x244, 0x24c, 0x254,
+	0x25e, 0x263, 0x269, 0x270, 0x278, 0x27e, 0x286, 0x28b, 0x292, 0x2a0, 0x2af, 0x2b6,
+	0x2bc, 0x2c1, 0x2ca, 0x2d3, 0x2d9, 0x2dd, 0x2e3, 0x2e9, 0x2f1, 0x2fa, 0x30a, 0x30f,
+	0x31f, 0x328, 0x331, 0x339, 0x33d, 0x340, 0x34c, 0x353, 0x364, 0x36f, 0x378, 0x37c,
+	0x382, 0x387, 0x38f, 0x395, 0x39a, 0x3a1, 0x3ab, 0x3b8, 0x3c0, 0x3d3, 0x3e2, 0x3e9,
+	0x3f2, 0x402, 0x416, 0x41c, 0x426, 0x42b, 0x436, 0x43c, 0x44d, 0x457, 0x461, 0x468,
+	0x46f, 0x478, 0x47f, 0x48b, 0x496, 0x4a2, 0x4a8, 0x4bb, 0x4cc, 0x4dd, 0x4e5, 0x4ea,
+	0x4f0, 0x4fa, 0x4ff, 0x505, 0x50d, 0x513, 0x51a, 0x522, 0x529, 0x52f, 0x533, 0x53c,
+	0x543, 0x54c, 0x54f, 0x55a, 0x567, 0x56d, 0x57a, 0x595, 0x597, 0x59f, 0x5b2, 0x5ba,
+	0x5cc, 0x5d2, 0x5df,
+}
+
+const paScriptStr = "" +
+	"ਅਰਬੀਆਰਮੀਨੀਅਨਬੰਗਾਲੀਬੋਪੋਮੋਫੋਬ੍ਰੇਲਸਿਰੀਲਿਕਦੇਵਨਾਗਰੀਐਥਿਓਪਿਕਜਾਰਜੀਅਨਗ੍ਰੀਕਗੁਜਰਾਤੀਗੁਰਮੁਖੀਹ" +
+	"ੰਗੁਲਹਾਨਸਰਲ ਕੀਤੀ ਹਾਨਰਵਾਇਤੀ ਹਾਨਹਿਬਰੂਹਿਰਾਗਾਨਾਜਾਪਾਨੀਕਾਟਾਕਾਨਾਖਮੇਰਕੰਨੜਕੋਰੀਆਈਲਾਓਲੈਟਿਨਮਲ" +
+	"ਿਆਲਮਮੰਗੋਲੀਅਨਮਿਆਂਮਾਰਉੜੀਆਸਿੰਹਾਲਾਤਮਿਲਤੇਲਗੂਥਾਨਾਥਾਈਤਿੱਬਤੀਪ੍ਰਤੀਕ ਲਿਪੀਅਲਿਖਤਸਧਾਰਨਅਗਿਆਤ ਲ" +
+	"ਿਪੀ"
+
+var paScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xc, 0xc, 0x24, 0x24, 0x24, 0x24, 0x24, 0x24, 0x36, 0x36,
+	0x4e, 0x4e, 0x5d, 0x5d, 0x5d, 0x5d, 0x5d, 0x5d, 0x5d, 0x5d, 0x5d, 0x5d,
+	0x5d, 0x72, 0x72, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x8a, 0x9f, 0x9f, 0xb4,
+	0xb4, 0xb4, 0xb4, 0xc3, 0xd8, 0xed, 0xfc, 0x105, 0x105, 0x125, 0x141, 0x150,
+	0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x168, 0x17a, 0x17a, 0x17a, 0x192,
+	0x192, 0x19e, 0x19e, 0x1aa, 0x1bc, 0x1bc, 0x1bc, 0x1bc, 0x1c5, 0x1c5, 0x1c5, 0x1d4,
+	0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4, 0x1d4,
+	0x1d4, 0x1d4, 0x1e6, 0x1fe, 0x1fe, 0x1fe, 0x1fe, 0x213, 0x213, 0x213, 0x213, 0x213,
+	0x213, 0x213, 0x213, 0x213, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f,
+	0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f, 0x21f,
+	0x21f, 0x21f, 0x234, 0x234, 0x234, 0x234, 0x234, 0x234, 0x234, 0x234, 0x234, 0x234,
+	0x234, 0x234, 0x240, 0x240, 0x240, 0x24f, 0x24f, 0x24f, 0x24f, 0x25b, 0x264, 0x276,
+	0x276, 0x276, 0x276, 0x276, 0x276, 0x276, 0x276, 0x276, 0x276, 0x276, 0x276, 0x295,
+	0x2a4, 0x2b3, 0x2cf,
+}
+
+const plScriptStr = "" +
+	"arabskiearmiormiańskieawestyjskiebalijskiebamunbatakbengalskiesymbole Blissabopo" +
+	"mofobrahmiBraille’abugińskiebuhidchakmazunifikowane symbole kanadyjskich autocht" +
+	"onówkaryjskieczamskieczirokeskicirthkoptyjskiecypryjskiecyrylicacyrylica staro-c" +
+	"erkiewno-słowiańskadevanagarideseretegipskie demotyczneegipskie hieratycznehiero" +
+	"glify egipskieetiopskiegruzińskie chucurigruzińskiegłagolicagotyckiegreckiegudźa" +
+	"rackiegurmukhihangylhanhanunoouproszczone hantradycyjne hanhebrajskiehiraganapah" +
+	"awh hmongkatakana lub hiraganastarowęgierskieindusstarowłoskiejawajskiejapońskie" +
+	"kayah likatakanacharostikhmerskiekannadakoreańskiekaithilannalaotańskiełaciński " +
+	"- frakturałaciński - odmiana gaelickałacińskielepchalimbulinearne Alinearne Blik" +
+	"ijskielidyjskiemandejskiemanichejskiehieroglify Majówmeroickiemalajalammongolski" +
+	"eMoon'ameitei mayekbirmańskien'kooghamol chikiorchońskieorijaosmanyastaropermski" +
+	"ephags-painskrypcyjne pahlawipahlawi psałterzowypahlawi książkowyfenickifonetycz" +
+	"ny Pollard'apartyjski inskrypcyjnyrejangrongorongorunicznesamarytańskisaratisaur" +
+	"ashtrapismo znakoweshawasyngaleskiesundajskiesyloti nagrisyryjskisyriacki estran" +
+	"gelosyryjski (odmiana zachodnia)syryjski (odmiana wschodnia)tagbanwatai lenowy t" +
+	"ai luetamilskietai viettelugutengwartifinagh (berberski)tagalogthaanatajskietybe" +
+	"tańskieugaryckievaiVisible Speechstaroperskieklinowe sumero-akadyjskieyidziedzic" +
+	"zonenotacja matematycznasymbolejęzyk bez systemu pismawspólnenieznane lub niepop" +
+	"rawne"
+
+var plScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0xc, 0x17, 0x22, 0x2b, 0x30, 0x30, 0x35, 0x3f, 0x4d,
+	0x55, 0x5b, 0x66, 0x70, 0x75, 0x7b, 0xa9, 0xb2, 0xba, 0xc4, 0xc9, 0xd3,
+	0xdd, 0xe5, 0x10a, 0x114, 0x11b, 0x11b, 0x12e, 0x142, 0x155, 0x15e, 0x171, 0x17c,
+	0x186, 0x18e, 0x18e, 0x195, 0x1a1, 0x1a9, 0x1af, 0x1b2, 0x1b9, 0x1c8, 0x1d6, 0x1e0,
+	0x1e8, 0x1e8, 0x1f4, 0x209, 0x219, 0x21e, 0x22b, 0x234, 0x23e, 0x23e, 0x246, 0x24e,
+	0x256, 0x25f, 0x25f, 0x266, 0x271, 0x271, 0x277, 0x27c, 0x287, 0x29c, 0x2b9, 0x2c4,
+	0x2ca, 0x2cf, 0x2d9, 0x2e3, 0x2e3, 0x2e3, 0x2ec, 0x2f5, 0x2ff, 0x30b, 0x31c, 0x31c,
+	0x31c, 0x325, 0x32e, 0x338, 0x33e, 0x33e, 0x34a, 0x355, 0x355, 0x355, 0x355, 0x359,
+	0x359, 0x35e, 0x366, 0x371, 0x376, 0x37d, 0x37d, 0x38a, 0x392, 0x3a6, 0x3ba, 0x3cd,
+	0x3d4, 0x3e8, 0x3fe, 0x404, 0x40e, 0x416, 0x423, 0x429, 0x429, 0x433, 0x440, 0x445,
+	0x445, 0x445, 0x450, 0x450, 0x45a, 0x466, 0x46e, 0x481, 0x49d, 0x4b9, 0x4c1, 0x4c1,
+	0x4c7, 0x4d3, 0x4dc, 0x4dc, 0x4e4, 0x4ea, 0x4f1, 0x505, 0x50c, 0x512, 0x519, 0x525,
+	0x525, 0x52e, 0x531, 0x53f, 0x53f, 0x53f, 0x54b, 0x564, 0x566, 0x572, 0x586, 0x58d,
+	0x5a5, 0x5ad, 0x5c5,
+}
+
+const ptScriptStr = "" +
+	"árabearmiarmênioavésticobalinêsbamumbataquebengalisímbolos blissbopomofobrahmibr" +
+	"aillebuginêsbuhidcakmescrita silábica unificada dos aborígenes canadensescariano" +
+	"chamcherokeecirthcópticocipriotacirílicocirílico eslavo eclesiásticodevanágaride" +
+	"seretdemótico egípciohierático egípciohieróglifos egípciosetiópicokhutsuri georg" +
+	"ianogeorgianoglagolíticogóticogregogujeratigurmuquihangulhanhanunoohan simplific" +
+	"adohan tradicionalhebraicohiraganapahawh hmongkatakana ou hiraganahúngaro antigo" +
+	"indoitálico antigojavanêsjaponêskayah likatakanakharoshthikhmerkannadacoreanokth" +
+	"ilannalaolatim frakturlatim gaélicolatimlepchalimbuA linearB linearlisulíciolídi" +
+	"omandaicomaniqueanohieróglifos maiasmeroítico cursivomeroíticomalaialamongolmoon" +
+	"meitei mayekbirmanêsn'koogâmicool chikiorkhonoriyaosmaniapérmico antigophags-pap" +
+	"hliphlppahlavi antigofeníciofonético pollardprtirejangrongorongorúnicosamaritano" +
+	"saratisaurashtrasignwritingshavianocingalêssundanêssyloti nagrisiríacosiríaco es" +
+	"trangelosiríaco ocidentalsiríaco orientaltagbanwatai Lenovo tai luetâmiltavttélu" +
+	"gotengwartifinaghtagalothaanatailandêstibetanougaríticovaivisible speechpersa an" +
+	"tigosumério-acadiano cuneiformeyiherdadozmthzsymágrafocomumescrita desconhecida"
+
+var ptScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0xa, 0x12, 0x1b, 0x23, 0x28, 0x28, 0x2f, 0x36, 0x45,
+	0x4d, 0x53, 0x5a, 0x62, 0x67, 0x6b, 0xa1, 0xa8, 0xac, 0xb4, 0xb9, 0xc1,
+	0xc9, 0xd2, 0xf0, 0xfb, 0x102, 0x102, 0x114, 0x127, 0x13d, 0x146, 0x158, 0x161,
+	0x16d, 0x174, 0x174, 0x179, 0x181, 0x189, 0x18f, 0x192, 0x199, 0x1a9, 0x1b8, 0x1c0,
+	0x1c8, 0x1c8, 0x1d4, 0x1e8, 0x1f7, 0x1fb, 0x20a, 0x212, 0x21a, 0x21a, 0x222, 0x22a,
+	0x234, 0x239, 0x239, 0x240, 0x247, 0x247, 0x24b, 0x250, 0x253, 0x260, 0x26e, 0x273,
+	0x279, 0x27e, 0x286, 0x28e, 0x292, 0x292, 0x298, 0x29e, 0x2a6, 0x2b0, 0x2c2, 0x2c2,
+	0x2d4, 0x2de, 0x2e6, 0x2ec, 0x2f0, 0x2f0, 0x2fc, 0x305, 0x305, 0x305, 0x305, 0x309,
+	0x309, 0x311, 0x319, 0x31f, 0x324, 0x32b, 0x32b, 0x33a, 0x342, 0x346, 0x34a, 0x358,
+	0x360, 0x371, 0x375, 0x37b, 0x385, 0x38c, 0x396, 0x39c, 0x39c, 0x3a6, 0x3b1, 0x3b9,
+	0x3b9, 0x3b9, 0x3c2, 0x3c2, 0x3cb, 0x3d7, 0x3df, 0x3f2, 0x404, 0x415, 0x41d, 0x41d,
+	0x423, 0x42f, 0x435, 0x435, 0x439, 0x440, 0x447, 0x44f, 0x455, 0x45b, 0x465, 0x46d,
+	0x46d, 0x477, 0x47a, 0x488, 0x488, 0x488, 0x494, 0x4b0, 0x4b2, 0x4b9, 0x4bd, 0x4c1,
+	0x4c8, 0x4cd, 0x4e1,
+}
+
+const ptPTScriptStr = "" +
+	"árabearméniobengalisímbolos Blissbopomofobraillecirílicodevanágariegípcio demóti" +
+	"coegípcio hieráticoetiópicogeorgianogregoguzerategurmuquihangulhanhan simplifica" +
+	"dohan tradicionalhebraicohiraganaindusjaponêskatakanakhmerkannadacoreanolaolatim" +
+	"linear Alinear Bmalaialamongolbirmanêsoriyacingalêssiloti nagritai letâmiltelugu" +
+	"thaanatailandêstibetanocuneiforme sumero-acadianosímbolosnão escritocomumescrita" +
+	" desconhecida"
+
+var ptPTScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0x6, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0x15, 0x24,
+	0x2c, 0x2c, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+	0x33, 0x3c, 0x3c, 0x47, 0x47, 0x47, 0x59, 0x6c, 0x6c, 0x75, 0x75, 0x7e,
+	0x7e, 0x7e, 0x7e, 0x83, 0x8b, 0x93, 0x99, 0x9c, 0x9c, 0xac, 0xbb, 0xc3,
+	0xcb, 0xcb, 0xcb, 0xcb, 0xcb, 0xd0, 0xd0, 0xd0, 0xd8, 0xd8, 0xd8, 0xe0,
+	0xe0, 0xe5, 0xe5, 0xec, 0xf3, 0xf3, 0xf3, 0xf3, 0xf6, 0xf6, 0xf6, 0xfb,
+	0xfb, 0xfb, 0x103, 0x10b, 0x10b, 0x10b, 0x10b, 0x10b, 0x10b, 0x10b, 0x10b, 0x10b,
+	0x10b, 0x10b, 0x113, 0x119, 0x119, 0x119, 0x119, 0x122, 0x122, 0x122, 0x122, 0x122,
+	0x122, 0x122, 0x122, 0x122, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127,
+	0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127, 0x127,
+	0x127, 0x127, 0x130, 0x130, 0x130, 0x13c, 0x13c, 0x13c, 0x13c, 0x13c, 0x13c, 0x13c,
+	0x142, 0x142, 0x148, 0x148, 0x148, 0x14e, 0x14e, 0x14e, 0x14e, 0x154, 0x15e, 0x166,
+	0x166, 0x166, 0x166, 0x166, 0x166, 0x166, 0x166, 0x180, 0x180, 0x180, 0x180, 0x189,
+	0x195, 0x19a, 0x1ae,
+}
+
+const roScriptStr = "" +
+	"arabăarmeanăbalinezăbengalibopomofobraillesilabică aborigenă canadiană unificată" +
+	"coptăcipriotăchirilicăchirilică slavonă bisericească vechedevanagarimormonădemot" +
+	"ică egipteanăhieratică egipteanăhieroglife egipteneetiopianăgeorgiană bisericeas" +
+	"căgeorgianăglagoliticăgoticăgreacăgujaratigurmukhihangulhanhan simplificatăhan t" +
+	"radiționalăebraicăhiraganakatakana sau hiraganamaghiară vecheindusitalică vechej" +
+	"avanezăjaponezăkatakanakhmerăkannadacoreeanălaoțianălatină Frakturlatină gaelică" +
+	"latinălineară Alineară Blidianăhieroglife mayamalayalammongolăbirmanăoriyafenici" +
+	"anărunicăsingalezăsiriacăsiriacă occidentalăsiriacă orientalătamilăteluguberberă" +
+	"thaanathailandezătibetanăpersană vechecuneiformă sumero-akkadianămoștenităsimbol" +
+	"urinescrisăcomunăscriere necunoscută"
+
+var roScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0x6, 0xe, 0xe, 0x17, 0x17, 0x17, 0x17, 0x1e, 0x1e,
+	0x26, 0x26, 0x2d, 0x2d, 0x2d, 0x2d, 0x57, 0x57, 0x57, 0x57, 0x57, 0x5d,
+	0x66, 0x70, 0x97, 0xa1, 0xa9, 0xa9, 0xbd, 0xd2, 0xe5, 0xef, 0x107, 0x111,
+	0x11d, 0x124, 0x124, 0x12b, 0x133, 0x13b, 0x141, 0x144, 0x144, 0x155, 0x167, 0x16f,
+	0x177, 0x177, 0x177, 0x18c, 0x19b, 0x1a0, 0x1ae, 0x1b7, 0x1c0, 0x1c0, 0x1c0, 0x1c8,
+	0x1c8, 0x1cf, 0x1cf, 0x1d6, 0x1df, 0x1df, 0x1df, 0x1df, 0x1e9, 0x1f8, 0x208, 0x20f,
+	0x20f, 0x20f, 0x219, 0x223, 0x223, 0x223, 0x223, 0x22b, 0x22b, 0x22b, 0x23a, 0x23a,
+	0x23a, 0x23a, 0x243, 0x24b, 0x24b, 0x24b, 0x24b, 0x253, 0x253, 0x253, 0x253, 0x253,
+	0x253, 0x253, 0x253, 0x253, 0x258, 0x258, 0x258, 0x258, 0x258, 0x258, 0x258, 0x258,
+	0x262, 0x262, 0x262, 0x262, 0x262, 0x269, 0x269, 0x269, 0x269, 0x269, 0x269, 0x269,
+	0x269, 0x269, 0x273, 0x273, 0x273, 0x273, 0x27b, 0x27b, 0x290, 0x2a3, 0x2a3, 0x2a3,
+	0x2a3, 0x2a3, 0x2aa, 0x2aa, 0x2aa, 0x2b0, 0x2b0, 0x2b8, 0x2b8, 0x2be, 0x2ca, 0x2d3,
+	0x2d3, 0x2d3, 0x2d3, 0x2d3, 0x2d3, 0x2d3, 0x2e1, 0x2fe, 0x2fe, 0x309, 0x309, 0x312,
+	0x31b, 0x322, 0x336,
+}
+
+const ruScriptStr = "" +
+	"афакаАрабскаяАрамейскаяАрмянскаяАвестийскаяБалийскаябамумбасса (вах)БатакскаяБен" +
+	"гальскаяБлиссимволикаБопомофоБрахмиБрайляБугинизийскаяБухидЧакмийскаяКанадское с" +
+	"логовое письмоКарийскаяЧамскаяЧерокиКиртКоптскаяКипрскаяКириллицаСтарославянская" +
+	"ДеванагариДезеретдуплоянская скорописьЕгипетская демотическаяЕгипетская иератиче" +
+	"скаяЕгипетская иероглифическаяЭфиопскаяГрузинская хуцуриГрузинскаяГлаголицаГотск" +
+	"аягрантхаГреческаяГуджаратиГурмукхиХангулКитайскаяХанунуУпрощенный китайскийТрад" +
+	"иционный китайскийИвритХираганалувийские иероглифыПахау хмонгКатакана или хирага" +
+	"наСтаровенгерскаяХараппская (письменность долины Инда)СтароитальянскаяЯванскаяЯп" +
+	"онскаячжурчжэньскаяКайаКатаканаКхароштхиКхмерскаяходжикиКаннадаКорейскаякпеллеКа" +
+	"йтхиЛаннаЛаосскаяЛатинская фрактураГэльская латинскаяЛатиницаЛепхаЛимбуЛинейное " +
+	"письмо АЛинейное письмо БлисуломаЛицианЛидийскаяМандейскаяМанихейскаяМайямендеме" +
+	"роитская курсивнаяМероитскаяМалаяльскаяМонгольскаяАзбука МунамроМанипуриМайанмар" +
+	"скаясеверноаравийскоенабатейскаянаси гебаНконюй-шуОгамическаяОл ЧикиОрхоно-енисе" +
+	"йскаяОрияОсманскаяпальмирыДревнепермскаяПагспапехлевийскаяпахлави псалтирнаяПахл" +
+	"ави книжнаяФиникийскаяПоллардовская фонетикапарфянскаяРеджангскаяРонго-ронгоРуни" +
+	"ческаяСамаритянскаяСаратистароюжноарабскаяСаураштраЯзык знаковАлфавит Шоушарадак" +
+	"худавадиСингальскаясора-сонпенгСунданскаяСилоти НагриСирийскаяСирийская эстранге" +
+	"лоЗападносирийскаяВосточно-сирийскаяТагбанватакриТайский ЛеНовый Тайский ЛеТамил" +
+	"ьскаятангутское менятай-вьетТелугуТенгварскаяДревнеливийскаяТагалогТаанаТайскаяТ" +
+	"ибетскаятирхутаУгаритскаяВайскаяВидимая речьваранг-кшитиволеаиСтароперсидскаяШум" +
+	"еро-аккадская клинописьИУнаследованнаяматематические обозначенияСимволыНенаписан" +
+	"наяОбщепринятаяНеизвестная письменность"
+
+var ruScriptIdx = []uint16{ // 159 entries
+	0x0, 0xa, 0x1a, 0x2e, 0x40, 0x56, 0x68, 0x72, 0x85, 0x97, 0xad, 0xc7,
+	0xd7, 0xe3, 0xef, 0x109, 0x113, 0x127, 0x157, 0x169, 0x177, 0x183, 0x18b, 0x19b,
+	0x1ab, 0x1bd, 0x1db, 0x1ef, 0x1fd, 0x226, 0x253, 0x280, 0x2b3, 0x2c5, 0x2e6, 0x2fa,
+	0x30c, 0x31a, 0x328, 0x33a, 0x34c, 0x35c, 0x368, 0x37a, 0x386, 0x3ad, 0x3d8, 0x3e2,
+	0x3f2, 0x417, 0x42c, 0x454, 0x472, 0x4b7, 0x4d7, 0x4e7, 0x4f7, 0x511, 0x519, 0x529,
+	0x53b, 0x54d, 0x55b, 0x569, 0x57b, 0x587, 0x593, 0x59d, 0x5ad, 0x5d0, 0x5f3, 0x603,
+	0x60d, 0x617, 0x637, 0x657, 0x65f, 0x667, 0x673, 0x685, 0x699, 0x6af, 0x6b7, 0x6c1,
+	0x6e8, 0x6fc, 0x712, 0x728, 0x73d, 0x743, 0x753, 0x76b, 0x78d, 0x7a3, 0x7b4, 0x7ba,
+	0x7c5, 0x7db, 0x7e8, 0x809, 0x811, 0x823, 0x833, 0x84f, 0x85b, 0x873, 0x896, 0x8b3,
+	0x8c9, 0x8f4, 0x908, 0x91e, 0x933, 0x947, 0x961, 0x96d, 0x98f, 0x9a1, 0x9b6, 0x9cb,
+	0x9d7, 0x9e9, 0x9ff, 0xa16, 0xa2a, 0xa41, 0xa53, 0xa7a, 0xa9a, 0xabd, 0xacd, 0xad7,
+	0xaea, 0xb08, 0xb1c, 0xb39, 0xb48, 0xb54, 0xb6a, 0xb88, 0xb96, 0xba0, 0xbae, 0xbc0,
+	0xbce, 0xbe2, 0xbf0, 0xc07, 0xc1e, 0xc2a, 0xc48, 0xc7a, 0xc7c, 0xc98, 0xccb, 0xcd9,
+	0xcf1, 0xd09, 0xd38,
+}
+
+const siScriptStr = "" +
+	"අරාබිආර්මේනියානුබෙංගාලිබොපොමොෆෝබ්‍රේල්සිරිලික්දේවනාගරීඉතියෝපියානුජෝර්ජියානුග්‍රී" +
+	"කගුජරාටිගුර්මුඛිහැන්ගුල්හන්සුළුකළ හෑන්සම්ප්‍රදායික හෑන්හීබෲහිරඟනාජපන්කතකනාකමර්කණ" +
+	"්ණඩකොරියානුලාඕලතින්මලයාලම්මොන්ගෝලියානුමියන්මාරඔරියාසිංහලදෙමළතෙළිඟුතානතායිටි‍බෙට්" +
+	"සංකේතඅලිඛිතපොදු.නොදත් අක්ෂර මාලාව"
+
+var siScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0xf, 0xf, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x45, 0x45,
+	0x5d, 0x5d, 0x72, 0x72, 0x72, 0x72, 0x72, 0x72, 0x72, 0x72, 0x72, 0x72,
+	0x72, 0x8a, 0x8a, 0xa2, 0xa2, 0xa2, 0xa2, 0xa2, 0xa2, 0xc3, 0xc3, 0xe1,
+	0xe1, 0xe1, 0xe1, 0xf3, 0x108, 0x120, 0x138, 0x141, 0x141, 0x160, 0x191, 0x19d,
+	0x1af, 0x1af, 0x1af, 0x1af, 0x1af, 0x1af, 0x1af, 0x1af, 0x1bb, 0x1bb, 0x1bb, 0x1ca,
+	0x1ca, 0x1d6, 0x1d6, 0x1e5, 0x1fd, 0x1fd, 0x1fd, 0x1fd, 0x206, 0x206, 0x206, 0x215,
+	0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215, 0x215,
+	0x215, 0x215, 0x22a, 0x24e, 0x24e, 0x24e, 0x24e, 0x266, 0x266, 0x266, 0x266, 0x266,
+	0x266, 0x266, 0x266, 0x266, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275,
+	0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275, 0x275,
+	0x275, 0x275, 0x284, 0x284, 0x284, 0x284, 0x284, 0x284, 0x284, 0x284, 0x284, 0x284,
+	0x284, 0x284, 0x290, 0x290, 0x290, 0x2a2, 0x2a2, 0x2a2, 0x2a2, 0x2ab, 0x2b7, 0x2cc,
+	0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2cc, 0x2db,
+	0x2ed, 0x2fa, 0x329,
+}
+
+const skScriptStr = "" +
+	"arabskéarménskebalijskýbengálskebopomofobraillovo písmocyrilikadévanágaríegyptsk" +
+	"é hieroglyfyetiópskegruzínskehlaholikagotickýgréckegudžarátígurmukhihangulhanhan" +
+	" – zjednodušenéhan – tradičnéhebrejskéhiraganajaponskékatakanakhmérskekannadskék" +
+	"órejskélaoskélatinkalineárna Alineárna Bmayské hieroglyfymalajálamskemongolskémj" +
+	"anmarskéuríjskeosmanskýRunové písmosinhálsketamilskételugskétánathajskétibetskés" +
+	"ymbolybez zápisuvšeobecnéneznáme písmo"
+
+var skScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0x8, 0x11, 0x11, 0x1a, 0x1a, 0x1a, 0x1a, 0x24, 0x24,
+	0x2c, 0x2c, 0x3c, 0x3c, 0x3c, 0x3c, 0x3c, 0x3c, 0x3c, 0x3c, 0x3c, 0x3c,
+	0x3c, 0x44, 0x44, 0x51, 0x51, 0x51, 0x51, 0x51, 0x65, 0x6e, 0x6e, 0x78,
+	0x81, 0x89, 0x89, 0x90, 0x9c, 0xa4, 0xaa, 0xad, 0xad, 0xc3, 0xd5, 0xdf,
+	0xe7, 0xe7, 0xe7, 0xe7, 0xe7, 0xe7, 0xe7, 0xe7, 0xf0, 0xf0, 0xf0, 0xf8,
+	0xf8, 0x101, 0x101, 0x10b, 0x115, 0x115, 0x115, 0x115, 0x11c, 0x11c, 0x11c, 0x123,
+	0x123, 0x123, 0x12e, 0x139, 0x139, 0x139, 0x139, 0x139, 0x139, 0x139, 0x14b, 0x14b,
+	0x14b, 0x14b, 0x158, 0x162, 0x162, 0x162, 0x162, 0x16d, 0x16d, 0x16d, 0x16d, 0x16d,
+	0x16d, 0x16d, 0x16d, 0x16d, 0x175, 0x17e, 0x17e, 0x17e, 0x17e, 0x17e, 0x17e, 0x17e,
+	0x17e, 0x17e, 0x17e, 0x17e, 0x17e, 0x18c, 0x18c, 0x18c, 0x18c, 0x18c, 0x18c, 0x18c,
+	0x18c, 0x18c, 0x196, 0x196, 0x196, 0x196, 0x196, 0x196, 0x196, 0x196, 0x196, 0x196,
+	0x196, 0x196, 0x19f, 0x19f, 0x19f, 0x1a8, 0x1a8, 0x1a8, 0x1a8, 0x1ad, 0x1b5, 0x1be,
+	0x1be, 0x1be, 0x1be, 0x1be, 0x1be, 0x1be, 0x1be, 0x1be, 0x1be, 0x1be, 0x1be, 0x1c5,
+	0x1d0, 0x1db, 0x1ea,
+}
+
+const slScriptStr = "" +
+	"arabskiimperialno-aramejskiarmenskiavestanskibalijskibataškibengalskiznakovna pi" +
+	"sava Blissbopomofobramanskibraillova pisavabuginskibuhidskipoenotena zlogovna pi" +
+	"sava kanadskih staroselcevChamčerokeškikirtkoptskiciprskicirilicastarocerkvenosl" +
+	"ovanska cirilicadevanagarščicafonetska pisava deseretdemotska egipčanska pisavah" +
+	"ieratska egipčanska pisavaegipčanska slikovna pisavaetiopskicerkvenogruzijskigru" +
+	"zijskiglagoliškigotskigrškigudžaratskigurmukihangulkanjihanunskiPoenostavljeni h" +
+	"anTradicionalni hanhebrejskihiraganapahavhmonska zlogovna pisavakatakana ali hir" +
+	"aganastaroogrskiinduškistaroitalskijavanskijaponskikarenskikatakanagandarskikmer" +
+	"skikanadskikorejskikajatskilaoškifrakturagelski latiničnilatinicalepškilimbuškil" +
+	"inearna pisava Alinearna pisava Blicijskilidijskimandanskimanihejskimajevska sli" +
+	"kovna pisavameroitskimalajalamskimongolskiMoonova pisava za slepemanipurskimjanm" +
+	"arskiogamskisantalskiorkonskiorijskiosmanskistaropermijskipagpajskivrezani napis" +
+	"i pahlavipsalmski pahlaviknjižno palavanskifeničanskiPollardova fonetska pisavar" +
+	"ongorongorunskisamaritanskisaratskiznakovna pisavašojevskisinhalskisundanskisile" +
+	"tsko-nagarijskisirijskisirska abeceda estrangelozahodnosirijskivzhodnosirijskita" +
+	"gbanskitamilskitajsko-vietnamskiteluškitengvarskitifinajskitagaloškitanajskitajs" +
+	"kitibetanskiugaritskizlogovna pisava vaividni govorstaroperzijskisumersko-akadsk" +
+	"i klinopispodedovanmatematična znamenjasimbolinenapisanosplošnoneznan ali nevelj" +
+	"aven zapis"
+
+var slScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x7, 0x1b, 0x23, 0x2d, 0x35, 0x35, 0x35, 0x3d, 0x46, 0x5b,
+	0x63, 0x6c, 0x7c, 0x84, 0x8c, 0x8c, 0xbb, 0xbb, 0xbf, 0xca, 0xce, 0xd5,
+	0xdc, 0xe4, 0x103, 0x113, 0x12a, 0x12a, 0x145, 0x161, 0x17c, 0x184, 0x195, 0x19e,
+	0x1a9, 0x1af, 0x1af, 0x1b5, 0x1c1, 0x1c8, 0x1ce, 0x1d3, 0x1db, 0x1ed, 0x1fe, 0x207,
+	0x20f, 0x20f, 0x22b, 0x240, 0x24b, 0x253, 0x25f, 0x267, 0x26f, 0x26f, 0x277, 0x27f,
+	0x288, 0x28f, 0x28f, 0x297, 0x29f, 0x29f, 0x2a7, 0x2a7, 0x2ae, 0x2b6, 0x2c7, 0x2cf,
+	0x2d6, 0x2df, 0x2f0, 0x301, 0x301, 0x301, 0x309, 0x311, 0x31a, 0x324, 0x33c, 0x33c,
+	0x33c, 0x345, 0x351, 0x35a, 0x371, 0x371, 0x37b, 0x385, 0x385, 0x385, 0x385, 0x385,
+	0x385, 0x38c, 0x395, 0x39d, 0x3a4, 0x3ac, 0x3ac, 0x3ba, 0x3c3, 0x3d9, 0x3e9, 0x3fc,
+	0x407, 0x421, 0x421, 0x421, 0x42b, 0x431, 0x43d, 0x445, 0x445, 0x445, 0x454, 0x45d,
+	0x45d, 0x45d, 0x466, 0x466, 0x46f, 0x482, 0x48a, 0x4a3, 0x4b2, 0x4c1, 0x4ca, 0x4ca,
+	0x4ca, 0x4ca, 0x4d2, 0x4d2, 0x4e3, 0x4eb, 0x4f5, 0x4ff, 0x509, 0x511, 0x517, 0x521,
+	0x521, 0x52a, 0x53d, 0x548, 0x548, 0x548, 0x556, 0x56f, 0x56f, 0x578, 0x58d, 0x594,
+	0x59e, 0x5a6, 0x5c1,
+}
+
+const sqScriptStr = "" +
+	"ArabikArmenBengalBopomofBrailCirilikDevanagarEtiopianGjeorgjianGrekGuxharatasGur" +
+	"mukHangulHanHan i ThjeshtësuarHan TradicionalHebraikHiraganJaponezKatakanKmerKan" +
+	"adaKoreanLaoLatinMalajalamMongolBirmanOrijaSinhalTamilTelugTanaTajlandezTibetian" +
+	"Me simboleI pashkruarI zakonshëmI panjohur"
+
+var sqScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x6, 0x6, 0xb, 0xb, 0xb, 0xb, 0xb, 0xb, 0x11, 0x11,
+	0x18, 0x18, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d,
+	0x1d, 0x24, 0x24, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x35, 0x35, 0x3f,
+	0x3f, 0x3f, 0x3f, 0x43, 0x4d, 0x53, 0x59, 0x5c, 0x5c, 0x6f, 0x7e, 0x85,
+	0x8c, 0x8c, 0x8c, 0x8c, 0x8c, 0x8c, 0x8c, 0x8c, 0x93, 0x93, 0x93, 0x9a,
+	0x9a, 0x9e, 0x9e, 0xa4, 0xaa, 0xaa, 0xaa, 0xaa, 0xad, 0xad, 0xad, 0xb2,
+	0xb2, 0xb2, 0xb2, 0xb2, 0xb2, 0xb2, 0xb2, 0xb2, 0xb2, 0xb2, 0xb2, 0xb2,
+	0xb2, 0xb2, 0xbb, 0xc1, 0xc1, 0xc1, 0xc1, 0xc7, 0xc7, 0xc7, 0xc7, 0xc7,
+	0xc7, 0xc7, 0xc7, 0xc7, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc,
+	0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc,
+	0xcc, 0xcc, 0xd2, 0xd2, 0xd2, 0xd2, 0xd2, 0xd2, 0xd2, 0xd2, 0xd2, 0xd2,
+	0xd2, 0xd2, 0xd7, 0xd7, 0xd7, 0xdc, 0xdc, 0xdc, 0xdc, 0xe0, 0xe9, 0xf1,
+	0xf1, 0xf1, 0xf1, 0xf1, 0xf1, 0xf1, 0xf1, 0xf1, 0xf1, 0xf1, 0xf1, 0xfb,
+	0x106, 0x112, 0x11c,
+}
+
+const srScriptStr = "" +
+	"арапско писмоимперијско арамејско писмојерменско писмоавестанско писмобалијско п" +
+	"исмобатак писмобенгалско писмоблисимболично писмобопомофо писмобраманско писмоБр" +
+	"ајево писмобугинско писмобухидско писмочакманско писмоуједињени канадски абориџи" +
+	"нски силабицикаријско писмочамско писмоЧерокицирт писмокоптичко писмокипарско пи" +
+	"смоЋирилицаСтарословенска црквена ћирилицаДеванагариДезеретегипатско народно пис" +
+	"моегипатско хијератско писмоегипатски хијероглифиетиопско писмогрузијско кхутсур" +
+	"и писмогрузијско писмоглагољицаГотикагрчко писмогујарати писмогурмуки писмохангу" +
+	"лханханунопоједностављено хан писмотрадиционално хан писмохебрејско писмоХираган" +
+	"апахав хмонг писмоКатакана или Хираганастаромађарско писмоиндушко писмостари ита" +
+	"ликјаванско писмојапанско писмокајах-ли писмоКатаканакарошти писмокмерско писмок" +
+	"аннада писмокорејско писмокаитиланна писмолаошко писмолатиница (фрактур варијант" +
+	"а)галска латиницаЛатиницалепча писмолимбу писмолинеарно А писмолинеарно Б писмол" +
+	"исијско писмолидијско писмомандеанско писмоманихејско писмомајански хијероглифим" +
+	"ероитик писмомалајалам писмомонголско писмомесечево писмомеитеи мајек писмомијан" +
+	"марско писмон’ко писмоогамско писмоол чики писмоорконско писмооријанско писмоосм" +
+	"ањанско писмостаро пермикско писмопагс-па писмописани пахлавипсалтер пахлавипахл" +
+	"ави писмоФеничанско писмопоралд фонетско писмописани партианрејанг писморонгорон" +
+	"го писморунско писмосамаританско писмосарати писмосаураштра писмознаковно писмош" +
+	"авијанско писмосинхала писмосуданско писмосилоти нагри писмосиријско писмосиријс" +
+	"ко естрангело писмозападносиријско писмописмо источне Сиријетагбанва писмотаи ле" +
+	" писмонови таи луетамилско писмотаи виет писмотелугу писмотенгвар писмотифинаг п" +
+	"исмоТагалогтхана писмотајландско писмотибетанско писмоугаритско писмоваи писмови" +
+	"дљиви говорстароперсијско писмосумерско-акадско кунеиформ писмоји писмонаследно " +
+	"писмоматематичка нотацијасимболиНеписани језикзаједничко писмоНепознато или нева" +
+	"жеће писмо"
+
+var srScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x19, 0x4b, 0x68, 0x87, 0xa2, 0xa2, 0xa2, 0xb7, 0xd4, 0xf9,
+	0x114, 0x131, 0x14a, 0x165, 0x180, 0x19d, 0x1e8, 0x203, 0x21a, 0x226, 0x239, 0x254,
+	0x26f, 0x27f, 0x2bb, 0x2cf, 0x2dd, 0x2dd, 0x309, 0x33b, 0x364, 0x37f, 0x3ad, 0x3ca,
+	0x3dc, 0x3e8, 0x3e8, 0x3fd, 0x418, 0x431, 0x43d, 0x443, 0x44f, 0x47f, 0x4ab, 0x4c8,
+	0x4d8, 0x4d8, 0x4f8, 0x520, 0x545, 0x55e, 0x575, 0x590, 0x5ab, 0x5ab, 0x5c5, 0x5d5,
+	0x5ee, 0x607, 0x607, 0x620, 0x63b, 0x63b, 0x645, 0x65a, 0x671, 0x6a5, 0x6c2, 0x6d2,
+	0x6e7, 0x6fc, 0x71a, 0x738, 0x738, 0x738, 0x753, 0x76e, 0x78d, 0x7ac, 0x7d3, 0x7d3,
+	0x7d3, 0x7ee, 0x80b, 0x828, 0x843, 0x843, 0x865, 0x886, 0x886, 0x886, 0x886, 0x89a,
+	0x89a, 0x8b3, 0x8cb, 0x8e6, 0x903, 0x922, 0x922, 0x94a, 0x962, 0x97d, 0x99a, 0x9b3,
+	0x9d2, 0x9fa, 0xa15, 0xa2c, 0xa4b, 0xa62, 0xa85, 0xa9c, 0xa9c, 0xab9, 0xad4, 0xaf3,
+	0xaf3, 0xaf3, 0xb0c, 0xb0c, 0xb27, 0xb49, 0xb64, 0xb94, 0xbbd, 0xbe3, 0xbfe, 0xbfe,
+	0xc14, 0xc2a, 0xc45, 0xc45, 0xc5f, 0xc76, 0xc8f, 0xca8, 0xcb6, 0xccb, 0xcea, 0xd09,
+	0xd09, 0xd26, 0xd37, 0xd50, 0xd50, 0xd50, 0xd77, 0xdb4, 0xdc3, 0xdde, 0xe05, 0xe13,
+	0xe2e, 0xe4d, 0xe82,
+}
+
+const svScriptStr = "" +
+	"afakiskaarabiskaimperisk arameiskaarmeniskaavestiskabalinesiskabamunskabassaiska" +
+	" vahbatakbengaliskablissymbolerbopomofobramipunktskriftbuginesiskabuhidchakmakan" +
+	"adensiska stavelseteckenkariskachamcherokeecirtkoptiskacypriotiskakyrilliskaforn" +
+	"kyrkoslavisk kyrilliskadevanagarideseretDuployéstenografiskademotiskahieratiskae" +
+	"gyptiska hieroglyferetiopiskakutsurigeorgiskaglagolitiskagotiskagammaltamilskagr" +
+	"ekiskagujaratigurmukhihangulhanhanunåförenklade han-teckentraditionella han-teck" +
+	"enhebreiskahiraganahittitiska hieroglyferpahaw mongkatakana/hiraganafornungerska" +
+	"indusfornitaliskajavanskajapanskajurchenskakaya likatakanakharoshtikhmeriskakhoj" +
+	"kiskakanaresiskakoreanskakpellékaithiskalannalaotiskafrakturlatingaeliskt latinl" +
+	"atinskaronglimbulinjär Alinjär BFraserlomalykiskalydiskamandaéiskamanikeanskamay" +
+	"ahieroglyfermendekursiv-meroitiskameriotiskamalayalammongoliskamoonmrumeitei-may" +
+	"ekburmesiskafornnordarabiskanabatateiskanaxi geban-kånüshuoghamol-chikiorkonoriy" +
+	"aosmanjapalmyreniskafornpermiskaphags-patidig pahlavipsaltaren-pahlavibokpahlavi" +
+	"fenikiskapollardteckentidig parthianskarejangrongo-rongorunorsamaritiskasaratifo" +
+	"rnsydarabiskasaurashtrateckningsskriftshawiskasharadasindhiskasingalesiskasora s" +
+	"ompengsundanesiskasyloti nagrisyriskaestrangelosyriskavästsyriskaöstsyriskatagba" +
+	"nwatakritiskatai letai luetamilskatangutiskatai viettelugutengwartifinaghiskatag" +
+	"alogtaanathailändskatibetanskatirhutaugaritiskavajsynligt talvarang kshitiwoleai" +
+	"fornpersiskasumeo-akkadisk kilskriftyiärvdamatematisk notationsymboleroskrivet s" +
+	"pråkgemensammaokänt skriftsystem"
+
+var svScriptIdx = []uint16{ // 159 entries
+	0x0, 0x8, 0x10, 0x22, 0x2b, 0x34, 0x3f, 0x47, 0x54, 0x59, 0x63, 0x6f,
+	0x77, 0x7c, 0x87, 0x92, 0x97, 0x9d, 0xb8, 0xbf, 0xc3, 0xcb, 0xcf, 0xd7,
+	0xe2, 0xec, 0x107, 0x111, 0x118, 0x12d, 0x136, 0x140, 0x155, 0x15e, 0x165, 0x16e,
+	0x17a, 0x181, 0x18f, 0x197, 0x19f, 0x1a7, 0x1ad, 0x1b0, 0x1b7, 0x1cd, 0x1e5, 0x1ee,
+	0x1f6, 0x20c, 0x216, 0x227, 0x233, 0x238, 0x244, 0x24c, 0x254, 0x25e, 0x265, 0x26d,
+	0x276, 0x27f, 0x288, 0x293, 0x29c, 0x2a3, 0x2ac, 0x2b1, 0x2b9, 0x2c5, 0x2d3, 0x2db,
+	0x2df, 0x2e4, 0x2ed, 0x2f6, 0x2fc, 0x300, 0x307, 0x30e, 0x319, 0x324, 0x333, 0x338,
+	0x349, 0x353, 0x35c, 0x366, 0x36a, 0x36d, 0x379, 0x383, 0x393, 0x39f, 0x3a8, 0x3ad,
+	0x3b3, 0x3b8, 0x3c0, 0x3c5, 0x3ca, 0x3d1, 0x3dd, 0x3e9, 0x3f1, 0x3fe, 0x40f, 0x419,
+	0x422, 0x42f, 0x440, 0x446, 0x451, 0x456, 0x461, 0x467, 0x476, 0x480, 0x48f, 0x497,
+	0x49e, 0x4a7, 0x4b3, 0x4bf, 0x4cb, 0x4d7, 0x4de, 0x4ef, 0x4fb, 0x506, 0x50e, 0x518,
+	0x51e, 0x525, 0x52d, 0x537, 0x53f, 0x545, 0x54c, 0x558, 0x55f, 0x564, 0x570, 0x57a,
+	0x581, 0x58b, 0x58e, 0x599, 0x5a6, 0x5ac, 0x5b8, 0x5d0, 0x5d2, 0x5d8, 0x5eb, 0x5f3,
+	0x602, 0x60c, 0x61f,
+}
+
+const swScriptStr = "" +
+	"KiarabuKiarmeniaKibengaliKibopomofoBrailleKisirilikiKidevanagariKiethiopiaKijoji" +
+	"aKigirikiKigujaratiKigurmukhiKihangulKihanKihan RahisiKihan cha JadiKiebraniaKih" +
+	"iraganaKijapaniKikatakanaKikambodiaKikannadaKikoreaKilaosiKilatiniKimalayalamMon" +
+	"goliaMyamaKioriyaKisinhalaKitamilKiteluguKithaanaKitaiKitibetiAlamaHaiandikwiKaw" +
+	"aidaHati isiyojulikana"
+
+var swScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x7, 0x7, 0x10, 0x10, 0x10, 0x10, 0x10, 0x10, 0x19, 0x19,
+	0x23, 0x23, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x2a,
+	0x2a, 0x34, 0x34, 0x40, 0x40, 0x40, 0x40, 0x40, 0x40, 0x4a, 0x4a, 0x51,
+	0x51, 0x51, 0x51, 0x59, 0x63, 0x6d, 0x75, 0x7a, 0x7a, 0x86, 0x94, 0x9d,
+	0xa7, 0xa7, 0xa7, 0xa7, 0xa7, 0xa7, 0xa7, 0xa7, 0xaf, 0xaf, 0xaf, 0xb9,
+	0xb9, 0xc3, 0xc3, 0xcc, 0xd3, 0xd3, 0xd3, 0xd3, 0xda, 0xda, 0xda, 0xe2,
+	0xe2, 0xe2, 0xe2, 0xe2, 0xe2, 0xe2, 0xe2, 0xe2, 0xe2, 0xe2, 0xe2, 0xe2,
+	0xe2, 0xe2, 0xed, 0xf5, 0xf5, 0xf5, 0xf5, 0xfa, 0xfa, 0xfa, 0xfa, 0xfa,
+	0xfa, 0xfa, 0xfa, 0xfa, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101,
+	0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101,
+	0x101, 0x101, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a, 0x10a,
+	0x10a, 0x10a, 0x111, 0x111, 0x111, 0x119, 0x119, 0x119, 0x119, 0x121, 0x126, 0x12e,
+	0x12e, 0x12e, 0x12e, 0x12e, 0x12e, 0x12e, 0x12e, 0x12e, 0x12e, 0x12e, 0x12e, 0x133,
+	0x13d, 0x144, 0x156,
+}
+
+const taScriptStr = "" +
+	"அரபிக்இம்பேரியல் அரமெய்க்அர்மேனியன்அவெஸ்தான்பாலினீஸ்பாடாக்வங்காளம்ப்லிஸ்ஸிமிபால்" +
+	"ஸ்ப்ரபோமோஃபோபிரம்மிப்ரைல்புகினீஸ்புகித்சக்மாயுனிஃபைடு கனடியன் அபொரிஜினல் சிலபிக்" +
+	"ஸ்கரியன்சாம்செரோக்கிகிர்த்காப்டிக்சைப்ரியாட்சிரிலிக்பழைய சர்ச் ஸ்லவோனிக் சிரிலிக" +
+	"்தேவனாகரிடெசராட்எகிப்தியன் டெமோட்டிக்எகிப்தியன் ஹைரேட்டிக்எகிப்தியன் ஹைரோகிளிப்ஸ" +
+	"்யெத்தியோப்பிக்ஜியார்ஜியன் குட்சுரிஜார்ஜியன்க்லாகோலிடிக்கோதிக்கிரேக்கம்குஜராத்தி" +
+	"குர்முகிஹங்குல்ஹான்ஹனுனூஎளிதாக்கப்பட்ட ஹான்பாரம்பரிய ஹான்ஹிப்ரூஹிராகானாபஹாவ் மாங" +
+	"்க்கடாகானா அல்லது ஹிராகானாபழைய ஹங்கேரியன்சிந்துபழைய இத்தாலிஜாவனீஸ்ஜாப்பனீஸ்கயாஹ்" +
+	" லீகடகனாகரோஷ்டிகமெர்கன்னடம்கொரியன்காய்திலன்னாலௌஃப்ரக்டூர் லெத்தின்கேலிக் லெத்தின" +
+	"்லத்தின்லெப்சாலிம்புலினியர் ஏலினியர் பிலிசியன்லிடியன்மேன்டியன்மனிசெய்ன்மயான் ஹைர" +
+	"ோகிளிப்மெராய்டிக்மலையாளம்மங்கோலியன்மூன்மெய்தெய் மயக்மியான்மர்என்'கோஒகாம்ஒல் சிக்" +
+	"கிஆர்கான்ஒரியாஒஸ்மான்யாபழைய பெர்மிக்பக்ஸ்-பாஇன்ஸ்கிரிப்ஷனல் பஹலவிசால்டர் பஹலவிபு" +
+	"க் பஹலவிஃபோனேஷியன்போலார்ட் ஃபொனெட்டிக்இன்ஸ்கிரிப்ஷனல் பார்த்தியன்ரெஜெய்ன்ரொங்கோர" +
+	"ொங்கோருனிக்சமாரிடன்சாராதிசௌராஷ்ட்ராஸைன்எழுத்துஷவியான்சிங்கலம்சுந்தானீஸ்சிலோடி நக" +
+	"்ரிசிரியாக்எஸ்ட்ரெங்கெலோ சிரியாக்மேற்கு சிரியாக்கிழக்கு சிரியாக்தகோவானாதாய் லேபு" +
+	"திய தை லூதமிழ்தை வியத்தெலுங்குதெங்வார்டிஃபினாக்தகலாக்தானாதாய்திபேத்தியன்உகாரதிக்" +
+	"வைவிசிபிள் ஸ்பீச்பழைய பெர்ஷியன்சுமெரோ-அக்கடியன் க்யூனிஃபார்ம்யீபாரம்பரியமானகணிதக" +
+	"்குறியீடுசின்னங்கள்எழுதப்படாததுசாதரணஅறியப்படாத எழுத்து"
+
+var taScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x12, 0x49, 0x67, 0x82, 0x9a, 0x9a, 0x9a, 0xac, 0xc4, 0xf4,
+	0x112, 0x127, 0x139, 0x151, 0x163, 0x172, 0x1de, 0x1f0, 0x1fc, 0x214, 0x226, 0x23e,
+	0x25c, 0x274, 0x2c5, 0x2dd, 0x2f2, 0x2f2, 0x32f, 0x36c, 0x3af, 0x3d9, 0x413, 0x42e,
+	0x452, 0x464, 0x464, 0x47f, 0x49a, 0x4b2, 0x4c7, 0x4d3, 0x4e2, 0x519, 0x541, 0x553,
+	0x56b, 0x56b, 0x58d, 0x5ce, 0x5f9, 0x60b, 0x62d, 0x642, 0x65d, 0x65d, 0x673, 0x682,
+	0x697, 0x6a6, 0x6a6, 0x6bb, 0x6d0, 0x6d0, 0x6e2, 0x6f1, 0x6f7, 0x72e, 0x759, 0x76e,
+	0x780, 0x792, 0x7ab, 0x7c7, 0x7c7, 0x7c7, 0x7dc, 0x7f1, 0x80c, 0x827, 0x855, 0x855,
+	0x855, 0x873, 0x88b, 0x8a9, 0x8b5, 0x8b5, 0x8da, 0x8f5, 0x8f5, 0x8f5, 0x8f5, 0x905,
+	0x905, 0x914, 0x930, 0x945, 0x954, 0x96f, 0x96f, 0x994, 0x9aa, 0x9e7, 0xa0c, 0xa28,
+	0xa46, 0xa80, 0xacf, 0xae7, 0xb0b, 0xb1d, 0xb35, 0xb47, 0xb47, 0xb65, 0xb86, 0xb9b,
+	0xb9b, 0xb9b, 0xbb3, 0xbb3, 0xbd1, 0xbf3, 0xc0b, 0xc4b, 0xc76, 0xca4, 0xcb9, 0xcb9,
+	0xccc, 0xce9, 0xcf8, 0xcf8, 0xd0e, 0xd26, 0xd3e, 0xd59, 0xd6b, 0xd77, 0xd83, 0xda4,
+	0xda4, 0xdbc, 0xdc2, 0xded, 0xded, 0xded, 0xe15, 0xe6b, 0xe71, 0xe95, 0xebf, 0xedd,
+	0xf01, 0xf10, 0xf44,
+}
+
+const teScriptStr = "" +
+	"అరబిక్ఇంపీరియల్ అరామాక్అర్మేనియన్అవేస్టాన్బాలినీస్బాటక్బెంగాలిబ్లిస్సింబల్స్బోపో" +
+	"మోఫోబ్రాహ్మిబ్రెయిల్బ్యుగినీస్బుహిడ్చక్మాయునిఫైడ్ కెనెడియన్ అబొరిజినల్ సిలబిక్స్" +
+	"కారియన్చామ్చిరోకిసిర్థ్కోప్టిక్సైప్రోట్సిరిలిక్ప్రాచీన చర్చ స్లావోనిక్ సిరిలిక్ద" +
+	"ేవనాగరిడేసెరెట్ఇజిప్షియన్ డెమోటిక్ఇజిప్షియన్ హైరాటిక్ఇజిప్షియన్ హైరోగ్లైఫ్స్ఇథియ" +
+	"ోపిక్జార్జియన్ ఖట్సూరిజార్జియన్గ్లాగో లిటిక్గోతిక్గ్రీక్గుజరాతీగుర్ముఖిహంగుల్హాన" +
+	"్హనునూసరళీకృత హాన్సాంప్రదాయక హాన్హీబ్రుహిరాగానపాహవా హ్మోంగ్కాటాకాన లేదా హిరాగనప్" +
+	"రాచీన హంగేరియన్సింధుప్రాచిన ఐటాలిక్జావనీస్జాపనీస్కాయాహ్ లికాటాకానఖరోషథిఖ్మేర్కన్" +
+	"నడకొరియన్కైథిలన్నాలావోఫ్రాక్టూర్ లాటిన్గేలిక్ లాటిన్లాటిన్లేప్చాలింబులినియర్ ఎలి" +
+	"నియర్ బిలిసియన్లిడియన్మాన్డియన్మానిచేన్మాయన్ హైరోగ్లైఫ్స్మెరోఇటిక్మలయాళంమంగోలియన" +
+	"్మూన్మీటి మయెక్మయాన్మార్న్కోఒఘమ్ఓల్ చికిఓర్ఖోన్ఒరియాఓసమాన్యప్రాచీన పెర్మిక్ఫాగ్స" +
+	"్-పాఇంస్క్రిప్షనాల్ పహ్లావిసల్టార్ పహ్లావిపుస్తక పహ్లావిఫోనిశియన్పోల్లర్డ్ ఫోనెట" +
+	"ిక్ఇంస్క్రిప్షనాల్ పార్థియన్రేజాంగ్రోంగో రోంగోరూనిక్సమారిటన్సరాటిసౌరాష్ట్రసంజ్ఞ " +
+	"లిపిషవియాన్సింహళంసుడానీస్స్లోటి నాగ్రిసిరియాక్ఎస్ట్రానజీలో సిరియాక్పశ్చిమ సిరియా" +
+	"క్తూర్పు సిరియాక్టాగ్బానవాతై లీక్రొత్త టై లుఇతమిళముటై వియట్తెలుగుటేంగ్వార్టిఫీనా" +
+	"ఘ్టగలాగ్థానాథాయ్టిబెటన్యుగారిటిక్వాయికనిపించే భాషప్రాచీన పర్షియన్సుమేరో- అక్కడియ" +
+	"న్ క్యునిఫార్మ్యివారసత్వంగణిత సంకేతలిపిచిహ్నాలులిపి లేనిసామాన్యతెలియని లిపి"
+
+var teScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x12, 0x43, 0x61, 0x7c, 0x94, 0x94, 0x94, 0xa3, 0xb8, 0xe2,
+	0xfa, 0x112, 0x12a, 0x148, 0x15a, 0x169, 0x1d8, 0x1ed, 0x1f9, 0x20b, 0x21d, 0x235,
+	0x24d, 0x265, 0x2bf, 0x2d7, 0x2ef, 0x2ef, 0x326, 0x35d, 0x3a0, 0x3bb, 0x3ec, 0x407,
+	0x42c, 0x43e, 0x43e, 0x450, 0x465, 0x47d, 0x48f, 0x49b, 0x4aa, 0x4cc, 0x4f7, 0x509,
+	0x51e, 0x51e, 0x543, 0x578, 0x5a9, 0x5b8, 0x5e3, 0x5f8, 0x60d, 0x60d, 0x626, 0x63b,
+	0x64d, 0x65f, 0x65f, 0x66e, 0x683, 0x683, 0x68f, 0x69e, 0x6aa, 0x6db, 0x700, 0x712,
+	0x724, 0x733, 0x74c, 0x768, 0x768, 0x768, 0x77d, 0x792, 0x7ad, 0x7c5, 0x7f9, 0x7f9,
+	0x7f9, 0x814, 0x826, 0x841, 0x84d, 0x84d, 0x869, 0x884, 0x884, 0x884, 0x884, 0x890,
+	0x890, 0x89c, 0x8b2, 0x8c7, 0x8d6, 0x8eb, 0x8eb, 0x919, 0x932, 0x975, 0x9a0, 0x9c8,
+	0x9e3, 0xa17, 0xa60, 0xa75, 0xa94, 0xaa6, 0xabe, 0xacd, 0xacd, 0xae8, 0xb04, 0xb19,
+	0xb19, 0xb19, 0xb2b, 0xb2b, 0xb43, 0xb68, 0xb80, 0xbbd, 0xbe8, 0xc13, 0xc2e, 0xc2e,
+	0xc3b, 0xc61, 0xc73, 0xc73, 0xc89, 0xc9b, 0xcb6, 0xcce, 0xce0, 0xcec, 0xcf8, 0xd0d,
+	0xd0d, 0xd2b, 0xd37, 0xd59, 0xd59, 0xd59, 0xd87, 0xddb, 0xde1, 0xdf9, 0xe21, 0xe39,
+	0xe52, 0xe67, 0xe89,
+}
+
+const thScriptStr = "" +
+	"อะฟาคาอาหรับอิมพีเรียล อราเมอิกอาร์เมเนียอเวสตะบาหลีบามุมบัสซาบาตักเบงกาลีบลิสซิ" +
+	"มโบลส์ปอพอมอฟอพราหมีเบรลล์บูกิสบูฮิดชากมาสัญลักษณ์ชนเผ่าพื้นเมืองแคนาดาคาเรียจาม" +
+	"เชอโรกีเซิร์ทคอปติกไซเปรียทซีริลลิกเชอร์ชสลาโวนิกซีริลลิกโบราณเทวนาครีเดเซเรทชวเ" +
+	"ลขดัปโลยันดีโมติกอียิปต์เฮียราติกอียิปต์เฮียโรกลิฟส์อียิปต์เอทิโอปิกคัตซูรีจอร์เ" +
+	"จียจอร์เจียกลาโกลิติกโกธิกคฤณห์กรีกคุชราตกูร์มูคีฮันกูลฮั่นฮานูโนโอฮั่นตัวย่อฮั่" +
+	"นตัวเต็มฮีบรูฮิระงะนะอักขระอานาโตเลียปาเฮาห์ม้งคะตะกะนะหรือฮิระงะนะฮังการีโบราณอ" +
+	"ินดัสอิตาลีโบราณชวาญี่ปุ่นจูร์เชนคยาห์คะตะกะนะขโรษฐีเขมรคอจคีกันนาดาเกาหลีเปลเลก" +
+	"ายติล้านนาลาวลาติน - ฟรังเตอร์ลาติน - แกลิกละตินเลปชาลิมบูลีเนียร์เอลีเนียร์บีเฟ" +
+	"รเซอร์โลมาไลเซียลีเดียแมนเดียนมานิแชนมายาไฮโรกลิฟส์เมนเดเคอร์ซีฟ-เมโรอิติกเมโรติ" +
+	"กมาลายาลัมมองโกเลียมูนมโรเมเทมาเยกพม่าอาระเบียเหนือโบราณนาบาทาเอียนกีบา-นาซีเอ็น" +
+	"โกนุซุโอคัมโอลชิกิออร์คอนโอริยาออสมันยาพาลไมรีนเปอร์มิกโบราณฟากส์-ปาปะห์ลาวีอินส" +
+	"คริปชันแนลปะห์ลาวีซอลเตอร์ปะห์ลาวีบุ๊กฟินิเชียสัทศาสตร์พอลลาร์ดพาร์เทียอินสคริปช" +
+	"ันแนลเรจังรองโกรองโกรูนิกซามาเรียซาราติอาระเบียใต้โบราณโสวรัสตระไซน์ไรติ้งซอเวีย" +
+	"นชาราดาคุดาวาดีสิงหลโสราสมเป็งซุนดาซิโลตินากรีซีเรียซีเรียเอสทรานจีโลซีเรียตะวัน" +
+	"ตกซีเรียตะวันออกตักบันวาทาครีไทเลไทลื้อใหม่ทมิฬตันกัทไทเวียตเทลูกูเทงกวาร์ทิฟินา" +
+	"กตากาล็อกทานาไทยทิเบตเทอฮุทายูการิตไวคำพูดที่มองเห็นได้วารังกสิติโอลีเอเปอร์เซีย" +
+	"โบราณอักษรรูปลิ่มสุเมเรีย-อัคคาเดียยิอินเฮอริตเครื่องหมายทางคณิตศาสตร์ซิมโบลส์ไม" +
+	"่มีภาษาเขียนสามัญสคริปต์ที่ไม่รู้จัก"
+
+var thScriptIdx = []uint16{ // 159 entries
+	0x0, 0x12, 0x24, 0x5b, 0x79, 0x8b, 0x9a, 0xa9, 0xb8, 0xc7, 0xdc, 0x100,
+	0x118, 0x12a, 0x13c, 0x14b, 0x15a, 0x169, 0x1c3, 0x1d5, 0x1de, 0x1f3, 0x205, 0x217,
+	0x22f, 0x247, 0x298, 0x2b0, 0x2c5, 0x2ec, 0x316, 0x346, 0x37f, 0x39a, 0x3c7, 0x3df,
+	0x3fd, 0x40c, 0x41b, 0x427, 0x439, 0x451, 0x463, 0x46f, 0x487, 0x4a5, 0x4c6, 0x4d5,
+	0x4ed, 0x51d, 0x53b, 0x577, 0x59b, 0x5ad, 0x5ce, 0x5d7, 0x5ec, 0x601, 0x610, 0x628,
+	0x63a, 0x646, 0x655, 0x66a, 0x67c, 0x68b, 0x69a, 0x6ac, 0x6b5, 0x6e2, 0x703, 0x712,
+	0x721, 0x730, 0x74e, 0x76c, 0x784, 0x790, 0x7a2, 0x7b4, 0x7cc, 0x7e1, 0x80b, 0x81a,
+	0x84e, 0x863, 0x87e, 0x899, 0x8a2, 0x8ab, 0x8c6, 0x8d2, 0x908, 0x929, 0x942, 0x954,
+	0x960, 0x96f, 0x984, 0x999, 0x9ab, 0x9c3, 0x9db, 0xa02, 0xa18, 0xa5a, 0xa8a, 0xaae,
+	0xac6, 0xaf9, 0xb3b, 0xb4a, 0xb68, 0xb77, 0xb8f, 0xba1, 0xbd1, 0xbec, 0xc0a, 0xc1f,
+	0xc31, 0xc49, 0xc58, 0xc76, 0xc85, 0xca6, 0xcb8, 0xceb, 0xd12, 0xd3c, 0xd54, 0xd63,
+	0xd6f, 0xd8d, 0xd99, 0xdab, 0xdc0, 0xdd2, 0xdea, 0xdff, 0xe17, 0xe23, 0xe2c, 0xe3b,
+	0xe50, 0xe65, 0xe6b, 0xea1, 0xebf, 0xed1, 0xefb, 0xf53, 0xf59, 0xf74, 0xfbc, 0xfd4,
+	0xffe, 0x100d, 0x1046,
+}
+
+const trScriptStr = "" +
+	"AfakaArapİmparatorluk AramicesiErmeniAvestaBali DiliBamumBassa VahBatakBengalBli" +
+	"s SembolleriBopomofoBrahmiBrailleBugisBuhidChakmaUCASKaryaChamÇerokiCirthKıptiKı" +
+	"brısKirilEski Kilise Slavcası KirilDevanagariDeseretDuployé StenografiDemotik Mı" +
+	"sırHiyeratik MısırMısır HiyeroglifleriEtiyopyaHutsuri GürcüGürcüGlagolitGotikGra" +
+	"nthaYunanGuceratGurmukhiHangılHanHanunooBasitleştirilmiş HanGeleneksel Hanİbrani" +
+	"HiraganaAnadolu HiyeroglifleriPahavh HmongKatakana veya HiraganaEski MacarIndusE" +
+	"ski İtalyanCava DiliJaponJurchenKayah LiKatakanaKharoshthiKmerKhojkiKannadaKoreK" +
+	"pelleKaithiLannaLaoFraktur LatinGael LatinLatinLepchaLimbuLineer ALineer BFraser" +
+	"LomaLikyaLidyaMandenManiMaya HiyeroglifleriMendeMeroitik El YazısıMeroitikMalaya" +
+	"lamMoğolMoonMroMeitei MayekBurmaEski Kuzey ArapNebatiNaksi GebaN’KoNüshuOghamOl " +
+	"ChikiOrhunOriyaOsmanyaPalmiraEski PermikPhags-paPehlevi Kitabe DiliPsalter Pehle" +
+	"viKitap Pehlevi DiliFenikePollard FonetikPartça Kitabe DiliRejangRongorongoRunik" +
+	"SamaritSaratiEski Güney ArapSaurashtraİşaret DiliShavianSharadaKhudabadiSeylanSo" +
+	"ra SompengSundaSyloti NagriSüryaniEstrangela SüryaniBatı SüryaniDoğu SüryaniTagb" +
+	"anvaTakriTai LeNew Tai LueTamilTangutTai VietTeluguTengvarTifinaghTakalotThaanaT" +
+	"ayTibetTirhutaUgarit Çivi YazısıVaiKonuşma Sesleri ÇizimlemesiVarang KshitiWolea" +
+	"iEski FarsSümer-Akad Çivi YazısıYiKalıtsalMatematiksel GösterimSembolYazılı Olma" +
+	"yanOrtakBilinmeyen Alfabe"
+
+var trScriptIdx = []uint16{ // 159 entries
+	0x0, 0x5, 0x9, 0x20, 0x26, 0x2c, 0x35, 0x3a, 0x43, 0x48, 0x4e, 0x5d,
+	0x65, 0x6b, 0x72, 0x77, 0x7c, 0x82, 0x86, 0x8b, 0x8f, 0x96, 0x9b, 0xa1,
+	0xa9, 0xae, 0xc9, 0xd3, 0xda, 0xed, 0xfc, 0x10d, 0x123, 0x12b, 0x13a, 0x141,
+	0x149, 0x14e, 0x155, 0x15a, 0x161, 0x169, 0x170, 0x173, 0x17a, 0x190, 0x19e, 0x1a5,
+	0x1ad, 0x1c3, 0x1cf, 0x1e5, 0x1ef, 0x1f4, 0x201, 0x20a, 0x20f, 0x216, 0x21e, 0x226,
+	0x230, 0x234, 0x23a, 0x241, 0x245, 0x24b, 0x251, 0x256, 0x259, 0x266, 0x270, 0x275,
+	0x27b, 0x280, 0x288, 0x290, 0x296, 0x29a, 0x29f, 0x2a4, 0x2aa, 0x2ae, 0x2c1, 0x2c6,
+	0x2da, 0x2e2, 0x2eb, 0x2f1, 0x2f5, 0x2f8, 0x304, 0x309, 0x318, 0x31e, 0x328, 0x32e,
+	0x334, 0x339, 0x341, 0x346, 0x34b, 0x352, 0x359, 0x364, 0x36c, 0x37f, 0x38e, 0x3a0,
+	0x3a6, 0x3b5, 0x3c8, 0x3ce, 0x3d8, 0x3dd, 0x3e4, 0x3ea, 0x3fa, 0x404, 0x411, 0x418,
+	0x41f, 0x428, 0x42e, 0x43a, 0x43f, 0x44b, 0x453, 0x466, 0x474, 0x482, 0x48a, 0x48f,
+	0x495, 0x4a0, 0x4a5, 0x4ab, 0x4b3, 0x4b9, 0x4c0, 0x4c8, 0x4cf, 0x4d5, 0x4d8, 0x4dd,
+	0x4e4, 0x4f9, 0x4fc, 0x519, 0x526, 0x52c, 0x535, 0x54f, 0x551, 0x55a, 0x570, 0x576,
+	0x586, 0x58b, 0x59c,
+}
+
+const ukScriptStr = "" +
+	"арабськаАрмівірменськаАвестійськийБалійськийБатакбенгальськаСимволи Бліссабопомо" +
+	"фоБрахмішрифт БрайляБугійськийБухідЧакмаУніфіковані символи канадських тубільців" +
+	"КаріанськийХамітськийЧерокіКиртКоптськийКіпрськийкирилицяДавньоцерковнослов'янсь" +
+	"кийдеванагаріДезеретЄгипетський демотичнийЄгипетський ієратичнийЄгипетський ієро" +
+	"гліфічнийефіопськаКхутсурігрузинськаГлаголичнийГотичнийгрецькагуджаратігурмухіха" +
+	"нгулкитайськаХанунукитайська спрощенакитайська традиційнаівритхіраганаПахау хмон" +
+	"гКатакана чи хіраганаДавньоугорськийХарапськийДавньоіталійськийЯванськийяпонська" +
+	"Кая ЛікатаканаКхароштхікхмерськаканнадакорейськаКаїтіЛанналаоськаЛатинський фрак" +
+	"турнийЛатинський гельськийлатинськаЛепчаЛімбуЛінійний АЛінійний ВЛікійськийЛідій" +
+	"ськийМандейськийМаніхейськийМайя ієрогліфічнийМероїтськиймалаяламськамонгольська" +
+	"МунМейтей майєкмʼянмськаНкоОгамічнийСантальськийОрхонськийоріяОсманськийДавньопе" +
+	"рмськийПхагс-паПехлеві написівПехлеві релігійнийПехлеві літературнийФінікійський" +
+	"Писемність ПоллардаПарфянськийРеджангРонго-ронгоРунічнийСамаритянськийСаратіСаур" +
+	"аштраЗнаковийШоусингальськаСунданськийСілоті нагріСирійськийДавньосирійський ест" +
+	"рангелоДавньосирійський західнийДавньосирійський східнийТагбанваТай-ліНовий тайс" +
+	"ький луетамільськаТай-в'єттелугуТенгварТифінагТагальськийтаанатайськатибетськаУг" +
+	"аритськийВаїФонетична транскрипція БеллаДавньоперськийШумеро-аккадський клінопис" +
+	"ЙїУспадкованийМатематичнийСимвольнийБезписемнийзвичайнаневідома система письма"
+
+var ukScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x10, 0x18, 0x2c, 0x44, 0x58, 0x58, 0x58, 0x62, 0x78, 0x93,
+	0xa3, 0xaf, 0xc6, 0xda, 0xe4, 0xee, 0x13b, 0x151, 0x165, 0x171, 0x179, 0x18b,
+	0x19d, 0x1ad, 0x1e0, 0x1f4, 0x202, 0x202, 0x22d, 0x258, 0x289, 0x29b, 0x2ab, 0x2bf,
+	0x2d5, 0x2e5, 0x2e5, 0x2f3, 0x305, 0x313, 0x31f, 0x331, 0x33d, 0x360, 0x387, 0x391,
+	0x3a1, 0x3a1, 0x3b6, 0x3dc, 0x3fa, 0x40e, 0x430, 0x442, 0x452, 0x452, 0x45d, 0x46d,
+	0x47f, 0x491, 0x491, 0x49f, 0x4b1, 0x4b1, 0x4bb, 0x4c5, 0x4d3, 0x4fc, 0x523, 0x535,
+	0x53f, 0x549, 0x55c, 0x56f, 0x56f, 0x56f, 0x583, 0x597, 0x5ad, 0x5c5, 0x5e8, 0x5e8,
+	0x5e8, 0x5fe, 0x616, 0x62c, 0x632, 0x632, 0x649, 0x65b, 0x65b, 0x65b, 0x65b, 0x661,
+	0x661, 0x673, 0x68b, 0x69f, 0x6a7, 0x6bb, 0x6bb, 0x6d9, 0x6e8, 0x705, 0x728, 0x74f,
+	0x767, 0x78c, 0x7a2, 0x7b0, 0x7c5, 0x7d5, 0x7f1, 0x7fd, 0x7fd, 0x80f, 0x81f, 0x825,
+	0x825, 0x825, 0x83b, 0x83b, 0x851, 0x868, 0x87c, 0x8b1, 0x8e2, 0x911, 0x921, 0x921,
+	0x92c, 0x94e, 0x962, 0x962, 0x970, 0x97c, 0x98a, 0x998, 0x9ae, 0x9b8, 0x9c6, 0x9d8,
+	0x9d8, 0x9ee, 0x9f4, 0xa2a, 0xa2a, 0xa2a, 0xa46, 0xa78, 0xa7c, 0xa94, 0xaac, 0xac0,
+	0xad6, 0xae6, 0xb12,
+}
+
+const urScriptStr = "" +
+	"عربیآرمینیائیبنگالیبوپوموفوبریلکریلِکدیوناگریایتھوپیا کا باشندہجارجیا کا باشندہی" +
+	"ونانیگجراتیگرمکھیہنگولہانآسان ہانروایتی ہانعبرانیہیراگیناجاپانیکٹاکاناخمیرکنڑکور" +
+	"یائیلاؤلاطینیملیالممنگولیائیمیانماراڑیہسنہالاتملتیلگوتھاناتھائیتبتیعلاماتغیر تحر" +
+	"یر شدہعامنامعلوم رسم الخط"
+
+var urScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0x8, 0x1a, 0x1a, 0x1a, 0x1a, 0x1a, 0x1a, 0x26, 0x26,
+	0x36, 0x36, 0x3e, 0x3e, 0x3e, 0x3e, 0x3e, 0x3e, 0x3e, 0x3e, 0x3e, 0x3e,
+	0x3e, 0x4a, 0x4a, 0x5a, 0x5a, 0x5a, 0x5a, 0x5a, 0x5a, 0x7c, 0x7c, 0x9a,
+	0x9a, 0x9a, 0x9a, 0xa6, 0xb2, 0xbe, 0xc8, 0xce, 0xce, 0xdd, 0xf0, 0xfc,
+	0x10c, 0x10c, 0x10c, 0x10c, 0x10c, 0x10c, 0x10c, 0x10c, 0x118, 0x118, 0x118, 0x126,
+	0x126, 0x12e, 0x12e, 0x134, 0x142, 0x142, 0x142, 0x142, 0x148, 0x148, 0x148, 0x154,
+	0x154, 0x154, 0x154, 0x154, 0x154, 0x154, 0x154, 0x154, 0x154, 0x154, 0x154, 0x154,
+	0x154, 0x154, 0x160, 0x172, 0x172, 0x172, 0x172, 0x180, 0x180, 0x180, 0x180, 0x180,
+	0x180, 0x180, 0x180, 0x180, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188,
+	0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188, 0x188,
+	0x188, 0x188, 0x194, 0x194, 0x194, 0x194, 0x194, 0x194, 0x194, 0x194, 0x194, 0x194,
+	0x194, 0x194, 0x19a, 0x19a, 0x19a, 0x1a4, 0x1a4, 0x1a4, 0x1a4, 0x1ae, 0x1b8, 0x1c0,
+	0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1c0, 0x1cc,
+	0x1e4, 0x1ea, 0x208,
+}
+
+const uzScriptStr = "" +
+	"ArabArmanBengaliBopomofoBrailleKirilDevangariHabashGruzinYunonGujaratiGurmuxiXan" +
+	"gulXanSoddalashtirilganAnʼanaviyIbroniyXiraganaYaponKatakanaXmerKannadaKoreyaLao" +
+	"LotinMalayalamMoʻgʻulchaMyanmaOriyaSinxalaTamilTeluguTaanaTayTibetRamzlarYozilma" +
+	"ganUmumiyNomaʼlum shrift"
+
+var uzScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x4, 0x4, 0x9, 0x9, 0x9, 0x9, 0x9, 0x9, 0x10, 0x10,
+	0x18, 0x18, 0x1f, 0x1f, 0x1f, 0x1f, 0x1f, 0x1f, 0x1f, 0x1f, 0x1f, 0x1f,
+	0x1f, 0x24, 0x24, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x33, 0x33, 0x39,
+	0x39, 0x39, 0x39, 0x3e, 0x46, 0x4d, 0x53, 0x56, 0x56, 0x67, 0x71, 0x78,
+	0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x85, 0x85, 0x8d,
+	0x8d, 0x91, 0x91, 0x98, 0x9e, 0x9e, 0x9e, 0x9e, 0xa1, 0xa1, 0xa1, 0xa6,
+	0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6,
+	0xa6, 0xa6, 0xaf, 0xbb, 0xbb, 0xbb, 0xbb, 0xc1, 0xc1, 0xc1, 0xc1, 0xc1,
+	0xc1, 0xc1, 0xc1, 0xc1, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6,
+	0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6, 0xc6,
+	0xc6, 0xc6, 0xcd, 0xcd, 0xcd, 0xcd, 0xcd, 0xcd, 0xcd, 0xcd, 0xcd, 0xcd,
+	0xcd, 0xcd, 0xd2, 0xd2, 0xd2, 0xd8, 0xd8, 0xd8, 0xd8, 0xdd, 0xe0, 0xe5,
+	0xe5, 0xe5, 0xe5, 0xe5, 0xe5, 0xe5, 0xe5, 0xe5, 0xe5, 0xe5, 0xe5, 0xec,
+	0xf6, 0xfc, 0x10c,
+}
+
+const viScriptStr = "" +
+	"Chữ AfakaChữ Ả RậpChữ Imperial AramaicChữ ArmeniaChữ AvestanChữ BaliChữ BamumChữ" +
+	" Bassa VahChữ BatakChữ BengaliChữ BlissymbolsChữ BopomofoChữ BrahmiChữ nổi Brail" +
+	"leChữ BuginChữ BuhidChữ ChakmaÂm tiết Thổ dân Canada Hợp nhấtChữ CariaChữ ChămCh" +
+	"ữ CherokeeChữ CirthChữ CopticChứ SípChữ KirinChữ Kirin Slavơ Nhà thờ cổChữ Devan" +
+	"agariChữ DeseretChữ tốc ký DuployanChữ Ai Cập bình dânChữ Ai Cập thày tuChữ tượn" +
+	"g hình Ai CậpChữ EthiopiaChữ Khutsuri GeorgiaChữ GruziaChữ GlagoliticChữ Gô-tích" +
+	"Chữ GranthaChữ Hy LạpChữ GujaratiChữ GurmukhiChữ HangulChữ HánChữ HanunooChữ Hán" +
+	" giản thểChữ Hán phồn thểChữ Do TháiChữ HiraganaChữ tượng hình AnatoliaChữ Pahaw" +
+	"h HmongBảng ký hiệu âm tiết Tiếng NhậtChữ Hungary cổChữ IndusChữ Italic cổChữ Ja" +
+	"vaChữ Nhật BảnChữ JurchenChữ Kayah LiChữ KatakanaChữ KharoshthiChữ Khơ-meChữ Kho" +
+	"jkiChữ KannadaChữ Hàn QuốcChữ KpelleChữ KaithiChữ LannaChữ LàoChữ La-tinh Fraktu" +
+	"rChữ La-tinh Xcốt-lenChữ La tinhChữ LepchaChữ LimbuChữ Linear AChữ Linear BChữ F" +
+	"raserChữ LomaChữ LyciaChữ LydiaChữ MandaeanChữ ManichaeanChữ tượng hình MayaChữ " +
+	"MendeChữ Meroitic Nét thảoChữ MeroiticChữ MalayalamChữ Mông CổChữ nổi MoonChữ Mr" +
+	"oChữ Meitei MayekMyanmaChữ Bắc Ả Rập cổChữ NabataeanChữ Naxi GebaChữ N’KoChữ Nüs" +
+	"huChữ OghamChữ Ol ChikiChữ OrkhonChữ OriyaChữ OsmanyaChữ PalmyreneChữ Permic cổC" +
+	"hữ Phags-paChữ Pahlavi Văn biaChữ Pahlavi Thánh caChữ Pahlavi SáchChữ PhoeniciaN" +
+	"gữ âm PollardChữ Parthia Văn biaChữ RejangChữ RongorongoChữ RunicChữ SamaritanCh" +
+	"ữ SaratiChữ Nam Ả Rập cổChữ SaurashtraChữ viết Ký hiệuChữ ShavianChữ SharadaChữ " +
+	"KhudawadiChữ SinhalaChữ Sora SompengChữ Xu-đăngChữ Syloti NagriChữ SyriaChữ Estr" +
+	"angelo SyriacChữ Tây SyriaChữ Đông SyriaChữ TagbanwaChữ TakriChữ Thái NaChữ Thái" +
+	" Lặc mớiChữ TamilChữ TangutChữ Thái ViệtChữ TeluguChữ TengwarChữ TifinaghChữ Tag" +
+	"alogChữ ThaanaChữ TháiChữ Tây TạngChữ TirhutaChữ UgaritChữ VaiTiếng nói Nhìn thấ" +
+	"y đượcChữ Varang KshitiChữ WoleaiChữ Ba Tư cổChữ hình nêm Sumero-AkkadianChữ DiC" +
+	"hữ Kế thừaKý hiệu Toán họcKý hiệuChưa có chữ viếtChungChữ viết Không xác địn" +
+	"h"
+
+var viScriptIdx = []uint16{ // 159 entries
+	0x0, 0xb, 0x1a, 0x30, 0x3d, 0x4a, 0x54, 0x5f, 0x6e, 0x79, 0x86, 0x97,
+	0xa5, 0xb1, 0xc4, 0xcf, 0xda, 0xe6, 0x10f, 0x11a, 0x125, 0x133, 0x13e, 0x14a,
+	0x154, 0x15f, 0x181, 0x191, 0x19e, 0x1b6, 0x1cf, 0x1e6, 0x203, 0x211, 0x227, 0x233,
+	0x243, 0x252, 0x25f, 0x26d, 0x27b, 0x289, 0x295, 0x29f, 0x2ac, 0x2c3, 0x2da, 0x2e8,
+	0x2f6, 0x313, 0x325, 0x350, 0x362, 0x36d, 0x37e, 0x388, 0x39a, 0x3a7, 0x3b5, 0x3c3,
+	0x3d3, 0x3e0, 0x3ec, 0x3f9, 0x40a, 0x416, 0x422, 0x42d, 0x437, 0x44c, 0x464, 0x471,
+	0x47d, 0x488, 0x496, 0x4a4, 0x4b0, 0x4ba, 0x4c5, 0x4d0, 0x4de, 0x4ee, 0x507, 0x512,
+	0x52c, 0x53a, 0x549, 0x559, 0x569, 0x572, 0x584, 0x58a, 0x5a4, 0x5b3, 0x5c2, 0x5ce,
+	0x5da, 0x5e5, 0x5f3, 0x5ff, 0x60a, 0x617, 0x626, 0x637, 0x645, 0x65b, 0x672, 0x685,
+	0x694, 0x6a5, 0x6bb, 0x6c7, 0x6d7, 0x6e2, 0x6f1, 0x6fd, 0x715, 0x725, 0x73c, 0x749,
+	0x756, 0x765, 0x772, 0x784, 0x793, 0x7a5, 0x7b0, 0x7c7, 0x7d7, 0x7e9, 0x7f7, 0x802,
+	0x810, 0x827, 0x832, 0x83e, 0x850, 0x85c, 0x869, 0x877, 0x884, 0x890, 0x89b, 0x8ac,
+	0x8b9, 0x8c5, 0x8ce, 0x8f0, 0x903, 0x90f, 0x920, 0x940, 0x948, 0x959, 0x96f, 0x979,
+	0x98f, 0x994, 0x9b7,
+}
+
+const zhScriptStr = "" +
+	"阿法卡文阿拉伯文皇室亚拉姆文亚美尼亚文阿维斯陀文巴厘文巴姆穆文巴萨文巴塔克文孟加拉文布列斯符号汉语拼音婆罗米文字布莱叶盲文布吉文布希德文查克马文加拿大土著统一音" +
+	"节卡里亚文占文切罗基文色斯文克普特文塞浦路斯文西里尔文西里尔文字（古教会斯拉夫文的变体）天城文德塞莱特文杜普洛伊速记后期埃及文古埃及僧侣书写体古埃及象形文埃塞俄" +
+	"比亚文格鲁吉亚文（教堂体）格鲁吉亚文格拉哥里文哥特文格兰塔文希腊文古吉拉特文果鲁穆奇文韩文字汉字汉奴罗文简体中文繁体中文希伯来文平假名安那托利亚象形文字杨松录苗" +
+	"文片假名或平假名古匈牙利文古希腊哈拉潘古意大利文爪哇文日文女真文克耶李文字片假名卡罗须提文高棉文克吉奇文字卡纳达文韩文克佩列文凯提文兰拿文老挝文拉丁文（哥特式字" +
+	"体变体）拉丁文（盖尔文变体）拉丁文雷布查文林布文线形文字（A）线形文字（B）傈僳文洛马文利西亚文吕底亚文阿拉米文摩尼教文玛雅圣符文门迪文麦罗埃草书麦若提克文马拉" +
+	"雅拉姆文蒙古文韩文语系谬文曼尼普尔文缅甸文古北方阿拉伯文纳巴泰文纳西格巴文西非书面文字（N’Ko）女书欧甘文桑塔利文鄂尔浑文奥里亚文奥斯曼亚文帕尔迈拉文古彼尔姆" +
+	"文八思巴文巴列维文碑铭体巴列维文（圣诗体）巴列维文（书体）腓尼基文波拉德音标文字帕提亚文碑铭体拉让文朗格朗格文古代北欧文撒马利亚文沙拉堤文古南阿拉伯文索拉什特拉" +
+	"文书写符号萧伯纳式文夏拉达文信德文僧伽罗文索朗桑朋文巽他文锡尔赫特文叙利亚文福音体叙利亚文西叙利亚文东叙利亚文塔格班瓦文泰克里文泰乐文新傣文泰米尔文唐古特文越南" +
+	"傣文泰卢固文腾格瓦文字提非纳文塔加路文塔安那文泰文藏文迈蒂利文乌加里特文瓦依文可见语言瓦郎奇蒂文字沃莱艾文古波斯文苏美尔-阿卡德楔形文字彝文遗传学术语数学符号符" +
+	"号非书写语言通用未知文字"
+
+var zhScriptIdx = []uint16{ // 159 entries
+	0x0, 0xc, 0x18, 0x2a, 0x39, 0x48, 0x51, 0x5d, 0x66, 0x72, 0x7e, 0x8d,
+	0x99, 0xa8, 0xb7, 0xc0, 0xcc, 0xd8, 0xf3, 0xff, 0x105, 0x111, 0x11a, 0x126,
+	0x135, 0x141, 0x174, 0x17d, 0x18c, 0x19e, 0x1ad, 0x1c5, 0x1d7, 0x1e9, 0x207, 0x216,
+	0x225, 0x22e, 0x23a, 0x243, 0x252, 0x261, 0x26a, 0x270, 0x27c, 0x288, 0x294, 0x2a0,
+	0x2a9, 0x2c4, 0x2d3, 0x2e8, 0x2f7, 0x309, 0x318, 0x321, 0x327, 0x330, 0x33f, 0x348,
+	0x357, 0x360, 0x36f, 0x37b, 0x381, 0x38d, 0x396, 0x39f, 0x3a8, 0x3cc, 0x3ea, 0x3f3,
+	0x3ff, 0x408, 0x41b, 0x42e, 0x437, 0x440, 0x44c, 0x458, 0x464, 0x470, 0x47f, 0x488,
+	0x497, 0x4a6, 0x4b8, 0x4c1, 0x4cd, 0x4d3, 0x4e2, 0x4eb, 0x500, 0x50c, 0x51b, 0x539,
+	0x53f, 0x548, 0x554, 0x560, 0x56c, 0x57b, 0x58a, 0x599, 0x5a5, 0x5ba, 0x5d5, 0x5ed,
+	0x5f9, 0x60e, 0x623, 0x62c, 0x63b, 0x64a, 0x659, 0x665, 0x677, 0x689, 0x695, 0x6a4,
+	0x6b0, 0x6b9, 0x6c5, 0x6d4, 0x6dd, 0x6ec, 0x6f8, 0x70d, 0x71c, 0x72b, 0x73a, 0x746,
+	0x74f, 0x758, 0x764, 0x770, 0x77c, 0x788, 0x797, 0x7a3, 0x7af, 0x7bb, 0x7c1, 0x7c7,
+	0x7d3, 0x7e2, 0x7eb, 0x7f7, 0x809, 0x815, 0x821, 0x840, 0x846, 0x855, 0x861, 0x867,
+	0x876, 0x87c, 0x888,
+}
+
+const zhHantScriptStr = "" +
+	"阿法卡文字阿拉伯文皇室亞美尼亞文亞美尼亞文阿維斯陀文峇里文巴姆穆文巴薩文巴塔克文孟加拉文布列斯文注音符號婆羅米文盲人用點字布吉斯文布希德文查克馬文加拿大原住民通" +
+	"用字符卡里亞文占文柴羅基文色斯文科普特文塞浦路斯文斯拉夫文西里爾文（古教會斯拉夫文變體）天城文德瑟雷特文杜普洛伊速記古埃及世俗體古埃及僧侶體古埃及象形文字衣索比" +
+	"亞文格魯吉亞語系（阿索他路里和努斯克胡里文）喬治亞文格拉哥里文歌德文格蘭他文字希臘文古吉拉特文古魯穆奇文韓文字漢語哈努諾文簡體中文繁體中文希伯來文平假名安那托利" +
+	"亞象形文字楊松錄苗文片假名或平假名古匈牙利文印度河流域（哈拉帕文）古意大利文爪哇文日文女真文字克耶李文片假名卡羅須提文高棉文克吉奇文字坎那達文韓文克培列文凱提文" +
+	"藍拿文寮國文拉丁文（尖角體活字變體）拉丁文（蓋爾語變體）拉丁文雷布查文林佈文線性文字（A）線性文字（B）栗僳文洛馬文呂西亞語里底亞語曼安底文摩尼教文瑪雅象形文字" +
+	"門德文麥羅埃文（曲線字體）麥羅埃文馬來亞拉姆文蒙古文蒙氏點字謬文曼尼普爾文緬甸文古北阿拉伯文納巴泰文字納西格巴文西非書面語言 (N’Ko)女書文字歐甘文桑塔利文" +
+	"鄂爾渾文歐利亞文歐斯曼亞文帕米瑞拉文字古彼爾姆諸文八思巴文巴列維文（碑銘體）巴列維文（聖詩體）巴列維文（書體）腓尼基文柏格理拼音符帕提亞文（碑銘體）拉讓文朗格朗" +
+	"格象形文古北歐文字撒馬利亞文沙拉堤文古南阿拉伯文索拉什特拉文手語書寫符號簫柏納字符夏拉達文信德文錫蘭文索朗桑朋文字巽他文希洛弟納格里文敍利亞文敘利亞文（福音體文" +
+	"字變體）敘利亞文（西方文字變體）敘利亞文（東方文字變體）南島文塔卡里文字傣哪文西雙版納新傣文坦米爾文西夏文傣擔文泰盧古文談格瓦文提非納文塔加拉文塔安那文泰文西藏" +
+	"文邁蒂利文烏加列文瓦依文視覺語音文字瓦郎奇蒂文字沃雷艾文古波斯文蘇米魯亞甲文楔形文字彞文繼承文字（Unicode）數學符號符號非書寫語言一般文字未知文字"
+
+var zhHantScriptIdx = []uint16{ // 159 entries
+	0x0, 0xf, 0x1b, 0x30, 0x3f, 0x4e, 0x57, 0x63, 0x6c, 0x78, 0x84, 0x90,
+	0x9c, 0xa8, 0xb7, 0xc3, 0xcf, 0xdb, 0xf9, 0x105, 0x10b, 0x117, 0x120, 0x12c,
+	0x13b, 0x147, 0x174, 0x17d, 0x18c, 0x19e, 0x1b0, 0x1c2, 0x1d7, 0x1e6, 0x222, 0x22e,
+	0x23d, 0x246, 0x255, 0x25e, 0x26d, 0x27c, 0x285, 0x28b, 0x297, 0x2a3, 0x2af, 0x2bb,
+	0x2c4, 0x2df, 0x2ee, 0x303, 0x312, 0x333, 0x342, 0x34b, 0x351, 0x35d, 0x369, 0x372,
+	0x381, 0x38a, 0x399, 0x3a5, 0x3ab, 0x3b7, 0x3c0, 0x3c9, 0x3d2, 0x3f6, 0x414, 0x41d,
+	0x429, 0x432, 0x445, 0x458, 0x461, 0x46a, 0x476, 0x482, 0x48e, 0x49a, 0x4ac, 0x4b5,
+	0x4d3, 0x4df, 0x4f1, 0x4fa, 0x506, 0x50c, 0x51b, 0x524, 0x536, 0x545, 0x554, 0x56f,
+	0x57b, 0x584, 0x590, 0x59c, 0x5a8, 0x5b7, 0x5c9, 0x5db, 0x5e7, 0x602, 0x61d, 0x635,
+	0x641, 0x653, 0x66e, 0x677, 0x68c, 0x69b, 0x6aa, 0x6b6, 0x6c8, 0x6da, 0x6ec, 0x6fb,
+	0x707, 0x710, 0x719, 0x72b, 0x734, 0x749, 0x755, 0x77c, 0x7a0, 0x7c4, 0x7cd, 0x7dc,
+	0x7e5, 0x7fa, 0x806, 0x80f, 0x818, 0x824, 0x830, 0x83c, 0x848, 0x854, 0x85a, 0x863,
+	0x86f, 0x87b, 0x884, 0x896, 0x8a8, 0x8b4, 0x8c0, 0x8de, 0x8e4, 0x8fd, 0x909, 0x90f,
+	0x91e, 0x92a, 0x936,
+}
+
+const zuScriptStr = "" +
+	"isi-Arabisi-Armenianisi-Bengalii-Bopomofoi-Braillei-Cyrillici-Devanagarii-Ethiop" +
+	"icisi-Georgianisi-Greekisi-Gujaratii-Gurmukhii-Hanguli-Hani-Simplified Hani-Trad" +
+	"itional Hanisi-Hebrewi-Hiraganaisi-Japanesei-Katakanaisi-Khmerisi-Kannadaisi-Kor" +
+	"eani-Laoisi-Latinisi-Malayalamisi-Mongoliani-Myanmarisi-Oriyai-Sinhalaisi-Tamili" +
+	"si-Telugui-Thaanaisi-Thaiisi-TibetanAmasimbuliOkungabhaliwei-CommonIskripthi esi" +
+	"ngaziwa"
+
+var zuScriptIdx = []uint16{ // 159 entries
+	0x0, 0x0, 0x8, 0x8, 0x14, 0x14, 0x14, 0x14, 0x14, 0x14, 0x1f, 0x1f,
+	0x29, 0x29, 0x32, 0x32, 0x32, 0x32, 0x32, 0x32, 0x32, 0x32, 0x32, 0x32,
+	0x32, 0x3c, 0x3c, 0x48, 0x48, 0x48, 0x48, 0x48, 0x48, 0x52, 0x52, 0x5e,
+	0x5e, 0x5e, 0x5e, 0x67, 0x73, 0x7d, 0x85, 0x8a, 0x8a, 0x9a, 0xab, 0xb5,
+	0xbf, 0xbf, 0xbf, 0xbf, 0xbf, 0xbf, 0xbf, 0xbf, 0xcb, 0xcb, 0xcb, 0xd5,
+	0xd5, 0xde, 0xde, 0xe9, 0xf3, 0xf3, 0xf3, 0xf3, 0xf8, 0xf8, 0xf8, 0x101,
+	0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101, 0x101,
+	0x101, 0x101, 0x10e, 0x11b, 0x11b, 0x11b, 0x11b, 0x124, 0x124, 0x124, 0x124, 0x124,
+	0x124, 0x124, 0x124, 0x124, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d,
+	0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d, 0x12d,
+	0x12d, 0x12d, 0x136, 0x136, 0x136, 0x136, 0x136, 0x136, 0x136, 0x136, 0x136, 0x136,
+	0x136, 0x136, 0x13f, 0x13f, 0x13f, 0x149, 0x149, 0x149, 0x149, 0x151, 0x159, 0x164,
+	0x164, 0x164, 0x164, 0x164, 0x164, 0x164, 0x164, 0x164, 0x164, 0x164, 0x164, 0x16e,
+	0x17b, 0x183, 0x197,
+}
+
+// Total size for script: 150334 bytes (150 KB)
+
+// Number of keys: 290
+var (
+	regionIndex = tagIndex{
+		"ACADAEAFAGAIALAMANAOAQARASATAUAWAXAZBABBBDBEBFBGBHBIBJBLBMBNBOBQBRBSBTBVBWBYBZCACCCDCFCGCHCICKCLCMCNCOCPCRCUCVCWCXCYCZDEDGDJDKDMDODZEAECEEEGEHERESETEUFIFJFKFMFOFRGAGBGDGEGFGGGHGIGLGMGNGPGQGRGSGTGUGWGYHKHMHNHRHTHUICIDIEILIMINIOIQIRISITJEJMJOJPKEKGKHKIKMKNKPKRKWKYKZLALBLCLILKLRLSLTLULVLYMAMCMDMEMFMGMHMKMLMMMNMOMPMQMRMSMTMUMVMWMXMYMZNANCNENFNGNINLNONPNRNUNZOMPAPEPFPGPHPKPLPMPNPRPSPTPWPYQAQORERORSRURWSASBSCSDSESGSHSISJSKSLSMSNSOSRSSSTSVSXSYSZTATCTDTFTGTHTJTKTLTMTNTOTRTTTVTWTZUAUGUMUSUYUZVAVCVEVGVIVNVUWFWSXKYEYTZAZMZWZZ",
+		"001002003005009011013014015017018019021029030034035039053054057061142143145150151154155419",
+		"",
+	}
+)
+
+var regionHeaders = [73]header{
+	{ // af
+		afRegionStr,
+		afRegionIdx,
+	},
+	{ // am
+		amRegionStr,
+		amRegionIdx,
+	},
+	{ // ar
+		arRegionStr,
+		arRegionIdx,
+	},
+	{ // az
+		azRegionStr,
+		azRegionIdx,
+	},
+	{ // bg
+		bgRegionStr,
+		bgRegionIdx,
+	},
+	{ // bn
+		bnRegionStr,
+		bnRegionIdx,
+	},
+	{ // ca
+		caRegionStr,
+		caRegionIdx,
+	},
+	{ // cs
+		csRegionStr,
+		csRegionIdx,
+	},
+	{ // da
+		daRegionStr,
+		daRegionIdx,
+	},
+	{ // de
+		deRegionStr,
+		deRegionIdx,
+	},
+	{ // el
+		elRegionStr,
+		elRegionIdx,
+	},
+	{ // en
+		enRegionStr,
+		enRegionIdx,
+	},
+	{ // en-GB
+		enGBRegionStr,
+		enGBRegionIdx,
+	},
+	{ // es
+		esRegionStr,
+		esRegionIdx,
+	},
+	{ // es-419
+		es419RegionStr,
+		es419RegionIdx,
+	},
+	{ // et
+		etRegionStr,
+		etRegionIdx,
+	},
+	{ // fa
+		faRegionStr,
+		faRegionIdx,
+	},
+	{ // fi
+		fiRegionStr,
+		fiRegionIdx,
+	},
+	{ // fil
+		filRegionStr,
+		filRegionIdx,
+	},
+	{ // fr
+		frRegionStr,
+		frRegionIdx,
+	},
+	{ // fr-CA
+		frCARegionStr,
+		frCARegionIdx,
+	},
+	{ // gu
+		guRegionStr,
+		guRegionIdx,
+	},
+	{ // he
+		heRegionStr,
+		heRegionIdx,
+	},
+	{ // hi
+		hiRegionStr,
+		hiRegionIdx,
+	},
+	{ // hr
+		hrRegionStr,
+		hrRegionIdx,
+	},
+	{ // hu
+		huRegionStr,
+		huRegionIdx,
+	},
+	{ // hy
+		hyRegionStr,
+		hyRegionIdx,
+	},
+	{ // id
+		idRegionStr,
+		idRegionIdx,
+	},
+	{ // is
+		isRegionStr,
+		isRegionIdx,
+	},
+	{ // it
+		itRegionStr,
+		itRegionIdx,
+	},
+	{ // ja
+		jaRegionStr,
+		jaRegionIdx,
+	},
+	{ // ka
+		kaRegionStr,
+		kaRegionIdx,
+	},
+	{ // kk
+		kkRegionStr,
+		kkRegionIdx,
+	},
+	{ // km
+		kmRegionStr,
+		kmRegionIdx,
+	},
+	{ // kn
+		knRegionStr,
+		knRegionIdx,
+	},
+	{ // ko
+		koRegionStr,
+		koRegionIdx,
+	},
+	{ // ky
+		kyRegionStr,
+		kyRegionIdx,
+	},
+	{ // lo
+		loRegionStr,
+		loRegionIdx,
+	},
+	{ // lt
+		ltRegionStr,
+		ltRegionIdx,
+	},
+	{ // lv
+		lvRegionStr,
+		lvRegionIdx,
+	},
+	{ // mk
+		mkRegionStr,
+		mkRegionIdx,
+	},
+	{ // ml
+		mlRegionStr,
+		mlRegionIdx,
+	},
+	{ // mn
+		mnRegionStr,
+		mnRegionIdx,
+	},
+	{ // mr
+		mrRegionStr,
+		mrRegionIdx,
+	},
+	{ // ms
+		msRegionStr,
+		msRegionIdx,
+	},
+	{ // my
+		myRegionStr,
+		myRegionIdx,
+	},
+	{ // ne
+		neRegionStr,
+		neRegionIdx,
+	},
+	{ // nl
+		nlRegionStr,
+		nlRegionIdx,
+	},
+	{ // no
+		noRegionStr,
+		noRegionIdx,
+	},
+	{ // pa
+		paRegionStr,
+		paRegionIdx,
+	},
+	{ // pl
+		plRegionStr,
+		plRegionIdx,
+	},
+	{ // pt
+		ptRegionStr,
+		ptRegionIdx,
+	},
+	{ // pt-PT
+		ptPTRegionStr,
+		ptPTRegionIdx,
+	},
+	{ // ro
+		roRegionStr,
+		roRegionIdx,
+	},
+	{ // ru
+		ruRegionStr,
+		ruRegionIdx,
+	},
+	{ // si
+		siRegionStr,
+		siRegionIdx,
+	},
+	{ // sk
+		skRegionStr,
+		skRegionIdx,
+	},
+	{ // sl
+		slRegionStr,
+		slRegionIdx,
+	},
+	{ // sq
+		sqRegionStr,
+		sqRegionIdx,
+	},
+	{ // sr
+		srRegionStr,
+		srRegionIdx,
+	},
+	{ // sv
+		svRegionStr,
+		svRegionIdx,
+	},
+	{ // sw
+		swRegionStr,
+		swRegionIdx,
+	},
+	{ // ta
+		taRegionStr,
+		taRegionIdx,
+	},
+	{ // te
+		teRegionStr,
+		teRegionIdx,
+	},
+	{ // th
+		thRegionStr,
+		thRegionIdx,
+	},
+	{ // tr
+		trRegionStr,
+		trRegionIdx,
+	},
+	{ // uk
+		ukRegionStr,
+		ukRegionIdx,
+	},
+	{ // ur
+		urRegionStr,
+		urRegionIdx,
+	},
+	{ // uz
+		uzRegionStr,
+		uzRegionIdx,
+	},
+	{ // vi
+		viRegionStr,
+		viRegionIdx,
+	},
+	{ // zh
+		zhRegionStr,
+		zhRegionIdx,
+	},
+	{ // zh-Hant
+		zhHantRegionStr,
+		zhHantRegionIdx,
+	},
+	{ // zu
+		zuRegionStr,
+		zuRegionIdx,
+	},
+}
+
+const afRegionStr = "" +
+	"AscensioneilandAndorraVerenigde Arabiese EmirateAfganistanAntigua en BarbudaAngu" +
+	"illaAlbaniëArmeniëNederlands-AntilleAngolaAntarktikaArgentiniëAmerikaans-SamoaOo" +
+	"stenrykAustraliëArubaÅlandeilandeAzerbeidjanBosnië en HerzegowinaBarbadosBanglad" +
+	"esjBelgiëBurkina FasoBulgaryeBahreinBurundiBeninSint BarthélemyBermudaBroeneiBol" +
+	"iviëKaribiese NederlandBrasiliëBahamasBhoetanBouveteilandBotswanaWit-RuslandBeli" +
+	"zeKanadaCocos- (Keeling) eilandeDemokratiese Republiek van die KongoSentraal-Afr" +
+	"ikaanse RepubliekKongoSwitserlandIvoorkusCookeilandeChiliKameroenSjinaColombiëCl" +
+	"ippertoneilandCosta RicaKubaKaap VerdeCuraçaoKerseilandSiprusTjeggiese Republiek" +
+	"DuitslandDiego GarciaDjiboetiDenemarkeDominicaDominikaanse RepubliekAlgeriëCeuta" +
+	" en MelillaEcuadorEstlandEgipteWes-SaharaEritreaSpanjeEthiopiëEuropese UnieFinla" +
+	"ndFidjiFalklandeilandeMikronesiëFaroëreilandeFrankrykGaboenVerenigde KoninkrykGr" +
+	"enadaGeorgiëFrans-GuyanaGuernseyGhanaGibraltarGroenlandGambiëGuineeGuadeloupeEkw" +
+	"atoriaal-GuineeGriekelandSuid-Georgië en die Suidelike SandwicheilandeGuatemalaG" +
+	"uamGuinee-BissauGuyanaHongkongHeard- en McDonaldeilandeHondurasKroasiëHaïtiHonga" +
+	"ryeKanarie-eilandeIndonesiëIerlandIsraelEiland ManIndiëBritse Indiese Oseaangebi" +
+	"edIrakIranYslandItaliëJerseyJamaikaJordaniëJapanKeniaKirgisiëKambodjaKiribatiCom" +
+	"oreSint Kitts en NevisNoord-KoreaSuid-KoreaKoeweitKaaimanseilandeKasakstanLaosLi" +
+	"banonSint LuciaLiechtensteinSri LankaLiberiëLesothoLitaueLuxemburgLetlandLibiëMa" +
+	"rokkoMonacoMoldovaMontenegroSint MartinMadagaskarMarshalleilandeMacedoniëMaliMia" +
+	"nmarMongoliëMacau SAR ChinaNoordelike Mariana-eilandeMartiniqueMauritaniëMontser" +
+	"ratMaltaMauritiusMalediveMalawiMeksikoMaleisiëMosambiekNamibiëNieu-KaledoniëNige" +
+	"rNorfolkeilandNigeriëNicaraguaNederlandNoorweëNepalNauruNiueNieu-SeelandOmanPana" +
+	"maPeruFrans-PolinesiëPapoea-Nieu-GuineeFilippynePakistanPoleSint Pierre en Mique" +
+	"lonPitcairneilandePuerto RicoPalestinaPortugalPalauParaguayKatarOmliggende Osean" +
+	"iëRéunionRoemeniëSerwiëRuslandRwandaSaoedi-ArabiëSolomoneilandeSeychelleSoedanSw" +
+	"edeSingapoerSint HelenaSloweniëSvalbard en Jan MayenSlowakyeSierra LeoneSan Mari" +
+	"noSenegalSomaliëSurinameSuid-SoedanSao Tome en PrincipeEl SalvadorSint MaartenSi" +
+	"riëSwazilandTristan da CunhaTurks- en CaicoseilandeTsjadFranse Suidelike Gebiede" +
+	"TogoThailandTadjikistanTokelauOos-TimorTurkmeniëTunisiëTongaTurkyeTrinidad en To" +
+	"bagoTuvaluTaiwanTanzaniëOekraïneUgandaVS klein omliggende eilandeVerenigde State" +
+	" van AmerikaUruguayOesbekistanVatikaanstadSint Vincent en die GrenadineVenezuela" +
+	"Britse Maagde-eilandeAmerikaanse Maagde-eilandeViëtnamVanuatuWallis en FutunaSam" +
+	"oaKosovoJemenMayotteSuid-AfrikaZambiëZimbabweOnbekende gebiedWêreldAfrikaNoord-A" +
+	"merikaSuid-AmerikaOseaniëWes-AfrikaSentraal-AmerikaOos-AfrikaNoord-AfrikaMidde-A" +
+	"frikaSuider-AfrikaAmerikasNoordelike AmerikaKaribbiesOos-AsiëSuid-AsiëSuidoos-As" +
+	"iëSuid-EuropaAustralasiëMelanesiëMikronesiese streekPolinesiëAsiëSentraal-AsiëWe" +
+	"s-AsiëEuropaOos-EuropaNoord-EuropaWes-EuropaLatyns-Amerika"
+
+var afRegionIdx = []uint16{ // 291 entries
+	0x0, 0xf, 0x16, 0x30, 0x3a, 0x4c, 0x54, 0x5c, 0x64, 0x76, 0x7c, 0x86,
+	0x91, 0xa1, 0xaa, 0xb4, 0xb9, 0xc6, 0xd1, 0xe7, 0xef, 0xf9, 0x100, 0x10c,
+	0x114, 0x11b, 0x122, 0x127, 0x137, 0x13e, 0x145, 0x14d, 0x160, 0x169, 0x170, 0x177,
+	0x183, 0x18b, 0x196, 0x19c, 0x1a2, 0x1ba, 0x1de, 0x1fb, 0x200, 0x20b, 0x213, 0x21e,
+	0x223, 0x22b, 0x230, 0x239, 0x249, 0x253, 0x257, 0x261, 0x269, 0x273, 0x279, 0x28c,
+	0x295, 0x2a1, 0x2a9, 0x2b2, 0x2ba, 0x2d0, 0x2d8, 0x2e8, 0x2ef, 0x2f6, 0x2fc, 0x306,
+	0x30d, 0x313, 0x31c, 0x329, 0x330, 0x335, 0x344, 0x34f, 0x35d, 0x365, 0x36b, 0x37e,
+	0x385, 0x38d, 0x399, 0x3a1, 0x3a6, 0x3af, 0x3b8, 0x3bf, 0x3c5, 0x3cf, 0x3e1, 0x3eb,
+	0x419, 0x422, 0x426, 0x433, 0x439, 0x441, 0x45a, 0x462, 0x46a, 0x470, 0x478, 0x487,
+	0x491, 0x498, 0x49e, 0x4a8, 0x4ae, 0x4c9, 0x4cd, 0x4d1, 0x4d7, 0x4de, 0x4e4, 0x4eb,
+	0x4f4, 0x4f9, 0x4fe, 0x507, 0x50f, 0x517, 0x51d, 0x530, 0x53b, 0x545, 0x54c, 0x55b,
+	0x564, 0x568, 0x56f, 0x579, 0x586, 0x58f, 0x597, 0x59e, 0x5a4, 0x5ad, 0x5b4, 0x5ba,
+	0x5c1, 0x5c7, 0x5ce, 0x5d8, 0x5e3, 0x5ed, 0x5fc, 0x606, 0x60a, 0x611, 0x61a, 0x629,
+	0x643, 0x64d, 0x658, 0x662, 0x667, 0x670, 0x678, 0x67e, 0x685, 0x68e, 0x697, 0x69f,
+	0x6ae, 0x6b3, 0x6c0, 0x6c8, 0x6d1, 0x6da, 0x6e2, 0x6e7, 0x6ec, 0x6f0, 0x6fc, 0x700,
+	0x706, 0x70a, 0x71a, 0x72c, 0x735, 0x73d, 0x741, 0x758, 0x767, 0x772, 0x77b, 0x783,
+	0x788, 0x790, 0x795, 0x7a8, 0x7b0, 0x7b9, 0x7c0, 0x7c7, 0x7cd, 0x7db, 0x7e9, 0x7f2,
+	0x7f8, 0x7fd, 0x806, 0x811, 0x81a, 0x82f, 0x837, 0x843, 0x84d, 0x854, 0x85c, 0x864,
+	0x86f, 0x883, 0x88e, 0x89a, 0x8a0, 0x8a9, 0x8b9, 0x8d0, 0x8d5, 0x8ed, 0x8f1, 0x8f9,
+	0x904, 0x90b, 0x914, 0x91e, 0x926, 0x92b, 0x931, 0x943, 0x949, 0x94f, 0x958, 0x961,
+	0x967, 0x982, 0x99d, 0x9a4, 0x9af, 0x9bb, 0x9d8, 0x9e1, 0x9f6, 0xa10, 0xa18, 0xa1f,
+	0xa2f, 0xa34, 0xa3a, 0xa3f, 0xa46, 0xa51, 0xa58, 0xa60, 0xa70, 0xa77, 0xa7d, 0xa8a,
+	0xa96, 0xa9e, 0xaa8, 0xab8, 0xac2, 0xace, 0xada, 0xae7, 0xaef, 0xb01, 0xb0a, 0xb13,
+	0xb1d, 0xb2a, 0xb35, 0xb41, 0xb4b, 0xb5e, 0xb68, 0xb6d, 0xb7b, 0xb84, 0xb8a, 0xb94,
+	0xba0, 0xbaa, 0xbb8,
+}
+
+const amRegionStr = "" +
+	"አሴንሽን ደሴትአንዶራየተባበሩት አረብ ኤምሬትስአፍጋኒስታንአንቲጓ እና ባሩዳአንጉኢላአልባኒያአርሜኒያኔዘርላንድስ አንቲልስአንጐላአ" +
+	"ንታርክቲካአርጀንቲናየአሜሪካ ሳሞአኦስትሪያአውስትሬሊያአሩባየአላንድ ደሴቶችአዘርባጃንቦስኒያ እና ሄርዞጎቪኒያባርቤዶስባንግላዲሽቤል" +
+	"ጄምቡርኪና ፋሶቡልጌሪያባህሬንብሩንዲቤኒንቅዱስ በርቴሎሜቤርሙዳብሩኒቦሊቪያየካሪቢያን ኔዘርላንድስብራዚልባሃማስቡህታንቡቬት ደሴትቦት" +
+	"ስዋናቤላሩስቤሊዘካናዳኮኮስ(ኬሊንግ) ደሴቶችኮንጎ-ኪንሻሳየመካከለኛው አፍሪካ ሪፐብሊክኮንጎ ብራዛቪልስዊዘርላንድኮት ዲቯርኩክ ደሴ" +
+	"ቶችቺሊካሜሩንቻይናኮሎምቢያክሊፐርቶን ደሴትኮስታ ሪካኩባኬፕ ቬርዴኩራሳዎየገና ደሴትሳይፕረስቼክ ሪፑብሊክጀርመንዲዬጎ ጋርሺያጂቡቲዴ" +
+	"ንማርክዶሚኒካዶሚኒክ ሪፑብሊክአልጄሪያሴኡታና ሜሊላኢኳዶርኤስቶኒያግብጽምዕራባዊ ሳህራኤርትራስፔንኢትዮጵያየአውሮፓ ህብረትፊንላንድፊ" +
+	"ጂየፎልክላንድ ደሴቶችሚክሮኔዢያየፋሮ ደሴቶችፈረንሳይጋቦንእንግሊዝግሬናዳጆርጂያየፈረንሳይ ጉዊአናጉርነሲጋናጊብራልታርግሪንላንድጋምቢ" +
+	"ያጊኒጉዋደሉፕኢኳቶሪያል ጊኒግሪክደቡብ ጆርጂያ እና የደቡብ ሳንድዊች ደሴቶችጉዋቲማላጉዋምጊኒ ቢሳኦጉያናሆንግ ኮንግ SAR ቻይናኽ" +
+	"ርድ ደሴቶችና ማክዶናልድ ደሴቶችሆንዱራስክሮኤሽያሀይቲሀንጋሪየካናሪ ደሴቶችኢንዶኔዢያአየርላንድእስራኤልአይል ኦፍ ማንህንድየብሪታኒ" +
+	"ያ ህንድ ውቂያኖስ ግዛትኢራቅኢራንአይስላንድጣሊያንጀርሲጃማይካጆርዳንጃፓንኬንያኪርጊስታንካምቦዲያኪሪባቲኮሞሮስቅዱስ ኪትስ እና ኔቪ" +
+	"ስሰሜን ኮሪያደቡብ ኮሪያክዌትካይማን ደሴቶችካዛኪስታንላኦስሊባኖስሴንት ሉቺያሊችተንስታይንሲሪላንካላይቤሪያሌሶቶሊቱዌኒያሉክሰምበርግ" +
+	"ላትቪያሊቢያሞሮኮሞናኮሞልዶቫሞንተኔግሮሴንት ማርቲንማዳጋስካርማርሻል አይላንድማከዶኒያማሊማይናማር(በርማ)ሞንጎሊያማካኡ ልዩ የአስተ" +
+	"ዳደር ክልል ቻይናየሰሜናዊ ማሪያና ደሴቶችማርቲኒክሞሪቴኒያሞንትሴራትማልታማሩሸስማልዲቭስማላዊሜክሲኮማሌዢያሞዛምቢክናሚቢያኒው ካሌዶ" +
+	"ኒያኒጀርኖርፎልክ ደሴትናይጄሪያኒካራጓኔዘርላንድኖርዌኔፓልናኡሩኒኡይኒው ዚላንድኦማንፓናማፔሩየፈረንሳይ ፖሊኔዢያፓፑዋ ኒው ጊኒፊሊፒ" +
+	"ንስፓኪስታንፖላንድቅዱስ ፒዬር እና ሚኩኤሎንፒትካኢርን አይስላንድፖርታ ሪኮየፍልስጤም ግዛትፖርቱጋልፓላውፓራጓይኳታርአውትላይንግ ኦ" +
+	"ሽንያሪዩኒየንሮሜኒያሰርብያራሺያሩዋንዳሳውድአረቢያሰሎሞን ደሴትሲሼልስሱዳንስዊድንሲንጋፖርሴንት ሄለናስሎቬኒያስቫልባርድ እና ጃን ማ" +
+	"የንስሎቫኪያሴራሊዮንሳን ማሪኖሴኔጋልሱማሌሱሪናምደቡብ ሱዳንሳኦ ቶሜ እና ፕሪንሲፔኤል ሳልቫዶርሲንት ማርተንሲሪያሱዋዚላንድትሪስታን" +
+	" ዲ ኩንሃየቱርኮችና የካኢኮስ ደሴቶችቻድየፈረንሳይ ደቡባዊ ግዛቶችቶጐታይላንድታጃኪስታንቶክላውምስራቅ ሌስትቱርክሜኒስታንቱኒዚያቶን" +
+	"ጋቱርክትሪናዳድ እና ቶቤጎቱቫሉታይዋንታንዛኒያዩክሬንዩጋንዳየዩ ኤስ ጠረፍ ላይ ያሉ ደሴቶችዩናይትድ ስቴትስኡራጓይዩዝበኪስታንቫቲካ" +
+	"ን ከተማቅዱስ ቪንሴንት እና ግሬናዲንስቬንዙዌላየእንግሊዝ ድንግል ደሴቶችየአሜሪካ ቨርጂን ደሴቶችቬትናምቫኑአቱዋሊስ እና ፉቱና ደ" +
+	"ሴቶችሳሞአኮሶቮየመንሜይኦቴደቡብ አፍሪካዛምቢያዚምቧቤያልታወቀ ክልልዓለምአፍሪካሰሜን አሜሪካደቡብ አሜሪካኦሽኒአምስራቃዊ አፍሪካመካ" +
+	"ከለኛው አሜሪካምዕራባዊ አፍሪካሰሜናዊ አፍሪካመካከለኛው አፍሪካደቡባዊ አፍሪካአሜሪካሰሜናዊ አሜሪካካሪቢያንምዕራባዊ እሲያደቡባዊ " +
+	"እሲያምዕራባዊ ደቡብ እሲያደቡባዊ አውሮፓአውስትራሊያሜላኔዥያየማይክሮኔዥያን ክልልፖሊኔዥያእሲያመካከለኛው እሲያምስራቃዊ እሲያአውሮ" +
+	"ፓምዕራባዊ አውሮፓሰሜናዊ አውሮፓምስራቃዊ አውሮፓላቲን አሜሪካ"
+
+var amRegionIdx = []uint16{ // 291 entries
+	0x0, 0x19, 0x25, 0x51, 0x66, 0x83, 0x92, 0xa1, 0xb0, 0xd5, 0xe1, 0xf6,
+	0x108, 0x121, 0x130, 0x145, 0x14e, 0x16a, 0x17c, 0x1a5, 0x1b4, 0x1c6, 0x1d2, 0x1e5,
+	0x1f4, 0x200, 0x20c, 0x215, 0x22e, 0x23a, 0x243, 0x24f, 0x277, 0x283, 0x28f, 0x29b,
+	0x2ae, 0x2bd, 0x2c9, 0x2d2, 0x2db, 0x2ff, 0x315, 0x347, 0x360, 0x375, 0x385, 0x398,
+	0x39e, 0x3aa, 0x3b3, 0x3c2, 0x3de, 0x3ee, 0x3f4, 0x404, 0x410, 0x423, 0x432, 0x448,
+	0x454, 0x46a, 0x473, 0x482, 0x48e, 0x4aa, 0x4b9, 0x4cf, 0x4db, 0x4ea, 0x4f3, 0x50c,
+	0x518, 0x521, 0x530, 0x54c, 0x55b, 0x561, 0x583, 0x595, 0x5ab, 0x5ba, 0x5c3, 0x5d2,
+	0x5de, 0x5ea, 0x609, 0x615, 0x61b, 0x62d, 0x63f, 0x64b, 0x651, 0x660, 0x679, 0x682,
+	0x6c9, 0x6d8, 0x6e1, 0x6f1, 0x6fa, 0x71b, 0x754, 0x763, 0x772, 0x77b, 0x787, 0x7a0,
+	0x7b2, 0x7c4, 0x7d3, 0x7ea, 0x7f3, 0x829, 0x832, 0x83b, 0x84d, 0x859, 0x862, 0x86e,
+	0x87a, 0x883, 0x88c, 0x89e, 0x8ad, 0x8b9, 0x8c5, 0x8e9, 0x8fc, 0x90f, 0x918, 0x931,
+	0x943, 0x94c, 0x958, 0x96b, 0x983, 0x992, 0x9a1, 0x9aa, 0x9b9, 0x9ce, 0x9da, 0x9e3,
+	0x9ec, 0x9f5, 0xa01, 0xa13, 0xa29, 0xa3b, 0xa57, 0xa66, 0xa6c, 0xa86, 0xa95, 0xacf,
+	0xaf8, 0xb07, 0xb16, 0xb28, 0xb31, 0xb3d, 0xb4c, 0xb55, 0xb61, 0xb6d, 0xb7c, 0xb88,
+	0xb9e, 0xba7, 0xbc0, 0xbcf, 0xbdb, 0xbed, 0xbf6, 0xbff, 0xc08, 0xc11, 0xc24, 0xc2d,
+	0xc36, 0xc3c, 0xc5e, 0xc75, 0xc84, 0xc93, 0xc9f, 0xcc9, 0xcee, 0xcfe, 0xd1a, 0xd29,
+	0xd32, 0xd3e, 0xd47, 0xd69, 0xd78, 0xd84, 0xd90, 0xd99, 0xda5, 0xdba, 0xdd0, 0xddc,
+	0xde5, 0xdf1, 0xe00, 0xe13, 0xe22, 0xe4c, 0xe5b, 0xe6a, 0xe7a, 0xe86, 0xe8f, 0xe9b,
+	0xeae, 0xed2, 0xee8, 0xefe, 0xf07, 0xf19, 0xf36, 0xf65, 0xf6b, 0xf97, 0xf9d, 0xfac,
+	0xfbe, 0xfca, 0xfe0, 0xff8, 0x1004, 0x100d, 0x1016, 0x1036, 0x103f, 0x104b, 0x105a, 0x1066,
+	0x1072, 0x10a4, 0x10c0, 0x10cc, 0x10e1, 0x10f7, 0x112a, 0x1139, 0x1165, 0x118e, 0x119a, 0x11a6,
+	0x11cd, 0x11d6, 0x11df, 0x11e8, 0x11f4, 0x120a, 0x1216, 0x1222, 0x123b, 0x1244, 0x1250, 0x1266,
+	0x127c, 0x1288, 0x12a4, 0x12c3, 0x12df, 0x12f8, 0x1317, 0x1330, 0x133c, 0x1355, 0x1364, 0x137d,
+	0x1393, 0x13b6, 0x13cf, 0x13e4, 0x13f3, 0x1418, 0x1427, 0x1430, 0x144c, 0x1465, 0x1471, 0x148d,
+	0x14a6, 0x14c2, 0x14d8,
+}
+
+const arRegionStr = "" +
+	"جزيرة أسينشيونأندوراالإمارات العربية المتحدةأفغانستانأنتيغوا وبربوداأنغويلاألبان" +
+	"ياأرمينياجزر الأنتيل الهولنديةأنغولاأنتاركتيكاالأرجنتينساموا الأمريكيةالنمساأستر" +
+	"الياآروباجزر آلاندأذربيجانالبوسنة والهرسكبربادوسبنجلاديشبلجيكابوركينا فاسوبلغاري" +
+	"االبحرينبورونديبنينسان بارتليميبرمودابرونايبوليفياهولندا الكاريبيةالبرازيلالباها" +
+	"مابوتانجزيرة بوفيهبتسواناروسيا البيضاءبليزكنداجزر كوكوسالكونغو - كينشاساجمهورية " +
+	"أفريقيا الوسطىالكونغو - برازافيلسويسراساحل العاججزر كوكشيليالكاميرونالصينكولومبي" +
+	"اجزيرة كليبيرتونكوستاريكاكوباالرأس الأخضركوراساوجزيرة الكريسماسقبرصجمهورية التشي" +
+	"كألمانيادييغو غارسياجيبوتيالدانمركدومينيكاجمهورية الدومينيكالجزائرسيوتا وميليلاا" +
+	"لإكوادورأستونيامصرالصحراء الغربيةأريترياإسبانياإثيوبياالاتحاد الأوروبيفنلندافيجي" +
+	"جزر فوكلاندميكرونيزياجزر فاروفرنساالجابونالمملكة المتحدةغريناداجورجياغويانا الفر" +
+	"نسيةغيرنزيغاناجبل طارقغرينلاندغامبياغينياجوادلوبغينيا الإستوائيةاليونانجورجيا ال" +
+	"جنوبية وجزر ساندويتش الجنوبيةغواتيمالاغوامغينيا بيساوغياناهونغ كونغ الصينيةجزيرة" +
+	" هيرد وجزر ماكدونالدهندوراسكرواتياهايتيهنغارياجزر الكناريأندونيسياأيرلنداإسرائيل" +
+	"جزيرة مانالهندالإقليم البريطاني في المحيط الهنديالعراقإيرانأيسلنداإيطالياجيرسيجا" +
+	"مايكاالأردناليابانكينياقرغيزستانكمبودياكيريباتيجزر القمرسانت كيتس ونيفيسكوريا ال" +
+	"شماليةكوريا الجنوبيةالكويتجزر الكايمنكازاخستانلاوسلبنانسانت لوسياليختنشتاينسريلا" +
+	"نكاليبيرياليسوتوليتوانيالوكسمبورغلاتفياليبياالمغربموناكومولدافياالجبل الأسودسانت" +
+	" مارتنمدغشقرجزر المارشالمقدونياماليميانمار -بورمامنغوليامكاو الصينية (منطقة إدار" +
+	"ية خاصة)جزر ماريانا الشماليةمارتينيكموريتانيامونتسراتمالطاموريشيوسجزر المالديفمل" +
+	"اويالمكسيكماليزياموزمبيقناميبياكاليدونيا الجديدةالنيجرجزيرة نورفوكنيجيريانيكاراغ" +
+	"واهولنداالنرويجنيبالناورونيوينيوزيلانداعُمانبنمابيروبولينيزيا الفرنسيةبابوا غيني" +
+	"ا الجديدةالفلبينباكستانبولنداسانت بيير وميكولونجزر بيتكيرنبورتوريكوالأراضي الفلس" +
+	"طينيةالبرتغالبالاوباراغوايقطرأوقيانوسيا النائيةروينيونرومانياصربياروسياروانداالم" +
+	"ملكة العربية السعوديةجزر سليمانسيشلالسودانالسويدسنغافورةسانت هيلناسلوفينياسفالبا" +
+	"رد وجان مايانسلوفاكياسيراليونسان مارينوالسنغالالصومالسورينامجنوب السودانساو تومي" +
+	" وبرينسيبيالسلفادورسينت مارتنسورياسوازيلاندتريستان دي كونهاجزر الترك وجايكوستشاد" +
+	"المقاطعات الجنوبية الفرنسيةتوجوتايلاندطاجكستانتوكيلوتيمور الشرقيةتركمانستانتونست" +
+	"ونغاتركياترينيداد وتوباغوتوفالوتايوانتانزانياأوكرانياأوغنداجزر الولايات المتحدة " +
+	"النائيةالولايات المتحدةأورغوايأوزبكستانالفاتيكانسانت فنسنت وغرنادينفنزويلاجزر فر" +
+	"جين البريطانيةجزر فرجين الأمريكيةفيتنامفانواتوجزر والس وفوتوناسامواكوسوفواليمنما" +
+	"يوتجنوب أفريقيازامبيازيمبابويمنطقة غير معروفةالعالمأفريقياأمريكا الشماليةأمريكا " +
+	"الجنوبيةأوقيانوسياغرب أفريقياأمريكا الوسطىشرق أفريقياشمال أفريقياوسط أفريقياأفري" +
+	"قيا الجنوبيةالأمريكتينشمال أمريكاالكاريبيشرق آسياجنوب آسياجنوب شرق آسياجنوب أورو" +
+	"باأسترالاسياميلانيزياالجزر الميكرونيزيةبولينيزياآسياوسط آسياغرب آسياأوروباشرق أو" +
+	"روباشمال أوروباغرب أوروباأمريكا اللاتينية"
+
+var arRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1b, 0x27, 0x55, 0x67, 0x84, 0x92, 0xa0, 0xae, 0xd6, 0xe2, 0xf6,
+	0x108, 0x125, 0x131, 0x141, 0x14b, 0x15c, 0x16c, 0x189, 0x197, 0x1a7, 0x1b3, 0x1ca,
+	0x1d8, 0x1e6, 0x1f4, 0x1fc, 0x213, 0x21f, 0x22b, 0x239, 0x258, 0x268, 0x278, 0x282,
+	0x297, 0x2a5, 0x2be, 0x2c6, 0x2ce, 0x2df, 0x2fe, 0x328, 0x349, 0x355, 0x368, 0x375,
+	0x37d, 0x38f, 0x399, 0x3a9, 0x3c6, 0x3d8, 0x3e0, 0x3f7, 0x405, 0x422, 0x42a, 0x445,
+	0x453, 0x46a, 0x476, 0x486, 0x496, 0x4b7, 0x4c5, 0x4de, 0x4f0, 0x4fe, 0x504, 0x521,
+	0x52f, 0x53d, 0x54b, 0x56a, 0x576, 0x57e, 0x593, 0x5a7, 0x5b6, 0x5c0, 0x5ce, 0x5eb,
+	0x5f9, 0x605, 0x622, 0x62e, 0x636, 0x645, 0x655, 0x661, 0x66b, 0x679, 0x698, 0x6a6,
+	0x6ee, 0x700, 0x708, 0x71d, 0x727, 0x747, 0x776, 0x784, 0x792, 0x79c, 0x7aa, 0x7bf,
+	0x7d1, 0x7df, 0x7ed, 0x7fe, 0x808, 0x848, 0x854, 0x85e, 0x86c, 0x87a, 0x884, 0x892,
+	0x89e, 0x8ac, 0x8b6, 0x8c8, 0x8d6, 0x8e6, 0x8f7, 0x915, 0x930, 0x94b, 0x957, 0x96c,
+	0x97e, 0x986, 0x990, 0x9a3, 0x9b7, 0x9c7, 0x9d5, 0x9e1, 0x9f1, 0xa03, 0xa0f, 0xa19,
+	0xa25, 0xa31, 0xa41, 0xa58, 0xa6b, 0xa77, 0xa8e, 0xa9c, 0xaa4, 0xabe, 0xacc, 0xb06,
+	0xb2c, 0xb3c, 0xb4e, 0xb5e, 0xb68, 0xb78, 0xb8f, 0xb99, 0xba7, 0xbb5, 0xbc3, 0xbd1,
+	0xbf2, 0xbfe, 0xc15, 0xc23, 0xc35, 0xc41, 0xc4f, 0xc59, 0xc63, 0xc6b, 0xc7f, 0xc89,
+	0xc91, 0xc99, 0xcbc, 0xce0, 0xcee, 0xcfc, 0xd08, 0xd2a, 0xd3f, 0xd51, 0xd74, 0xd84,
+	0xd8e, 0xd9e, 0xda4, 0xdc7, 0xdd5, 0xde3, 0xded, 0xdf7, 0xe03, 0xe31, 0xe44, 0xe4c,
+	0xe5a, 0xe66, 0xe76, 0xe89, 0xe99, 0xebd, 0xecd, 0xedd, 0xef0, 0xefe, 0xf0c, 0xf1a,
+	0xf31, 0xf53, 0xf65, 0xf78, 0xf82, 0xf94, 0xfb2, 0xfd2, 0xfda, 0x100e, 0x1016, 0x1024,
+	0x1034, 0x1040, 0x1059, 0x106d, 0x1075, 0x107f, 0x1089, 0x10a8, 0x10b4, 0x10c0, 0x10d0, 0x10e0,
+	0x10ec, 0x1121, 0x1140, 0x114e, 0x1160, 0x1172, 0x1196, 0x11a4, 0x11ca, 0x11ee, 0x11fa, 0x1208,
+	0x1226, 0x1230, 0x123c, 0x1246, 0x1250, 0x1267, 0x1273, 0x1283, 0x12a1, 0x12ad, 0x12bb, 0x12d8,
+	0x12f5, 0x1309, 0x131e, 0x1337, 0x134c, 0x1363, 0x1378, 0x1397, 0x13ab, 0x13c0, 0x13d0, 0x13df,
+	0x13f0, 0x1408, 0x141d, 0x1431, 0x1443, 0x1466, 0x1478, 0x1480, 0x148f, 0x149e, 0x14aa, 0x14bd,
+	0x14d2, 0x14e5, 0x1504,
+}
+
+const azRegionStr = "" +
+	"Yüksəliş AdasıAndorraBirləşmiş Ərəb ƏmirlikləriƏfqanıstanAntiqua və BarbudaAngil" +
+	"aAlbaniyaErmənistanHollandiya antilleriAnqolaAntarktikaArgentinaAmerika SamoasıA" +
+	"vstriyaAvstraliyaArubaAland AdalarıAzərbaycanBosniya və HersoqovinaBarbadosBanql" +
+	"adeşBelçikaBurkina FasoBolqariyaBəhreynBurundiBeninSan BartolomeyBermudaBruneyBo" +
+	"liviyaKarib NiderlandıBraziliyaBaham AdalarıButanBuve AdasıBotsvanaBelarusBelizK" +
+	"anadaKokos AdalarıKonqo - KinşasaMərkəzi Afrika RespublikasıKonqo - Brazzavilİsv" +
+	"eçrəFil Dişi SahiliKuk AdalarıÇiliKamerunÇinKolumbiyaKlipperton AdasıKosta RikaK" +
+	"ubaKape VerdeKurasaoMilad AdasıKiprÇexiyaAlmaniyaDieqo QarsiyaCibutiDanimarkaDom" +
+	"inikaDominikan RespublikasıƏlcəzairSeuta və MelilyaEkvadorEstoniyaMisirQərbi Sah" +
+	"araEritreyaİspaniyaEfiopiyaAvropa BirliyiFinlandiyaFiciFolklend AdalarıMikronezi" +
+	"yaFarer AdalarıFransaQabonBirləşmiş KrallıqQrenadaGürcüstanFransız QviyanasıGern" +
+	"seyQanaGibraltarQrenlandiyaQambiyaQvineyaQvadelupaEkvatorial QvineyaYunanıstanCə" +
+	"nubi Corciya və Cənubi Sendviç AdalarıQvatemalaQuamQvineya-BisauQviyanaHonq Konq" +
+	" Xüsusi İnzibati Ərazi ÇinHerd və Mak Donald AdalarıHondurasXorvatiyaHaitiMacarı" +
+	"stanKanar AdalarıİndoneziyaİrlandiyaİsrailMen AdasıHindistanBritaniya Hind Okean" +
+	"ı ƏrazisiİraqİranİslandiyaİtaliyaCersiYamaykaİordaniyaYaponiyaKeniyaQırğızıstanK" +
+	"ambocaKiribatiKomor AdalarıSan Kits və NevisŞimali KoreyaCənubi KoreyaKüveytKaym" +
+	"an AdalarıQazaxıstanLaosLivanSan LüsiyaLixtenşteynŞri LankaLiberiyaLesotoLitvaLü" +
+	"ksemburqLatviyaLiviyaMərakeşMonakoMoldovaMonteneqroSan MartinMadaqaskarMarşal Ad" +
+	"alarıMakedoniyaMaliMyanmaMonqoliyaMakao Xüsusi İnzibati Ərazi ÇinŞimali Mariana " +
+	"AdalarıMartinikMavritaniyaMonseratMaltaMavrikiMaldiv AdalarıMalaviMeksikaMalayzi" +
+	"yaMozambikNamibiyaYeni KaledoniyaNigerNorfolk AdasıNigeriyaNikaraquaNiderlandNor" +
+	"veçNepalNauruNiueYeni ZelandiyaOmanPanamaPeruFransız PolineziyasıPapua Yeni Qvin" +
+	"eyaFilippinPakistanPolşaSan Pier və MikelonPitkern AdalarıPuerto RikoFələstin Ər" +
+	"aziləriPortuqalPalauParaqvayQatarUzaq OkeaniyaReunionRumıniyaSerbiyaRusiyaRuanda" +
+	"Səudiyyə ƏrəbistanıSolomon AdalarıSeyşel AdalarıSudanİsveçSinqapurMüqəddəs Yelen" +
+	"aSloveniyaSvalbard və Yan MayenSlovakiyaSiera LeonSan MarinoSeneqalSomaliSurinam" +
+	"Cənubi SudanSao Tome və PrinsipSalvadorSint MaartenSuriyaSvazilendTristan da Kun" +
+	"yaTurks və Kaikos AdalarıÇadFransa Cənub ƏraziləriToqoTaylandTacikistanTokelauŞə" +
+	"rqi TimorTürkmənistanTunisTonqaTürkiyaTrinidad və TobaqoTuvaluTayvanTanzaniyaUkr" +
+	"aynaUqandaBirləşmiş Ştatlar Uzaq AdalarAmerika Birləşmiş ŞtatlarıUruqvayÖzbəkist" +
+	"anVatikanSan Vinsent və QrenadaVenesuelaBritaniya Vircin AdalarıABŞ Vircin Adala" +
+	"rıVyetnamVanuatuUolis və FutunaSamoaKosovoYəmənMayotCənub AfrikaZambiyaZimbabveN" +
+	"aməlum RegionDünyaAfrikaŞimali AmerikaCənubi AmerikaOkeaniyaQərbi AfrikaMərkəzi " +
+	"AmerikaŞərqi AfrikaŞimali AfrikaMərkəzi AfrikaCənubi AfrikaAmerikalarŞimal Ameri" +
+	"kasıKaribŞərqi AsiyaCənubi AsiyaCənub-Şərqi AsiyaCənubi AvropaAvstralaziyaMelane" +
+	"ziyaMikroneziya RegionuPolineziyaAsiyaMərkəzi AsiyaQərbi AsiyaAvropaŞərqi Avropa" +
+	"Şimali AvropaQərbi AvropaLatın Amerikası"
+
+var azRegionIdx = []uint16{ // 291 entries
+	0x0, 0x12, 0x19, 0x3a, 0x46, 0x59, 0x5f, 0x67, 0x72, 0x86, 0x8c, 0x96,
+	0x9f, 0xaf, 0xb7, 0xc1, 0xc6, 0xd4, 0xdf, 0xf6, 0xfe, 0x108, 0x110, 0x11c,
+	0x125, 0x12d, 0x134, 0x139, 0x147, 0x14e, 0x154, 0x15c, 0x16d, 0x176, 0x184, 0x189,
+	0x194, 0x19c, 0x1a3, 0x1a8, 0x1ae, 0x1bc, 0x1cc, 0x1ea, 0x1fb, 0x205, 0x215, 0x221,
+	0x226, 0x22d, 0x231, 0x23a, 0x24b, 0x255, 0x259, 0x263, 0x26a, 0x276, 0x27a, 0x281,
+	0x289, 0x296, 0x29c, 0x2a5, 0x2ad, 0x2c4, 0x2ce, 0x2df, 0x2e6, 0x2ee, 0x2f3, 0x300,
+	0x308, 0x311, 0x319, 0x327, 0x331, 0x335, 0x346, 0x351, 0x35f, 0x365, 0x36a, 0x37f,
+	0x386, 0x391, 0x3a4, 0x3ab, 0x3af, 0x3b8, 0x3c3, 0x3ca, 0x3d1, 0x3da, 0x3ec, 0x3f7,
+	0x424, 0x42d, 0x431, 0x43e, 0x445, 0x46c, 0x488, 0x490, 0x499, 0x49e, 0x4a9, 0x4b7,
+	0x4c2, 0x4cc, 0x4d3, 0x4dd, 0x4e6, 0x505, 0x50a, 0x50f, 0x519, 0x521, 0x526, 0x52d,
+	0x537, 0x53f, 0x545, 0x554, 0x55b, 0x563, 0x571, 0x583, 0x591, 0x59f, 0x5a6, 0x5b5,
+	0x5c0, 0x5c4, 0x5c9, 0x5d4, 0x5e0, 0x5ea, 0x5f2, 0x5f8, 0x5fd, 0x608, 0x60f, 0x615,
+	0x61e, 0x624, 0x62b, 0x635, 0x63f, 0x649, 0x659, 0x663, 0x667, 0x66d, 0x676, 0x699,
+	0x6b1, 0x6b9, 0x6c4, 0x6cc, 0x6d1, 0x6d8, 0x6e7, 0x6ed, 0x6f4, 0x6fd, 0x705, 0x70d,
+	0x71c, 0x721, 0x72f, 0x737, 0x740, 0x749, 0x750, 0x755, 0x75a, 0x75e, 0x76c, 0x770,
+	0x776, 0x77a, 0x790, 0x7a2, 0x7aa, 0x7b2, 0x7b8, 0x7cc, 0x7dc, 0x7e7, 0x7fd, 0x805,
+	0x80a, 0x812, 0x817, 0x824, 0x82b, 0x834, 0x83b, 0x841, 0x847, 0x85f, 0x86f, 0x87f,
+	0x884, 0x88b, 0x893, 0x8a5, 0x8ae, 0x8c4, 0x8cd, 0x8d7, 0x8e1, 0x8e8, 0x8ee, 0x8f5,
+	0x902, 0x916, 0x91e, 0x92a, 0x930, 0x939, 0x949, 0x962, 0x966, 0x97f, 0x983, 0x98a,
+	0x994, 0x99b, 0x9a8, 0x9b6, 0x9bb, 0x9c0, 0x9c8, 0x9db, 0x9e1, 0x9e7, 0x9f0, 0x9f7,
+	0x9fd, 0xa1e, 0xa3d, 0xa44, 0xa50, 0xa57, 0xa6e, 0xa77, 0xa90, 0xaa4, 0xaab, 0xab2,
+	0xac2, 0xac7, 0xacd, 0xad4, 0xad9, 0xae6, 0xaed, 0xaf5, 0xb04, 0xb0a, 0xb10, 0xb1f,
+	0xb2e, 0xb36, 0xb43, 0xb54, 0xb62, 0xb70, 0xb80, 0xb8e, 0xb98, 0xba9, 0xbae, 0xbbb,
+	0xbc8, 0xbdc, 0xbea, 0xbf6, 0xc00, 0xc13, 0xc1d, 0xc22, 0xc31, 0xc3d, 0xc43, 0xc51,
+	0xc5f, 0xc6c, 0xc7d,
+}
+
+const bgRegionStr = "" +
+	"остров ВъзнесениеАндораОбединени арабски емирстваАфганистанАнтигуа и БарбудаАнгу" +
+	"илаАлбанияАрменияХоландски АнтилиАнголаАнтарктикаАржентинаАмериканска СамоаАвстр" +
+	"ияАвстралияАрубаОландски островиАзербайджанБосна и ХерцеговинаБарбадосБангладешБ" +
+	"елгияБуркина ФасоБългарияБахрейнБурундиБенинСен БартелемиБермудаБруней Дарусалам" +
+	"БоливияКарибска НидерландияБразилияБахамиБутаностров БувеБотсванаБеларусБелизКан" +
+	"адаКокосови острови (острови Кийлинг)Демократична република КонгоЦентралноафрика" +
+	"нска РепубликаКонгоШвейцарияКот д'Ивоарострови КукЧилиКамерунКитайКолумбияостров" +
+	" КлипертонКоста РикаКубаКабо ВердеКюрасаоОстров РождествоКипърЧехияГерманияДиего" +
+	" ГарсияДжибутиДанияДоминикаДоминиканска републикаАлжирСеута и МелияЕквадорЕстони" +
+	"яЕгипетЗападна СахараЕритреяИспанияЕтиопияЕвропейски съюзФинландияФиджиФолклендс" +
+	"ки островиФедерални щати на МикронезияФарьорски островиФранцияГабонВеликобритани" +
+	"яГренадаГрузияФренска Гвианао. ГърнсиГанаГибралтарГренландияГамбияГвинеяГваделуп" +
+	"аЕкваториална ГвинеяГърцияЮжна Джорджия и Южни Сандвичеви островиГватемалаГуамГв" +
+	"инея-БисауГаянаХонконг САР на Китайостров Хърд и острови МакдоналдХондурасХърват" +
+	"ияХаитиУнгарияКанарски островиИндонезияИрландияИзраелОстров МанИндияБританска те" +
+	"ритория в Индийския океанИракИранИсландияИталияДжърсиЯмайкаЙорданияЯпонияКенияКи" +
+	"ргизстанКамбоджаКирибатиКоморски островиСейнт Китс и НевисСеверна КореяЮжна Коре" +
+	"яКувейтКайманови островиКазахстанЛаосЛиванСейнт ЛусияЛихтенщайнШри ЛанкаЛиберияЛ" +
+	"есотоЛитваЛюксембургЛатвияЛибияМарокоМонакоМолдоваЧерна гораСен МартенМадагаскар" +
+	"Маршалови островиМакедонияМалиМианмар (Бирма)МонголияМакао САР на КитайСеверни М" +
+	"ариански островиМартиникаМавританияМонсератМалтаМаврицийМалдивиМалавиМексикоМала" +
+	"йзияМозамбикНамибияНова КаледонияНигеростров НорфолкНигерияНикарагуаНидерландияН" +
+	"орвегияНепалНауруНиуеНова ЗеландияОманПанамаПеруФренска ПолинезияПапуа Нова Гвин" +
+	"еяФилипиниПакистанПолшаСен Пиер и МикелонОстрови ПиткернПуерто РикоПалестински т" +
+	"ериторииПортугалияПалауПарагвайКатаротдалечени острови на ОкеанияРеюнионРумънияС" +
+	"ърбияРусияРуандаСаудитска АрабияСоломонови островиСейшелиСуданШвецияСингапурСвет" +
+	"а ЕленаСловенияСвалбард и Ян МайенСловакияСиера ЛеонеСан МариноСенегалСомалияСур" +
+	"инамЮжен СуданСао Томе и ПринсипиСалвадорСинт МартенСирияСвазилендТристан да Кун" +
+	"яОстрови Търкс и КайкосЧадФренски южни територииТогоТайландТаджикистанТокелауИзт" +
+	"очен ТиморТуркменистанТунисТонгаТурцияТринидад и ТобагоТувалуТайванТанзанияУкрай" +
+	"наУгандаОтдалечени острови на САЩСАЩУругвайУзбекистанВатиканСейнт Винсънт и Грен" +
+	"адиниВенецуелаБритански Вирджински островиВирджински острови, САЩВиетнамВануатуУ" +
+	"олис и ФутунаСамоаКосовоЙеменМайотЮжна АфрикаЗамбияЗимбабвенепознат регионЗемята" +
+	"АфрикаСеверноамерикански континентЮжна АмерикаОкеанияЗападна АфиркаЦентрална Аме" +
+	"рикаИзточна АфрикаСеверна АфрикаЦентрална АфрикаЮжноафрикански регионАмерикаСеве" +
+	"рна АмерикаКарибски регионИзточна АзияЮжна АзияЮгоизточна АзияЮжна ЕвропаАвстрал" +
+	"азияМеланезияМикронезияПолинезияАзияЦентрална АзияЗападна АзияЕвропаИзточна Евро" +
+	"паСеверна ЕвропаЗападна ЕвропаЛатинска Америка"
+
+var bgRegionIdx = []uint16{ // 291 entries
+	0x0, 0x21, 0x2d, 0x5f, 0x73, 0x93, 0xa1, 0xaf, 0xbd, 0xdc, 0xe8, 0xfc,
+	0x10e, 0x12f, 0x13d, 0x14f, 0x159, 0x178, 0x18e, 0x1b2, 0x1c2, 0x1d4, 0x1e0, 0x1f7,
+	0x207, 0x215, 0x223, 0x22d, 0x246, 0x254, 0x273, 0x281, 0x2a8, 0x2b8, 0x2c4, 0x2ce,
+	0x2e3, 0x2f3, 0x301, 0x30b, 0x317, 0x356, 0x38c, 0x3c5, 0x3cf, 0x3e1, 0x3f5, 0x40a,
+	0x412, 0x420, 0x42a, 0x43a, 0x459, 0x46c, 0x474, 0x487, 0x495, 0x4b4, 0x4be, 0x4c8,
+	0x4d8, 0x4ef, 0x4fd, 0x507, 0x517, 0x542, 0x54c, 0x564, 0x572, 0x580, 0x58c, 0x5a7,
+	0x5b5, 0x5c3, 0x5d1, 0x5ee, 0x600, 0x60a, 0x62f, 0x664, 0x685, 0x693, 0x69d, 0x6b9,
+	0x6c7, 0x6d3, 0x6ee, 0x6fe, 0x706, 0x718, 0x72c, 0x738, 0x744, 0x756, 0x77b, 0x787,
+	0x7d0, 0x7e2, 0x7ea, 0x801, 0x80b, 0x830, 0x86a, 0x87a, 0x88a, 0x894, 0x8a2, 0x8c1,
+	0x8d3, 0x8e3, 0x8ef, 0x902, 0x90c, 0x952, 0x95a, 0x962, 0x972, 0x97e, 0x98a, 0x996,
+	0x9a6, 0x9b2, 0x9bc, 0x9d0, 0x9e0, 0x9f0, 0xa0f, 0xa30, 0xa49, 0xa5c, 0xa68, 0xa89,
+	0xa9b, 0xaa3, 0xaad, 0xac2, 0xad6, 0xae7, 0xaf5, 0xb01, 0xb0b, 0xb1f, 0xb2b, 0xb35,
+	0xb41, 0xb4d, 0xb5b, 0xb6e, 0xb81, 0xb95, 0xbb6, 0xbc8, 0xbd0, 0xbeb, 0xbfb, 0xc1c,
+	0xc4c, 0xc5e, 0xc72, 0xc82, 0xc8c, 0xc9c, 0xcaa, 0xcb6, 0xcc4, 0xcd4, 0xce4, 0xcf2,
+	0xd0d, 0xd17, 0xd32, 0xd40, 0xd52, 0xd68, 0xd78, 0xd82, 0xd8c, 0xd94, 0xdad, 0xdb5,
+	0xdc1, 0xdc9, 0xdea, 0xe0a, 0xe1a, 0xe2a, 0xe34, 0xe55, 0xe72, 0xe87, 0xeb0, 0xec4,
+	0xece, 0xede, 0xee8, 0xf1f, 0xf2d, 0xf3b, 0xf47, 0xf51, 0xf5d, 0xf7c, 0xf9f, 0xfad,
+	0xfb7, 0xfc3, 0xfd3, 0xfe8, 0xff8, 0x101b, 0x102b, 0x1040, 0x1053, 0x1061, 0x106f, 0x107d,
+	0x1090, 0x10b3, 0x10c3, 0x10d8, 0x10e2, 0x10f4, 0x1110, 0x1139, 0x113f, 0x1169, 0x1171, 0x117f,
+	0x1195, 0x11a3, 0x11bc, 0x11d4, 0x11de, 0x11e8, 0x11f4, 0x1214, 0x1220, 0x122c, 0x123c, 0x124a,
+	0x1256, 0x1285, 0x128b, 0x1299, 0x12ad, 0x12bb, 0x12ea, 0x12fc, 0x1332, 0x135d, 0x136b, 0x1379,
+	0x1393, 0x139d, 0x13a9, 0x13b3, 0x13bd, 0x13d2, 0x13de, 0x13ee, 0x140b, 0x1417, 0x1423, 0x145a,
+	0x1471, 0x147f, 0x149a, 0x14bb, 0x14d6, 0x14f1, 0x1510, 0x1539, 0x1547, 0x1564, 0x1581, 0x1598,
+	0x15a9, 0x15c6, 0x15db, 0x15f1, 0x1603, 0x1617, 0x1629, 0x1631, 0x164c, 0x1663, 0x166f, 0x168a,
+	0x16a5, 0x16c0, 0x16df,
+}
+
+const bnRegionStr = "" +
+	"অ্যাসসেনশন আইল্যান্ডএ্যান্ডোরাসংযুক্ত আরব আমিরাতআফগানিস্তানএন্টিগুয়া ও বারবুডাএ" +
+	"্যাঙ্গুইলাআলব্যানিয়াআর্মেনিয়ানেদারল্যান্ডস এ্যান্টিলিসএ্যাঙ্গোলাঅ্যান্টার্কটিক" +
+	"াআর্জেণ্টাইনাআমেরিকান সামোয়াঅস্ট্রিয়াঅস্ট্রেলিয়াআরুবাআলান্ড দ্বীপপুঞ্জআজারবাই" +
+	"জানবসনিয়া ও হার্জেগোভিনাবারবাদোসবাংলাদেশবেলজিয়ামবুরকিনা ফাসোবুলগেরিয়াবাহরাইনব" +
+	"ুরুন্ডিবেনিনসেন্ট বারথেলিমিবারমুডাব্রুনেইবোলিভিয়াক্যারিবিয়ান নেদারল্যান্ডসব্রা" +
+	"জিলবাহামা দ্বীপপুঞ্জভুটানবোভেট দ্বীপবতসোয়ানাবেলোরুশিয়াবেলিযকানাডাকোকোস দ্বীপপু" +
+	"ঞ্জকঙ্গো - কিনসাসামধ্য আফ্রিকান প্রজাতন্ত্রকঙ্গো - ব্রাজাভিলসুইজারল্যান্ডআইভরি ক" +
+	"োস্টকুক দ্বীপপুঞ্জচিলিক্যামেরুনচীনকোলোম্বিয়াক্লিপারটন আইল্যান্ডকোস্টারিকাকিউবাক" +
+	"েপভার্দেকুরাসাওক্রিসমাস দ্বীপসাইপ্রাসচেক প্রজাতন্ত্রজার্মানিদিয়েগো গার্সিয়াজিব" +
+	"ুতিডেনমার্কডোমিনিকাডোমেনিকান প্রজাতন্ত্রএলজিরিয়াকুউটা এবং মেলিলাইকুয়েডরএস্তোনি" +
+	"য়ামিশরপশ্চিমী সাহারাইরিত্রিয়াস্পেনইফিওপিয়াইউরোপীয় ইউনিয়নফিনল্যান্ডফিজিফকল্য" +
+	"ান্ড দ্বীপপুঞ্জমাইক্রোনেশিয়াফ্যারও দ্বীপপুঞ্জফ্রান্সগ্যাবনযুক্তরাজ্যগ্রেনাডাজর্" +
+	"জিয়াফরাসী গায়ানাগ্রাঞ্জিঘানাজিব্রাল্টারগ্রীনল্যান্ডগাম্বিয়াগিনিগুয়াদেলৌপনিরক" +
+	"্ষীয় গিনিগ্রীস্দক্ষিণ জর্জিয়া ও দক্ষিণ স্যান্ডউইচ দ্বীপপুঞগোয়াটিমালাগুয়ামগিন" +
+	"ি-বিসাউগিয়ানাহংকং এসএআর চীনাহার্ড দ্বীপ এবং ম্যাকডোনাল্ড দ্বীপপুঞ্জহণ্ডুরাসক্রো" +
+	"য়েশিয়াহাইতিহাঙ্গেরিক্যানারি দ্বীপপুঞ্জইন্দোনেশিয়াআয়ার্লণ্ডইস্রায়েলআইল অফ ম্" +
+	"যানভারতব্রিটিশ ভারত মহাসাগরীয় অঞ্চলইরাকইরানআইসলণ্ডইতালীজার্সিজ্যামেকাজর্ডনজাপান" +
+	"কেনিয়াকির্গিজিয়াকাম্বোজকিরিবাতিকমোরোসসেন্ট কিটস ও নেভিসউত্তর কোরিয়াদক্ষিণ কোর" +
+	"িয়াকুয়েতকেম্যান দ্বীপপুঞ্জকাজাকস্থানলাওসলেবাননসেন্ট লুসিয়ালিচেনস্টেইনশ্রীলঙ্ক" +
+	"ালাইবেরিয়ালেসোথোলিত্ভালাক্সেমবার্গলাত্ভিয়ালিবিয়ামোরক্কোমোনাকোমোল্দাভিয়ামন্টি" +
+	"নিগ্রোসেন্ট মার্টিনমাদাগাস্কারমার্শাল দ্বীপপুঞ্জম্যাসাডোনিয়ামালিমায়ানমার (বার্" +
+	"মা)মঙ্গোলিয়াম্যাকাও এসএআর চীনাউত্তরাঞ্চলীয় মারিয়ানা দ্বীপপুঞ্জমার্টিনিকমরিতান" +
+	"িয়ামন্টসেরাটমাল্টামরিশাসমালদ্বীপমালাউইমক্সিকোমাল্যাশিয়ামোজাম্বিকনামিবিয়ানিউ ক" +
+	"্যালেডোনিয়ানাইজারনিরফোক দ্বীপনাইজেরিয়ানিকারাগুয়ানেদারল্যান্ডসনরওয়েনেপালনাউরু" +
+	"নিউয়েনিউ জিলণ্ডওমানপানামাপিরুফরাসী পলিনেশিয়াপাপুয়া নিউ গিনিফিলিপাইনপাকিস্তানপ" +
+	"োল্যাণ্ডসেন্ট পিয়ের ও মিকুয়েলনপিটকেয়ার্ন দ্বীপপুঞ্জপুয়ের্টোরিকোফিলিস্তিন অঞ্" +
+	"চলসমূহপর্তুগালপালাউপ্যারাগোয়েকাতারআউটলাইনিং ওসানিয়ারিইউনিয়নরুমানিয়াসারবিয়ার" +
+	"াশিয়ারুয়ান্ডাসাউদি আরবসলোমন দ্বীপপুঞ্জসিসিলিসুদানসুইডেনসিঙ্গাপুরসেন্ট হেলেনাস্" +
+	"লোভানিয়াস্বালবার্ড ও জান মেয়েনশ্লোভাকিয়াসিয়েরালিওনসান মারিনোসেনেগালসোমালিসুর" +
+	"িনামদক্ষিন সুদানসাওটোমা ও প্রিন্সিপিএল সালভেদরসিন্ট মার্টেনসিরিয়াসোয়াজিল্যান্ড" +
+	"ট্রিস্টান ডা কুনাতুর্কস ও কাইকোস দ্বীপপুঞ্জচাদফরাসী দক্ষিণাঞ্চলটোগোথাইতাজিকস্থান" +
+	"টোকেলাউপূর্ব-তিমুরতুর্কমেনিয়াটিউনিস্টোঙ্গাতুরস্কত্রিনিনাদ ও টোব্যাগোটুভালুতাইওয" +
+	"়ানতাঞ্জানিয়াইউক্রেইনউগান্ডাযুক্তরাষ্ট্রের পার্শ্ববর্তী দ্বীপপুঞ্জমার্কিন যুক্ত" +
+	"রাষ্ট্রউরুগোয়েউজ্বেকিস্থানভ্যাটিকান সিটিসেন্ট ভিনসেন্ট ও দ্যা গ্রেনাডিনসভেনেজুয" +
+	"়েলাব্রিটিশ ভার্জিন দ্বীপপুঞ্জমার্কিন ভার্জিন দ্বীপপুঞ্জভিয়েতনামভানুয়াটুওয়ালি" +
+	"স ও ফুটুনাসামোয়াকসোভোইমেনমায়োত্তেদক্ষিণ আফ্রিকাজাম্বিয়াজিম্বাবুয়েঅজানা স্থান" +
+	"পৃথিবীআফ্রিকাউত্তর আমেরিকদক্ষিন আমেরিকাওসানিয়াপশ্চিমাঞ্চলীয় আফ্রিকামধ্য আমেরিক" +
+	"াপূর্ব আফ্রিকাউত্তর আফ্রিকামধ্য আফ্রিকাদক্ষিণাঞ্চলীয় আফ্রিকাআমেরিকাসউত্তরাঞ্চলী" +
+	"য় আমেরিকাক্যারাবিয়ানপূর্ব এশিয়াদক্ষিণাঞ্চলীয় এশিয়াদক্ষিন পূর্ব এশিয়াদক্ষিণ" +
+	"াঞ্চলীয় ইউরোপঅস্ট্রেলিয়া এবং নিউজিল্যান্ডম্যালেনেশিয়াম্যালেনিশা অঞ্চলপলিনেশিয" +
+	"়াএশিয়ামধ্য এশিয়াপশ্চিমাঞ্চলীয় এশিয়াইউরোপপূর্ব ইউরোপউত্তরাঞ্চলীয় ইউরোপপশ্চি" +
+	"মাঞ্চলীয় ইউরোপল্যাটিন আমেরিকা"
+
+var bnRegionIdx = []uint16{ // 291 entries
+	0x0, 0x3a, 0x58, 0x8a, 0xab, 0xe3, 0x104, 0x125, 0x143, 0x18c, 0x1aa, 0x1d7,
+	0x1fb, 0x229, 0x247, 0x26b, 0x27a, 0x2ab, 0x2c9, 0x307, 0x31f, 0x337, 0x352, 0x374,
+	0x392, 0x3a7, 0x3bf, 0x3ce, 0x3f9, 0x40e, 0x423, 0x43e, 0x48a, 0x49f, 0x4d0, 0x4df,
+	0x4fe, 0x519, 0x53a, 0x549, 0x55b, 0x589, 0x5b0, 0x5f7, 0x624, 0x64b, 0x66a, 0x692,
+	0x69e, 0x6b9, 0x6c2, 0x6e3, 0x71a, 0x738, 0x747, 0x762, 0x777, 0x79f, 0x7b7, 0x7e2,
+	0x7fa, 0x82b, 0x83d, 0x855, 0x86d, 0x8aa, 0x8c5, 0x8f1, 0x909, 0x927, 0x933, 0x95b,
+	0x979, 0x988, 0x9a3, 0x9d1, 0x9ef, 0x9fb, 0xa35, 0xa5f, 0xa90, 0xaa5, 0xab7, 0xad5,
+	0xaed, 0xb05, 0xb2a, 0xb42, 0xb4e, 0xb6f, 0xb93, 0xbae, 0xbba, 0xbd8, 0xc00, 0xc12,
+	0xc8c, 0xcad, 0xcbf, 0xcdb, 0xcf0, 0xd19, 0xd86, 0xd9e, 0xdc2, 0xdd1, 0xde9, 0xe20,
+	0xe44, 0xe62, 0xe7d, 0xe9d, 0xea9, 0xefa, 0xf06, 0xf12, 0xf27, 0xf36, 0xf48, 0xf60,
+	0xf6f, 0xf7e, 0xf93, 0xfb4, 0xfc9, 0xfe1, 0xff3, 0x1023, 0x1048, 0x1070, 0x1082, 0x10b6,
+	0x10d4, 0x10e0, 0x10f2, 0x1117, 0x1138, 0x1153, 0x1171, 0x1183, 0x1195, 0x11b9, 0x11d4, 0x11e9,
+	0x11fe, 0x1210, 0x1231, 0x1252, 0x1277, 0x1298, 0x12cc, 0x12f3, 0x12ff, 0x132f, 0x134d, 0x137f,
+	0x13e1, 0x13fc, 0x141a, 0x1435, 0x1447, 0x1459, 0x1471, 0x1483, 0x1498, 0x14b9, 0x14d4, 0x14ef,
+	0x1520, 0x1532, 0x1554, 0x1572, 0x1593, 0x15ba, 0x15cc, 0x15db, 0x15ea, 0x15fc, 0x1618, 0x1624,
+	0x1636, 0x1642, 0x1670, 0x169c, 0x16b4, 0x16cf, 0x16ea, 0x172c, 0x176c, 0x1793, 0x17ca, 0x17e2,
+	0x17f1, 0x1812, 0x1821, 0x1855, 0x1870, 0x188b, 0x18a3, 0x18b8, 0x18d3, 0x18ec, 0x191a, 0x192c,
+	0x193b, 0x194d, 0x1968, 0x198a, 0x19ab, 0x19ea, 0x1a0b, 0x1a2c, 0x1a48, 0x1a5d, 0x1a6f, 0x1a84,
+	0x1aa6, 0x1ade, 0x1afa, 0x1b1f, 0x1b34, 0x1b5e, 0x1b8d, 0x1bd5, 0x1bde, 0x1c0f, 0x1c1b, 0x1c24,
+	0x1c42, 0x1c57, 0x1c76, 0x1c9a, 0x1caf, 0x1cc1, 0x1cd3, 0x1d0b, 0x1d1d, 0x1d35, 0x1d56, 0x1d6e,
+	0x1d83, 0x1df1, 0x1e2b, 0x1e43, 0x1e67, 0x1e8f, 0x1ee7, 0x1f08, 0x1f52, 0x1f9c, 0x1fb7, 0x1fd2,
+	0x1ffe, 0x2013, 0x2022, 0x202e, 0x2049, 0x2071, 0x208c, 0x20ad, 0x20cc, 0x20de, 0x20f3, 0x2115,
+	0x213d, 0x2155, 0x2195, 0x21b7, 0x21dc, 0x2201, 0x2223, 0x2263, 0x227b, 0x22b8, 0x22dc, 0x22fe,
+	0x233b, 0x2370, 0x23aa, 0x23fd, 0x2424, 0x2452, 0x2470, 0x2482, 0x24a1, 0x24de, 0x24ed, 0x250c,
+	0x2543, 0x257d, 0x25a8,
+}
+
+const caRegionStr = "" +
+	"Illa de l'AscensióAndorraEmirats Àrabs UnitsAfganistanAntigua i BarbudaAnguillaA" +
+	"lbàniaArmèniaAntilles NeerlandesesAngolaAntàrtidaArgentinaSamoa Nord-americanaÀu" +
+	"striaAustràliaArubaIlles ÅlandAzerbaidjanBòsnia i HercegovinaBarbadosBangla Desh" +
+	"BèlgicaBurkina FasoBulgàriaBahrainBurundiBenínSaint BarthélemyBermudesBruneiBolí" +
+	"viaCarib NeerlandèsBrasilBahamesBhutanBouvetBotswanaBielorússiaBelizeCanadàIlles" +
+	" CocosCongo - KinshasaRepública CentreafricanaCongo - BrazzavilleSuïssaCosta d’I" +
+	"voriIlles CookXileCamerunXinaColòmbiaIlla ClippertonCosta RicaCubaCap VerdCuraça" +
+	"oIlla ChristmasXipreRepública TxecaAlemanyaDiego GarciaDjiboutiDinamarcaDominica" +
+	"República DominicanaAlgèriaCeuta i MelillaEquadorEstòniaEgipteSàhara OccidentalE" +
+	"ritreaEspanyaEtiòpiaUnió EuropeaFinlàndiaFijiIlles MalvinesMicronèsiaIlles Fèroe" +
+	"FrançaGabonRegne UnitGrenadaGeòrgiaGuaiana FrancesaGuernseyGhanaGibraltarGrenlàn" +
+	"diaGàmbiaGuineaGuadeloupeGuinea EquatorialGrèciaIlles Geòrgia del Sud i Sandwich" +
+	" del SudGuatemalaGuamGuinea BissauGuyanaHong Kong (RAE Xina)Illa Heard i Illes M" +
+	"cDonaldHonduresCroàciaHaitíHongriaIlles CanàriesIndonèsiaIrlandaIsraelIlla de Ma" +
+	"nÍndiaTerritori Britànic de l'Oceà ÍndicIraqIranIslàndiaItàliaJerseyJamaicaJordà" +
+	"niaJapóKenyaKirguizistanCambodjaKiribatiComoresSaint Christopher i NevisCorea de" +
+	"l NordCorea del SudKuwaitIlles CaimanKazakhstanLaosLíbanSaint LuciaLiechtenstein" +
+	"Sri LankaLibèriaLesothoLituàniaLuxemburgLetòniaLíbiaMarrocMònacoMoldàviaMonteneg" +
+	"roSaint MartinMadagascarIlles MarshallMacedòniaMaliMyanmar (Birmània)MongòliaMac" +
+	"au (RAE Xina)Illes Mariannes del NordMartinicaMauritàniaMontserratMaltaMauriciMa" +
+	"ldivesMalawiMèxicMalàisiaMoçambicNamíbiaNova CaledòniaNígerNorfolkNigèriaNicarag" +
+	"uaPaïsos BaixosNoruegaNepalNauruNiueNova ZelandaOmanPanamàPerúPolinèsia Francesa" +
+	"Papua Nova GuineaFilipinesPakistanPolòniaSaint-Pierre-et-MiquelonIlles PitcairnP" +
+	"uerto RicoPalestinaPortugalPalauParaguaiQatarTerritoris allunyats d'OceaniaIlla " +
+	"de la ReunióRomaniaSèrbiaRússiaRwandaAràbia SauditaIlles SalomóSeychellesSudanSu" +
+	"èciaSingapurSaint HelenaEslovèniaSvalbard i Jan MayenEslovàquiaSierra LeoneSan M" +
+	"arinoSenegalSomàliaSurinamSudan del SudSão Tomé i PríncipeEl SalvadorSint Maarte" +
+	"nSíriaSwazilàndiaTristão da CunhaIlles Turks i CaicosTxadTerritoris Francesos de" +
+	"l SudTogoTailàndiaTadjikistanTokelauTimor OrientalTurkmenistanTunísiaTongaTurqui" +
+	"aTrinitat i TobagoTuvaluTaiwanTanzàniaUcraïnaUgandaIlles Perifèriques Menors del" +
+	"s EUAEstats UnitsUruguaiUzbekistanCiutat del VaticàSaint Vincent i les Grenadine" +
+	"sVeneçuelaIlles Verges BritàniquesIlles Verges Nord-americanesVietnamVanuatuWall" +
+	"is i FutunaSamoaKosovoIemenMayotteRepública de Sud-àfricaZàmbiaZimbabweRegió des" +
+	"conegudaMónÀfricaAmèrica del NordAmèrica del SudOceaniaÀfrica OccidentalAmèrica " +
+	"CentralÀfrica OrientalÀfrica SeptentrionalÀfrica CentralÀfrica MeridionalAmèrica" +
+	"Amèrica SeptentrionalCaribÀsia OrientalÀsia MeridionalÀsia Sud-orientalEuropa Me" +
+	"ridionalAustralàsiaMelanèsiaRegió de la MicronèsiaPolinèsiaÀsiaÀsia CentralÀsia " +
+	"OccidentalEuropaEuropa OrientalEuropa SeptentrionalEuropa OccidentalAmèrica Llat" +
+	"ina"
+
+var caRegionIdx = []uint16{ // 291 entries
+	0x0, 0x13, 0x1a, 0x2e, 0x38, 0x49, 0x51, 0x59, 0x61, 0x76, 0x7c, 0x86,
+	0x8f, 0xa3, 0xab, 0xb5, 0xba, 0xc6, 0xd1, 0xe6, 0xee, 0xf9, 0x101, 0x10d,
+	0x116, 0x11d, 0x124, 0x12a, 0x13b, 0x143, 0x149, 0x151, 0x162, 0x168, 0x16f, 0x175,
+	0x17b, 0x183, 0x18f, 0x195, 0x19c, 0x1a7, 0x1b7, 0x1d0, 0x1e3, 0x1ea, 0x1f9, 0x203,
+	0x207, 0x20e, 0x212, 0x21b, 0x22a, 0x234, 0x238, 0x240, 0x248, 0x256, 0x25b, 0x26b,
+	0x273, 0x27f, 0x287, 0x290, 0x298, 0x2ad, 0x2b5, 0x2c4, 0x2cb, 0x2d3, 0x2d9, 0x2eb,
+	0x2f2, 0x2f9, 0x301, 0x30e, 0x318, 0x31c, 0x32a, 0x335, 0x341, 0x348, 0x34d, 0x357,
+	0x35e, 0x366, 0x376, 0x37e, 0x383, 0x38c, 0x397, 0x39e, 0x3a4, 0x3ae, 0x3bf, 0x3c6,
+	0x3ef, 0x3f8, 0x3fc, 0x409, 0x40f, 0x423, 0x43e, 0x446, 0x44e, 0x454, 0x45b, 0x46a,
+	0x474, 0x47b, 0x481, 0x48c, 0x492, 0x4b7, 0x4bb, 0x4bf, 0x4c8, 0x4cf, 0x4d5, 0x4dc,
+	0x4e5, 0x4ea, 0x4ef, 0x4fb, 0x503, 0x50b, 0x512, 0x52b, 0x539, 0x546, 0x54c, 0x558,
+	0x562, 0x566, 0x56c, 0x577, 0x584, 0x58d, 0x595, 0x59c, 0x5a5, 0x5ae, 0x5b6, 0x5bc,
+	0x5c2, 0x5c9, 0x5d2, 0x5dc, 0x5e8, 0x5f2, 0x600, 0x60a, 0x60e, 0x621, 0x62a, 0x63a,
+	0x652, 0x65b, 0x666, 0x670, 0x675, 0x67c, 0x684, 0x68a, 0x690, 0x699, 0x6a2, 0x6aa,
+	0x6b9, 0x6bf, 0x6c6, 0x6ce, 0x6d7, 0x6e5, 0x6ec, 0x6f1, 0x6f6, 0x6fa, 0x706, 0x70a,
+	0x711, 0x716, 0x729, 0x73a, 0x743, 0x74b, 0x753, 0x76b, 0x779, 0x784, 0x78d, 0x795,
+	0x79a, 0x7a2, 0x7a7, 0x7c5, 0x7d7, 0x7de, 0x7e5, 0x7ec, 0x7f2, 0x801, 0x80e, 0x818,
+	0x81d, 0x824, 0x82c, 0x838, 0x842, 0x856, 0x861, 0x86d, 0x877, 0x87e, 0x886, 0x88d,
+	0x89a, 0x8b0, 0x8bb, 0x8c7, 0x8cd, 0x8d9, 0x8ea, 0x8fe, 0x902, 0x91e, 0x922, 0x92c,
+	0x937, 0x93e, 0x94c, 0x958, 0x960, 0x965, 0x96c, 0x97d, 0x983, 0x989, 0x992, 0x99a,
+	0x9a0, 0x9c3, 0x9cf, 0x9d6, 0x9e0, 0x9f2, 0xa10, 0xa1a, 0xa33, 0xa4f, 0xa56, 0xa5d,
+	0xa6c, 0xa71, 0xa77, 0xa7c, 0xa83, 0xa9c, 0xaa3, 0xaab, 0xabd, 0xac1, 0xac8, 0xad9,
+	0xae9, 0xaf0, 0xb02, 0xb12, 0xb22, 0xb37, 0xb46, 0xb58, 0xb60, 0xb76, 0xb7b, 0xb89,
+	0xb99, 0xbab, 0xbbc, 0xbc8, 0xbd2, 0xbea, 0xbf4, 0xbf9, 0xc06, 0xc16, 0xc1c, 0xc2b,
+	0xc3f, 0xc50, 0xc60,
+}
+
+const csRegionStr = "" +
+	"AscensionAndorraSpojené arabské emirátyAfghánistánAntigua a BarbudaAnguillaAlbán" +
+	"ieArménieNizozemské AntilyAngolaAntarktidaArgentinaAmerická SamoaRakouskoAustrál" +
+	"ieArubaÅlandyÁzerbájdžánBosna a HercegovinaBarbadosBangladéšBelgieBurkina FasoBu" +
+	"lharskoBahrajnBurundiBeninSvatý BartolomějBermudyBrunejBolívieKaribské Nizozemsk" +
+	"oBrazílieBahamyBhútánBouvetův ostrovBotswanaBěloruskoBelizeKanadaKokosové ostrov" +
+	"yKongo - KinshasaStředoafrická republikaKongo - BrazzavilleŠvýcarskoPobřeží slon" +
+	"ovinyCookovy ostrovyChileKamerunČínaKolumbieClippertonův ostrovKostarikaKubaKapv" +
+	"erdyCuraçaoVánoční ostrovKyprČeská republikaNěmeckoDiego GarcíaDžibutskoDánskoDo" +
+	"minikaDominikánská republikaAlžírskoCeuta a MelillaEkvádorEstonskoEgyptZápadní S" +
+	"aharaEritreaŠpanělskoEtiopieEvropská unieFinskoFidžiFalklandské ostrovyMikronési" +
+	"eFaerské ostrovyFrancieGabonVelká BritánieGrenadaGruzieFrancouzská GuyanaGuernse" +
+	"yGhanaGibraltarGrónskoGambieGuineaGuadeloupeRovníková GuineaŘeckoJižní Georgie a" +
+	" Jižní Sandwichovy ostrovyGuatemalaGuamGuinea-BissauGuyanaHongkong - ZAO ČínyHea" +
+	"rdův ostrov a McDonaldovy ostrovyHondurasChorvatskoHaitiMaďarskoKanárské ostrovy" +
+	"IndonésieIrskoIzraelOstrov ManIndieBritské indickooceánské územíIrákÍránIslandIt" +
+	"álieJerseyJamajkaJordánskoJaponskoKeňaKyrgyzstánKambodžaKiribatiKomorySvatý Kryš" +
+	"tof a NevisSeverní KoreaJižní KoreaKuvajtKajmanské ostrovyKazachstánLaosLibanonS" +
+	"vatá LucieLichtenštejnskoSrí LankaLibérieLesothoLitvaLucemburskoLotyšskoLibyeMar" +
+	"okoMonakoMoldavskoČerná HoraSvatý MartinMadagaskarMarshallovy ostrovyMakedonieMa" +
+	"liMyanmar (Barma)MongolskoMacao - ZAO ČínySeverní MarianyMartinikMauritánieMonts" +
+	"erratMaltaMauriciusMaledivyMalawiMexikoMalajsieMosambikNamibieNová KaledonieNige" +
+	"rNorfolkNigérieNikaraguaNizozemskoNorskoNepálNauruNiueNový ZélandOmánPanamaPeruF" +
+	"rancouzská PolynésiePapua-Nová GuineaFilipínyPákistánPolskoSaint-Pierre a Miquel" +
+	"onPitcairnovy ostrovyPortorikoPalestinská územíPortugalskoPalauParaguayKatarVněj" +
+	"ší OceánieRéunionRumunskoSrbskoRuskoRwandaSaúdská ArábieŠalamounovy ostrovySeych" +
+	"elySúdánŠvédskoSingapurSvatá HelenaSlovinskoŠpicberky a Jan MayenSlovenskoSierra" +
+	" LeoneSan MarinoSenegalSomálskoSurinamJižní SúdánSvatý Tomáš a Princův ostrovSal" +
+	"vadorSint MaartenSýrieSvazijskoTristan da CunhaTurks a CaicosČadFrancouzská jižn" +
+	"í územíTogoThajskoTádžikistánTokelauVýchodní TimorTurkmenistánTuniskoTongaTureck" +
+	"oTrinidad a TobagoTuvaluTchaj-wanTanzanieUkrajinaUgandaMenší odlehlé ostrovy USA" +
+	"Spojené státyUruguayUzbekistánVatikánSvatý Vincenc a GrenadinyVenezuelaBritské P" +
+	"anenské ostrovyAmerické Panenské ostrovyVietnamVanuatuWallis a FutunaSamoaKosovo" +
+	"JemenMayotteJihoafrická republikaZambieZimbabweNeznámá oblastSvětAfrikaSeverní A" +
+	"merikaJižní AmerikaOceánieZápadní AfrikaStřední AmerikaVýchodní AfrikaSeverní Af" +
+	"rikaStřední AfrikaJižní AfrikaAmerikaSeverní Amerika oblastKaribikVýchodní AsieJ" +
+	"ižní AsieJihovýchodní AsieJižní EvropaAustralasieMelanésieMikronésie (region)Pol" +
+	"ynésieAsieStřední AsieZápadní AsieEvropaVýchodní EvropaSeverní EvropaZápadní Evr" +
+	"opaLatinská Amerika"
+
+var csRegionIdx = []uint16{ // 291 entries
+	0x0, 0x9, 0x10, 0x2a, 0x37, 0x48, 0x50, 0x58, 0x60, 0x72, 0x78, 0x82,
+	0x8b, 0x9a, 0xa2, 0xac, 0xb1, 0xb8, 0xc7, 0xda, 0xe2, 0xed, 0xf3, 0xff,
+	0x108, 0x10f, 0x116, 0x11b, 0x12d, 0x134, 0x13a, 0x142, 0x156, 0x15f, 0x165, 0x16d,
+	0x17d, 0x185, 0x18f, 0x195, 0x19b, 0x1ac, 0x1bc, 0x1d5, 0x1e8, 0x1f3, 0x207, 0x216,
+	0x21b, 0x222, 0x228, 0x230, 0x244, 0x24d, 0x251, 0x259, 0x261, 0x272, 0x276, 0x287,
+	0x28f, 0x29c, 0x2a6, 0x2ad, 0x2b5, 0x2cd, 0x2d7, 0x2e6, 0x2ee, 0x2f6, 0x2fb, 0x30b,
+	0x312, 0x31d, 0x324, 0x332, 0x338, 0x33e, 0x352, 0x35d, 0x36d, 0x374, 0x379, 0x389,
+	0x390, 0x396, 0x3a9, 0x3b1, 0x3b6, 0x3bf, 0x3c7, 0x3cd, 0x3d3, 0x3dd, 0x3ef, 0x3f5,
+	0x422, 0x42b, 0x42f, 0x43c, 0x442, 0x457, 0x47c, 0x484, 0x48e, 0x493, 0x49c, 0x4ae,
+	0x4b8, 0x4bd, 0x4c3, 0x4cd, 0x4d2, 0x4f4, 0x4f9, 0x4ff, 0x505, 0x50c, 0x512, 0x519,
+	0x523, 0x52b, 0x530, 0x53b, 0x544, 0x54c, 0x552, 0x569, 0x577, 0x584, 0x58a, 0x59c,
+	0x5a7, 0x5ab, 0x5b2, 0x5be, 0x5ce, 0x5d8, 0x5e0, 0x5e7, 0x5ec, 0x5f7, 0x600, 0x605,
+	0x60b, 0x611, 0x61a, 0x626, 0x633, 0x63d, 0x650, 0x659, 0x65d, 0x66c, 0x675, 0x687,
+	0x697, 0x69f, 0x6aa, 0x6b4, 0x6b9, 0x6c2, 0x6ca, 0x6d0, 0x6d6, 0x6de, 0x6e6, 0x6ed,
+	0x6fc, 0x701, 0x708, 0x710, 0x719, 0x723, 0x729, 0x72f, 0x734, 0x738, 0x745, 0x74a,
+	0x750, 0x754, 0x76b, 0x77d, 0x786, 0x790, 0x796, 0x7ad, 0x7c0, 0x7c9, 0x7dd, 0x7e8,
+	0x7ed, 0x7f5, 0x7fa, 0x80c, 0x814, 0x81c, 0x822, 0x827, 0x82d, 0x83e, 0x852, 0x85a,
+	0x861, 0x86a, 0x872, 0x87f, 0x888, 0x89e, 0x8a7, 0x8b3, 0x8bd, 0x8c4, 0x8cd, 0x8d4,
+	0x8e3, 0x903, 0x90b, 0x917, 0x91d, 0x926, 0x936, 0x944, 0x948, 0x964, 0x968, 0x96f,
+	0x97d, 0x984, 0x994, 0x9a1, 0x9a8, 0x9ad, 0x9b4, 0x9c5, 0x9cb, 0x9d4, 0x9dc, 0x9e4,
+	0x9ea, 0xa06, 0xa15, 0xa1c, 0xa27, 0xa2f, 0xa49, 0xa52, 0xa6c, 0xa87, 0xa8e, 0xa95,
+	0xaa4, 0xaa9, 0xaaf, 0xab4, 0xabb, 0xad1, 0xad7, 0xadf, 0xaef, 0xaf4, 0xafa, 0xb0a,
+	0xb19, 0xb21, 0xb31, 0xb42, 0xb53, 0xb62, 0xb72, 0xb80, 0xb87, 0xb9e, 0xba5, 0xbb4,
+	0xbc0, 0xbd3, 0xbe1, 0xbec, 0xbf6, 0xc0a, 0xc14, 0xc18, 0xc26, 0xc34, 0xc3a, 0xc4b,
+	0xc5a, 0xc6a, 0xc7b,
+}
+
+const daRegionStr = "" +
+	"AscensionøenAndorraForenede Arabiske EmiraterAfghanistanAntigua og BarbudaAnguil" +
+	"laAlbanienArmenienHollandske AntillerAngolaAntarktisArgentinaAmerikansk SamoaØst" +
+	"rigAustralienArubaÅlandsøerneAserbajdsjanBosnien-HercegovinaBarbadosBangladeshBe" +
+	"lgienBurkina FasoBulgarienBahrainBurundiBeninSaint BarthélemyBermudaBruneiBolivi" +
+	"aNederlandske antillerBrasilienBahamasBhutanBouvetøenBotswanaHvideruslandBelizeC" +
+	"anadaCocosøerneCongo-KinshasaCentralafrikanske RepublikCongo-BrazzavilleSchweizC" +
+	"ôte d’IvoireCookøerneChileCamerounKinaColombiaClippertonøenCosta RicaCubaKap Ver" +
+	"deCuraçaoJuleøenCypernTjekkietTysklandDiego GarciaDjiboutiDanmarkDominicaDen Dom" +
+	"inikanske RepublikAlgerietCeuta og MelillaEcuadorEstlandEgyptenVestsaharaEritrea" +
+	"SpanienEtiopienDen Europæiske UnionFinlandFijiFalklandsøerneMikronesiens Forened" +
+	"e StaterFærøerneFrankrigGabonStorbritannienGrenadaGeorgienFransk GuyanaGuernseyG" +
+	"hanaGibraltarGrønlandGambiaGuineaGuadeloupeÆkvatorialguineaGrækenlandSouth Georg" +
+	"ia og De Sydlige SandwichøerGuatemalaGuamGuinea-BissauGuyanaHongkong SARHeard- o" +
+	"g McDonald-øerneHondurasKroatienHaitiUngarnKanariske øerIndonesienIrlandIsraelIs" +
+	"le of ManIndienDet britiske territorium i Det Indiske OceanIrakIranIslandItalien" +
+	"JerseyJamaicaJordanJapanKenyaKirgisistanCambodjaKiribatiComorerneSaint Kitts og " +
+	"NevisNordkoreaSydkoreaKuwaitCaymanøerneKasakhstanLaosLibanonSaint LuciaLiechtens" +
+	"teinSri LankaLiberiaLesothoLitauenLuxembourgLetlandLibyenMarokkoMonacoMoldovaMon" +
+	"tenegroSaint MartinMadagaskarMarshalløerneMakedonienMaliMyanmar (Burma)Mongoliet" +
+	"Macao SARNordmarianerneMartiniqueMauretanienMontserratMaltaMauritiusMaldiverneMa" +
+	"lawiMexicoMalaysiaMozambiqueNamibiaNy CaledonienNigerNorfolkøenNigeriaNicaraguaH" +
+	"ollandNorgeNepalNauruNiueNew ZealandOmanPanamaPeruFransk PolynesienPapua Ny Guin" +
+	"eaFilippinernePakistanPolenSaint Pierre og MiquelonPitcairnPuerto RicoDe palæsti" +
+	"nensiske områderPortugalPalauParaguayQatarYdre OceanienRéunionRumænienSerbienRus" +
+	"landRwandaSaudi-ArabienSalomonøerneSeychellerneSudanSverigeSingaporeSt. HelenaSl" +
+	"ovenienSvalbard og Jan MayenSlovakietSierra LeoneSan MarinoSenegalSomaliaSurinam" +
+	"SydsudanSao Tome og PrincipeEl SalvadorSint MaartenSyrienSwazilandTristan da Cun" +
+	"haTurks- og CaicosøerneTchadDe franske besiddelser i Det Sydlige Indiske OceanTo" +
+	"goThailandTadsjikistanTokelauTimor-LesteTurkmenistanTunesienTongaTyrkietTrinidad" +
+	" og TobagoTuvaluTaiwanTanzaniaUkraineUgandaAmerikanske oversøiske øerUSAUruguayU" +
+	"sbekistanVatikanstatenSaint Vincent og GrenadinerneVenezuelaDe Britiske Jomfruøe" +
+	"rDe Amerikanske JomfruøerVietnamVanuatuWallis og FutunaSamoaKosovoYemenMayotteSy" +
+	"dafrikaZambiaZimbabweUkendt områdeVerdenAfrikaNordamerikaSydamerikaOceanienVesta" +
+	"frikaMellemamerikaØstafrikaNordafrikaCentralafrikaDet sydlige AfrikaAmerikaDet n" +
+	"ordlige AmerikaCaribienØstasienSydasienSydøstasienSydeuropaAustralasienMelanesie" +
+	"nMikronesienPolynesienAsienCentralasienVestasienEuropaØsteuropaNordeuropaVesteur" +
+	"opaLatinamerika"
+
+var daRegionIdx = []uint16{ // 291 entries
+	0x0, 0xd, 0x14, 0x2e, 0x39, 0x4b, 0x53, 0x5b, 0x63, 0x76, 0x7c, 0x85,
+	0x8e, 0x9e, 0xa5, 0xaf, 0xb4, 0xc1, 0xcd, 0xe0, 0xe8, 0xf2, 0xf9, 0x105,
+	0x10e, 0x115, 0x11c, 0x121, 0x132, 0x139, 0x13f, 0x146, 0x15b, 0x164, 0x16b, 0x171,
+	0x17b, 0x183, 0x18f, 0x195, 0x19b, 0x1a6, 0x1b4, 0x1ce, 0x1df, 0x1e6, 0x1f6, 0x200,
+	0x205, 0x20d, 0x211, 0x219, 0x227, 0x231, 0x235, 0x23e, 0x246, 0x24e, 0x254, 0x25c,
+	0x264, 0x270, 0x278, 0x27f, 0x287, 0x2a0, 0x2a8, 0x2b8, 0x2bf, 0x2c6, 0x2cd, 0x2d7,
+	0x2de, 0x2e5, 0x2ed, 0x302, 0x309, 0x30d, 0x31c, 0x338, 0x342, 0x34a, 0x34f, 0x35d,
+	0x364, 0x36c, 0x379, 0x381, 0x386, 0x38f, 0x398, 0x39e, 0x3a4, 0x3ae, 0x3bf, 0x3ca,
+	0x3f2, 0x3fb, 0x3ff, 0x40c, 0x412, 0x41e, 0x437, 0x43f, 0x447, 0x44c, 0x452, 0x460,
+	0x46a, 0x470, 0x476, 0x481, 0x487, 0x4b3, 0x4b7, 0x4bb, 0x4c1, 0x4c8, 0x4ce, 0x4d5,
+	0x4db, 0x4e0, 0x4e5, 0x4f0, 0x4f8, 0x500, 0x509, 0x51d, 0x526, 0x52e, 0x534, 0x540,
+	0x54a, 0x54e, 0x555, 0x560, 0x56d, 0x576, 0x57d, 0x584, 0x58b, 0x595, 0x59c, 0x5a2,
+	0x5a9, 0x5af, 0x5b6, 0x5c0, 0x5cc, 0x5d6, 0x5e4, 0x5ee, 0x5f2, 0x601, 0x60a, 0x613,
+	0x621, 0x62b, 0x636, 0x640, 0x645, 0x64e, 0x658, 0x65e, 0x664, 0x66c, 0x676, 0x67d,
+	0x68a, 0x68f, 0x69a, 0x6a1, 0x6aa, 0x6b1, 0x6b6, 0x6bb, 0x6c0, 0x6c4, 0x6cf, 0x6d3,
+	0x6d9, 0x6dd, 0x6ee, 0x6fd, 0x709, 0x711, 0x716, 0x72e, 0x736, 0x741, 0x75d, 0x765,
+	0x76a, 0x772, 0x777, 0x784, 0x78c, 0x795, 0x79c, 0x7a3, 0x7a9, 0x7b6, 0x7c3, 0x7cf,
+	0x7d4, 0x7db, 0x7e4, 0x7ee, 0x7f7, 0x80c, 0x815, 0x821, 0x82b, 0x832, 0x839, 0x840,
+	0x848, 0x85c, 0x867, 0x873, 0x879, 0x882, 0x892, 0x8a8, 0x8ad, 0x8df, 0x8e3, 0x8eb,
+	0x8f7, 0x8fe, 0x909, 0x915, 0x91d, 0x922, 0x929, 0x93b, 0x941, 0x947, 0x94f, 0x956,
+	0x95c, 0x978, 0x97b, 0x982, 0x98c, 0x999, 0x9b6, 0x9bf, 0x9d5, 0x9ee, 0x9f5, 0x9fc,
+	0xa0c, 0xa11, 0xa17, 0xa1c, 0xa23, 0xa2c, 0xa32, 0xa3a, 0xa48, 0xa4e, 0xa54, 0xa5f,
+	0xa69, 0xa71, 0xa7b, 0xa88, 0xa92, 0xa9c, 0xaa9, 0xabb, 0xac2, 0xad6, 0xade, 0xae7,
+	0xaef, 0xafb, 0xb04, 0xb10, 0xb1a, 0xb25, 0xb2f, 0xb34, 0xb40, 0xb49, 0xb4f, 0xb59,
+	0xb63, 0xb6d, 0xb79,
+}
+
+const deRegionStr = "" +
+	"AscensionAndorraVereinigte Arabische EmirateAfghanistanAntigua und BarbudaAnguil" +
+	"laAlbanienArmenienNiederländische AntillenAngolaAntarktisArgentinienAmerikanisch" +
+	"-SamoaÖsterreichAustralienArubaÅland-InselnAserbaidschanBosnien und HerzegowinaB" +
+	"arbadosBangladeschBelgienBurkina FasoBulgarienBahrainBurundiBeninSt. BarthélemyB" +
+	"ermudaBrunei DarussalamBolivienKaribische NiederlandeBrasilienBahamasBhutanBouve" +
+	"tinselBotsuanaBelarusBelizeKanadaKokosinselnKongo-KinshasaZentralafrikanische Re" +
+	"publikKongo-BrazzavilleSchweizCôte d’IvoireCookinselnChileKamerunChinaKolumbienC" +
+	"lipperton-InselCosta RicaKubaKap VerdeCuraçaoWeihnachtsinselZypernTschechische R" +
+	"epublikDeutschlandDiego GarciaDschibutiDänemarkDominicaDominikanische RepublikAl" +
+	"gerienCeuta und MelillaEcuadorEstlandÄgyptenWestsaharaEritreaSpanienÄthiopienEur" +
+	"opäische UnionFinnlandFidschiFalklandinselnMikronesienFäröerFrankreichGabunVerei" +
+	"nigtes KönigreichGrenadaGeorgienFranzösisch-GuayanaGuernseyGhanaGibraltarGrönlan" +
+	"dGambiaGuineaGuadeloupeÄquatorialguineaGriechenlandSüdgeorgien und die Südlichen" +
+	" SandwichinselnGuatemalaGuamGuinea-BissauGuyanaSonderverwaltungszone HongkongHea" +
+	"rd- und McDonald-InselnHondurasKroatienHaitiUngarnKanarische InselnIndonesienIrl" +
+	"andIsraelIsle of ManIndienBritisches Territorium im Indischen OzeanIrakIranIslan" +
+	"dItalienJerseyJamaikaJordanienJapanKeniaKirgisistanKambodschaKiribatiKomorenSt. " +
+	"Kitts und NevisDemokratische Volksrepublik KoreaRepublik KoreaKuwaitKaimaninseln" +
+	"KasachstanLaosLibanonSt. LuciaLiechtensteinSri LankaLiberiaLesothoLitauenLuxembu" +
+	"rgLettlandLibyenMarokkoMonacoRepublik MoldauMontenegroSt. MartinMadagaskarMarsha" +
+	"llinselnMazedonienMaliMyanmarMongoleiSonderverwaltungszone MacaoNördliche Marian" +
+	"enMartiniqueMauretanienMontserratMaltaMauritiusMaledivenMalawiMexikoMalaysiaMosa" +
+	"mbikNamibiaNeukaledonienNigerNorfolkinselNigeriaNicaraguaNiederlandeNorwegenNepa" +
+	"lNauruNiueNeuseelandOmanPanamaPeruFranzösisch-PolynesienPapua-NeuguineaPhilippin" +
+	"enPakistanPolenSt. Pierre und MiquelonPitcairninselnPuerto RicoPalästinensische " +
+	"AutonomiegebietePortugalPalauParaguayKatarÄußeres OzeanienRéunionRumänienSerbien" +
+	"Russische FöderationRuandaSaudi-ArabienSalomonenSeychellenSudanSchwedenSingapurS" +
+	"t. HelenaSlowenienSvalbard und Jan MayenSlowakeiSierra LeoneSan MarinoSenegalSom" +
+	"aliaSurinameSüdsudanSão Tomé und PríncipeEl SalvadorSint MaartenSyrienSwasilandT" +
+	"ristan da CunhaTurks- und CaicosinselnTschadFranzösische Süd- und Antarktisgebie" +
+	"teTogoThailandTadschikistanTokelauTimor-LesteTurkmenistanTunesienTongaTürkeiTrin" +
+	"idad und TobagoTuvaluTaiwanTansaniaUkraineUgandaAmerikanisch-OzeanienVereinigte " +
+	"StaatenUruguayUsbekistanVatikanstadtSt. Vincent und die GrenadinenVenezuelaBriti" +
+	"sche JungferninselnAmerikanische JungferninselnVietnamVanuatuWallis und FutunaSa" +
+	"moaKosovoJemenMayotteSüdafrikaSambiaSimbabweUnbekannte RegionWeltAfrikaNordameri" +
+	"kaSüdamerikaOzeanienWestafrikaMittelamerikaOstafrikaNordafrikaZentralafrikaSüdli" +
+	"ches AfrikaAmerikaNördliches AmerikaKaribikOstasienSüdasienSüdostasienSüdeuropaA" +
+	"ustralasienMelanesienMikronesisches InselgebietPolynesienAsienZentralasienWestas" +
+	"ienEuropaOsteuropaNordeuropaWesteuropaLateinamerika"
+
+var deRegionIdx = []uint16{ // 291 entries
+	0x0, 0x9, 0x10, 0x2c, 0x37, 0x4a, 0x52, 0x5a, 0x62, 0x7b, 0x81, 0x8a,
+	0x95, 0xa7, 0xb2, 0xbc, 0xc1, 0xce, 0xdb, 0xf2, 0xfa, 0x105, 0x10c, 0x118,
+	0x121, 0x128, 0x12f, 0x134, 0x143, 0x14a, 0x15b, 0x163, 0x179, 0x182, 0x189, 0x18f,
+	0x19a, 0x1a2, 0x1a9, 0x1af, 0x1b5, 0x1c0, 0x1ce, 0x1ea, 0x1fb, 0x202, 0x212, 0x21c,
+	0x221, 0x228, 0x22d, 0x236, 0x246, 0x250, 0x254, 0x25d, 0x265, 0x274, 0x27a, 0x28f,
+	0x29a, 0x2a6, 0x2af, 0x2b8, 0x2c0, 0x2d7, 0x2df, 0x2f0, 0x2f7, 0x2fe, 0x306, 0x310,
+	0x317, 0x31e, 0x328, 0x33a, 0x342, 0x349, 0x357, 0x362, 0x36a, 0x374, 0x379, 0x390,
+	0x397, 0x39f, 0x3b3, 0x3bb, 0x3c0, 0x3c9, 0x3d2, 0x3d8, 0x3de, 0x3e8, 0x3f9, 0x405,
+	0x433, 0x43c, 0x440, 0x44d, 0x453, 0x471, 0x48b, 0x493, 0x49b, 0x4a0, 0x4a6, 0x4b7,
+	0x4c1, 0x4c7, 0x4cd, 0x4d8, 0x4de, 0x507, 0x50b, 0x50f, 0x515, 0x51c, 0x522, 0x529,
+	0x532, 0x537, 0x53c, 0x547, 0x551, 0x559, 0x560, 0x573, 0x594, 0x5a2, 0x5a8, 0x5b4,
+	0x5be, 0x5c2, 0x5c9, 0x5d2, 0x5df, 0x5e8, 0x5ef, 0x5f6, 0x5fd, 0x606, 0x60e, 0x614,
+	0x61b, 0x621, 0x630, 0x63a, 0x644, 0x64e, 0x65c, 0x666, 0x66a, 0x671, 0x679, 0x694,
+	0x6a7, 0x6b1, 0x6bc, 0x6c6, 0x6cb, 0x6d4, 0x6dd, 0x6e3, 0x6e9, 0x6f1, 0x6f9, 0x700,
+	0x70d, 0x712, 0x71e, 0x725, 0x72e, 0x739, 0x741, 0x746, 0x74b, 0x74f, 0x759, 0x75d,
+	0x763, 0x767, 0x77e, 0x78d, 0x798, 0x7a0, 0x7a5, 0x7bc, 0x7ca, 0x7d5, 0x7f7, 0x7ff,
+	0x804, 0x80c, 0x811, 0x823, 0x82b, 0x834, 0x83b, 0x850, 0x856, 0x863, 0x86c, 0x876,
+	0x87b, 0x883, 0x88b, 0x895, 0x89e, 0x8b4, 0x8bc, 0x8c8, 0x8d2, 0x8d9, 0x8e0, 0x8e8,
+	0x8f1, 0x909, 0x914, 0x920, 0x926, 0x92f, 0x93f, 0x956, 0x95c, 0x984, 0x988, 0x990,
+	0x99d, 0x9a4, 0x9af, 0x9bb, 0x9c3, 0x9c8, 0x9cf, 0x9e2, 0x9e8, 0x9ee, 0x9f6, 0x9fd,
+	0xa03, 0xa18, 0xa2a, 0xa31, 0xa3b, 0xa47, 0xa65, 0xa6e, 0xa86, 0xaa2, 0xaa9, 0xab0,
+	0xac1, 0xac6, 0xacc, 0xad1, 0xad8, 0xae2, 0xae8, 0xaf0, 0xb01, 0xb05, 0xb0b, 0xb16,
+	0xb21, 0xb29, 0xb33, 0xb40, 0xb49, 0xb53, 0xb60, 0xb71, 0xb78, 0xb8b, 0xb92, 0xb9a,
+	0xba3, 0xbaf, 0xbb9, 0xbc5, 0xbcf, 0xbe9, 0xbf3, 0xbf8, 0xc04, 0xc0d, 0xc13, 0xc1c,
+	0xc26, 0xc30, 0xc3d,
+}
+
+const elRegionStr = "" +
+	"Νήσος ΑσενσιόνΑνδόραΗνωμένα Αραβικά ΕμιράταΑφγανιστάνΑντίγκουα και ΜπαρμπούνταΑν" +
+	"γκουίλαΑλβανίαΑρμενίαΟλλανδικές ΑντίλλεςΑνγκόλαΑνταρκτικήΑργεντινήΑμερικανική Σα" +
+	"μόαΑυστρίαΑυστραλίαΑρούμπαΝήσοι ΌλαντΑζερμπαϊτζάνΒοσνία - ΕρζεγοβίνηΜπαρμπάντοςΜ" +
+	"πανγκλαντέςΒέλγιοΜπουρκίνα ΦάσοΒουλγαρίαΜπαχρέινΜπουρούντιΜπενίνΆγιος Βαρθολομαί" +
+	"οςΒερμούδεςΜπρουνέιΒολιβίαΟλλανδία ΚαραϊβικήςΒραζιλίαΜπαχάμεςΜπουτάνΝήσος Μπουβέ" +
+	"ΜποτσουάναΛευκορωσίαΜπελίζΚαναδάςΝήσοι Κόκος (Κίλινγκ)Κονγκό - ΚινσάσαΚεντροαφρι" +
+	"κανική ΔημοκρατίαΚονγκό - ΜπραζαβίλΕλβετίαΑκτή ΕλεφαντοστούΝήσοι ΚουκΧιλήΚαμερού" +
+	"νΚίναΚολομβίαΝήσος ΚλίπερτονΚόστα ΡίκαΚούβαΠράσινο ΑκρωτήριοΚουρασάοΝήσος των Χρ" +
+	"ιστουγέννωνΚύπροςΤσεχική ΔημοκρατίαΓερμανίαΝτιέγκο ΓκαρσίαΤζιμπουτίΔανίαΝτομίνικ" +
+	"αΔομινικανή ΔημοκρατίαΑλγερίαΘεούτα και ΜελίλαΕκουαδόρΕσθονίαΑίγυπτοςΔυτική Σαχά" +
+	"ραΕρυθραίαΙσπανίαΑιθιοπίαΕυρωπαϊκή ΈνωσηΦινλανδίαΦίτζιΝήσοι ΦόκλαντΜικρονησίαΝήσ" +
+	"οι ΦερόεςΓαλλίαΓκαμπόνΗνωμένο ΒασίλειοΓρενάδαΓεωργίαΓαλλική ΓουιάναΓκέρνζιΓκάναΓ" +
+	"ιβραλτάρΓροιλανδίαΓκάμπιαΓουινέαΓουαδελούπηΙσημερινή ΓουινέαΕλλάδαΝήσοι Νότια Γε" +
+	"ωργία και Νότιες ΣάντουιτςΓουατεμάλαΓκουάμΓουινέα ΜπισάουΓουιάναΧονγκ Κονγκ ΕΔΠ " +
+	"ΚίναςΝήσοι Χερντ και ΜακντόναλντΟνδούραΚροατίαΑϊτήΟυγγαρίαΚανάριοι ΝήσοιΙνδονησί" +
+	"αΙρλανδίαΙσραήλΝήσος ΜανΙνδίαΒρετανικά Εδάφη Ινδικού ΩκεανούΙράκΙράνΙσλανδίαΙταλ" +
+	"ίαΤζέρζιΤζαμάικαΙορδανίαΙαπωνίαΚένυαΚιργιστάνΚαμπότζηΚιριμπάτιΚομόρεςΆγιος Χριστ" +
+	"όφορος και ΝέβιςΒόρεια ΚορέαΝότια ΚορέαΚουβέιτΝήσοι ΚάιμανΚαζακστάνΛάοςΛίβανοςΑγ" +
+	"ία ΛουκίαΛιχτενστάινΣρι ΛάνκαΛιβερίαΛεσότοΛιθουανίαΛουξεμβούργοΛετονίαΛιβύηΜαρόκ" +
+	"οΜονακόΜολδαβίαΜαυροβούνιοΆγιος Μαρτίνος (Γαλλικό τμήμα)ΜαδαγασκάρηΝήσοι ΜάρσαλΠ" +
+	"ΓΔΜΜάλιΜιανμάρ/ΒιρμανίαΜογγολίαΜακάο ΕΔΠ ΚίναςΝήσοι Βόρειες ΜαριάνεςΜαρτινίκαΜαυ" +
+	"ριτανίαΜονσεράτΜάλταΜαυρίκιοςΜαλδίβεςΜαλάουιΜεξικόΜαλαισίαΜοζαμβίκηΝαμίμπιαΝέα Κ" +
+	"αληδονίαΝίγηραςΝήσος ΝόρφολκΝιγηρίαΝικαράγουαΟλλανδίαΝορβηγίαΝεπάλΝαουρούΝιούεΝέ" +
+	"α ΖηλανδίαΟμάνΠαναμάςΠερούΓαλλική ΠολυνησίαΠαπούα Νέα ΓουινέαΦιλιππίνεςΠακιστάνΠ" +
+	"ολωνίαΣεν Πιερ και ΜικελόνΝήσοι ΠίτκερνΠουέρτο ΡίκοΠαλαιστινιακά ΕδάφηΠορτογαλία" +
+	"ΠαλάουΠαραγουάηΚατάρΠεριφερειακή ΩκεανίαΡεϊνιόνΡουμανίαΣερβίαΡωσίαΡουάνταΣαουδικ" +
+	"ή ΑραβίαΝήσοι ΣολομώντοςΣεϋχέλλεςΣουδάνΣουηδίαΣιγκαπούρηΑγία ΕλένηΣλοβενίαΣβάλμπ" +
+	"αρντ και Γιαν ΜαγιένΣλοβακίαΣιέρα ΛεόνεΆγιος ΜαρίνοςΣενεγάληΣομαλίαΣουρινάμΝότιο" +
+	" ΣουδάνΣάο Τομέ και ΠρίνσιπεΕλ ΣαλβαδόρΆγιος Μαρτίνος (Ολλανδικό τμήμα)ΣυρίαΣουα" +
+	"ζιλάνδηΤριστάν ντα ΚούνιαΝήσοι Τερκ και ΚάικοςΤσαντΓαλλικές περιοχές του νοτίου " +
+	"ημισφαιρίουΤόγκοΤαϊλάνδηΤατζικιστάνΤοκελάουΤιμόρ-ΛέστεΤουρκμενιστάνΤυνησίαΤόνγκα" +
+	"ΤουρκίαΤρινιντάντ και ΤομπάγκοΤουβαλούΤαϊβάνΤανζανίαΟυκρανίαΟυγκάνταΑπομακρυσμέν" +
+	"ες Νησίδες Η.Π.Α.Ηνωμένες ΠολιτείεςΟυρουγουάηΟυζμπεκιστάνΒατικανόΆγιος Βικέντιος" +
+	" και ΓρεναδίνεςΒενεζουέλαΒρετανικές Παρθένοι ΝήσοιΑμερικανικές Παρθένοι ΝήσοιΒιε" +
+	"τνάμΒανουάτουΟυάλις και ΦουτούναΣαμόαΚόσοβοΥεμένηΜαγιότΝότια ΑφρικήΖάμπιαΖιμπάμπ" +
+	"ουεΆγνωστη περιοχήΚόσμοςΑφρικήΒόρεια ΑμερικήΝότια ΑμερικήΩκεανίαΔυτική ΑφρικήΚεν" +
+	"τρική ΑμερικήΑνατολική ΑφρικήΒόρεια ΑφρικήΜέση ΑφρικήΝότιος ΑφρικήΑμερικήΒόρειος" +
+	" ΑμερικήΚαραϊβικήΑνατολική ΑσίαΝότια ΑσίαΝοτιοανατολική ΑσίαΝότια ΕυρώπηΑυστραλα" +
+	"σίαΜελανησίαΠεριοχή ΜικρονησίαςΠολυνησίαΑσίαΚεντρική ΑσίαΔυτική ΑσίαΕυρώπηΑνατολ" +
+	"ική ΕυρώπηΒόρεια ΕυρώπηΔυτική ΕυρώπηΛατινική Αμερική"
+
+var elRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1b, 0x27, 0x53, 0x67, 0x97, 0xa9, 0xb7, 0xc5, 0xea, 0xf8, 0x10c,
+	0x11e, 0x13f, 0x14d, 0x15f, 0x16d, 0x182, 0x19a, 0x1bd, 0x1d3, 0x1eb, 0x1f7, 0x212,
+	0x224, 0x234, 0x248, 0x254, 0x277, 0x289, 0x299, 0x2a7, 0x2cc, 0x2dc, 0x2ec, 0x2fa,
+	0x311, 0x325, 0x339, 0x345, 0x353, 0x379, 0x396, 0x3cb, 0x3ec, 0x3fa, 0x41b, 0x42e,
+	0x436, 0x446, 0x44e, 0x45e, 0x47b, 0x48e, 0x498, 0x4b9, 0x4c9, 0x4f5, 0x501, 0x524,
+	0x534, 0x551, 0x563, 0x56d, 0x57f, 0x5a8, 0x5b6, 0x5d6, 0x5e6, 0x5f4, 0x604, 0x61d,
+	0x62d, 0x63b, 0x64b, 0x668, 0x67a, 0x684, 0x69d, 0x6b1, 0x6c8, 0x6d4, 0x6e2, 0x701,
+	0x70f, 0x71d, 0x73a, 0x748, 0x752, 0x764, 0x778, 0x786, 0x794, 0x7aa, 0x7cb, 0x7d7,
+	0x822, 0x836, 0x842, 0x85f, 0x86d, 0x894, 0x8c7, 0x8d5, 0x8e3, 0x8eb, 0x8fb, 0x916,
+	0x928, 0x938, 0x944, 0x955, 0x95f, 0x99a, 0x9a2, 0x9aa, 0x9ba, 0x9c6, 0x9d2, 0x9e2,
+	0x9f2, 0xa00, 0xa0a, 0xa1c, 0xa2c, 0xa3e, 0xa4c, 0xa7f, 0xa96, 0xaab, 0xab9, 0xad0,
+	0xae2, 0xaea, 0xaf8, 0xb0d, 0xb23, 0xb34, 0xb42, 0xb4e, 0xb60, 0xb78, 0xb86, 0xb90,
+	0xb9c, 0xba8, 0xbb8, 0xbce, 0xc05, 0xc1b, 0xc32, 0xc3a, 0xc42, 0xc61, 0xc71, 0xc8d,
+	0xcb7, 0xcc9, 0xcdd, 0xced, 0xcf7, 0xd09, 0xd19, 0xd27, 0xd33, 0xd43, 0xd55, 0xd65,
+	0xd7e, 0xd8c, 0xda5, 0xdb3, 0xdc7, 0xdd7, 0xde7, 0xdf1, 0xdff, 0xe09, 0xe20, 0xe28,
+	0xe36, 0xe40, 0xe61, 0xe83, 0xe97, 0xea7, 0xeb5, 0xeda, 0xef3, 0xf0a, 0xf2f, 0xf43,
+	0xf4f, 0xf61, 0xf6b, 0xf92, 0xfa0, 0xfb0, 0xfbc, 0xfc6, 0xfd4, 0xff1, 0x1010, 0x1022,
+	0x102e, 0x103c, 0x1050, 0x1063, 0x1073, 0x10a4, 0x10b4, 0x10c9, 0x10e2, 0x10f2, 0x1100, 0x1110,
+	0x1127, 0x114e, 0x1163, 0x119e, 0x11a8, 0x11be, 0x11e0, 0x1207, 0x1211, 0x125d, 0x1267, 0x1277,
+	0x128d, 0x129d, 0x12b2, 0x12cc, 0x12da, 0x12e6, 0x12f4, 0x1320, 0x1330, 0x133c, 0x134c, 0x135c,
+	0x136c, 0x13a1, 0x13c4, 0x13d8, 0x13f0, 0x1400, 0x1439, 0x144d, 0x147d, 0x14b1, 0x14bf, 0x14d1,
+	0x14f5, 0x14ff, 0x150b, 0x1517, 0x1523, 0x153a, 0x1546, 0x155a, 0x1577, 0x1583, 0x158f, 0x15aa,
+	0x15c3, 0x15d1, 0x15ea, 0x1609, 0x1628, 0x1641, 0x1656, 0x166f, 0x167d, 0x169a, 0x16ac, 0x16c7,
+	0x16da, 0x16ff, 0x1716, 0x172c, 0x173e, 0x1763, 0x1775, 0x177d, 0x1796, 0x17ab, 0x17b7, 0x17d6,
+	0x17ef, 0x1808, 0x1827,
+}
+
+const enRegionStr = "" +
+	"Ascension IslandAndorraUnited Arab EmiratesAfghanistanAntigua and BarbudaAnguill" +
+	"aAlbaniaArmeniaNetherlands AntillesAngolaAntarcticaArgentinaAmerican SamoaAustri" +
+	"aAustraliaArubaÅland IslandsAzerbaijanBosnia and HerzegovinaBarbadosBangladeshBe" +
+	"lgiumBurkina FasoBulgariaBahrainBurundiBeninSaint BarthélemyBermudaBruneiBolivia" +
+	"Caribbean NetherlandsBrazilBahamasBhutanBouvet IslandBotswanaBelarusBelizeCanada" +
+	"Cocos (Keeling) IslandsCongo - KinshasaCentral African RepublicCongo - Brazzavil" +
+	"leSwitzerlandCôte d’IvoireCook IslandsChileCameroonChinaColombiaClipperton Islan" +
+	"dCosta RicaCubaCape VerdeCuraçaoChristmas IslandCyprusCzech RepublicGermanyDiego" +
+	" GarciaDjiboutiDenmarkDominicaDominican RepublicAlgeriaCeuta and MelillaEcuadorE" +
+	"stoniaEgyptWestern SaharaEritreaSpainEthiopiaEuropean UnionFinlandFijiFalkland I" +
+	"slandsMicronesiaFaroe IslandsFranceGabonUnited KingdomGrenadaGeorgiaFrench Guian" +
+	"aGuernseyGhanaGibraltarGreenlandGambiaGuineaGuadeloupeEquatorial GuineaGreeceSou" +
+	"th Georgia & South Sandwich IslandsGuatemalaGuamGuinea-BissauGuyanaHong Kong SAR" +
+	" ChinaHeard & McDonald IslandsHondurasCroatiaHaitiHungaryCanary IslandsIndonesia" +
+	"IrelandIsraelIsle of ManIndiaBritish Indian Ocean TerritoryIraqIranIcelandItalyJ" +
+	"erseyJamaicaJordanJapanKenyaKyrgyzstanCambodiaKiribatiComorosSaint Kitts and Nev" +
+	"isNorth KoreaSouth KoreaKuwaitCayman IslandsKazakhstanLaosLebanonSaint LuciaLiec" +
+	"htensteinSri LankaLiberiaLesothoLithuaniaLuxembourgLatviaLibyaMoroccoMonacoMoldo" +
+	"vaMontenegroSaint MartinMadagascarMarshall IslandsMacedoniaMaliMyanmar (Burma)Mo" +
+	"ngoliaMacau SAR ChinaNorthern Mariana IslandsMartiniqueMauritaniaMontserratMalta" +
+	"MauritiusMaldivesMalawiMexicoMalaysiaMozambiqueNamibiaNew CaledoniaNigerNorfolk " +
+	"IslandNigeriaNicaraguaNetherlandsNorwayNepalNauruNiueNew ZealandOmanPanamaPeruFr" +
+	"ench PolynesiaPapua New GuineaPhilippinesPakistanPolandSaint Pierre and Miquelon" +
+	"Pitcairn IslandsPuerto RicoPalestinian TerritoriesPortugalPalauParaguayQatarOutl" +
+	"ying OceaniaRéunionRomaniaSerbiaRussiaRwandaSaudi ArabiaSolomon IslandsSeychelle" +
+	"sSudanSwedenSingaporeSaint HelenaSloveniaSvalbard and Jan MayenSlovakiaSierra Le" +
+	"oneSan MarinoSenegalSomaliaSurinameSouth SudanSão Tomé and PríncipeEl SalvadorSi" +
+	"nt MaartenSyriaSwazilandTristan da CunhaTurks and Caicos IslandsChadFrench South" +
+	"ern TerritoriesTogoThailandTajikistanTokelauTimor-LesteTurkmenistanTunisiaTongaT" +
+	"urkeyTrinidad and TobagoTuvaluTaiwanTanzaniaUkraineUgandaU.S. Outlying IslandsUn" +
+	"ited StatesUruguayUzbekistanVatican CitySt. Vincent & GrenadinesVenezuelaBritish" +
+	" Virgin IslandsU.S. Virgin IslandsVietnamVanuatuWallis and FutunaSamoaKosovoYeme" +
+	"nMayotteSouth AfricaZambiaZimbabweUnknown RegionWorldAfricaNorth AmericaSouth Am" +
+	"ericaOceaniaWestern AfricaCentral AmericaEastern AfricaNorthern AfricaMiddle Afr" +
+	"icaSouthern AfricaAmericasNorthern AmericaCaribbeanEastern AsiaSouthern AsiaSout" +
+	"h-Eastern AsiaSouthern EuropeAustralasiaMelanesiaMicronesian RegionPolynesiaAsia" +
+	"Central AsiaWestern AsiaEuropeEastern EuropeNorthern EuropeWestern EuropeLatin A" +
+	"merica"
+
+var enRegionIdx = []uint16{ // 291 entries
+	0x0, 0x10, 0x17, 0x2b, 0x36, 0x49, 0x51, 0x58, 0x5f, 0x73, 0x79, 0x83,
+	0x8c, 0x9a, 0xa1, 0xaa, 0xaf, 0xbd, 0xc7, 0xdd, 0xe5, 0xef, 0xf6, 0x102,
+	0x10a, 0x111, 0x118, 0x11d, 0x12e, 0x135, 0x13b, 0x142, 0x157, 0x15d, 0x164, 0x16a,
+	0x177, 0x17f, 0x186, 0x18c, 0x192, 0x1a9, 0x1b9, 0x1d1, 0x1e4, 0x1ef, 0x1ff, 0x20b,
+	0x210, 0x218, 0x21d, 0x225, 0x236, 0x240, 0x244, 0x24e, 0x256, 0x266, 0x26c, 0x27a,
+	0x281, 0x28d, 0x295, 0x29c, 0x2a4, 0x2b6, 0x2bd, 0x2ce, 0x2d5, 0x2dc, 0x2e1, 0x2ef,
+	0x2f6, 0x2fb, 0x303, 0x311, 0x318, 0x31c, 0x32c, 0x336, 0x343, 0x349, 0x34e, 0x35c,
+	0x363, 0x36a, 0x377, 0x37f, 0x384, 0x38d, 0x396, 0x39c, 0x3a2, 0x3ac, 0x3bd, 0x3c3,
+	0x3e9, 0x3f2, 0x3f6, 0x403, 0x409, 0x41c, 0x434, 0x43c, 0x443, 0x448, 0x44f, 0x45d,
+	0x466, 0x46d, 0x473, 0x47e, 0x483, 0x4a1, 0x4a5, 0x4a9, 0x4b0, 0x4b5, 0x4bb, 0x4c2,
+	0x4c8, 0x4cd, 0x4d2, 0x4dc, 0x4e4, 0x4ec, 0x4f3, 0x508, 0x513, 0x51e, 0x524, 0x532,
+	0x53c, 0x540, 0x547, 0x552, 0x55f, 0x568, 0x56f, 0x576, 0x57f, 0x589, 0x58f, 0x594,
+	0x59b, 0x5a1, 0x5a8, 0x5b2, 0x5be, 0x5c8, 0x5d8, 0x5e1, 0x5e5, 0x5f4, 0x5fc, 0x60b,
+	0x623, 0x62d, 0x637, 0x641, 0x646, 0x64f, 0x657, 0x65d, 0x663, 0x66b, 0x675, 0x67c,
+	0x689, 0x68e, 0x69c, 0x6a3, 0x6ac, 0x6b7, 0x6bd, 0x6c2, 0x6c7, 0x6cb, 0x6d6, 0x6da,
+	0x6e0, 0x6e4, 0x6f4, 0x704, 0x70f, 0x717, 0x71d, 0x736, 0x746, 0x751, 0x768, 0x770,
+	0x775, 0x77d, 0x782, 0x792, 0x79a, 0x7a1, 0x7a7, 0x7ad, 0x7b3, 0x7bf, 0x7ce, 0x7d8,
+	0x7dd, 0x7e3, 0x7ec, 0x7f8, 0x800, 0x816, 0x81e, 0x82a, 0x834, 0x83b, 0x842, 0x84a,
+	0x855, 0x86d, 0x878, 0x884, 0x889, 0x892, 0x8a2, 0x8ba, 0x8be, 0x8d9, 0x8dd, 0x8e5,
+	0x8ef, 0x8f6, 0x901, 0x90d, 0x914, 0x919, 0x91f, 0x932, 0x938, 0x93e, 0x946, 0x94d,
+	0x953, 0x968, 0x975, 0x97c, 0x986, 0x992, 0x9aa, 0x9b3, 0x9c9, 0x9dc, 0x9e3, 0x9ea,
+	0x9fb, 0xa00, 0xa06, 0xa0b, 0xa12, 0xa1e, 0xa24, 0xa2c, 0xa3a, 0xa3f, 0xa45, 0xa52,
+	0xa5f, 0xa66, 0xa74, 0xa83, 0xa91, 0xaa0, 0xaad, 0xabc, 0xac4, 0xad4, 0xadd, 0xae9,
+	0xaf6, 0xb08, 0xb17, 0xb22, 0xb2b, 0xb3d, 0xb46, 0xb4a, 0xb56, 0xb62, 0xb68, 0xb76,
+	0xb85, 0xb93, 0xba0,
+}
+
+const enGBRegionStr = "" +
+	"United KingdomSouth Georgia and the South Sandwich IslandsHeard Island and McDon" +
+	"ald IslandsU.S. Minor Outlying IslandsUnited StatesSaint Vincent and the Grenadi" +
+	"nes"
+
+var enGBRegionIdx = []uint16{ // 247 entries
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xe,
+	0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe, 0xe,
+	0x3a, 0x3a, 0x3a, 0x3a, 0x3a, 0x3a, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x76, 0x83, 0x83, 0x83, 0x83, 0xa3,
+}
+
+const esRegionStr = "" +
+	"Isla de la AscensiónAndorraEmiratos Árabes UnidosAfganistánAntigua y BarbudaAngu" +
+	"ilaAlbaniaArmeniaAntillas NeerlandesasAngolaAntártidaArgentinaSamoa AmericanaAus" +
+	"triaAustraliaArubaIslas ÅlandAzerbaiyánBosnia y HerzegovinaBarbadosBangladésBélg" +
+	"icaBurkina FasoBulgariaBaréinBurundiBenínSan BartoloméBermudasBrunéiBoliviaCarib" +
+	"e neerlandésBrasilBahamasButánIsla BouvetBotsuanaBielorrusiaBeliceCanadáIslas Co" +
+	"cosRepública Democrática del CongoRepública CentroafricanaRepública del CongoSui" +
+	"zaCosta de MarfilIslas CookChileCamerúnChinaColombiaIsla ClippertonCosta RicaCub" +
+	"aCabo VerdeCurazaoIsla ChristmasChipreRepública ChecaAlemaniaDiego GarcíaYibutiD" +
+	"inamarcaDominicaRepública DominicanaArgeliaCeuta y MelillaEcuadorEstoniaEgiptoSá" +
+	"hara OccidentalEritreaEspañaEtiopíaUnión EuropeaFinlandiaFiyiIslas MalvinasMicro" +
+	"nesiaIslas FeroeFranciaGabónReino UnidoGranadaGeorgiaGuayana FrancesaGuerneseyGh" +
+	"anaGibraltarGroenlandiaGambiaGuineaGuadalupeGuinea EcuatorialGreciaIslas Georgia" +
+	" del Sur y Sandwich del SurGuatemalaGuamGuinea-BisáuGuyanaRAE de Hong Kong (Chin" +
+	"a)Islas Heard y McDonaldHondurasCroaciaHaitíHungríaislas CanariasIndonesiaIrland" +
+	"aIsraelIsla de ManIndiaTerritorio Británico del Océano ÍndicoIraqIránIslandiaIta" +
+	"liaJerseyJamaicaJordaniaJapónKeniaKirguistánCamboyaKiribatiComorasSan Cristóbal " +
+	"y NievesCorea del NorteCorea del SurKuwaitIslas CaimánKazajistánLaosLíbanoSanta " +
+	"LucíaLiechtensteinSri LankaLiberiaLesotoLituaniaLuxemburgoLetoniaLibiaMarruecosM" +
+	"ónacoMoldaviaMontenegroSan MartínMadagascarIslas MarshallMacedoniaMaliMyanmar (B" +
+	"irmania)MongoliaRAE de Macao (China)Islas Marianas del NorteMartinicaMauritaniaM" +
+	"ontserratMaltaMauricioMaldivasMalauiMéxicoMalasiaMozambiqueNamibiaNueva Caledoni" +
+	"aNígerIsla NorfolkNigeriaNicaraguaPaíses BajosNoruegaNepalNauruIsla NiueNueva Ze" +
+	"landaOmánPanamáPerúPolinesia FrancesaPapúa Nueva GuineaFilipinasPakistánPoloniaS" +
+	"an Pedro y MiquelónIslas PitcairnPuerto RicoTerritorios PalestinosPortugalPalauP" +
+	"araguayCatarTerritorios alejados de OceaníaReuniónRumaníaSerbiaRusiaRuandaArabia" +
+	" SaudíIslas SalomónSeychellesSudánSueciaSingapurSanta ElenaEsloveniaSvalbard y J" +
+	"an MayenEslovaquiaSierra LeonaSan MarinoSenegalSomaliaSurinamSudán del SurSanto " +
+	"Tomé y PríncipeEl SalvadorSint MaartenSiriaSuazilandiaTristán da CunhaIslas Turc" +
+	"as y CaicosChadTerritorios Australes FrancesesTogoTailandiaTayikistánTokelauTimo" +
+	"r OrientalTurkmenistánTúnezTongaTurquíaTrinidad y TobagoTuvaluTaiwánTanzaniaUcra" +
+	"niaUgandaIslas menores alejadas de EE. UU.Estados UnidosUruguayUzbekistánCiudad " +
+	"del VaticanoSan Vicente y las GranadinasVenezuelaIslas Vírgenes BritánicasIslas " +
+	"Vírgenes de EE. UU.VietnamVanuatuWallis y FutunaSamoaKosovoYemenMayotteSudáfrica" +
+	"ZambiaZimbabueRegión desconocidaMundoÁfricaAmérica del NorteSuraméricaOceaníaÁfr" +
+	"ica occidentalCentroaméricaÁfrica orientalÁfrica septentrionalÁfrica centralÁfri" +
+	"ca meridionalAméricaNorteaméricaCaribeAsia orientalAsia meridionalSudeste asiáti" +
+	"coEuropa meridionalAustralasiaMelanesiaRegión de MicronesiaPolinesiaAsiaAsia cen" +
+	"tralAsia occidentalEuropaEuropa orientalEuropa septentrionalEuropa occidentalLat" +
+	"inoamérica"
+
+var esRegionIdx = []uint16{ // 291 entries
+	0x0, 0x15, 0x1c, 0x33, 0x3e, 0x4f, 0x56, 0x5d, 0x64, 0x79, 0x7f, 0x89,
+	0x92, 0xa1, 0xa8, 0xb1, 0xb6, 0xc2, 0xcd, 0xe1, 0xe9, 0xf3, 0xfb, 0x107,
+	0x10f, 0x116, 0x11d, 0x123, 0x131, 0x139, 0x140, 0x147, 0x159, 0x15f, 0x166, 0x16c,
+	0x177, 0x17f, 0x18a, 0x190, 0x197, 0x1a2, 0x1c3, 0x1dc, 0x1f0, 0x1f5, 0x204, 0x20e,
+	0x213, 0x21b, 0x220, 0x228, 0x237, 0x241, 0x245, 0x24f, 0x256, 0x264, 0x26a, 0x27a,
+	0x282, 0x28f, 0x295, 0x29e, 0x2a6, 0x2bb, 0x2c2, 0x2d1, 0x2d8, 0x2df, 0x2e5, 0x2f7,
+	0x2fe, 0x305, 0x30d, 0x31b, 0x324, 0x328, 0x336, 0x340, 0x34b, 0x352, 0x358, 0x363,
+	0x36a, 0x371, 0x381, 0x38a, 0x38f, 0x398, 0x3a3, 0x3a9, 0x3af, 0x3b8, 0x3c9, 0x3cf,
+	0x3f7, 0x400, 0x404, 0x411, 0x417, 0x42f, 0x445, 0x44d, 0x454, 0x45a, 0x462, 0x470,
+	0x479, 0x480, 0x486, 0x491, 0x496, 0x4bf, 0x4c3, 0x4c8, 0x4d0, 0x4d6, 0x4dc, 0x4e3,
+	0x4eb, 0x4f1, 0x4f6, 0x501, 0x508, 0x510, 0x517, 0x52e, 0x53d, 0x54a, 0x550, 0x55d,
+	0x568, 0x56c, 0x573, 0x57f, 0x58c, 0x595, 0x59c, 0x5a2, 0x5aa, 0x5b4, 0x5bb, 0x5c0,
+	0x5c9, 0x5d0, 0x5d8, 0x5e2, 0x5ed, 0x5f7, 0x605, 0x60e, 0x612, 0x624, 0x62c, 0x640,
+	0x658, 0x661, 0x66b, 0x675, 0x67a, 0x682, 0x68a, 0x690, 0x697, 0x69e, 0x6a8, 0x6af,
+	0x6be, 0x6c4, 0x6d0, 0x6d7, 0x6e0, 0x6ed, 0x6f4, 0x6f9, 0x6fe, 0x707, 0x714, 0x719,
+	0x720, 0x725, 0x737, 0x74a, 0x753, 0x75c, 0x763, 0x778, 0x786, 0x791, 0x7a7, 0x7af,
+	0x7b4, 0x7bc, 0x7c1, 0x7e1, 0x7e9, 0x7f1, 0x7f7, 0x7fc, 0x802, 0x80f, 0x81d, 0x827,
+	0x82d, 0x833, 0x83b, 0x846, 0x84f, 0x863, 0x86d, 0x879, 0x883, 0x88a, 0x891, 0x898,
+	0x8a6, 0x8bd, 0x8c8, 0x8d4, 0x8d9, 0x8e4, 0x8f5, 0x90a, 0x90e, 0x92d, 0x931, 0x93a,
+	0x945, 0x94c, 0x95a, 0x967, 0x96d, 0x972, 0x97a, 0x98b, 0x991, 0x998, 0x9a0, 0x9a7,
+	0x9ad, 0x9ce, 0x9dc, 0x9e3, 0x9ee, 0xa01, 0xa1d, 0xa26, 0xa41, 0xa5b, 0xa62, 0xa69,
+	0xa78, 0xa7d, 0xa83, 0xa88, 0xa8f, 0xa99, 0xa9f, 0xaa7, 0xaba, 0xabf, 0xac6, 0xad8,
+	0xae3, 0xaeb, 0xafd, 0xb0b, 0xb1b, 0xb30, 0xb3f, 0xb51, 0xb59, 0xb66, 0xb6c, 0xb79,
+	0xb88, 0xb99, 0xbaa, 0xbb5, 0xbbe, 0xbd3, 0xbdc, 0xbe0, 0xbec, 0xbfb, 0xc01, 0xc10,
+	0xc24, 0xc35, 0xc43,
+}
+
+const es419RegionStr = "" +
+	""
+
+var es419RegionIdx = []uint16{ // 0 entries
+}
+
+const etRegionStr = "" +
+	"Ascensioni saarAndorraAraabia ÜhendemiraadidAfganistanAntigua ja BarbudaAnguilla" +
+	"AlbaaniaArmeeniaHollandi AntillidAngolaAntarktisArgentinaAmeerika SamoaAustriaAu" +
+	"straaliaArubaAhvenamaaAserbaidžaanBosnia ja HertsegoviinaBarbadosBangladeshBelgi" +
+	"aBurkina FasoBulgaariaBahreinBurundiBeninSaint BarthélemyBermudaBruneiBoliiviaHo" +
+	"llandi Kariibi mere saaredBrasiiliaBahamaBhutanBouvet’ saarBotswanaValgeveneBeli" +
+	"zeKanadaKookossaaredKongo DVKesk-Aafrika VabariikKongo-BrazzavilleŠveitsCôte d'I" +
+	"voireCooki saaredTšiiliKamerunHiinaColombiaClippertoni saarCosta RicaKuubaRohene" +
+	"emesaaredCuraçaoJõulusaarKüprosTšehhiSaksamaaDiego GarciaDjiboutiTaaniDominicaDo" +
+	"minikaani VabariikAlžeeriaCeuta ja MelillaEcuadorEestiEgiptusLääne-SaharaEritrea" +
+	"HispaaniaEtioopiaEuroopa LiitSoomeFidžiFalklandi saaredMikroneesia LiiduriigidFä" +
+	"äri saaredPrantsusmaaGabonSuurbritanniaGrenadaGruusiaPrantsuse GuajaanaGuernseyG" +
+	"hanaGibraltarGröönimaaGambiaGuineaGuadeloupeEkvatoriaal-GuineaKreekaLõuna-Georgi" +
+	"a ja Lõuna-Sandwichi saaredGuatemalaGuamGuinea-BissauGuyanaHongkong - Hiina erih" +
+	"alduspiirkondHeard ja McDonald saaredHondurasHorvaatiaHaitiUngariKanaari saaredI" +
+	"ndoneesiaIirimaaIisraelMani saarIndiaBriti India ookeani alaIraakIraanIslandItaa" +
+	"liaJerseyJamaicaJordaaniaJaapanKenyaKõrgõzstanKambodžaKiribatiKomooridSaint Kitt" +
+	"s ja NevisPõhja-KoreaLõuna-KoreaKuveitKaimanisaaredKasahstanLaosLiibanonSaint Lu" +
+	"ciaLiechtensteinSri LankaLibeeriaLesothoLeeduLuksemburgLätiLiibüaMarokoMonacoMol" +
+	"dovaMontenegroSaint MartinMadagaskarMarshalli saaredMakedooniaMaliMyanmarMongool" +
+	"iaMacau - Hiina erihalduspiirkondPõhja-MariaanidMartiniqueMauritaaniaMontserratM" +
+	"altaMauritiusMaldiividMalawiMehhikoMalaisiaMosambiikNamiibiaUus-KaledooniaNigerN" +
+	"orfolkNigeeriaNicaraguaHollandNorraNepalNauruNiueUus-MeremaaOmaanPanamaPeruuPran" +
+	"tsuse PolüneesiaPaapua Uus-GuineaFilipiinidPakistanPoolaSaint Pierre ja Miquelon" +
+	"Pitcairni saaredPuerto RicoPalestiina aladPortugalBelauParaguayKatarOkeaania haj" +
+	"asaaredRéunionRumeeniaSerbiaVenemaaRwandaSaudi AraabiaSaalomoni SaaredSeišellidS" +
+	"udaanRootsiSingapurSaint HelenaSloveeniaSvalbard ja Jan MayenSlovakkiaSierra Leo" +
+	"neSan MarinoSenegalSomaaliaSurinameLõuna-SudaanSão Tomé ja PríncipeEl SalvadorSi" +
+	"nt MaartenSüüriaSvaasimaaTristan da CunhaTurks ja CaicosTšaadPrantsuse Lõunaalad" +
+	"TogoTaiTadžikistanTokelauIda-TimorTürkmenistanTuneesiaTongaTürgiTrinidad ja Toba" +
+	"goTuvaluTaiwanTansaaniaUkrainaUgandaÜhendriikide hajasaaredAmeerika ÜhendriigidU" +
+	"ruguayUsbekistanVatikanSaint Vincent ja GrenadiinidVenezuelaBriti NeitsisaaredUS" +
+	"A NeitsisaaredVietnamVanuatuWallis ja FutunaSamoaKosovoJeemenMayotteLõuna-Aafrik" +
+	"a VabariikSambiaZimbabweTundmatu piirkondmaailmAafrikaPõhja-AmeerikaLõuna-Ameeri" +
+	"kaOkeaaniaLääne-AafrikaKesk-AmeerikaIda-AafrikaPõhja-AafrikaKesk-AafrikaLõuna-Aa" +
+	"frikaAmeerika maailmajaguAmeerika põhjaosaKariibi meriIda-AasiaLõuna-AasiaKagu-A" +
+	"asiaLõuna-EuroopaAustralaasiaMelaneesiaMikroneesiaPolüneesiaAasiaKesk-AasiaLääne" +
+	"-AasiaEuroopaIda-EuroopaPõhja-EuroopaLääne-EuroopaLadina-Ameerika"
+
+var etRegionIdx = []uint16{ // 291 entries
+	0x0, 0xf, 0x16, 0x2d, 0x37, 0x49, 0x51, 0x59, 0x61, 0x72, 0x78, 0x81,
+	0x8a, 0x98, 0x9f, 0xa9, 0xae, 0xb7, 0xc4, 0xdb, 0xe3, 0xed, 0xf3, 0xff,
+	0x108, 0x10f, 0x116, 0x11b, 0x12c, 0x133, 0x139, 0x141, 0x15d, 0x166, 0x16c, 0x172,
+	0x180, 0x188, 0x191, 0x197, 0x19d, 0x1a9, 0x1b1, 0x1c6, 0x1d7, 0x1de, 0x1ec, 0x1f8,
+	0x1ff, 0x206, 0x20b, 0x213, 0x223, 0x22d, 0x232, 0x241, 0x249, 0x253, 0x25a, 0x261,
+	0x269, 0x275, 0x27d, 0x282, 0x28a, 0x29e, 0x2a7, 0x2b7, 0x2be, 0x2c3, 0x2ca, 0x2d8,
+	0x2df, 0x2e8, 0x2f0, 0x2fc, 0x301, 0x307, 0x317, 0x32e, 0x33c, 0x347, 0x34c, 0x359,
+	0x360, 0x367, 0x379, 0x381, 0x386, 0x38f, 0x39a, 0x3a0, 0x3a6, 0x3b0, 0x3c2, 0x3c8,
+	0x3f1, 0x3fa, 0x3fe, 0x40b, 0x411, 0x433, 0x44b, 0x453, 0x45c, 0x461, 0x467, 0x475,
+	0x47f, 0x486, 0x48d, 0x496, 0x49b, 0x4b2, 0x4b7, 0x4bc, 0x4c2, 0x4c9, 0x4cf, 0x4d6,
+	0x4df, 0x4e5, 0x4ea, 0x4f6, 0x4ff, 0x507, 0x50f, 0x523, 0x52f, 0x53b, 0x541, 0x54e,
+	0x557, 0x55b, 0x563, 0x56e, 0x57b, 0x584, 0x58c, 0x593, 0x598, 0x5a2, 0x5a7, 0x5ae,
+	0x5b4, 0x5ba, 0x5c1, 0x5cb, 0x5d7, 0x5e1, 0x5f1, 0x5fb, 0x5ff, 0x606, 0x60f, 0x62e,
+	0x63e, 0x648, 0x653, 0x65d, 0x662, 0x66b, 0x674, 0x67a, 0x681, 0x689, 0x692, 0x69a,
+	0x6a8, 0x6ad, 0x6b4, 0x6bc, 0x6c5, 0x6cc, 0x6d1, 0x6d6, 0x6db, 0x6df, 0x6ea, 0x6ef,
+	0x6f5, 0x6fa, 0x70f, 0x720, 0x72a, 0x732, 0x737, 0x74f, 0x75f, 0x76a, 0x779, 0x781,
+	0x786, 0x78e, 0x793, 0x7a6, 0x7ae, 0x7b6, 0x7bc, 0x7c3, 0x7c9, 0x7d6, 0x7e6, 0x7f0,
+	0x7f6, 0x7fc, 0x804, 0x810, 0x819, 0x82e, 0x837, 0x843, 0x84d, 0x854, 0x85c, 0x864,
+	0x871, 0x888, 0x893, 0x89f, 0x8a7, 0x8b0, 0x8c0, 0x8cf, 0x8d5, 0x8e9, 0x8ed, 0x8f0,
+	0x8fc, 0x903, 0x90c, 0x919, 0x921, 0x926, 0x92c, 0x93e, 0x944, 0x94a, 0x953, 0x95a,
+	0x960, 0x978, 0x98d, 0x994, 0x99e, 0x9a5, 0x9c1, 0x9ca, 0x9dc, 0x9ec, 0x9f3, 0x9fa,
+	0xa0a, 0xa0f, 0xa15, 0xa1b, 0xa22, 0xa39, 0xa3f, 0xa47, 0xa58, 0xa5e, 0xa65, 0xa74,
+	0xa83, 0xa8b, 0xa9a, 0xaa7, 0xab2, 0xac0, 0xacc, 0xada, 0xaee, 0xb00, 0xb0c, 0xb15,
+	0xb21, 0xb2b, 0xb39, 0xb45, 0xb4f, 0xb5a, 0xb65, 0xb6a, 0xb74, 0xb81, 0xb88, 0xb93,
+	0xba1, 0xbb0, 0xbbf,
+}
+
+const faRegionStr = "" +
+	"جزایر آسنسیونآندوراامارات متحدهٔ عربیافغانستانآنتیگوا و باربوداآنگویلاآلبانیارمن" +
+	"ستانآنتیل هلندآنگولاجنوبگانآرژانتینساموآی آمریکااتریشاسترالیاآروباجزایر اُلندجمه" +
+	"وری آذربایجانبوسنی و هرزگوینباربادوسبنگلادشبلژیکبورکینافاسوبلغارستانبحرینبوروندی" +
+	"بنینسن بارتلمیبرمودابرونئیبولیویجزایر کارائیب هلندبرزیلباهامابوتانجزیره بووهبوتس" +
+	"وانابلاروسبلیزکاناداجزایر کوکوس (کیلینگ)کنگو - کینشاساجمهوری افریقای مرکزیکنگو -" +
+	" برازویلسوئیسساحل عاججزایر کوکشیلیکامرونچینکلمبیاجزایر کلیپرتونکاستاریکاکوباکیپ‌" +
+	"وردکوراسائوجزیرهٔ کریسمسقبرسجمهوری چکآلماندیه‌گو گارسیاجیبوتیدانمارکدومینیکاجمهو" +
+	"ری دومینیکنالجزایرسبته و ملیلهاکوادوراستونیمصرصحرای غربیاریترهاسپانیااتیوپیاتحاد" +
+	"یهٔ اروپافنلاندفیجیجزایر فالکلندمیکرونزیجزایر فاروفرانسهگابنبریتانیاگرناداگرجستا" +
+	"نگویان فرانسهگرنزیغناجبل‌الطارقگرینلندگامبیاگینهگوادلوپگینهٔ استوایییونانجزایر ج" +
+	"ورجیای جنوبی و ساندویچ جنوبیگواتمالاگوامگینهٔ بیسائوگویانهنگ‌کنگ، ناحیهٔ ویژهٔ ح" +
+	"کومتی چینجزیرهٔ هرد و جزایر مک‌دونالدهندوراسکرواسیهائیتیمجارستانجزایر قناریاندون" +
+	"زیایرلنداسرائیلجزیرهٔ منهندقلمرو بریتانیا در اقیانوس هندعراقایرانایسلندایتالیاجر" +
+	"زیجامائیکااردنژاپنکنیاقرقیزستانکامبوجکیریباتیکومورسنت کیتس و نویسکرهٔ شمالیکرهٔ " +
+	"جنوبیکویتجزایر کِیمنقزاقستانلائوسلبنانسنت لوسیالیختن‌اشتاینسری‌لانکالیبریالسوتول" +
+	"یتوانیلوکزامبورگلتونیلیبیمراکشموناکومولداویمونته‌نگروسنت مارتینماداگاسکارجزایر م" +
+	"ارشالمقدونیهمالیمیانمار (برمه)مغولستانماکائو، ناحیهٔ ویژهٔ حکومتی چینجزایر ماریا" +
+	"نای شمالیمارتینیکموریتانیمونت‌سراتمالتموریسمالدیومالاویمکزیکمالزیموزامبیکنامیبیا" +
+	"کالدونیای جدیدنیجرجزیره نورفکنیجریهنیکاراگوئههلندنروژنپالنائورونیوئهزلاند نوعمان" +
+	"پاناماپروپلی‌نزی فرانسهپاپوا گینهٔ نوفیلیپینپاکستانلهستانسن پیر و میکلنجزایر پیت" +
+	"‌کرنپورتوریکوسرزمین‌های فلسطینیپرتغالپالائوپاراگوئهقطربخش‌های دورافتادهٔ اقیانوس" +
+	"یهرئونیونرومانیصربستانروسیهروانداعربستان سعودیجزایر سلیمانسیشلسودانسوئدسنگاپورسن" +
+	"ت هلناسلوونیاسوالبارد و جان‌مایناسلواکیسیرالئونسن مارینوسنگالسومالیسورینامسودان " +
+	"جنوبیسائوتومه و پرینسیپالسالوادورسنت مارتنسوریهسوازیلندتریستان دا کوناجزایر تورک" +
+	"س و کایکوسچادمستعمره‌های جنوبی فرانسهتوگوتایلندتاجیکستانتوکلائوتیمور شرقیترکمنست" +
+	"انتونستونگاترکیهترینیداد و توباگوتووالوتایوانتانزانیااوکرایناوگانداجزایر دورافتا" +
+	"دهٔ ایالات متحدهایالات متحدهٔ امریکااروگوئهازبکستانواتیکانسنت وینسنت و گرنادین‌ه" +
+	"اونزوئلاجزایر ویرجین بریتانیاجزایر ویرجین ایالات متحدهویتناموانواتووالیس و فوتون" +
+	"اساموآکوزووویمنمایوتافریقای جنوبیزامبیازیمبابوهناحیهٔ نامشخصجهانافریقاامریکای شم" +
+	"الیامریکای جنوبیاقیانوسیهغرب افریقاامریکای مرکزیشرق افریقاشمال افریقامرکز افریقا" +
+	"جنوب افریقاامریکاشمال امریکاکارائیبشرق آسیاجنوب آسیاجنوب شرق آسیاجنوب اروپااسترا" +
+	"لزیملانزیناحیهٔ میکرونزیپلی‌نزیآسیاآسیای مرکزیغرب آسیااروپاشرق اروپاشمال اروپاغر" +
+	"ب اروپاامریکای لاتین"
+
+var faRegionIdx = []uint16{ // 291 entries
+	0x0, 0x19, 0x25, 0x47, 0x59, 0x79, 0x87, 0x93, 0xa3, 0xb6, 0xc2, 0xd0,
+	0xe0, 0xf9, 0x103, 0x113, 0x11d, 0x132, 0x151, 0x16d, 0x17d, 0x18b, 0x195, 0x1ab,
+	0x1bd, 0x1c7, 0x1d5, 0x1dd, 0x1f0, 0x1fc, 0x208, 0x214, 0x236, 0x240, 0x24c, 0x256,
+	0x269, 0x279, 0x285, 0x28d, 0x299, 0x2bd, 0x2d6, 0x2fc, 0x315, 0x31f, 0x32e, 0x33f,
+	0x347, 0x353, 0x359, 0x365, 0x380, 0x392, 0x39a, 0x3a9, 0x3b9, 0x3d2, 0x3da, 0x3eb,
+	0x3f5, 0x40f, 0x41b, 0x429, 0x439, 0x456, 0x464, 0x47a, 0x488, 0x494, 0x49a, 0x4ad,
+	0x4b9, 0x4c7, 0x4d3, 0x4ee, 0x4fa, 0x502, 0x51b, 0x52b, 0x53e, 0x54a, 0x552, 0x562,
+	0x56e, 0x57c, 0x593, 0x59d, 0x5a3, 0x5b8, 0x5c6, 0x5d2, 0x5da, 0x5e8, 0x601, 0x60b,
+	0x64c, 0x65c, 0x664, 0x67b, 0x685, 0x6c2, 0x6f7, 0x705, 0x711, 0x71d, 0x72d, 0x742,
+	0x750, 0x75c, 0x76a, 0x77b, 0x781, 0x7b7, 0x7bf, 0x7c9, 0x7d5, 0x7e3, 0x7eb, 0x7fb,
+	0x803, 0x80b, 0x813, 0x825, 0x831, 0x841, 0x84b, 0x866, 0x879, 0x88c, 0x894, 0x8a9,
+	0x8b9, 0x8c3, 0x8cd, 0x8de, 0x8f7, 0x90a, 0x916, 0x920, 0x92e, 0x942, 0x94c, 0x954,
+	0x95e, 0x96a, 0x978, 0x98d, 0x9a0, 0x9b4, 0x9cb, 0x9d9, 0x9e1, 0x9fa, 0xa0a, 0xa44,
+	0xa6a, 0xa7a, 0xa8a, 0xa9d, 0xaa5, 0xaaf, 0xabb, 0xac7, 0xad1, 0xadb, 0xaeb, 0xaf9,
+	0xb14, 0xb1c, 0xb31, 0xb3d, 0xb51, 0xb59, 0xb61, 0xb69, 0xb75, 0xb7f, 0xb8e, 0xb96,
+	0xba2, 0xba8, 0xbc4, 0xbde, 0xbec, 0xbfa, 0xc06, 0xc1f, 0xc39, 0xc4b, 0xc6f, 0xc7b,
+	0xc87, 0xc97, 0xc9d, 0xcd4, 0xce2, 0xcee, 0xcfc, 0xd06, 0xd12, 0xd2b, 0xd42, 0xd4a,
+	0xd54, 0xd5c, 0xd6a, 0xd77, 0xd85, 0xdac, 0xdba, 0xdca, 0xddb, 0xde5, 0xdf1, 0xdff,
+	0xe14, 0xe36, 0xe4a, 0xe5b, 0xe65, 0xe75, 0xe91, 0xeb6, 0xebc, 0xeeb, 0xef3, 0xeff,
+	0xf11, 0xf1f, 0xf32, 0xf44, 0xf4c, 0xf56, 0xf60, 0xf80, 0xf8c, 0xf98, 0xfa8, 0xfb6,
+	0xfc4, 0xffb, 0x1021, 0x102f, 0x103f, 0x104d, 0x1079, 0x1087, 0x10af, 0x10de, 0x10ea, 0x10f8,
+	0x1112, 0x111c, 0x1128, 0x112e, 0x1138, 0x1151, 0x115d, 0x116d, 0x1186, 0x118e, 0x119a, 0x11b3,
+	0x11cc, 0x11de, 0x11f1, 0x120a, 0x121d, 0x1232, 0x1247, 0x125c, 0x1268, 0x127d, 0x128b, 0x129a,
+	0x12ab, 0x12c3, 0x12d6, 0x12e6, 0x12f2, 0x130f, 0x131e, 0x1326, 0x133b, 0x134a, 0x1354, 0x1365,
+	0x1378, 0x1389, 0x13a2,
+}
+
+const fiRegionStr = "" +
+	"Ascension-saariAndorraArabiemiirikunnatAfganistanAntigua ja BarbudaAnguillaAlban" +
+	"iaArmeniaAlankomaiden AntillitAngolaAntarktisArgentiinaAmerikan SamoaItävaltaAus" +
+	"traliaArubaAhvenanmaaAzerbaidžanBosnia ja HertsegovinaBarbadosBangladeshBelgiaBu" +
+	"rkina FasoBulgariaBahrainBurundiBeninSaint-BarthélemyBermudaBruneiBoliviaKaribia" +
+	"n AlankomaatBrasiliaBahamaBhutanBouvet’nsaariBotswanaValko-VenäjäBelizeKanadaKoo" +
+	"kossaaret (Keelingsaaret)Kongon demokraattinen tasavaltaKeski-Afrikan tasavaltaK" +
+	"ongon tasavaltaSveitsiNorsunluurannikkoCookinsaaretChileKamerunKiinaKolumbiaClip" +
+	"pertoninsaariCosta RicaKuubaKap VerdeCuraçaoJoulusaariKyprosTšekkiSaksaDiego Gar" +
+	"ciaDjiboutiTanskaDominicaDominikaaninen tasavaltaAlgeriaCeuta ja MelillaEcuadorV" +
+	"iroEgyptiLänsi-SaharaEritreaEspanjaEtiopiaEuroopan unioniSuomiFidžiFalklandinsaa" +
+	"retMikronesian liittovaltioFärsaaretRanskaGabonYhdistynyt kuningaskuntaGrenadaGe" +
+	"orgiaRanskan GuayanaGuernseyGhanaGibraltarGrönlantiGambiaGuineaGuadeloupePäivänt" +
+	"asaajan GuineaKreikkaEtelä-Georgia ja Eteläiset SandwichsaaretGuatemalaGuamGuine" +
+	"a-BissauGuyanaHongkong – Kiinan e.h.a.Heard ja McDonaldinsaaretHondurasKroatiaHa" +
+	"itiUnkariKanariansaaretIndonesiaIrlantiIsraelMansaariIntiaBrittiläinen Intian va" +
+	"ltameren alueIrakIranIslantiItaliaJerseyJamaikaJordaniaJapaniKeniaKirgisiaKambod" +
+	"žaKiribatiKomoritSaint Kitts ja NevisPohjois-KoreaEtelä-KoreaKuwaitCaymansaaretK" +
+	"azakstanLaosLibanonSaint LuciaLiechtensteinSri LankaLiberiaLesothoLiettuaLuxembu" +
+	"rgLatviaLibyaMarokkoMonacoMoldovaMontenegroSaint-MartinMadagaskarMarshallinsaare" +
+	"tMakedoniaMaliMyanmar (Burma)MongoliaMacao – Kiinan e.h.a.Pohjois-MariaanitMarti" +
+	"niqueMauritaniaMontserratMaltaMauritiusMalediivitMalawiMeksikoMalesiaMosambikNam" +
+	"ibiaUusi-KaledoniaNigerNorfolkinsaariNigeriaNicaraguaAlankomaatNorjaNepalNauruNi" +
+	"ueUusi-SeelantiOmanPanamaPeruRanskan PolynesiaPapua-Uusi-GuineaFilippiinitPakist" +
+	"anPuolaSaint-Pierre ja MiquelonPitcairnPuerto RicoPalestiinalaisalueetPortugaliP" +
+	"alauParaguayQatarulkomeriRéunionRomaniaSerbiaVenäjäRuandaSaudi-ArabiaSalomonsaar" +
+	"etSeychellitSudanRuotsiSingaporeSaint HelenaSloveniaHuippuvuoret ja Jan MayenSlo" +
+	"vakiaSierra LeoneSan MarinoSenegalSomaliaSurinamEtelä-SudanSão Tomé ja PríncipeE" +
+	"l SalvadorSint MaartenSyyriaSwazimaaTristan da CunhaTurks- ja CaicossaaretTšadRa" +
+	"nskan ulkopuoliset eteläiset alueetTogoThaimaaTadžikistanTokelauItä-TimorTurkmen" +
+	"istanTunisiaTongaTurkkiTrinidad ja TobagoTuvaluTaiwanTansaniaUkrainaUgandaYhdysv" +
+	"altain erillissaaretYhdysvallatUruguayUzbekistanVatikaaniSaint Vincent ja Grenad" +
+	"iinitVenezuelaBrittiläiset NeitsytsaaretYhdysvaltain NeitsytsaaretVietnamVanuatu" +
+	"Wallis ja FutunaSamoaKosovoJemenMayotteEtelä-AfrikkaSambiaZimbabwetuntematon alu" +
+	"emaailmaAfrikkaPohjois-AmerikkaEtelä-AmerikkaOseaniaLänsi-AfrikkaVäli-AmerikkaIt" +
+	"ä-AfrikkaPohjois-AfrikkaKeski-Afrikkaeteläinen AfrikkaAmerikkapohjoinen Amerikka" +
+	"KaribiaItä-AasiaEtelä-AasiaKaakkois-AasiaEtelä-EurooppaAustralaasiaMelanesiaMikr" +
+	"onesiaPolynesiaAasiaKeski-AasiaLänsi-AasiaEurooppaItä-EurooppaPohjois-EurooppaLä" +
+	"nsi-EurooppaLatinalainen Amerikka"
+
+var fiRegionIdx = []uint16{ // 291 entries
+	0x0, 0xf, 0x16, 0x27, 0x31, 0x43, 0x4b, 0x52, 0x59, 0x6e, 0x74, 0x7d,
+	0x87, 0x95, 0x9e, 0xa7, 0xac, 0xb6, 0xc2, 0xd8, 0xe0, 0xea, 0xf0, 0xfc,
+	0x104, 0x10b, 0x112, 0x117, 0x128, 0x12f, 0x135, 0x13c, 0x14f, 0x157, 0x15d, 0x163,
+	0x172, 0x17a, 0x188, 0x18e, 0x194, 0x1b0, 0x1cf, 0x1e6, 0x1f6, 0x1fd, 0x20e, 0x21a,
+	0x21f, 0x226, 0x22b, 0x233, 0x244, 0x24e, 0x253, 0x25c, 0x264, 0x26e, 0x274, 0x27b,
+	0x280, 0x28c, 0x294, 0x29a, 0x2a2, 0x2ba, 0x2c1, 0x2d1, 0x2d8, 0x2dc, 0x2e2, 0x2ef,
+	0x2f6, 0x2fd, 0x304, 0x313, 0x318, 0x31e, 0x32e, 0x346, 0x350, 0x356, 0x35b, 0x373,
+	0x37a, 0x381, 0x390, 0x398, 0x39d, 0x3a6, 0x3b0, 0x3b6, 0x3bc, 0x3c6, 0x3dd, 0x3e4,
+	0x40f, 0x418, 0x41c, 0x429, 0x42f, 0x449, 0x462, 0x46a, 0x471, 0x476, 0x47c, 0x48a,
+	0x493, 0x49a, 0x4a0, 0x4a8, 0x4ad, 0x4d1, 0x4d5, 0x4d9, 0x4e0, 0x4e6, 0x4ec, 0x4f3,
+	0x4fb, 0x501, 0x506, 0x50e, 0x517, 0x51f, 0x526, 0x53a, 0x547, 0x553, 0x559, 0x565,
+	0x56e, 0x572, 0x579, 0x584, 0x591, 0x59a, 0x5a1, 0x5a8, 0x5af, 0x5b8, 0x5be, 0x5c3,
+	0x5ca, 0x5d0, 0x5d7, 0x5e1, 0x5ed, 0x5f7, 0x607, 0x610, 0x614, 0x623, 0x62b, 0x642,
+	0x653, 0x65d, 0x667, 0x671, 0x676, 0x67f, 0x689, 0x68f, 0x696, 0x69d, 0x6a5, 0x6ac,
+	0x6ba, 0x6bf, 0x6cd, 0x6d4, 0x6dd, 0x6e7, 0x6ec, 0x6f1, 0x6f6, 0x6fa, 0x707, 0x70b,
+	0x711, 0x715, 0x726, 0x737, 0x742, 0x74a, 0x74f, 0x767, 0x76f, 0x77a, 0x78e, 0x797,
+	0x79c, 0x7a4, 0x7a9, 0x7b1, 0x7b9, 0x7c0, 0x7c6, 0x7ce, 0x7d4, 0x7e0, 0x7ed, 0x7f7,
+	0x7fc, 0x802, 0x80b, 0x817, 0x81f, 0x838, 0x840, 0x84c, 0x856, 0x85d, 0x864, 0x86b,
+	0x877, 0x88e, 0x899, 0x8a5, 0x8ab, 0x8b3, 0x8c3, 0x8d9, 0x8de, 0x904, 0x908, 0x90f,
+	0x91b, 0x922, 0x92c, 0x938, 0x93f, 0x944, 0x94a, 0x95c, 0x962, 0x968, 0x970, 0x977,
+	0x97d, 0x997, 0x9a2, 0x9a9, 0x9b3, 0x9bc, 0x9d8, 0x9e1, 0x9fc, 0xa16, 0xa1d, 0xa24,
+	0xa34, 0xa39, 0xa3f, 0xa44, 0xa4b, 0xa59, 0xa5f, 0xa67, 0xa76, 0xa7d, 0xa84, 0xa94,
+	0xaa3, 0xaaa, 0xab8, 0xac6, 0xad2, 0xae1, 0xaee, 0xb00, 0xb08, 0xb1a, 0xb21, 0xb2b,
+	0xb37, 0xb45, 0xb54, 0xb60, 0xb69, 0xb73, 0xb7c, 0xb81, 0xb8c, 0xb98, 0xba0, 0xbad,
+	0xbbd, 0xbcc, 0xbe1,
+}
+
+const filRegionStr = "" +
+	"Acsencion islandAndorraUnited Arab EmiratesAfghanistanAntigua and BarbudaAnguill" +
+	"aAlbaniaArmeniaNetherlands AntillesAngolaAntarcticaArgentinaAmerican SamoaAustri" +
+	"aAustraliaArubaÅland IslandsAzerbaijanBosnia and HerzegovinaBarbadosBangladeshBe" +
+	"lgiumBurkina FasoBulgariaBahrainBurundiBeninSaint BarthélemyBermudaBruneiBolivia" +
+	"Caribbean NetherlandsBrazilBahamasBhutanBouvet IslandBotswanaBelarusBelizeCanada" +
+	"Cocos (Keeling) IslandsCongo - KinshasaCentral African RepublicCongo - Brazzavil" +
+	"leSwitzerlandCôte d'IvoireCook IslandsChileCameroonChinaColombiaClipperton Islan" +
+	"dCosta RicaCubaCape VerdeCuraçaoChristmas IslandCyprusCzech RepublicGermanyDiego" +
+	" GarciaDjiboutiDenmarkDominicaDominican RepublicAlgeriaCeuta and MelillaEcuadorE" +
+	"stoniaEgyptWestern SaharaEritreaSpainEthiopiaEuropean UnionFinlandFijiFalkland I" +
+	"slandsMicronesiaFaroe IslandsFranceGabonUnited KingdomGrenadaGeorgiaFrench Guian" +
+	"aGuernseyGhanaGibraltarGreenlandGambiaGuineaGuadeloupeEquatorial GuineaGreeceSou" +
+	"th Georgia and the South Sandwich IslandsGuatemalaGuamGuinea-BissauGuyanaHong Ko" +
+	"ng SAR ChinaHeard Island and McDonald IslandsHondurasCroatiaHaitiHungaryCanary I" +
+	"slandIndonesiaIrelandIsraelIsle of ManIndiaBritish Indian Ocean TerritoryIraqIra" +
+	"nIcelandItalyJerseyJamaicaJordanJapanKenyaKyrgyzstanCambodiaKiribatiComorosSaint" +
+	" Kitts and NevisNorth KoreaSouth KoreaKuwaitCayman IslandsKazakhstanLaosLebanonS" +
+	"aint LuciaLiechtensteinSri LankaLiberiaLesothoLithuaniaLuxembourgLatviaLibyaMoro" +
+	"ccoMonacoMoldovaMontenegroSaint MartinMadagascarMarshall IslandsMacedoniaMaliMya" +
+	"nmar (Burma)MongoliaMacau SAR ChinaNorthern Mariana IslandsMartiniqueMauritaniaM" +
+	"ontserratMaltaMauritiusMaldivesMalawiMexicoMalaysiaMozambiqueNamibiaNew Caledoni" +
+	"aNigerNorfolk IslandNigeriaNicaraguaNetherlandsNorwayNepalNauruNiueNew ZealandOm" +
+	"anPanamaPeruFrench PolynesiaPapua New GuineaPilipinasPakistanPolandSaint Pierre " +
+	"and MiquelonPitcairn IslandsPuerto RicoPalestinian TerritoriesPortugalPalauParag" +
+	"uayQatarOutlying OceaniaRéunionRomaniaSerbiaRussiaRwandaSaudi ArabiaSolomon Isla" +
+	"ndsSeychellesSudanSwedenSingaporeSaint HelenaSloveniaSvalbard and Jan MayenSlova" +
+	"kiaSierra LeoneSan MarinoSenegalSomaliaSurinameSouth SudanSão Tomé and PríncipeE" +
+	"l SalvadorSint MaartenSyriaSwazilandTristan de CunhaTurks and Caicos IslandsChad" +
+	"French Southern TerritoriesTogoThailandTajikistanTokelauTimor-LesteTurkmenistanT" +
+	"unisiaTongaTurkeyTrinidad and TobagoTuvaluTaiwanTanzaniaUkraineUgandaU.S. Outlyi" +
+	"ng IslandsEstados UnidosUruguayUzbekistanVatican CitySaint Vincent and the Grena" +
+	"dinesVenezuelaBritish Virgin IslandsU.S. Virgin IslandsVietnamVanuatuWallis and " +
+	"FutunaSamoaKosovoYemenMayotteSouth AfricaZambiaZimbabweHindi Kilalang RehiyonMun" +
+	"doAfricaNorth AmericaSouth AmericaOceaniaWestern AfricaCentral AmericaEastern Af" +
+	"ricaNorthern AfricaMiddle AfricaSouthern AfricaAmericasNorthern AmericaCarribbea" +
+	"nEastern AsiaSouthern AsiaSouth-Eastern AsiaSouthern EuropeAustralasiaMelanesiaM" +
+	"icronesian RegionPolynesiaAsiaCentral AsiaWestern AsiaEuropeEastern EuropeNorthe" +
+	"rn EuropeWestern EuropeLatin America"
+
+var filRegionIdx = []uint16{ // 291 entries
+	0x0, 0x10, 0x17, 0x2b, 0x36, 0x49, 0x51, 0x58, 0x5f, 0x73, 0x79, 0x83,
+	0x8c, 0x9a, 0xa1, 0xaa, 0xaf, 0xbd, 0xc7, 0xdd, 0xe5, 0xef, 0xf6, 0x102,
+	0x10a, 0x111, 0x118, 0x11d, 0x12e, 0x135, 0x13b, 0x142, 0x157, 0x15d, 0x164, 0x16a,
+	0x177, 0x17f, 0x186, 0x18c, 0x192, 0x1a9, 0x1b9, 0x1d1, 0x1e4, 0x1ef, 0x1fd, 0x209,
+	0x20e, 0x216, 0x21b, 0x223, 0x234, 0x23e, 0x242, 0x24c, 0x254, 0x264, 0x26a, 0x278,
+	0x27f, 0x28b, 0x293, 0x29a, 0x2a2, 0x2b4, 0x2bb, 0x2cc, 0x2d3, 0x2da, 0x2df, 0x2ed,
+	0x2f4, 0x2f9, 0x301, 0x30f, 0x316, 0x31a, 0x32a, 0x334, 0x341, 0x347, 0x34c, 0x35a,
+	0x361, 0x368, 0x375, 0x37d, 0x382, 0x38b, 0x394, 0x39a, 0x3a0, 0x3aa, 0x3bb, 0x3c1,
+	0x3ed, 0x3f6, 0x3fa, 0x407, 0x40d, 0x420, 0x441, 0x449, 0x450, 0x455, 0x45c, 0x469,
+	0x472, 0x479, 0x47f, 0x48a, 0x48f, 0x4ad, 0x4b1, 0x4b5, 0x4bc, 0x4c1, 0x4c7, 0x4ce,
+	0x4d4, 0x4d9, 0x4de, 0x4e8, 0x4f0, 0x4f8, 0x4ff, 0x514, 0x51f, 0x52a, 0x530, 0x53e,
+	0x548, 0x54c, 0x553, 0x55e, 0x56b, 0x574, 0x57b, 0x582, 0x58b, 0x595, 0x59b, 0x5a0,
+	0x5a7, 0x5ad, 0x5b4, 0x5be, 0x5ca, 0x5d4, 0x5e4, 0x5ed, 0x5f1, 0x600, 0x608, 0x617,
+	0x62f, 0x639, 0x643, 0x64d, 0x652, 0x65b, 0x663, 0x669, 0x66f, 0x677, 0x681, 0x688,
+	0x695, 0x69a, 0x6a8, 0x6af, 0x6b8, 0x6c3, 0x6c9, 0x6ce, 0x6d3, 0x6d7, 0x6e2, 0x6e6,
+	0x6ec, 0x6f0, 0x700, 0x710, 0x719, 0x721, 0x727, 0x740, 0x750, 0x75b, 0x772, 0x77a,
+	0x77f, 0x787, 0x78c, 0x79c, 0x7a4, 0x7ab, 0x7b1, 0x7b7, 0x7bd, 0x7c9, 0x7d8, 0x7e2,
+	0x7e7, 0x7ed, 0x7f6, 0x802, 0x80a, 0x820, 0x828, 0x834, 0x83e, 0x845, 0x84c, 0x854,
+	0x85f, 0x877, 0x882, 0x88e, 0x893, 0x89c, 0x8ac, 0x8c4, 0x8c8, 0x8e3, 0x8e7, 0x8ef,
+	0x8f9, 0x900, 0x90b, 0x917, 0x91e, 0x923, 0x929, 0x93c, 0x942, 0x948, 0x950, 0x957,
+	0x95d, 0x972, 0x980, 0x987, 0x991, 0x99d, 0x9bd, 0x9c6, 0x9dc, 0x9ef, 0x9f6, 0x9fd,
+	0xa0e, 0xa13, 0xa19, 0xa1e, 0xa25, 0xa31, 0xa37, 0xa3f, 0xa55, 0xa5a, 0xa60, 0xa6d,
+	0xa7a, 0xa81, 0xa8f, 0xa9e, 0xaac, 0xabb, 0xac8, 0xad7, 0xadf, 0xaef, 0xaf9, 0xb05,
+	0xb12, 0xb24, 0xb33, 0xb3e, 0xb47, 0xb59, 0xb62, 0xb66, 0xb72, 0xb7e, 0xb84, 0xb92,
+	0xba1, 0xbaf, 0xbbc,
+}
+
+const frRegionStr = "" +
+	"Île de l’AscensionAndorreÉmirats arabes unisAfghanistanAntigua-et-BarbudaAnguill" +
+	"aAlbanieArménieAntilles néerlandaisesAngolaAntarctiqueArgentineSamoa américaines" +
+	"AutricheAustralieArubaÎles ÅlandAzerbaïdjanBosnie-HerzégovineBarbadeBangladeshBe" +
+	"lgiqueBurkina FasoBulgarieBahreïnBurundiBéninSaint-BarthélemyBermudesBrunéi Daru" +
+	"ssalamBoliviePays-Bas caribéensBrésilBahamasBhoutanÎle BouvetBotswanaBiélorussie" +
+	"BelizeCanadaÎles Cocos (Keeling)Congo-KinshasaRépublique centrafricaineCongo-Bra" +
+	"zzavilleSuisseCôte d’IvoireÎles CookChiliCamerounChineColombieÎle ClippertonCost" +
+	"a RicaCubaCap-VertCuraçaoÎle ChristmasChypreRépublique tchèqueAllemagneDiego Gar" +
+	"ciaDjiboutiDanemarkDominiqueRépublique dominicaineAlgérieCeuta et MelillaÉquateu" +
+	"rEstonieÉgypteSahara occidentalÉrythréeEspagneÉthiopieUnion européenneFinlandeFi" +
+	"djiÎles MalouinesÉtats fédérés de MicronésieÎles FéroéFranceGabonRoyaume-UniGren" +
+	"adeGéorgieGuyane françaiseGuerneseyGhanaGibraltarGroenlandGambieGuinéeGuadeloupe" +
+	"Guinée équatorialeGrèceGéorgie du Sud et les Îles Sandwich du SudGuatemalaGuamGu" +
+	"inée-BissauGuyanaR.A.S. chinoise de Hong KongÎles Heard et MacDonaldHondurasCroa" +
+	"tieHaïtiHongrieÎles CanariesIndonésieIrlandeIsraëlÎle de ManIndeTerritoire brita" +
+	"nnique de l’océan IndienIrakIranIslandeItalieJerseyJamaïqueJordanieJaponKenyaKir" +
+	"ghizistanCambodgeKiribatiComoresSaint-Kitts-et-NevisCorée du NordCorée du SudKow" +
+	"eïtÎles CaïmansKazakhstanLaosLibanSainte-LucieLiechtensteinSri LankaLibériaLesot" +
+	"hoLituanieLuxembourgLettonieLibyeMarocMonacoMoldavieMonténégroSaint-Martin (part" +
+	"ie française)MadagascarÎles MarshallMacédoineMaliMyanmarMongolieR.A.S. chinoise " +
+	"de MacaoÎles Mariannes du NordMartiniqueMauritanieMontserratMalteMauriceMaldives" +
+	"MalawiMexiqueMalaisieMozambiqueNamibieNouvelle-CalédonieNigerÎle NorfolkNigériaN" +
+	"icaraguaPays-BasNorvègeNépalNauruNiueNouvelle-ZélandeOmanPanamaPérouPolynésie fr" +
+	"ançaisePapouasie-Nouvelle-GuinéePhilippinesPakistanPologneSaint-Pierre-et-Miquel" +
+	"onPitcairnPorto RicoTerritoires palestiniensPortugalPalaosParaguayQatarrégions é" +
+	"loignées de l’OcéanieLa RéunionRoumanieSerbieRussieRwandaArabie saouditeÎles Sal" +
+	"omonSeychellesSoudanSuèdeSingapourSainte-HélèneSlovénieSvalbard et Jan MayenSlov" +
+	"aquieSierra LeoneSaint-MarinSénégalSomalieSurinameSoudan du SudSao Tomé-et-Princ" +
+	"ipeEl SalvadorSaint-Martin (partie néerlandaise)SyrieSwazilandTristan da CunhaÎl" +
+	"es Turques-et-CaïquesTchadTerres australes françaisesTogoThaïlandeTadjikistanTok" +
+	"elauTimor orientalTurkménistanTunisieTongaTurquieTrinité-et-TobagoTuvaluTaïwanTa" +
+	"nzanieUkraineOugandaÎles mineures éloignées des États-UnisÉtats-UnisUruguayOuzbé" +
+	"kistanÉtat de la Cité du VaticanSaint-Vincent-et-les GrenadinesVenezuelaÎles Vie" +
+	"rges britanniquesÎles Vierges des États-UnisVietnamVanuatuWallis-et-FutunaSamoaK" +
+	"osovoYémenMayotteAfrique du SudZambieZimbabwerégion indéterminéeMondeAfriqueAmér" +
+	"ique du NordAmérique du SudOcéanieAfrique occidentaleAmérique centraleAfrique or" +
+	"ientaleAfrique septentrionaleAfrique centraleAfrique australeAmériquesAmérique s" +
+	"eptentrionaleCaraïbesAsie orientaleAsie du SudAsie du Sud-EstEurope méridionaleA" +
+	"ustralasieMélanésierégion micronésiennePolynésieAsieAsie centraleAsie occidental" +
+	"eEuropeEurope orientaleEurope septentrionaleEurope occidentaleAmérique latine"
+
+var frRegionIdx = []uint16{ // 291 entries
+	0x0, 0x15, 0x1c, 0x30, 0x3b, 0x4d, 0x55, 0x5c, 0x64, 0x7b, 0x81, 0x8c,
+	0x95, 0xa7, 0xaf, 0xb8, 0xbd, 0xc9, 0xd5, 0xe8, 0xef, 0xf9, 0x101, 0x10d,
+	0x115, 0x11d, 0x124, 0x12a, 0x13b, 0x143, 0x155, 0x15c, 0x16f, 0x176, 0x17d, 0x184,
+	0x18f, 0x197, 0x1a3, 0x1a9, 0x1af, 0x1c4, 0x1d2, 0x1ec, 0x1fd, 0x203, 0x213, 0x21d,
+	0x222, 0x22a, 0x22f, 0x237, 0x246, 0x250, 0x254, 0x25c, 0x264, 0x272, 0x278, 0x28c,
+	0x295, 0x2a1, 0x2a9, 0x2b1, 0x2ba, 0x2d1, 0x2d9, 0x2e9, 0x2f2, 0x2f9, 0x300, 0x311,
+	0x31b, 0x322, 0x32b, 0x33c, 0x344, 0x349, 0x358, 0x378, 0x385, 0x38b, 0x390, 0x39b,
+	0x3a2, 0x3aa, 0x3bb, 0x3c4, 0x3c9, 0x3d2, 0x3db, 0x3e1, 0x3e8, 0x3f2, 0x406, 0x40c,
+	0x438, 0x441, 0x445, 0x453, 0x459, 0x475, 0x48d, 0x495, 0x49c, 0x4a2, 0x4a9, 0x4b7,
+	0x4c1, 0x4c8, 0x4cf, 0x4da, 0x4de, 0x509, 0x50d, 0x511, 0x518, 0x51e, 0x524, 0x52d,
+	0x535, 0x53a, 0x53f, 0x54b, 0x553, 0x55b, 0x562, 0x576, 0x584, 0x591, 0x598, 0x5a6,
+	0x5b0, 0x5b4, 0x5b9, 0x5c5, 0x5d2, 0x5db, 0x5e3, 0x5ea, 0x5f2, 0x5fc, 0x604, 0x609,
+	0x60e, 0x614, 0x61c, 0x628, 0x648, 0x652, 0x660, 0x66a, 0x66e, 0x675, 0x67d, 0x695,
+	0x6ac, 0x6b6, 0x6c0, 0x6ca, 0x6cf, 0x6d6, 0x6de, 0x6e4, 0x6eb, 0x6f3, 0x6fd, 0x704,
+	0x717, 0x71c, 0x728, 0x730, 0x739, 0x741, 0x749, 0x74f, 0x754, 0x758, 0x769, 0x76d,
+	0x773, 0x779, 0x78e, 0x7a8, 0x7b3, 0x7bb, 0x7c2, 0x7da, 0x7e2, 0x7ec, 0x804, 0x80c,
+	0x812, 0x81a, 0x81f, 0x843, 0x84e, 0x856, 0x85c, 0x862, 0x868, 0x877, 0x884, 0x88e,
+	0x894, 0x89a, 0x8a3, 0x8b2, 0x8bb, 0x8d0, 0x8d9, 0x8e5, 0x8f0, 0x8f9, 0x900, 0x908,
+	0x915, 0x92a, 0x935, 0x958, 0x95d, 0x966, 0x976, 0x98f, 0x994, 0x9b0, 0x9b4, 0x9be,
+	0x9c9, 0x9d0, 0x9de, 0x9eb, 0x9f2, 0x9f7, 0x9fe, 0xa10, 0xa16, 0xa1d, 0xa25, 0xa2c,
+	0xa33, 0xa5d, 0xa68, 0xa6f, 0xa7b, 0xa97, 0xab6, 0xabf, 0xad9, 0xaf6, 0xafd, 0xb04,
+	0xb14, 0xb19, 0xb1f, 0xb25, 0xb2c, 0xb3a, 0xb40, 0xb48, 0xb5e, 0xb63, 0xb6a, 0xb7b,
+	0xb8b, 0xb93, 0xba6, 0xbb8, 0xbc9, 0xbdf, 0xbef, 0xbff, 0xc09, 0xc21, 0xc2a, 0xc38,
+	0xc43, 0xc52, 0xc65, 0xc70, 0xc7b, 0xc91, 0xc9b, 0xc9f, 0xcac, 0xcbc, 0xcc2, 0xcd2,
+	0xce7, 0xcf9, 0xd09,
+}
+
+const frCARegionStr = "" +
+	"Île de l'AscensionSaint-BarthélémyBélarusMicronésieGéorgie du Sud et les îles Sa" +
+	"ndwich du SudTerritoire britannique de l'océan IndienSaint-MartinTerritoire pale" +
+	"stinienRéunionÎles Turks et CaïquesÎles éloignées des États-UnisAustralie et Nou" +
+	"velle-Zélande"
+
+var frCARegionIdx = []uint16{ // 280 entries
+	0x0, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13,
+	0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13, 0x13,
+	0x13, 0x13, 0x13, 0x13, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25,
+	0x25, 0x25, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d,
+	0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d,
+	0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d,
+	0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x2d, 0x38, 0x38, 0x38, 0x38, 0x38,
+	0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38, 0x38,
+	0x64, 0x64, 0x64, 0x64, 0x64, 0x64, 0x64, 0x64, 0x64, 0x64, 0x64, 0x64,
+	0x64, 0x64, 0x64, 0x64, 0x64, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d,
+	0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d,
+	0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d, 0x8d,
+	0x8d, 0x8d, 0x8d, 0x8d, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99,
+	0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99,
+	0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99,
+	0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0xaf, 0xaf,
+	0xaf, 0xaf, 0xaf, 0xaf, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7,
+	0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7,
+	0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xb7, 0xce, 0xce, 0xce, 0xce, 0xce,
+	0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce, 0xce,
+	0xce, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef,
+	0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef,
+	0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef, 0xef,
+	0xef, 0xef, 0xef, 0x10d,
+}
+
+const guRegionStr = "" +
+	"એસેન્શન આઇલેન્ડઍંડોરાયુનાઇટેડ અરબ એમિરેટ્સઅફઘાનિસ્તાનએન્ટીગુઆ અને બરબુડાઍંગ્વિલા" +
+	"અલ્બેનિયાઆર્મેનિયાનેધરલેંડ્સ એંટિલેસઅંગોલાએન્ટાર્કટિકાઆર્જેન્ટીનાઅમેરીકન સમોઆઑસ્" +
+	"ટ્રિયાઑસ્ટ્રેલિયાઅરુબાએલેંડ આઇલેન્ડ્સઅઝરબૈજાનબોસ્નિયા અને હર્ઝેગોવિનાબાર્બાડોસબા" +
+	"ંગ્લાદેશબેલ્જીયમબુર્કિના ફાસોબલ્ગેરિયાબેહરીનબુરુંડીબેનિનસેંટ બાર્થલેમીબર્મુડાબ્ર" +
+	"ુનેઇબોલિવિયાકેરેબિયન નેધરલેન્ડ્ઝબ્રાઝિલબહામાસભૂટાનબૌવેત આઇલેન્ડબોત્સ્વાનાબેલારુસ" +
+	"બેલીઝકેનેડાકોકોઝ આઇલેન્ડકોંગો - કિંશાસાસેંટ્રલ આફ્રિકન રીપબ્લિકકોંગો - બ્રાઝાવિલ" +
+	"ેસ્વિટ્ઝર્લૅન્ડઆઇવરી કોસ્ટકુક આઇલેન્ડ્સચિલીકૅમરૂનચીનકોલમ્બિયાક્લિપરટન આઇલેન્ડકોસ" +
+	"્ટા રિકાક્યુબાકૅપ વર્ડેકુરાકાઓક્રિસમસ આઇલેંડસાયપ્રસચેક રીપબ્લિકજર્મનીડિએગો ગારસિ" +
+	"આજીબૌટીડેનમાર્કડોમિનિકાડોમિનિકન રીપબ્લિકઅલ્જીરિયાસ્યુટા અને મેલિલાએક્વાડોરએસ્ટોન" +
+	"િયાઇજિપ્તપશ્ચિમી સહારાએરિટ્રિયાસ્પેનઇથિઓપિયાયુરોપિયન સંઘફીનલેંડફીજીફૉકલૅંડ આઇલૅં" +
+	"ડ્સમાઇક્રોનેશિયાફૅરો આઇલેન્ડ્સફ્રાંસગેબનયુનાઇટેડ કિંગડમગ્રેનેડાજ્યોર્જીયાફ્રેંચ " +
+	"ગયાનાગ્વેર્નસેઘાનાજીબ્રાલ્ટરગ્રીનલેન્ડગેમ્બિયાગિનીગ્વાડેલોપઇક્વેટોરિયલ ગિનીગ્રીસ" +
+	"દક્ષિણ જ્યોર્જીયા અને દક્ષિણ સેન્ડવિચ આઇલેન્ડગ્વાટેમાલાગ્વામગિની-બિસાઉગયાનાહોંગક" +
+	"ોંગ સાર ચીનહર્ડ આઇલૅંડ એંડ મેકડોનાલ્ડ આઇલૅંડ્સહોન્ડુરસક્રોએશિયાહૈતિહંગેરીકૅનેરી " +
+	"આઇલેન્ડ્સઇન્ડોનેશિયાઆયર્લેંડઇઝરાઇલઇસ્લે ઓફ મૅનભારતબ્રિટિશ ઇન્ડિયન ઓશન ટેરિટરીઇરા" +
+	"કઈરાનઆઇસલેન્ડઇટાલીજર્સીજમૈકાજોર્ડનજાપાનકેન્યાકિર્ગિઝ્સ્તાનકંબોડિયાકિરિબાટીકોમોરસ" +
+	"સેંટ કિટ્સ એંડ નેવિસઉત્તર કોરિયાદક્ષિણ કોરિયાકુવૈતકેયમેન આઇલૅંડ્સકઝાકિસ્તાનલાઓસલ" +
+	"ેબનોનસેંટ લુસિયાલૈચટેંસ્ટેઇનશ્રીલંકાલાઇબેરિયાલેસોથોલિથુઆનિયાલક્ઝમબર્ગલાત્વિયાલિબ" +
+	"િયામોરોક્કોમોનાકોમોલડોવામૉન્ટેંનેગ્રોસેંટ માર્ટિનમેડાગાસ્કરમાર્શલ આઇલેન્ડ્સમેસેડ" +
+	"ોનિયામાલીમ્યાંમારમંગોલિયામકાઉ SAR ચીનઉતરીય મારિયાના આઇલેન્ડ્સમાર્ટીનીકમૌરિટાનિયા" +
+	"મોંટસેરાતમાલ્ટામોરિશિયસમાલદિવ્સમાલાવીમેક્સિકોમલેશિયામોઝામ્બિકનામિબિયાન્યુ સેલેડો" +
+	"નિયાનાઇજરનોરફૉક આઇલેન્ડનાઇજીરીયાનિકારાગુઆનેધરલેન્ડનૉર્વેનેપાળનૌરુનીયુન્યુઝીલેન્ડ" +
+	"ઓમાનપનામાપેરુફ્રેંચ પોલિનેશિયાપાપુઆ ન્યૂ ગિનીફિલિપિન્સપાકિસ્તાનપોલેંડસેંટ પીએરે " +
+	"એન્ડ મિકીલોનપીટકૈર્નપ્યુઅર્ટો રિકોપેલેસ્ટિનિયન ટેરિટરીપોર્ટુગલપલાઉપેરાગ્વેકતારઆઉ" +
+	"ટલાયિંગ ઓશનિયારીયુનિયનરોમાનિયાસર્બિયારશિયન ફેડરેશનરવાંડાસાઉદી અરેબિયાસોલોમન આઇલે" +
+	"ન્ડશેશેલ્સસુદાનસ્વીડનસિંગાપુરસેંટ હેલેનાસ્લોવેનિયાસ્વાલબર્ડ એંડ જેન મેયનસ્લોવેકિ" +
+	"યાસીએરા લેઓનસૅન મેરિનોસેનેગલસોમાલિયાસુરીનામદક્ષિણ સુદાનસાઓ ટૉમ અને પ્રિંસિપેએલ સ" +
+	"ેલ્વાડોરસિંટ માર્ટેનસીરિયાસ્વાઝિલેન્ડટ્રિસ્ટન ડે કુનાટર્ક્સ એન્ડ કૈકોસ આઇલેન્ડ્સ" +
+	"ચાડફ્રેંચ સદર્ન ટેરિટરીઝટોગોથાઇલેંડતાજીકિસ્તાનટોકેલાઉપૂર્વ તિમોરતુર્ક્મનિસ્તાનટ્" +
+	"યુનિશિયાટોંગાતુર્કસ્તાનત્રિનિડાડ અને ટોબેગોતુવાલુતાઇવાનતાંઝાનિયાયૂક્રેનયુગાંડાસં" +
+	"યુક્ત રાજ્ય આઉટલાઇંગ આયલેન્ડ્સસંયુકત રાજ્ય/ અમેરિકાઉરુગ્વેઉઝ્બેકિસ્તાનવેટિકનસેંટ" +
+	" વિંસેંટ એંડ ધ ગ્રેનેડિન્સવેનેઝુએલાબ્રિટિશ વર્જિન આઇલેન્ડયુ.એસ વર્જિન આઇલેન્ડવિય" +
+	"ેતનામવાનુઆતુવૉલિસ એંડ ફ્યુચુના આઇલેન્ડ્સસમોઆકોસોવોયેમેનમેયોટદક્ષિણ આફ્રિકાઝામ્બિ" +
+	"યાઝિમ્બાબ્વેઅજ્ઞાત પ્રદેશવિશ્વઆફ્રિકાઉતર અમેરિકાદક્ષિણ અમેરિકાઓશિઆનિયાપશ્ચિમ આફ્" +
+	"રિકામધ્ય અમેરિકાપૂર્વ અમેરિકાઉતરીય આફ્રિકામધ્ય આફ્રિકાદક્ષિણી આફ્રિકાઅમેરિકાસઉતર" +
+	"ીય અમેરિકાકેરિબિયનપૂર્વી એશિયાદક્ષિણી એશિયાદક્ષિણ- પૂર્વ એશિયાદક્ષિણી યૂરોપઓસ્ટ્" +
+	"રેલિયામેલાનેશિયામાઇક્રોનેશિયન રીજનપોલિનેશિયાએશિયામધ્ય એશિયાપશ્ચિમ એશિયાયૂરોપપૂર્" +
+	"વી યૂરોપઉતરીય યૂરોપપશ્ચિમ યૂરોપલેટિન અમેરિકા"
+
+var guRegionIdx = []uint16{ // 291 entries
+	0x0, 0x2b, 0x3d, 0x78, 0x99, 0xce, 0xe6, 0x101, 0x11c, 0x150, 0x162, 0x186,
+	0x1a7, 0x1c9, 0x1e4, 0x205, 0x214, 0x23f, 0x257, 0x29b, 0x2b6, 0x2d4, 0x2ec, 0x311,
+	0x32c, 0x33e, 0x353, 0x362, 0x38a, 0x39f, 0x3b4, 0x3cc, 0x406, 0x41b, 0x42d, 0x43c,
+	0x461, 0x47f, 0x494, 0x4a3, 0x4b5, 0x4da, 0x501, 0x545, 0x575, 0x59f, 0x5be, 0x5e3,
+	0x5ef, 0x601, 0x60a, 0x625, 0x653, 0x672, 0x684, 0x69d, 0x6b2, 0x6da, 0x6ef, 0x711,
+	0x723, 0x745, 0x757, 0x76f, 0x787, 0x7b8, 0x7d3, 0x802, 0x81a, 0x835, 0x847, 0x86c,
+	0x887, 0x896, 0x8ae, 0x8d0, 0x8e5, 0x8f1, 0x91f, 0x946, 0x96e, 0x980, 0x98c, 0x9b7,
+	0x9cf, 0x9ed, 0xa0f, 0xa2a, 0xa36, 0xa54, 0xa72, 0xa8a, 0xa96, 0xab1, 0xadf, 0xaee,
+	0xb6b, 0xb89, 0xb98, 0xbb4, 0xbc3, 0xbef, 0xc50, 0xc68, 0xc83, 0xc8f, 0xca1, 0xccf,
+	0xcf0, 0xd08, 0xd1a, 0xd3a, 0xd46, 0xd91, 0xd9d, 0xda9, 0xdc1, 0xdd0, 0xddf, 0xdee,
+	0xe00, 0xe0f, 0xe21, 0xe48, 0xe60, 0xe78, 0xe8a, 0xec0, 0xee2, 0xf07, 0xf16, 0xf41,
+	0xf5f, 0xf6b, 0xf7d, 0xf9c, 0xfc0, 0xfd8, 0xff3, 0x1005, 0x1020, 0x103b, 0x1053, 0x1065,
+	0x107d, 0x108f, 0x10a4, 0x10cb, 0x10ed, 0x110b, 0x1139, 0x1157, 0x1163, 0x117b, 0x1193, 0x11ad,
+	0x11f1, 0x120c, 0x122a, 0x1245, 0x1257, 0x126f, 0x1287, 0x1299, 0x12b1, 0x12c6, 0x12e1, 0x12f9,
+	0x1324, 0x1333, 0x135b, 0x1376, 0x1391, 0x13ac, 0x13be, 0x13cd, 0x13d9, 0x13e5, 0x1406, 0x1412,
+	0x1421, 0x142d, 0x145e, 0x1487, 0x14a2, 0x14bd, 0x14cf, 0x150e, 0x1526, 0x154e, 0x1588, 0x15a0,
+	0x15ac, 0x15c4, 0x15d0, 0x15fe, 0x1616, 0x162e, 0x1643, 0x1668, 0x167a, 0x169f, 0x16c7, 0x16dc,
+	0x16eb, 0x16fd, 0x1715, 0x1734, 0x1752, 0x178e, 0x17ac, 0x17c8, 0x17e4, 0x17f6, 0x180e, 0x1823,
+	0x1845, 0x187e, 0x18a0, 0x18c2, 0x18d4, 0x18f5, 0x1921, 0x196c, 0x1975, 0x19b0, 0x19bc, 0x19d1,
+	0x19f2, 0x1a07, 0x1a26, 0x1a50, 0x1a6e, 0x1a7d, 0x1a9b, 0x1ad3, 0x1ae5, 0x1af7, 0x1b12, 0x1b27,
+	0x1b3c, 0x1b96, 0x1bcf, 0x1be4, 0x1c08, 0x1c1a, 0x1c6c, 0x1c87, 0x1cc5, 0x1cfb, 0x1d13, 0x1d28,
+	0x1d76, 0x1d82, 0x1d94, 0x1da3, 0x1db2, 0x1dda, 0x1df2, 0x1e10, 0x1e35, 0x1e44, 0x1e59, 0x1e78,
+	0x1ea0, 0x1eb8, 0x1ee0, 0x1f02, 0x1f27, 0x1f4c, 0x1f6e, 0x1f99, 0x1fb1, 0x1fd6, 0x1fee, 0x2010,
+	0x2035, 0x2068, 0x208d, 0x20ae, 0x20cc, 0x2100, 0x211e, 0x212d, 0x2149, 0x216b, 0x217a, 0x219c,
+	0x21bb, 0x21dd, 0x2202,
+}
+
+const heRegionStr = "" +
+	"האי אסנשןאנדורהאיחוד האמירויות הערביותאפגניסטןאנטיגואה וברבודהאנגילהאלבניהארמניה" +
+	"אנטילים הולנדייםאנגולהאנטארקטיקהארגנטינהסמואה האמריקניתאוסטריהאוסטרליהארובהאיי א" +
+	"ולנדאזרבייג׳ןבוסניה והרצגובינהברבדוסבנגלדשבלגיהבורקינה פאסובולגריהבחרייןבורונדיב" +
+	"ניןסנט ברתולומיאוברמודהברונייבוליביההאיים הקריביים ההולנדייםברזילאיי בהאמהבהוטןא" +
+	"יי בובהבוטסוואנהבלארוסבליזקנדהאיי קוקס (קילינג)קונגו - קינשאסההרפובליקה של מרכז " +
+	"אפריקהקונגו - ברזאוילשווייץחוף השנהבאיי קוקצ׳ילהקמרוןסיןקולומביההאי קליפרטוןקוסט" +
+	"ה ריקהקובהכף ורדהקוראסאואיי כריסטמסקפריסיןצ׳כיהגרמניהדייגו גרסיהג׳יבוטידנמרקדומי" +
+	"ניקההרפובליקה הדומיניקניתאלג׳יריהסאוטה ומלייהאקוודוראסטוניהמצריםסהרה המערביתארית" +
+	"ריאהספרדאתיופיההאיחוד האירופיפינלנדפיג׳יאיי פוקלנדמיקרונזיהאיי פארוצרפתגאבוןהממל" +
+	"כה המאוחדתגרנדהגאורגיהגיאנה הצרפתיתגרנסיגאנהגיברלטרגרינלנדגמביהגיניאהגוואדלופגינ" +
+	"יאה המשווניתיווןג׳ורג׳יה הדרומית ואיי סנדוויץ׳ הדרומייםגואטמלהגואםגיניאה-ביסאוגי" +
+	"אנההונג קונג - מחוז מנהלי מיוחד של סיןאיי הרד ואיי מקדונלנדהונדורסקרואטיההאיטיהו" +
+	"נגריההאיים הקנרייםאינדונזיהאירלנדישראלהאי מאןהודוטריטוריה בריטית באוקיאנוס ההודי" +
+	"עיראקאיראןאיסלנדאיטליהג׳רסיג׳מייקהירדןיפןקניהקירגיזסטןקמבודיהקיריבאטיקומורוססנט " +
+	"קיטס ונוויסקוריאה הצפוניתקוריאה הדרומיתכוויתאיי קיימןקזחסטןלאוסלבנוןסנט לוסיהליכ" +
+	"טנשטייןסרי לנקהליבריהלסוטוליטאלוקסמבורגלטביהלובמרוקומונקומולדובהמונטנגרוסנט מרטי" +
+	"ןמדגסקראיי מרשלמקדוניהמאלימיאנמאר (בורמה)‎מונגוליהמקאו - מחוז מנהלי מיוחד של סין" +
+	"איי מריאנה הצפונייםמרטיניקמאוריטניהמונסראטמלטהמאוריציוסהאיים המלדיבייםמלאווימקסי" +
+	"קומלזיהמוזמביקנמיביהקלדוניה החדשהניז׳ראיי נורפוקניגריהניקרגואההולנדנורווגיהנפאלנ" +
+	"אורוניווהניו זילנדעומאןפנמהפרופולינזיה הצרפתיתפפואה גיניאה החדשהפיליפיניםפקיסטןפ" +
+	"וליןסנט פייר ומיקלוןאיי פיטקרןפורטו ריקוהשטחים הפלסטינייםפורטוגלפאלאופרגוואיקטאר" +
+	"אוקיאניה פרושהראוניוןרומניהסרביהרוסיהרואנדהערב הסעודיתאיי שלמהאיי סיישלסודןשוודי" +
+	"הסינגפורסנט הלנהסלובניהסוולבארד ויאן מאייןסלובקיהסיירה לאונהסן מרינוסנגלסומליהסו" +
+	"רינםדרום סודןסאו טומה ופרינסיפהאל סלבדורסינט מארטןסוריהסווזילנדטריסטן דה קונהאיי" +
+	" טורקס וקאיקוסצ׳אדטריטוריות דרומיות של צרפתטוגותאילנדטג׳יקיסטןטוקלאוטימור לסטהטו" +
+	"רקמניסטןתוניסיהטונגהטורקיהטרינידד וטובגוטובלוטייוואןטנזניהאוקראינהאוגנדהאיים לחו" +
+	"ף ארצות הבריתארצות הבריתאורוגוואיאוזבקיסטןהוותיקןסנט וינסנט והגרנדיניםונצואלהאיי" +
+	" הבתולה הבריטייםאיי הבתולה האמריקנייםוייטנאםונואטואיי ווליס ופוטונהסמואהקוסובותי" +
+	"מןמאיוטדרום אפריקהזמביהזימבאבווהאזור לא ידועהעולםאפריקהצפון אמריקהדרום אמריקהאוק" +
+	"יאניהמערב אפריקהמרכז אמריקהמזרח אפריקהצפון אפריקהמרכז אפריקהדרום יבשת אפריקהאמרי" +
+	"קהאמריקה הצפוניתקריבייםמזרח אסיהדרום אסיהדרום־מזרח אסיהדרום אירופהאוסטרלאסיהמלנז" +
+	"יהאזור מיקרונזיהפולינזיהאסיהמרכז אסיהמערב אסיהאירופהמזרח אירופהצפון אירופהמערב א" +
+	"ירופהאמריקה הלטינית"
+
+var heRegionIdx = []uint16{ // 291 entries
+	0x0, 0x11, 0x1d, 0x49, 0x59, 0x78, 0x84, 0x90, 0x9c, 0xbb, 0xc7, 0xdb,
+	0xeb, 0x108, 0x116, 0x126, 0x130, 0x141, 0x153, 0x174, 0x180, 0x18c, 0x196, 0x1ad,
+	0x1bb, 0x1c7, 0x1d5, 0x1dd, 0x1f8, 0x204, 0x210, 0x21e, 0x24c, 0x256, 0x267, 0x271,
+	0x280, 0x292, 0x29e, 0x2a6, 0x2ae, 0x2cc, 0x2e7, 0x314, 0x32f, 0x33b, 0x34c, 0x359,
+	0x363, 0x36d, 0x373, 0x383, 0x39a, 0x3ad, 0x3b5, 0x3c2, 0x3d0, 0x3e5, 0x3f3, 0x3fd,
+	0x409, 0x41e, 0x42c, 0x436, 0x446, 0x46f, 0x47f, 0x496, 0x4a4, 0x4b2, 0x4bc, 0x4d3,
+	0x4e3, 0x4eb, 0x4f9, 0x514, 0x520, 0x52a, 0x53d, 0x54f, 0x55e, 0x566, 0x570, 0x58b,
+	0x595, 0x5a3, 0x5bc, 0x5c6, 0x5ce, 0x5dc, 0x5ea, 0x5f4, 0x600, 0x610, 0x62d, 0x635,
+	0x67f, 0x68d, 0x695, 0x6ac, 0x6b6, 0x6f4, 0x71b, 0x729, 0x737, 0x741, 0x74f, 0x768,
+	0x77a, 0x786, 0x790, 0x79d, 0x7a5, 0x7e0, 0x7ea, 0x7f4, 0x800, 0x80c, 0x816, 0x824,
+	0x82c, 0x832, 0x83a, 0x84c, 0x85a, 0x86a, 0x878, 0x894, 0x8af, 0x8ca, 0x8d4, 0x8e5,
+	0x8f1, 0x8f9, 0x903, 0x914, 0x928, 0x937, 0x943, 0x94d, 0x955, 0x967, 0x971, 0x977,
+	0x981, 0x98b, 0x999, 0x9a9, 0x9ba, 0x9c6, 0x9d5, 0x9e3, 0x9eb, 0xa09, 0xa19, 0xa4e,
+	0xa72, 0xa80, 0xa92, 0xaa0, 0xaa8, 0xaba, 0xad7, 0xae3, 0xaef, 0xaf9, 0xb07, 0xb13,
+	0xb2c, 0xb36, 0xb49, 0xb55, 0xb65, 0xb6f, 0xb7f, 0xb87, 0xb91, 0xb9b, 0xbac, 0xbb6,
+	0xbbe, 0xbc4, 0xbe3, 0xc05, 0xc17, 0xc23, 0xc2d, 0xc4b, 0xc5e, 0xc71, 0xc92, 0xca0,
+	0xcaa, 0xcb8, 0xcc0, 0xcdb, 0xce9, 0xcf5, 0xcff, 0xd09, 0xd15, 0xd2a, 0xd39, 0xd4a,
+	0xd52, 0xd5e, 0xd6c, 0xd7b, 0xd89, 0xdad, 0xdbb, 0xdd0, 0xddf, 0xde7, 0xdf3, 0xdff,
+	0xe10, 0xe32, 0xe43, 0xe56, 0xe60, 0xe70, 0xe8a, 0xeaa, 0xeb2, 0xee1, 0xee9, 0xef5,
+	0xf07, 0xf13, 0xf26, 0xf3a, 0xf48, 0xf52, 0xf5e, 0xf79, 0xf83, 0xf91, 0xf9d, 0xfad,
+	0xfb9, 0xfe0, 0xff5, 0x1007, 0x1019, 0x1027, 0x104f, 0x105d, 0x1081, 0x10a9, 0x10b7, 0x10c3,
+	0x10e3, 0x10ed, 0x10f9, 0x1101, 0x110b, 0x1120, 0x112a, 0x113c, 0x1152, 0x115c, 0x1168, 0x117d,
+	0x1192, 0x11a2, 0x11b7, 0x11cc, 0x11e1, 0x11f6, 0x120b, 0x1229, 0x1235, 0x1250, 0x125e, 0x126f,
+	0x1280, 0x129b, 0x12b0, 0x12c4, 0x12d0, 0x12eb, 0x12fb, 0x1303, 0x1314, 0x1325, 0x1331, 0x1346,
+	0x135b, 0x1370, 0x138b,
+}
+
+const hiRegionStr = "" +
+	"असेंशन द्वीपएंडोरासंयुक्त अरब अमीरातअफ़गानिस्तानएंटिगुआ और बरबुडाएंग्विलाअल्बानि" +
+	"याआर्मेनियानीदरलैंडी ऐंटीलअंगोलाअंटार्कटिकाअर्जेंटीनाअमेरिकी समोआऑस्ट्रियाऑस्ट्र" +
+	"ेलियाअरूबाएलैंड द्वीपसमूहअज़रबैजानबोस्निया और हर्ज़ेगोविनाबारबाडोसबांग्लादेशबेल्" +
+	"जियमबुर्किना फ़ासोबुल्गारियाबहरीनबुरुंडीबेनिनसेंट बार्थेलेमीबरमूडाब्रूनेईबोलीविय" +
+	"ाकैरिबीयाई नीदरलैंडब्राज़ीलबहामासभूटानबोवेत द्वीपबोत्स्वानाबेलारूसबेलीज़कनाडाकोक" +
+	"ोस (कीलिंग) द्वीपसमूहकोंगो – किंशासामध्य अफ़्रीकी गणराज्यकोंगो – ब्राज़ाविलस्विट" +
+	"्ज़रलैंडकोट डी आइवरकुक द्वीपसमूहचिलीकैमरूनचीनकोलंबियाक्लिपर्टन द्वीपकोस्टारिकाक्" +
+	"यूबाकेप वर्डक्यूरासाओक्रिसमस द्वीपसाइप्रसचेक गणराज्यजर्मनीडिएगो गार्सियाजिबूतीडे" +
+	"नमार्कडोमिनिकाडोमिनिकन गणराज्यअल्जीरियासेउटा और मेलिलाइक्वाडोरएस्टोनियामिस्रपश्च" +
+	"िमी सहाराइरिट्रियास्पेनइथियोपियायूरोपीय संघफ़िनलैंडफ़िजीफ़ॉकलैंड द्वीपसमूहमाइक्र" +
+	"ोनेशियाफ़ेरो द्वीपसमूहफ़्रांसगैबॉनयूनाइटेड किंगडमग्रेनाडाजॉर्जियाफ़्रेंच गुयानाग" +
+	"र्नसीघानाजिब्राल्टरग्रीनलैंडगाम्बियागिनीग्वाडेलूपइक्वेटोरियल गिनीयूनानदक्षिण जॉर" +
+	"्जिया और दक्षिण सैंडविच द्वीपसमूहग्वाटेमालागुआमगिनी-बिसाउगुयानाहाँग काँग (चीन वि" +
+	"शेष प्रशासनिक क्षेत्र)हर्ड द्वीप और मैकडोनॉल्ड द्वीपसमूहहोंडूरासक्रोएशियाहैतीहंग" +
+	"रीकैनेरी द्वीपसमूहइंडोनेशियाआयरलैंडइसराइलआइल ऑफ़ मैनभारतब्रिटिश हिंद महासागरीय क" +
+	"्षेत्रइराकईरानआइसलैंडइटलीजर्सीजमैकाजॉर्डनजापानकेन्याकिर्गिज़स्तानकंबोडियाकिरिबात" +
+	"ीकोमोरोससेंट किट्स और नेविसउत्तर कोरियादक्षिण कोरियाकुवैतकेमैन द्वीपसमूहकज़ाखस्त" +
+	"ानलाओसलेबनानसेंट लूसियालिचेंस्टीनश्रीलंकालाइबेरियालेसोथोलिथुआनियालग्ज़मबर्गलातवि" +
+	"यालीबियामोरक्कोमोनाकोमॉल्डोवामोंटेनेग्रोसेंट मार्टिनमेडागास्करमार्शल द्वीपसमूहमै" +
+	"सिडोनियामालीम्यांमार (बर्मा)मंगोलियामकाओ (चीन विशेष प्रशासनिक क्षेत्र)उत्तरी मार" +
+	"ियाना द्वीपसमूहमार्टीनिकमॉरिटानियामोंटसेरातमाल्टामॉरिशसमालदीवमलावीमैक्सिकोमलेशिय" +
+	"ामोज़ांबिकनामीबियान्यू कैलेडोनियानाइजरनॉरफ़ॉक द्वीपनाइजीरियानिकारागुआनीदरलैंडनॉर" +
+	"्वेनेपालनाउरुनीयून्यूज़ीलैंडओमानपनामापेरूफ़्रेंच पोलिनेशियापापुआ न्यू गिनीफ़िलिप" +
+	"ींसपाकिस्तानपोलैंडसेंट पिएरे और मिक्वेलानपिटकैर्न द्वीपसमूहपोर्टो रिकोफ़िलिस्तीन" +
+	"ी क्षेत्रपुर्तगालपलाऊपेराग्वेक़तरआउटलाइंग ओशिनियारियूनियनरोमानियासर्बियारूसरवांड" +
+	"ासऊदी अरबसोलोमन द्वीपसमूहसेशेल्ससूडानस्वीडनसिंगापुरसेंट हेलेनास्लोवेनियास्वालबार" +
+	"्ड और जान मायेनस्लोवाकियासिएरा लियोनसैन मेरीनोसेनेगलसोमालियासूरीनामदक्षिण सूडानस" +
+	"ाओ टोम और प्रिंसिपेअल सल्वाडोरसिंट मार्टिनसीरियास्वाज़ीलैंडत्रिस्टान डा कुनातुर्" +
+	"क और कैकोज़ द्वीपसमूहचाडफ़्रांसीसी दक्षिणी क्षेत्रटोगोथाईलैंडताजिकिस्तानतोकेलाउत" +
+	"िमोर-लेस्ततुर्कमेनिस्तानट्यूनीशियाटोंगातुर्कीत्रिनिडाड और टोबैगोतुवालूताइवानतंज़" +
+	"ानियायूक्रेनयुगांडायू.एस. आउटलाइंग द्वीपसमूहसंयुक्त राज्यउरूग्वेउज़्बेकिस्तानवेट" +
+	"िकन सिटीसेंट विंसेंट एंड ग्रेनाडाइंसवेनेज़ुएलाब्रिटिश वर्जिन द्वीपसमूहयू.एस. वर्" +
+	"जिन द्वीपसमूहवियतनामवनुआतूवालिस और फ़्यूचूनासमोआकोसोवोयमनमायोतेदक्षिण अफ़्रीकाज़" +
+	"ाम्बियाज़िम्बाब्वेअज्ञात क्षेत्रविश्वअफ़्रीकाउत्तर अमेरिकादक्षिण अमेरिकाओशिआनिया" +
+	"पश्चिमी अफ़्रीकामध्य अमेरिकापूर्वी अफ़्रीकाउत्तरी अफ़्रीकामध्य अफ़्रीकादक्षिणी अ" +
+	"फ़्रीकाअमेरिकाज़उत्तरी अमेरिकाकैरिबीयनपूर्वी एशियादक्षिणी एशियादक्षिण-पूर्व एशिय" +
+	"ादक्षिणी यूरोपऑस्ट्रेलेशियामेलानेशियामाइक्रोनेशियाई क्षेत्रपोलीनेशियाएशियामध्य ए" +
+	"शियापश्चिमी एशियायूरोपपूर्वी यूरोपउत्तरी यूरोपपश्चिमी यूरोपलैटिन अमेरिका"
+
+var hiRegionIdx = []uint16{ // 291 entries
+	0x0, 0x22, 0x34, 0x66, 0x8a, 0xb9, 0xd1, 0xec, 0x107, 0x132, 0x144, 0x165,
+	0x183, 0x1a5, 0x1c0, 0x1e1, 0x1f0, 0x21b, 0x236, 0x27a, 0x292, 0x2b0, 0x2c8, 0x2f0,
+	0x30e, 0x31d, 0x332, 0x341, 0x36c, 0x37e, 0x393, 0x3ab, 0x3df, 0x3f7, 0x409, 0x418,
+	0x437, 0x455, 0x46a, 0x47c, 0x48b, 0x4cb, 0x4f4, 0x52f, 0x561, 0x588, 0x5a5, 0x5ca,
+	0x5d6, 0x5e8, 0x5f1, 0x609, 0x634, 0x652, 0x664, 0x67a, 0x695, 0x6ba, 0x6cf, 0x6ee,
+	0x700, 0x728, 0x73a, 0x752, 0x76a, 0x798, 0x7b3, 0x7dc, 0x7f4, 0x80f, 0x81e, 0x843,
+	0x85e, 0x86d, 0x888, 0x8a7, 0x8bf, 0x8ce, 0x902, 0x929, 0x954, 0x969, 0x978, 0x9a3,
+	0x9bb, 0x9d3, 0x9fb, 0xa0d, 0xa19, 0xa37, 0xa52, 0xa6a, 0xa76, 0xa91, 0xabf, 0xace,
+	0xb45, 0xb63, 0xb6f, 0xb8b, 0xb9d, 0xc04, 0xc62, 0xc7a, 0xc95, 0xca1, 0xcb0, 0xcde,
+	0xcfc, 0xd11, 0xd23, 0xd40, 0xd4c, 0xda0, 0xdac, 0xdb8, 0xdcd, 0xdd9, 0xde8, 0xdf7,
+	0xe09, 0xe18, 0xe2a, 0xe51, 0xe69, 0xe81, 0xe96, 0xec9, 0xeeb, 0xf10, 0xf1f, 0xf4a,
+	0xf68, 0xf74, 0xf86, 0xfa5, 0xfc3, 0xfdb, 0xff6, 0x1008, 0x1023, 0x1041, 0x1056, 0x1068,
+	0x107d, 0x108f, 0x10a7, 0x10c8, 0x10ea, 0x1108, 0x1136, 0x1154, 0x1160, 0x118a, 0x11a2, 0x11fc,
+	0x1243, 0x125e, 0x127c, 0x1297, 0x12a9, 0x12bb, 0x12cd, 0x12dc, 0x12f4, 0x1309, 0x1324, 0x133c,
+	0x1367, 0x1376, 0x139b, 0x13b6, 0x13d1, 0x13e9, 0x13fb, 0x140a, 0x1419, 0x1425, 0x1446, 0x1452,
+	0x1461, 0x146d, 0x14a1, 0x14ca, 0x14e5, 0x1500, 0x1512, 0x1551, 0x1585, 0x15a4, 0x15db, 0x15f3,
+	0x15ff, 0x1617, 0x1623, 0x1651, 0x1669, 0x1681, 0x1696, 0x169f, 0x16b1, 0x16c7, 0x16f5, 0x170a,
+	0x1719, 0x172b, 0x1743, 0x1762, 0x1780, 0x17bf, 0x17dd, 0x17fc, 0x1818, 0x182a, 0x1842, 0x1857,
+	0x1879, 0x18af, 0x18ce, 0x18f0, 0x1902, 0x1923, 0x1952, 0x1997, 0x19a0, 0x19ea, 0x19f6, 0x1a0b,
+	0x1a2c, 0x1a41, 0x1a60, 0x1a8a, 0x1aa8, 0x1ab7, 0x1ac9, 0x1afe, 0x1b10, 0x1b22, 0x1b3d, 0x1b52,
+	0x1b67, 0x1baa, 0x1bcf, 0x1be4, 0x1c0b, 0x1c2a, 0x1c78, 0x1c96, 0x1cda, 0x1d17, 0x1d2c, 0x1d3e,
+	0x1d70, 0x1d7c, 0x1d8e, 0x1d97, 0x1da9, 0x1dd4, 0x1def, 0x1e10, 0x1e38, 0x1e47, 0x1e5f, 0x1e84,
+	0x1eac, 0x1ec4, 0x1ef2, 0x1f14, 0x1f3f, 0x1f6a, 0x1f8f, 0x1fbd, 0x1fd8, 0x2000, 0x2018, 0x203a,
+	0x205f, 0x2091, 0x20b6, 0x20dd, 0x20fb, 0x213b, 0x2159, 0x2168, 0x2184, 0x21a9, 0x21b8, 0x21da,
+	0x21fc, 0x2221, 0x2246,
+}
+
+const hrRegionStr = "" +
+	"Otok AscensionAndoraUjedinjeni Arapski EmiratiAfganistanAntigua i BarbudaAngvila" +
+	"AlbanijaArmenijaNizozemski AntiliAngolaAntarktikaArgentinaAmerička SamoaAustrija" +
+	"AustralijaArubaOtoci AlandAzerbajdžanBosna i HercegovinaBarbadosBangladešBelgija" +
+	"Burkina FasoBugarskaBahreinBurundiBeninSveti BartolomejBermudaBrunejBolivijaKari" +
+	"pski otoci NizozemskeBrazilBahamiButanOtok BouvetBocvanaBjelorusijaBelizeKanadaK" +
+	"okosovi (Keeling) OtociKongo (DRK)Srednjoafrička RepublikaKongo - BrazzavilleŠvi" +
+	"carskaObala BjelokostiCookovi OtociČileKamerunKinaKolumbijaOtok ClippertonKostar" +
+	"ikaKubaZelenortska RepublikaCuraçaoBožićni OtokCiparČeška RepublikaNjemačkaDiego" +
+	" GarciaDžibutiDanskaDominikaDominikanska RepublikaAlžirCeuta i MelillaEkvadorEst" +
+	"onijaEgipatZapadna SaharaEritrejaŠpanjolskaEtiopijaEuropska UnijaFinskaFidžiFalk" +
+	"landski OtociMikronezijaFarski OtociFrancuskaGabonVelika BritanijaGrenadaGruzija" +
+	"Francuska GvajanaGuernseyGanaGibraltarGrenlandGambijaGvinejaGuadalupaEkvatorska " +
+	"GvinejaGrčkaJužna Georgija i Južni Sendvički OtociGvatemalaGuamGvineja BisauGvaj" +
+	"anaHong Kong PUP KinaOtoci Heard i McDonaldHondurasHrvatskaHaitiMađarskaKanarski" +
+	" OtociIndonezijaIrskaIzraelOtok ManIndijaBritanski Indijskooceanski TeritorijIra" +
+	"kIranIslandItalijaJerseyJamajkaJordanJapanKenijaKirgistanKambodžaKiribatiKomoriS" +
+	"veti Kristofor i NevisSjeverna KorejaJužna KorejaKuvajtKajmanski OtociKazahstanL" +
+	"aosLibanonSveta LucijaLihtenštajnŠri LankaLiberijaLesotoLitvaLuksemburgLatvijaLi" +
+	"bijaMarokoMonakoMoldavijaCrna GoraSveti MartinMadagaskarMaršalovi OtociMakedonij" +
+	"aMaliMijanmar (Burma)MongolijaMakao PUP KinaSjeverni Marijanski OtociMartiniqueM" +
+	"auritanijaMontserratMaltaMauricijusMaldiviMalaviMeksikoMalezijaMozambikNamibijaN" +
+	"ova KaledonijaNigerOtok NorfolkNigerijaNikaragvaNizozemskaNorveškaNepalNauruNiue" +
+	"Novi ZelandOmanPanamaPeruFrancuska PolinezijaPapua Nova GvinejaFilipiniPakistanP" +
+	"oljskaSveti Petar i MikelonOtoci PitcairnPortorikoPalestinsko PodručjePortugalPa" +
+	"lauParagvajKatarOstala oceanijaReunionRumunjskaSrbijaRusijaRuandaSaudijska Arabi" +
+	"jaSalomonski OtociSejšeliSudanŠvedskaSingapurSveta HelenaSlovenijaSvalbard i Jan" +
+	" MayenSlovačkaSijera LeoneSan MarinoSenegalSomalijaSurinamJužni SudanSveti Toma " +
+	"i PrincipSalvadorSint MaartenSirijaSvaziTristan da CunhaOtoci Turks i CaicosČadF" +
+	"rancuske Južne i Antarktičke ZemljeTogoTajlandTadžikistanTokelauIstočni TimorTur" +
+	"kmenistanTunisTongaTurskaTrinidad i TobagoTuvaluTajvanTanzanijaUkrajinaUgandaMal" +
+	"i udaljeni otoci SAD-aSjedinjene Američke DržaveUrugvajUzbekistanSveta StolicaSv" +
+	"eti Vincent i GrenadiniVenezuelaBritanski Djevičanski OtociAmerički Djevičanski " +
+	"OtociVijetnamVanuatuWallis i FutunaSamoaKosovoJemenMayotteJužnoafrička Republika" +
+	"ZambijaZimbabvenepoznato područjeSvijetAfrikaSjevernoamerički kontinentJužna Ame" +
+	"rikaOceanijaZapadna AfrikaCentralna AmerikaIstočna AfrikaSjeverna AfrikaSredišnj" +
+	"a AfrikaJužna AfrikaAmerikeSjeverna AmerikaKaribiIstočna AzijaJužna AzijaJugoist" +
+	"očna AzijaJužna EuropaAustralazijaMelanezijaMikronezki oblastPolinezijaAzijaSred" +
+	"nja AzijaZapadna AzijaEuropaIstočna EuropaSjeverna EuropaZapadna EuropaLatinska " +
+	"Amerika"
+
+var hrRegionIdx = []uint16{ // 291 entries
+	0x0, 0xe, 0x14, 0x2e, 0x38, 0x49, 0x50, 0x58, 0x60, 0x71, 0x77, 0x81,
+	0x8a, 0x99, 0xa1, 0xab, 0xb0, 0xbb, 0xc7, 0xda, 0xe2, 0xec, 0xf3, 0xff,
+	0x107, 0x10e, 0x115, 0x11a, 0x12a, 0x131, 0x137, 0x13f, 0x158, 0x15e, 0x164, 0x169,
+	0x174, 0x17b, 0x186, 0x18c, 0x192, 0x1aa, 0x1b5, 0x1ce, 0x1e1, 0x1eb, 0x1fb, 0x208,
+	0x20d, 0x214, 0x218, 0x221, 0x230, 0x239, 0x23d, 0x252, 0x25a, 0x268, 0x26d, 0x27e,
+	0x287, 0x293, 0x29b, 0x2a1, 0x2a9, 0x2bf, 0x2c5, 0x2d4, 0x2db, 0x2e3, 0x2e9, 0x2f7,
+	0x2ff, 0x30a, 0x312, 0x320, 0x326, 0x32c, 0x33d, 0x348, 0x354, 0x35d, 0x362, 0x372,
+	0x379, 0x380, 0x391, 0x399, 0x39d, 0x3a6, 0x3ae, 0x3b5, 0x3bc, 0x3c5, 0x3d7, 0x3dd,
+	0x406, 0x40f, 0x413, 0x420, 0x427, 0x439, 0x44f, 0x457, 0x45f, 0x464, 0x46d, 0x47b,
+	0x485, 0x48a, 0x490, 0x498, 0x49e, 0x4c2, 0x4c6, 0x4ca, 0x4d0, 0x4d7, 0x4dd, 0x4e4,
+	0x4ea, 0x4ef, 0x4f5, 0x4fe, 0x507, 0x50f, 0x515, 0x52c, 0x53b, 0x548, 0x54e, 0x55d,
+	0x566, 0x56a, 0x571, 0x57d, 0x589, 0x593, 0x59b, 0x5a1, 0x5a6, 0x5b0, 0x5b7, 0x5bd,
+	0x5c3, 0x5c9, 0x5d2, 0x5db, 0x5e7, 0x5f1, 0x601, 0x60b, 0x60f, 0x61f, 0x628, 0x636,
+	0x64f, 0x659, 0x664, 0x66e, 0x673, 0x67d, 0x684, 0x68a, 0x691, 0x699, 0x6a1, 0x6a9,
+	0x6b8, 0x6bd, 0x6c9, 0x6d1, 0x6da, 0x6e4, 0x6ed, 0x6f2, 0x6f7, 0x6fb, 0x706, 0x70a,
+	0x710, 0x714, 0x728, 0x73a, 0x742, 0x74a, 0x751, 0x766, 0x774, 0x77d, 0x792, 0x79a,
+	0x79f, 0x7a7, 0x7ac, 0x7bb, 0x7c2, 0x7cb, 0x7d1, 0x7d7, 0x7dd, 0x7ee, 0x7fe, 0x806,
+	0x80b, 0x813, 0x81b, 0x827, 0x830, 0x844, 0x84d, 0x859, 0x863, 0x86a, 0x872, 0x879,
+	0x885, 0x899, 0x8a1, 0x8ad, 0x8b3, 0x8b8, 0x8c8, 0x8dc, 0x8e0, 0x906, 0x90a, 0x911,
+	0x91d, 0x924, 0x932, 0x93e, 0x943, 0x948, 0x94e, 0x95f, 0x965, 0x96b, 0x974, 0x97c,
+	0x982, 0x99b, 0x9b7, 0x9be, 0x9c8, 0x9d5, 0x9ee, 0x9f7, 0xa13, 0xa2f, 0xa37, 0xa3e,
+	0xa4d, 0xa52, 0xa58, 0xa5d, 0xa64, 0xa7c, 0xa83, 0xa8b, 0xa9e, 0xaa4, 0xaaa, 0xac5,
+	0xad3, 0xadb, 0xae9, 0xafa, 0xb09, 0xb18, 0xb29, 0xb36, 0xb3d, 0xb4d, 0xb53, 0xb61,
+	0xb6d, 0xb7f, 0xb8c, 0xb98, 0xba2, 0xbb3, 0xbbd, 0xbc2, 0xbcf, 0xbdc, 0xbe2, 0xbf1,
+	0xc00, 0xc0e, 0xc1e,
+}
+
+const huRegionStr = "" +
+	"Ascension-szigetAndorraEgyesült Arab EmirátusAfganisztánAntigua és BarbudaAnguil" +
+	"laAlbániaÖrményországHolland AntillákAngolaAntarktiszArgentínaAmerikai SzamoaAus" +
+	"ztriaAusztráliaArubaÅland-szigetekAzerbajdzsánBosznia-HercegovinaBarbadosBanglad" +
+	"esBelgiumBurkina FasoBulgáriaBahreinBurundiBeninSaint BarthélemyBermudaBruneiBol" +
+	"íviaHolland Karib-térségBrazíliaBahama-szigetekBhutánBouvet-szigetBotswanaFehéro" +
+	"roszországBelizeKanadaKókusz-szigetekKongó - KinshasaKözép-afrikai KöztársaságKo" +
+	"ngó - BrazzavilleSvájcElefántcsontpartCook-szigetekChileKamerunKínaKolumbiaClipp" +
+	"erton-szigetCosta RicaKubaZöld-foki KöztársaságCuraçaoKarácsony-szigetCiprusCseh" +
+	"országNémetországDiego GarciaDzsibutiDániaDominikaDominikai KöztársaságAlgériaCe" +
+	"uta és MelillaEcuadorÉsztországEgyiptomNyugat-SzaharaEritreaSpanyolországEtiópia" +
+	"Európai UnióFinnországFidzsi-szigetekFalkland-szigetekMikronéziaFeröer-szigetekF" +
+	"ranciaországGabonEgyesült KirályságGrenadaGrúziaFrancia GuyanaGuernseyGhánaGibra" +
+	"ltárGrönlandGambiaGuineaGuadeloupeEgyenlítői-GuineaGörögországDéli-Georgia és Dé" +
+	"li-Sandwich-szigetekGuatemalaGuamGuinea-BissauGuyanaHongkong SAR KínaHeard-szige" +
+	"t és McDonald-szigetekHondurasHorvátországHaitiMagyarországKanári-szigetekIndoné" +
+	"ziaÍrországIzraelMan-szigetIndiaBrit Indiai-óceáni TerületIrakIránIzlandOlaszors" +
+	"zágJerseyJamaicaJordániaJapánKenyaKirgizisztánKambodzsaKiribatiComore-szigetekSa" +
+	"int Kitts és NevisÉszak-KoreaDél-KoreaKuvaitKajmán-szigetekKazahsztánLaoszLibano" +
+	"nSanta LuciaLiechtensteinSrí LankaLibériaLesothoLitvániaLuxemburgLettországLíbia" +
+	"MarokkóMonacoMoldovaMontenegróSaint MartinMadagaszkárMarshall-szigetekMacedóniaM" +
+	"aliMianmar (Burma)MongóliaMakaó SAR KínaÉszaki Mariana-szigetekMartiniqueMauritá" +
+	"niaMontserratMáltaMauritiusMaldív-szigetekMalawiMexikóMalajziaMozambikNamíbiaÚj-" +
+	"KaledóniaNigerNorfolk-szigetNigériaNicaraguaHollandiaNorvégiaNepálNauruNiueÚj-Zé" +
+	"landOmánPanamaPeruFrancia PolinéziaPápua Új-GuineaFülöp-szigetekPakisztánLengyel" +
+	"országSaint Pierre és MiquelonPitcairn-szigetekPuerto RicoPalesztin TerületPortu" +
+	"gáliaPalauParaguayKatarKülső-ÓceániaReunionRomániaSzerbiaOroszországRuandaSzaúd-" +
+	"ArábiaSalamon-szigetekSeychelle-szigetekSzudánSvédországSzingapúrSzent IlonaSzlo" +
+	"véniaSpitzbergák és Jan Mayen-szigetekSzlovákiaSierra LeoneSan MarinoSzenegálSzo" +
+	"máliaSurinameDél-SzudánSao Tomé és PríncipeSalvadorSint MaartenSzíriaSzváziföldT" +
+	"ristan da CunhaTurks- és Caicos-szigetekCsádFrancia Déli TerületekTogoThaiföldTá" +
+	"dzsikisztánTokelauKelet-TimorTürkmenisztánTunéziaTongaTörökországTrinidad és Tob" +
+	"agoTuvaluTajvanTanzániaUkrajnaUgandaAmerikai Csendes-óceáni SzigetekEgyesült Áll" +
+	"amokUruguayÜzbegisztánVatikánSaint Vincent és a Grenadine-szigetekVenezuelaBrit " +
+	"Virgin-szigetekAmerikai Virgin-szigetekVietnamVanuatuWallis- és Futuna-szigetekS" +
+	"zamoaKoszovóJemenMayotteDél-afrikai KöztársaságZambiaZimbabweIsmeretlen körzetVi" +
+	"lágAfrikaÉszak-AmerikaDél-AmerikaÓceániaNyugat-AfrikaKözép-AmerikaKelet-AfrikaÉs" +
+	"zak-AfrikaKözép-AfrikaAfrika déli részeAmerikaAmerika északi részeKarib-térségKe" +
+	"let-ÁzsiaDél-ÁzsiaDélkelet-ÁzsiaDél-EurópaAusztrálázsiaMelanéziaMikronéziai régi" +
+	"óPolinéziaÁzsiaKözép-ÁzsiaNyugat-ÁzsiaEurópaKelet-EurópaÉszak-EurópaNyugat-Európ" +
+	"aLatin-Amerika"
+
+var huRegionIdx = []uint16{ // 291 entries
+	0x0, 0x10, 0x17, 0x2f, 0x3b, 0x4e, 0x56, 0x5e, 0x6d, 0x7e, 0x84, 0x8e,
+	0x98, 0xa7, 0xaf, 0xba, 0xbf, 0xce, 0xdb, 0xee, 0xf6, 0xff, 0x106, 0x112,
+	0x11b, 0x122, 0x129, 0x12e, 0x13f, 0x146, 0x14c, 0x154, 0x16a, 0x173, 0x182, 0x189,
+	0x196, 0x19e, 0x1b0, 0x1b6, 0x1bc, 0x1cc, 0x1dd, 0x1fb, 0x20f, 0x215, 0x226, 0x233,
+	0x238, 0x23f, 0x244, 0x24c, 0x25d, 0x267, 0x26b, 0x284, 0x28c, 0x29d, 0x2a3, 0x2ae,
+	0x2bb, 0x2c7, 0x2cf, 0x2d5, 0x2dd, 0x2f5, 0x2fd, 0x30e, 0x315, 0x321, 0x329, 0x337,
+	0x33e, 0x34c, 0x354, 0x362, 0x36d, 0x37c, 0x38d, 0x398, 0x3a8, 0x3b6, 0x3bb, 0x3d0,
+	0x3d7, 0x3de, 0x3ec, 0x3f4, 0x3fa, 0x404, 0x40d, 0x413, 0x419, 0x423, 0x436, 0x444,
+	0x46d, 0x476, 0x47a, 0x487, 0x48d, 0x49f, 0x4c1, 0x4c9, 0x4d7, 0x4dc, 0x4e9, 0x4f9,
+	0x503, 0x50d, 0x513, 0x51d, 0x522, 0x53f, 0x543, 0x548, 0x54e, 0x55a, 0x560, 0x567,
+	0x570, 0x576, 0x57b, 0x588, 0x591, 0x599, 0x5a8, 0x5bd, 0x5c9, 0x5d3, 0x5d9, 0x5e9,
+	0x5f4, 0x5f9, 0x600, 0x60b, 0x618, 0x622, 0x62a, 0x631, 0x63a, 0x643, 0x64e, 0x654,
+	0x65c, 0x662, 0x669, 0x674, 0x680, 0x68c, 0x69d, 0x6a7, 0x6ab, 0x6ba, 0x6c3, 0x6d3,
+	0x6eb, 0x6f5, 0x700, 0x70a, 0x710, 0x719, 0x729, 0x72f, 0x736, 0x73e, 0x746, 0x74e,
+	0x75c, 0x761, 0x76f, 0x777, 0x780, 0x789, 0x792, 0x798, 0x79d, 0x7a1, 0x7ac, 0x7b1,
+	0x7b7, 0x7bb, 0x7cd, 0x7de, 0x7ee, 0x7f8, 0x806, 0x81f, 0x830, 0x83b, 0x84d, 0x858,
+	0x85d, 0x865, 0x86a, 0x87b, 0x882, 0x88a, 0x891, 0x89d, 0x8a3, 0x8b1, 0x8c1, 0x8d3,
+	0x8da, 0x8e6, 0x8f0, 0x8fb, 0x905, 0x928, 0x932, 0x93e, 0x948, 0x951, 0x95a, 0x962,
+	0x96e, 0x985, 0x98d, 0x999, 0x9a0, 0x9ac, 0x9bc, 0x9d6, 0x9db, 0x9f3, 0x9f7, 0xa00,
+	0xa0f, 0xa16, 0xa21, 0xa30, 0xa38, 0xa3d, 0xa4b, 0xa5e, 0xa64, 0xa6a, 0xa73, 0xa7a,
+	0xa80, 0xaa2, 0xab4, 0xabb, 0xac8, 0xad0, 0xaf6, 0xaff, 0xb13, 0xb2b, 0xb32, 0xb39,
+	0xb54, 0xb5a, 0xb62, 0xb67, 0xb6e, 0xb89, 0xb8f, 0xb97, 0xba9, 0xbaf, 0xbb5, 0xbc3,
+	0xbcf, 0xbd8, 0xbe5, 0xbf4, 0xc00, 0xc0d, 0xc1b, 0xc2e, 0xc35, 0xc4b, 0xc59, 0xc65,
+	0xc70, 0xc80, 0xc8c, 0xc9b, 0xca5, 0xcb9, 0xcc3, 0xcc9, 0xcd7, 0xce4, 0xceb, 0xcf8,
+	0xd06, 0xd14, 0xd21,
+}
+
+const hyRegionStr = "" +
+	"Համբարձման կղզիԱնդորաՄիացյալ Արաբական ԷմիրություններԱֆղանստանԱնտիգուա և Բարբուդա" +
+	"ԱնգիլիաԱլբանիաՀայաստանԱնգոլաԱնտարկտիդաԱրգենտինաԱմերիկյան ՍամոաԱվստրիաԱվստրալիաԱր" +
+	"ուբաԱլանդյան կղզիներԱդրբեջանԲոսնիա և ՀերցեգովինաԲարբադոսԲանգլադեշԲելգիաԲուրկինա " +
+	"ՖասոԲուլղարիաԲահրեյնԲուրունդիԲենինՍուրբ Բարթողոմեոսի կղզիԲերմուդյան կղզիներԲրուն" +
+	"եյԲոլիվիաԿարիբյան ՆիդերլանդներԲրազիլիաԲահամյան կղզիներԲութանԲուվե կղզիԲոտսվանաԲե" +
+	"լառուսԲելիզԿանադաԿոկոսյան (Քիլինգ) կղզիներԿոնգո - ԿինշասաԿենտրոնական Աֆրիկյան Հա" +
+	"նրապետությունԿոնգո - ԲրազավիլՇվեյցարիաՓղոսկրի ԱփԿուկի կղզիներՉիլիԿամերունՉինաստա" +
+	"նԿոլումբիաՔլիփերթոն կղզիԿոստա-ՌիկաԿուբաԿաբո ՎերդեԿյուրասաոԾննդյան կղզիԿիպրոսՉեխի" +
+	"աԳերմանիաԴիեգո ԳարսիաՋիբուտիԴանիաԴոմինիկաԴոմինիկյան ՀանրապետությունԱլժիրՍեուտա և" +
+	" ՄելիլյաԷկվադորԷստոնիաԵգիպտոսԱրևմտյան ՍահարաԷրիտրեաԻսպանիաԵթովպիաԵվրոպական Միութ" +
+	"յունՖինլանդիաՖիջիՖոլկլենդյան կղզիներՄիկրոնեզիաՖարերյան կղզիներՖրանսիաԳաբոնՄիացյա" +
+	"լ ԹագավորությունԳրենադաՎրաստանՖրանսիական ԳվիանաԳերնսիԳանաՋիբրալթարԳրենլանդիաԳամբ" +
+	"իաԳվինեաԳվադելուպաՀասարակածային ԳվինեաՀունաստանՀարավային Ջորջիա և Հարավային Սենդ" +
+	"վիչյան կղզիներԳվատեմալաԳուամԳվինեա-ԲիսաուԳայանաՀոնկոնգի ՀՎՇՀերդ կղզի և ՄակԴոնալդ" +
+	"ի կղզիներՀոնդուրասԽորվաթիաՀաիթիՀունգարիաԿանարյան կղզիներԻնդոնեզիաԻռլանդիաԻսրայել" +
+	"Մեն կղզիՀնդկաստանՀնդկական Օվկիանոսում Բրիտանական ՏարածքԻրաքԻրանԻսլանդիաԻտալիաՋեր" +
+	"սիՋամայկաՀորդանանՃապոնիաՔենիաՂրղզստանԿամբոջաԿիրիբատիԿոմորյան կղզիներՍենթ Քիթս և " +
+	"ՆևիսՀյուսիսային ԿորեաՀարավային ԿորեաՔուվեյթԿայմանյան կղզիներՂազախստանԼաոսԼիբանան" +
+	"Սենթ ԼյուսիաԼիխտենշտեյնՇրի ԼանկաԼիբերիաԼեսոտոԼիտվաԼյուքսեմբուրգԼատվիաԼիբիաՄարոկո" +
+	"ՄոնակոՄոլդովաՉեռնոգորիաՍեն ՄարտենՄադագասկարՄարշալյան կղզիներՄակեդոնիաՄալիՄյանմա " +
+	"(Բիրմա)ՄոնղոլիաՉինաստանի Մակաո ՀՎՇՀյուսիսային Մարիանյան կղզիներՄարտինիկաՄավրիտան" +
+	"իաՄոնտսերատՄալթաՄավրիկիոսՄալդիվներՄալավիՄեքսիկաՄալայզիաՄոզամբիկՆամիբիաՆոր Կալեդո" +
+	"նիաՆիգերՆորֆոլկ կղզիՆիգերիաՆիկարագուաՆիդերլանդերՆորվեգիաՆեպալՆաուրուՆիուեՆոր Զել" +
+	"անդիաՕմանՊանամաՊերուՖրանսիական ՊոլինեզիաՊապուա Նոր ԳվինեաՖիլիպիններՊակիստանԼեհաս" +
+	"տանՍեն Պիեր և ՄիկելոնՊիտկեռն կղզիներՊուերտո ՌիկոՊաղեստինյան տարածքներՊորտուգալիա" +
+	"ՊալաուՊարագվայԿատարԱրտաքին ՕվկիանիաՌեյունիոնՌումինիաՍերբիաՌուսաստանՌուանդաՍաուդյ" +
+	"ան ԱրաբիաՍողոմոնյան կղզիներՍեյշելյան կղզիներՍուդանՇվեդիաՍինգապուրՍուրբ Հեղինեի կ" +
+	"ղզիՍլովենիաՍվալբարդ և Յան-ՄայենՍլովակիաՍյերա-ԼեոնեՍան ՄարինոՍենեգալՍոմալիՍուրինա" +
+	"մՀարավային ՍուդանՍան Տոմե և ՊրինսիպիՍալվադորՍինտ ՄարտենՍիրիաՍվազիլենդՏրիստան դա " +
+	"ԿունյաՏերկս և Կայկոս կղզիներՉադՖրանսիական Հարավային ՏարածքներՏոգոԹաիլանդՏաջիկստա" +
+	"նՏոկելաուԹիմոր-ԼեստեԹուրքմենստանԹունիսՏոնգաԹուրքիաՏրինիդադ և ՏոբագոՏուվալուԹայվա" +
+	"նՏանզանիաՈւկրաինաՈւգանդաԱրտաքին կղզիներ (ԱՄՆ)Ամերիկայի Միացյալ ՆահանգներՈւրուգվա" +
+	"յՈւզբեկստանՎատիկան քաղաք-պետությունՍենթ Վիսենտ և ԳրենադիններՎենեսուելաԲրիտանական" +
+	" Վիրջինյան կղզիներԱմերիկյան Վիրջինյան կղզիներՎիետնամՎանուատուՈւոլիս և ՖուտունաՍա" +
+	"մոաԿոսովոԵմենՄայոտՀարավաֆրիկյան ՀանրապետությունԶամբիաԶիմբաբվեԱնհայտ տարածաշրջանԱ" +
+	"շխարհԱֆրիկաՀյուսիսային ԱմերիկաՀարավային ԱմերիկաՕվկիանիաԱրևմտյան ԱֆրիկաԿենտրոնակա" +
+	"ն ԱմերիկաԱրևելյան ԱֆրիկաՀյուսիսային ԱֆրիկաԿենտրոնական ԱֆրիկաԱֆրիկայի հարավԱմերիկ" +
+	"աՀյուսիսային Ամերիկա - Մեքսիկայից հյուսիսԿարիբյան կղզիներԱրևելյան ԱսիաՀարավային " +
+	"ԱսիաՀարավ-Արևելյան ԱսիաՀարավային ԵվրոպաԱվստրալասիաՄալանեզիաՄիկրոնեզիայի տարածաշր" +
+	"ջանՊոլինեզիաԱսիաԿենտրոնական ԱսիաԱրևմտյան ԱսիաԵվրոպաԱրևելյան ԵվրոպաՀյուսիսային Եվ" +
+	"րոպաԱրևմտյան ԵվրոպաԼատինական Ամերիկա"
+
+var hyRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1d, 0x29, 0x65, 0x77, 0x9b, 0xa9, 0xb7, 0xc7, 0xc7, 0xd3, 0xe7,
+	0xf9, 0x116, 0x124, 0x136, 0x142, 0x161, 0x171, 0x197, 0x1a7, 0x1b9, 0x1c5, 0x1de,
+	0x1f0, 0x1fe, 0x210, 0x21a, 0x246, 0x269, 0x277, 0x285, 0x2ae, 0x2be, 0x2dd, 0x2e9,
+	0x2fc, 0x30c, 0x31c, 0x326, 0x332, 0x360, 0x37b, 0x3c1, 0x3de, 0x3f0, 0x403, 0x41c,
+	0x424, 0x434, 0x444, 0x456, 0x471, 0x484, 0x48e, 0x4a1, 0x4b3, 0x4ca, 0x4d6, 0x4e0,
+	0x4f0, 0x507, 0x515, 0x51f, 0x52f, 0x562, 0x56c, 0x58a, 0x598, 0x5a6, 0x5b4, 0x5d1,
+	0x5df, 0x5ed, 0x5fb, 0x620, 0x632, 0x63a, 0x65f, 0x673, 0x692, 0x6a0, 0x6aa, 0x6d5,
+	0x6e3, 0x6f1, 0x712, 0x71e, 0x726, 0x738, 0x74c, 0x758, 0x764, 0x778, 0x79f, 0x7b1,
+	0x80a, 0x81c, 0x826, 0x83f, 0x84b, 0x862, 0x89a, 0x8ac, 0x8bc, 0x8c6, 0x8d8, 0x8f7,
+	0x909, 0x919, 0x927, 0x936, 0x948, 0x991, 0x999, 0x9a1, 0x9b1, 0x9bd, 0x9c7, 0x9d5,
+	0x9e5, 0x9f3, 0x9fd, 0xa0d, 0xa1b, 0xa2b, 0xa4a, 0xa67, 0xa88, 0xaa5, 0xab3, 0xad4,
+	0xae6, 0xaee, 0xafc, 0xb13, 0xb29, 0xb3a, 0xb48, 0xb54, 0xb5e, 0xb78, 0xb84, 0xb8e,
+	0xb9a, 0xba6, 0xbb4, 0xbc8, 0xbdb, 0xbef, 0xc10, 0xc22, 0xc2a, 0xc43, 0xc53, 0xc77,
+	0xcaf, 0xcc1, 0xcd5, 0xce7, 0xcf1, 0xd03, 0xd15, 0xd21, 0xd2f, 0xd3f, 0xd4f, 0xd5d,
+	0xd76, 0xd80, 0xd97, 0xda5, 0xdb9, 0xdcf, 0xddf, 0xde9, 0xdf7, 0xe01, 0xe18, 0xe20,
+	0xe2c, 0xe36, 0xe5d, 0xe7d, 0xe91, 0xea1, 0xeb1, 0xed2, 0xeef, 0xf06, 0xf2f, 0xf45,
+	0xf51, 0xf61, 0xf6b, 0xf8a, 0xf9c, 0xfac, 0xfb8, 0xfca, 0xfd8, 0xff5, 0x1018, 0x1039,
+	0x1045, 0x1051, 0x1063, 0x1085, 0x1095, 0x10ba, 0x10ca, 0x10df, 0x10f2, 0x1100, 0x110c, 0x111c,
+	0x113b, 0x115e, 0x116e, 0x1183, 0x118d, 0x119f, 0x11bf, 0x11e8, 0x11ee, 0x1228, 0x1230, 0x123e,
+	0x1250, 0x1260, 0x1275, 0x128d, 0x1299, 0x12a3, 0x12b1, 0x12d1, 0x12e1, 0x12ed, 0x12fd, 0x130d,
+	0x131b, 0x1341, 0x1375, 0x1387, 0x139b, 0x13c9, 0x13f8, 0x140c, 0x1442, 0x1476, 0x1484, 0x1496,
+	0x14b6, 0x14c0, 0x14cc, 0x14d4, 0x14de, 0x1517, 0x1523, 0x1533, 0x1556, 0x1562, 0x156e, 0x1593,
+	0x15b4, 0x15c4, 0x15e1, 0x1606, 0x1623, 0x1646, 0x1669, 0x1684, 0x1692, 0x16dd, 0x16fc, 0x1715,
+	0x1730, 0x1754, 0x1773, 0x1789, 0x179b, 0x17ca, 0x17dc, 0x17e4, 0x1803, 0x181c, 0x1828, 0x1845,
+	0x1868, 0x1885, 0x18a6,
+}
+
+const idRegionStr = "" +
+	"Pulau AscensionAndorraUni Emirat ArabAfganistanAntigua dan BarbudaAnguillaAlbani" +
+	"aArmeniaAntilla BelandaAngolaAntarktikaArgentinaSamoa AmerikaAustriaAustraliaAru" +
+	"baKepulauan AlandAzerbaijanBosnia dan HerzegovinaBarbadosBangladeshBelgiaBurkina" +
+	" FasoBulgariaBahrainBurundiBeninSaint BarthelemyBermudaBruneiBoliviaKaribia Bela" +
+	"ndaBrasilBahamaBhutanPulau BouvetBotswanaBelarusBelizeKanadaKepulauan CocosKongo" +
+	" - KinshasaRepublik Afrika TengahKongo - BrazzavilleSwissCote d'IvoireKepulauan " +
+	"CookCileKamerunChinaKolombiaPulau ClippertonKosta RikaKubaTanjung VerdeCuraçaoPu" +
+	"lau ChristmasSiprusRepublik CheskaJermanDiego GarciaJibutiDenmarkDominikaRepubli" +
+	"k DominikaAljazairCeuta dan MelillaEkuadorEstoniaMesirSahara BaratEritreaSpanyol" +
+	"EtiopiaUni EropaFinlandiaFijiKepulauan MalvinasMikronesiaKepulauan FaroePrancisG" +
+	"abonInggrisGrenadaGeorgiaGuyana PrancisGuernseyGhanaGibraltarGrinlandiaGambiaGui" +
+	"neaGuadeloupeGuinea EkuatorialYunaniGeorgia Selatan dan Kepulauan Sandwich Selat" +
+	"anGuatemalaGuamGuinea-BissauGuyanaHong Kong SAR ChinaPulau Heard dan Kepulauan M" +
+	"cDonaldHondurasKroasiaHaitiHungariaKepulauan CanaryIndonesiaIrlandiaIsraelPulau " +
+	"ManIndiaWilayah Inggris di Samudra HindiaIrakIranIslandiaItaliaJerseyJamaikaYord" +
+	"aniaJepangKenyaKirgistanKambojaKiribatiKomoroSaint Kitts dan NevisKorea UtaraKor" +
+	"ea SelatanKuwaitKepulauan CaymanKazakstanLaosLebanonSaint LuciaLiechtensteinSri " +
+	"LankaLiberiaLesothoLituaniaLuksemburgLatviaLibiaMarokoMonakoMoldovaMontenegroSai" +
+	"nt MartinMadagaskarKepulauan MarshallMakedoniaMaliMyanmar (Burma)MongoliaMakau S" +
+	"AR ChinaKepulauan Mariana UtaraMartinikMauritaniaMontserratMaltaMauritiusMaladew" +
+	"aMalawiMeksikoMalaysiaMozambikNamibiaKaledonia BaruNigerKepulauan NorfolkNigeria" +
+	"NikaraguaBelandaNorwegiaNepalNauruNiueSelandia BaruOmanPanamaPeruPolinesia Pranc" +
+	"isPapua NuginiFilipinaPakistanPolandiaSaint Pierre dan MiquelonKepulauan Pitcair" +
+	"nPuerto RikoWilayah PalestinaPortugalPalauParaguayQatarOseania LuarRéunionRumani" +
+	"aSerbiaRusiaRwandaArab SaudiKepulauan SolomonSeychellesSudanSwediaSingapuraSaint" +
+	" HelenaSloveniaKepulauan Svalbard dan Jan MayenSlovakiaSierra LeoneSan MarinoSen" +
+	"egalSomaliaSurinameSudan SelatanSao Tome dan PrincipeEl SalvadorSint MaartenSuri" +
+	"ahSwazilandTristan da CunhaKepulauan Turks dan CaicosCadWilayah Kutub Selatan Pr" +
+	"ancisTogoThailandTajikistanTokelauTimor LesteTurkimenistanTunisiaTongaTurkiTrini" +
+	"dad dan TobagoTuvaluTaiwanTanzaniaUkrainaUgandaKepulauan Terluar A.S.Amerika Ser" +
+	"ikatUruguayUzbekistanVatikanSaint Vincent dan GrenadinesVenezuelaKepulauan Virgi" +
+	"n InggrisKepulauan Virgin A.S.VietnamVanuatuKepulauan Wallis dan FutunaSamoaKoso" +
+	"voYamanMayotteAfrika SelatanZambiaZimbabweWilayah Tidak DikenalDuniaAfrikaAmerik" +
+	"a UtaraAmerika SelatanOseaniaAfrika BaratAmerika TengahAfrika TimurAfrika UtaraA" +
+	"frika TengahAfrika Bagian SelatanAmerikaAmerika Bagian UtaraKepulauan KaribiaAsi" +
+	"a TimurAsia SelatanAsia TenggaraEropa SelatanAustralasiaMelanesiaWilayah Mikrone" +
+	"siaPolinesiaAsiaAsia TengahAsia BaratEropaEropa TimurEropa UtaraEropa BaratAmeri" +
+	"ka Latin"
+
+var idRegionIdx = []uint16{ // 291 entries
+	0x0, 0xf, 0x16, 0x25, 0x2f, 0x42, 0x4a, 0x51, 0x58, 0x67, 0x6d, 0x77,
+	0x80, 0x8d, 0x94, 0x9d, 0xa2, 0xb1, 0xbb, 0xd1, 0xd9, 0xe3, 0xe9, 0xf5,
+	0xfd, 0x104, 0x10b, 0x110, 0x120, 0x127, 0x12d, 0x134, 0x143, 0x149, 0x14f, 0x155,
+	0x161, 0x169, 0x170, 0x176, 0x17c, 0x18b, 0x19b, 0x1b1, 0x1c4, 0x1c9, 0x1d6, 0x1e4,
+	0x1e8, 0x1ef, 0x1f4, 0x1fc, 0x20c, 0x216, 0x21a, 0x227, 0x22f, 0x23e, 0x244, 0x253,
+	0x259, 0x265, 0x26b, 0x272, 0x27a, 0x28b, 0x293, 0x2a4, 0x2ab, 0x2b2, 0x2b7, 0x2c3,
+	0x2ca, 0x2d1, 0x2d8, 0x2e1, 0x2ea, 0x2ee, 0x300, 0x30a, 0x319, 0x320, 0x325, 0x32c,
+	0x333, 0x33a, 0x348, 0x350, 0x355, 0x35e, 0x368, 0x36e, 0x374, 0x37e, 0x38f, 0x395,
+	0x3c3, 0x3cc, 0x3d0, 0x3dd, 0x3e3, 0x3f6, 0x418, 0x420, 0x427, 0x42c, 0x434, 0x444,
+	0x44d, 0x455, 0x45b, 0x464, 0x469, 0x48a, 0x48e, 0x492, 0x49a, 0x4a0, 0x4a6, 0x4ad,
+	0x4b5, 0x4bb, 0x4c0, 0x4c9, 0x4d0, 0x4d8, 0x4de, 0x4f3, 0x4fe, 0x50b, 0x511, 0x521,
+	0x52a, 0x52e, 0x535, 0x540, 0x54d, 0x556, 0x55d, 0x564, 0x56c, 0x576, 0x57c, 0x581,
+	0x587, 0x58d, 0x594, 0x59e, 0x5aa, 0x5b4, 0x5c6, 0x5cf, 0x5d3, 0x5e2, 0x5ea, 0x5f9,
+	0x610, 0x618, 0x622, 0x62c, 0x631, 0x63a, 0x642, 0x648, 0x64f, 0x657, 0x65f, 0x666,
+	0x674, 0x679, 0x68a, 0x691, 0x69a, 0x6a1, 0x6a9, 0x6ae, 0x6b3, 0x6b7, 0x6c4, 0x6c8,
+	0x6ce, 0x6d2, 0x6e3, 0x6ef, 0x6f7, 0x6ff, 0x707, 0x720, 0x732, 0x73d, 0x74e, 0x756,
+	0x75b, 0x763, 0x768, 0x774, 0x77c, 0x783, 0x789, 0x78e, 0x794, 0x79e, 0x7af, 0x7b9,
+	0x7be, 0x7c4, 0x7cd, 0x7d9, 0x7e1, 0x801, 0x809, 0x815, 0x81f, 0x826, 0x82d, 0x835,
+	0x842, 0x857, 0x862, 0x86e, 0x874, 0x87d, 0x88d, 0x8a7, 0x8aa, 0x8c7, 0x8cb, 0x8d3,
+	0x8dd, 0x8e4, 0x8ef, 0x8fc, 0x903, 0x908, 0x90d, 0x920, 0x926, 0x92c, 0x934, 0x93b,
+	0x941, 0x957, 0x966, 0x96d, 0x977, 0x97e, 0x99a, 0x9a3, 0x9bb, 0x9d0, 0x9d7, 0x9de,
+	0x9f9, 0x9fe, 0xa04, 0xa09, 0xa10, 0xa1e, 0xa24, 0xa2c, 0xa41, 0xa46, 0xa4c, 0xa59,
+	0xa68, 0xa6f, 0xa7b, 0xa89, 0xa95, 0xaa1, 0xaae, 0xac3, 0xaca, 0xade, 0xaef, 0xaf9,
+	0xb05, 0xb12, 0xb1f, 0xb2a, 0xb33, 0xb45, 0xb4e, 0xb52, 0xb5d, 0xb67, 0xb6c, 0xb77,
+	0xb82, 0xb8d, 0xb9a,
+}
+
+const isRegionStr = "" +
+	"Ascension-eyjaAndorraSameinuðu arabísku furstadæminAfganistanAntígva og BarbúdaA" +
+	"ngvillaAlbaníaArmeníaHollensku AntillurAngólaSuðurskautslandiðArgentínaBandarísk" +
+	"a SamóaAusturríkiÁstralíaArúbaÁlandseyjarAserbaídsjanBosnía og HersegóvínaBarbad" +
+	"osBangladessBelgíaBúrkína FasóBúlgaríaBareinBúrúndíBenínSaint BarthélemyBermúdae" +
+	"yjarBrúneiBólivíaKaríbahafshluti HollandsBrasilíaBahamaeyjarBútanBouveteyjaBotsv" +
+	"anaHvíta-RússlandBelísKanadaKókoseyjar (Keeling)Kongó-KinshasaMið-Afríkulýðveldi" +
+	"ðKongó-BrazzavilleSvissFílabeinsströndinCooks-eyjarSíleKamerúnKínaKólumbíaClippe" +
+	"rton-eyjaKostaríkaKúbaGrænhöfðaeyjarCuraçaoJólaeyKýpurTékklandÞýskalandDiego Gar" +
+	"ciaDjíbútíDanmörkDóminíkaDóminíska lýðveldiðAlsírCeuta og MelillaEkvadorEistland" +
+	"EgyptalandVestur-SaharaErítreaSpánnEþíópíaEvrópusambandiðFinnlandFídjieyjarFalkl" +
+	"andseyjarMíkrónesía (FM)FæreyjarFrakklandGabonBretlandGrenadaGeorgíaFranska Gvæj" +
+	"anaGuernseyGanaGíbraltarGrænlandGambíaGíneaGvadelúpeyjarMiðbaugs-GíneaGrikklandS" +
+	"uður-Georgía og Suður-SandvíkureyjarGvatemalaGvamGínea-BissáGvæjanaSjálfstjórnar" +
+	"svæðið Hong KongHeard og McDonaldseyjarHondúrasKróatíaHaítíUngverjalandKanaríeyj" +
+	"arIndónesíaÍrlandÍsraelMönIndlandBresku IndlandshafseyjarÍrakÍranÍslandÍtalíaJer" +
+	"seyJamaíkaJórdaníaJapanKeníaKirgisistanKambódíaKíribatíKómoreyjarSankti Kristófe" +
+	"r og NevisNorður-KóreaSuður-KóreaKúveitCaymaneyjarKasakstanLaosLíbanonSankti Lús" +
+	"íaLiechtensteinSrí LankaLíberíaLesótóLitháenLúxemborgLettlandLíbíaMarokkóMónakóM" +
+	"oldóvaSvartfjallalandSaint MartinMadagaskarMarshalleyjarMakedóníaMalíMjanmar (Bú" +
+	"rma)MongólíaSjálfstjórnarsvæðið MakaóNorður-MaríanaeyjarMartiníkMáritaníaMontser" +
+	"ratMaltaMáritíusMaldíveyjarMalavíMexíkóMalasíaMósambíkNamibíaNýja-KaledóníaNíger" +
+	"NorfolkeyjaNígeríaNíkaragvaHollandNoregurNepalNárúNiueNýja-SjálandÓmanPanamaPerú" +
+	"Franska PólýnesíaPapúa Nýja-GíneaFilippseyjarPakistanPóllandSankti Pierre og Miq" +
+	"uelonPitcairn-eyjarPúertó RíkóHeimastjórnarsvæði PalestínumannaPortúgalPaláParag" +
+	"væKatarYtri EyjaálfaRéunionRúmeníaSerbíaRússlandRúandaSádi-ArabíaSalómonseyjarSe" +
+	"ychelles-eyjarSúdanSvíþjóðSingapúrSankti HelenaSlóveníaSvalbarði og Jan MayenSló" +
+	"vakíaSíerra LeóneSan MarínóSenegalSómalíaSúrínamSuður-SúdanSaó Tóme og PrinsípeE" +
+	"l SalvadorSint MaartenSýrlandSvasílandTristan da CunhaTurks- og CaicoseyjarTsjad" +
+	"Frönsku suðlægu landsvæðinTógóTaílandTadsjikistanTókeláTímor-LesteTúrkmenistanTú" +
+	"nisTongaTyrklandTrínidad og TóbagóTúvalúTaívanTansaníaÚkraínaÚgandaSmáeyjar Band" +
+	"aríkjannaBandaríkinÚrúgvæÚsbekistanPáfastóllSankti Vinsent og GrenadíneyjarVenes" +
+	"úelaBresku JómfrúaeyjarBandarísku JómfrúaeyjarVíetnamVanúatúWallis- og Fútúnaeyj" +
+	"arSamóaKósóvóJemenMayotteSuður-AfríkaSambíaSimbabveÓþekkt svæðiHeimurinnAfríkaNo" +
+	"rður-AmeríkaSuður-AmeríkaEyjaálfaVestur-AfríkaMið-AmeríkaAustur-AfríkaNorður-Afr" +
+	"íkaMið-AfríkaSuðurhluti AfríkuAmeríkaAmeríka norðan MexikóKarabíska hafiðAustur-" +
+	"AsíaSuður-AsíaSuðaustur-AsíaSuður-EvrópaÁstralasíaMelanesíaMíkrónesíaPólýnesíaAs" +
+	"íaMið-AsíaVestur-AsíaEvrópaAustur-EvrópaNorður-EvrópaVestur-EvrópaRómanska Amerí" +
+	"ka"
+
+var isRegionIdx = []uint16{ // 291 entries
+	0x0, 0xe, 0x15, 0x36, 0x40, 0x54, 0x5c, 0x64, 0x6c, 0x7e, 0x85, 0x98,
+	0xa2, 0xb4, 0xbf, 0xc9, 0xcf, 0xdb, 0xe8, 0x100, 0x108, 0x112, 0x119, 0x128,
+	0x132, 0x138, 0x142, 0x148, 0x159, 0x166, 0x16d, 0x176, 0x18f, 0x198, 0x1a3, 0x1a9,
+	0x1b3, 0x1bb, 0x1cb, 0x1d1, 0x1d7, 0x1ec, 0x1fb, 0x213, 0x225, 0x22a, 0x23d, 0x248,
+	0x24d, 0x255, 0x25a, 0x264, 0x273, 0x27d, 0x282, 0x293, 0x29b, 0x2a2, 0x2a8, 0x2b1,
+	0x2bc, 0x2c8, 0x2d2, 0x2da, 0x2e4, 0x2fc, 0x302, 0x312, 0x319, 0x321, 0x32b, 0x338,
+	0x340, 0x346, 0x351, 0x362, 0x36a, 0x375, 0x383, 0x395, 0x39e, 0x3a7, 0x3ac, 0x3b4,
+	0x3bb, 0x3c3, 0x3d3, 0x3db, 0x3df, 0x3e9, 0x3f2, 0x3f9, 0x3ff, 0x40d, 0x41d, 0x426,
+	0x44f, 0x458, 0x45c, 0x469, 0x471, 0x493, 0x4aa, 0x4b3, 0x4bc, 0x4c3, 0x4cf, 0x4db,
+	0x4e6, 0x4ed, 0x4f4, 0x4f8, 0x4ff, 0x517, 0x51c, 0x521, 0x528, 0x530, 0x536, 0x53e,
+	0x548, 0x54d, 0x553, 0x55e, 0x568, 0x572, 0x57d, 0x597, 0x5a5, 0x5b2, 0x5b9, 0x5c4,
+	0x5cd, 0x5d1, 0x5d9, 0x5e7, 0x5f4, 0x5fe, 0x607, 0x60f, 0x617, 0x621, 0x629, 0x630,
+	0x638, 0x640, 0x648, 0x657, 0x663, 0x66d, 0x67a, 0x685, 0x68a, 0x69a, 0x6a4, 0x6c3,
+	0x6d8, 0x6e1, 0x6ec, 0x6f6, 0x6fb, 0x705, 0x711, 0x718, 0x720, 0x728, 0x732, 0x73a,
+	0x74b, 0x751, 0x75c, 0x765, 0x76f, 0x776, 0x77d, 0x782, 0x788, 0x78c, 0x79a, 0x79f,
+	0x7a5, 0x7aa, 0x7be, 0x7d1, 0x7dd, 0x7e5, 0x7ed, 0x806, 0x814, 0x823, 0x848, 0x851,
+	0x856, 0x85e, 0x863, 0x871, 0x879, 0x882, 0x889, 0x892, 0x899, 0x8a6, 0x8b4, 0x8c4,
+	0x8ca, 0x8d5, 0x8de, 0x8eb, 0x8f5, 0x90c, 0x916, 0x924, 0x930, 0x937, 0x940, 0x949,
+	0x956, 0x96d, 0x978, 0x984, 0x98c, 0x996, 0x9a6, 0x9bb, 0x9c0, 0x9df, 0x9e5, 0x9ed,
+	0x9f9, 0xa01, 0xa0d, 0xa1a, 0xa20, 0xa25, 0xa2d, 0xa42, 0xa4a, 0xa51, 0xa5a, 0xa63,
+	0xa6a, 0xa82, 0xa8d, 0xa96, 0xaa1, 0xaac, 0xacc, 0xad6, 0xaeb, 0xb05, 0xb0d, 0xb16,
+	0xb2e, 0xb34, 0xb3d, 0xb42, 0xb49, 0xb57, 0xb5e, 0xb66, 0xb76, 0xb7f, 0xb86, 0xb96,
+	0xba5, 0xbae, 0xbbc, 0xbc9, 0xbd7, 0xbe6, 0xbf2, 0xc05, 0xc0d, 0xc25, 0xc36, 0xc42,
+	0xc4e, 0xc5e, 0xc6c, 0xc78, 0xc82, 0xc8f, 0xc9b, 0xca0, 0xcaa, 0xcb6, 0xcbd, 0xccb,
+	0xcda, 0xce8, 0xcfa,
+}
+
+const itRegionStr = "" +
+	"Isola di AscensioneAndorraEmirati Arabi UnitiAfghanistanAntigua e BarbudaAnguill" +
+	"aAlbaniaArmeniaAntille OlandesiAngolaAntartideArgentinaSamoa AmericaneAustriaAus" +
+	"traliaArubaIsole AlandAzerbaigianBosnia ErzegovinaBarbadosBangladeshBelgioBurkin" +
+	"a FasoBulgariaBahreinBurundiBeninSan BartolomeoBermudaBruneiBoliviaCaraibi Oland" +
+	"esiBrasileBahamasBhutanIsola BouvetBotswanaBielorussiaBelizeCanadaIsole CocosCon" +
+	"go - KinshasaRepubblica CentrafricanaCongoSvizzeraCosta d’AvorioIsole CookCileCa" +
+	"merunCinaColombiaIsola di ClippertonCosta RicaCubaCapo VerdeCuraçaoIsola di Chri" +
+	"stmasCiproRepubblica CecaGermaniaDiego GarciaGibutiDanimarcaDominicaRepubblica D" +
+	"ominicanaAlgeriaCeuta e MelillaEcuadorEstoniaEgittoSahara OccidentaleEritreaSpag" +
+	"naEtiopiaUnione EuropeaFinlandiaFigiIsole FalklandMicronesiaIsole FaroeFranciaGa" +
+	"bonRegno UnitoGrenadaGeorgiaGuiana FranceseGuernseyGhanaGibilterraGroenlandiaGam" +
+	"biaGuineaGuadalupaGuinea EquatorialeGreciaGeorgia del Sud e Isole Sandwich del S" +
+	"udGuatemalaGuamGuinea-BissauGuyanaRAS di Hong KongIsole Heard ed Isole McDonaldH" +
+	"ondurasCroaziaHaitiUngheriaIsole CanarieIndonesiaIrlandaIsraeleIsola di ManIndia" +
+	"Territorio Britannico dell’Oceano IndianoIraqIranIslandaItaliaJerseyGiamaicaGior" +
+	"daniaGiapponeKenyaKirghizistanCambogiaKiribatiComoreSaint Kitts e NevisCorea del" +
+	" NordCorea del SudKuwaitIsole CaymanKazakistanLaosLibanoSaint LuciaLiechtenstein" +
+	"Sri LankaLiberiaLesothoLituaniaLussemburgoLettoniaLibiaMaroccoMonacoMoldaviaMont" +
+	"enegroSaint MartinMadagascarIsole MarshallRepubblica di MacedoniaMaliMyanmarMong" +
+	"oliaRAS di MacaoIsole Marianne SettentrionaliMartinicaMauritaniaMontserratMaltaM" +
+	"auritiusMaldiveMalawiMessicoMalesiaMozambicoNamibiaNuova CaledoniaNigerIsola Nor" +
+	"folkNigeriaNicaraguaPaesi BassiNorvegiaNepalNauruNiueNuova ZelandaOmanPanamaPerù" +
+	"Polinesia FrancesePapua Nuova GuineaFilippinePakistanPoloniaSaint Pierre e Mique" +
+	"lonIsole PitcairnPortoricoTerritori palestinesiPortogalloPalauParaguayQatarOcean" +
+	"ia lontanaRéunionRomaniaSerbiaFederazione RussaRuandaArabia SauditaIsole Solomon" +
+	"SeychellesSudanSveziaSingaporeSant’ElenaSloveniaSvalbard e Jan MayenSlovacchiaSi" +
+	"erra LeoneSan MarinoSenegalSomaliaSurinameSudan del SudSao Tomé e PríncipeEl Sal" +
+	"vadorSint MaartenSiriaSwazilandTristan da CunhaIsole Turks e CaicosCiadTerritori" +
+	" australi francesiTogoTailandiaTagikistanTokelauTimor EstTurkmenistanTunisiaTong" +
+	"aTurchiaTrinidad e TobagoTuvaluTaiwanTanzaniaUcrainaUgandaIsole minori lontane d" +
+	"agli USAStati UnitiUruguayUzbekistanCittà del VaticanoSaint Vincent e Grenadines" +
+	"VenezuelaIsole Vergini BritannicheIsole Vergini AmericaneVietnamVanuatuWallis e " +
+	"FutunaSamoaKosovoYemenMayotteSudafricaZambiaZimbabweRegione non valida o sconosc" +
+	"iutaMondoAfricaNord AmericaAmerica del SudOceaniaAfrica occidentaleAmerica centr" +
+	"aleAfrica orientaleAfrica del NordAfrica centraleAfrica del SudAmericheAmerica d" +
+	"el NordCaraibiAsia orientaleAsia del SudAsia sudorientaleEuropa del SudAustralas" +
+	"iaMelanesiaRegione MicronesianaPolinesiaAsiaAsia centraleAsia occidentaleEuropaE" +
+	"uropa orientaleEuropa del NordEuropa occidentaleAmerica Latina"
+
+var itRegionIdx = []uint16{ // 291 entries
+	0x0, 0x13, 0x1a, 0x2d, 0x38, 0x49, 0x51, 0x58, 0x5f, 0x6f, 0x75, 0x7e,
+	0x87, 0x96, 0x9d, 0xa6, 0xab, 0xb6, 0xc1, 0xd2, 0xda, 0xe4, 0xea, 0xf6,
+	0xfe, 0x105, 0x10c, 0x111, 0x11f, 0x126, 0x12c, 0x133, 0x143, 0x14a, 0x151, 0x157,
+	0x163, 0x16b, 0x176, 0x17c, 0x182, 0x18d, 0x19d, 0x1b5, 0x1ba, 0x1c2, 0x1d2, 0x1dc,
+	0x1e0, 0x1e7, 0x1eb, 0x1f3, 0x206, 0x210, 0x214, 0x21e, 0x226, 0x238, 0x23d, 0x24c,
+	0x254, 0x260, 0x266, 0x26f, 0x277, 0x28c, 0x293, 0x2a2, 0x2a9, 0x2b0, 0x2b6, 0x2c8,
+	0x2cf, 0x2d5, 0x2dc, 0x2ea, 0x2f3, 0x2f7, 0x305, 0x30f, 0x31a, 0x321, 0x326, 0x331,
+	0x338, 0x33f, 0x34e, 0x356, 0x35b, 0x365, 0x370, 0x376, 0x37c, 0x385, 0x397, 0x39d,
+	0x3c5, 0x3ce, 0x3d2, 0x3df, 0x3e5, 0x3f5, 0x412, 0x41a, 0x421, 0x426, 0x42e, 0x43b,
+	0x444, 0x44b, 0x452, 0x45e, 0x463, 0x48e, 0x492, 0x496, 0x49d, 0x4a3, 0x4a9, 0x4b1,
+	0x4ba, 0x4c2, 0x4c7, 0x4d3, 0x4db, 0x4e3, 0x4e9, 0x4fc, 0x50a, 0x517, 0x51d, 0x529,
+	0x533, 0x537, 0x53d, 0x548, 0x555, 0x55e, 0x565, 0x56c, 0x574, 0x57f, 0x587, 0x58c,
+	0x593, 0x599, 0x5a1, 0x5ab, 0x5b7, 0x5c1, 0x5cf, 0x5e6, 0x5ea, 0x5f1, 0x5f9, 0x605,
+	0x622, 0x62b, 0x635, 0x63f, 0x644, 0x64d, 0x654, 0x65a, 0x661, 0x668, 0x671, 0x678,
+	0x687, 0x68c, 0x699, 0x6a0, 0x6a9, 0x6b4, 0x6bc, 0x6c1, 0x6c6, 0x6ca, 0x6d7, 0x6db,
+	0x6e1, 0x6e6, 0x6f8, 0x70a, 0x713, 0x71b, 0x722, 0x739, 0x747, 0x750, 0x765, 0x76f,
+	0x774, 0x77c, 0x781, 0x790, 0x798, 0x79f, 0x7a5, 0x7b6, 0x7bc, 0x7ca, 0x7d7, 0x7e1,
+	0x7e6, 0x7ec, 0x7f5, 0x801, 0x809, 0x81d, 0x827, 0x833, 0x83d, 0x844, 0x84b, 0x853,
+	0x860, 0x875, 0x880, 0x88c, 0x891, 0x89a, 0x8aa, 0x8be, 0x8c2, 0x8dd, 0x8e1, 0x8ea,
+	0x8f4, 0x8fb, 0x904, 0x910, 0x917, 0x91c, 0x923, 0x934, 0x93a, 0x940, 0x948, 0x94f,
+	0x955, 0x973, 0x97e, 0x985, 0x98f, 0x9a2, 0x9bc, 0x9c5, 0x9de, 0x9f5, 0x9fc, 0xa03,
+	0xa12, 0xa17, 0xa1d, 0xa22, 0xa29, 0xa32, 0xa38, 0xa40, 0xa60, 0xa65, 0xa6b, 0xa77,
+	0xa86, 0xa8d, 0xa9f, 0xaaf, 0xabf, 0xace, 0xadd, 0xaeb, 0xaf3, 0xb03, 0xb0a, 0xb18,
+	0xb24, 0xb35, 0xb43, 0xb4e, 0xb57, 0xb6b, 0xb74, 0xb78, 0xb85, 0xb95, 0xb9b, 0xbab,
+	0xbba, 0xbcc, 0xbda,
+}
+
+const jaRegionStr = "" +
+	"アセンション島アンドラアラブ首長国連邦アフガニスタンアンティグア・バーブーダアンギラアルバニアアルメニアオランダ領アンティルアンゴラ南極アルゼンチン米領サモアオ" +
+	"ーストリアオーストラリアアルバオーランド諸島アゼルバイジャンボスニア・ヘルツェゴビナバルバドスバングラデシュベルギーブルキナファソブルガリアバーレーンブルンジベ" +
+	"ナンサン・バルテルミー島バミューダブルネイボリビアオランダ領カリブブラジルバハマブータンブーベ島ボツワナベラルーシベリーズカナダココス(キーリング)諸島コンゴ民" +
+	"主共和国(キンシャサ)中央アフリカ共和国コンゴ共和国(ブラザビル)スイスコートジボワールクック諸島チリカメルーン中国コロンビアクリッパートン島コスタリカキューバ" +
+	"カーボベルデキュラソークリスマス島キプロスチェコ共和国ドイツディエゴガルシア島ジブチデンマークドミニカ国ドミニカ共和国アルジェリアセウタ・メリリャエクアドルエス" +
+	"トニアエジプト西サハラエリトリアスペインエチオピア欧州連合フィンランドフィジーフォークランド諸島ミクロネシア連邦フェロー諸島フランスガボンイギリスグレナダグルジ" +
+	"ア仏領ギアナガーンジーガーナジブラルタルグリーンランドガンビアギニアグアドループ赤道ギニアギリシャ南ジョージア島・南サンドイッチ諸島グアテマラグアムギニアビサウ" +
+	"ガイアナ中華人民共和国香港特別行政区ハード島・マクドナルド諸島ホンジュラスクロアチアハイチハンガリーカナリア諸島インドネシアアイルランドイスラエルマン島インド英" +
+	"領インド洋地域イラクイランアイスランドイタリアジャージージャマイカヨルダン日本ケニアキルギスカンボジアキリバスコモロセントクリストファー・ネイビス朝鮮民主主義人" +
+	"民共和国大韓民国クウェートケイマン諸島カザフスタンラオスレバノンセントルシアリヒテンシュタインスリランカリベリアレソトリトアニアルクセンブルグラトビアリビアモロ" +
+	"ッコモナコモルドバモンテネグロサン・マルタンマダガスカルマーシャル諸島マケドニアマリミャンマーモンゴル中華人民共和国マカオ特別行政区北マリアナ諸島マルティニーク" +
+	"モーリタニアモントセラトマルタモーリシャスモルジブマラウイメキシコマレーシアモザンビークナミビアニューカレドニアニジェールノーフォーク島ナイジェリアニカラグアオ" +
+	"ランダノルウェーネパールナウルニウエ島ニュージーランドオマーンパナマペルー仏領ポリネシアパプアニューギニアフィリピンパキスタンポーランドサンピエール島・ミクロン" +
+	"島ピトケアン諸島プエルトリコパレスチナポルトガルパラオパラグアイカタールオセアニア周辺地域レユニオン島ルーマニアセルビアロシアルワンダサウジアラビアソロモン諸島" +
+	"セーシェルスーダンスウェーデンシンガポールセントヘレナスロベニアスバールバル諸島・ヤンマイエン島スロバキアシエラレオネサンマリノセネガルソマリアスリナム南スーダ" +
+	"ンサントメ・プリンシペエルサルバドルシント・マールテンシリアスワジランドトリスタン・ダ・クーニャタークス・カイコス諸島チャド仏領極南諸島トーゴタイタジキスタント" +
+	"ケラウ東ティモールトルクメニスタンチュニジアトンガトルコトリニダード・トバゴツバル台湾タンザニアウクライナウガンダ米領太平洋諸島アメリカ合衆国ウルグアイウズベキ" +
+	"スタンバチカン市国セントビンセント・グレナディーン諸島ベネズエラ英領ヴァージン諸島米領ヴァージン諸島ベトナムバヌアツウォリス・フツナサモアコソボイエメンマヨット" +
+	"島南アフリカザンビアジンバブエ不明な地域世界アフリカ北アメリカ大陸南アメリカオセアニア西アフリカ中央アメリカ東アフリカ北アフリカ中部アフリカ南部アフリカアメリカ" +
+	"大陸北アメリカカリブ東アジア南アジア東南アジア南ヨーロッパオーストララシアメラネシアミクロネシアポリネシアアジア中央アジア西アジアヨーロッパ東ヨーロッパ北ヨーロ" +
+	"ッパ西ヨーロッパラテンアメリカ"
+
+var jaRegionIdx = []uint16{ // 291 entries
+	0x0, 0x15, 0x21, 0x39, 0x4e, 0x72, 0x7e, 0x8d, 0x9c, 0xba, 0xc6, 0xcc,
+	0xde, 0xed, 0xff, 0x114, 0x11d, 0x132, 0x14a, 0x16e, 0x17d, 0x192, 0x19e, 0x1b3,
+	0x1c2, 0x1d1, 0x1dd, 0x1e6, 0x204, 0x213, 0x21f, 0x22b, 0x243, 0x24f, 0x258, 0x264,
+	0x270, 0x27c, 0x28b, 0x297, 0x2a0, 0x2c0, 0x2e9, 0x304, 0x327, 0x330, 0x348, 0x357,
+	0x35d, 0x36c, 0x372, 0x381, 0x399, 0x3a8, 0x3b4, 0x3c6, 0x3d5, 0x3e7, 0x3f3, 0x405,
+	0x40e, 0x429, 0x432, 0x441, 0x450, 0x465, 0x477, 0x48f, 0x49e, 0x4ad, 0x4b9, 0x4c5,
+	0x4d4, 0x4e0, 0x4ef, 0x4fb, 0x50d, 0x519, 0x534, 0x54c, 0x55e, 0x56a, 0x573, 0x57f,
+	0x58b, 0x597, 0x5a6, 0x5b5, 0x5be, 0x5d0, 0x5e5, 0x5f1, 0x5fa, 0x60c, 0x61b, 0x627,
+	0x65a, 0x669, 0x672, 0x684, 0x690, 0x6ba, 0x6e1, 0x6f3, 0x702, 0x70b, 0x71a, 0x72c,
+	0x73e, 0x750, 0x75f, 0x768, 0x771, 0x789, 0x792, 0x79b, 0x7ad, 0x7b9, 0x7c8, 0x7d7,
+	0x7e3, 0x7e9, 0x7f2, 0x7fe, 0x80d, 0x819, 0x822, 0x84f, 0x870, 0x87c, 0x88b, 0x89d,
+	0x8af, 0x8b8, 0x8c4, 0x8d6, 0x8f1, 0x900, 0x90c, 0x915, 0x924, 0x939, 0x945, 0x94e,
+	0x95a, 0x963, 0x96f, 0x981, 0x996, 0x9a8, 0x9bd, 0x9cc, 0x9d2, 0x9e1, 0x9ed, 0xa1a,
+	0xa2f, 0xa44, 0xa56, 0xa68, 0xa71, 0xa83, 0xa8f, 0xa9b, 0xaa7, 0xab6, 0xac8, 0xad4,
+	0xaec, 0xafb, 0xb10, 0xb22, 0xb31, 0xb3d, 0xb4c, 0xb58, 0xb61, 0xb6d, 0xb85, 0xb91,
+	0xb9a, 0xba3, 0xbb8, 0xbd3, 0xbe2, 0xbf1, 0xc00, 0xc27, 0xc3c, 0xc4e, 0xc5d, 0xc6c,
+	0xc75, 0xc84, 0xc90, 0xcab, 0xcbd, 0xccc, 0xcd8, 0xce1, 0xced, 0xd02, 0xd14, 0xd23,
+	0xd2f, 0xd41, 0xd53, 0xd65, 0xd74, 0xda4, 0xdb3, 0xdc5, 0xdd4, 0xde0, 0xdec, 0xdf8,
+	0xe07, 0xe25, 0xe3a, 0xe55, 0xe5e, 0xe70, 0xe94, 0xeb5, 0xebe, 0xed0, 0xed9, 0xedf,
+	0xef1, 0xefd, 0xf0f, 0xf27, 0xf36, 0xf3f, 0xf48, 0xf66, 0xf6f, 0xf75, 0xf84, 0xf93,
+	0xf9f, 0xfb4, 0xfc9, 0xfd8, 0xfed, 0xfff, 0x1035, 0x1044, 0x105f, 0x107a, 0x1086, 0x1092,
+	0x10aa, 0x10b3, 0x10bc, 0x10c8, 0x10d7, 0x10e6, 0x10f2, 0x1101, 0x1110, 0x1116, 0x1122, 0x1137,
+	0x1146, 0x1155, 0x1164, 0x1176, 0x1185, 0x1194, 0x11a6, 0x11b8, 0x11ca, 0x11d9, 0x11e2, 0x11ee,
+	0x11fa, 0x1209, 0x121b, 0x1233, 0x1242, 0x1254, 0x1263, 0x126c, 0x127b, 0x1287, 0x1296, 0x12a8,
+	0x12ba, 0x12cc, 0x12e1,
+}
+
+const kaRegionStr = "" +
+	"ამაღლების კუნძულიანდორაარაბთა გაერთიანებული საამიროებიავღანეთიანტიგუა და ბარბუდა" +
+	"ანგვილაალბანეთისომხეთინიდერლანდების ანტილებიანგოლაანტარქტიკაარგენტინაამერიკული ს" +
+	"ამოაავსტრიაავსტრალიაარუბაალანდის კუნძულებიაზერბაიჯანიბოსნია და ჰერცეგოვინაბარბად" +
+	"ოსიბანგლადეშიბელგიაბურკინა-ფასობულგარეთიბაჰრეინიბურუნდიბენინისენ-ბართლემიბერმუდი" +
+	"ბრუნეიბოლივიაკარიბის ნიდერლანდებიბრაზილიაბაჰამის კუნძულებიბუტანიბუვეს კუნძულიბოტ" +
+	"სვანაბელორუსიაბელიზიკანადაქოქოსის (კილინგის) კუნძულებიკონგო - კინშასაცენტრალური " +
+	"აფრიკის რესპუბლიკაკონგო - ბრაზავილიშვეიცარიაკოტ-დივუარიკუკის კუნძულებიჩილეკამერუ" +
+	"ნიჩინეთიკოლუმბიაკლიპერტონის კუნძულიკოსტა-რიკაკუბაკაბო-ვერდეკიურასაოშობის კუნძული" +
+	"კვიპროსიჩეხეთის რესპუბლიკაგერმანიადიეგო-გარსიაჯიბუტიდანიადომინიკადომინიკანის რეს" +
+	"პუბლიკაალჟირისეუტა და მელილაეკვადორიესტონეთიეგვიპტედასავლეთი საჰარაერიტრეაესპანე" +
+	"თიეთიოპიაევროკავშირიფინეთიფიჯიფოლკლენდის კუნძულებიმიკრონეზიაფარერის კუნძულებისაფ" +
+	"რანგეთიგაბონიდიდი ბრიტანეთიგრენადასაქართველოფრანგული გვიანაგერნსიგანაგიბრალტარიგ" +
+	"რენლანდიაგამბიაგვინეაგვადელუპეეკვატორული გვინეასაბერძნეთისამხრეთი გეორგია და სამ" +
+	"ხრეთ სენდვიჩის კუნძულებიგვატემალაგუამიგვინეა-ბისაუგაიანაჰონკონგის სპეციალური ადმ" +
+	"ინისტრაციული რეგიონი ჩინეთიჰერდი და მაკდონალდის კუნძულებიჰონდურასიხორვატიაჰაიტიუ" +
+	"ნგრეთიკანარის კუნძულებიინდონეზიაირლანდიაისრაელიმენის კუნძულიინდოეთიბრიტანული ტერ" +
+	"იტორია ინდოეთის ოკეანეშიერაყიირანიისლანდიაიტალიაჯერსიიამაიკაიორდანიაიაპონიაკენია" +
+	"ყირგიზეთიკამბოჯაკირიბატიკომორის კუნძულებისენტ-კიტსი და ნევისიჩრდილოეთი კორეასამხ" +
+	"რეთი კორეაქუვეითიკაიმანის კუნძულებიყაზახეთილაოსილიბანისენტ-ლუსიალიხტენშტეინიშრი-" +
+	"ლანკალიბერიალესოთოლიტვალუქსემბურგილატვიალიბიამაროკომონაკომოლდოვამონტენეგროსენ-მა" +
+	"რტენიმადაგასკარიმარშალის კუნძულებიმაკედონიამალიმიანმარი (ბირმა)მონღოლეთიმაკაოს ს" +
+	"პეციალური ადმინისტრაციული რეგიონი ჩინეთიჩრდილოეთ მარიანას კუნძულებიმარტინიკამავრ" +
+	"იტანიამონსერატიმალტამავრიკიმალდივის კუნძულებიმალავიმექსიკამალაიზიამოზამბიკინამიბ" +
+	"იაახალი კალედონიანიგერინორფოლკის კუნძულინიგერიანიკარაგუანიდერლანდებინორვეგიანეპა" +
+	"ლინაურუნიუეახალი ზელანდიაომანიპანამაპერუფრანგული პოლინეზიაპაპუა-ახალი გვინეაფილი" +
+	"პინებიპაკისტანიპოლონეთისენ-პიერი და მიკელონიპიტკერნის კუნძულებიპუერტო-რიკოპალესტ" +
+	"ინის ტერიტორიებიპორტუგალიაპალაუპარაგვაიკატარიშორეული ოკეანეთირეუნიონირუმინეთისერ" +
+	"ბეთირუსეთირუანდასაუდის არაბეთისოლომონის კუნძულებისეიშელის კუნძულებისუდანიშვედეთი" +
+	"სინგაპურიწმინდა ელენეს კუნძულისლოვენიაშპიცბერგენი და იან-მაიენისლოვაკეთისიერა-ლე" +
+	"ონესან-მარინოსენეგალისომალისურინამისამხრეთი სუდანისან-ტომე და პრინსიპისალვადორის" +
+	"ინტ-მარტენისირიასვაზილენდიტრისტან-და-კუნიატერკსის და კაიკოსის კუნძულებიჩადიფრანგ" +
+	"ული სამხრეთის ტერიტორიებიტოგოტაილანდიტაჯიკეთიტოკელაუტიმორ-ლეშტითურქმენეთიტუნისიტ" +
+	"ონგათურქეთიტრინიდადი და ტობაგოტუვალუტაივანიტანზანიაუკრაინაუგანდააშშ-ის შორეული კ" +
+	"უნძულებიამერიკის შეერთებული შტატებიურუგვაიუზბეკეთიქალაქი ვატიკანისენტ-ვინსენტი დ" +
+	"ა გრენადინებივენესუელაბრიტანეთის ვირჯინიის კუნძულებიაშშ-ის ვირჯინიის კუნძულებივი" +
+	"ეტნამივანუატუუოლისი და ფუტუნასამოაკოსოვოიემენიმაიოტასამხრეთ აფრიკაზამბიაზიმბაბვე" +
+	"უცნობი რეგიონიმსოფლიოაფრიკაჩრდილოეთი ამერიკასამხრეთი ამერიკაოკეანეთიდასავლეთი აფ" +
+	"რიკაცენტრალური ამერიკააღმოსავლეთი აფრიკაჩრდილოეთი აფრიკაშუა აფრიკასამხრეთი აფრიკ" +
+	"აამერიკებიამერიკის ჩრდილოეთიკარიბის ზღვააღმოსავლეთი აზიასამხრეთი აზიასამხრეთ-აღმ" +
+	"ოსავლეთი აზიასამხრეთი ევროპაავსტრალაზიამელანეზიამიკრონეზიის რეგიონიპოლინეზიააზია" +
+	"ცენტრალური აზიადასავლეთი აზიაევროპააღმოსავლეთი ევროპაჩრდილოეთი ევროპადასავლეთი ე" +
+	"ვროპალათინური ამერიკა"
+
+var kaRegionIdx = []uint16{ // 291 entries
+	0x0, 0x31, 0x43, 0x9c, 0xb4, 0xe6, 0xfb, 0x113, 0x128, 0x168, 0x17a, 0x198,
+	0x1b3, 0x1de, 0x1f3, 0x20e, 0x21d, 0x24e, 0x26f, 0x2aa, 0x2c5, 0x2e3, 0x2f5, 0x317,
+	0x332, 0x34a, 0x35f, 0x371, 0x393, 0x3a8, 0x3ba, 0x3cf, 0x409, 0x421, 0x452, 0x464,
+	0x489, 0x4a1, 0x4bc, 0x4ce, 0x4e0, 0x52c, 0x553, 0x5a6, 0x5d3, 0x5ee, 0x60d, 0x638,
+	0x644, 0x65c, 0x66e, 0x686, 0x6bd, 0x6d9, 0x6e5, 0x701, 0x719, 0x73e, 0x756, 0x78a,
+	0x7a2, 0x7c4, 0x7d6, 0x7e5, 0x7fd, 0x83d, 0x84f, 0x878, 0x890, 0x8a8, 0x8bd, 0x8eb,
+	0x900, 0x918, 0x92d, 0x94e, 0x960, 0x96c, 0x9a6, 0x9c4, 0x9f5, 0xa13, 0xa25, 0xa4d,
+	0xa62, 0xa80, 0xaab, 0xabd, 0xac9, 0xae7, 0xb05, 0xb17, 0xb29, 0xb44, 0xb75, 0xb93,
+	0xc16, 0xc31, 0xc40, 0xc62, 0xc74, 0xd05, 0xd59, 0xd74, 0xd8c, 0xd9b, 0xdb0, 0xde1,
+	0xdfc, 0xe14, 0xe29, 0xe4e, 0xe63, 0xecc, 0xedb, 0xeea, 0xf02, 0xf14, 0xf23, 0xf38,
+	0xf50, 0xf65, 0xf74, 0xf8f, 0xfa4, 0xfbc, 0xfed, 0x1023, 0x104e, 0x1076, 0x108b, 0x10bf,
+	0x10d7, 0x10e6, 0x10f8, 0x1114, 0x1138, 0x1151, 0x1166, 0x1178, 0x1187, 0x11a8, 0x11ba, 0x11c9,
+	0x11db, 0x11ed, 0x1202, 0x1220, 0x123f, 0x1260, 0x1294, 0x12af, 0x12bb, 0x12e5, 0x1300, 0x1388,
+	0x13d5, 0x13f0, 0x140e, 0x1429, 0x1438, 0x144d, 0x1481, 0x1493, 0x14a8, 0x14c0, 0x14db, 0x14f0,
+	0x151b, 0x152d, 0x155e, 0x1573, 0x158e, 0x15b2, 0x15ca, 0x15dc, 0x15eb, 0x15f7, 0x161f, 0x162e,
+	0x1640, 0x164c, 0x1680, 0x16b2, 0x16d0, 0x16eb, 0x1703, 0x173c, 0x1773, 0x1792, 0x17d2, 0x17f0,
+	0x17ff, 0x1817, 0x1829, 0x1857, 0x186f, 0x1887, 0x189c, 0x18ae, 0x18c0, 0x18e8, 0x191f, 0x1953,
+	0x1965, 0x197a, 0x1995, 0x19d0, 0x19e8, 0x1a2d, 0x1a48, 0x1a67, 0x1a83, 0x1a9b, 0x1aad, 0x1ac5,
+	0x1af0, 0x1b26, 0x1b41, 0x1b63, 0x1b72, 0x1b90, 0x1bbc, 0x1c0d, 0x1c19, 0x1c6f, 0x1c7b, 0x1c93,
+	0x1cab, 0x1cc0, 0x1cdf, 0x1cfd, 0x1d0f, 0x1d1e, 0x1d33, 0x1d68, 0x1d7a, 0x1d8f, 0x1da7, 0x1dbc,
+	0x1dce, 0x1e10, 0x1e5d, 0x1e72, 0x1e8a, 0x1eb5, 0x1f03, 0x1f1e, 0x1f74, 0x1fbc, 0x1fd4, 0x1fe9,
+	0x2015, 0x2024, 0x2036, 0x2048, 0x205a, 0x2082, 0x2094, 0x20ac, 0x20d4, 0x20e9, 0x20fb, 0x212c,
+	0x215a, 0x2172, 0x21a0, 0x21d4, 0x2208, 0x2236, 0x2252, 0x227d, 0x2298, 0x22cc, 0x22ee, 0x231c,
+	0x2341, 0x2385, 0x23b0, 0x23d1, 0x23ec, 0x2423, 0x243e, 0x244a, 0x2475, 0x249d, 0x24af, 0x24e3,
+	0x2511, 0x253f, 0x256d,
+}
+
+const kkRegionStr = "" +
+	"Ғибадат аралыАндорраБіріккен Араб ЭмираттарыАуғанстанАнтигуа мен БарбудаАнгильяА" +
+	"лбанияАрменияАнголаАнтарктикаАргентинаАмерикан СамоасыАвстрияАвстралияАрубаАланд" +
+	" аралдарыӘзербайжанБосния және ГерцеговинаБарбадосБангладешБельгияБуркина-ФасоБо" +
+	"лгарияБахрейнБурундиБенинСен-БартелемиБермуд аралдарыБрунейБоливияКариб Нидерлан" +
+	"дысыБразилияБагам аралдарыБутанБуве аралыБотсванаБеларусьБелизКанадаКокос аралда" +
+	"рыКонгоОрталық Африка РеспубликасыКонго РеспубликасыШвейцарияКот-д’ИвуарКук арал" +
+	"дарыЧилиКамерунҚытайКолумбияКлиппертон аралыКоста-РикаКубаКабо-ВердеКюрасаоКрист" +
+	"мас аралыКипрЧех РеспубликасыАлманияДиего-ГарсияДжибутиДанияДоминикаДоминикан Ре" +
+	"спубликасыАлжирСеута мен МелильяЭквадорЭстонияМысырБатыс СахараЭритреяИспанияЭфи" +
+	"опияЕуропалық ОдақФинляндияФиджиФолкленд аралдарыМикронезияФарер аралдарыФранция" +
+	"ГабонҰлыбританияГренадаГрузияФранцуз ГвианасыГернсиГанаГибралтарГренландияГамбия" +
+	"ГвинеяГваделупаЭкваторлық ГвинеяГрекияОңтүстік Георгия және Оңтүстік Сандвичев а" +
+	"ралыГватемалаГуамГвинея-БисауГайанаҚытай Халық Республикасының Гонг-Конг арнайы " +
+	"әкімшілік ауданыХерд аралы мен Макдональд аралыГондурасХорватияГаитиВенгрияКанар" +
+	" аралдарыИндонезияИрландияИзраильМэн аралыИндияҮнді мұхитындағы Британ аймағыИра" +
+	"кИранИсландияИталияДжерсиЯмайкаИорданияЖапонияКенияҚырғызстанКамбоджаКирибатиКом" +
+	"орСент-Китс және НевисОңтүстік КореяСолтүстік КореяКувейтКайман аралдарыҚазақста" +
+	"нЛаосЛебанонСент-ЛюсияЛихтенштейнШри-ЛанкаЛиберияЛесотоЛитваЛюксембургЛатвияЛиби" +
+	"яМороккоМонакоМолдоваЧерногорияСен-МартенМадагаскарМаршалл аралдарыМакедонияМали" +
+	"Мьянма (Бирма)МоңғолияҚытай Халық Республикасының Макао арнайы әкімшілік ауданыС" +
+	"олтүстік Мариан аралдарыМартиникаМавританияМонтсерратМальтаМаврикийМальдив Респу" +
+	"бликасыМалавиМексикаМалайзияМозамбикНамибияЖаңа КаледонияНигерНорфолк аралыНигер" +
+	"ияНикарагуаГолландияНорвегияНепалНауруНиуэЖаңа ЗеландияОманПанамаПеруФранцуз Пол" +
+	"инезиясыПапуа — Жаңа ГвинеяФилиппиндерПәкістанПольшаСен-Пьер және МикелонПиткэрн" +
+	" аралдарыПуэрто-РикоПалестина аймақтарыПортугалияПалауПарагвайКатарАлыс ОкеанияР" +
+	"еюньонРумынияСербияРесейРуандаСауд АрабиясыСоломон аралдарыСейшель аралдарыСудан" +
+	"ШвецияСингапурӘулие Елена аралыСловенияШпицберген мен Ян-МайенСловакияСьерра-Лео" +
+	"неСан-МариноСенегалСомалиСуринамОңтүстік СуданСан-Томе мен ПринсипиСальвадорСинт" +
+	"-МартенСирияСвазилендТристан-да-КуньяТуркс пен КайкосЧадФранцияның солтүстік айм" +
+	"ағыТогоТайландТәжікстанТокелауТимор-ЛестеТүрікменстанТунисТонгаТүркияТринидад пе" +
+	"н ТобагоТувалуТайваньТанзанияУкраинаУгандаАҚШ-тың ішкі кіші аралдарыАҚШУругвайӨз" +
+	"бекстанВатиканСент-Винсент және ГренадиндерВенесуэлаБритандық Виргин аралдарыАҚШ" +
+	"-тың Виргин аралдарыВьетнамВануатуУоллис пен ФутунаСамоаКосовоЙеменМайоттаОңтүст" +
+	"ік Африка республикасыЗамбияЗимбабвеБелгісіз аймақӘлемАфрикаСолтүстік АмерикаОңт" +
+	"үстік АмерикаОкеанияБатыс АфрикаОрталық АмерикаШығыс АфрикаСолтүстік АфрикаОртал" +
+	"ық АфрикаОңтүстік АфрикаАмерикаларАмериканың солтүстігіКарибШығыс АзияОңтүстік А" +
+	"зияОңтүстік-шығыс АзияОңтүстік ЕуропаАвстралазияМеланезияМикронезия регионыПолин" +
+	"езияАзияОрталық АзияБатыс АзияЕуропаШығыс ЕуропаСолтүстік ЕуропаБатыс ЕуропаЛаты" +
+	"н Америкасы"
+
+var kkRegionIdx = []uint16{ // 291 entries
+	0x0, 0x19, 0x27, 0x55, 0x67, 0x8b, 0x99, 0xa7, 0xb5, 0xb5, 0xc1, 0xd5,
+	0xe7, 0x106, 0x114, 0x126, 0x130, 0x14b, 0x15f, 0x18b, 0x19b, 0x1ad, 0x1bb, 0x1d2,
+	0x1e2, 0x1f0, 0x1fe, 0x208, 0x221, 0x23e, 0x24a, 0x258, 0x27b, 0x28b, 0x2a6, 0x2b0,
+	0x2c3, 0x2d3, 0x2e3, 0x2ed, 0x2f9, 0x314, 0x31e, 0x352, 0x375, 0x387, 0x39d, 0x3b4,
+	0x3bc, 0x3ca, 0x3d4, 0x3e4, 0x403, 0x416, 0x41e, 0x431, 0x43f, 0x45a, 0x462, 0x481,
+	0x48f, 0x4a6, 0x4b4, 0x4be, 0x4ce, 0x4f9, 0x503, 0x523, 0x531, 0x53f, 0x549, 0x560,
+	0x56e, 0x57c, 0x58a, 0x5a5, 0x5b7, 0x5c1, 0x5e2, 0x5f6, 0x611, 0x61f, 0x629, 0x63f,
+	0x64d, 0x659, 0x678, 0x684, 0x68c, 0x69e, 0x6b2, 0x6be, 0x6ca, 0x6dc, 0x6fd, 0x709,
+	0x760, 0x772, 0x77a, 0x791, 0x79d, 0x810, 0x84a, 0x85a, 0x86a, 0x874, 0x882, 0x89d,
+	0x8af, 0x8bf, 0x8cd, 0x8de, 0x8e8, 0x921, 0x929, 0x931, 0x941, 0x94d, 0x959, 0x965,
+	0x975, 0x983, 0x98d, 0x9a1, 0x9b1, 0x9c1, 0x9cb, 0x9f0, 0xa0b, 0xa28, 0xa34, 0xa51,
+	0xa63, 0xa6b, 0xa79, 0xa8c, 0xaa2, 0xab3, 0xac1, 0xacd, 0xad7, 0xaeb, 0xaf7, 0xb01,
+	0xb0f, 0xb1b, 0xb29, 0xb3d, 0xb50, 0xb64, 0xb83, 0xb95, 0xb9d, 0xbb6, 0xbc6, 0xc32,
+	0xc62, 0xc74, 0xc88, 0xc9c, 0xca8, 0xcb8, 0xcdf, 0xceb, 0xcf9, 0xd09, 0xd19, 0xd27,
+	0xd42, 0xd4c, 0xd65, 0xd73, 0xd85, 0xd97, 0xda7, 0xdb1, 0xdbb, 0xdc3, 0xddc, 0xde4,
+	0xdf0, 0xdf8, 0xe1d, 0xe41, 0xe57, 0xe67, 0xe73, 0xe9a, 0xeb9, 0xece, 0xef3, 0xf07,
+	0xf11, 0xf21, 0xf2b, 0xf42, 0xf50, 0xf5e, 0xf6a, 0xf74, 0xf80, 0xf99, 0xfb8, 0xfd7,
+	0xfe1, 0xfed, 0xffd, 0x101d, 0x102d, 0x1058, 0x1068, 0x107f, 0x1092, 0x10a0, 0x10ac, 0x10ba,
+	0x10d5, 0x10fc, 0x110e, 0x1123, 0x112d, 0x113f, 0x115d, 0x117b, 0x1181, 0x11b5, 0x11bd, 0x11cb,
+	0x11dd, 0x11eb, 0x1200, 0x1218, 0x1222, 0x122c, 0x1238, 0x125c, 0x1268, 0x1276, 0x1286, 0x1294,
+	0x12a0, 0x12d0, 0x12d6, 0x12e4, 0x12f6, 0x1304, 0x133b, 0x134d, 0x137d, 0x13a8, 0x13b6, 0x13c4,
+	0x13e4, 0x13ee, 0x13fa, 0x1404, 0x1412, 0x1448, 0x1454, 0x1464, 0x147f, 0x1487, 0x1493, 0x14b4,
+	0x14d3, 0x14e1, 0x14f8, 0x1515, 0x152c, 0x154b, 0x1566, 0x1583, 0x1597, 0x15c0, 0x15ca, 0x15dd,
+	0x15f6, 0x161a, 0x1637, 0x164d, 0x165f, 0x1682, 0x1694, 0x169c, 0x16b3, 0x16c6, 0x16d2, 0x16e9,
+	0x1708, 0x171f, 0x173c,
+}
+
+const kmRegionStr = "" +
+	"កោះ​អាសេនសិនអង់ដូរ៉ាអេមីរ៉ែទអារ៉ាប់រួមអាហ្វហ្គានីស្ថានអង់ទីគ័រ និង​បាបុយដាអង់កូឡ" +
+	"ាអាល់បានីអារមេនីអង់ហ្គោឡាអង់តាទីកាអាហ្សង់ទីនសៅម៉ៅ អាមេរិកអូទ្រីសអូស្ត្រាលីអារូបា" +
+	"កោះ​អាឡាំងអាហ៊្សែរបែហ្សង់បូស្ន៉ីបារបាដូសបង់ក្លាដេស្ហបែលហ្ស៉ិកប៊ូរគីណាហ្វាសូប៊ុលហ" +
+	"្គារីបារ៉ែនប៊ូរុនឌីបេណាំងសង់ បាតេឡេម៉ីប៊ឺមុយដាព្រុយណេបូលីវីហុល្លង់ ការ៉ាប៊ីនប្រេ" +
+	"ស៊ីលបាហាម៉ាប៊ូតានកោះ​ប៊ូវ៉េតបុតស្វាណាបេឡារុស្សបេលីហ្សកាណាដាកោះ​កូកូសកុងហ្គោ- គីន" +
+	"ស្ហាសាសាធារណរដ្ឋអាហ្វ្រិកកណ្ដាលកុងហ្គោ - ប្រាហ្សាវីលស្វីសកូដឌីវ័រកោះ​ខូកស៊ីលីកាម" +
+	"េរូនចិនកូឡុំប៊ីកោះ​ឃ្លីភឺតុនកូស្តារីកាគុយបាកាបវែរកូរ៉ាកៅកោះ​គ្រីស្មាសស៉ីពរ៍សាធារ" +
+	"ណរដ្ឋឆេកអាល្លឺម៉ង់ឌៀហ្គោហ្គាស៊ីហ្ស៉ីបូទីដាណឺម៉ាកដូមីនីកាសាធារណរដ្ឋដូមីនីកែនអាល់ហ" +
+	"្សេរីជឺតា និង​ម៉េលីឡាអេក្វាឌ័រអេស្តូនីអេហ្ស៉ីបសាហារ៉ាខាងលិចអេរីទ្រាអេស្ប៉ាញអេត្យ" +
+	"ូពីសហភាព​អឺរ៉ុបហ្វាំងឡង់ហ្វីជីកោះ​ហ្វក់ឡែនមិក្រូនេស៊ីកោះ​ហ្វារ៉ូបារាំងហ្គាបុងចក្" +
+	"រភព​អង់គ្លេសហ្គ្រើណាដហ្សកហ្ស៉ីហ្វ្វេន​ហ្គៀណាហ្គេនស៊ីហ្គាណាហ្គីប្រាលតាហ្គ្រោអង់ឡង" +
+	"់ហ្គាំប៊ីហ្គីណេហ្គោដឺឡុបហ្គីណេអេក្វាទ័រក្រិចកោះ​ហ្សកហ្ស៊ី​ខាង​ត្បូង និង​សាន់វិច​" +
+	"ខាង​ត្បូងហ្គាតេម៉ាឡាហ្គាំហ្គីណេប៊ីសូហ្គីយ៉ាណាហុងកុងកោះ​ហឺដ និង​ម៉ាក់ដូណាល់ហុងឌួរ" +
+	"៉ាស់ក្រូអាតហៃទីហុងគ្រីកោះ​កាណារីឥណ្ឌូណេស៊ីអៀរឡង់អ៊ីស្រាអែលអែលអុហ្វមែនឥណ្ឌាដែន​មហ" +
+	"ា​សមុទ្រ​ឥណ្ឌា ចក្រភព​អង់គ្លេសអ៊ីរ៉ាក់អ៊ីរ៉ង់អ៊ីស្លង់អ៊ីតាលីជឺស៊ីហ្សាម៉ាអ៉ិគហ៊្ស" +
+	"កដានីជប៉ុនកេនយ៉ាគៀរហ្គីស្តង់កម្ពុជាគិរិបាទីកុំម៉ូរ៉ូសសង់ឃីត និង​ណេវីសកូរ៉េ​ខាង​ជ" +
+	"ើងកូរ៉េ​ខាង​ត្បូងគុយវ៉ែតកោះ​កៃម៉ង់កាហ្សាក់ស្តាង់់ឡាវលីបង់សង់​លូសៀលិចទេនស្តែនស្រី" +
+	"លង្កាលីបេរីយ៉ាលើសូតូលីទុយអានីលុចហ្សំបួរឡាតវីយ៉ាលីប៊ីម៉ារ៉ុកម៉ូណាកូសាធារណរដ្ឋម៉ុល" +
+	"ដាវីម៉ុងតេណេហ្គ្រោសង់​ម៉ាទីនម៉ាដាហ្កាស្ការកោះ​ម៉ាស់សលម៉ាសេដនម៉ាលីមីយ៉ាន់ម៉ាម៉ុងហ" +
+	"្គោលីម៉ាកាវកោះ​ម៉ារីណា​ខាង​ជើងម៉ាទីនីកម៉ូរីតានីម៉ុង​សេរ៉ង់ម៉ាល់តាម៉ូរីទុសម៉ាល់ឌី" +
+	"វម៉ាឡាវីម៉ិចសិកម៉ាឡេស៊ីម៉ូហ្សាំប៊ិកណាមីប៊ីញូកាឡេដូនៀនីហ្សេរកោះ​ណ័រហ្វក់នីហ្សេរីយ" +
+	"៉ានីការ៉ាហ្គ័រហុល្លង់ន័រវែសនេប៉ាល់ណូរូណៀនូវែលហ្សេឡង់អូម៉ង់ប៉ាណាម៉ាប៉េរូហ្វ្រេន​ប" +
+	"៉ូលី​ណេស៊ីប៉ាពូអានូវែលហ្គីណេហ្វីលីពីនប៉ាគីស្ថានប៉ូលូញសង់ព្យែរ និង​មីគីឡុងកោះ​ភីត" +
+	"ខារិនព័រតូរីកូដែន​ប៉ាលេស្ទីនព័រទុយហ្កាល់ផៅឡូប៉ារ៉ាហ្គាយកាតារអៅលីយីង អុកស៊ីនរ៉េអ៊" +
+	"ុយ៉ុងរូម៉ានីសើបរុស្ស៊ីរវ៉ាន់ដាអារ៉ាប៊ីសាអ៊ូឌីតកោះ​ស៊ូឡូម៉ុងសីសែលស៊ូដង់ស៊ុយអែដសិង" +
+	"្ហបុរីសង់​ហេឡេណាស្លូវេនីស្វាប៊ឺត និង​ហ្យង់ម៉ាយេនស្លូវ៉ាគីសេរ៉ាឡេអូនសាន​ម៉ារីណូសេ" +
+	"នេហ្កាល់សូម៉ាលីសូរីណាមស៊ូដង់​ខាង​ត្បូងសៅ​តុំ និង​ព្រីនស៊ីប៉េអែលសាល់វ៉ាឌ័រសីង​ម៉ា" +
+	"ធីនស៊ីរីសូហ្ស៉ីឡង់ទ្រីស្តង់​ដា​ចូនហាកោះ​កៃកូស និងទូកឆាដដែន​បារាំង​ខាង​ត្បូងតូហ្គ" +
+	"ូថៃតាដហ្ស៉ីគីស្តង់តូខេឡៅទីម័រទួគមេនីស្តង់ទុយនេស៊ីតុងហ្គាទួរគីទ្រីនីដាត និង​តូបាហ" +
+	"្គោទូវ៉ាលូតៃវ៉ាន់តង់ហ្សានីអ៊ុយក្រែនអ៊ូហ្កង់ដាកោះ​អៅឡាយីង​អាមេរិកសហរដ្ឋអាមេរិកអ៊ុ" +
+	"យរុយហ្គាយអ៊ូហ្សបេគីស្តង់ទីក្រុងវ៉ាទីកង់សាំង​វីនសេន និង​ឌឹ​ហ្គ្រីណាឌីនីសវេនេហ្ស៊ុ" +
+	"យឡាកោះ​វឺជិន​ចក្រភព​អង់គ្លេសកោះ​វឺជីន​អាមេរិកវៀតណាមវ៉ាន់ណៅទូវ៉ាលីស និង​ហ្វូទូណាស" +
+	"ាមូអាកូសូវ៉ូយេមែនម៉ាយុតអាព្រិក​ភាគ​ខាង​ត្បូងហ្សាំប៊ីហ្ស៊ីមបាបវ៉េមិន​ស្គាល់​តំបន់" +
+	"ពិភពលោកអាហ្វ្រិកអាមេរិក​ខាង​ជើងអាមេរិក​ខាង​ត្បូងមហា​សមុទ្រអាព្រិក​ខាង​លិចអាមេរិក" +
+	"​កណ្ដាលអាព្រិក​ខាង​កើតអាព្រិក​ខាង​ជើងអាព្រិក​កណ្ដាលអាព្រិក​ខាង​ត្បូងអាមេរិកអាមេរ" +
+	"ិក​ភាគ​ខាង​ជើងការ៉ាប៊ីនអាស៊ី​ខាង​កើតអាស៊ី​ខាង​ត្បូងអាស៊ី អាគ្នេយ៍អឺរ៉ុប​ខាង​ត្បូ" +
+	"ងអូស្ត្រាឡាស៊ីមេឡែណេស៊ីតំបន់​មីក្រូណេស៊ីប៉ូលីណេស៊ីអាស៊ីអាស៊ី​កណ្ដាលអាស៊ី​ខាង​លិច" +
+	"អឺរ៉ុបអឺរ៉ុប​ខាង​កើតអឺរ៉ុប​ខាង​ជើងអឺរ៉ុប​ខាង​លិចអាមេរិក​ឡាទីន"
+
+var kmRegionIdx = []uint16{ // 291 entries
+	0x0, 0x24, 0x3c, 0x72, 0xa2, 0xdc, 0xf1, 0x109, 0x11e, 0x11e, 0x139, 0x154,
+	0x172, 0x197, 0x1ac, 0x1ca, 0x1dc, 0x1fa, 0x227, 0x23c, 0x254, 0x278, 0x293, 0x2bd,
+	0x2db, 0x2ed, 0x305, 0x317, 0x33c, 0x354, 0x369, 0x37b, 0x3ac, 0x3c4, 0x3d9, 0x3eb,
+	0x40c, 0x427, 0x442, 0x457, 0x469, 0x484, 0x4b6, 0x501, 0x53a, 0x549, 0x561, 0x576,
+	0x585, 0x59a, 0x5a3, 0x5bb, 0x5e2, 0x600, 0x60f, 0x621, 0x636, 0x65d, 0x66f, 0x696,
+	0x6b4, 0x6db, 0x6f6, 0x70e, 0x726, 0x75f, 0x77d, 0x7ab, 0x7c6, 0x7de, 0x7f6, 0x81d,
+	0x835, 0x84d, 0x865, 0x889, 0x8a4, 0x8b6, 0x8da, 0x8fb, 0x91c, 0x92e, 0x943, 0x970,
+	0x98b, 0x9a6, 0x9d0, 0x9e8, 0x9fa, 0xa1b, 0xa3f, 0xa57, 0xa69, 0xa84, 0xab1, 0xac0,
+	0xb45, 0xb66, 0xb75, 0xb96, 0xbb1, 0xbc3, 0xc06, 0xc24, 0xc39, 0xc45, 0xc5a, 0xc78,
+	0xc96, 0xca8, 0xcc6, 0xce7, 0xcf6, 0xd60, 0xd78, 0xd8d, 0xda5, 0xdba, 0xdc9, 0xdea,
+	0xe05, 0xe14, 0xe26, 0xe4a, 0xe5f, 0xe77, 0xe95, 0xec3, 0xeea, 0xf17, 0xf2c, 0xf4a,
+	0xf77, 0xf80, 0xf8f, 0xfa7, 0xfc8, 0xfe3, 0xffe, 0x1010, 0x102b, 0x1049, 0x1061, 0x1070,
+	0x1085, 0x109a, 0x10d0, 0x10fa, 0x1118, 0x1142, 0x1163, 0x1178, 0x1187, 0x11a5, 0x11c3, 0x11d5,
+	0x120e, 0x1226, 0x1241, 0x1262, 0x1277, 0x128f, 0x12a7, 0x12bc, 0x12d1, 0x12e9, 0x130d, 0x1322,
+	0x1340, 0x1355, 0x1379, 0x139a, 0x13be, 0x13d3, 0x13e5, 0x13fa, 0x1406, 0x140c, 0x1430, 0x1442,
+	0x145a, 0x1469, 0x14a2, 0x14d8, 0x14f3, 0x1511, 0x1523, 0x155d, 0x1581, 0x159c, 0x15c6, 0x15ea,
+	0x15f6, 0x1617, 0x1626, 0x1651, 0x166f, 0x1684, 0x168d, 0x16a2, 0x16ba, 0x16ea, 0x1711, 0x1720,
+	0x1732, 0x1747, 0x1762, 0x1780, 0x1798, 0x17de, 0x17f9, 0x1817, 0x1838, 0x1856, 0x186b, 0x1880,
+	0x18b0, 0x18f0, 0x1917, 0x1935, 0x1944, 0x1962, 0x1998, 0x19c6, 0x19cf, 0x1a0b, 0x1a1d, 0x1a23,
+	0x1a50, 0x1a62, 0x1a71, 0x1a95, 0x1aad, 0x1ac2, 0x1ad1, 0x1b11, 0x1b26, 0x1b3b, 0x1b56, 0x1b71,
+	0x1b8f, 0x1bc8, 0x1bef, 0x1c13, 0x1c40, 0x1c6d, 0x1ccb, 0x1cef, 0x1d3a, 0x1d6d, 0x1d7f, 0x1d9a,
+	0x1dd1, 0x1de3, 0x1df8, 0x1e07, 0x1e19, 0x1e58, 0x1e70, 0x1e94, 0x1ec4, 0x1ed9, 0x1ef4, 0x1f21,
+	0x1f54, 0x1f72, 0x1f9f, 0x1fc9, 0x1ff6, 0x2023, 0x204d, 0x2080, 0x2095, 0x20ce, 0x20e9, 0x2110,
+	0x213d, 0x2165, 0x2195, 0x21bc, 0x21d7, 0x220a, 0x2228, 0x2237, 0x225b, 0x2282, 0x2294, 0x22be,
+	0x22e8, 0x2312, 0x2339,
+}
+
+const knRegionStr = "" +
+	"ಅಸೆನ್ಶನ್ ದ್ವೀಪಅಂಡೋರಾಸಂಯುಕ್ತ ಅರಬ್ ಎಮಿರೇಟಸ್ಅಫಘಾನಿಸ್ಥಾನ್ಆಂಟಿಗುವಾ ಮತ್ತು ಬರ್ಬುಡಾಆಂಗುಯ" +
+	"ಿಲ್ಲಾಅಲ್ಬೇನಿಯಾಅರ್ಮೇನಿಯಾನೆದರ್‌ಲ್ಯಾಂಡ್ಅಂಗೋಲಾಅಂಟಾರ್ಟಿಕಾಅರ್ಜೆಂಟಿನಾಅಮೇರಿಕನ್ ಸಮೋವಾಆಸ್ಟ" +
+	"್ರಿಯಾಆಸ್ಟ್ರೇಲಿಯಅರುಬಾಆಲ್ಯಾಂಡ್ ದ್ವೀಪಗಳುಅಜರ್ಬೈಜಾನ್ಬೋಸ್ನಿಯಾ ಮತ್ತು ಹರ್ಜೆಗೋವಿನಾಬಾರ್ಬಡೋ" +
+	"ಸ್ಬಾಂಗ್ಲಾದೇಶ್ಬೆಲ್ಜಿಯಮ್ಬುರ್ಕಿನಾ ಫಾಸೋಬಲ್ಗೇರಿಯಾಬಹರೈನ್ಬುರುಂಡಿಬೆನಿನ್ಸೇಂಟ್ ಬಾರ್ಥೆಲೆಮಿಬ" +
+	"ರ್ಮುಡಾಬ್ರೂನಿಬೊಲಿವಿಯಾಕೆರೀಬಿಯನ್ ನೆದರ್‌ಲ್ಯಾಂಡ್ಸ್ಬ್ರೆಜಿಲ್ಬಹಾಮಾಸ್ಭೂತಾನ್ಬೋವೆಟ್ ದ್ವೀಪಬೋ" +
+	"ಟ್ಸ್‌ವಾನಾಬೆಲಾರಸ್ಬೆಲಿಜ್ಕೆನಡಾಕೊಕೊಸ್ ದ್ವೀಪಗಳುಕಾಂಗೋ - ಕಿನ್ಶಾಸಾಮಧ್ಯ ಆಫ್ರಿಕಾ ಗಣರಾಜ್ಯಕಾ" +
+	"ಂಗೋ - ಬ್ರಾಜಾವಿಲ್ಲೇಸ್ವಿಟ್ಜರ್ಲ್ಯಾಂಡ್ಕೋತ್‌ ದಿವಾರ್‍ಕುಕ್ ದ್ವೀಪಗಳುಚಿಲಿಕ್ಯಾಮರೋನ್ಚೀನಾಕೊಲ" +
+	"ಂಬಿಯಾಕ್ಲಿಪ್ಪರ್‌ಟಾನ್ ದ್ವೀಪಗಳುಕೊಸ್ಟಾ ರಿಕಾಕ್ಯೂಬಾಕೇಪ್ ವರ್ಡೆಕುರಾಕಾವ್ಕ್ರಿಸ್ಮಸ್ ದ್ವೀಪಸೈ" +
+	"ಪ್ರಸ್ಚೆಕ್ ರಿಪಬ್ಲಿಕ್ಜರ್ಮನಿಡೈಗೋ ಗಾರ್ಸಿಯಜಿಬೋಟಿಡೆನ್ಮಾರ್ಕ್ಡೊಮಿನಿಕಾಡೊಮೆನಿಕನ್ ರಿಪಬ್ಲಿಕ್" +
+	"ಅಲ್ಗೇರಿಯಾಸೆಯುಟಾ ಹಾಗೂ ಮೆಲಿಲ್ಲಾಈಕ್ವೆಡಾರ್ಎಸ್ಟೋನಿಯಾಈಜಿಪ್ಟ್ಪಶ್ಚಿಮ ಸಹಾರಾಏರಿಟ್ರಿಯಾಸ್ಪೇನ" +
+	"್ಇಥಿಯೋಪಿಯಾಯುರೋಪಿಯನ್ ಯೂನಿಯನ್ಫಿನ್‌ಲ್ಯಾಂಡ್ಫಿಜಿಫಾಲ್ಕ್‌ಲ್ಯಾಂಡ್ ದ್ವೀಪಗಳುಮೈಕ್ರೋನೇಶಿಯಾಫರ" +
+	"ೋ ದ್ವೀಪಗಳುಫ್ರಾನ್ಸ್ಗೆಬೊನ್ಬ್ರಿಟನ್/ಇಂಗ್ಲೆಂಡ್ಗ್ರೆನೆಡಾಜಾರ್ಜಿಯಾಫ್ರೆಂಚ್ ಗಯಾನಾಗುರ್ನ್‌ಸೆಘ" +
+	"ಾನಾಗಿಬ್ರಾಲ್ಟರ್ಗ್ರೀನ್‌ಲ್ಯಾಂಡ್ಗ್ಯಾಂಬಿಯಾಗಿನಿಗುಡೆಲೋಪ್ಈಕ್ವೆಟೋರಿಯಲ್ ಗಿನಿಗ್ರೀಸ್ದಕ್ಷಿಣ ಜ" +
+	"ಾರ್ಜಿಯಾ ಮತ್ತು ದಕ್ಷಿಣ ಸ್ಯಾಂಡ್ವಿಚ್ ದ್ವೀಪಗಳುಗ್ವಾಟೆಮಾಲಾಗುಯಾಮ್ಗಿನಿ-ಬಿಸ್ಸಾವ್ಗಯಾನಾಹಾಂಕ್" +
+	"‌ ಕಾಂಗ್ SAR ಚೈನಾಹರ್ಡ್ ದ್ವೀಪ ಮತ್ತು ಮ್ಯಾಕ್ಡೊನಾಲ್ಡ್ ದ್ವೀಪಗಳುಹೊಂಡುರಾಸ್ಕ್ರೊಯೇಶಿಯಾಹೈಟಿ" +
+	"ಹಂಗಾರಿಕ್ಯಾನರಿ ದ್ವೀಪಗಳುಇಂಡೋನೇಶಿಯಾಐರ್ಲೆಂಡ್ಇಸ್ರೇಲ್ಐಲ್ ಆಫ್ ಮ್ಯಾನ್ಭಾರತಬ್ರಿಟೀಶ್ ಇಂಡಿಯನ" +
+	"್ ಮಹಾಸಾಗರ ಪ್ರದೇಶಇರಾಕ್ಇರಾನ್ಐಸ್‌ಲ್ಯಾಂಡ್ಇಟಲಿಜೆರ್ಸಿಜಮೈಕಾಜೋರ್ಡಾನ್ಜಪಾನ್ಕೀನ್ಯಾಕಿರ್ಗಿಸ್ಥ" +
+	"ಾನ್ಕಾಂಬೋಡಿಯಾಕಿರಿಬಾತಿಕೊಮೊರೊಸ್ಸೇಂಟ್ ಕಿಟ್ಸ್ ಮತ್ತು ನೆವಿಸ್ಉತ್ತರ ಕೋರಿಯಾದಕ್ಷಿಣ ಕೋರಿಯಾಕು" +
+	"ವೈತ್ಕೇಮನ್ ದ್ವೀಪಗಳುಕಝಾಕಿಸ್ಥಾನ್ಲಾವೋಸ್ಲೆಬನಾನ್ಸೇಂಟ್ ಲೂಸಿಯಾಲಿಚೆನ್‌ಸ್ಟೈನ್ಶ್ರೀಲಂಕಾಲಿಬೇರ" +
+	"ಿಯಾಲೆಸೊಥೋಲಿಥುವೇನಿಯಾಲಕ್ಸಂಬರ್ಗ್ಲಾಟ್ವಿಯಾಲಿಬಿಯಾಮೊರಾಕ್ಕೊಮೊನಾಕೊಮೊಲ್ಡೋವಾಮೊಂಟೆನೆಗ್ರೋಸೇಂಟ" +
+	"್ ಮಾರ್ಟಿನ್ಮಡಗಾಸ್ಕರ್ಮಾರ್ಶಲ್ ದ್ವೀಪಗಳುಮ್ಯಾಸಿಡೋನಿಯಾಮಾಲಿಮಯನ್ಮಾರ್ಮೊಂಗೋಲಿಯಾಮಖಾವ್ SAR ಚೈ" +
+	"ನಾಉತ್ತರ ಮರಿಯಾನಾ ದ್ವೀಪಗಳುಮಾರ್ಟಿನಿಕ್ಮಾರಿಟೇನಿಯಾಮಾಂಟ್‌ಸೆರೇಟ್ಮಾಲ್ಟಾಮಾರಿಶಿಯಸ್ಮಾಲ್ಡಿವ್ಸ" +
+	"್ಮಲಾವಿಮೆಕ್ಸಿಕೊಮಲೇಶಿಯಾಮೊಜಾಂಬಿಕ್ನಮೀಬಿಯಾನ್ಯೂ ಕ್ಯಾಲಿಡೋನಿಯಾನೈಜರ್ನಾರ್ಫೋಕ್ ದ್ವೀಪನೈಜೀರಿಯ" +
+	"ಾನಿಕಾರಾಗುವಾನೆದರ್‌ಲ್ಯಾಂಡ್ಸ್ನಾರ್ವೇನೇಪಾಳನೌರುನಿಯುನ್ಯೂಜಿಲೆಂಡ್ಓಮನ್ಪನಾಮಾಪೆರುಫ್ರೆಂಚ್ ಪೋಲ" +
+	"ಿನೇಶಿಯಾಪಪುವಾ ನ್ಯೂಗೀನಿಯಾಫಿಲಿಫೈನ್ಸ್ಪಾಕಿಸ್ತಾನಪೋಲ್ಯಾಂಡ್ಸೇಂಟ್ ಪಿಯರೆ ಮತ್ತು ಮಿಕೆಲನ್ಪಿಟ್" +
+	"‌ಕೈರ್ನ್ ದ್ವೀಪಗಳುಪ್ಯೂರ್ಟೋ ರಿಕೊಪ್ಯಾಲಿಸ್ಟೇನಿಯನ್ ಪ್ರದೇಶಪೋರ್ಚುಗಲ್ಪಲಾವುಪರಾಗ್ವೇಕತಾರ್ಔಟ್" +
+	"‌‌ಲೇಯಿಂಗ್ ಓಷಿಯಾನಿಯರೀಯೂನಿಯನ್ರೊಮೇನಿಯಾಸೆರ್ಬಿಯಾರಷ್ಯಾರುವಾಂಡಾಸೌದಿ ಅರೇಬಿಯಾಸೊಲೊಮನ್ ದ್ವೀಪ" +
+	"ಗಳುಸೀಶೆಲ್ಲೆಸ್ಸೂಡಾನ್ಸ್ವೀಡನ್ಸಿಂಗಪುರಸೇಂಟ್ ಹೆಲೆನಾಸ್ಲೋವೇನಿಯಾಸ್ವಾಲ್ಬಾರ್ಡ್ ಮತ್ತು ಜಾನ್ ಮ" +
+	"ೆಯನ್ಸ್ಲೋವೇಕಿಯಾಸಿಯೆರ್ರಾ ಲಿಯೋನ್ಸ್ಯಾನ್ ಮೆರಿನೋಸೆನೆಗಲ್ಸೊಮಾಲಿಯಾಸುರಿನಾಮದಕ್ಷಿಣ ಸೂಡಾನ್ಸಾವ" +
+	"ೋ ಟೋಮ್ ಮತ್ತು ಪ್ರಿನ್ಸಿಪಿಎಲ್ ಸಾಲ್ವೇಡಾರ್ಸಿಂಟ್ ಮಾರ್ಟೆನ್ಸಿರಿಯಾಸ್ವಾಜಿಲ್ಯಾಂಡ್ಟ್ರಿಸ್ತನ್ " +
+	"ಡಾ ಕುನ್ಹಾಟರ್ಕ್ಸ್ ಮತ್ತು ಕೈಕೋಸ್ ದ್ವೀಪಗಳುಚಾದ್ಫ್ರೆಂಚ್ ದಕ್ಷಿಣ ಪ್ರದೇಶಗಳುಟೋಗೋಥೈಲ್ಯಾಂಡ್ತ" +
+	"ಜಾಕಿಸ್ಥಾನ್ಟೊಕೆಲಾವ್ಪೂರ್ವ ತಿಮೋರ್ತುರ್ಕ್ಮೇನಿಸ್ಥಾನ್ಟುನಿಶಿಯಾಟೊಂಗಟರ್ಕಿಟ್ರಿನಿಡಾಡ್ ಮತ್ತು " +
+	"ಟೊಬಾಗೊಟುವಾಲುಥೈವಾನ್ತಾಂಜೇನಿಯಾಉಕ್ರೈನ್ಉಗಾಂಡಾಯುಎಸ್‌. ಔಟ್‌ಲೇಯಿಂಗ್ ದ್ವೀಪಗಳುಅಮೇರಿಕಾ ಸಂಯು" +
+	"ಕ್ತ ಸಂಸ್ಥಾನಉರುಗ್ವೇಉಜ್ಬೇಕಿಸ್ಥಾನ್ವ್ಯಾಟಿಕನ್ಸೇಂಟ್ ವಿನ್ಸೆಂಟ್ ಮತ್ತು ಗ್ರೆನೆಡೈನ್ಸ್ವೆನೆಜು" +
+	"ವೆಲಾಬ್ರಿಟಿಷ್ ವರ್ಜಿನ್ ದ್ವೀಪಗಳುಯು.ಎಸ್. ವರ್ಜಿನ್ ದ್ವೀಪಗಳುವಿಯೇಟ್ನಾಮ್ವನೌಟುವಾಲಿಸ್ ಮತ್ತು" +
+	" ಫುಟುನಾಸಮೋವಾಕೊಸೊವೊಯೆಮನ್ಮಯೊಟ್ಟೆದಕ್ಷಿಣ ಆಫ್ರಿಕಾಝಾಂಬಿಯಾಜಿಂಬಾಬ್ವೆಅಮಾನ್ಯ ಪ್ರದೇಶಪ್ರಪಂಚಆ" +
+	"ಫ್ರಿಕಾಉತ್ತರ ಅಮೇರಿಕಾದಕ್ಷಿಣ ಅಮೇರಿಕಾಓಶಿಯೇನಿಯಾಪಶ್ಚಿಮ ಆಫ್ರಿಕಾಮಧ್ಯ ಅಮೇರಿಕಾಪೂರ್ವ ಆಫ್ರಿಕ" +
+	"ಾಉತ್ತರ ಆಫ್ರಿಕಾಮಧ್ಯ ಆಫ್ರಿಕಾಆಫ್ರಿಕಾದ ದಕ್ಷಿಣ ಭಾಗಅಮೆರಿಕಾಸ್ಅಮೇರಿಕಾದ ಉತ್ತರ ಭಾಗಕೆರೀಬಿಯನ" +
+	"್ಏಷ್ಯಾದ ಪೂರ್ವ ಭಾಗಏಷ್ಯಾದ ದಕ್ಷಿಣ ಭಾಗಆಗ್ನೇಯ ಏಷ್ಯಾದಕ್ಷಿಣ ಯೂರೋಪ್ಆಸ್ಟ್ರೇಲಿಯೇಷಿಯಾಮೇಲಿನೇ" +
+	"ಶಿಯಾಮೈಕ್ರೋನೇಶಿಯನ್ ಪ್ರದೇಶಪೋಲಿನೇಶಿಯಾಏಷ್ಯಾಮಧ್ಯ ಏಷ್ಯಾಪಶ್ಚಿಮ ಏಷ್ಯಾಯೂರೋಪ್ಪೂರ್ವ ಯೂರೋಪ್ಉ" +
+	"ತ್ತರ ಯೂರೋಪ್ಪಶ್ಚಿಮ ಯೂರೋಪ್ಲ್ಯಾಟಿನ್ ಅಮೇರಿಕಾ"
+
+var knRegionIdx = []uint16{ // 291 entries
+	0x0, 0x28, 0x3a, 0x75, 0x99, 0xd7, 0xf5, 0x110, 0x12b, 0x152, 0x164, 0x182,
+	0x1a0, 0x1c8, 0x1e3, 0x201, 0x210, 0x241, 0x25f, 0x2a9, 0x2c4, 0x2e5, 0x300, 0x325,
+	0x340, 0x352, 0x367, 0x379, 0x3a7, 0x3bc, 0x3ce, 0x3e6, 0x42f, 0x447, 0x45c, 0x46e,
+	0x490, 0x4b1, 0x4c6, 0x4d8, 0x4e7, 0x512, 0x53c, 0x574, 0x5aa, 0x5da, 0x5ff, 0x624,
+	0x630, 0x64b, 0x657, 0x66f, 0x6b2, 0x6d1, 0x6e3, 0x6ff, 0x717, 0x742, 0x757, 0x77f,
+	0x791, 0x7b3, 0x7c5, 0x7e3, 0x7fb, 0x832, 0x84d, 0x885, 0x8a0, 0x8bb, 0x8d0, 0x8f2,
+	0x90d, 0x91f, 0x93a, 0x96b, 0x98f, 0x99b, 0x9de, 0xa02, 0xa24, 0xa3c, 0xa4e, 0xa7f,
+	0xa97, 0xaaf, 0xad4, 0xaef, 0xafb, 0xb1c, 0xb46, 0xb61, 0xb6d, 0xb85, 0xbb6, 0xbc8,
+	0xc51, 0xc6f, 0xc81, 0xca6, 0xcb5, 0xce8, 0xd5b, 0xd76, 0xd94, 0xda0, 0xdb2, 0xde0,
+	0xdfe, 0xe16, 0xe2b, 0xe51, 0xe5d, 0xeb4, 0xec3, 0xed2, 0xef3, 0xeff, 0xf11, 0xf20,
+	0xf38, 0xf47, 0xf59, 0xf7d, 0xf98, 0xfb0, 0xfc8, 0x100d, 0x102f, 0x1054, 0x1066, 0x108e,
+	0x10af, 0x10c1, 0x10d6, 0x10f8, 0x111f, 0x1137, 0x114f, 0x1161, 0x117f, 0x119d, 0x11b5, 0x11c7,
+	0x11df, 0x11f1, 0x1209, 0x122a, 0x1252, 0x126d, 0x129b, 0x12bf, 0x12cb, 0x12e3, 0x12fe, 0x131e,
+	0x135c, 0x137a, 0x1398, 0x13bc, 0x13ce, 0x13e9, 0x1407, 0x1416, 0x142e, 0x1443, 0x145e, 0x1473,
+	0x14a4, 0x14b3, 0x14db, 0x14f3, 0x1511, 0x153e, 0x1550, 0x155f, 0x156b, 0x1577, 0x1598, 0x15a4,
+	0x15b3, 0x15bf, 0x15f3, 0x1621, 0x163f, 0x165a, 0x1675, 0x16ba, 0x16f4, 0x1719, 0x1759, 0x1774,
+	0x1783, 0x1798, 0x17a7, 0x17e4, 0x17ff, 0x1817, 0x182f, 0x183e, 0x1853, 0x1875, 0x18a3, 0x18c1,
+	0x18d3, 0x18e8, 0x18fd, 0x191f, 0x193d, 0x198e, 0x19ac, 0x19d7, 0x19fc, 0x1a11, 0x1a29, 0x1a3e,
+	0x1a63, 0x1aab, 0x1ad3, 0x1afb, 0x1b0d, 0x1b34, 0x1b69, 0x1bba, 0x1bc6, 0x1c0a, 0x1c16, 0x1c31,
+	0x1c52, 0x1c6a, 0x1c8c, 0x1cbc, 0x1cd4, 0x1ce0, 0x1cef, 0x1d30, 0x1d42, 0x1d54, 0x1d6f, 0x1d84,
+	0x1d96, 0x1de4, 0x1e25, 0x1e3a, 0x1e61, 0x1e7c, 0x1edc, 0x1efa, 0x1f41, 0x1f81, 0x1f9f, 0x1fae,
+	0x1fe3, 0x1ff2, 0x2004, 0x2013, 0x2028, 0x2050, 0x2065, 0x2080, 0x20a5, 0x20b7, 0x20cc, 0x20f1,
+	0x2119, 0x2134, 0x215c, 0x217e, 0x21a3, 0x21c8, 0x21ea, 0x221f, 0x223a, 0x226c, 0x2287, 0x22b3,
+	0x22e2, 0x2304, 0x2329, 0x2356, 0x2374, 0x23ae, 0x23cc, 0x23db, 0x23f7, 0x2419, 0x242b, 0x244d,
+	0x246f, 0x2494, 0x24c2,
+}
+
+const koRegionStr = "" +
+	"어센션 섬안도라아랍에미리트 연합아프가니스탄앤티가 바부다안길라알바니아아르메니아네덜란드령 안틸레스앙골라남극 대륙아르헨티나아메리칸 사모아오스트리아오스" +
+	"트레일리아아루바올란드 제도아제르바이잔보스니아 헤르체고비나바베이도스방글라데시벨기에부르키나파소불가리아바레인부룬디베냉생 바르텔르미버뮤다브루나이볼리비아" +
+	"네덜란드령 카리브브라질바하마부탄부베보츠와나벨라루스벨리즈캐나다코코스제도콩고-킨샤사중앙 아프리카 공화국콩고스위스코트디부아르쿡제도칠레카메룬중국콜롬비아" +
+	"클립퍼튼 섬코스타리카쿠바까뽀베르데퀴라소크리스마스섬사이프러스체코독일디에고 가르시아지부티덴마크도미니카도미니카 공화국알제리세우타 및 멜리야에콰도르에스" +
+	"토니아이집트서사하라에리트리아스페인이디오피아유럽 연합핀란드피지포클랜드 제도미크로네시아페로제도프랑스가봉영국그레나다조지아프랑스령 기아나건지가나지브롤터" +
+	"그린란드감비아기니과들루프적도 기니그리스사우스조지아 사우스샌드위치 제도과테말라괌기네비쏘가이아나홍콩, 중국 특별행정구허드섬-맥도널드제도온두라스크로아" +
+	"티아아이티헝가리카나리아 제도인도네시아아일랜드이스라엘맨 섬인도영국령인도양식민지이라크이란아이슬란드이탈리아저지자메이카요르단일본케냐키르기스스탄캄보디아키" +
+	"리바시코모로스세인트크리스토퍼 네비스조선 민주주의 인민 공화국대한민국쿠웨이트케이맨제도카자흐스탄라오스레바논세인트루시아리히텐슈타인스리랑카라이베리아레소" +
+	"토리투아니아룩셈부르크라트비아리비아모로코모나코몰도바몬테네그로생 마르탱마다가스카르마샬 군도마케도니아말리미얀마몽골마카오, 중국 특별행정구북마리아나제도" +
+	"말티니크모리타니몬트세라트몰타모리셔스몰디브말라위멕시코말레이시아모잠비크나미비아뉴 칼레도니아니제르노퍽섬나이지리아니카라과네덜란드노르웨이네팔나우루니우에뉴" +
+	"질랜드오만파나마페루프랑스령 폴리네시아파푸아뉴기니필리핀파키스탄폴란드세인트피에르-미케롱핏케언섬푸에르토리코팔레스타인 지구포르투갈팔라우파라과이카타르오세" +
+	"아니아 외곽리유니온루마니아세르비아러시아르완다사우디아라비아솔로몬 제도쉐이쉘수단스웨덴싱가포르세인트헬레나슬로베니아스발바르제도-얀마웬섬슬로바키아시에라리" +
+	"온산마리노세네갈소말리아수리남남수단상투메 프린시페엘살바도르신트마르턴시리아스와질랜드트리스탄다쿠나터크스케이커스제도차드프랑스 남부 지방토고태국타지키스탄" +
+	"토켈라우동티모르투르크메니스탄튀니지통가터키트리니다드 토바고투발루대만탄자니아우크라이나우간다미국령 해외 제도미국우루과이우즈베키스탄바티칸세인트빈센트그레" +
+	"나딘베네수엘라영국령 버진 아일랜드미국령 버진 아일랜드베트남바누아투왈리스-푸투나 제도사모아코소보예멘마요티남아프리카잠비아짐바브웨알수없거나 유효하지 " +
+	"않은 지역세계아프리카북아메리카남아메리카(남미)오세아니아서아프리카중앙 아메리카동부 아프리카북부 아프리카중부 아프리카남부 아프리카아메리카 대륙북부 " +
+	"아메리카카리브 해 제도동아시아남아시아동남 아시아남유럽오스트랄라시아멜라네시아미크로네시아 지역폴리네시아아시아중앙 아시아서아시아유럽동유럽북유럽서유럽라" +
+	"틴 아메리카"
+
+var koRegionIdx = []uint16{ // 291 entries
+	0x0, 0xd, 0x16, 0x2f, 0x41, 0x54, 0x5d, 0x69, 0x78, 0x94, 0x9d, 0xaa,
+	0xb9, 0xcf, 0xde, 0xf3, 0xfc, 0x10c, 0x11e, 0x13d, 0x14c, 0x15b, 0x164, 0x176,
+	0x182, 0x18b, 0x194, 0x19a, 0x1ad, 0x1b6, 0x1c2, 0x1ce, 0x1e7, 0x1f0, 0x1f9, 0x1ff,
+	0x205, 0x211, 0x21d, 0x226, 0x22f, 0x23e, 0x24e, 0x26b, 0x271, 0x27a, 0x28c, 0x295,
+	0x29b, 0x2a4, 0x2aa, 0x2b6, 0x2c6, 0x2d5, 0x2db, 0x2ea, 0x2f3, 0x305, 0x314, 0x31a,
+	0x320, 0x336, 0x33f, 0x348, 0x354, 0x36a, 0x373, 0x38a, 0x396, 0x3a5, 0x3ae, 0x3ba,
+	0x3c9, 0x3d2, 0x3e1, 0x3ee, 0x3f7, 0x3fd, 0x410, 0x422, 0x42e, 0x437, 0x43d, 0x443,
+	0x44f, 0x458, 0x46e, 0x474, 0x47a, 0x486, 0x492, 0x49b, 0x4a1, 0x4ad, 0x4ba, 0x4c3,
+	0x4f2, 0x4fe, 0x501, 0x50d, 0x519, 0x537, 0x553, 0x55f, 0x56e, 0x577, 0x580, 0x593,
+	0x5a2, 0x5ae, 0x5ba, 0x5c1, 0x5c7, 0x5e2, 0x5eb, 0x5f1, 0x600, 0x60c, 0x612, 0x61e,
+	0x627, 0x62d, 0x633, 0x645, 0x651, 0x65d, 0x669, 0x68b, 0x6af, 0x6bb, 0x6c7, 0x6d6,
+	0x6e5, 0x6ee, 0x6f7, 0x709, 0x71b, 0x727, 0x736, 0x73f, 0x74e, 0x75d, 0x769, 0x772,
+	0x77b, 0x784, 0x78d, 0x79c, 0x7a9, 0x7bb, 0x7c8, 0x7d7, 0x7dd, 0x7e6, 0x7ec, 0x80d,
+	0x822, 0x82e, 0x83a, 0x849, 0x84f, 0x85b, 0x864, 0x86d, 0x876, 0x885, 0x891, 0x89d,
+	0x8b0, 0x8b9, 0x8c2, 0x8d1, 0x8dd, 0x8e9, 0x8f5, 0x8fb, 0x904, 0x90d, 0x919, 0x91f,
+	0x928, 0x92e, 0x94a, 0x95c, 0x965, 0x971, 0x97a, 0x996, 0x9a2, 0x9b4, 0x9ca, 0x9d6,
+	0x9df, 0x9eb, 0x9f4, 0xa0a, 0xa16, 0xa22, 0xa2e, 0xa37, 0xa40, 0xa55, 0xa65, 0xa6e,
+	0xa74, 0xa7d, 0xa89, 0xa9b, 0xaaa, 0xac9, 0xad8, 0xae7, 0xaf3, 0xafc, 0xb08, 0xb11,
+	0xb1a, 0xb30, 0xb3f, 0xb4e, 0xb57, 0xb66, 0xb7b, 0xb96, 0xb9c, 0xbb3, 0xbb9, 0xbbf,
+	0xbce, 0xbda, 0xbe6, 0xbfb, 0xc04, 0xc0a, 0xc10, 0xc29, 0xc32, 0xc38, 0xc44, 0xc53,
+	0xc5c, 0xc73, 0xc79, 0xc85, 0xc97, 0xca0, 0xcbe, 0xccd, 0xcea, 0xd07, 0xd10, 0xd1c,
+	0xd36, 0xd3f, 0xd48, 0xd4e, 0xd57, 0xd66, 0xd6f, 0xd7b, 0xda5, 0xdab, 0xdb7, 0xdc6,
+	0xddd, 0xdec, 0xdfb, 0xe0e, 0xe21, 0xe34, 0xe47, 0xe5a, 0xe6d, 0xe80, 0xe94, 0xea0,
+	0xeac, 0xebc, 0xec5, 0xeda, 0xee9, 0xf02, 0xf11, 0xf1a, 0xf2a, 0xf36, 0xf3c, 0xf45,
+	0xf4e, 0xf57, 0xf6a,
+}
+
+const kyRegionStr = "" +
+	"Асенсион аралыАндорраБириккен Араб ЭмираттарыАфганистанАнтигуа жана БарбудаАнгуи" +
+	"лаАлбанияАрменияАнголаАнтарктикаАргентинаАмерика СамоасыАвстрияАвстралияАрубаАла" +
+	"нд аралдарыАзербайжанБосния жана ГерцеговинаБарбадосБангладешБелгияБуркина ФасоБ" +
+	"олгарияБахрейнБурундиБенинЫйык БартелемиБермудаБрунейБоливияКариб НидерланддарыБ" +
+	"разилияБагамасБутанБуве аралдарыБотсванаБеларусьБелизКанадаКокос (Килиӊ) аралдар" +
+	"ыКонго - КиншасаБорбордук Африка РеспубликасыКонго - БраззавилШвейцарияКот-д-Иву" +
+	"арКук аралдарыЧилиКамерунКытайКолумбияКлиппертон аралыКоста РикаКубаКапе ВердеКю" +
+	"расаоКрисмас аралыКипрЧехияГерманияДиего ГарсияЖибутиДанияДоминикаДоминика Респу" +
+	"бликасыАлжирСеута жана МелиллаЭкуадорЭстонияМисирБатыш СахараЭритреаИспанияЭфиоп" +
+	"ияЕвропа БирлигиФинляндияФижиФолклэнд аралдарыМикронезияФарер аралдарыФранцияГаб" +
+	"онУлуу БританияГренадаГрузияФранцуз ГуианасыГернсиГанаГибралтарГренландияГамбияГ" +
+	"инеГваделупаЭкваториялык ГинеГрекияТүштүк Жоржия жана Түштүк Сэндвич аралдарыГва" +
+	"темалаГуамГине-БисауГуианаГоӊ Коӊ Кытай АААХерд жана Макдоналд аралдарыГондурасХ" +
+	"орватияГаитиВенгрияКанар аралдарыИндонезияИрландияИзраилМэн аралыИндияБританияны" +
+	"н Инд Океанындагы аймагыИракИранИсландияИталияЖерсиЖамайкаЙорданияЖапанКенияКырг" +
+	"ызстанКамбожаКирибатиКоморосЫйык Китс жана НевисТүндүк КореяТүштүк КореяКувейтКа" +
+	"йман АралдарыКазакстанЛаосЛиванЫйык ЛючияЛихтенштейнШри ЛанкаЛиберияЛесотоЛитваЛ" +
+	"юксембургЛатвияЛивияМароккоМонакоМолдоваМонтенегроЫйык МартинМадагаскарМаршалл а" +
+	"ралдарыМакедонияМалиМйанмар (Бурма)МонголияМакау Кытай АААТүндүк Мариана аралдар" +
+	"ыМартиникМавританияМонсерратМальтаМаврикийМалдивзМалавиМексикаМалайзияМозамбикНа" +
+	"мибияЖаӊы КаледонияНигерНорфолк аралыНигерияНикарагуаГолландияНорвегияНепалНауру" +
+	"НиуэЖаӊы ЗеландияОманПанамаПеруФранцуз ПолинезиясыПапуа Жаӊы ГинеФилиппинзПакист" +
+	"анПольшаЫйык Пьер жана МикуелонПиткэрн аралдарыПуэрто РикоПалестина аймактарыПор" +
+	"тугалияПалауПарагвайКатарАлыскы ОкеанияРеюнионРумынияСербияОрусияРуандаСауд Араб" +
+	"иясыСоломон аралдарыСейшелдерСуданШвецияСингапурЫйык ЕленаСловенияСвалбард жана " +
+	"Жан МайенСловакияСиерра ЛеонеСан МариноСенегалСомалиСуринамеТүштүк СуданСао Томе" +
+	" жана ПринсипеЭл СалвадорСинт МаартенСирияСуазилендТристан да КуньяТүркс жана Ка" +
+	"йкос аралдарыЧадФранциянын Түштүктөгү аймактарыТогоТайландТажикстанТокелауТиморЛ" +
+	"естеТүркмөнстанТунисТонгаТүркияТринидад жана ТобагоТувалуТайуанТанзанияУкраинаУг" +
+	"андаАКШнын Алыскы аралдарыКошмо ШтаттарУругвайӨзбекстанВатиканЫйык Винсент жана " +
+	"ГренадинзВенесуэлаБритан Виргин аралдарыАКШ Виржин аралдарыВьетнамВануатуУоллис " +
+	"жана ФутунаСамоаКосовоЙеменМайоттТүштүк Африка РеспубликасыЗамбияЗимбабвеБелгиси" +
+	"з регионДүйнөАфрикаТүндүк АмерикаТүштүк АмерикаОкеанияБатыш АфрикаБорбордук Амер" +
+	"икаЧыгыш АфрикаТүндүк АфрикаОрто АфрикаТүштүк АфрикаАмерикаАмериканын ТүндүгүКар" +
+	"иб аралдарыЧыгыш АзияТүштүк АзияТүштүк-Чыгыш АзияТүштүк ЕвропаАвстралазияМеланез" +
+	"ияМикронезия РегионуПолинезияАзияБорбор АзияБатыш АзияЕвропаЧыгыш ЕвропаТүндүк Е" +
+	"вропаБатыш ЕвропаЛатын Америкасы"
+
+var kyRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1b, 0x29, 0x57, 0x6b, 0x91, 0x9f, 0xad, 0xbb, 0xbb, 0xc7, 0xdb,
+	0xed, 0x10a, 0x118, 0x12a, 0x134, 0x14f, 0x163, 0x18f, 0x19f, 0x1b1, 0x1bd, 0x1d4,
+	0x1e4, 0x1f2, 0x200, 0x20a, 0x225, 0x233, 0x23f, 0x24d, 0x272, 0x282, 0x290, 0x29a,
+	0x2b3, 0x2c3, 0x2d3, 0x2dd, 0x2e9, 0x311, 0x32c, 0x364, 0x383, 0x395, 0x3a9, 0x3c0,
+	0x3c8, 0x3d6, 0x3e0, 0x3f0, 0x40f, 0x422, 0x42a, 0x43d, 0x44b, 0x464, 0x46c, 0x476,
+	0x486, 0x49d, 0x4a9, 0x4b3, 0x4c3, 0x4ec, 0x4f6, 0x518, 0x526, 0x534, 0x53e, 0x555,
+	0x563, 0x571, 0x57f, 0x59a, 0x5ac, 0x5b4, 0x5d5, 0x5e9, 0x604, 0x612, 0x61c, 0x635,
+	0x643, 0x64f, 0x66e, 0x67a, 0x682, 0x694, 0x6a8, 0x6b4, 0x6bc, 0x6ce, 0x6ef, 0x6fb,
+	0x74a, 0x75c, 0x764, 0x777, 0x783, 0x7a2, 0x7d7, 0x7e7, 0x7f7, 0x801, 0x80f, 0x82a,
+	0x83c, 0x84c, 0x858, 0x869, 0x873, 0x8b4, 0x8bc, 0x8c4, 0x8d4, 0x8e0, 0x8ea, 0x8f8,
+	0x908, 0x912, 0x91c, 0x930, 0x93e, 0x94e, 0x95c, 0x981, 0x998, 0x9af, 0x9bb, 0x9d8,
+	0x9ea, 0x9f2, 0x9fc, 0xa0f, 0xa25, 0xa36, 0xa44, 0xa50, 0xa5a, 0xa6e, 0xa7a, 0xa84,
+	0xa92, 0xa9e, 0xaac, 0xac0, 0xad5, 0xae9, 0xb08, 0xb1a, 0xb22, 0xb3d, 0xb4d, 0xb69,
+	0xb95, 0xba5, 0xbb9, 0xbcb, 0xbd7, 0xbe7, 0xbf5, 0xc01, 0xc0f, 0xc1f, 0xc2f, 0xc3d,
+	0xc58, 0xc62, 0xc7b, 0xc89, 0xc9b, 0xcad, 0xcbd, 0xcc7, 0xcd1, 0xcd9, 0xcf2, 0xcfa,
+	0xd06, 0xd0e, 0xd33, 0xd4f, 0xd61, 0xd71, 0xd7d, 0xda8, 0xdc7, 0xddc, 0xe01, 0xe15,
+	0xe1f, 0xe2f, 0xe39, 0xe54, 0xe62, 0xe70, 0xe7c, 0xe88, 0xe94, 0xead, 0xecc, 0xede,
+	0xee8, 0xef4, 0xf04, 0xf17, 0xf27, 0xf52, 0xf62, 0xf79, 0xf8c, 0xf9a, 0xfa6, 0xfb6,
+	0xfcd, 0xff6, 0x100b, 0x1022, 0x102c, 0x103e, 0x105c, 0x108d, 0x1093, 0x10cf, 0x10d7, 0x10e5,
+	0x10f7, 0x1105, 0x1119, 0x112f, 0x1139, 0x1143, 0x114f, 0x1175, 0x1181, 0x118d, 0x119d, 0x11ab,
+	0x11b7, 0x11e1, 0x11fa, 0x1208, 0x121a, 0x1228, 0x125b, 0x126d, 0x1297, 0x12bb, 0x12c9, 0x12d7,
+	0x12f9, 0x1303, 0x130f, 0x1319, 0x1325, 0x1357, 0x1363, 0x1373, 0x1390, 0x139a, 0x13a6, 0x13c1,
+	0x13dc, 0x13ea, 0x1401, 0x1422, 0x1439, 0x1452, 0x1467, 0x1480, 0x148e, 0x14b1, 0x14cc, 0x14df,
+	0x14f4, 0x1514, 0x152d, 0x1543, 0x1555, 0x1578, 0x158a, 0x1592, 0x15a7, 0x15ba, 0x15c6, 0x15dd,
+	0x15f6, 0x160d, 0x162a,
+}
+
+const loRegionStr = "" +
+	"ເກາະອາເຊນຊັນອັນດໍຣາສະຫະລັດອາຫຣັບເອມິເຣດອາຟການິສຖານອາທິກົວ ບາບູດາແອນກຸຍລາແອວເບເນຍ" +
+	"ອາມີເນຍອັນໂກລາແອນຕາດຕິກາອາເຈນທິນາອາເມຣິກາ ຊາມົວອອສເທຣຍອອສເຕຣເລຍອໍຣູບາຫມູ່ເກາະໂອລ" +
+	"ັນອາເຊີໄບຈານບອດສະເນຍ ແລະ ແຮສໂກວີນາບາບາໂດສບັງກະລາເທດເບວຢຽມເບີກິນາ ຟາໂຊບັງກາເຣຍບາເ" +
+	"ຣນບູຣຸນດິເບນິນເຊນ ບາເທເລມີເບີມິວດາບຣູໄນໂບລິເວຍຄາຣິບບຽນ ເນເທີແລນບຣາຊິວບາຮາມາສພູຖາ" +
+	"ນເກາະບູເວດບອດສະວານາເບວບາຣຸສເບລີຊແຄນາດາຫມູ່ເກາະໂກໂກສຄອງໂກ - ຄິນຊາຊາສາທາລະນະລັດອາຟ" +
+	"ຣິກາກາງຄອງໂກ - ບຣາຊາວິວສະວິດເຊີແລນໂຄຕີ ວົວຫມູ່ເກາະຄູກຊິລີຄາເມຣູນຈີນໂຄລົມເບຍເກາະຄ" +
+	"ລິບເປີຕັນໂຄສຕາ ຣິກາຄິວບາເຄບ ເວີດຄູຣາຊາວເກາະຄຣິສມາດໄຊປຣັສສາທາລະນະລັດເຊກເຢຍລະມັນດິ" +
+	"ເອໂກ ກາເຊຍຈິບູຕິເດນມາກໂດມີນິຄາສາທາລະນະລັດ ໂດມິນິກັນອັລຈິເຣຍເຊວຕາ ແລະເມລິນລາເອກກວ" +
+	"າດໍເອສໂຕເນຍອີຢິບຊາຮາຣາຕາເວັນຕົກເອຣິເທຣຍສະເປນອີທິໂອເປຍສະຫະພາບຢູໂຣບຟິນແລນຟິຈິຫມູ່ເ" +
+	"ກາະຟອກແລນໄມໂຄຣນີເຊຍຫມູ່ເກາະແຟໂຣຝຣັ່ງກາບອນສະຫະລາດຊະອະນາຈັກເກຣເນດາຈໍເຈຍເຟຣນຊ ກຸຍອາ" +
+	"ນາເກີນຊີການ່າຈິບບຣອນທາກຣີນແລນສາທາລະນະລັດແກມເບຍກິນີກົວດາລູບອີຄົວໂຕຣຽວ ກີນີກຣີຊເກາ" +
+	"ະຈອດເຈຍໃຕ້ແລະຫມູ່ເກາະແຊນວິດຕອນໃຕ້ກົວເທມາລາກວມກິນີ-ບິສໂຊກາຍຢານາຮົງກົງ ເຂດປົກຄອງພິ" +
+	"ເສດ ຈີນເກາະເຮີດແລະຫມູ່ເກາະແມັກໂດນອລຮອນດູຣັສໂຄເອເຊຍເຮທີຮັງກາຣີໝູ່ເກາະຄານາຣີອິນໂດເ" +
+	"ນເຊຍໄອແລນອິສຣາເອວເອວ ອອບ ແມນອິນເດຍເຂດແດນບຣິທິສອິນດຽນໂອຊຽນອີຣັກອີຣ່ານໄອສແລນອິຕາລີ" +
+	"ເຈີຊີຈາໄມຄາຈໍແດນຍີ່ປຸ່ນເຄນຢາຄີກິສຖານກຳປູເຈຍຄຣິບາຕິໂຄໂມໂຣສເຊນ ຄິດ ແລະ ເນວິສເກົາຫລ" +
+	"ີເໜືອເກົາຫລີໃຕ້ຄູເວດເຄແມນ ໄອສແລນຄາຊັກສະຖານລາວເລບານອນເຊນ ລູເຊຍລິດເທນສະຕາຍສີລັງກາລ" +
+	"ິເບີເຣຍເລໂຊໂທລິທົວເນຍລຸກແຊມເບີກລັດເວຍລິເບຍໂມຣັອກໂຄໂມນາໂຄໂມນໂດວາມອນເຕເນໂກຣເຊນ ມາທ" +
+	"ິນມາດາກາສກາຫມູ່ເກາະມາແຊວແມຊິໂດເນຍມາລິມຽນມາ pເບີມາ)ມອງໂກເລຍມາເກົ້າ ເຂດປົກຄອງພິເສດ" +
+	" ຈີນຫມູ່ເກາະມາແຊວຕອນເຫນືອມາຕິນີກມົວຣິເທເນຍມອນເຊີຣາດມອນທາມົວຣິຊຽສມັນດິຟມາລາວີເມັກ" +
+	"ຊິໂກມາເລເຊຍໂມແຊມບິກນາມີເບຍນິວ ຄາເລໂດເນຍນິເຈີເກາະນໍໂຟກໄນຈີເຣຍນິກຄາຣາກົວເນເທີແລນນໍ" +
+	"ເວເນປານນາອູຣູນີອູເອນິວຊີແລນໂອມານພານາມາເພີຣູເຟຣນຊ ໂພລີເນເຂຍປາປົວນິວກີນີຟິລິບປິນປາ" +
+	"ກິສຖານໂປແລນເຊນ ປີແອ ມິເກວລອນໝູ່ເກາະພິດແຄນເພືອໂຕ ຣິໂກດິນແດນ ປາເລສຕິນຽນພອລທູໂກປາເລ" +
+	"ົາພາຣາກວຍກາຕາເຂດຫ່າງໄກໂອຊີເນຍເຣອູນິຍົງໂຣແມເນຍເຊີເບຍຣັດເຊຍວັນດາຊາອຸດິ ອາຣາເບຍຫມູ່" +
+	"ເກາະໂຊໂລມອນເຊເຊວເລສຊູດານສະວີເດັນສິງກະໂປເຊນ ເຮເລນາສະໂລເວເນຍສະວາບາ ແລະ ແຢນ ມາເຢນສະ" +
+	"ໂລວາເກຍເຊຍຣາ ລີໂອນແຊນ ມາຣິໂນເຊນີໂກລໂຊມາເລຍຊູຣິນາມຊູດານໃຕ້ເຊົາທູເມ ແລະ ພຣິນຊິບເອວ" +
+	" ຊໍວາດໍຊິນ ມາທິນຊີເຣຍສະວາຊິແລນທຣິສຕັນ ດາ ກັນຮາໝູ່ເກາະ ເທີກ ແລະ ໄຄໂຄສແຊດອານາເຂດທາ" +
+	"ງໃຕ້ຂອງຝຣັ່ງໂຕໂກໄທທາຈິກິສຖານໂຕເກເລົາທິມໍ-ເລສເຕເທີກເມນິສຖານຕູນິເຊຍທອງກາເທີຄີທຣິນິ" +
+	"ແດດ ແລະ ໂທແບໂກຕູວາລູໄຕ້ຫວັນທານຊາເນຍຢູເຄຣນອູການດາຫມູ່ເກາະຮອບນອກຂອງສະຫະລັດສະຫະລັດອ" +
+	"ູຣຸກວຍອຸສເບກິສຖານນະຄອນ ວາຕິກັນເຊນ ວິນເຊນ ແລະ ເດິ ເກຣເນດິນເວເນຊູເອລາໝູ່ເກາະ ບຣິທິ" +
+	"ຊ ເວີຈິນໝູ່ເກາະ ຢູເອສ ເວີຈິນຫວຽດນາມວານົວຕູວາລິສ ແລະ ຟຸຕູນາຊາມົວໂຄໂຊໂວເຢເມນມາຢອດອ" +
+	"າຟະລິກາໃຕ້ແຊມເບຍຊິມບັບເວຂົງເຂດທີ່ບໍ່ຮູ້ຈັກໂລກອາຟຣິກາອາເມລິກາເໜືອອາເມລິກາໃຕ້ໂອຊີເ" +
+	"ນຍອາຟຣິກາຕາເວັນຕົກອາເມລິກາກາງອາຟຣິກາຕາເວັນອອກອາຟຣິກາເໜືອອາຟຣິກາກາງອາຟຣິກາໃຕ້ອາເມ" +
+	"ຣິກາອາເມລິກາເໜືອສຸດຄາຣິບບຽນເອເຊຍຕາເວັນອອກເອເຊຍໃຕ້ເອເຊຍຕາເວັນອອກສ່ຽງໃຕ້ຢູໂຣບໃຕ້ອອ" +
+	"ສເຕຣເລເຊຍເມລານີເຊຍເຂດໄມໂຄຣເນຊຽນໂພລີນີເຊຍເອເຊຍເອເຊຍກາງເອເຊຍຕາເວັນຕົກຢູໂຣບຢູໂຣບຕາເ" +
+	"ວັນອອກຢູໂຣບເໜືອຢູໂຣບຕາເວັນຕົກອາເມລິກາ ລາຕິນ"
+
+var loRegionIdx = []uint16{ // 291 entries
+	0x0, 0x24, 0x39, 0x75, 0x96, 0xbe, 0xd6, 0xee, 0x103, 0x103, 0x118, 0x136,
+	0x151, 0x179, 0x18e, 0x1a9, 0x1bb, 0x1e2, 0x200, 0x23e, 0x253, 0x271, 0x283, 0x2a5,
+	0x2bd, 0x2cc, 0x2e1, 0x2f0, 0x312, 0x32a, 0x339, 0x34e, 0x37f, 0x391, 0x3a6, 0x3b5,
+	0x3d0, 0x3eb, 0x403, 0x412, 0x424, 0x44b, 0x472, 0x4b1, 0x4db, 0x4fc, 0x512, 0x533,
+	0x53f, 0x554, 0x55d, 0x575, 0x59f, 0x5bb, 0x5ca, 0x5e0, 0x5f5, 0x616, 0x628, 0x652,
+	0x66a, 0x68c, 0x69e, 0x6b0, 0x6c8, 0x705, 0x71d, 0x74b, 0x763, 0x77b, 0x78a, 0x7b7,
+	0x7cf, 0x7de, 0x7f9, 0x81d, 0x82f, 0x83b, 0x865, 0x883, 0x8a7, 0x8b6, 0x8c5, 0x8f5,
+	0x90a, 0x919, 0x93e, 0x950, 0x95f, 0x97a, 0x98f, 0x9c2, 0x9ce, 0x9e6, 0xa11, 0xa1d,
+	0xa89, 0xaa4, 0xaad, 0xac9, 0xade, 0xb25, 0xb79, 0xb91, 0xba6, 0xbb2, 0xbc7, 0xbee,
+	0xc0c, 0xc1b, 0xc33, 0xc50, 0xc62, 0xca7, 0xcb6, 0xcc8, 0xcda, 0xcec, 0xcfb, 0xd0d,
+	0xd1c, 0xd31, 0xd40, 0xd58, 0xd6d, 0xd82, 0xd97, 0xdc4, 0xde5, 0xe03, 0xe12, 0xe34,
+	0xe52, 0xe5b, 0xe70, 0xe89, 0xeaa, 0xebf, 0xed7, 0xee9, 0xf01, 0xf1f, 0xf31, 0xf40,
+	0xf58, 0xf6a, 0xf7f, 0xf9d, 0xfb6, 0xfd1, 0xff8, 0x1013, 0x101f, 0x1040, 0x1058, 0x10a2,
+	0x10e1, 0x10f6, 0x1114, 0x112f, 0x113e, 0x1156, 0x1168, 0x117a, 0x1192, 0x11a7, 0x11bf, 0x11d4,
+	0x11f9, 0x1208, 0x1223, 0x1238, 0x1256, 0x126e, 0x127a, 0x1289, 0x129b, 0x12ad, 0x12c5, 0x12d4,
+	0x12e6, 0x12f5, 0x1320, 0x1344, 0x135c, 0x1374, 0x1383, 0x13b2, 0x13d9, 0x13f8, 0x1429, 0x143e,
+	0x1450, 0x1465, 0x1471, 0x14a1, 0x14bc, 0x14d1, 0x14e3, 0x14f5, 0x1504, 0x152c, 0x1559, 0x1571,
+	0x1580, 0x1598, 0x15ad, 0x15c9, 0x15e4, 0x161a, 0x1635, 0x1654, 0x1670, 0x1685, 0x169a, 0x16af,
+	0x16c7, 0x16ff, 0x171b, 0x1734, 0x1743, 0x175e, 0x178a, 0x17c6, 0x17cf, 0x180e, 0x181a, 0x1820,
+	0x183e, 0x1856, 0x1872, 0x1896, 0x18ab, 0x18ba, 0x18c9, 0x18fe, 0x1910, 0x1925, 0x193d, 0x194f,
+	0x1964, 0x19ac, 0x19c1, 0x19d6, 0x19f7, 0x1a1c, 0x1a65, 0x1a83, 0x1abe, 0x1af6, 0x1b0b, 0x1b20,
+	0x1b4c, 0x1b5b, 0x1b6d, 0x1b7c, 0x1b8b, 0x1bac, 0x1bbe, 0x1bd6, 0x1c0c, 0x1c15, 0x1c2a, 0x1c4e,
+	0x1c6f, 0x1c84, 0x1cb4, 0x1cd5, 0x1d05, 0x1d26, 0x1d44, 0x1d62, 0x1d7a, 0x1da7, 0x1dbf, 0x1de9,
+	0x1e01, 0x1e40, 0x1e58, 0x1e79, 0x1e94, 0x1ebb, 0x1ed6, 0x1ee5, 0x1efd, 0x1f27, 0x1f36, 0x1f60,
+	0x1f7b, 0x1fa5, 0x1fcd,
+}
+
+const ltRegionStr = "" +
+	"Dangun Žengimo salaAndoraJungtiniai Arabų EmyrataiAfganistanasAntigva ir Barbuda" +
+	"AngilijaAlbanijaArmėnijaOlandijos AntilaiAngolaAntarktidaArgentinaAmerikos Samoa" +
+	"AustrijaAustralijaArubaAlandų SalosAzerbaidžanasBosnija ir HercegovinaBarbadosas" +
+	"BangladešasBelgijaBurkina FasasBulgarijaBahreinasBurundisBeninasSen BartelemiBer" +
+	"mudaBrunėjusBolivijaKaribų NyderlandaiBrazilijaBahamosButanasBuvė SalaBotsvanaBa" +
+	"ltarusijaBelizasKanadaKokosų (Kilingo) SalosKongas-KinšasaCentrinės Afrikos Resp" +
+	"ublikaKongas-BrazavilisŠveicarijaDramblio Kaulo KrantasKuko SalosČilėKamerūnasKi" +
+	"nijaKolumbijaKlipertono salaKosta RikaKubaŽaliasis KyšulysKiurasaoKalėdų SalaKip" +
+	"rasČekijaVokietijaDiego GarsijaDžibutisDanijaDominikaDominikos RespublikaAlžyras" +
+	"Seuta ir MelilaEkvadorasEstijaEgiptasVakarų SacharaEritrėjaIspanijaEtiopijaEurop" +
+	"os SąjungaSuomijaFidžisFolklando SalosMikronezijaFarerų SalosPrancūzijaGabonasDi" +
+	"džioji BritanijaGrenadaGruzijaPrancūzijos GvianaGernsisGanaGibraltarasGrenlandij" +
+	"aGambijaGvinėjaGvadelupaPusiaujo GvinėjaGraikijaPietų Džordžija ir Pietų Sandvič" +
+	"o salosGvatemalaGuamasBisau GvinėjaGajanaYpatingasis Administracinis Kinijos Reg" +
+	"ionas HonkongasHerdo ir Makdonaldo SalosHondūrasKroatijaHaitisVengrijaKanarų sal" +
+	"osIndonezijaAirijaIzraelisMeno SalaIndijaIndijos Vandenyno Britų SritisIrakasIra" +
+	"nasIslandijaItalijaDžersisJamaikaJordanijaJaponijaKenijaKirgizijaKambodžaKiribat" +
+	"isKomoraiSent Kitsas ir NevisŠiaurės KorėjaPietų KorėjaKuveitasKaimanų SalosKaza" +
+	"chstanasLaosasLibanasŠventoji LiucijaLichtenšteinasŠri LankaLiberijaLesotasLietu" +
+	"vaLiuksemburgasLatvijaLibijaMarokasMonakasMoldovaJuodkalnijaSen MartenasMadagask" +
+	"arasMaršalo SalosMakedonijaMalisMianmaras (Birma)MongolijaYpatingasis Administra" +
+	"cinis Kinijos Regionas MakaoMarianos Šiaurinės SalosMartinikaMauritanijaMontsera" +
+	"tasMaltaMauricijusMaldyvaiMalavisMeksikaMalaizijaMozambikasNamibijaNaujoji Kaled" +
+	"onijaNigerisNorfolko salaNigerijaNikaragvaNyderlandaiNorvegijaNepalasNauruNiujėN" +
+	"aujoji ZelandijaOmanasPanamaPeruPrancūzų PolinezijaPapua Naujoji GvinėjaFilipina" +
+	"iPakistanasLenkijaSen Pjeras ir MikelonasPitkernasPuerto RikasPalestinos teritor" +
+	"ijaPortugalijaPalauParagvajusKatarasNuošali OkeanijaReunjonasRumunijaSerbijaRusi" +
+	"jaRuandaSaudo ArabijaSaliamono SalosSeišeliaiSudanasŠvedijaSingapūrasŠv. Elenos " +
+	"SalaSlovėnijaSvalbardas ir Janas MajenasSlovakijaSiera LeonėSan MarinasSenegalas" +
+	"SomalisSurinamasPietų SudanasSan Tomė ir PrinsipėSalvadorasSint MartenasSirijaSv" +
+	"azilandasTristanas da KunjaTerkso ir Kaikoso SalosČadasPrancūzijos Pietų sritysT" +
+	"ogasTailandasTadžikijaTokelauRytų TimorasTurkmėnistanasTunisasTongaTurkijaTrinid" +
+	"adas ir TobagasTuvaluTaivanasTanzanijaUkrainaUgandaJungtinių Valstijų Mažosios T" +
+	"olimosios SalosJungtinės ValstijosUrugvajusUzbekistanasVatikano Miesto ValstybėŠ" +
+	"ventasis Vincentas ir GrenadinaiVenesuelaDidžiosios Britanijos Mergelių SalosJun" +
+	"gtinių Valstijų Mergelių SalosVietnamasVanuatuVolisas ir FutunaSamoaKosovasJemen" +
+	"asMajotasPietų AfrikaZambijaZimbabvėnežinoma sritispasaulisAfrikaŠiaurės Amerika" +
+	"Pietų AmerikaOkeanijaVakarų AfrikaCentrinė AmerikaRytų AfrikaŠiaurės AfrikaVidur" +
+	"io AfrikaPietinė AfrikaAmerikaŠiaurinė AmerikaKaribaiRytų AzijaPietų AzijaPietry" +
+	"čių AzijaPietų EuropaAustralazijaMelanezijaMikronezijos regionasPolinezijaAzijaC" +
+	"entrinė AzijaVakarų AzijaEuropaRytų EuropaŠiaurės EuropaVakarų EuropaLotynų Amer" +
+	"ika"
+
+var ltRegionIdx = []uint16{ // 291 entries
+	0x0, 0x14, 0x1a, 0x34, 0x40, 0x52, 0x5a, 0x62, 0x6b, 0x7c, 0x82, 0x8c,
+	0x95, 0xa3, 0xab, 0xb5, 0xba, 0xc7, 0xd5, 0xeb, 0xf5, 0x101, 0x108, 0x115,
+	0x11e, 0x127, 0x12f, 0x136, 0x143, 0x14a, 0x153, 0x15b, 0x16e, 0x177, 0x17e, 0x185,
+	0x18f, 0x197, 0x1a2, 0x1a9, 0x1af, 0x1c6, 0x1d5, 0x1f2, 0x203, 0x20e, 0x224, 0x22e,
+	0x234, 0x23e, 0x244, 0x24d, 0x25c, 0x266, 0x26a, 0x27c, 0x284, 0x291, 0x297, 0x29e,
+	0x2a7, 0x2b4, 0x2bd, 0x2c3, 0x2cb, 0x2df, 0x2e7, 0x2f6, 0x2ff, 0x305, 0x30c, 0x31b,
+	0x324, 0x32c, 0x334, 0x344, 0x34b, 0x352, 0x361, 0x36c, 0x379, 0x384, 0x38b, 0x39e,
+	0x3a5, 0x3ac, 0x3bf, 0x3c6, 0x3ca, 0x3d5, 0x3e0, 0x3e7, 0x3ef, 0x3f8, 0x409, 0x411,
+	0x43d, 0x446, 0x44c, 0x45a, 0x460, 0x496, 0x4af, 0x4b8, 0x4c0, 0x4c6, 0x4ce, 0x4db,
+	0x4e5, 0x4eb, 0x4f3, 0x4fc, 0x502, 0x521, 0x527, 0x52d, 0x536, 0x53d, 0x545, 0x54c,
+	0x555, 0x55d, 0x563, 0x56c, 0x575, 0x57e, 0x585, 0x599, 0x5aa, 0x5b8, 0x5c0, 0x5ce,
+	0x5da, 0x5e0, 0x5e7, 0x5f8, 0x607, 0x611, 0x619, 0x620, 0x627, 0x634, 0x63b, 0x641,
+	0x648, 0x64f, 0x656, 0x661, 0x66d, 0x679, 0x687, 0x691, 0x696, 0x6a7, 0x6b0, 0x6e2,
+	0x6fc, 0x705, 0x710, 0x71b, 0x720, 0x72a, 0x732, 0x739, 0x740, 0x749, 0x753, 0x75b,
+	0x76d, 0x774, 0x781, 0x789, 0x792, 0x79d, 0x7a6, 0x7ad, 0x7b2, 0x7b8, 0x7c9, 0x7cf,
+	0x7d5, 0x7d9, 0x7ee, 0x804, 0x80d, 0x817, 0x81e, 0x835, 0x83e, 0x84a, 0x85f, 0x86a,
+	0x86f, 0x879, 0x880, 0x891, 0x89a, 0x8a2, 0x8a9, 0x8af, 0x8b5, 0x8c2, 0x8d1, 0x8db,
+	0x8e2, 0x8ea, 0x8f5, 0x905, 0x90f, 0x92a, 0x933, 0x93f, 0x94a, 0x953, 0x95a, 0x963,
+	0x971, 0x987, 0x991, 0x99e, 0x9a4, 0x9af, 0x9c1, 0x9d8, 0x9de, 0x9f8, 0x9fd, 0xa06,
+	0xa10, 0xa17, 0xa24, 0xa33, 0xa3a, 0xa3f, 0xa46, 0xa5b, 0xa61, 0xa69, 0xa72, 0xa79,
+	0xa7f, 0xaae, 0xac2, 0xacb, 0xad7, 0xaf0, 0xb12, 0xb1b, 0xb41, 0xb65, 0xb6e, 0xb75,
+	0xb86, 0xb8b, 0xb92, 0xb99, 0xba0, 0xbad, 0xbb4, 0xbbd, 0xbcd, 0xbd5, 0xbdb, 0xbec,
+	0xbfa, 0xc02, 0xc10, 0xc21, 0xc2d, 0xc3d, 0xc4b, 0xc5a, 0xc61, 0xc73, 0xc7a, 0xc85,
+	0xc91, 0xca2, 0xcaf, 0xcbb, 0xcc5, 0xcda, 0xce4, 0xce9, 0xcf8, 0xd05, 0xd0b, 0xd17,
+	0xd27, 0xd35, 0xd44,
+}
+
+const lvRegionStr = "" +
+	"Debesbraukšanas salaAndoraApvienotie Arābu EmirātiAfganistānaAntigva un BarbudaA" +
+	"ngiljaAlbānijaArmēnijaNīderlandes AntiļasAngolaAntarktikaArgentīnaAmerikāņu Samo" +
+	"aAustrijaAustrālijaArubaOlandes salasAzerbaidžānaBosnija un HercegovinaBarbadosa" +
+	"BangladešaBeļģijaBurkinafasoBulgārijaBahreinaBurundiBeninaSenbartelmīBermudu sal" +
+	"asBrunejaBolīvijaNīderlandes Karību salasBrazīlijaBahamu salasButānaBuvē salaBot" +
+	"svānaBaltkrievijaBelizaKanādaKokosu jeb Kīlinga salasKongo-KinšasaCentrālāfrikas" +
+	" RepublikaKongo - BrazavilaŠveiceKotdivuāraKuka salasČīleKamerūnaĶīnaKolumbijaKl" +
+	"ipertona salaKostarikaKubaKaboverdeKirasaoZiemsvētku salaKipraČehijaVācijaDjego " +
+	"Garsijas atolsDžibutijaDānijaDominikaDominikānaAlžīrijaSeūta un MeliljaEkvadoraI" +
+	"gaunijaĒģipteRietumsahāraEritrejaSpānijaEtiopijaEiropas SavienībaSomijaFidžiFolk" +
+	"lenda salasMikronēzijaFēru SalasFrancijaGabonaLielbritānijaGrenādaGruzijaFranču " +
+	"GviānaGērnsijaGanaGibraltārsGrenlandeGambijaGvinejaGvadelupaEkvatoriālā GvinejaG" +
+	"rieķijaDienviddžordžija un Dienvidsendviču salasGvatemalaGuamaGvineja-BisavaGajā" +
+	"naĶīnas īpašās pārvaldes apgabals HonkongaHērda un Makdonalda salasHondurasaHorv" +
+	"ātijaHaitiUngārijaKanāriju salasIndonēzijaĪrijaIzraēlaMenaIndijaIndijas okeāna B" +
+	"ritu teritorijaIrākaIrānaĪslandeItālijaDžērsijaJamaikaJordānijaJapānaKenijaKirgi" +
+	"zstānaKambodžaKiribatiKomoru salasSentkitsa un NevisaZiemeļkorejaDienvidkorejaKu" +
+	"veitaKaimanu salasKazahstānaLaosaLibānaSentlūsijaLihtenšteinaŠrilankaLibērijaLes" +
+	"otoLietuvaLuksemburgaLatvijaLībijaMarokaMonakoMoldovaMelnkalneSenmartēnaMadagask" +
+	"araMāršala salasMaķedonijaMaliMjanma (Birma)MongolijaĶīnas īpašās pārvaldes apga" +
+	"bals MakaoZiemeļu Marianas salasMartinikaMauritānijaMontserrataMaltaMaurīcijaMal" +
+	"dīvijaMalāvijaMeksikaMalaizijaMozambikaNamībijaJaunkaledonijaNigēraNorfolkas sal" +
+	"aNigērijaNikaragvaNīderlandeNorvēģijaNepālaNauruNiueJaunzēlandeOmānaPanamaPeruFr" +
+	"anču PolinēzijaPapua-JaungvinejaFilipīnasPakistānaPolijaSenpjēra un MikelonaPitk" +
+	"ērnaPuertorikoPalestīnaPortugālePalauParagvajaKataraOkeānijas attālās salasReinj" +
+	"onaRumānijaSerbijaKrievijaRuandaSaūda ArābijaZālamana salasSeišeļu salasSudānaZv" +
+	"iedrijaSingapūraSv.Helēnas salaSlovēnijaSvalbāra un Jana Majena salaSlovākijaSje" +
+	"rraleoneSanmarīnoSenegālaSomālijaSurinamaDienvidsudānaSantome un PrinsipiSalvado" +
+	"raSintmārtenaSīrijaSvazilendaTristana da Kuņas salasTērksas un Kaikosas salasČad" +
+	"aFrancijas Dienvidjūru ZemesTogoTaizemeTadžikistānaTokelauAustrumtimoraTurkmenis" +
+	"tānaTunisijaTongaTurcijaTrinidāda un TobāgoTuvaluTaivānaTanzānijaUkrainaUgandaAS" +
+	"V Aizjūras salasAmerikas Savienotās ValstisUrugvajaUzbekistānaVatikānsSentvinsen" +
+	"ta un GrenadīnasVenecuēlaBritu VirdžīnasASV VirdžīnasVjetnamaVanuatuVolisa un Fu" +
+	"tunaSamoaKosovaJemenaMajotaDienvidāfrikas RepublikaZambijaZimbabvenezināms reģio" +
+	"nspasauleĀfrikaZiemeļamerikaDienvidamerikaOkeānijaRietumāfrikaCentrālamerikaAust" +
+	"rumāfrikaZiemeļāfrikaVidusāfrikaDienvidāfrikaAmerikaAmerikas ziemeļu daļaKarību " +
+	"jūras reģionsAustrumāzijaDienvidāzijaCentrālaustrumāzijaDienvideiropaAustrālāzij" +
+	"aMelanēzijaMikronēzijas reģionsPolinēzijaĀzijaCentrālāzijaRietumāzijaEiropaAustr" +
+	"umeiropaZiemeļeiropaRietumeiropaLatīņamerika"
+
+var lvRegionIdx = []uint16{ // 291 entries
+	0x0, 0x15, 0x1b, 0x35, 0x41, 0x53, 0x5a, 0x63, 0x6c, 0x81, 0x87, 0x91,
+	0x9b, 0xac, 0xb4, 0xbf, 0xc4, 0xd1, 0xdf, 0xf5, 0xfe, 0x109, 0x112, 0x11d,
+	0x127, 0x12f, 0x136, 0x13c, 0x148, 0x155, 0x15c, 0x165, 0x17f, 0x189, 0x195, 0x19c,
+	0x1a6, 0x1af, 0x1bb, 0x1c1, 0x1c8, 0x1e1, 0x1ef, 0x209, 0x21a, 0x221, 0x22c, 0x236,
+	0x23c, 0x245, 0x24b, 0x254, 0x263, 0x26c, 0x270, 0x279, 0x280, 0x290, 0x295, 0x29c,
+	0x2a3, 0x2b7, 0x2c1, 0x2c8, 0x2d0, 0x2db, 0x2e5, 0x2f6, 0x2fe, 0x306, 0x30e, 0x31b,
+	0x323, 0x32b, 0x333, 0x345, 0x34b, 0x351, 0x360, 0x36c, 0x377, 0x37f, 0x385, 0x393,
+	0x39b, 0x3a2, 0x3b1, 0x3ba, 0x3be, 0x3c9, 0x3d2, 0x3d9, 0x3e0, 0x3e9, 0x3fe, 0x407,
+	0x433, 0x43c, 0x441, 0x44f, 0x456, 0x484, 0x49e, 0x4a7, 0x4b1, 0x4b6, 0x4bf, 0x4ce,
+	0x4d9, 0x4df, 0x4e7, 0x4eb, 0x4f1, 0x511, 0x517, 0x51d, 0x525, 0x52d, 0x537, 0x53e,
+	0x548, 0x54f, 0x555, 0x561, 0x56a, 0x572, 0x57e, 0x591, 0x59e, 0x5ab, 0x5b2, 0x5bf,
+	0x5ca, 0x5cf, 0x5d6, 0x5e1, 0x5ee, 0x5f7, 0x600, 0x606, 0x60d, 0x618, 0x61f, 0x626,
+	0x62c, 0x632, 0x639, 0x642, 0x64d, 0x658, 0x667, 0x672, 0x676, 0x684, 0x68d, 0x6b8,
+	0x6cf, 0x6d8, 0x6e4, 0x6ef, 0x6f4, 0x6fe, 0x708, 0x711, 0x718, 0x721, 0x72a, 0x733,
+	0x741, 0x748, 0x756, 0x75f, 0x768, 0x773, 0x77e, 0x785, 0x78a, 0x78e, 0x79a, 0x7a0,
+	0x7a6, 0x7aa, 0x7bd, 0x7ce, 0x7d8, 0x7e2, 0x7e8, 0x7fd, 0x806, 0x810, 0x81a, 0x824,
+	0x829, 0x832, 0x838, 0x852, 0x85a, 0x863, 0x86a, 0x872, 0x878, 0x887, 0x896, 0x8a5,
+	0x8ac, 0x8b5, 0x8bf, 0x8cf, 0x8d9, 0x8f6, 0x900, 0x90b, 0x915, 0x91e, 0x927, 0x92f,
+	0x93d, 0x950, 0x959, 0x965, 0x96c, 0x976, 0x98e, 0x9a8, 0x9ad, 0x9c9, 0x9cd, 0x9d4,
+	0x9e2, 0x9e9, 0x9f6, 0xa04, 0xa0c, 0xa11, 0xa18, 0xa2d, 0xa33, 0xa3b, 0xa45, 0xa4c,
+	0xa52, 0xa65, 0xa81, 0xa89, 0xa95, 0xa9e, 0xab9, 0xac3, 0xad4, 0xae3, 0xaeb, 0xaf2,
+	0xb02, 0xb07, 0xb0d, 0xb13, 0xb19, 0xb32, 0xb39, 0xb41, 0xb53, 0xb5a, 0xb61, 0xb6f,
+	0xb7d, 0xb86, 0xb93, 0xba2, 0xbb0, 0xbbe, 0xbca, 0xbd8, 0xbdf, 0xbf6, 0xc0d, 0xc1a,
+	0xc27, 0xc3c, 0xc49, 0xc57, 0xc62, 0xc78, 0xc83, 0xc89, 0xc97, 0xca3, 0xca9, 0xcb6,
+	0xcc3, 0xccf, 0xcdd,
+}
+
+const mkRegionStr = "" +
+	"Остров АсенсионАндораОбединети Арапски ЕмиратиАвганистанАнтигва и БарбудаАнгвила" +
+	"АлбанијаЕрменијаХоландски АнтилиАнголаАнтарктикАргентинаАмериканска СамоаАвстриј" +
+	"аАвстралијаАрубаОландски островиАзербејџанБосна и ХерцеговинаБарбадосБангладешБе" +
+	"лгијаБуркина ФасоБугаријаБахреинБурундиБенинСент БартоломејБермудиБрунејБоливија" +
+	"Карипска ХоландијаБразилБахамиБутанОстров БувеБоцванаБелорусијаБелизеКанадаКокос" +
+	"ови (Килиншки) ОстровиКонго - КиншасаЦентралноафриканска РепубликаКонго - Бразав" +
+	"илШвајцаријаБрегот на Слоновата КоскаКукови ОстровиЧилеКамерунКинаКолумбијаОстро" +
+	"в КлипертонКостарикаКубаЗелен ’РтКурасаоБожиќен ОстровКипарРепублика ЧешкаГерман" +
+	"ијаДиего ГарсијаЏибутиДанскаДоминикаДоминиканска РепубликаАлжирСеута и МелиљаЕкв" +
+	"адорЕстонијаЕгипетЗападна СахараЕритрејаШпанијаЕтиопијаЕвропска унијаФинскаФиџиФ" +
+	"олкландски ОстровиМикронезијаФарски ОстровиФранцијаГабонОбединето КралствоГренад" +
+	"аГрузијаФранцуска ГвајанаГернзиГанаГибралтарГренландГамбијаГвинејаГвадалупеЕкват" +
+	"орска ГвинејаГрцијаЈужна Грузија и Јужни Сендвич ОстровиГватемалаГвамГвинеја-Бис" +
+	"ауГвајанаХонг Конг С.А.Р КинаОстров Херд и Острови МакдоналдХондурасХрватскаХаит" +
+	"иУнгаријаКанарски островиИндонезијаИрскаИзраелОстров МанИндијаБританска Индоокеа" +
+	"нска ТериторијаИракИранИсландИталијаЏерсиЈамајкаЈорданЈапонијаКенијаКиргистанКам" +
+	"боџаКирибатиКоморски ОстровиСент Кристофер и НевисСеверна КорејаЈужна КорејаКува" +
+	"јтКајмански ОстровиКазахстанЛаосЛибанСвета ЛуцијаЛихтенштајнШри ЛанкаЛиберијаЛес" +
+	"отоЛитванијаЛуксембургЛатвијаЛибијаМарокоМонакоМолдавијаЦрна ГораСент МартинМада" +
+	"гаскарМаршалски ОстровиМакедонијаМалиМјанмар (Бурма)МонголијаМакао С.А.Р КинаСев" +
+	"ерни Маријански ОстровиМартиникМавританијаМонсератМалтаМаврициусМалдивиМалавиМек" +
+	"сикоМалезијаМозамбикНамибијаНова КаледонијаНигерНорфолшки ОстровНигеријаНикарагв" +
+	"аХоландијаНорвешкаНепалНауруНијуеНов ЗеландОманПанамаПеруФранцуска ПолинезијаПап" +
+	"уа Нова ГвинејаФилипиниПакистанПолскаСент Пјер и МикеланПиткернски ОстровиПортор" +
+	"икоПалестински територииПортугалијаПалауПарагвајКатарЗависни земји во ОкеанијаРи" +
+	"јунионРоманијаСрбијаРусијаРуандаСаудиска АрабијаСоломонски ОстровиСејшелиСуданШв" +
+	"едскаСингапурСвета ЕленаСловенијаСвалбард и Жан МејенСловачкаСиера ЛеонеСан Мари" +
+	"ноСенегалСомалијаСуринамЈужен СуданСао Томе и ПринсипеЕл СалвадорСвети МартинСир" +
+	"ијаСвазилендТристан да КуњаОстрови Туркс и КајкосЧадФранцуски Јужни територииТог" +
+	"оТајландТаџикистанТокелауИсточен Тимор (Тимор Лесте)ТуркменистанТунисТонгаТурциј" +
+	"аТринидад и ТобагоТувалуТајванТанзанијаУкраинаУгандаАмерикански територии во Пац" +
+	"ификотСоединети Американски ДржавиУругвајУзбекистанВатиканСент Винсент и Гренади" +
+	"нитеВенецуелаБритански Девствени ОстровиАмерикански Девствени ОстровиВиетнамВану" +
+	"атуВолис и ФутунаСамоаКосовоЈеменМајотЈужноафриканска РепубликаЗамбијаЗимбабвеНе" +
+	"познат регионСветАфрикаСеверна АмерикаЈужна АмерикаОкеанијаЗападна АфрикаЦентрал" +
+	"на АмерикаИсточна АфрикаСеверна АфрикаСредна АфрикаЈужна АфрикаАмерикиСеверна ко" +
+	"нтинентална АмерикаКарибиИсточна АзијаЈужна АзијаЈугоисточна АзијаЈужна ЕвропаАв" +
+	"стралазијаМеланезијаМикронезиски регионПолинезијаАзијаЦентрална АзијаЗападна Ази" +
+	"јаЕвропаИсточна ЕвропаСеверна ЕвропаЗападна ЕвропаЛатинска Америка"
+
+var mkRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1d, 0x29, 0x59, 0x6d, 0x8d, 0x9b, 0xab, 0xbb, 0xda, 0xe6, 0xf8,
+	0x10a, 0x12b, 0x13b, 0x14f, 0x159, 0x178, 0x18c, 0x1b0, 0x1c0, 0x1d2, 0x1e0, 0x1f7,
+	0x207, 0x215, 0x223, 0x22d, 0x24a, 0x258, 0x264, 0x274, 0x297, 0x2a3, 0x2af, 0x2b9,
+	0x2ce, 0x2dc, 0x2f0, 0x2fc, 0x308, 0x33a, 0x355, 0x38e, 0x3ab, 0x3bf, 0x3ee, 0x409,
+	0x411, 0x41f, 0x427, 0x439, 0x458, 0x46a, 0x472, 0x484, 0x492, 0x4ad, 0x4b7, 0x4d4,
+	0x4e6, 0x4ff, 0x50b, 0x517, 0x527, 0x552, 0x55c, 0x576, 0x584, 0x594, 0x5a0, 0x5bb,
+	0x5cb, 0x5d9, 0x5e9, 0x604, 0x610, 0x618, 0x63d, 0x653, 0x66e, 0x67e, 0x688, 0x6ab,
+	0x6b9, 0x6c7, 0x6e8, 0x6f4, 0x6fc, 0x70e, 0x71e, 0x72c, 0x73a, 0x74c, 0x76f, 0x77b,
+	0x7c0, 0x7d2, 0x7da, 0x7f3, 0x801, 0x824, 0x85e, 0x86e, 0x87e, 0x888, 0x898, 0x8b7,
+	0x8cb, 0x8d5, 0x8e1, 0x8f4, 0x900, 0x940, 0x948, 0x950, 0x95c, 0x96a, 0x974, 0x982,
+	0x98e, 0x99e, 0x9aa, 0x9bc, 0x9ca, 0x9da, 0x9f9, 0xa22, 0xa3d, 0xa54, 0xa60, 0xa81,
+	0xa93, 0xa9b, 0xaa5, 0xabc, 0xad2, 0xae3, 0xaf3, 0xaff, 0xb11, 0xb25, 0xb33, 0xb3f,
+	0xb4b, 0xb57, 0xb69, 0xb7a, 0xb8f, 0xba3, 0xbc4, 0xbd8, 0xbe0, 0xbfb, 0xc0d, 0xc29,
+	0xc5b, 0xc6b, 0xc81, 0xc91, 0xc9b, 0xcad, 0xcbb, 0xcc7, 0xcd5, 0xce5, 0xcf5, 0xd05,
+	0xd22, 0xd2c, 0xd4b, 0xd5b, 0xd6d, 0xd7f, 0xd8f, 0xd99, 0xda3, 0xdad, 0xdc0, 0xdc8,
+	0xdd4, 0xddc, 0xe03, 0xe25, 0xe35, 0xe45, 0xe51, 0xe74, 0xe97, 0xea9, 0xed2, 0xee8,
+	0xef2, 0xf02, 0xf0c, 0xf3b, 0xf4b, 0xf5b, 0xf67, 0xf73, 0xf7f, 0xf9e, 0xfc1, 0xfcf,
+	0xfd9, 0xfe7, 0xff7, 0x100c, 0x101e, 0x1043, 0x1053, 0x1068, 0x107b, 0x1089, 0x1099, 0x10a7,
+	0x10bc, 0x10df, 0x10f4, 0x110b, 0x1117, 0x1129, 0x1145, 0x116e, 0x1174, 0x11a4, 0x11ac, 0x11ba,
+	0x11ce, 0x11dc, 0x120d, 0x1225, 0x122f, 0x1239, 0x1247, 0x1267, 0x1273, 0x127f, 0x1291, 0x129f,
+	0x12ab, 0x12ec, 0x1322, 0x1330, 0x1344, 0x1352, 0x1383, 0x1395, 0x13c9, 0x1401, 0x140f, 0x141d,
+	0x1437, 0x1441, 0x144d, 0x1457, 0x1461, 0x1492, 0x14a0, 0x14b0, 0x14cd, 0x14d5, 0x14e1, 0x14fe,
+	0x1517, 0x1527, 0x1542, 0x1563, 0x157e, 0x1599, 0x15b2, 0x15c9, 0x15d7, 0x160f, 0x161b, 0x1634,
+	0x1649, 0x166a, 0x1681, 0x1699, 0x16ad, 0x16d2, 0x16e6, 0x16f0, 0x170d, 0x1726, 0x1732, 0x174d,
+	0x1768, 0x1783, 0x17a2,
+}
+
+const mlRegionStr = "" +
+	"അസൻഷൻ ദ്വീപ്അന്റോറയുണൈറ്റഡ് അറബ് എമിറൈറ്റ്‌സ്അഫ്‌ഗാനിസ്ഥാൻആൻറിഗ്വയും ബർബുഡയുംആൻഗ" +
+	"്വില്ലഅൽബേനിയഅർമേനിയനെതർലൻഡ്സ് ആൻറിലിസ്അംഗോളഅൻറാർട്ടിക്കഅർജൻറീനഅമേരിക്കൻ സാമോവഓസ" +
+	"്ട്രിയഓസ്‌ട്രേലിയഅറുബഅലൻഡ് ദ്വീപുകൾഅസർബൈജാൻബോസ്നിയയും ഹെർസഗോവിനയുംബാർബഡോസ്ബംഗ്ലാ" +
+	"ദേശ്ബെൽജിയംബുർക്കിനാ ഫാസോബൾഗേറിയബഹ്റൈൻബുറുണ്ടിബെനിൻസെൻറ് ബാർത്തെൽമിബർമുഡബ്രൂണൈബൊ" +
+	"ളീവിയബൊണെയ്ർ, സിന്റ് യുസ്റ്റേഷ്യസ്, സാബ എന്നിവബ്രസീൽബഹാമാസ്ഭൂട്ടാൻബൗവെറ്റ് ദ്വീപ" +
+	"്ബോട്സ്വാനബെലറൂസ്ബെലീസ്കാനഡകോക്കസ് ദ്വീപുകൾകോംഗോ - കിൻഷാസസെൻട്രൽ ആഫ്രിക്കൻ റിപ്പ" +
+	"ബ്ലിക്കോംഗോ - ബ്രാസാവിൽസ്വിറ്റ്സർലാൻഡ്കോട്ട് ഡി വാർകൂക്ക് ദ്വീപുകൾചിലികാമറൂൺചൈനക" +
+	"ൊളംബിയക്ലിപ്പെർട്ടൻ ദ്വീപ്കോസ്റ്ററിക്കക്യൂബകേപ്പ് വെർഡെകുറാകാവോക്രിസ്മസ് ദ്വീപ്സ" +
+	"ൈപ്രസ്സ്ചെക്ക് റിപ്പബ്ലിക്ജർമനിഡീഗോ ഗ്രാഷ്യജിബൂട്ടിഡെൻമാർക്ക്ഡൊമിനിക്കഡൊമിനിക്കൻ" +
+	" റിപ്പബ്ലിക്അൾജീരിയസെയൂത്ത ആൻഡ് മെലിയഇക്വഡോർഎസ്റ്റോണിയ‍ഈജിപ്ത്പശ്ചിമ സഹാറഎറിത്രി" +
+	"യസ്‌പെയിൻഎത്യോപ്യയൂറോപ്യൻ യൂണിയൻഫിൻലാൻഡ്ഫിജിഫാക്ക്‌ലാന്റ് ദ്വീപുകൾമൈക്രോനേഷ്യഫറോ" +
+	"യി ദ്വീപുകൾഫ്രാൻസ്ഗാബോൺബ്രിട്ടൻഗ്രനേഡജോർജിയഫ്രഞ്ച് ഗയാനഗേൺസിഘാനജിബ്രാൾട്ടർഗ്രീൻല" +
+	"ാൻറ്ഗാംബിയഗ്വിനിയഗുവാഡെലോപ്ഇക്വിറ്റോറിയൽ ഗ്വിനിയഗ്രീസ്ദക്ഷിണ ജോർജ്ജിയയും ദക്ഷിണ " +
+	"സാൻഡ്‌വിച്ച് ദ്വീപുകളുംഗ്വാട്ടിമാലാഗ്വാംഗിനി-ബിസോഗയാനഹോങ് കോങ് എസ്.ഏ.ആർ. ചൈനഹിയേ" +
+	"ർഡ് ദ്വീപും മക്ഡൊണാള്ഡ് ദ്വീപുകളുംഹോണ്ടുറാസ്ക്രൊയേഷ്യഹെയ്തിഹംഗറികാനറി ദ്വീപുകൾഇൻ" +
+	"ഡോനേഷ്യഅയർലാൻഡ്ഇസ്രായേൽഐൽ ഓഫ് മാൻഇന്ത്യബ്രിട്ടീഷ് ഇന്ത്യൻ മഹാസമുദ്ര പ്രദേശംഇറാക്" +
+	"ക്ഇറാൻഐസ്‌ലാന്റ്ഇറ്റലിജേഴ്സിജമൈക്കജോർദ്ദാൻജപ്പാൻകെനിയകിർഗിസ്ഥാൻകംബോഡിയകിരിബാത്തി" +
+	"കോമറോസ്സെയ്ൻറ് കിറ്റ്സും നെവിസുംഉത്തരകൊറിയദക്ഷിണകൊറിയകുവൈറ്റ്കേമാൻ ദ്വീപുകൾകസാഖി" +
+	"സ്ഥാൻലാവോസ്ലബനോൺസെൻറ് ലൂസിയലിച്ചൺസ്റ്റൈൻശ്രീലങ്കലൈബീരിയലെസോത്തോലിത്വാനിയലക്സംബർഗ" +
+	"്ലാത്വിയലിബിയമൊറോക്കൊമൊണോക്കൊമൾഡോവമോണ്ടേനേഗ്രോസെൻറ് മാർട്ടിൻമഡഗാസ്കർമാർഷൽ‍‍ ദ്വീ" +
+	"പുകൾമാസിഡോണിയമാലിമ്യാൻമാർ (ബർമ്മ)മംഗോളിയമക്കാവോ SAR ചൈനഉത്തര മറിയാനാ ദ്വീപുകൾമാർ" +
+	"ട്ടീനിക്മൗറിറ്റാനിയമോണ്ട്സെറാറ്റ്മാൾട്ടമൗറീഷ്യസ്മാലിദ്വീപ്മലാവിമെക്സിക്കോമലേഷ്യമ" +
+	"ൊസാംബിക്ക്നമീബിയപുതിയ കാലിഡോണിയനൈജർനോർഫോക് ദ്വീപ്നൈജീരിയനിക്കാരഗ്വനെതർലൻഡ്സ്നോർവ" +
+	"െനേപ്പാൾനൗറൂന്യൂവേന്യൂസിലാൻറ്ഒമാൻപനാമപെറുഫ്രഞ്ച് പോളിനേഷ്യപപ്പുവ ന്യൂ ഗ്വിനിഫിലി" +
+	"പ്പൈൻസ്പാക്കിസ്ഥാൻപോളണ്ട്സെൻറ് പിയാറും മിക്വലണുംപിറ്റ്കെയ്ൻപോർട്ടോ റിക്കോപാലസ്‌ത" +
+	"ീൻ പ്രദേശങ്ങൾപോർച്ചുഗൽപലാവുപരാഗ്വേഖത്തർപുറംദ്വീപുകൾറീയൂണിയൻറുമേനിയസെർബിയറഷ്യറുവാ" +
+	"ണ്ടസൗദി അറേബ്യസോളമൻ‍ ദ്വീപുകൾസെയ്‌ഷെൽസ്സുഡാൻസ്വീഡൻസിംഗപ്പുർസെൻറ് ഹെലീനസ്ലോവേനിയസ" +
+	"്വാൽബാഡും ജാൻ മായേനുംസ്ലോവാക്യസിയേറാ ലിയോൺസാൻ മറിനോസെനഗൽസോമാലിയസുറിനാംദക്ഷിണ സുഡ" +
+	"ാൻസാവോ റ്റമെ ആൻഡ് പ്രിൻസിപ്പിഎൽ സാൽവദോർസിന്റ് മാർട്ടെൻസിറിയസ്വാസിലാൻറ്ട്രസ്റ്റൻ " +
+	"ഡ കൂനടർക്ക്സും കൈക്കോ ദ്വീപുകളുംചാഡ്ഫ്രഞ്ച് ദക്ഷിണ ഭൂപ്രദേശംടോഗോതായ്‌ലാൻഡ്താജിക്" +
+	"കിസ്ഥാൻടൊകെലാവുകിഴക്കൻ തിമൂർതുർക്ക്മെനിസ്ഥാൻടുണീഷ്യടോംഗതുർക്കിട്രിനിഡാഡും റ്റൊബാ" +
+	"ഗോയുംതുവാലുതായ്‌വാൻടാൻസാനിയഉക്രൈൻഉഗാണ്ടയു.എസ് ചെറിയ പുറംദ്വീപുകളുംഅമേരിക്കൻ ഐക്യ" +
+	"നാടുകൾഉറുഗ്വേഉസ്‌ബെക്കിസ്ഥാൻവത്തിക്കാൻസെൻറ് വിൻസൻറും ഗ്രനഡൈൻസുംവെനിസ്വേലബ്രിട്ടീ" +
+	"ഷ് വെർജിൻ ദ്വീപുകൾയു.എസ്. വെർജിൻ ദ്വീപുകൾവിയറ്റ്നാംവന്വാത്തുവാലിസ് ആൻഡ് ഫ്യുചൂനസ" +
+	"ാമോവകൊസോവൊയമൻമയോട്ട്ദക്ഷിണാഫ്രിക്കസാംബിയസിംബാബ്‌വേഅജ്ഞാത പ്രദേശംലോകംആഫ്രിക്കവടക്" +
+	"കേ അമേരിക്കൻ ഭൂഖണ്ഡംതെക്കേ അമേരിക്കൻ ഭൂഖണ്ഡംഓഷ്യാനിയപശ്ചിമ ആഫ്രിക്കമദ്ധ്യഅമേരിക്" +
+	"കകിഴക്കൻ ആഫ്രിക്കവടക്കേ ആഫ്രിക്കമദ്ധ്യആഫ്രിക്കതെക്കേ ആഫ്രിക്കഅമേരിക്കൻ ഭൂഖണ്ഡംവട" +
+	"ക്കേ അമേരിക്കകരീബിയൻകിഴക്കൻ ഏഷ്യതെക്കേ ഏഷ്യതെക്ക്-കിഴക്കൻ ഏഷ്യതെക്കേ യൂറോപ്പ്ഓസ്" +
+	"‌ട്രേലിയയും ന്യൂസിലാൻഡുംമെലനേഷ്യമൈക്രോനേഷ്യൻ പ്രദേശംപോളിനേഷ്യഏഷ്യമദ്ധ്യേഷ്യപശ്ചി" +
+	"മേഷ്യയൂറോപ്പ്കിഴക്കൻ യൂറോപ്പ്വടക്കേ യൂറോപ്പ്പശ്ചിമ യൂറോപ്പ്ലാറ്റിനമേരിക്ക"
+
+var mlRegionIdx = []uint16{ // 291 entries
+	0x0, 0x22, 0x34, 0x81, 0xa8, 0xdf, 0xfa, 0x10f, 0x124, 0x15b, 0x16a, 0x18e,
+	0x1a3, 0x1ce, 0x1e6, 0x207, 0x213, 0x23b, 0x253, 0x296, 0x2ae, 0x2cc, 0x2e1, 0x309,
+	0x31e, 0x330, 0x348, 0x357, 0x385, 0x394, 0x3a6, 0x3bb, 0x42a, 0x43c, 0x451, 0x466,
+	0x491, 0x4ac, 0x4c1, 0x4d3, 0x4df, 0x50d, 0x531, 0x584, 0x5b1, 0x5de, 0x601, 0x62c,
+	0x638, 0x64a, 0x653, 0x668, 0x6a2, 0x6c6, 0x6d5, 0x6f7, 0x70f, 0x73d, 0x758, 0x78c,
+	0x79b, 0x7bd, 0x7d5, 0x7f3, 0x80e, 0x84e, 0x863, 0x895, 0x8aa, 0x8cb, 0x8e0, 0x8ff,
+	0x917, 0x92f, 0x947, 0x972, 0x98a, 0x996, 0x9d6, 0x9f7, 0xa1f, 0xa34, 0xa43, 0xa5b,
+	0xa6d, 0xa7f, 0xaa1, 0xab0, 0xab9, 0xada, 0xaf8, 0xb0a, 0xb1f, 0xb3d, 0xb7a, 0xb8c,
+	0xc17, 0xc3b, 0xc4a, 0xc63, 0xc6f, 0xca8, 0xd14, 0xd32, 0xd4d, 0xd5f, 0xd6e, 0xd96,
+	0xdb1, 0xdc9, 0xde1, 0xdfb, 0xe0d, 0xe73, 0xe88, 0xe94, 0xeb2, 0xec4, 0xed6, 0xee8,
+	0xf00, 0xf12, 0xf21, 0xf3f, 0xf54, 0xf72, 0xf87, 0xfce, 0xfec, 0x100d, 0x1025, 0x104d,
+	0x106b, 0x107d, 0x108c, 0x10ab, 0x10d2, 0x10ea, 0x10ff, 0x1117, 0x1132, 0x114d, 0x1162, 0x1171,
+	0x1189, 0x11a1, 0x11b0, 0x11d4, 0x11fc, 0x1214, 0x1242, 0x125d, 0x1269, 0x1293, 0x12a8, 0x12cb,
+	0x1309, 0x132a, 0x134b, 0x1375, 0x1387, 0x13a2, 0x13c0, 0x13cf, 0x13ed, 0x13ff, 0x1420, 0x1432,
+	0x145d, 0x1469, 0x1491, 0x14a6, 0x14c4, 0x14e2, 0x14f1, 0x1506, 0x1512, 0x1524, 0x1545, 0x1551,
+	0x155d, 0x1569, 0x159a, 0x15cc, 0x15ed, 0x160e, 0x1623, 0x1664, 0x1685, 0x16ad, 0x16e7, 0x1702,
+	0x1711, 0x1726, 0x1735, 0x1759, 0x1771, 0x1786, 0x1798, 0x17a4, 0x17b9, 0x17d8, 0x1803, 0x1821,
+	0x1830, 0x1842, 0x185d, 0x187c, 0x1897, 0x18d5, 0x18f0, 0x1912, 0x192b, 0x193a, 0x194f, 0x1964,
+	0x1986, 0x19d1, 0x19ed, 0x1a18, 0x1a27, 0x1a48, 0x1a71, 0x1abe, 0x1aca, 0x1b0e, 0x1b1a, 0x1b38,
+	0x1b5f, 0x1b77, 0x1b9c, 0x1bcc, 0x1be1, 0x1bed, 0x1c02, 0x1c45, 0x1c57, 0x1c6f, 0x1c87, 0x1c99,
+	0x1cab, 0x1cf6, 0x1d30, 0x1d45, 0x1d72, 0x1d90, 0x1dd7, 0x1df2, 0x1e3c, 0x1e79, 0x1e97, 0x1eb2,
+	0x1ee7, 0x1ef6, 0x1f08, 0x1f11, 0x1f26, 0x1f50, 0x1f62, 0x1f80, 0x1fa8, 0x1fb4, 0x1fcc, 0x2010,
+	0x2054, 0x206c, 0x2097, 0x20c1, 0x20ef, 0x211a, 0x2144, 0x216f, 0x21a0, 0x21cb, 0x21e0, 0x2202,
+	0x2221, 0x2256, 0x2281, 0x22d0, 0x22e8, 0x2322, 0x233d, 0x2349, 0x2367, 0x2385, 0x239d, 0x23cb,
+	0x23f6, 0x2421, 0x244b,
+}
+
+const mnRegionStr = "" +
+	"Аскенсион АралАндорраАрабын Нэгдсэн ЭмиратАфганистанАнтигуа ба БарбудаАнгилаАлба" +
+	"ниАрменАнголАнтарктикАргентинАмерикийн СамоаАвстриАвстралиАрубаАландын АрлуудАзе" +
+	"рбайжанБосни ХерцеговинБарбадосБангладешБелгиБуркина фасоБолгарБахрейнБурундиБен" +
+	"инСент БартельмиБермудБрунейБоливиКарибын НидерландБразилБагамБутанБуветын Арлуу" +
+	"дБотсванаБеларусБелизКанадКокос (Кийлинг) АрлуудКонго-КиншасаТөв Африкийн Бүгд Н" +
+	"айрамдах УлсКонго БраззавильШвейцариКот д'ИвуарКүүкийн АрлуудЧилиКамерунХятадКол" +
+	"умбКлиппертон АралКоста РикаКубаКапе ВердеКуракаоЗул Сарын АралКипрБүгд Найрамда" +
+	"х Чех УлсГерманДиего ГарсиаДжибутиДаниДоминикБүгд Найрамдах ДоминиканАлжирСеута " +
+	"ба МелильяЭквадорЭстонЕгипетБаруун СахарЭритриИспаниЭтиопЕвропын ХолбооФинландФи" +
+	"жиФолькландын АрлуудМикронезиФароэ АрлуудФранцГабонИх БританиГренадаГүржФранцын " +
+	"ГайанаГернсиГанаГибралтарГренландГамбиГвинейГваделупЭкваторын ГвинейГрекӨмнөд Жо" +
+	"ржиа ба Өмнөд Сэндвичийн АрлуудГватемалГуамГвиней-БисауГайанаБНХАУ-ын Тусгай зах" +
+	"иргааны бүс Хонг КонгХэрд болон Макдоналд АрлуудГондурасХорватГаитиУнгарКанарын " +
+	"арлуудИндонезиИрландИзраильМэн АралЭнэтхэгБританийн харьяа Энэтхэгийн далай дахь" +
+	" нутаг дэвсгэрүүдИракИранИсландИталиЖерсиЯмайкЙорданЯпонКениКыргызстанКамбожКири" +
+	"батиКоморосСент-Киттс ба НевисХойд СолонгосӨмнөд СолонгосКувейтКайманы АрлуудКаз" +
+	"ахстанЛаосЛиванСент ЛюсиаЛихтенштейнШри ЛанкаЛибериЛесотоЛитваЛюксембургЛатвиЛив" +
+	"иМароккоМонакоМолдавМонтенегроСент-МартинМадагаскарМаршаллын АрлуудМакедонМалиМь" +
+	"янмар (Бурма)МонголБНХАУ-ын Тусгай захиргааны бүс МакаоХойд Марианы АрлуудМартин" +
+	"икМавританиМонтсерратМальтаМавритусМальдивМалавиМексикМалайзМозамбикНамибиШинэ К" +
+	"аледониНигерНорфолк АрлуудНигериНикарагуаГолландНорвегиБалбаНауруНиуэШинэ Зеланд" +
+	"ОманПанамПеруФранцын ПолинезПапуа Шинэ ГвинейФилиппинПакистанПольшСэнт Пьер ба М" +
+	"икелонПиткэрн АрлуудПуэрто РикоПалестины нутаг дэвсгэрүүдПортугалПалауПарагвайКа" +
+	"тарНомхон далайг тойрсон улс орнуудРеюньонРумынСербиОросРуандаСаудын АрабСоломон" +
+	"ы АрлуудСейшелСуданШведСингапурСент ХеленаСловениСвалбард ба Ян МайенСловакСьерр" +
+	"а-ЛеонеСан-МариноСенегалСомалиСуринамӨмнөд СуданСан-Томе ба ПринсипиЭль Сальвадо" +
+	"рСинт МартенСириСвазиландТристан да КуньяТурк ба Кайкосын АрлуудЧадФранцын өмнөд" +
+	" газар нутагТогоТайландТажикистанТокелауТимор-ЛестеТуркменистанТунисТонгаТуркТри" +
+	"нидад ба ТобагоТувалуТайванТанзаниУкрайнУгандаАНУ-ын тойрсон арлуудАмерикийн Нэг" +
+	"дсэн УлсУругвайУзбекистанВатикан хот улсСэнт Винсэнт ба ГренадинВенесуэлБританий" +
+	"н Виржиний АрлуудАНУ-ын Виржиний АрлуудВьетнамВануатуУоллис ба ФутунаСамоаКосово" +
+	"ЙеменМайоттеӨмнөд Африк тивЗамбиЗимбабвеТодорхойгүйДэлхийАфрикХойд АмерикӨмнөд А" +
+	"мерикНомхон далайн орнуудБаруун АфрикТөв АмерикЗүүн АфрикХойд АфрикТөв АфрикӨмнө" +
+	"д АфрикАмерикХойд Америк тивКарибынЗүүн АзиӨмнөд АзиЗүүн Өмнөд АзиӨмнөд ЕвропАвс" +
+	"тралиазиМеланезиМикронезийн бүсПолинезАзиТөв АзиБаруун АзиЕвропЗүүн ЕвропХойд Ев" +
+	"ропБаруун ЕвропЛатин Америк"
+
+var mnRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1b, 0x29, 0x51, 0x65, 0x87, 0x93, 0x9f, 0xa9, 0xa9, 0xb3, 0xc5,
+	0xd5, 0xf2, 0xfe, 0x10e, 0x118, 0x133, 0x147, 0x166, 0x176, 0x188, 0x192, 0x1a9,
+	0x1b5, 0x1c3, 0x1d1, 0x1db, 0x1f6, 0x202, 0x20e, 0x21a, 0x23b, 0x247, 0x251, 0x25b,
+	0x276, 0x286, 0x294, 0x29e, 0x2a8, 0x2d0, 0x2e9, 0x323, 0x342, 0x352, 0x366, 0x381,
+	0x389, 0x397, 0x3a1, 0x3ad, 0x3ca, 0x3dd, 0x3e5, 0x3f8, 0x406, 0x420, 0x428, 0x451,
+	0x45d, 0x474, 0x482, 0x48a, 0x498, 0x4c6, 0x4d0, 0x4ee, 0x4fc, 0x506, 0x512, 0x529,
+	0x535, 0x541, 0x54b, 0x566, 0x574, 0x57c, 0x59f, 0x5b1, 0x5c8, 0x5d2, 0x5dc, 0x5ef,
+	0x5fd, 0x605, 0x620, 0x62c, 0x634, 0x646, 0x656, 0x660, 0x66c, 0x67c, 0x69b, 0x6a3,
+	0x6ec, 0x6fc, 0x704, 0x71b, 0x727, 0x771, 0x7a4, 0x7b4, 0x7c0, 0x7ca, 0x7d4, 0x7ef,
+	0x7ff, 0x80b, 0x819, 0x828, 0x836, 0x89e, 0x8a6, 0x8ae, 0x8ba, 0x8c4, 0x8ce, 0x8d8,
+	0x8e4, 0x8ec, 0x8f4, 0x908, 0x914, 0x924, 0x932, 0x955, 0x96e, 0x989, 0x995, 0x9b0,
+	0x9c2, 0x9ca, 0x9d4, 0x9e7, 0x9fd, 0xa0e, 0xa1a, 0xa26, 0xa30, 0xa44, 0xa4e, 0xa56,
+	0xa64, 0xa70, 0xa7c, 0xa90, 0xaa5, 0xab9, 0xad8, 0xae6, 0xaee, 0xb09, 0xb15, 0xb58,
+	0xb7c, 0xb8c, 0xb9e, 0xbb2, 0xbbe, 0xbce, 0xbdc, 0xbe8, 0xbf4, 0xc00, 0xc10, 0xc1c,
+	0xc35, 0xc3f, 0xc5a, 0xc66, 0xc78, 0xc86, 0xc94, 0xc9e, 0xca8, 0xcb0, 0xcc5, 0xccd,
+	0xcd7, 0xcdf, 0xcfc, 0xd1c, 0xd2c, 0xd3c, 0xd46, 0xd6b, 0xd86, 0xd9b, 0xdcd, 0xddd,
+	0xde7, 0xdf7, 0xe01, 0xe3d, 0xe4b, 0xe55, 0xe5f, 0xe67, 0xe73, 0xe88, 0xea5, 0xeb1,
+	0xebb, 0xec3, 0xed3, 0xee8, 0xef6, 0xf1b, 0xf27, 0xf3e, 0xf51, 0xf5f, 0xf6b, 0xf79,
+	0xf8e, 0xfb3, 0xfcc, 0xfe1, 0xfe9, 0xffb, 0x1019, 0x1044, 0x104a, 0x1079, 0x1081, 0x108f,
+	0x10a3, 0x10b1, 0x10c6, 0x10de, 0x10e8, 0x10f2, 0x10fa, 0x111c, 0x1128, 0x1134, 0x1142, 0x114e,
+	0x115a, 0x1181, 0x11a9, 0x11b7, 0x11cb, 0x11e7, 0x1214, 0x1224, 0x1254, 0x127d, 0x128b, 0x1299,
+	0x12b7, 0x12c1, 0x12cd, 0x12d7, 0x12e5, 0x1301, 0x130b, 0x131b, 0x1331, 0x133d, 0x1347, 0x135c,
+	0x1373, 0x1399, 0x13b0, 0x13c3, 0x13d6, 0x13e9, 0x13fa, 0x140f, 0x141b, 0x1437, 0x1445, 0x1454,
+	0x1465, 0x147f, 0x1494, 0x14aa, 0x14ba, 0x14d7, 0x14e5, 0x14eb, 0x14f8, 0x150b, 0x1515, 0x1528,
+	0x153b, 0x1552, 0x1569,
+}
+
+const mrRegionStr = "" +
+	"अ‍ॅसेन्शियन बेटअँडोरासंयुक्त अरब अमीरातअफगाणिस्तानअँटिग्वा आणि बर्बुडाअँग्विलाअल" +
+	"्बानियाअर्मेनियानेदरलँड्‍स अँ‍टिल्सअंगोलाअंटार्क्टिकाअर्जेंटिनाअमेरिकन सामोआऑस्ट" +
+	"्रियाऑस्ट्रेलियाअरुबाअ‍ॅलँड बेटेअझरबैजानबोस्निया अणि हर्जेगोविनाबार्बाडोसबांग्ला" +
+	"देशबेल्जियमबुर्किना फासोबल्गेरियाबहारीनबुरुंडीबेनिनसेंट बार्थेलेमीबर्मुडाब्रुनेई" +
+	"बोलिव्हियाकॅरिबियन नेदरलँड्सब्राझिलबहामाजभूतानबोउवेट बेटबोट्सवानाबेलारूसबेलिझेकॅ" +
+	"नडाकोकोस बेटेकाँगो - किंशासाकेंद्रीय अफ्रिकी प्रजासत्ताककाँगो - ब्राझाविलेस्वित्" +
+	"झर्लंडआयव्हरी कोस्टकुक बेटेचिलीकॅमेरूनचीनकोलम्बियाक्लिपरटोन बेटकोस्टारिकाक्यूबाक" +
+	"ेप व्हर्डेकुरॅकोख्रिसमस बेटसायप्रसझेक प्रजासत्ताकजर्मनीदिएगो गार्सियाजिबौटीडेन्म" +
+	"ार्कडोमिनिकाडोमिनिकन प्रजासत्ताकअल्जीरियास्यूटा आणि मेलिलाइक्वाडोरएस्टोनियाइजिप्" +
+	"तपश्चिम सहाराएरिट्रियास्पेनइथिओपियायुरोपीय संघफिनलंडफिजीफॉकलंड बेटेमायक्रोनेशिया" +
+	"फरोए बेटेफ्रान्सगॅबॉनयुनायटेड किंगडमग्रेनेडाजॉर्जियाफ्रेंच गयानाग्वेर्नसेघानाजिब" +
+	"्राल्टरग्रीनलंडगाम्बियागिनीग्वाडेलोउपेइक्वेटोरियल गिनीग्रीसदक्षिण जॉर्जिया आणि द" +
+	"क्षिण सँडविच बेटेग्वाटेमालागुआमगिनी-बिसाउगयानाहाँगकाँग एसएआर चीनहर्ड आणि मॅक्डोन" +
+	"ाल्ड बेटेहोंडुरासक्रोएशियाहैतीहंगेरीकॅनरी बेटेइंडोनेशियाआयर्लंडइस्त्राइलइस्ले ऑफ" +
+	" मॅनभारतब्रिटिश इंडियन ओशन प्रदेशइराकईराणआइसलँडइटलीजर्सीजमैकाजॉर्डनजपानकेनियाकिर" +
+	"गिझस्तानकंबोडियाकिरीबाटीकोमोरोजसेंट किट्स आणि नेव्हिसउत्तर कोरियादक्षिण कोरियाकु" +
+	"वेतकेमन बेटेकझाकस्तानलाओसलेबनॉनसेंट ल्यूसियालिंचेनस्टाइनश्रीलंकालायबेरियालेसोथोल" +
+	"िथुआनियालक्झेंबर्गलाट्‌वियालिबियामोरोक्कोमोनॅकोमोल्डोव्हामोंटेनेग्रोसेंट मार्टिन" +
+	"मादागास्करमार्शल बेटेमॅसेडोनियामालीम्यानमार (बर्मा)मंगोलियामकाओ एसएआर चीनउत्तरी " +
+	"मारियाना बेटेमार्टिनिकमॉरिटानियामॉन्ट्सेराटमाल्टामॉरिशसमालदीवमलावीमेक्सिकोमलेशिय" +
+	"ामोझाम्बिकनामिबियान्यू कॅलेडोनियानाइजरनॉरफॉक बेटनायजेरियानिकाराग्वेनेदरलँडनॉर्वे" +
+	"नेपाळनऊरुनीयून्यूझीलंडओमानपनामापेरूफ्रेंच पॉलिनेशियापापुआ न्यू गिनीफिलिपिन्सपाकि" +
+	"स्तानपोलंडसेंट पियरे आणि मिक्वेलोनपिटकैर्न बेटेपोर्टो रिकोपॅलेस्टिनियन प्रदेशपोर" +
+	"्तुगालपलाऊपराग्वेकतारआउटलाईंग ओशनियारियुनियनरोमानियासर्बियारशियारवांडासौदी अरबसो" +
+	"लोमन बेटेसेशेल्ससुदानस्वीडनसिंगापूरसेंट हेलेनास्लोव्हेनियास्वालबर्ड आणि जान माये" +
+	"नस्लोव्हाकियासिएरा लिओनसॅन मरीनोसेनेगलसोमालियासुरिनामदक्षिण सुदानसाओ टोम आणि प्र" +
+	"िंसिपेअल साल्वाडोरसिंट मार्टेनसीरियास्वाझिलँडट्रिस्टन दा कन्हाटर्क्स आणि कैकोस ब" +
+	"ेटेचाडफ्रेंच दाक्षिणात्य प्रदेशटोगोथायलंडताजिकिस्तानतोकेलाउपूर्व तिमोरतुर्कमेनिस" +
+	"्तानट्यूनिशियाटोंगातुर्कीत्रिनिदाद आणि टोबॅगोटुवालुतैवानटांझानियायुक्रेनयुगांडाय" +
+	"ू.एस. आउटलाइंग बेटेयुनायटेड स्टेट्सउरुग्वेउझबेकिस्तानव्हॅटिकन सिटीसेंट व्हिन्सें" +
+	"ट आणि ग्रेनडाइन्सव्हेनेझुएलाब्रिटिश व्हर्जिन बेटेयू.एस. व्हर्जिन बेटेव्हिएतनामवा" +
+	"नौटुवालिस आणि फ्यूचूनासामोआकोसोव्होयेमेनमायोट्टेदक्षिण आफ्रिकाझाम्बियाझिम्बाब्वे" +
+	"अज्ञात प्रदेशविश्वआफ्रिकाउत्तर अमेरिकादक्षिण अमेरिकाओशनियापश्चिम आफ्रिकामध्य अमे" +
+	"रिकापूर्व आफ्रिकाउत्तर आफ्रिकामध्य आफ्रिकादक्षिणी आफ्रिकाअमेरिकाउत्तरी अमेरिकाकॅ" +
+	"रीबियनपूर्व अशियादक्षिण अशियादक्षिण पूर्व अशियादक्षिण युरोपऑस्‍ट्रेलेशियामेलानेश" +
+	"ियामायक्रोनेशियन प्रदेशपॉलिनेशियाअशियामध्य अशियापश्चिम अशियायुरोपपूर्व युरोपउत्त" +
+	"र युरोपपश्चिम युरोपलॅटिन अमेरिका"
+
+var mrRegionIdx = []uint16{ // 291 entries
+	0x0, 0x2b, 0x3d, 0x6f, 0x90, 0xc8, 0xe0, 0xfb, 0x116, 0x14d, 0x15f, 0x183,
+	0x1a1, 0x1c6, 0x1e1, 0x202, 0x211, 0x230, 0x248, 0x28c, 0x2a7, 0x2c5, 0x2dd, 0x302,
+	0x31d, 0x32f, 0x344, 0x353, 0x37e, 0x393, 0x3a8, 0x3c6, 0x3fa, 0x40f, 0x421, 0x430,
+	0x44c, 0x467, 0x47c, 0x48e, 0x49d, 0x4b9, 0x4e0, 0x530, 0x560, 0x584, 0x5a9, 0x5bf,
+	0x5cb, 0x5e0, 0x5e9, 0x604, 0x629, 0x647, 0x659, 0x678, 0x68a, 0x6a9, 0x6be, 0x6e9,
+	0x6fb, 0x723, 0x735, 0x750, 0x768, 0x7a2, 0x7bd, 0x7ec, 0x804, 0x81f, 0x831, 0x853,
+	0x86e, 0x87d, 0x895, 0x8b4, 0x8c6, 0x8d2, 0x8f1, 0x918, 0x931, 0x946, 0x955, 0x980,
+	0x998, 0x9b0, 0x9d2, 0x9ed, 0x9f9, 0xa17, 0xa2f, 0xa47, 0xa53, 0xa74, 0xaa2, 0xab1,
+	0xb19, 0xb37, 0xb43, 0xb5f, 0xb6e, 0xba0, 0xbe5, 0xbfd, 0xc18, 0xc24, 0xc36, 0xc52,
+	0xc70, 0xc85, 0xca0, 0xcc0, 0xccc, 0xd11, 0xd1d, 0xd29, 0xd3b, 0xd47, 0xd56, 0xd65,
+	0xd77, 0xd83, 0xd95, 0xdb6, 0xdce, 0xde6, 0xdfb, 0xe37, 0xe59, 0xe7e, 0xe8d, 0xea6,
+	0xec1, 0xecd, 0xedf, 0xf04, 0xf28, 0xf40, 0xf5b, 0xf6d, 0xf88, 0xfa6, 0xfc1, 0xfd3,
+	0xfeb, 0xffd, 0x101b, 0x103c, 0x105e, 0x107c, 0x109b, 0x10b9, 0x10c5, 0x10ef, 0x1107, 0x112d,
+	0x1165, 0x1180, 0x119e, 0x11bf, 0x11d1, 0x11e3, 0x11f5, 0x1204, 0x121c, 0x1231, 0x124c, 0x1264,
+	0x128f, 0x129e, 0x12ba, 0x12d5, 0x12f3, 0x1308, 0x131a, 0x1329, 0x1335, 0x1341, 0x135c, 0x1368,
+	0x1377, 0x1383, 0x13b4, 0x13dd, 0x13f8, 0x1413, 0x1422, 0x1464, 0x1489, 0x14a8, 0x14df, 0x14fa,
+	0x1506, 0x151b, 0x1527, 0x1552, 0x156a, 0x1582, 0x1597, 0x15a6, 0x15b8, 0x15ce, 0x15ed, 0x1602,
+	0x1611, 0x1623, 0x163b, 0x165a, 0x167e, 0x16bd, 0x16e1, 0x16fd, 0x1716, 0x1728, 0x1740, 0x1755,
+	0x1777, 0x17b0, 0x17d2, 0x17f4, 0x1806, 0x1821, 0x1850, 0x1889, 0x1892, 0x18d9, 0x18e5, 0x18f7,
+	0x1918, 0x192d, 0x194c, 0x1976, 0x1994, 0x19a3, 0x19b5, 0x19ed, 0x19ff, 0x1a0e, 0x1a29, 0x1a3e,
+	0x1a53, 0x1a87, 0x1ab5, 0x1aca, 0x1aeb, 0x1b10, 0x1b67, 0x1b88, 0x1bc3, 0x1bf7, 0x1c12, 0x1c24,
+	0x1c56, 0x1c65, 0x1c7d, 0x1c8c, 0x1ca4, 0x1ccc, 0x1ce4, 0x1d02, 0x1d27, 0x1d36, 0x1d4b, 0x1d70,
+	0x1d98, 0x1daa, 0x1dd2, 0x1df4, 0x1e19, 0x1e3e, 0x1e60, 0x1e8b, 0x1ea0, 0x1ec8, 0x1ee0, 0x1eff,
+	0x1f21, 0x1f53, 0x1f75, 0x1f9f, 0x1fbd, 0x1ff7, 0x2015, 0x2024, 0x2040, 0x2062, 0x2071, 0x2090,
+	0x20af, 0x20d1, 0x20f6,
+}
+
+const msRegionStr = "" +
+	"Pulau AscensionAndorraEmiriah Arab BersatuAfghanistanAntigua dan BarbudaAnguilla" +
+	"AlbaniaArmeniaNetherlands AntillesAngolaAntartikaArgentinaAmerican SamoaAustriaA" +
+	"ustraliaArubaKepulauan AlandAzerbaijanBosnia dan HerzegovinaBarbadosBangladeshBe" +
+	"lgiumBurkina FasoBulgariaBahrainBurundiBeninSaint BarthélemyBermudaBruneiBolivia" +
+	"Belanda CaribbeanBrazilBahamasBhutanPulau BouvetBotswanaBelarusBelizeKanadaKepul" +
+	"auan Cocos (Keeling)Congo - KinshasaRepublik Afrika TengahCongo - BrazzavilleSwi" +
+	"tzerlandCote d’IvoireKepulauan CookChileCameroonChinaColombiaPulau ClippertonCos" +
+	"ta RicaCubaCape VerdeCuracaoPulau KrismasCyprusRepublik CzechJermanDiego GarciaD" +
+	"jiboutiDenmarkDominicaRepublik DominicaAlgeriaCeuta dan MelillaEcuadorEstoniaMes" +
+	"irSahara BaratEritreaSepanyolEthiopiaKesatuan EropahFinlandFijiKepulauan Falklan" +
+	"dMicronesiaKepulauan FaroePerancisGabonUnited KingdomGrenadaGeorgiaGuiana Peranc" +
+	"isGuernseyGhanaGibraltarGreenlandGambiaGuineaGuadeloupeGuinea KhatulistiwaYunani" +
+	"Kepualaun Georgia Selatan dan Sandwich SelatanGuatemalaGuamGuinea BissauGuyanaHo" +
+	"ng Kong SAR ChinaPulau Heard dan Kepulauan McDonaldHondurasCroatiaHaitiHungaryKe" +
+	"pulauan CanaryIndonesiaIrelandIsraelIsle of ManIndiaWilayah Lautan Hindi British" +
+	"IraqIranIcelandItaliJerseyJamaicaJordanJepunKenyaKyrgyzstanKembojaKiribatiComoro" +
+	"sSaint Kitts dan NevisKorea UtaraKorea SelatanKuwaitKepulauan CaymanKazakhstanLa" +
+	"osLubnanSaint LuciaLiechtensteinSri LankaLiberiaLesothoLithuaniaLuxembourgLatvia" +
+	"LibyaMaghribiMonacoMoldovaMontenegroSaint MartinMadagaskarKepulauan MarshallMace" +
+	"doniaMaliMyanmar (Burma)MongoliaMacau SAR ChinaKepulauan Mariana UtaraMartinique" +
+	"MauritaniaMontserratMaltaMauritiusMaldivesMalawiMexicoMalaysiaMozambikNamibiaNew" +
+	" CaledoniaNigerNorfolk IslandNigeriaNicaraguaBelandaNorwayNepalNauruNiueNew Zeal" +
+	"andOmanPanamaPeruPolinesia PerancisPapua New GuineaFilipinaPakistanPolandSaint P" +
+	"ierre dan MiquelonKepulauan PitcairnPuerto RicoWilayah PalestinPortugalPalauPara" +
+	"guayQatarOceania TerpencilReunionRomaniaSerbiaRusiaRwandaArab SaudiKepulauan Sol" +
+	"omonSeychellesSudanSwedenSingapuraSaint HelenaSloveniaSvalbard dan Jan MayenSlov" +
+	"akiaSierra LeoneSan MarinoSenegalSomaliaSurinamSudan SelatanSao Tome dan Princip" +
+	"eEl SalvadorSint MaartenSyriaSwazilandTristan da CunhaKepulauan Turks dan Caicos" +
+	"ChadWilayah Selatan PerancisTogoThailandTajikistanTokelauTimor TimurTurkmenistan" +
+	"TunisiaTongaTurkiTrinidad dan TobagoTuvaluTaiwanTanzaniaUkraineUgandaKepulauan T" +
+	"erpencil A.S.Amerika SyarikatUruguayUzbekistanKota VaticanSaint Vincent dan Gren" +
+	"adinesVenezuelaKepulauan Virgin BritishKepulauan Virgin A.S.VietnamVanuatuWallis" +
+	" dan FutunaSamoaKosovoYamanMayotteAfrika SelatanZambiaZimbabweWilayah Tidak Dike" +
+	"tahuiDuniaAfrikaAmerika UtaraAmerika SelatanOceaniaAfrika BaratAmerika TengahAfr" +
+	"ika TimurAfrika UtaraAfrika TengahSelatan AfrikaAmerikaUtara AmerikaCaribbeanAsi" +
+	"a TimurAsia SelatanAsia TenggaraEropah SelatanAustralasiaMelanesiaWilayah Mikron" +
+	"esiaPolinesiaAsiaAsia TengahAsia BaratEropahEropah TimurEropah UtaraEropah Barat" +
+	"Amerika Latin"
+
+var msRegionIdx = []uint16{ // 291 entries
+	0x0, 0xf, 0x16, 0x2a, 0x35, 0x48, 0x50, 0x57, 0x5e, 0x72, 0x78, 0x81,
+	0x8a, 0x98, 0x9f, 0xa8, 0xad, 0xbc, 0xc6, 0xdc, 0xe4, 0xee, 0xf5, 0x101,
+	0x109, 0x110, 0x117, 0x11c, 0x12d, 0x134, 0x13a, 0x141, 0x152, 0x158, 0x15f, 0x165,
+	0x171, 0x179, 0x180, 0x186, 0x18c, 0x1a5, 0x1b5, 0x1cb, 0x1de, 0x1e9, 0x1f8, 0x206,
+	0x20b, 0x213, 0x218, 0x220, 0x230, 0x23a, 0x23e, 0x248, 0x24f, 0x25c, 0x262, 0x270,
+	0x276, 0x282, 0x28a, 0x291, 0x299, 0x2aa, 0x2b1, 0x2c2, 0x2c9, 0x2d0, 0x2d5, 0x2e1,
+	0x2e8, 0x2f0, 0x2f8, 0x307, 0x30e, 0x312, 0x324, 0x32e, 0x33d, 0x345, 0x34a, 0x358,
+	0x35f, 0x366, 0x375, 0x37d, 0x382, 0x38b, 0x394, 0x39a, 0x3a0, 0x3aa, 0x3bd, 0x3c3,
+	0x3f1, 0x3fa, 0x3fe, 0x40b, 0x411, 0x424, 0x446, 0x44e, 0x455, 0x45a, 0x461, 0x471,
+	0x47a, 0x481, 0x487, 0x492, 0x497, 0x4b3, 0x4b7, 0x4bb, 0x4c2, 0x4c7, 0x4cd, 0x4d4,
+	0x4da, 0x4df, 0x4e4, 0x4ee, 0x4f5, 0x4fd, 0x504, 0x519, 0x524, 0x531, 0x537, 0x547,
+	0x551, 0x555, 0x55b, 0x566, 0x573, 0x57c, 0x583, 0x58a, 0x593, 0x59d, 0x5a3, 0x5a8,
+	0x5b0, 0x5b6, 0x5bd, 0x5c7, 0x5d3, 0x5dd, 0x5ef, 0x5f8, 0x5fc, 0x60b, 0x613, 0x622,
+	0x639, 0x643, 0x64d, 0x657, 0x65c, 0x665, 0x66d, 0x673, 0x679, 0x681, 0x689, 0x690,
+	0x69d, 0x6a2, 0x6b0, 0x6b7, 0x6c0, 0x6c7, 0x6cd, 0x6d2, 0x6d7, 0x6db, 0x6e6, 0x6ea,
+	0x6f0, 0x6f4, 0x706, 0x716, 0x71e, 0x726, 0x72c, 0x745, 0x757, 0x762, 0x772, 0x77a,
+	0x77f, 0x787, 0x78c, 0x79d, 0x7a4, 0x7ab, 0x7b1, 0x7b6, 0x7bc, 0x7c6, 0x7d7, 0x7e1,
+	0x7e6, 0x7ec, 0x7f5, 0x801, 0x809, 0x81f, 0x827, 0x833, 0x83d, 0x844, 0x84b, 0x852,
+	0x85f, 0x874, 0x87f, 0x88b, 0x890, 0x899, 0x8a9, 0x8c3, 0x8c7, 0x8df, 0x8e3, 0x8eb,
+	0x8f5, 0x8fc, 0x907, 0x913, 0x91a, 0x91f, 0x924, 0x937, 0x93d, 0x943, 0x94b, 0x952,
+	0x958, 0x970, 0x980, 0x987, 0x991, 0x99d, 0x9b9, 0x9c2, 0x9da, 0x9ef, 0x9f6, 0x9fd,
+	0xa0e, 0xa13, 0xa19, 0xa1e, 0xa25, 0xa33, 0xa39, 0xa41, 0xa58, 0xa5d, 0xa63, 0xa70,
+	0xa7f, 0xa86, 0xa92, 0xaa0, 0xaac, 0xab8, 0xac5, 0xad3, 0xada, 0xae7, 0xaf0, 0xafa,
+	0xb06, 0xb13, 0xb21, 0xb2c, 0xb35, 0xb47, 0xb50, 0xb54, 0xb5f, 0xb69, 0xb6f, 0xb7b,
+	0xb87, 0xb93, 0xba0,
+}
+
+const myRegionStr = "" +
+	"အက်စ်စင်ရှီအွန်ကျွန်းအန်ဒိုရာယူအေအီးအာဖဂန်နစ္စတန်အန်တီဂုအာနှင့်ဘာဘုဒါအန်ဂွီလာအဲလ" +
+	"်ဘာနီအာအာမေနီးယားအင်ဂိုလာအန္တာတိကအာဂျင်တီးနားအမေရိကန် စမိုအဩစတြီးယားဩစတြေးလျအာရု" +
+	"ဘာအာလန်ကျွန်းအဇာဘိုင်ဂျန်ဘော့စနီးယား နှင့် ဟာဇီဂိုဘီးနားဘာဘဒိုးစ်ဘင်္ဂလားဒေ့ရှ်ဘ" +
+	"ယ်လ်ဂျီယမ်ဘာကီနာ ဖာဆိုဘူဂေးရီးယားဘာရိန်းဘူရွန်ဒီဘီနင်စိန့်ဘာသီလီမိုင်ဘာမူဒါဘရူနိ" +
+	"ုင်းဘိုလီးဘီးယားကာရီဘီယံနယ်သာလန်ဘရာဇီးဘဟားမားဘူတန်ဘူဗက်ကျွန်းဘော့စ်ဝါနာဘီလာရုစ်ဘ" +
+	"ေလီဇ်ကနေဒါကိုကိုး ကျွန်းစုကွန်ဂို-ကင်ရှာစအလယ်ပိုင်း အာဖရိက ပြည်ထောင်စုကွန်ဂို-ဘရ" +
+	"ာဇာဗီလ်ဆွစ်ဇလန်အိုင်ဗရီကိုစ့်ကွတ် ကျွန်းစုချီလီကင်မရွန်းတရုတ်ကိုလံဘီယာကလစ်ပါတန်က" +
+	"ျွန်းကော့စ်တာရီကာကျူးဘားခေ့ပ်ဗာဒူခူရာကာအိုခရစ်စမတ် ကျွန်းဆိုက်ပရက်စ်ချက် ပြည်ထော" +
+	"င်စုဂျာမဏီဒီအေဂိုဂရာစီအာဂျီဘူတီဒိန်းမတ်ဒိုမီနီကာဒိုမီနီကန်အယ်လ်ဂျီးရီးယားဆယ်ဥတာန" +
+	"ှင့်မယ်လီလ်လာအီကွေဒေါအက်စတိုးနီးယားအီဂျစ်အနောက်ပိုင်း ဆာဟာရအီရီတရီအာစပိန်အီသီယို" +
+	"းပီးယားဥရောပသမဂ္ဂဖင်လန်ဖီဂျီဖောက်ကလန် ကျွန်းစုမိုင်ခရိုနီရှားဖာရိုး ကျွန်းစုများ" +
+	"ပြင်သစ်ဂါဘွန်ယူနိုက်တက်ကင်းဒမ်းဂရီနာဒါဂျော်ဂျီယာပြင်သစ် ဂီယာနာဂွန်းဇီဂါနာဂျီဘရော" +
+	"်လ်တာဂရင်းလန်းဂန်ဘီရာဂီးနီဂူအာဒီလုပ်အီကွေတာ ဂီရာနာဂရိတောင် ဂျော်ဂျီယာ နှင့် တောင" +
+	"် ဆင်းဒဝစ်ဂျ် ကျွန်းစုများဂွာတီမာလာဂူအမ်ဂီရာနာ-ဘီစ်စာဥဂူရာနာတရုတ်၏ အထူးအုပ်ချုပ်" +
+	"ခွင့်ရ ဟောင်ကောင်ဟတ်ကျွန်းနှင့်မက်ဒေါနယ်ကျွန်းစုဟွန်ဒူးရပ်စ်ခရိုအေးရှားဟေတီဟန်ဂေ" +
+	"ရီကာနာရီကျွန်းစုအင်ဒိုနီးရှားအိုင်ယာလန်အစ္စရေးမန်ကျွန်းအိန္ဒိယဗြိတိသျှ အိန္ဒြိယ " +
+	"သမုဒ္ဒရာ ပိုင်နက်အီရတ်အီရန်အိုက်စလန်အီတလီဂျာစီဂျမေကာဂျော်ဒန်ဂျပန်ကင်ညာခရူဂစ်စတန်" +
+	"ကမ္ဘောဒီးယားခီရီဘာတီကိုမိုရိုစ်စိန့်ကစ်နှင့်နီဗီစ်မြောက်ကိုရီးယားတောင်ကိုရီးယားက" +
+	"ူဝိတ်ကေမန် ကျွန်းစုကာဇက်စတန်လာအိုလက်ဘနွန်စိန့်လူစီအာလစ်ခ်ထင်စတိုင်သီရိလင်္ကာလိုင" +
+	"်ဘေးရီးယားလီဆိုသိုလစ်သူယေးနီးယားလူဇင်ဘတ်လတ်ဗီးယားလီဗရာမော်ရိုကိုမိုနာကိုမောလ်ဒို" +
+	"ဗာမွန်တီနိဂရိုးစိန့်မာတင်မာဒါဂတ်စကာမာရှယ် ကျွန်းစုမာစီဒိုးနီးယားမာလီမြန်မာမွန်ဂိ" +
+	"ုးလီးယားတရုတ်၏ အထူးအုပ်ချုပ်ခွင့်ရ မကာအိုတောင်ပိုင်းမာရီအာနာကျွန်းစုမာတီနီကီမောရ" +
+	"ီတာနီအာမောင့်စဲရက်မောလ်တာမော်ရေရှားစ်မော်လ်ဒိုက်မာလာဝီမက္ကဆီကိုမလေးရှားမိုဇန်ဘစ်" +
+	"နမ်မီးဘီးယားနယူး ကယ်လီဒိုနီးယားနိုင်ဂျာနောဖော့ခ်ကျွန်းနိုင်ဂျီးရီးယားနီကာရာဂွာနယ" +
+	"်သာလန်နော်ဝေနီပေါနာဥူရူနီဥူအေနယူးဇီလန်အိုမန်ပနားမားပီရူးပြင်သစ် ပေါ်လီနေးရှားပါပ" +
+	"ူရာနယူးဂီနီဖိလစ်ပိုင်ပါကစ္စတန်ပိုလန်စိန့်ပီအဲရီနှင့်မီကွီလွန်ပစ်တ်ကိန်းကျွန်းစုပ" +
+	"ေါ်တူရီကိုပါလက်စတိုင်း ပိုင်နက်ပေါ်တူဂီပလောင်ပါရာဂွေးကာတာသမုဒ္ဒရာဒေသအပြင်ထွက်နေသ" +
+	"ောဒေသများရဲအူနီရွန်ရိုမေးနီးယားဆားဘီးယားရုရှရဝန်ဒါဆော်ဒီအာရေးဗီးယားဆော်လမွန်ကျွန" +
+	"်းစုဆေးရှလ်ဆူဒန်ဆွီဒင်စင်္ကာပူစိန့်ဟဲလီနာစလိုဗေးနီးယားစဗိုလ်ဘတ်နှင့်ဂျန်မေရန်စလိ" +
+	"ုဗေးကီးယားဆီအဲရာ လီအိုနီဆော့န်မာရီနိုဆီနီဂေါဆိုမာလီယာဆူရီနိမ်းမြောက်ဆူဒန်စိန့်တိ" +
+	"ုမီနှင့်ပရင်စီပ့်အယ်လ်ဆာဗေးဒိုးဆင့်မာအာတင်ဆီးရီးယားစွာဇီလန်ထရစ်တန်ဒါကွန်ဟာတခ်စ်န" +
+	"ှင့်ကာအီကိုစ်ကျွန်းစုချဒ်ပြင်သစ် တောင်ပိုင်း ပိုင်နက်များတိုဂိုထိုင်းတာဂျီကစ္စတန" +
+	"်ထိုးခါလူအရှေ့တီမောတာခ်မီန့စ်တန်တူနီးရှားတွန်ဂါတူရကီထရိုင်နီဒတ်နှင့်တိုဘာဂိုထူးဗ" +
+	"လူထိုင်ဝမ်တန်ဇန်းနီးယားယူကရိန်းယူဂန္ဓာယူနိုက်တက်စတိတ် အပြင်ထွက် နေသည့် သေးငယ်သော" +
+	"ကျွန်းများယူနိုက်တက်စတိတ်ဥရုဂွေးဥဘက်ကစ္စတန်ဗာတီကန်စီတီးစိန့်ဗင့်ဆင့်နှင့် သည်ဂရဲ" +
+	"နာဒင်းစ်ဗင်နီဇွဲလားဗြိတိသျှ ဗာဂျင်း ကျွန်းစုယူအက်စ် ဗာဂျင်း ကျွန်းစုဗီယက်နမ်ဗာနု" +
+	"အာတူဝေါလစ်နှင့်ဖူထူးနားဆာမိုအာကိုဆိုဗိုယီမင်မေအိုတီတောင်အာဖရိကဇမ်ဘီယာဇင်ဘာဘွေမသိ" +
+	" သို့မဟုတ် မရှိ သော နေရာကမ္ဘာအာဖရိကမြောက် အမေရိကတောင် အမေရိကသမုဒ္ဒရာဒေသအနောက် အာ" +
+	"ဖရိကအလယ်ပိုင်း အမေရိကအရှေ့ပိုင်း အာဖရိကမြောက်ပိုင်း အာဖရိကအလယ်ပိုင်း အာဖရိကတောင်" +
+	"ပိုင်း အာဖရိကအမေရိကများမြောက်ပိုင်း အမေရိကကာရီဘီယံအရှေ့ပိုင်း အာရှတောင်ပိုင်း အာ" +
+	"ရှအရှေ့တောင်ပိုင်းအာရှတောင်ပိုင်း ဥရောပဩစတြေးလျနှင့် နယူးဇီလန်မီလာနီးရှားမိုင်ခရ" +
+	"ိုနီရှားနယ်ပိုလီနီရှားအာရှအလယ် အာရှအနောက် အာရှဥရောပအရှေ့ပိုင်း ဥရောပမြောက်ပိုင်း" +
+	" ဥရောပအနောက်ပိုင်း ဥရောပလက်တင်အမေရိက"
+
+var myRegionIdx = []uint16{ // 291 entries
+	0x0, 0x3f, 0x57, 0x6c, 0x93, 0xcf, 0xe7, 0x105, 0x123, 0x123, 0x13b, 0x153,
+	0x177, 0x19f, 0x1ba, 0x1d2, 0x1e4, 0x205, 0x229, 0x282, 0x29d, 0x2c7, 0x2e8, 0x30a,
+	0x32b, 0x340, 0x358, 0x367, 0x397, 0x3a9, 0x3c4, 0x3e8, 0x418, 0x42a, 0x43f, 0x44e,
+	0x46f, 0x48d, 0x4a5, 0x4b7, 0x4c6, 0x4f4, 0x51f, 0x572, 0x5a3, 0x5bb, 0x5e5, 0x60a,
+	0x619, 0x634, 0x643, 0x65e, 0x68b, 0x6af, 0x6c4, 0x6df, 0x6fa, 0x725, 0x746, 0x774,
+	0x786, 0x7b0, 0x7c5, 0x7dd, 0x7f8, 0x816, 0x843, 0x87f, 0x897, 0x8c1, 0x8d3, 0x907,
+	0x922, 0x931, 0x95b, 0x979, 0x98b, 0x99a, 0x9ce, 0x9fb, 0xa32, 0xa47, 0xa59, 0xa8f,
+	0xaa4, 0xac2, 0xaea, 0xaff, 0xb0b, 0xb2f, 0xb4a, 0xb5f, 0xb6e, 0xb8c, 0xbb4, 0xbbd,
+	0xc52, 0xc6d, 0xc7c, 0xca4, 0xcb6, 0xd21, 0xd7e, 0xda2, 0xdc3, 0xdcf, 0xde4, 0xe0e,
+	0xe35, 0xe53, 0xe68, 0xe83, 0xe98, 0xefb, 0xf0a, 0xf19, 0xf34, 0xf43, 0xf52, 0xf64,
+	0xf7c, 0xf8b, 0xf9a, 0xfb8, 0xfdc, 0xff4, 0x1015, 0x104e, 0x107b, 0x10a5, 0x10b7, 0x10df,
+	0x10fa, 0x1109, 0x1121, 0x1142, 0x116c, 0x118a, 0x11b4, 0x11cc, 0x11f6, 0x120e, 0x1229, 0x1238,
+	0x1256, 0x126e, 0x128c, 0x12b3, 0x12d1, 0x12ef, 0x131a, 0x1344, 0x1350, 0x1362, 0x138c, 0x13eb,
+	0x143c, 0x1454, 0x1475, 0x1496, 0x14ab, 0x14cf, 0x14f0, 0x1502, 0x151d, 0x1535, 0x1550, 0x1574,
+	0x15ab, 0x15c3, 0x15f0, 0x161d, 0x1638, 0x1650, 0x1662, 0x1671, 0x1683, 0x1695, 0x16b0, 0x16c2,
+	0x16d7, 0x16e6, 0x1723, 0x174d, 0x176b, 0x1786, 0x1798, 0x17e3, 0x1819, 0x183a, 0x1877, 0x188f,
+	0x18a1, 0x18b9, 0x18c5, 0x1925, 0x1943, 0x1967, 0x1982, 0x198e, 0x19a0, 0x19d3, 0x1a06, 0x1a1b,
+	0x1a2a, 0x1a3c, 0x1a54, 0x1a75, 0x1a9c, 0x1ae1, 0x1b08, 0x1b30, 0x1b57, 0x1b6c, 0x1b87, 0x1ba2,
+	0x1bc3, 0x1c0b, 0x1c35, 0x1c56, 0x1c71, 0x1c89, 0x1cb6, 0x1d07, 0x1d13, 0x1d6f, 0x1d81, 0x1d93,
+	0x1db7, 0x1dcf, 0x1ded, 0x1e14, 0x1e2f, 0x1e41, 0x1e50, 0x1e98, 0x1eaa, 0x1ec2, 0x1ee9, 0x1f01,
+	0x1f16, 0x1fac, 0x1fd9, 0x1fee, 0x200f, 0x2033, 0x2094, 0x20b5, 0x20fc, 0x2140, 0x2158, 0x2170,
+	0x21a9, 0x21be, 0x21d9, 0x21e8, 0x21fd, 0x221e, 0x2233, 0x224b, 0x2294, 0x22a3, 0x22b5, 0x22da,
+	0x22fc, 0x231d, 0x2342, 0x2373, 0x23a7, 0x23de, 0x240f, 0x2443, 0x2461, 0x2498, 0x24b0, 0x24de,
+	0x250c, 0x2548, 0x2579, 0x25bc, 0x25dd, 0x2613, 0x2634, 0x2640, 0x2659, 0x2678, 0x2687, 0x26b8,
+	0x26ec, 0x2720, 0x2744,
+}
+
+const neRegionStr = "" +
+	"एस्केन्सन टापुअन्डोर्रासंयुक्त अरब इमिराट्सअफगानिस्तानएन्टिगुआ र बारबुडाआङ्गुइला" +
+	"अल्बानियाआर्मेनियानेदरल्याण्ड्स एण्टिलिसअङ्गोलाअन्टारतिकाअर्जेन्टिनाअमेरिकी समोआ" +
+	"अष्ट्रियाअष्ट्रेलियाआरूबाअलान्ड टापुहरुअजरबैजानबोस्निया एण्ड हर्जगोभिनियाबार्बाड" +
+	"ोसबङ्गलादेशबेल्जियमबर्किना फासोबुल्गेरियाबहराइनबुरूण्डीबेनिनसेन्ट बार्थालेमीबर्म" +
+	"ुडाब्रुनाइबोलिभियाक्यारिवियन नेदरल्याण्ड्सब्राजिलबहामासभुटानबुभेट टापुबोट्स्वाना" +
+	"बेलारूसबेलिजक्यानाडाकोकोस (किलिंग) टापुहरुकोङ्गो-किन्शासाकेन्द्रीय अफ्रिकी गणतन्" +
+	"त्रकोङ्गो - ब्राज्जाभिल्लेस्विजरल्याण्डआइभरी कोस्टकुक टापुहरुचिलीक्यामरूनचीनकोलो" +
+	"म्बियाक्लिप्पेर्टन टापुकोष्टारिकाक्युबाकेप भर्डेकुराकाओक्रिष्टमस टापुसाइप्रसचेक " +
+	"गणतन्त्रजर्मनीडियगो गार्सियाडिजिबुटीडेनमार्कडोमिनिकाडोमिनिकन गणतन्त्रअल्जेरियासि" +
+	"उटा र मेलिलाइक्वडेरइस्टोनियाइजिप्टपश्चिमी साहाराएरित्रियास्पेनइथियोपियायुरोपियन " +
+	"युनियनफिन्ल्याण्डफिजीफकल्याण्ड टापुहरुमाइक्रोनेसियाफारोर टापुहरुफ्रान्सगावोनबेला" +
+	"यतग्रेनाडाजर्जियाफ्रान्सेली गायनागुएर्नसेघानाजिब्राल्टारग्रिनल्याण्डगाम्वियागिनी" +
+	"ग्वाडेलुपभू-मध्यीय गिनीग्रिसदक्षिण जर्जिया र दक्षिण स्यान्डवीच टापुहरूग्वाटेमाला" +
+	"गुवामगिनी-बिसाउगुयानाहङकङ चिनिया समाजवादी स्वायत्त क्षेत्रहर्ड टापु एण्ड म्याकडो" +
+	"नाल्ड टापुहरुहन्डुरासक्रोएशियाहैटीहङ्गेरीक्यानारी टापुहरूइन्डोनेशियाआयरल्याण्डइज" +
+	"रायलआइज्ले अफ् म्यानभारतबेलायती हिन्द महासागर क्षेत्रइराकइरानआइस्ल्याण्डइटालीजर्" +
+	"सीजमाइकाजोर्डनजापानकेन्याकिर्गिस्थानकम्बोडियाकिरिबाटीकोमोरोससेन्ट किट्स र नेभिसउ" +
+	"त्तर कोरियादक्षिण कोरियाकुवेतकेयमान टापुकाजाकस्तानलाओसलेबननसेन्ट लुसियालिएखटेन्स" +
+	"्टाइनश्रीलङ्कालाइबेरियालेसोथोलिथुअनियालक्जेमबर्गलाट्भियालिबियामोरोक्कोमोनाकोमाल्" +
+	"डोभामोन्टेनेग्रोसेन्ट मार्टिनमडागास्करमार्शल टापुहरुम्याकेडोनियामालीम्यान्मार (ब" +
+	"र्मा)मङ्गोलियामकावो चिनिँया स्वशासित क्षेत्रउत्तरी मारिआना टापुमार्टिनिकमाउरिटान" +
+	"ियामोन्टसेर्राटमाल्टामाउरिटसमाल्दिभ्समालावीमेक्सिकोमलेसियामोजाम्बिकनामिबियानयाँ " +
+	"कालेडोनियानाइजरनोरफोल्क टापुनाइजेरियानिकारागुवानेदरल्याण्ड्सनर्वेनेपालनाउरूनियुइ" +
+	"न्युजिल्याण्डओमनपनामापेरूफ्रान्सेली पोलिनेसियापपुआ न्यू गाइनियाफिलिपिन्सपाकिस्ता" +
+	"नपोल्याण्डसेन्ट पिर्रे र मिक्केलोनपिटकाइर्न टापुहरुपुएर्टो रिकोप्यालेस्टनी भू-भा" +
+	"गहरुपोर्चुगलपलाउप्याराग्वेकतारबाह्य ओसनियारियुनियनरोमानियासर्बियारूसरवाण्डासाउदी" +
+	" अरबसोलोमोन टापुहरुसेचेलेससुडानस्विडेनसिङ्गापुरसेन्ट हेलेनास्लोभेनियासभाल्बार्ड " +
+	"र जान मायेनस्लोभाकियासिएर्रा लिओनसान् मारिनोसेनेगालसोमालियासुरिनेमदक्षिणी सुडानस" +
+	"ाओ टोमे र प्रिन्सिपएल् साल्भाडोरसिन्ट मार्टेनसिरियास्वाजिल्याण्डट्रिस्टान डा कुन" +
+	"्हातुर्क र काइकोस टापुचाडफ्रान्सेली दक्षिणी क्षेत्रहरुटोगोथाइल्याण्डताजिकिस्तानत" +
+	"ोकेलाउटिमोर-लेस्टेतुर्कमेनिस्तानट्युनिसियाटोंगाटर्कीत्रिनिडाड एण्ड टोबागोतुभालुत" +
+	"ाइवानतान्जानियायुक्रेनयुगाण्डासंयुक्त राज्य बाह्य टापुहरुसंयुक्त राज्यउरूग्वेउज्" +
+	"बेकिस्तानभेटिकन सिटीसेन्ट भिन्सेन्ट र ग्रेनाडिन्सभेनेजुएलाबेलायती भर्जिन टापुहरु" +
+	"संयुक्त राज्य भर्जिन टापुहरुभिएतनामभानुआतुवालिस र फुटुनासामोआकोसोवोयेमेनमायोट्टद" +
+	"क्षिण अफ्रिकाजाम्बियाजिम्बाबेअपरिचित क्षेत्रविश्वअफ्रिकाउत्तर अमेरिकादक्षिण अमेर" +
+	"िकाओसनियापश्चिमी अफ्रिकाकेन्द्रीय अमेरिकापूर्वी अफ्रिकाउत्तरी अफ्रिकामध्य अफ्रिक" +
+	"ादक्षिणी अफ्रिकाअमेरिकासउत्तरी अमेरिकाक्यारिबियनपूर्वी एशियादक्षिणी एशियादक्षिण " +
+	"पूर्वी एशियादक्षिणी युरोपअष्ट्रालासियामेलानेसियामाइक्रोनेसियाली क्षेत्रपोलिनेशिय" +
+	"ाएशियाकेन्द्रीय एशियापश्चिमी एशियायुरोपपूर्वी युरोपउत्तरी युरोपपश्चिमी युरोपल्या" +
+	"टिन अमेरिका"
+
+var neRegionIdx = []uint16{ // 291 entries
+	0x0, 0x28, 0x43, 0x7b, 0x9c, 0xce, 0xe6, 0x101, 0x11c, 0x15c, 0x171, 0x18f,
+	0x1b0, 0x1d2, 0x1ed, 0x20e, 0x21d, 0x245, 0x25d, 0x2a7, 0x2c2, 0x2dd, 0x2f5, 0x317,
+	0x335, 0x347, 0x35f, 0x36e, 0x39c, 0x3b1, 0x3c6, 0x3de, 0x424, 0x439, 0x44b, 0x45a,
+	0x476, 0x494, 0x4a9, 0x4b8, 0x4d0, 0x50a, 0x535, 0x57f, 0x5be, 0x5e5, 0x604, 0x623,
+	0x62f, 0x647, 0x650, 0x66e, 0x69f, 0x6bd, 0x6cf, 0x6e8, 0x6fd, 0x725, 0x73a, 0x75c,
+	0x76e, 0x796, 0x7ae, 0x7c6, 0x7de, 0x80f, 0x82a, 0x850, 0x865, 0x880, 0x892, 0x8ba,
+	0x8d5, 0x8e4, 0x8ff, 0x92a, 0x94b, 0x957, 0x988, 0x9af, 0x9d4, 0x9e9, 0x9f8, 0xa0a,
+	0xa22, 0xa37, 0xa65, 0xa7d, 0xa89, 0xaaa, 0xace, 0xae6, 0xaf2, 0xb0d, 0xb33, 0xb42,
+	0xbb6, 0xbd4, 0xbe3, 0xbff, 0xc11, 0xc78, 0xcd9, 0xcf1, 0xd0c, 0xd18, 0xd2d, 0xd5b,
+	0xd7c, 0xd9a, 0xdac, 0xdd8, 0xde4, 0xe35, 0xe41, 0xe4d, 0xe6e, 0xe7d, 0xe8c, 0xe9e,
+	0xeb0, 0xebf, 0xed1, 0xef2, 0xf0d, 0xf25, 0xf3a, 0xf6d, 0xf8f, 0xfb4, 0xfc3, 0xfe2,
+	0x1000, 0x100c, 0x101b, 0x103d, 0x1067, 0x1082, 0x109d, 0x10af, 0x10ca, 0x10e8, 0x1100, 0x1112,
+	0x112a, 0x113c, 0x1154, 0x1178, 0x119d, 0x11b8, 0x11e0, 0x1204, 0x1210, 0x123d, 0x1258, 0x12ac,
+	0x12e1, 0x12fc, 0x131d, 0x1341, 0x1353, 0x1368, 0x1383, 0x1395, 0x13ad, 0x13c2, 0x13dd, 0x13f5,
+	0x1420, 0x142f, 0x1454, 0x146f, 0x148d, 0x14b4, 0x14c3, 0x14d2, 0x14e1, 0x14f0, 0x1517, 0x1520,
+	0x152f, 0x153b, 0x1578, 0x15a7, 0x15c2, 0x15dd, 0x15f8, 0x163a, 0x166b, 0x168d, 0x16c8, 0x16e0,
+	0x16ec, 0x170a, 0x1716, 0x1738, 0x1750, 0x1768, 0x177d, 0x1786, 0x179b, 0x17b4, 0x17df, 0x17f4,
+	0x1803, 0x1818, 0x1833, 0x1855, 0x1873, 0x18af, 0x18cd, 0x18ef, 0x190e, 0x1923, 0x193b, 0x1950,
+	0x1975, 0x19ab, 0x19d0, 0x19f5, 0x1a07, 0x1a2e, 0x1a63, 0x1a96, 0x1a9f, 0x1af2, 0x1afe, 0x1b1c,
+	0x1b3d, 0x1b52, 0x1b74, 0x1b9e, 0x1bbc, 0x1bcb, 0x1bda, 0x1c15, 0x1c27, 0x1c39, 0x1c57, 0x1c6c,
+	0x1c84, 0x1ccf, 0x1cf4, 0x1d09, 0x1d2d, 0x1d4c, 0x1d9d, 0x1db8, 0x1df6, 0x1e44, 0x1e59, 0x1e6e,
+	0x1e94, 0x1ea3, 0x1eb5, 0x1ec4, 0x1ed9, 0x1f01, 0x1f19, 0x1f31, 0x1f5c, 0x1f6b, 0x1f80, 0x1fa5,
+	0x1fcd, 0x1fdf, 0x200a, 0x203b, 0x2063, 0x208b, 0x20ad, 0x20d8, 0x20f0, 0x2118, 0x2136, 0x2158,
+	0x217d, 0x21b2, 0x21d7, 0x21fe, 0x221c, 0x225f, 0x227d, 0x228c, 0x22b7, 0x22dc, 0x22eb, 0x230d,
+	0x232f, 0x2354, 0x237f,
+}
+
+const nlRegionStr = "" +
+	"AscensionAndorraVerenigde Arabische EmiratenAfghanistanAntigua en BarbudaAnguill" +
+	"aAlbaniëArmeniëNederlandse AntillenAngolaAntarcticaArgentiniëAmerikaans SamoaOos" +
+	"tenrijkAustraliëArubaÅlandAzerbeidzjanBosnië en HerzegovinaBarbadosBangladeshBel" +
+	"giëBurkina FasoBulgarijeBahreinBurundiBeninSaint BarthélemyBermudaBruneiBoliviaC" +
+	"aribisch NederlandBraziliëBahama’sBhutanBouveteilandBotswanaWit-RuslandBelizeCan" +
+	"adaCocoseilandenCongo-KinshasaCentraal-Afrikaanse RepubliekCongo-BrazzavilleZwit" +
+	"serlandIvoorkustCookeilandenChiliKameroenChinaColombiaClippertonCosta RicaCubaKa" +
+	"apverdiëCuraçaoChristmaseilandCyprusTsjechiëDuitslandDiego GarciaDjiboutiDenemar" +
+	"kenDominicaDominicaanse RepubliekAlgerijeCeuta en MelillaEcuadorEstlandEgypteWes" +
+	"telijke SaharaEritreaSpanjeEthiopiëEuropese UnieFinlandFijiFalklandeilandenMicro" +
+	"nesiëFaeröerFrankrijkGabonVerenigd KoninkrijkGrenadaGeorgiëFrans-GuyanaGuernseyG" +
+	"hanaGibraltarGroenlandGambiaGuineeGuadeloupeEquatoriaal-GuineaGriekenlandZuid-Ge" +
+	"orgia en Zuidelijke SandwicheilandenGuatemalaGuamGuinee-BissauGuyanaHongkong SAR" +
+	" van ChinaHeard- en McDonaldeilandenHondurasKroatiëHaïtiHongarijeCanarische Eila" +
+	"ndenIndonesiëIerlandIsraëlIsle of ManIndiaBritse Gebieden in de Indische OceaanI" +
+	"rakIranIJslandItaliëJerseyJamaicaJordaniëJapanKeniaKirgiziëCambodjaKiribatiComor" +
+	"enSaint Kitts en NevisNoord-KoreaZuid-KoreaKoeweitCaymaneilandenKazachstanLaosLi" +
+	"banonSaint LuciaLiechtensteinSri LankaLiberiaLesothoLitouwenLuxemburgLetlandLibi" +
+	"ëMarokkoMonacoMoldaviëMontenegroSaint-MartinMadagaskarMarshalleilandenMacedoniëM" +
+	"aliMyanmar (Birma)MongoliëMacao SAR van ChinaNoordelijke MarianeneilandenMartini" +
+	"queMauritaniëMontserratMaltaMauritiusMaldivenMalawiMexicoMaleisiëMozambiqueNamib" +
+	"iëNieuw-CaledoniëNigerNorfolkeilandNigeriaNicaraguaNederlandNoorwegenNepalNauruN" +
+	"iueNieuw-ZeelandOmanPanamaPeruFrans-PolynesiëPapoea-Nieuw-GuineaFilipijnenPakist" +
+	"anPolenSaint-Pierre en MiquelonPitcairneilandenPuerto RicoPalestijnse gebiedenPo" +
+	"rtugalPalauParaguayQatarOverig OceaniëRéunionRoemeniëServiëRuslandRwandaSaoedi-A" +
+	"rabiëSalomonseilandenSeychellenSoedanZwedenSingaporeSint-HelenaSloveniëSpitsberg" +
+	"en en Jan MayenSlowakijeSierra LeoneSan MarinoSenegalSomaliëSurinameZuid-SoedanS" +
+	"ao Tomé en PrincipeEl SalvadorSint-MaartenSyriëSwazilandTristan da CunhaTurks- e" +
+	"n CaicoseilandenTsjaadFranse Gebieden in de zuidelijke Indische OceaanTogoThaila" +
+	"ndTadzjikistanTokelauOost-TimorTurkmenistanTunesiëTongaTurkijeTrinidad en Tobago" +
+	"TuvaluTaiwanTanzaniaOekraïneOegandaKleine afgelegen eilanden van de Verenigde St" +
+	"atenVerenigde StatenUruguayOezbekistanVaticaanstadSaint Vincent en de Grenadines" +
+	"VenezuelaBritse MaagdeneilandenAmerikaanse MaagdeneilandenVietnamVanuatuWallis e" +
+	"n FutunaSamoaKosovoJemenMayotteZuid-AfrikaZambiaZimbabweOnbekend gebiedWereldAfr" +
+	"ikaNoord-AmerikaZuid-AmerikaOceaniëWest-AfrikaMidden-AmerikaOost-AfrikaNoord-Afr" +
+	"ikaCentraal-AfrikaZuidelijk AfrikaAmerikaNoordelijk AmerikaCaribisch gebiedOost-" +
+	"AziëZuid-AziëZuidoost-AziëZuid-EuropaAustralaziëMelanesiëMicronesische regioPoly" +
+	"nesiëAziëCentraal-AziëWest-AziëEuropaOost-EuropaNoord-EuropaWest-EuropaLatijns-A" +
+	"merika"
+
+var nlRegionIdx = []uint16{ // 291 entries
+	0x0, 0x9, 0x10, 0x2c, 0x37, 0x49, 0x51, 0x59, 0x61, 0x75, 0x7b, 0x85,
+	0x90, 0xa0, 0xaa, 0xb4, 0xb9, 0xbf, 0xcb, 0xe1, 0xe9, 0xf3, 0xfa, 0x106,
+	0x10f, 0x116, 0x11d, 0x122, 0x133, 0x13a, 0x140, 0x147, 0x15a, 0x163, 0x16d, 0x173,
+	0x17f, 0x187, 0x192, 0x198, 0x19e, 0x1ab, 0x1b9, 0x1d6, 0x1e7, 0x1f2, 0x1fb, 0x207,
+	0x20c, 0x214, 0x219, 0x221, 0x22b, 0x235, 0x239, 0x244, 0x24c, 0x25b, 0x261, 0x26a,
+	0x273, 0x27f, 0x287, 0x291, 0x299, 0x2af, 0x2b7, 0x2c7, 0x2ce, 0x2d5, 0x2db, 0x2ec,
+	0x2f3, 0x2f9, 0x302, 0x30f, 0x316, 0x31a, 0x32a, 0x335, 0x33d, 0x346, 0x34b, 0x35e,
+	0x365, 0x36d, 0x379, 0x381, 0x386, 0x38f, 0x398, 0x39e, 0x3a4, 0x3ae, 0x3c0, 0x3cb,
+	0x3f6, 0x3ff, 0x403, 0x410, 0x416, 0x42c, 0x446, 0x44e, 0x456, 0x45c, 0x465, 0x478,
+	0x482, 0x489, 0x490, 0x49b, 0x4a0, 0x4c5, 0x4c9, 0x4cd, 0x4d4, 0x4db, 0x4e1, 0x4e8,
+	0x4f1, 0x4f6, 0x4fb, 0x504, 0x50c, 0x514, 0x51b, 0x52f, 0x53a, 0x544, 0x54b, 0x559,
+	0x563, 0x567, 0x56e, 0x579, 0x586, 0x58f, 0x596, 0x59d, 0x5a5, 0x5ae, 0x5b5, 0x5bb,
+	0x5c2, 0x5c8, 0x5d1, 0x5db, 0x5e7, 0x5f1, 0x601, 0x60b, 0x60f, 0x61e, 0x627, 0x63a,
+	0x656, 0x660, 0x66b, 0x675, 0x67a, 0x683, 0x68b, 0x691, 0x697, 0x6a0, 0x6aa, 0x6b2,
+	0x6c2, 0x6c7, 0x6d4, 0x6db, 0x6e4, 0x6ed, 0x6f6, 0x6fb, 0x700, 0x704, 0x711, 0x715,
+	0x71b, 0x71f, 0x72f, 0x742, 0x74c, 0x754, 0x759, 0x771, 0x781, 0x78c, 0x7a0, 0x7a8,
+	0x7ad, 0x7b5, 0x7ba, 0x7c9, 0x7d1, 0x7da, 0x7e1, 0x7e8, 0x7ee, 0x7fc, 0x80c, 0x816,
+	0x81c, 0x822, 0x82b, 0x836, 0x83f, 0x857, 0x860, 0x86c, 0x876, 0x87d, 0x885, 0x88d,
+	0x898, 0x8ad, 0x8b8, 0x8c4, 0x8ca, 0x8d3, 0x8e3, 0x8fb, 0x901, 0x931, 0x935, 0x93d,
+	0x949, 0x950, 0x95a, 0x966, 0x96e, 0x973, 0x97a, 0x98c, 0x992, 0x998, 0x9a0, 0x9a9,
+	0x9b0, 0x9e1, 0x9f1, 0x9f8, 0xa03, 0xa0f, 0xa2d, 0xa36, 0xa4c, 0xa67, 0xa6e, 0xa75,
+	0xa85, 0xa8a, 0xa90, 0xa95, 0xa9c, 0xaa7, 0xaad, 0xab5, 0xac4, 0xaca, 0xad0, 0xadd,
+	0xae9, 0xaf1, 0xafc, 0xb0a, 0xb15, 0xb21, 0xb30, 0xb40, 0xb47, 0xb59, 0xb69, 0xb73,
+	0xb7d, 0xb8b, 0xb96, 0xba2, 0xbac, 0xbbf, 0xbc9, 0xbce, 0xbdc, 0xbe6, 0xbec, 0xbf7,
+	0xc03, 0xc0e, 0xc1d,
+}
+
+const noRegionStr = "" +
+	"AscensionAndorraDe forente arabiske emiraterAfghanistanAntigua og BarbudaAnguill" +
+	"aAlbaniaArmeniaDe nederlandske antillerAngolaAntarktisArgentinaAmerikansk SamoaØ" +
+	"sterrikeAustraliaArubaÅlandAserbajdsjanBosnia-HercegovinaBarbadosBangladeshBelgi" +
+	"aBurkina FasoBulgariaBahrainBurundiBeninSaint BarthélemyBermudaBrunei Darussalam" +
+	"BoliviaKaribisk NederlandBrasilBahamasBhutanBouvetøyaBotswanaHviterusslandBelize" +
+	"CanadaKokosøyeneKongo-KinshasaDen sentralafrikanske republikkKongo-BrazzavilleSv" +
+	"eitsElfenbenskystenCookøyeneChileKamerunKinaColombiaClippertonøyaCosta RicaCubaK" +
+	"app VerdeCuraçaoChristmasøyaKyprosTsjekkiaTysklandDiego GarciaDjiboutiDanmarkDom" +
+	"inicaDen dominikanske republikkAlgerieCeuta og MelillaEcuadorEstlandEgyptVest-Sa" +
+	"haraEritreaSpaniaEtiopiaDen europeiske unionFinlandFijiFalklandsøyeneMikronesiaf" +
+	"øderasjonenFærøyeneFrankrikeGabonStorbritanniaGrenadaGeorgiaFransk GuyanaGuernse" +
+	"yGhanaGibraltarGrønlandGambiaGuineaGuadeloupeEkvatorial-GuineaHellasSør-Georgia " +
+	"og Sør-SandwichøyeneGuatemalaGuamGuinea-BissauGuyanaHongkong S.A.R. KinaHeardøya" +
+	" og McDonaldøyeneHondurasKroatiaHaitiUngarnKanariøyeneIndonesiaIrlandIsraelManIn" +
+	"diaBritiske territorier i IndiahavetIrakIranIslandItaliaJerseyJamaicaJordanJapan" +
+	"KenyaKirgisistanKambodsjaKiribatiKomoreneSt. Kitts og NevisNord-KoreaSør-KoreaKu" +
+	"waitCaymanøyeneKasakhstanLaosLibanonSt. LuciaLiechtensteinSri LankaLiberiaLesoth" +
+	"oLitauenLuxembourgLatviaLibyaMarokkoMonacoMoldovaMontenegroSaint-MartinMadagaska" +
+	"rMarshalløyeneMakedoniaMaliMyanmar (Burma)MongoliaMacao S.A.R. KinaNord-Marianen" +
+	"eMartiniqueMauritaniaMontserratMaltaMauritiusMaldiveneMalawiMexicoMalaysiaMosamb" +
+	"ikNamibiaNy-CaledoniaNigerNorfolkøyaNigeriaNicaraguaNederlandNorgeNepalNauruNiue" +
+	"New ZealandOmanPanamaPeruFransk PolynesiaPapua Ny-GuineaFilippinenePakistanPolen" +
+	"St. Pierre og MiquelonPitcairnPuerto RicoDet palestinske områdetPortugalPalauPar" +
+	"aguayQatarytre OseaniaRéunionRomaniaSerbiaRusslandRwandaSaudi-ArabiaSalomonøyene" +
+	"SeychelleneSudanSverigeSingaporeSt. HelenaSloveniaSvalbard og Jan MayenSlovakiaS" +
+	"ierra LeoneSan MarinoSenegalSomaliaSurinamSør-SudanSão Tomé og PríncipeEl Salvad" +
+	"orSint MaartenSyriaSwazilandTristan da CunhaTurks- og CaicosøyeneTsjadDe franske" +
+	" sørterritorierTogoThailandTadsjikistanTokelauØst-TimorTurkmenistanTunisiaTongaT" +
+	"yrkiaTrinidad og TobagoTuvaluTaiwanTanzaniaUkrainaUgandaUSAs ytre øyerUSAUruguay" +
+	"UsbekistanVatikanstatenSt. Vincent og GrenadineneVenezuelaDe britiske jomfruøyen" +
+	"eDe amerikanske jomfruøyeneVietnamVanuatuWallis og FutunaSamoaKosovoJemenMayotte" +
+	"Sør-AfrikaZambiaZimbabweukjent områdeverdenAfrikaNord-AmerikaSør-AmerikaOseaniaV" +
+	"est-AfrikaSentral-AmerikaØst-AfrikaNord-AfrikaSentral-AfrikaSørlige AfrikaAmerik" +
+	"aNordlige AmerikaKaribiaØst-AsiaSør-AsiaSørøst-AsiaSør-EuropaAustralasiaMelanesi" +
+	"aMikronesiaPolynesiaAsiaSentral-AsiaVest-AsiaEuropaØst-EuropaNord-EuropaVest-Eur" +
+	"opaLatin-Amerika"
+
+var noRegionIdx = []uint16{ // 291 entries
+	0x0, 0x9, 0x10, 0x2c, 0x37, 0x49, 0x51, 0x58, 0x5f, 0x77, 0x7d, 0x86,
+	0x8f, 0x9f, 0xa9, 0xb2, 0xb7, 0xbd, 0xc9, 0xdb, 0xe3, 0xed, 0xf3, 0xff,
+	0x107, 0x10e, 0x115, 0x11a, 0x12b, 0x132, 0x143, 0x14a, 0x15c, 0x162, 0x169, 0x16f,
+	0x179, 0x181, 0x18e, 0x194, 0x19a, 0x1a5, 0x1b3, 0x1d2, 0x1e3, 0x1e9, 0x1f8, 0x202,
+	0x207, 0x20e, 0x212, 0x21a, 0x228, 0x232, 0x236, 0x240, 0x248, 0x255, 0x25b, 0x263,
+	0x26b, 0x277, 0x27f, 0x286, 0x28e, 0x2a8, 0x2af, 0x2bf, 0x2c6, 0x2cd, 0x2d2, 0x2dd,
+	0x2e4, 0x2ea, 0x2f1, 0x305, 0x30c, 0x310, 0x31f, 0x336, 0x340, 0x349, 0x34e, 0x35b,
+	0x362, 0x369, 0x376, 0x37e, 0x383, 0x38c, 0x395, 0x39b, 0x3a1, 0x3ab, 0x3bc, 0x3c2,
+	0x3e5, 0x3ee, 0x3f2, 0x3ff, 0x405, 0x419, 0x434, 0x43c, 0x443, 0x448, 0x44e, 0x45a,
+	0x463, 0x469, 0x46f, 0x472, 0x477, 0x498, 0x49c, 0x4a0, 0x4a6, 0x4ac, 0x4b2, 0x4b9,
+	0x4bf, 0x4c4, 0x4c9, 0x4d4, 0x4dd, 0x4e5, 0x4ed, 0x4ff, 0x509, 0x513, 0x519, 0x525,
+	0x52f, 0x533, 0x53a, 0x543, 0x550, 0x559, 0x560, 0x567, 0x56e, 0x578, 0x57e, 0x583,
+	0x58a, 0x590, 0x597, 0x5a1, 0x5ad, 0x5b7, 0x5c5, 0x5ce, 0x5d2, 0x5e1, 0x5e9, 0x5fa,
+	0x608, 0x612, 0x61c, 0x626, 0x62b, 0x634, 0x63d, 0x643, 0x649, 0x651, 0x659, 0x660,
+	0x66c, 0x671, 0x67c, 0x683, 0x68c, 0x695, 0x69a, 0x69f, 0x6a4, 0x6a8, 0x6b3, 0x6b7,
+	0x6bd, 0x6c1, 0x6d1, 0x6e0, 0x6eb, 0x6f3, 0x6f8, 0x70e, 0x716, 0x721, 0x739, 0x741,
+	0x746, 0x74e, 0x753, 0x75f, 0x767, 0x76e, 0x774, 0x77c, 0x782, 0x78e, 0x79b, 0x7a6,
+	0x7ab, 0x7b2, 0x7bb, 0x7c5, 0x7cd, 0x7e2, 0x7ea, 0x7f6, 0x800, 0x807, 0x80e, 0x815,
+	0x81f, 0x836, 0x841, 0x84d, 0x852, 0x85b, 0x86b, 0x881, 0x886, 0x8a0, 0x8a4, 0x8ac,
+	0x8b8, 0x8bf, 0x8c9, 0x8d5, 0x8dc, 0x8e1, 0x8e7, 0x8f9, 0x8ff, 0x905, 0x90d, 0x914,
+	0x91a, 0x929, 0x92c, 0x933, 0x93d, 0x94a, 0x964, 0x96d, 0x985, 0x9a0, 0x9a7, 0x9ae,
+	0x9be, 0x9c3, 0x9c9, 0x9ce, 0x9d5, 0x9e0, 0x9e6, 0x9ee, 0x9fc, 0xa02, 0xa08, 0xa14,
+	0xa20, 0xa27, 0xa32, 0xa41, 0xa4c, 0xa57, 0xa65, 0xa74, 0xa7b, 0xa8b, 0xa92, 0xa9b,
+	0xaa4, 0xab1, 0xabc, 0xac7, 0xad0, 0xada, 0xae3, 0xae7, 0xaf3, 0xafc, 0xb02, 0xb0d,
+	0xb18, 0xb23, 0xb30,
+}
+
+const paRegionStr = "" +
+	"ਅਸੈਂਸ਼ਨ ਆਈਲੈਂਡਅੰਡੋਰਾਸੰਯੁਕਤ ਅਰਬ ਅਮੀਰਾਤਅਫ਼ਗਾਨਿਸਤਾਨਐਂਟੀਗੁਆ ਅਤੇ ਬਾਰਬੁਡਾਅੰਗੁਇਲਾਅਲਬਾਨੀ" +
+	"ਆਆਰਮੀਨਿਆਅੰਗੋਲਾਅੰਟਾਰਕਟਿਕਾਅਰਜਨਟੀਨਾਅਮੈਰੀਕਨ ਸਮੋਆਆਸਟਰੀਆਆਸਟ੍ਰੇਲੀਆਅਰੂਬਾਅਲੈਂਡ ਆਈਲੈਂਡਸਅਜ਼" +
+	"ਰਬੈਜਾਨਬੋਸਨੀਆ ਅਤੇ ਹਰਜ਼ੇਗੋਵੀਨਾਬਾਰਬਾਡੋਸਬੰਗਲਾਦੇਸ਼ਬੈਲਜੀਅਮਬੁਰਕੀਨਾ ਫ਼ਾਸੋਬੁਲਗਾਰਿਆਬਹਿਰੀਨਬ" +
+	"ੁਰੁੰਡੀਬੇਨਿਨਸੇਂਟ ਬਾਰਥੇਲੇਮੀਬਰਮੂਡਾਬਰੂਨੇਈਬੋਲੀਵੀਆਕੈਰੇਬੀਅਨ ਨੀਦਰਲੈਂਡਬ੍ਰਾਜ਼ੀਲਬਹਾਮਾਸਭੂਟਾਨ" +
+	"ਬੌਵੇਟ ਆਈਲੈਂਡਬੋਟਸਵਾਨਾਬੇਲਾਰੂਸਬੇਲੀਜ਼ਕੈਨੇਡਾਕੋਕੋਜ਼ (ਕੀਲਿੰਗ) ਆਈਲੈਂਡਸਕਾਂਗੋ - ਕਿਂਸ਼ਾਸਾਕੇ" +
+	"ਂਦਰੀ ਅਫਰੀਕੀ ਗਣਰਾਜਕਾਂਗੋ - ਬ੍ਰਾਜ਼ਾਵਿਲੇਸਵਿਟਜ਼ਰਲੈਂਡਕੋਟ ਡੀਵੋਆਰਕੁਕ ਆਈਲੈਂਡਸਚਿਲੀਕੈਮਰੂਨਚੀ" +
+	"ਨਕੋਲੰਬੀਆਕਲਿਪਰਟਨ ਆਈਲੈਂਡਕੋਸਟਾ ਰੀਕਾਕਿਊਬਾਕੇਪ ਵਰਡਕੁਰਾਕਾਓਕ੍ਰਿਸਮਸ ਆਈਲੈਂਡਸਾਇਪਰਸਚੈਕ ਗਣਰਾਜ" +
+	"ਜਰਮਨੀਡੀਗੋ ਗਾਰਸ਼ੀਆਜ਼ੀਬੂਤੀਡੈਨਮਾਰਕਡੋਮੀਨਿਕਾਡੌਮਿਨਿਕਨ ਗਣਰਾਜਅਲਜੀਰੀਆਸਿਓਟਾ ਅਤੇ ਮੇਲਿੱਲਾਇਕਵ" +
+	"ੇਡੋਰਇਸਟੋਨੀਆਮਿਸਰਪੱਛਮੀ ਸਹਾਰਾਇਰੀਟ੍ਰਿਆਸਪੇਨਇਥੋਪੀਆਯੂਰੋਪੀਅਨ ਯੂਨਿਅਨਫਿਨਲੈਂਡਫ਼ਿਜੀਫ਼ਾਕਲੈਂਡ " +
+	"ਆਈਲੈਂਡਸਮਾਇਕ੍ਰੋਨੇਸ਼ੀਆਫੈਰੋ ਆਇਲੈਂਡਸਫ੍ਰਾਂਸਗਾਬੋਨਯੁਨਾਇਟੇਡ ਕਿੰਗਡਮਗ੍ਰੇਨਾਡਾਜਾਰਜਿਆਫ੍ਰੈਂਚ ਗ" +
+	"ੁਏਨਾਗਰਨਸੀਘਾਨਾਜਿਬਰਾਲਟਰਗ੍ਰੀਨਲੈਂਡਗਾਂਬੀਆਗਿਨੀਗੁਆਡੇਲੋਪਭੂ-ਖੰਡੀ ਗਿਨੀਗ੍ਰੀਸਦੱਖਣੀ ਜਾਰਜਿਆ ਅਤ" +
+	"ੇ ਦੱਖਣੀ ਸੈਂਡਵਿਚ ਆਇਲੈਂਡਸਗੁਆਟੇਮਾਲਾਗੁਆਮਗਿਨੀ-ਬਿਸਾਉਗੁਯਾਨਾਹਾਂਗ ਕਾਂਗ ਐਸਏਆਰ ਚੀਨਹਰਡ ਆਈਲੈਂ" +
+	"ਡ ਅਤੇ ਮੈਕਡੋਨਾਲਡ ਆਈਲੈਂਡਸਹਾਨਡੂਰਸਕਰੋਏਸ਼ੀਆਹਾਈਟੀਹੰਗਰੀਕੇਨੇਰੀ ਆਈਲੈਂਡਜ਼ਇੰਡੋਨੇਸ਼ੀਆਆਇਰਲੈਂਡ" +
+	"ਇਜ਼ਰਾਈਲਆਇਲ ਆਫ ਮੈਨਭਾਰਤਬ੍ਰਿਟਿਸ਼ ਹਿੰਦ ਮਹਾਂਸਾਗਰ ਟੈਰਿਟਰੀਇਰਾਕਈਰਾਨਆਈਸਲੈਂਡਇਟਲੀਜਰਸੀਜਮਾਇਕਾ" +
+	"ਜੌਰਡਨਜਾਪਾਨਕੀਨੀਆਕਿਰਗਿਜ਼ਸਤਾਨਕੰਬੋਡੀਆਕੀਰੀਬਾਟੀਕੋਮੋਰੋਸਸੈਂਟ ਕਿਟਸ ਐਂਡ ਨੇਵਿਸਉੱਤਰੀ ਕੋਰੀਆਦੱ" +
+	"ਖਣੀ ਕੋਰੀਆਕੁਵੈਤਕੇਮੈਨ ਆਈਲੈਂਡਸਕਜ਼ਾਕਸਤਾਨਲਾਓਸਲੈਬਨਾਨਸੇਂਟ ਲੂਸੀਆਲਿਕਟਨਸਟਾਇਨਸ੍ਰੀ ਲੰਕਾਲਾਈਬੀ" +
+	"ਰੀਆਲੇਸੋਥੋਲਿਥੁਆਨੀਆਲਕਜ਼ਮਬਰਗਲਾਟਵਿਆਲੀਬੀਆਮੋਰੱਕੋਮੋਨਾਕੋਮੋਲਡੋਵਾਮੋਨਟੇਨੇਗਰੋਸੇਂਟ ਮਾਰਟਿਨਮੈਡਾ" +
+	"ਗਾਸਕਰਮਾਰਸ਼ਲ ਆਈਲੈਂਡਸਮੈਕਡੋਨੀਆਮਾਲੀਮਿਆਂਮਾਰਮੰਗੋਲੀਆਮਕਾਉ ਐਸਏਆਰ ਚੀਨਉੱਤਰੀ ਮਰੀਆਨਾ ਆਈਲੈਂਡਮਾ" +
+	"ਰਟੀਨਿਕਮੋਰਿਟਾਨੀਆਮੋਂਟਸੇਰਾਤਮਾਲਟਾਮੌਰਿਸ਼ਸਮਾਲਦੀਵਮਾਲਾਵੀਮੈਕਸਿਕੋਮਲੇਸ਼ੀਆਮੋਜ਼ਾਮਬੀਕਨਾਮੀਬੀਆਨਿ" +
+	"ਊ ਕੈਲੇਡੋਨਿਆਨਾਇਜਰਨੋਰਫੌਕ ਆਈਲੈਂਡਨਾਇਜੀਰੀਆਨਿਕਾਰਾਗੁਆਨੀਦਰਲੈਂਡਸਨਾਰਵੇਨੇਪਾਲਨਾਉਰੂਨਿਯੂਨਿਊਜ਼ੀ" +
+	"ਲੈਂਡਓਮਾਨਪਨਾਮਾਪੇਰੂਫ੍ਰੈਂਚ ਪੋਲਿਨੇਸ਼ੀਆਪਾਪੂਆ ਨਿਊ ਗਿਨੀਫਿਲਿਪੀਨੀਜ਼ਪਾਕਿਸਤਾਨਪੋਲੈਂਡਸੈਂਟ ਪੀਅ" +
+	"ਰੇ ਐਂਡ ਮਿਕੇਲਨਪਿਟਕੈਰਨ ਆਈਲੈਂਡਸਪਿਊਰਟੋ ਰਿਕੋਪਲੈਸਟਿਨੀ ਪ੍ਰਦੇਸ਼ਪੁਰਤਗਾਲਪਲਾਉਪੈਰਾਗਵੇਕਤਰਆਊਟਲ" +
+	"ਾਇੰਗ ਓਸ਼ਨੀਆਰਿਯੂਨਿਅਨਰੋਮਾਨੀਆਸਰਬੀਆਰੂਸਰਵਾਂਡਾਸਾਉਦੀ ਅਰਬਸੋਲੋਮਨ ਆਈਲੈਂਡਸਸੇਸ਼ਲਸਸੁਡਾਨਸਵੀਡਨਸ" +
+	"ਿੰਗਾਪੁਰਸੇਂਟ ਹੇਲੇਨਾਸਲੋਵੇਨੀਆਸਵਾਲਬਰਡ ਅਤੇ ਜਾਨ ਮਾਯੇਨਸਲੋਵਾਕੀਆਸਿਏਰਾ ਲਿਓਨਸੈਨ ਮਰੀਨੋਸੇਨੇਗਲ" +
+	"ਸੋਮਾਲੀਆਸੂਰੀਨਾਮਦੱਖਣੀ ਸੁਡਾਨਸਾਉ ਟੋਮੀ ਐਂਡ ਪ੍ਰਿੰਸਪੀਅਲ ਸਲਵਾਡੋਰਸਿੰਟ ਮਾਰਟੀਨਸੀਰੀਆਸਵਾਜ਼ੀਲੈ" +
+	"ਂਡਤ੍ਰਿਸਟਾਨ ਡਾ ਕੁਨਾਤੁਰਕਸ ਐਂਡ ਕੇਕੋਸ ਆਈਲੈਂਡਸਚਾਡਫ੍ਰੈਂਚ ਸਾਉਦਰਨ ਟੈਰੀਟਰੀਜ਼ਟੋਗੋਥਾਈਲੈਂਡਤਾ" +
+	"ਜਿਕਿਸਤਾਨਟੋਕੇਲਾਉਟੀਮੁਰ-ਲੀਸਟੀਤੁਰਕਮੇਨਿਸਤਾਨਟਿਉਨੀਸ਼ੀਆਟੌਂਗਾਤੁਰਕੀਟ੍ਰਿਨੀਡਾਡ ਅਤੇ ਟੋਬਾਗੋਟੁਵ" +
+	"ਾਲੂਤਾਇਵਾਨਤਨਜ਼ਾਨੀਆਯੂਕਰੇਨਯੂਗਾਂਡਾਯੂ.ਐਸ. ਆਊਟਲਾਇੰਗ ਆਈਲੈਂਡਸਯੂਨਾਇਟੇਡ ਸਟੇਟਸਉਰੂਗਵੇਉਜ਼ਬੇਕਿ" +
+	"ਸਤਾਨਵੈਟਿਕਨ ਸਿਟੀਸੇਂਟ ਵਿਨਸੈਂਟ ਐਂਡ ਗ੍ਰੇਨਾਡੀਨਸਵੇਨੇਜ਼ੂਏਲਾਬ੍ਰਿਟਿਸ਼ ਵਰਜਿਨ ਆਈਲੈਂਡਸਯੂ ਐਸ " +
+	"ਵਰਜਿਨ ਆਈਲੈਂਡਸਵਿਯਤਨਾਮਵਾਨੂਆਟੂਵਾਲਿਸ ਐਂਡ ਫਯੁਚੁਨਾਸਾਮੋਆਕੋਸੋਵੋਯਮਨਮਾਯੋਟੀਦੱਖਣੀ ਅਫਰੀਕਾਜ਼ਾਮ" +
+	"ਬੀਆਜ਼ਿੰਬਾਬਵੇਅਗਿਆਤ ਖੇਤਰਵਿਸ਼ਵਅਫ਼ਰੀਕਾਉੱਤਰ ਅਮਰੀਕਾਦੱਖਣ ਅਮਰੀਕਾਓਸ਼ਨਿਆਪੱਛਮੀ ਅਫ਼ਰੀਕਾਕੇਂਦਰ" +
+	"ੀ ਅਮਰੀਕਾਪੂਰਬੀ ਅਫ਼ਰੀਕਾਉੱਤਰੀ ਅਫ਼ਰੀਕਾਮੱਧਮ ਅਫ਼ਰੀਕਾਦੱਖਣੀ ਅਫ਼ਰੀਕਾਅਮਰੀਕਾਉੱਤਰੀ ਅਮਰੀਕਾਕੈਰ" +
+	"ੇਬੀਅਨਪੂਰਬੀ ਏਸ਼ੀਆਦੱਖਣੀ ਏਸ਼ੀਆਦੱਖਣ-ਪੂਰਬੀ ਏਸ਼ੀਆਦੱਖਣੀ ਯੂਰਪਔਸਟ੍ਰਾਲੇਸ਼ੀਆਮੈਲਾਨੇਸ਼ੀਆਮਾਇਕ੍" +
+	"ਰੋਨੇਸ਼ੀਆਈ ਖੇਤਰਪੋਲਿਨੇਸ਼ੀਆਏਸ਼ੀਆਕੇਂਦਰੀ ਏਸ਼ੀਆਪੱਛਮੀ ਏਸ਼ੀਆਯੂਰਪਪੂਰਬੀ ਯੂਰਪਉੱਤਰੀ ਯੂਰਪਪੱਛਮ" +
+	"ੀ ਯੂਰਪਲੈਟਿਨ ਅਮਰੀਕਾ"
+
+var paRegionIdx = []uint16{ // 291 entries
+	0x0, 0x28, 0x3a, 0x69, 0x8a, 0xbf, 0xd4, 0xe9, 0xfe, 0xfe, 0x110, 0x12e,
+	0x146, 0x168, 0x17a, 0x195, 0x1a4, 0x1c9, 0x1e4, 0x222, 0x23a, 0x255, 0x26a, 0x28f,
+	0x2a7, 0x2b9, 0x2ce, 0x2dd, 0x305, 0x317, 0x329, 0x33e, 0x36f, 0x387, 0x399, 0x3a8,
+	0x3ca, 0x3e2, 0x3f7, 0x409, 0x41b, 0x458, 0x482, 0x4b7, 0x4ea, 0x50b, 0x527, 0x546,
+	0x552, 0x564, 0x56d, 0x582, 0x5aa, 0x5c6, 0x5d5, 0x5e8, 0x5fd, 0x625, 0x637, 0x650,
+	0x65f, 0x681, 0x696, 0x6ab, 0x6c3, 0x6eb, 0x700, 0x72f, 0x744, 0x759, 0x765, 0x784,
+	0x79c, 0x7a8, 0x7ba, 0x7e5, 0x7fa, 0x809, 0x837, 0x85e, 0x880, 0x892, 0x8a1, 0x8cc,
+	0x8e4, 0x8f6, 0x918, 0x927, 0x933, 0x94b, 0x966, 0x978, 0x984, 0x99c, 0x9bc, 0x9cb,
+	0xa33, 0xa4e, 0xa5a, 0xa76, 0xa88, 0xabb, 0xb13, 0xb28, 0xb40, 0xb4f, 0xb5e, 0xb89,
+	0xba7, 0xbbc, 0xbd1, 0xbeb, 0xbf7, 0xc4b, 0xc57, 0xc63, 0xc78, 0xc84, 0xc90, 0xca2,
+	0xcb1, 0xcc0, 0xccf, 0xcf0, 0xd05, 0xd1d, 0xd32, 0xd65, 0xd84, 0xda3, 0xdb2, 0xdd7,
+	0xdf2, 0xdfe, 0xe10, 0xe2c, 0xe4a, 0xe63, 0xe7b, 0xe8d, 0xea5, 0xebd, 0xecf, 0xede,
+	0xef0, 0xf02, 0xf17, 0xf35, 0xf54, 0xf6f, 0xf97, 0xfaf, 0xfbb, 0xfd0, 0xfe5, 0x100b,
+	0x1040, 0x1058, 0x1073, 0x108e, 0x109d, 0x10b2, 0x10c4, 0x10d6, 0x10eb, 0x1100, 0x111b, 0x1130,
+	0x1155, 0x1164, 0x1189, 0x11a1, 0x11bc, 0x11d7, 0x11e6, 0x11f5, 0x1204, 0x1210, 0x122e, 0x123a,
+	0x1249, 0x1255, 0x1286, 0x12ac, 0x12ca, 0x12e2, 0x12f4, 0x132d, 0x1358, 0x1377, 0x13a5, 0x13ba,
+	0x13c6, 0x13db, 0x13e4, 0x140f, 0x1427, 0x143c, 0x144b, 0x1454, 0x1466, 0x147f, 0x14a7, 0x14b9,
+	0x14c8, 0x14d7, 0x14ef, 0x150e, 0x1526, 0x155f, 0x1577, 0x1593, 0x15ac, 0x15be, 0x15d3, 0x15e8,
+	0x1607, 0x1640, 0x165c, 0x167b, 0x168a, 0x16a8, 0x16d4, 0x1713, 0x171c, 0x175d, 0x1769, 0x177e,
+	0x179c, 0x17b1, 0x17d0, 0x17f4, 0x180f, 0x181e, 0x182d, 0x1865, 0x1877, 0x1889, 0x18a1, 0x18b3,
+	0x18c8, 0x1905, 0x192d, 0x193f, 0x1960, 0x197f, 0x19ca, 0x19e8, 0x1a26, 0x1a59, 0x1a6e, 0x1a83,
+	0x1ab2, 0x1ac1, 0x1ad3, 0x1adc, 0x1aee, 0x1b10, 0x1b25, 0x1b40, 0x1b5c, 0x1b6b, 0x1b80, 0x1b9f,
+	0x1bbe, 0x1bd0, 0x1bf5, 0x1c1a, 0x1c3f, 0x1c64, 0x1c86, 0x1cab, 0x1cbd, 0x1cdf, 0x1cf7, 0x1d16,
+	0x1d35, 0x1d61, 0x1d7d, 0x1da1, 0x1dbf, 0x1df6, 0x1e14, 0x1e23, 0x1e45, 0x1e64, 0x1e70, 0x1e8c,
+	0x1ea8, 0x1ec4, 0x1ee6,
+}
+
+const plRegionStr = "" +
+	"Wyspa WniebowstąpieniaAndoraZjednoczone Emiraty ArabskieAfganistanAntigua i Barb" +
+	"udaAnguillaAlbaniaArmeniaAntyle HolenderskieAngolaAntarktykaArgentynaSamoa Amery" +
+	"kańskieAustriaAustraliaArubaWyspy AlandzkieAzerbejdżanBośnia i HercegowinaBarbad" +
+	"osBangladeszBelgiaBurkina FasoBułgariaBahrajnBurundiBeninSaint-BarthélemyBermudy" +
+	"Brunei DarussalamBoliwiaNiderlandy KaraibskieBrazyliaBahamyBhutanWyspa BouvetaBo" +
+	"tswanaBiałoruśBelizeKanadaWyspy KokosoweDemokratyczna Republika KongaRepublika Ś" +
+	"rodkowoafrykańskaKongoSzwajcariaCôte d’IvoireWyspy CookaChileKamerunChinyKolumbi" +
+	"aClippertonKostarykaKubaRepublika Zielonego PrzylądkaCuraçaoWyspa Bożego Narodze" +
+	"niaCyprCzechyNiemcyDiego GarciaDżibutiDaniaDominikaDominikanaAlgieriaCeuta i Mel" +
+	"illaEkwadorEstoniaEgiptSahara ZachodniaErytreaHiszpaniaEtiopiaUnia EuropejskaFin" +
+	"landiaFidżiFalklandyMikronezjaWyspy OwczeFrancjaGabonWielka BrytaniaGrenadaGruzj" +
+	"aGujana FrancuskaWyspa GuernseyGhanaGibraltarGrenlandiaGambiaGwineaGwadelupaGwin" +
+	"ea RównikowaGrecjaGeorgia Południowa i Sandwich PołudniowyGwatemalaGuamGwinea Bi" +
+	"ssauGujanaHongkong SARWyspy Heard i McDonaldaHondurasChorwacjaHaitiWęgryWyspy Ka" +
+	"naryjskieIndonezjaIrlandiaIzraelWyspa ManIndieBrytyjskie Terytorium Oceanu Indyj" +
+	"skiegoIrakIranIslandiaWłochyWyspa JerseyJamajkaJordaniaJaponiaKeniaKirgistanKamb" +
+	"odżaKiribatiKomorySaint Kitts i NevisKorea PółnocnaKorea PołudniowaKuwejtKajmany" +
+	"KazachstanLaosLibanSaint LuciaLiechtensteinSri LankaLiberiaLesothoLitwaLuksembur" +
+	"gŁotwaLibiaMarokoMonakoMołdawiaCzarnogóraSaint-MartinMadagaskarWyspy MarshallaMa" +
+	"cedoniaMaliMjanma (Birma)MongoliaMakau SARMariany PółnocneMartynikaMauretaniaMon" +
+	"tserratMaltaMauritiusMalediwyMalawiMeksykMalezjaMozambikNamibiaNowa KaledoniaNig" +
+	"erNorfolkNigeriaNikaraguaHolandiaNorwegiaNepalNauruNiueNowa ZelandiaOmanPanamaPe" +
+	"ruPolinezja FrancuskaPapua-Nowa GwineaFilipinyPakistanPolskaSaint-Pierre i Mique" +
+	"lonPitcairnPortorykoTerytoria PalestyńskiePortugaliaPalauParagwajKatarOceania in" +
+	"neReunionRumuniaSerbiaRosjaRwandaArabia SaudyjskaWyspy SalomonaSeszeleSudanSzwec" +
+	"jaSingapurWyspa Świętej HelenySłoweniaSvalbard i Jan MayenSłowacjaSierra LeoneSa" +
+	"n MarinoSenegalSomaliaSurinamSudan PołudniowyWyspy Świętego Tomasza i KsiążęcaSa" +
+	"lwadorSint MaartenSyriaSuaziTristan da CunhaTurks i CaicosCzadFrancuskie Terytor" +
+	"ia PołudnioweTogoTajlandiaTadżykistanTokelauTimor WschodniTurkmenistanTunezjaTon" +
+	"gaTurcjaTrynidad i TobagoTuvaluTajwanTanzaniaUkrainaUgandaDalekie Wyspy Mniejsze" +
+	" Stanów ZjednoczonychStany ZjednoczoneUrugwajUzbekistanWatykanSaint Vincent i Gr" +
+	"enadynyWenezuelaBrytyjskie Wyspy DziewiczeWyspy Dziewicze Stanów ZjednoczonychWi" +
+	"etnamVanuatuWallis i FutunaSamoaKosowoJemenMajottaRepublika Południowej AfrykiZa" +
+	"mbiaZimbabweNieznany regionświatAfrykaAmeryka PółnocnaAmeryka PołudniowaOceaniaA" +
+	"fryka ZachodniaAmeryka ŚrodkowaAfryka WschodniaAfryka PółnocnaAfryka ŚrodkowaAfr" +
+	"yka PołudniowaAmerykaAmeryka Północna (USA, Kanada)KaraibyAzja WschodniaAzja Poł" +
+	"udniowaAzja Południowo-WschodniaEuropa PołudniowaAustralazjaMelanezjaRegion Mikr" +
+	"onezjiPolinezjaAzjaAzja ŚrodkowaAzja ZachodniaEuropaEuropa WschodniaEuropa Półno" +
+	"cnaEuropa ZachodniaAmeryka Łacińska"
+
+var plRegionIdx = []uint16{ // 291 entries
+	0x0, 0x17, 0x1d, 0x39, 0x43, 0x54, 0x5c, 0x63, 0x6a, 0x7d, 0x83, 0x8d,
+	0x96, 0xa9, 0xb0, 0xb9, 0xbe, 0xcd, 0xd9, 0xee, 0xf6, 0x100, 0x106, 0x112,
+	0x11b, 0x122, 0x129, 0x12e, 0x13f, 0x146, 0x157, 0x15e, 0x173, 0x17b, 0x181, 0x187,
+	0x194, 0x19c, 0x1a6, 0x1ac, 0x1b2, 0x1c0, 0x1dd, 0x1fb, 0x200, 0x20a, 0x21a, 0x225,
+	0x22a, 0x231, 0x236, 0x23e, 0x248, 0x251, 0x255, 0x273, 0x27b, 0x293, 0x297, 0x29d,
+	0x2a3, 0x2af, 0x2b7, 0x2bc, 0x2c4, 0x2ce, 0x2d6, 0x2e5, 0x2ec, 0x2f3, 0x2f8, 0x308,
+	0x30f, 0x318, 0x31f, 0x32e, 0x337, 0x33d, 0x346, 0x350, 0x35b, 0x362, 0x367, 0x376,
+	0x37d, 0x383, 0x393, 0x3a1, 0x3a6, 0x3af, 0x3b9, 0x3bf, 0x3c5, 0x3ce, 0x3df, 0x3e5,
+	0x40f, 0x418, 0x41c, 0x429, 0x42f, 0x43b, 0x452, 0x45a, 0x463, 0x468, 0x46e, 0x47f,
+	0x488, 0x490, 0x496, 0x49f, 0x4a4, 0x4cc, 0x4d0, 0x4d4, 0x4dc, 0x4e3, 0x4ef, 0x4f6,
+	0x4fe, 0x505, 0x50a, 0x513, 0x51c, 0x524, 0x52a, 0x53d, 0x54d, 0x55e, 0x564, 0x56b,
+	0x575, 0x579, 0x57e, 0x589, 0x596, 0x59f, 0x5a6, 0x5ad, 0x5b2, 0x5bc, 0x5c2, 0x5c7,
+	0x5cd, 0x5d3, 0x5dc, 0x5e7, 0x5f3, 0x5fd, 0x60c, 0x615, 0x619, 0x627, 0x62f, 0x638,
+	0x64a, 0x653, 0x65d, 0x667, 0x66c, 0x675, 0x67d, 0x683, 0x689, 0x690, 0x698, 0x69f,
+	0x6ad, 0x6b2, 0x6b9, 0x6c0, 0x6c9, 0x6d1, 0x6d9, 0x6de, 0x6e3, 0x6e7, 0x6f4, 0x6f8,
+	0x6fe, 0x702, 0x715, 0x726, 0x72e, 0x736, 0x73c, 0x753, 0x75b, 0x764, 0x77b, 0x785,
+	0x78a, 0x792, 0x797, 0x7a3, 0x7aa, 0x7b1, 0x7b7, 0x7bc, 0x7c2, 0x7d2, 0x7e0, 0x7e7,
+	0x7ec, 0x7f3, 0x7fb, 0x811, 0x81a, 0x82e, 0x837, 0x843, 0x84d, 0x854, 0x85b, 0x862,
+	0x873, 0x899, 0x8a1, 0x8ad, 0x8b2, 0x8b7, 0x8c7, 0x8d5, 0x8d9, 0x8f9, 0x8fd, 0x906,
+	0x912, 0x919, 0x927, 0x933, 0x93a, 0x93f, 0x945, 0x956, 0x95c, 0x962, 0x96a, 0x971,
+	0x977, 0x9a3, 0x9b4, 0x9bb, 0x9c5, 0x9cc, 0x9e5, 0x9ee, 0xa08, 0xa2d, 0xa34, 0xa3b,
+	0xa4a, 0xa4f, 0xa55, 0xa5a, 0xa61, 0xa7e, 0xa84, 0xa8c, 0xa9b, 0xaa1, 0xaa7, 0xab9,
+	0xacc, 0xad3, 0xae3, 0xaf4, 0xb04, 0xb15, 0xb25, 0xb37, 0xb3e, 0xb5e, 0xb65, 0xb73,
+	0xb83, 0xb9d, 0xbaf, 0xbba, 0xbc3, 0xbd4, 0xbdd, 0xbe1, 0xbef, 0xbfd, 0xc03, 0xc13,
+	0xc24, 0xc34, 0xc46,
+}
+
+const ptRegionStr = "" +
+	"Ilha de AscensãoAndorraEmirados Árabes UnidosAfeganistãoAntígua e BarbudaAnguill" +
+	"aAlbâniaArmêniaAntilhas HolandesasAngolaAntártidaArgentinaSamoa AmericanaÁustria" +
+	"AustráliaArubaIlhas ÅlandAzerbaijãoBósnia e HerzegovinaBarbadosBangladeshBélgica" +
+	"Burquina FasoBulgáriaBahreinBurundiBeninSão BartolomeuBermudasBruneiBolíviaPaíse" +
+	"s Baixos CaribenhosBrasilBahamasButãoIlha BouvetBotsuanaBielorrússiaBelizeCanadá" +
+	"Ilhas CocoCongo - KinshasaRepública Centro-AfricanaCongo - BrazzavilleSuíçaCosta" +
+	" do MarfimIlhas CookChileRepública dos CamarõesChinaColômbiaIlha de ClippertonCo" +
+	"sta RicaCubaCabo VerdeCuraçaoIlhas NatalChipreRepública TchecaAlemanhaDiego Garc" +
+	"iaDjibutiDinamarcaDominicaRepública DominicanaArgéliaCeuta e MelilhaEquadorEstôn" +
+	"iaEgitoSaara OcidentalEritreiaEspanhaEtiópiaUnião EuropeiaFinlândiaFijiIlhas Mal" +
+	"vinasMicronésiaIlhas FaroeFrançaGabãoReino UnidoGranadaGeórgiaGuiana FrancesaGue" +
+	"rnseyGanaGibraltarGroênlandiaGâmbiaGuinéGuadalupeGuiné EquatorialGréciaGeórgia d" +
+	"o Sul e Ilhas Sandwich do SulGuatemalaGuamGuiné BissauGuianaHong Kong, RAE da Ch" +
+	"inaIlha Heard e Ilhas McDonaldHondurasCroáciaHaitiHungriaIlhas CanáriasIndonésia" +
+	"IrlandaIsraelIlha de ManÍndiaTerritório Britânico do Oceano ÍndicoIraqueIrãIslân" +
+	"diaItáliaJerseyJamaicaJordâniaJapãoQuêniaQuirguistãoCambojaQuiribatiComoresSão C" +
+	"ristovão e NevisCoreia do NorteCoreia do SulKuwaitIlhas CaimanCazaquistãoLaosLíb" +
+	"anoSanta LúciaLiechtensteinSri LankaLibériaLesotoLituâniaLuxemburgoLetôniaLíbiaM" +
+	"arrocosMônacoMoldáviaMontenegroSão MartinhoMadagascarIlhas MarshallMacedôniaMali" +
+	"Mianmar (Birmânia)MongóliaMacau, RAE da ChinaIlhas Marianas do NorteMartinicaMau" +
+	"ritâniaMontserratMaltaMaurícioMaldivasMalawiMéxicoMalásiaMoçambiqueNamíbiaNova C" +
+	"aledôniaNígerIlha NorfolkNigériaNicaráguaHolandaNoruegaNepalNauruNiueNova Zelând" +
+	"iaOmãPanamáPeruPolinésia FrancesaPapua-Nova GuinéFilipinasPaquistãoPolôniaSaint " +
+	"Pierre e MiquelonIlhas PitcairnPorto RicoTerritórios palestinosPortugalPalauPara" +
+	"guaiCatarOceania RemotaReuniãoRomêniaSérviaRússiaRuandaArábia SauditaIlhas Salom" +
+	"ãoSeychellesSudãoSuéciaCingapuraSanta HelenaEslovêniaSvalbard e Jan MayenEslováq" +
+	"uiaSerra LeoaSan MarinoSenegalSomáliaSurinameSudão do SulSão Tomé e PríncipeEl S" +
+	"alvadorSint MaartenSíriaSuazilândiaTristão da CunhaIlhas Turks e CaicosChadeTerr" +
+	"itórios Franceses do SulTogoTailândiaTadjiquistãoTokelauTimor-LesteTurcomenistão" +
+	"TunísiaTongaTurquiaTrinidad e TobagoTuvaluTaiwanTanzâniaUcrâniaUgandaIlhas Dista" +
+	"ntes dos EUAEstados UnidosUruguaiUzbequistãoCidade do VaticanoSão Vicente e Gran" +
+	"adinasVenezuelaIlhas Virgens BritânicasIlhas Virgens dos EUAVietnãVanuatuWallis " +
+	"e FutunaSamoaKosovoIêmenMayotteÁfrica do SulZâmbiaZimbábueRegião desconhecidaMun" +
+	"doÁfricaAmérica do NorteAmérica do SulOceaniaÁfrica OcidentalAmérica CentralÁfri" +
+	"ca OrientalÁfrica do NorteÁfrica CentralÁfrica AustralAméricasAmérica Setentrion" +
+	"alCaribeÁsia OrientalÁsia do SulÁsia Centro-OrientalEuropa do SulAustralásiaMela" +
+	"nésiaRegião da MicronésiaPolinésiaÁsiaÁsia CentralÁsia OcidentalEuropaEuropa Ori" +
+	"entalEuropa SetentrionalEuropa OcidentalAmérica Latina"
+
+var ptRegionIdx = []uint16{ // 291 entries
+	0x0, 0x11, 0x18, 0x2f, 0x3b, 0x4d, 0x55, 0x5d, 0x65, 0x78, 0x7e, 0x88,
+	0x91, 0xa0, 0xa8, 0xb2, 0xb7, 0xc3, 0xce, 0xe3, 0xeb, 0xf5, 0xfd, 0x10a,
+	0x113, 0x11a, 0x121, 0x126, 0x135, 0x13d, 0x143, 0x14b, 0x164, 0x16a, 0x171, 0x177,
+	0x182, 0x18a, 0x197, 0x19d, 0x1a4, 0x1ae, 0x1be, 0x1d8, 0x1eb, 0x1f2, 0x201, 0x20b,
+	0x210, 0x228, 0x22d, 0x236, 0x248, 0x252, 0x256, 0x260, 0x268, 0x273, 0x279, 0x28a,
+	0x292, 0x29e, 0x2a5, 0x2ae, 0x2b6, 0x2cb, 0x2d3, 0x2e2, 0x2e9, 0x2f1, 0x2f6, 0x305,
+	0x30d, 0x314, 0x31c, 0x32b, 0x335, 0x339, 0x347, 0x352, 0x35d, 0x364, 0x36a, 0x375,
+	0x37c, 0x384, 0x393, 0x39b, 0x39f, 0x3a8, 0x3b4, 0x3bb, 0x3c1, 0x3ca, 0x3db, 0x3e2,
+	0x409, 0x412, 0x416, 0x423, 0x429, 0x440, 0x45b, 0x463, 0x46b, 0x470, 0x477, 0x486,
+	0x490, 0x497, 0x49d, 0x4a8, 0x4ae, 0x4d6, 0x4dc, 0x4e0, 0x4e9, 0x4f0, 0x4f6, 0x4fd,
+	0x506, 0x50c, 0x513, 0x51f, 0x526, 0x52f, 0x536, 0x54d, 0x55c, 0x569, 0x56f, 0x57b,
+	0x587, 0x58b, 0x592, 0x59e, 0x5ab, 0x5b4, 0x5bc, 0x5c2, 0x5cb, 0x5d5, 0x5dd, 0x5e3,
+	0x5eb, 0x5f2, 0x5fb, 0x605, 0x612, 0x61c, 0x62a, 0x634, 0x638, 0x64b, 0x654, 0x667,
+	0x67e, 0x687, 0x692, 0x69c, 0x6a1, 0x6aa, 0x6b2, 0x6b8, 0x6bf, 0x6c7, 0x6d2, 0x6da,
+	0x6e9, 0x6ef, 0x6fb, 0x703, 0x70d, 0x714, 0x71b, 0x720, 0x725, 0x729, 0x737, 0x73b,
+	0x742, 0x746, 0x759, 0x76a, 0x773, 0x77d, 0x785, 0x79c, 0x7aa, 0x7b4, 0x7cb, 0x7d3,
+	0x7d8, 0x7e0, 0x7e5, 0x7f3, 0x7fb, 0x803, 0x80a, 0x811, 0x817, 0x826, 0x834, 0x83e,
+	0x844, 0x84b, 0x854, 0x860, 0x86a, 0x87e, 0x889, 0x893, 0x89d, 0x8a4, 0x8ac, 0x8b4,
+	0x8c1, 0x8d7, 0x8e2, 0x8ee, 0x8f4, 0x900, 0x911, 0x925, 0x92a, 0x947, 0x94b, 0x955,
+	0x962, 0x969, 0x974, 0x982, 0x98a, 0x98f, 0x996, 0x9a7, 0x9ad, 0x9b3, 0x9bc, 0x9c4,
+	0x9ca, 0x9e1, 0x9ef, 0x9f6, 0xa02, 0xa14, 0xa2d, 0xa36, 0xa4f, 0xa64, 0xa6b, 0xa72,
+	0xa81, 0xa86, 0xa8c, 0xa92, 0xa99, 0xaa7, 0xaae, 0xab7, 0xacb, 0xad0, 0xad7, 0xae8,
+	0xaf7, 0xafe, 0xb0f, 0xb1f, 0xb2f, 0xb3f, 0xb4e, 0xb5d, 0xb66, 0xb7b, 0xb81, 0xb8f,
+	0xb9b, 0xbb0, 0xbbd, 0xbc9, 0xbd3, 0xbe9, 0xbf3, 0xbf8, 0xc05, 0xc14, 0xc1a, 0xc29,
+	0xc3c, 0xc4c, 0xc5b,
+}
+
+const ptPTRegionStr = "" +
+	"Ilha de AscensãoAndorraEmirados Árabes UnidosAfeganistãoAntígua e BarbudaAnguila" +
+	"AlbâniaArméniaAngolaAntártidaArgentinaSamoa AmericanaÁustriaAustráliaArubaIlhas " +
+	"ÅlandAzerbaijãoBósnia e HerzegovinaBarbadosBangladeshBélgicaBurquina FasoBulgári" +
+	"aBarémBurundiBenimSão BartolomeuBermudasBruneiBolíviaPaíses Baixos CaribenhosBra" +
+	"silBahamasButãoIlha BouvetBotswanaBielorrússiaBelizeCanadáIlhas CocosCongo-Kinsh" +
+	"asaRepública Centro-AfricanaCongo-BrazzavilleSuíçaCosta do MarfimIlhas CookChile" +
+	"CamarõesChinaColômbiaIlha de ClippertonCosta RicaCubaCabo VerdeCuraçauIlha do Na" +
+	"talChipreRepública ChecaAlemanhaDiego GarciaJibutiDinamarcaDomínicaRepública Dom" +
+	"inicanaArgéliaCeuta e MelilhaEquadorEstóniaEgiptoSaara OcidentalEritreiaEspanhaE" +
+	"tiópiaUnião EuropeiaFinlândiaFijiIlhas FalklandMicronésiaIlhas FaroéFrançaGabãoR" +
+	"eino UnidoGranadaGeórgiaGuiana FrancesaGuernseyGanaGibraltarGronelândiaGâmbiaGui" +
+	"néGuadalupeGuiné EquatorialGréciaIlhas Geórgia do Sul e Sandwich do SulGuatemala" +
+	"GuameGuiné-BissauGuianaHong Kong, RAE da ChinaIlhas Heard e McDonaldHondurasCroá" +
+	"ciaHaitiHungriaIlhas CanáriasIndonésiaIrlandaIsraelIlha de ManÍndiaTerritório Br" +
+	"itânico do Oceano ÍndicoIraqueIrãoIslândiaItáliaJerseyJamaicaJordâniaJapãoQuénia" +
+	"QuirguizistãoCambojaQuiribatiComorosSão Cristóvão e NevisCoreia do NorteCoreia d" +
+	"o SulKuwaitIlhas CaimãoCazaquistãoLaosLíbanoSanta LúciaLiechtensteinLibériaLesot" +
+	"oLituâniaLuxemburgoLetóniaLíbiaMarrocosMónacoMoldáviaMontenegroSão MartinhoMadag" +
+	"áscarIlhas MarshallMacedóniaMaliMianmar (Birmânia)MongóliaMacau, RAE da ChinaIlh" +
+	"as Marianas do NorteMartinicaMauritâniaMonserrateMaltaMauríciaMaldivasMalawiMéxi" +
+	"coMalásiaMoçambiqueNamíbiaNova CaledóniaNígerIlha NorfolkNigériaNicaráguaPaíses " +
+	"BaixosNoruegaNepalNauruNiueNova ZelândiaOmãPanamáPeruPolinésia FrancesaPapua-Nov" +
+	"a GuinéFilipinasPaquistãoPolóniaSaint Pierre e MiquelonPitcairnPorto RicoTerritó" +
+	"rio PalestinianoPortugalPalauParaguaiCatarOceânia InsularReuniãoRoméniaSérviaRús" +
+	"siaRuandaArábia SauditaIlhas SalomãoSeichelesSudãoSuéciaSingapuraSanta HelenaEsl" +
+	"ovéniaSvalbard e Jan MayenEslováquiaSerra LeoaSão MarinoSenegalSomáliaSurinameSu" +
+	"dão do SulSão Tomé e PríncipeEl SalvadorSint MaartenSíriaSuazilândiaTristão da C" +
+	"unhaIlhas Turcas e CaicosChadeTerritórios Franceses do SulTogoTailândiaTajiquist" +
+	"ãoToquelauTimor-LesteTurquemenistãoTunísiaTongaTurquiaTrindade e TobagoTuvaluTai" +
+	"wanTanzâniaUcrâniaUgandaIlhas Distantes dos EUAEstados UnidosUruguaiUzbequistãoC" +
+	"idade do VaticanoSão Vicente e GranadinasVenezuelaIlhas Virgens BritânicasIlhas " +
+	"Virgens dos EUAVietnameVanuatuWallis e FutunaSamoaKosovoIémenMaioteÁfrica do Sul" +
+	"ZâmbiaZimbabuéRegião desconhecida ou inválidaMundoÁfricaAmérica do NorteAmérica " +
+	"do SulOceâniaÁfrica OcidentalAmérica CentralÁfrica OrientalNorte de ÁfricaÁfrica" +
+	" CentralÁfrica AustralAméricasAmérica SetentrionalCaraíbasÁsia OrientalÁsia do S" +
+	"ulSudeste AsiáticoEuropa do SulAustralásiaMelanésiaRegião da MicronésiaPolinésia" +
+	"ÁsiaÁsia CentralÁsia OcidentalEuropaEuropa OrientalEuropa do NorteEuropa Ocident" +
+	"alAmérica Latina"
+
+var ptPTRegionIdx = []uint16{ // 291 entries
+	0x0, 0x11, 0x18, 0x2f, 0x3b, 0x4d, 0x54, 0x5c, 0x64, 0x64, 0x6a, 0x74,
+	0x7d, 0x8c, 0x94, 0x9e, 0xa3, 0xaf, 0xba, 0xcf, 0xd7, 0xe1, 0xe9, 0xf6,
+	0xff, 0x105, 0x10c, 0x111, 0x120, 0x128, 0x12e, 0x136, 0x14f, 0x155, 0x15c, 0x162,
+	0x16d, 0x175, 0x182, 0x188, 0x18f, 0x19a, 0x1a8, 0x1c2, 0x1d3, 0x1da, 0x1e9, 0x1f3,
+	0x1f8, 0x201, 0x206, 0x20f, 0x221, 0x22b, 0x22f, 0x239, 0x241, 0x24e, 0x254, 0x264,
+	0x26c, 0x278, 0x27e, 0x287, 0x290, 0x2a5, 0x2ad, 0x2bc, 0x2c3, 0x2cb, 0x2d1, 0x2e0,
+	0x2e8, 0x2ef, 0x2f7, 0x306, 0x310, 0x314, 0x322, 0x32d, 0x339, 0x340, 0x346, 0x351,
+	0x358, 0x360, 0x36f, 0x377, 0x37b, 0x384, 0x390, 0x397, 0x39d, 0x3a6, 0x3b7, 0x3be,
+	0x3e5, 0x3ee, 0x3f3, 0x400, 0x406, 0x41d, 0x433, 0x43b, 0x443, 0x448, 0x44f, 0x45e,
+	0x468, 0x46f, 0x475, 0x480, 0x486, 0x4ae, 0x4b4, 0x4b9, 0x4c2, 0x4c9, 0x4cf, 0x4d6,
+	0x4df, 0x4e5, 0x4ec, 0x4fa, 0x501, 0x50a, 0x511, 0x529, 0x538, 0x545, 0x54b, 0x558,
+	0x564, 0x568, 0x56f, 0x57b, 0x588, 0x588, 0x590, 0x596, 0x59f, 0x5a9, 0x5b1, 0x5b7,
+	0x5bf, 0x5c6, 0x5cf, 0x5d9, 0x5e6, 0x5f1, 0x5ff, 0x609, 0x60d, 0x620, 0x629, 0x63c,
+	0x653, 0x65c, 0x667, 0x671, 0x676, 0x67f, 0x687, 0x68d, 0x694, 0x69c, 0x6a7, 0x6af,
+	0x6be, 0x6c4, 0x6d0, 0x6d8, 0x6e2, 0x6f0, 0x6f7, 0x6fc, 0x701, 0x705, 0x713, 0x717,
+	0x71e, 0x722, 0x735, 0x746, 0x74f, 0x759, 0x761, 0x778, 0x780, 0x78a, 0x7a2, 0x7aa,
+	0x7af, 0x7b7, 0x7bc, 0x7cc, 0x7d4, 0x7dc, 0x7e3, 0x7ea, 0x7f0, 0x7ff, 0x80d, 0x816,
+	0x81c, 0x823, 0x82c, 0x838, 0x842, 0x856, 0x861, 0x86b, 0x876, 0x87d, 0x885, 0x88d,
+	0x89a, 0x8b0, 0x8bb, 0x8c7, 0x8cd, 0x8d9, 0x8ea, 0x8ff, 0x904, 0x921, 0x925, 0x92f,
+	0x93b, 0x943, 0x94e, 0x95d, 0x965, 0x96a, 0x971, 0x982, 0x988, 0x98e, 0x997, 0x99f,
+	0x9a5, 0x9bc, 0x9ca, 0x9d1, 0x9dd, 0x9ef, 0xa08, 0xa11, 0xa2a, 0xa3f, 0xa47, 0xa4e,
+	0xa5d, 0xa62, 0xa68, 0xa6e, 0xa74, 0xa82, 0xa89, 0xa92, 0xab3, 0xab8, 0xabf, 0xad0,
+	0xadf, 0xae7, 0xaf8, 0xb08, 0xb18, 0xb28, 0xb37, 0xb46, 0xb4f, 0xb64, 0xb6d, 0xb7b,
+	0xb87, 0xb98, 0xba5, 0xbb1, 0xbbb, 0xbd1, 0xbdb, 0xbe0, 0xbed, 0xbfc, 0xc02, 0xc11,
+	0xc20, 0xc30, 0xc3f,
+}
+
+const roRegionStr = "" +
+	"Insula AscensionAndorraEmiratele Arabe UniteAfganistanAntigua și BarbudaAnguilla" +
+	"AlbaniaArmeniaAntilele OlandezeAngolaAntarcticaArgentinaSamoa AmericanăAustriaAu" +
+	"straliaArubaInsulele ÅlandAzerbaidjanBosnia și HerțegovinaBarbadosBangladeshBelg" +
+	"iaBurkina FasoBulgariaBahrainBurundiBeninSfântul BartolomeuBermudaBruneiBoliviaI" +
+	"nsulele Caraibe OlandezeBraziliaBahamasBhutanInsula BouvetBotswanaBelarusBelizeC" +
+	"anadaInsulele Cocos (Keeling)Republica Democrată CongoRepublica CentrafricanăCon" +
+	"goElvețiaCôte d’IvoireInsulele CookChileCamerunChinaColumbiaInsula ClippertonCos" +
+	"ta RicaCubaCapul VerdeCuraçaoInsula ChristmasCipruRepublica CehăGermaniaDiego Ga" +
+	"rciaDjiboutiDanemarcaDominicaRepublica DominicanăAlgeriaCeuta și MelillaEcuadorE" +
+	"stoniaEgiptSahara OccidentalăEritreeaSpaniaEtiopiaUniunea EuropeanăFinlandaFijiI" +
+	"nsulele FalklandMicroneziaInsulele FeroeFranțaGabonRegatul UnitGrenadaGeorgiaGuy" +
+	"ana FrancezăGuernseyGhanaGibraltarGroenlandaGambiaGuineeaGuadelupaGuineea Ecuato" +
+	"rialăGreciaGeorgia de Sud și Insulele Sandwich de SudGuatemalaGuamGuineea-Bissau" +
+	"GuyanaR.A.S. Hong Kong a ChineiInsula Heard și Insulele McDonaldHondurasCroațiaH" +
+	"aitiUngariaInsulele CanareIndoneziaIrlandaIsraelInsula ManIndiaTeritoriul Britan" +
+	"ic din Oceanul IndianIrakIranIslandaItaliaJerseyJamaicaIordaniaJaponiaKenyaKârgâ" +
+	"zstanCambodgiaKiribatiComoreSaint Kitts și NevisCoreea de NordCoreea de SudKuwei" +
+	"tInsulele CaymanKazahstanLaosLibanSfânta LuciaLiechtensteinSri LankaLiberiaLesot" +
+	"hoLituaniaLuxemburgLetoniaLibiaMarocMonacoRepublica MoldovaMuntenegruSfântul Mar" +
+	"tinMadagascarInsulele MarshallMacedoniaMaliMyanmar (Birmania)MongoliaR.A.S. Maca" +
+	"o a ChineiInsulele Mariane de NordMartinicaMauritaniaMontserratMaltaMauritiusMal" +
+	"diveMalawiMexicMalaeziaMozambicNamibiaNoua CaledonieNigerInsulele NorfolkNigeria" +
+	"NicaraguaȚările de JosNorvegiaNepalNauruNiueNoua ZeelandăOmanPanamaPeruPolinezia" +
+	" FrancezăPapua-Noua GuineeFilipinePakistanPoloniaSaint-Pierre și MiquelonInsulel" +
+	"e PitcairnPuerto RicoTeritoriile PalestinienePortugaliaPalauParaguayQatarOceania" +
+	" PerifericăRéunionRomâniaSerbiaRusiaRwandaArabia SaudităInsulele SolomonSeychell" +
+	"esSudanSuediaSingaporeSfânta ElenaSloveniaSvalbard și Jan MayenSlovaciaSierra Le" +
+	"oneSan MarinoSenegalSomaliaSurinamSudanul de SudSao Tome și PrincipeEl SalvadorS" +
+	"int-MaartenSiriaSwazilandTristan da CunhaInsulele Turks și CaicosCiadTeritoriile" +
+	" Australe și Antarctice FrancezeTogoThailandaTadjikistanTokelauTimorul de EstTur" +
+	"kmenistanTunisiaTongaTurciaTrinidad și TobagoTuvaluTaiwanTanzaniaUcrainaUgandaIn" +
+	"sulele Minore Îndepărtate ale Statelor Unite ale AmericiiStatele Unite ale Ameri" +
+	"ciiUruguayUzbekistanStatul Cetății VaticanuluiSaint Vincent și GrenadineVenezuel" +
+	"aInsulele Virgine BritaniceInsulele Virgine S.U.A.VietnamVanuatuWallis și Futuna" +
+	"SamoaKosovoYemenMayotteAfrica de SudZambiaZimbabweRegiune necunoscutăLumeAfricaA" +
+	"merica de NordAmerica de SudOceaniaAfrica OccidentalăAmerica CentralăAfrica Orie" +
+	"ntalăAfrica SeptentrionalăAfrica CentralăAfrica MeridionalăAmericiAmerica Septen" +
+	"trionalăCaraibeAsia OrientalăAsia MeridionalăAsia de Sud-EstEuropa MeridionalăAu" +
+	"stralasiaMelaneziaRegiunea MicroneziaPolineziaAsiaAsia CentralăAsia OccidentalăE" +
+	"uropaEuropa OrientalăEuropa SeptentrionalăEuropa OccidentalăAmerica Latină"
+
+var roRegionIdx = []uint16{ // 291 entries
+	0x0, 0x10, 0x17, 0x2c, 0x36, 0x49, 0x51, 0x58, 0x5f, 0x70, 0x76, 0x80,
+	0x89, 0x99, 0xa0, 0xa9, 0xae, 0xbd, 0xc8, 0xdf, 0xe7, 0xf1, 0xf7, 0x103,
+	0x10b, 0x112, 0x119, 0x11e, 0x131, 0x138, 0x13e, 0x145, 0x15e, 0x166, 0x16d, 0x173,
+	0x180, 0x188, 0x18f, 0x195, 0x19b, 0x1b3, 0x1cd, 0x1e5, 0x1ea, 0x1f2, 0x202, 0x20f,
+	0x214, 0x21b, 0x220, 0x228, 0x239, 0x243, 0x247, 0x252, 0x25a, 0x26a, 0x26f, 0x27e,
+	0x286, 0x292, 0x29a, 0x2a3, 0x2ab, 0x2c0, 0x2c7, 0x2d8, 0x2df, 0x2e6, 0x2eb, 0x2fe,
+	0x306, 0x30c, 0x313, 0x325, 0x32d, 0x331, 0x342, 0x34c, 0x35a, 0x361, 0x366, 0x372,
+	0x379, 0x380, 0x390, 0x398, 0x39d, 0x3a6, 0x3b0, 0x3b6, 0x3bd, 0x3c6, 0x3da, 0x3e0,
+	0x40b, 0x414, 0x418, 0x426, 0x42c, 0x445, 0x467, 0x46f, 0x477, 0x47c, 0x483, 0x492,
+	0x49b, 0x4a2, 0x4a8, 0x4b2, 0x4b7, 0x4dd, 0x4e1, 0x4e5, 0x4ec, 0x4f2, 0x4f8, 0x4ff,
+	0x507, 0x50e, 0x513, 0x51f, 0x528, 0x530, 0x536, 0x54b, 0x559, 0x566, 0x56c, 0x57b,
+	0x584, 0x588, 0x58d, 0x59a, 0x5a7, 0x5b0, 0x5b7, 0x5be, 0x5c6, 0x5cf, 0x5d6, 0x5db,
+	0x5e0, 0x5e6, 0x5f7, 0x601, 0x610, 0x61a, 0x62b, 0x634, 0x638, 0x64a, 0x652, 0x667,
+	0x67f, 0x688, 0x692, 0x69c, 0x6a1, 0x6aa, 0x6b1, 0x6b7, 0x6bc, 0x6c4, 0x6cc, 0x6d3,
+	0x6e1, 0x6e6, 0x6f6, 0x6fd, 0x706, 0x715, 0x71d, 0x722, 0x727, 0x72b, 0x739, 0x73d,
+	0x743, 0x747, 0x75a, 0x76b, 0x773, 0x77b, 0x782, 0x79b, 0x7ac, 0x7b7, 0x7cf, 0x7d9,
+	0x7de, 0x7e6, 0x7eb, 0x7fe, 0x806, 0x80e, 0x814, 0x819, 0x81f, 0x82e, 0x83e, 0x848,
+	0x84d, 0x853, 0x85c, 0x869, 0x871, 0x887, 0x88f, 0x89b, 0x8a5, 0x8ac, 0x8b3, 0x8ba,
+	0x8c8, 0x8dd, 0x8e8, 0x8f4, 0x8f9, 0x902, 0x912, 0x92b, 0x92f, 0x95b, 0x95f, 0x968,
+	0x973, 0x97a, 0x988, 0x994, 0x99b, 0x9a0, 0x9a6, 0x9b9, 0x9bf, 0x9c5, 0x9cd, 0x9d4,
+	0x9da, 0xa17, 0xa31, 0xa38, 0xa42, 0xa5e, 0xa79, 0xa82, 0xa9c, 0xab3, 0xaba, 0xac1,
+	0xad2, 0xad7, 0xadd, 0xae2, 0xae9, 0xaf6, 0xafc, 0xb04, 0xb18, 0xb1c, 0xb22, 0xb31,
+	0xb3f, 0xb46, 0xb59, 0xb6a, 0xb7b, 0xb91, 0xba1, 0xbb4, 0xbbb, 0xbd2, 0xbd9, 0xbe8,
+	0xbf9, 0xc08, 0xc1b, 0xc26, 0xc2f, 0xc42, 0xc4b, 0xc4f, 0xc5d, 0xc6e, 0xc74, 0xc85,
+	0xc9b, 0xcae, 0xcbd,
+}
+
+const ruRegionStr = "" +
+	"О-в ВознесенияАндорраОАЭАфганистанАнтигуа и БарбудаАнгильяАлбанияАрменияНидерлан" +
+	"дские Антильские о-ваАнголаАнтарктидаАргентинаАмериканское СамоаАвстрияАвстралия" +
+	"АрубаАландские о-ваАзербайджанБосния и ГерцеговинаБарбадосБангладешБельгияБуркин" +
+	"а-ФасоБолгарияБахрейнБурундиБенинСен-БартельмиБермудские о-ваБруней-ДаруссаламБо" +
+	"ливияБонэйр, Синт-Эстатиус и СабаБразилияБагамские о-ваБутанО-в БувеБотсванаБела" +
+	"русьБелизКанадаКокосовые о-ваКонго - КиншасаЦАРКонго - БраззавильШвейцарияКот-д'" +
+	"ИвуарО-ва КукаЧилиКамерунКитайКолумбияО-в КлиппертонКоста-РикаКубаКабо-ВердеКюра" +
+	"саоО-в РождестваКипрЧехияГерманияДиего-ГарсияДжибутиДанияДоминикаДоминиканская Р" +
+	"еспубликаАлжирСеута и МелильяЭквадорЭстонияЕгипетЗападная СахараЭритреяИспанияЭф" +
+	"иопияЕвропейский союзФинляндияФиджиФолклендские о-ваФедеративные Штаты Микронези" +
+	"иФарерские о-ваФранцияГабонВеликобританияГренадаГрузияФранцузская ГвианаГернсиГа" +
+	"наГибралтарГренландияГамбияГвинеяГваделупаЭкваториальная ГвинеяГрецияЮжная Георг" +
+	"ия и Южные Сандвичевы о-ваГватемалаГуамГвинея-БисауГайанаГонконг (особый район)О" +
+	"-ва Херд и МакдональдГондурасХорватияГаитиВенгрияКанарские о-ваИндонезияИрландия" +
+	"ИзраильО-в МэнИндияБританская территория в Индийском океанеИракИранИсландияИтали" +
+	"яДжерсиЯмайкаИорданияЯпонияКенияКиргизияКамбоджаКирибатиКоморские о-ваСент-Китс " +
+	"и НевисКНДРРеспублика КореяКувейтКаймановы о-ваКазахстанЛаосЛиванСент-ЛюсияЛихте" +
+	"нштейнШри-ЛанкаЛиберияЛесотоЛитваЛюксембургЛатвияЛивияМароккоМонакоМолдоваЧерног" +
+	"орияСен-МартенМадагаскарМаршалловы о-ваМакедонияМалиМьянма (Бирма)МонголияМакао " +
+	"(особый район)Северные Марианские о-ваМартиникаМавританияМонтсерратМальтаМаврики" +
+	"йМальдивские о-ваМалавиМексикаМалайзияМозамбикНамибияНовая КаледонияНигерО-в Нор" +
+	"фолкНигерияНикарагуаНидерландыНорвегияНепалНауруНиуэНовая ЗеландияОманПанамаПеру" +
+	"Французская ПолинезияПапуа – Новая ГвинеяФилиппиныПакистанПольшаСен-Пьер и Микел" +
+	"онПиткэрнПуэрто-РикоПалестинские территорииПортугалияПалауПарагвайКатарВнешняя О" +
+	"кеанияРеюньонРумынияСербияРоссияРуандаСаудовская АравияСоломоновы о-ваСейшельски" +
+	"е о-ваСуданШвецияСингапурО-в Св. ЕленыСловенияШпицберген и Ян-МайенСловакияСьерр" +
+	"а-ЛеонеСан-МариноСенегалСомалиСуринамЮжный СуданСан-Томе и ПринсипиСальвадорСинт" +
+	"-МартенСирияСвазилендТристан-да-КуньяО-ва Тёркс и КайкосЧадФранцузские Южные Тер" +
+	"риторииТогоТаиландТаджикистанТокелауВосточный ТиморТуркменистанТунисТонгаТурцияТ" +
+	"ринидад и ТобагоТувалуТайваньТанзанияУкраинаУгандаВнешние малые о-ва (США)Соедин" +
+	"енные ШтатыУругвайУзбекистанВатиканСент-Винсент и ГренадиныВенесуэлаВиргинские о" +
+	"-ва (Британские)Виргинские о-ва (США)ВьетнамВануатуУоллис и ФутунаСамоаКосовоЙем" +
+	"енМайоттаЮАРЗамбияЗимбабвеНеизвестный регионМирАфрикаСеверная АмерикаЮжная Амери" +
+	"каОкеанияЗападная АфрикаЦентральная АмерикаВосточная АфрикаСеверная АфрикаЦентра" +
+	"льная АфрикаЮжная АфрикаАмерикаСеверная Америка – США и КанадаКарибыВосточная Аз" +
+	"ияЮжная АзияЮго-Восточная АзияЮжная ЕвропаАвстралазияМеланезияМикронезияПолинези" +
+	"яАзияСредняя АзияБлижний и Средний ВостокЕвропаВосточная ЕвропаСеверная ЕвропаЗа" +
+	"падная ЕвропаЛатинская Америка"
+
+var ruRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1a, 0x28, 0x2e, 0x42, 0x62, 0x70, 0x7e, 0x8c, 0xc3, 0xcf, 0xe3,
+	0xf5, 0x118, 0x126, 0x138, 0x142, 0x15c, 0x172, 0x198, 0x1a8, 0x1ba, 0x1c8, 0x1df,
+	0x1ef, 0x1fd, 0x20b, 0x215, 0x22e, 0x24a, 0x26b, 0x279, 0x2ac, 0x2bc, 0x2d6, 0x2e0,
+	0x2ee, 0x2fe, 0x30e, 0x318, 0x324, 0x33e, 0x359, 0x35f, 0x380, 0x392, 0x3a6, 0x3b6,
+	0x3be, 0x3cc, 0x3d6, 0x3e6, 0x400, 0x413, 0x41b, 0x42e, 0x43c, 0x454, 0x45c, 0x466,
+	0x476, 0x48d, 0x49b, 0x4a5, 0x4b5, 0x4e4, 0x4ee, 0x50a, 0x518, 0x526, 0x532, 0x54f,
+	0x55d, 0x56b, 0x579, 0x598, 0x5aa, 0x5b4, 0x5d4, 0x60c, 0x626, 0x634, 0x63e, 0x65a,
+	0x668, 0x674, 0x697, 0x6a3, 0x6ab, 0x6bd, 0x6d1, 0x6dd, 0x6e9, 0x6fb, 0x724, 0x730,
+	0x774, 0x786, 0x78e, 0x7a5, 0x7b1, 0x7d9, 0x801, 0x811, 0x821, 0x82b, 0x839, 0x853,
+	0x865, 0x875, 0x883, 0x88f, 0x899, 0x8e5, 0x8ed, 0x8f5, 0x905, 0x911, 0x91d, 0x929,
+	0x939, 0x945, 0x94f, 0x95f, 0x96f, 0x97f, 0x999, 0x9b8, 0x9c0, 0x9df, 0x9eb, 0xa05,
+	0xa17, 0xa1f, 0xa29, 0xa3c, 0xa52, 0xa63, 0xa71, 0xa7d, 0xa87, 0xa9b, 0xaa7, 0xab1,
+	0xabf, 0xacb, 0xad9, 0xaed, 0xb00, 0xb14, 0xb30, 0xb42, 0xb4a, 0xb63, 0xb73, 0xb97,
+	0xbc4, 0xbd6, 0xbea, 0xbfe, 0xc0a, 0xc1a, 0xc38, 0xc44, 0xc52, 0xc62, 0xc72, 0xc80,
+	0xc9d, 0xca7, 0xcbb, 0xcc9, 0xcdb, 0xcef, 0xcff, 0xd09, 0xd13, 0xd1b, 0xd36, 0xd3e,
+	0xd4a, 0xd52, 0xd7b, 0xda1, 0xdb3, 0xdc3, 0xdcf, 0xdf0, 0xdfe, 0xe13, 0xe40, 0xe54,
+	0xe5e, 0xe6e, 0xe78, 0xe95, 0xea3, 0xeb1, 0xebd, 0xec9, 0xed5, 0xef6, 0xf12, 0xf30,
+	0xf3a, 0xf46, 0xf56, 0xf6c, 0xf7c, 0xfa3, 0xfb3, 0xfca, 0xfdd, 0xfeb, 0xff7, 0x1005,
+	0x101a, 0x103d, 0x104f, 0x1064, 0x106e, 0x1080, 0x109e, 0x10c0, 0x10c6, 0x10fc, 0x1104, 0x1112,
+	0x1128, 0x1136, 0x1153, 0x116b, 0x1175, 0x117f, 0x118b, 0x11ab, 0x11b7, 0x11c5, 0x11d5, 0x11e3,
+	0x11ef, 0x1219, 0x123a, 0x1248, 0x125c, 0x126a, 0x1297, 0x12a9, 0x12dc, 0x1301, 0x130f, 0x131d,
+	0x1339, 0x1343, 0x134f, 0x1359, 0x1367, 0x136d, 0x1379, 0x1389, 0x13ac, 0x13b2, 0x13be, 0x13dd,
+	0x13f6, 0x1404, 0x1421, 0x1446, 0x1465, 0x1482, 0x14a5, 0x14bc, 0x14ca, 0x1504, 0x1510, 0x152b,
+	0x153e, 0x1560, 0x1577, 0x158d, 0x159f, 0x15b3, 0x15c5, 0x15cd, 0x15e4, 0x1611, 0x161d, 0x163c,
+	0x1659, 0x1676, 0x1697,
+}
+
+const siRegionStr = "" +
+	"ඇසෙන්ෂන් දිවයිනඇන්ඩෝරාවඑක්සත් අරාබි එමිර් රාජ්‍යයඇෆ්ගනිස්ථානයඇන්ටිගුවා සහ බාබියු" +
+	"ඩාවඇන්ගුයිලාවඇල්බේනියාවආර්මේනියාවනෙදර්ලන්ත ඇන්ටිලීසියඇන්ගෝලාවඇන්ටාක්ටිකාවආර්ජෙන්" +
+	"ටිනාවඇමරිකානු සැමෝවාවඔස්ට්‍රියාවඕස්ට්‍රේලියාවඅරුබාවඕලන්ඩ් දූපත්අසර්බයිජානයබොස්නි" +
+	"යාව සහ හර්සගොවීනාවබාර්බඩෝස්බංග්ලාදේශයබෙල්ජියමබර්කිනා ෆාසෝබල්ගේරියාවබහරේන්බුරුන්ද" +
+	"ිබෙනින්ශාන්ත බර්තලෙමිබර්මියුඩාබෲනායිබොලීවියාවකැරිබියානු නෙදර්ලන්තයබ්‍රසීලයබහමාස්" +
+	"භූතානයබුවට් දුපත්බොට්ස්වානාබෙලරුස්බෙලීස්කැනඩාවකොකෝස් දූපත්කොංගො - කින්ශාසාමධ්‍යම" +
+	" අප්‍රිකානු ජනරජයකොංගො - බ්‍රසාවිල්ස්විස්ටර්ලන්තයකෝට් දි අයිවරිකුක් දූපත්චිලීකැම" +
+	"රූන්චීනයකොළොම්බියාවක්ලීපර්ටන් දූපතකොස්ටරිකාවකියුබාවකේප් වර්ඩ්කුරකාවෝක්‍රිස්මස් ද" +
+	"ූපතසයිප්‍රසයචෙක් ජනරජයජර්මනියදියාගෝ ගාර්සියාජිබුටිඩෙන්මාර්කයඩොමිනිකාවඩොමිනිකා ජන" +
+	"රජයඇල්ජීරියාවසෙයුටා සහ මෙලිල්ලාඉක්වදෝරයඑස්තෝනියාවඊජිප්තුවබටහිර සහරාවඑරිත්‍රියාවස" +
+	"්පාඤ්ඤයඉතියෝපියාවයුරෝපා සංගමයෆින්ලන්තයෆීජීෆෝක්ලන්ත දූපත්මයික්‍රොනීසියාවෆැරෝ දූපත" +
+	"්ප්‍රංශයගැබොන්එක්සත් රාජධානියග්‍රැනඩාවජෝර්ජියාවප්‍රංශ ගයනාවගර්න්සියඝානාවජිබ්‍රෝල" +
+	"්ටාවග්‍රීන්ලන්තයගැම්බියාවගිණියාවග්වෝඩලෝප්සමක ගිනියාවග්‍රීසියදකුණු ජෝර්ජියාව සහ ද" +
+	"කුණු සැන්ඩ්විච් දූපත්ගෝතමාලාවගුවාම්ගිනි බිසව්ගයනාවහොංකොං චීන විශේෂ පරිපාලන කලාපය" +
+	"හර්ඩ් දූපත සහ මැක්ඩොනල්ඩ් දූපත්හොන්ඩුරාස්ක්‍රොඒෂියාවහයිටිහන්ගේරියාවකැනරි සූපත්ඉන" +
+	"්දුනීසියාවඅයර්ලන්තයඊශ්‍රායලයඅයිල් ඔෆ් මෑන්ඉන්දියාවබ්‍රිතාන්‍ය ඉන්දීය සාගර බල ප්‍" +
+	"රදේශයඉරාකයඉරානයඅයිස්ලන්තයඉතාලියජර්සිජැමෙයිකාවජෝර්දානයජපානයකෙන්යාවකිර්ගිස්තානයකාම" +
+	"්බෝජයකිරිබතිකොමොරෝස්ශාන්ත කිට්ස් සහ නේවිස්උතුරු කොරියාවදකුණු කොරියාවකුවේටයකේමන් " +
+	"දූපත්කසකස්තානයලාඕසයලෙබනනයශාන්ත ලුසියාලික්ටන්ස්ටයින්ශ්‍රී ලංකාවලයිබීරියාවලෙසතෝලිත" +
+	"ුවේනියාවලක්ශම්බර්ග්ලැට්වියාවලිබියාවමොරොක්කෝවමොනැකෝවමොල්ඩෝවාවමොන්ටෙනීග්‍රෝශාන්ත ම" +
+	"ාර්ටින්මැඩගස්කරයමාෂල් දූපත්මැසිඩෝනියාවමාලිමියන්මාරය (බුරුමය)මොන්ගෝලියාවමකාවු චීන" +
+	" විශේෂ පරිපාලන කලාපයඋතුරු මරියානා දූපත්මර්ටිනික්මොරිටේනියාවමොන්සෙරාට්මෝල්ටාවමුරු" +
+	"සියමාල දිවයිනමලාවිමෙක්සිකෝවමැලේසියාවමොසැම්බික්නැමීබියාවනව කැලිඩෝනියාවනයිජර්නෝෆෝක" +
+	"් දූපතනයිජීරියාවනිකරගුවාවනෙදර්ලන්තයනෝර්වේනේපාලයනාවුරුනියූනවසීලන්තයඕමානයපැනමාවපේර" +
+	"ුප්‍රංශ පොලිනීසියාවපැපුවා නිව් ගිනියාවපිලිපීනයපාකිස්තානයපෝලන්තයශාන්ත පියරේ සහ මැ" +
+	"කෝලන්පිට්කෙය්න් දූපත්පුවර්ටෝ රිකෝපලස්තීන රාජ්‍යයපෘතුගාලයපලාවුපැරගුවේකටාර්ඈත ඕෂනි" +
+	"යාවරීයුනියන්රුමේනියාවසර්බියාවරුසියාවරුවන්ඩාවසෞදි අරාබියසොලමන් දූපත්සීශෙල්ස්සූඩාන" +
+	"යස්වීඩනයසිංගප්පූරුවශාන්ත හෙලේනාස්ලෝවේනියාවස්වෙල්බර්ඩ් සහ ජේන් මයේන්ස්ලෝවැකියාවසි" +
+	"යරාලියෝන්සැන් මැරිනෝසෙනගාලයසෝමාලියාවසුරිනාමයදකුණු සුඩානයසාඕ තෝම් සහ ප්‍රින්සිප්එ" +
+	"ල් සැල්වදෝරයශාන්ත මාර්ටෙන්සිරියාවස්වාසිලන්තයට්‍රිස්ටන් ද කුන්හාටර්ක්ස් සහ කයිකොස" +
+	"් දූපත්චැච්දකුණු ප්‍රංශ දූපත් සමූහයටොගෝතායිලන්තයටජිකිස්තානයටොකලාවුටිමෝර් - ලෙස්ත" +
+	"්ටර්ක්මෙනිස්ථානයටියුනීසියාවටොංගාතුර්කියට්‍රිනිඩෑඩ් සහ ටොබැගෝටුවාලූතායිවානයටැන්සා" +
+	"නියාවයුක්රේනයඋගන්ඩාවඑක්සත් ජනපද ඈත දූපත්එක්සත් ජනපදයඋරුගුවේඋස්බෙකිස්ථානයවතිකානු " +
+	"නගරයශාන්ත වින්සන්ට් සහ ග්‍රෙනඩින්ස්වෙනිසියුලාවබ්‍රිතාන්‍ය වර්ජින් දූපත්ඇමරිකානු " +
+	"වර්ජින් දූපත්වියට්නාමයවනුවාටුවැලිස් සහ ෆුටුනාසැමෝවාකොසෝවෝයේමනයමයෝට්දකුණු අප්‍රික" +
+	"ාවසැම්බියාවසිම්බාබ්වේහඳුනා නොගත් කළාපයලෝකයඅප්‍රිකාවඋතුරු ඇමෙරිකාවදකුණු ඇමෙරිකාවඕ" +
+	"ෂනියාවබටහිරදිග අප්‍රිකාවමධ්‍යම ඇමෙරිකාවපෙරදිග අප්‍රිකාවඋතුරුදිග අප්‍රිකාවමධ්‍යම " +
+	"අප්‍රිකාවදකුණුදිග අප්‍රිකාවඇමරිකාවඋතුරුදිග ඇමෙරිකාවකැරීබියන්නැගෙනහිර ආසියාවදකුණු" +
+	" ආසියාවඅග්නිදිග ආසියාවදකුණුදිග යුරෝපයඕස්ට්‍රලේෂියාවමෙලනීසියාවමයික්‍රෝනීසියානු කළ" +
+	"ාපයපොලිනීසියාවආසියාවමධ්‍යම ආසියාවබටහිර ආසියාවයුරෝපයනැගෙනහිර යුරෝපයඋතුරු යුරෝපයබට" +
+	"හිර යුරෝපයලතින් ඇමෙරිකාව"
+
+var siRegionIdx = []uint16{ // 291 entries
+	0x0, 0x2b, 0x43, 0x8b, 0xaf, 0xed, 0x10b, 0x129, 0x147, 0x181, 0x199, 0x1bd,
+	0x1e1, 0x20f, 0x230, 0x257, 0x269, 0x28b, 0x2ac, 0x2f0, 0x30b, 0x329, 0x341, 0x363,
+	0x381, 0x393, 0x3ab, 0x3bd, 0x3e5, 0x400, 0x412, 0x42d, 0x46a, 0x482, 0x494, 0x4a6,
+	0x4c5, 0x4e3, 0x4f8, 0x50a, 0x51c, 0x53e, 0x568, 0x5a9, 0x5d9, 0x603, 0x629, 0x645,
+	0x651, 0x666, 0x672, 0x693, 0x6be, 0x6dc, 0x6f1, 0x70d, 0x722, 0x74d, 0x768, 0x784,
+	0x799, 0x7c4, 0x7d6, 0x7f4, 0x80f, 0x837, 0x855, 0x887, 0x89f, 0x8bd, 0x8d5, 0x8f4,
+	0x915, 0x92d, 0x94b, 0x96d, 0x988, 0x994, 0x9bc, 0x9e9, 0xa05, 0xa1a, 0xa2c, 0xa57,
+	0xa72, 0xa8d, 0xaaf, 0xac7, 0xad6, 0xafa, 0xb1e, 0xb39, 0xb4e, 0xb69, 0xb88, 0xba0,
+	0xc11, 0xc29, 0xc3b, 0xc57, 0xc66, 0xcb8, 0xd0d, 0xd2b, 0xd4c, 0xd5b, 0xd79, 0xd98,
+	0xdbc, 0xdd7, 0xdf2, 0xe18, 0xe30, 0xe91, 0xea0, 0xeaf, 0xecd, 0xedf, 0xeee, 0xf09,
+	0xf21, 0xf30, 0xf45, 0xf69, 0xf81, 0xf96, 0xfae, 0xfea, 0x100f, 0x1034, 0x1046, 0x1065,
+	0x1080, 0x108f, 0x10a1, 0x10c3, 0x10ed, 0x110c, 0x112a, 0x1139, 0x115a, 0x117b, 0x1196, 0x11ab,
+	0x11c6, 0x11db, 0x11f6, 0x121d, 0x1245, 0x1260, 0x127f, 0x12a0, 0x12ac, 0x12dc, 0x12fd, 0x134c,
+	0x1381, 0x139c, 0x13bd, 0x13db, 0x13f0, 0x1405, 0x1421, 0x1430, 0x144b, 0x1466, 0x1484, 0x149f,
+	0x14c7, 0x14d9, 0x14f8, 0x1516, 0x1531, 0x154f, 0x1561, 0x1573, 0x1585, 0x1591, 0x15ac, 0x15bb,
+	0x15cd, 0x15d9, 0x160d, 0x1642, 0x165a, 0x1678, 0x168d, 0x16c9, 0x16f7, 0x1719, 0x1744, 0x175c,
+	0x176b, 0x1780, 0x178f, 0x17ab, 0x17c6, 0x17e1, 0x17f9, 0x180e, 0x1826, 0x1845, 0x1867, 0x187f,
+	0x1891, 0x18a6, 0x18c7, 0x18e9, 0x190a, 0x194f, 0x1970, 0x1991, 0x19b0, 0x19c5, 0x19e0, 0x19f8,
+	0x1a1a, 0x1a59, 0x1a7e, 0x1aa6, 0x1abb, 0x1adc, 0x1b11, 0x1b53, 0x1b5f, 0x1ba1, 0x1bad, 0x1bc8,
+	0x1be9, 0x1bfe, 0x1c25, 0x1c52, 0x1c73, 0x1c82, 0x1c97, 0x1cd2, 0x1ce4, 0x1cfc, 0x1d1d, 0x1d35,
+	0x1d4a, 0x1d80, 0x1da2, 0x1db7, 0x1dde, 0x1e00, 0x1e57, 0x1e78, 0x1ebf, 0x1efd, 0x1f18, 0x1f2d,
+	0x1f59, 0x1f6b, 0x1f7d, 0x1f8c, 0x1f9b, 0x1fc6, 0x1fe1, 0x1fff, 0x202e, 0x203a, 0x2055, 0x207d,
+	0x20a5, 0x20ba, 0x20ee, 0x2119, 0x2147, 0x217b, 0x21a9, 0x21dd, 0x21f2, 0x2223, 0x223e, 0x2269,
+	0x228b, 0x22b6, 0x22e1, 0x230b, 0x2329, 0x2369, 0x238a, 0x239c, 0x23c1, 0x23e3, 0x23f5, 0x2420,
+	0x2442, 0x2464, 0x248c,
+}
+
+const skRegionStr = "" +
+	"Ostrov AscensiónAndorraSpojené arabské emirátyAfganistanAntigua a BarbudaAnguill" +
+	"aAlbánskoArménskoHolandské AntilyAngolaAntarktídaArgentínaAmerická SamoaRakúskoA" +
+	"ustráliaArubaAlandské ostrovyAzerbajdžanBosna a HercegovinaBarbadosBangladéšBelg" +
+	"ickoBurkina FasoBulharskoBahrajnBurundiBeninSvätý BartolomejBermudyBrunejBolívia" +
+	"Karibské HolandskoBrazíliaBahamyBhutánBouvetov ostrovBotswanaBieloruskoBelizeKan" +
+	"adaKokosové ostrovyKongo - KinshasaStredoafrická republikaKongo - BrazzavilleŠva" +
+	"jčiarskoPobrežie SlonovinyCookove ostrovyČileKamerunČínaKolumbiaOstrov Clipperto" +
+	"nKostarikaKubaKapverdyCuraçaoVianočný ostrovCyprusČeská republikaNemeckoDiego Ga" +
+	"rciaDžibutskoDánskoDominikaDominikánska republikaAlžírskoCeuta a MelillaEkvádorE" +
+	"stónskoEgyptZápadná SaharaEritreaŠpanielskoEtiópiaEurópska úniaFínskoFidžiFalkla" +
+	"ndské ostrovyMikronéziaFaerské ostrovyFrancúzskoGabonSpojené kráľovstvoGrenadaGr" +
+	"uzínskoFrancúzska GuayanaGuernseyGhanaGibraltárGrónskoGambiaGuineaGuadeloupeRovn" +
+	"íková GuineaGréckoJužná Georgia a Južné Sandwichove ostrovyGuatemalaGuamGuinea-B" +
+	"issauGuayanaHongkong OAO ČínyHeardov ostrov a McDonaldove ostrovyHondurasChorvát" +
+	"skoHaitiMaďarskoKanárske ostrovyIndonéziaÍrskoIzraelOstrov ManIndiaBritské indic" +
+	"kooceánske územieIrakIránIslandTalianskoJerseyJamajkaJordánskoJaponskoKeňaKirgiz" +
+	"skoKambodžaKiribatiKomorySaint Kitts a NevisSeverná KóreaJužná KóreaKuvajtKajman" +
+	"ie ostrovyKazachstanLaosLibanonSvätá LuciaLichtenštajnskoSrí LankaLibériaLesotho" +
+	"LitvaLuxemburskoLotyšskoLíbyaMarokoMonakoMoldavskoČierna HoraSaint MartinMadagas" +
+	"karMarshallove ostrovyMacedónskoMaliMjanmarskoMongolskoMacao OAO ČínySeverné Mar" +
+	"iányMartinikMauritániaMontserratMaltaMauríciusMaldivyMalawiMexikoMalajziaMozambi" +
+	"kNamíbiaNová KaledóniaNigerNorfolkov ostrovNigériaNikaraguaHolandskoNórskoNepálN" +
+	"auruNiueNový ZélandOmánPanamaPeruFrancúzska PolynéziaPapua Nová GuineaFilipínyPa" +
+	"kistanPoľskoSaint Pierre a MiquelonPitcairnove ostrovyPortorikoPalestínske územi" +
+	"ePortugalskoPalauParaguajKatarTichomorie - ostatnéRéunionRumunskoSrbskoRuskoRwan" +
+	"daSaudská ArábiaŠalamúnove ostrovySeychelské ostrovySudánŠvédskoSingapurSvätá He" +
+	"lenaSlovinskoŠpicbergy a Jan MayenSlovenskoSierra LeoneSan MarínoSenegalSomálsko" +
+	"SurinamJužný SudánSvätý Tomáš a Princove ostrovySalvadorSint MaartenSýriaSvazijs" +
+	"koTristan da CunhaTurks a CaicosČadFrancúzske južné a antarktické územiaTogoThaj" +
+	"skoTadžikistanTokelauVýchodný TimorTurkménskoTuniskoTongaTureckoTrinidad a Tobag" +
+	"oTuvaluTaiwanTanzániaUkrajinaUgandaMenšie odľahlé ostrovy USASpojené štátyUrugua" +
+	"jUzbekistanVatikánSvätý Vincent a GrenadínyVenezuelaBritské panenské ostrovyPane" +
+	"nské ostrovy - USAVietnamVanuatuWallis a FutunaSamoaKosovoJemenMayotteJužná Afri" +
+	"kaZambiaZimbabweNeznámy regiónSvetAfrikaSeverná AmerikaJužná AmerikaOceániaZápad" +
+	"ná AfrikaStredná AmerikaVýchodná AfrikaSeverná AfrikaStredná Afrikajužné územia " +
+	"AfrikyAmerický kontinentseverné územia AmerikyKaribikVýchodná ÁziaJužná ÁziaJuho" +
+	"východná ÁziaJužná EurópaAustraláziaMelanéziaMikronézia – oblasťPolynéziaÁziaStr" +
+	"edná ÁziaZápadná ÁziaEurópaVýchodná EurópaSeverná EurópaZápadná EurópaLatinská A" +
+	"merika"
+
+var skRegionIdx = []uint16{ // 291 entries
+	0x0, 0x11, 0x18, 0x32, 0x3c, 0x4d, 0x55, 0x5e, 0x67, 0x78, 0x7e, 0x89,
+	0x93, 0xa2, 0xaa, 0xb4, 0xb9, 0xca, 0xd6, 0xe9, 0xf1, 0xfc, 0x104, 0x110,
+	0x119, 0x120, 0x127, 0x12c, 0x13e, 0x145, 0x14b, 0x153, 0x166, 0x16f, 0x175, 0x17c,
+	0x18b, 0x193, 0x19d, 0x1a3, 0x1a9, 0x1ba, 0x1ca, 0x1e2, 0x1f5, 0x202, 0x215, 0x224,
+	0x229, 0x230, 0x236, 0x23e, 0x24f, 0x258, 0x25c, 0x264, 0x26c, 0x27d, 0x283, 0x294,
+	0x29b, 0x2a7, 0x2b1, 0x2b8, 0x2c0, 0x2d7, 0x2e1, 0x2f0, 0x2f8, 0x301, 0x306, 0x316,
+	0x31d, 0x328, 0x330, 0x33f, 0x346, 0x34c, 0x360, 0x36b, 0x37b, 0x386, 0x38b, 0x3a0,
+	0x3a7, 0x3b1, 0x3c4, 0x3cc, 0x3d1, 0x3db, 0x3e3, 0x3e9, 0x3ef, 0x3f9, 0x40b, 0x412,
+	0x43f, 0x448, 0x44c, 0x459, 0x460, 0x473, 0x497, 0x49f, 0x4aa, 0x4af, 0x4b8, 0x4c9,
+	0x4d3, 0x4d9, 0x4df, 0x4e9, 0x4ee, 0x50f, 0x513, 0x518, 0x51e, 0x527, 0x52d, 0x534,
+	0x53e, 0x546, 0x54b, 0x554, 0x55d, 0x565, 0x56b, 0x57e, 0x58d, 0x59b, 0x5a1, 0x5b1,
+	0x5bb, 0x5bf, 0x5c6, 0x5d3, 0x5e3, 0x5ed, 0x5f5, 0x5fc, 0x601, 0x60c, 0x615, 0x61b,
+	0x621, 0x627, 0x630, 0x63c, 0x648, 0x652, 0x665, 0x670, 0x674, 0x67e, 0x687, 0x697,
+	0x6a8, 0x6b0, 0x6bb, 0x6c5, 0x6ca, 0x6d4, 0x6db, 0x6e1, 0x6e7, 0x6ef, 0x6f7, 0x6ff,
+	0x70f, 0x714, 0x724, 0x72c, 0x735, 0x73e, 0x745, 0x74b, 0x750, 0x754, 0x761, 0x766,
+	0x76c, 0x770, 0x786, 0x798, 0x7a1, 0x7a9, 0x7b0, 0x7c7, 0x7da, 0x7e3, 0x7f7, 0x802,
+	0x807, 0x80f, 0x814, 0x829, 0x831, 0x839, 0x83f, 0x844, 0x84a, 0x85a, 0x86e, 0x881,
+	0x887, 0x890, 0x898, 0x8a6, 0x8af, 0x8c5, 0x8ce, 0x8da, 0x8e5, 0x8ec, 0x8f5, 0x8fc,
+	0x90a, 0x92c, 0x934, 0x940, 0x946, 0x94f, 0x95f, 0x96d, 0x971, 0x99b, 0x99f, 0x9a6,
+	0x9b2, 0x9b9, 0x9c9, 0x9d4, 0x9db, 0x9e0, 0x9e7, 0x9f8, 0x9fe, 0xa04, 0xa0d, 0xa15,
+	0xa1b, 0xa38, 0xa48, 0xa4f, 0xa59, 0xa61, 0xa7d, 0xa86, 0xaa0, 0xab7, 0xabe, 0xac5,
+	0xad4, 0xad9, 0xadf, 0xae4, 0xaeb, 0xaf9, 0xaff, 0xb07, 0xb17, 0xb1b, 0xb21, 0xb31,
+	0xb40, 0xb48, 0xb58, 0xb68, 0xb79, 0xb88, 0xb97, 0xbad, 0xbc0, 0xbd8, 0xbdf, 0xbef,
+	0xbfc, 0xc10, 0xc1f, 0xc2b, 0xc35, 0xc4c, 0xc56, 0xc5b, 0xc69, 0xc78, 0xc7f, 0xc91,
+	0xca1, 0xcb2, 0xcc3,
+}
+
+const slRegionStr = "" +
+	"Otok AscensionAndoraZdruženi arabski emiratiAfganistanAntigva in BarbudaAngvilaA" +
+	"lbanijaArmenijaNizozemski AntiliAngolaAntarktikaArgentinaAmeriška SamoaAvstrijaA" +
+	"vstralijaArubaAlandsko otočjeAzerbajdžanBosna in HercegovinaBarbadosBangladešBel" +
+	"gijaBurkina FasoBolgarijaBahrajnBurundiBeninSaint BarthelemyBermudiBrunejBolivij" +
+	"aNizozemski KaribiBrazilijaBahamiButanBouvetov otokBocvanaBelorusijaBelizeKanada" +
+	"Kokosovi otokiDemokratična republika KongoCentralnoafriška republikaKongo - Braz" +
+	"zavilleŠvicaSlonokoščena obalaCookovo otočjeČileKamerunKitajskaKolumbijaOtok Cli" +
+	"ppertonKostarikaKubaZelenortski otokiCuraçaoBožični otokCiperČeškaNemčijaDiego G" +
+	"arciaDžibutiDanskaDominikaDominikanska republikaAlžirijaCeuta in MelillaEkvadorE" +
+	"stonijaEgiptZahodna SaharaEritrejaŠpanijaEtiopijaEvropska unijaFinskaFidžiFalkla" +
+	"ndski otokiMikronezijaFerski otokiFrancijaGabonVelika BritanijaGrenadaGruzijaFra" +
+	"ncoska GvajanaGuernseyGanaGibraltarGrenlandijaGambijaGvinejaGvadalupeEkvatorialn" +
+	"a GvinejaGrčijaJužna Georgia in Južni Sandwichevi otokiGvatemalaGuamGvineja Biss" +
+	"auGvajanaPosebno administrativno območje LR Kitajske Hong KongOtok Heard in otoč" +
+	"je McDonaldHondurasHrvaškaHaitiMadžarskaKanarski otokiIndonezijaIrskaIzraelOtok " +
+	"ManIndijaBritansko ozemlje v Indijskem oceanuIrakIranIslandijaItalijaJerseyJamaj" +
+	"kaJordanijaJaponskaKenijaKirgizistanKambodžaKiribatiKomoriSaint Kitts in NevisSe" +
+	"verna KorejaJužna KorejaKuvajtKajmanski otokiKazahstanLaosLibanonSaint LuciaLiht" +
+	"enštajnŠrilankaLiberijaLesotoLitvaLuksemburgLatvijaLibijaMarokoMonakoMoldavijaČr" +
+	"na goraSaint MartinMadagaskarMarshallovi otokiMakedonijaMaliMjanmar (Burma)Mongo" +
+	"lijaPosebno administrativno območje LR Kitajske MacaoSeverni Marianski otokiMart" +
+	"inikMavretanijaMontserratMaltaMauritiusMaldiviMalaviMehikaMalezijaMozambikNamibi" +
+	"jaNova KaledonijaNigerNorfolški otokNigerijaNikaragvaNizozemskaNorveškaNepalNaur" +
+	"uNiueNova ZelandijaOmanPanamaPeruFrancoska PolinezijaPapua Nova GvinejaFilipiniP" +
+	"akistanPoljskaSaint Pierre in MiquelonPitcairnPortorikoPalestinsko ozemljePortug" +
+	"alskaPalauParagvajKatarOstala oceanijaReunionRomunijaSrbijaRusijaRuandaSaudova A" +
+	"rabijaSalomonovi otokiSejšeliSudanŠvedskaSingapurSveta HelenaSlovenijaSvalbard i" +
+	"n Jan MayenSlovaškaSierra LeoneSan MarinoSenegalSomalijaSurinamJužni SudanSao To" +
+	"me in PrincipeSalvadorSint MaartenSirijaSvaziTristan da CunhaOtočji Turks in Cai" +
+	"cosČadFrancosko južno ozemljeTogoTajskaTadžikistanTokelauVzhodni TimorTurkmenist" +
+	"anTunizijaTongaTurčijaTrinidad in TobagoTuvaluTajvanTanzanijaUkrajinaUgandaDruga" +
+	" ameriška ozemlja v Tihem oceanuZdružene države AmerikeUrugvajUzbekistanVatikanS" +
+	"aint Vincent in GrenadineVenezuelaBritanski Deviški otokiAmeriški Deviški otokiV" +
+	"ietnamVanuatuWallis in FutunaSamoaKosovoJemenMayotteJužnoafriška republikaZambij" +
+	"aZimbabveNeznano ali neveljavno območjeSvetAfrikaSeverna AmerikaJužna AmerikaOce" +
+	"anijaZahodna AfrikaSrednja AmerikaVzhodna AfrikaSeverna AfrikaSrednja AfrikaJužn" +
+	"a AfrikaAmerikesevernoameriška celinaKaribiVzhodna AzijaJužna AzijaJugovzhodna A" +
+	"zijaJužna EvropaAvstralija in Nova ZelandijaMelanezijamikronezijska regijaPoline" +
+	"zijaAzijaOsrednja AzijaZahodna AzijaEvropaVzhodna EvropaSeverna EvropaZahodna Ev" +
+	"ropaLatinska Amerika"
+
+var slRegionIdx = []uint16{ // 291 entries
+	0x0, 0xe, 0x14, 0x2d, 0x37, 0x49, 0x50, 0x58, 0x60, 0x71, 0x77, 0x81,
+	0x8a, 0x99, 0xa1, 0xab, 0xb0, 0xc0, 0xcc, 0xe0, 0xe8, 0xf2, 0xf9, 0x105,
+	0x10e, 0x115, 0x11c, 0x121, 0x131, 0x138, 0x13e, 0x146, 0x157, 0x160, 0x166, 0x16b,
+	0x178, 0x17f, 0x189, 0x18f, 0x195, 0x1a3, 0x1c0, 0x1db, 0x1ee, 0x1f4, 0x208, 0x217,
+	0x21c, 0x223, 0x22b, 0x234, 0x243, 0x24c, 0x250, 0x261, 0x269, 0x277, 0x27c, 0x283,
+	0x28b, 0x297, 0x29f, 0x2a5, 0x2ad, 0x2c3, 0x2cc, 0x2dc, 0x2e3, 0x2eb, 0x2f0, 0x2fe,
+	0x306, 0x30e, 0x316, 0x324, 0x32a, 0x330, 0x341, 0x34c, 0x358, 0x360, 0x365, 0x375,
+	0x37c, 0x383, 0x394, 0x39c, 0x3a0, 0x3a9, 0x3b4, 0x3bb, 0x3c2, 0x3cb, 0x3df, 0x3e6,
+	0x410, 0x419, 0x41d, 0x42b, 0x432, 0x468, 0x486, 0x48e, 0x496, 0x49b, 0x4a5, 0x4b3,
+	0x4bd, 0x4c2, 0x4c8, 0x4d0, 0x4d6, 0x4fa, 0x4fe, 0x502, 0x50b, 0x512, 0x518, 0x51f,
+	0x528, 0x530, 0x536, 0x541, 0x54a, 0x552, 0x558, 0x56c, 0x57a, 0x587, 0x58d, 0x59c,
+	0x5a5, 0x5a9, 0x5b0, 0x5bb, 0x5c7, 0x5d0, 0x5d8, 0x5de, 0x5e3, 0x5ed, 0x5f4, 0x5fa,
+	0x600, 0x606, 0x60f, 0x619, 0x625, 0x62f, 0x640, 0x64a, 0x64e, 0x65d, 0x666, 0x698,
+	0x6af, 0x6b7, 0x6c2, 0x6cc, 0x6d1, 0x6da, 0x6e1, 0x6e7, 0x6ed, 0x6f5, 0x6fd, 0x705,
+	0x714, 0x719, 0x728, 0x730, 0x739, 0x743, 0x74c, 0x751, 0x756, 0x75a, 0x768, 0x76c,
+	0x772, 0x776, 0x78a, 0x79c, 0x7a4, 0x7ac, 0x7b3, 0x7cb, 0x7d3, 0x7dc, 0x7ef, 0x7fa,
+	0x7ff, 0x807, 0x80c, 0x81b, 0x822, 0x82a, 0x830, 0x836, 0x83c, 0x84b, 0x85b, 0x863,
+	0x868, 0x870, 0x878, 0x884, 0x88d, 0x8a2, 0x8ab, 0x8b7, 0x8c1, 0x8c8, 0x8d0, 0x8d7,
+	0x8e3, 0x8f7, 0x8ff, 0x90b, 0x911, 0x916, 0x926, 0x93d, 0x941, 0x959, 0x95d, 0x963,
+	0x96f, 0x976, 0x983, 0x98f, 0x997, 0x99c, 0x9a4, 0x9b6, 0x9bc, 0x9c2, 0x9cb, 0x9d3,
+	0x9d9, 0x9ff, 0xa18, 0xa1f, 0xa29, 0xa30, 0xa4a, 0xa53, 0xa6b, 0xa83, 0xa8a, 0xa91,
+	0xaa1, 0xaa6, 0xaac, 0xab1, 0xab8, 0xad0, 0xad7, 0xadf, 0xafe, 0xb02, 0xb08, 0xb17,
+	0xb25, 0xb2d, 0xb3b, 0xb4a, 0xb58, 0xb66, 0xb74, 0xb81, 0xb88, 0xb9f, 0xba5, 0xbb2,
+	0xbbe, 0xbcf, 0xbdc, 0xbf8, 0xc02, 0xc16, 0xc20, 0xc25, 0xc33, 0xc40, 0xc46, 0xc54,
+	0xc62, 0xc70, 0xc80,
+}
+
+const sqRegionStr = "" +
+	"Ishulli AsenshionAndorrëEmiratet e Bashkuara ArabeAfganistanAntigua e BarbudaAng" +
+	"uilëShqipëriArmeniAngolëAntarktikëArgjentinëSamoa AmerikaneAustriAustraliArubëIs" +
+	"hujt AlandAzerbajxhanBosnjë e HercegovinëBarbadosBangladeshBelgjikëBurkina FasoB" +
+	"ullgariBahreinBurundBeninShën BartolemeoBermudëBrunejBoliviKaraibet HolandezeBra" +
+	"zilBahamasButanIshujt BoveBotsvanëBjellorusiBelizKanadaIshujt KokosKongo-Kinshas" +
+	"aRepublika Afrikano-QendroreKongo-BrazavilëZvicërBregu i FildishtëIshujt KukKili" +
+	"KamerunKinëKolumbiIshulli KlipërtonKosta RikaKubëKepi i GjelbërKuraçaoIshulli i " +
+	"KrishtlindjesQiproRepublika ÇekeGjermaniDiego GarsiaXhibutDanimarkëDominikëRepub" +
+	"lika DominikaneAlgjeriTheuta e MelilaEkuadorEstoniEgjiptSaharaja PerëndimoreErit" +
+	"reSpanjëEtiopiBashkimi EuropianFinlandëFixhiIshujt FolklandMikroneziIshujt Faroe" +
+	"FrancëGabonMbretëria e BashkuarGrenadëGjeorgjiGuajana FrancezeGuernsejGanëGjibra" +
+	"ltarGrenlandëGambiGuineGuadalupeGuineja EkuatorialeGreqiXhorxha Jugore dhe Ishuj" +
+	"t Senduiç të JugutGuatemalëGuamGuine-BisauGuajanëRVAK i Hong KongutIshulli Hërd " +
+	"dhe Ishujt MekdonaldHondurasKroaciHaitiHungariIshujt KanarieIndoneziIrlandëIzrae" +
+	"lIshulli i ManitIndiTerritori Britanik i Oqeanit IndianIrakIranIslandëItaliXhers" +
+	"ejXhamajkëJordaniJaponiKeniaKirgistanKamboxhiaQiribatiKomoreShën Kits e NevisKor" +
+	"eja e VeriutKoreja e JugutKuvajtIshujt KajmanKazakistanLaosLibanShën LuçiaLihtën" +
+	"shtajnSri LankëLiberiLesotoLituaniLuksemburgLetoniLibiMarokMonakoMoldaviMali i Z" +
+	"iShën MartinMadagaskarIshujt MarshallMaqedoniMaliMianmar (Burma)MongoliRVAK i Ma" +
+	"kaosIshujt e Marianës VerioreIshulli i MartinikutMauritaniIshulli i MontseratitM" +
+	"altëMauritiusMaldiveMalaviMeksikëMalajziMozambikNamibiKaledonia e ReNigerIshujt " +
+	"NorfolkNigeriNikaraguaHolandëNorvegjiNepalNauruNiueZelanda e ReOmanPanamaPeruPol" +
+	"inezia FrancezePapua Guineja e ReFilipinePakistanPoloniShën Peir dhe MikuelonIsh" +
+	"ujt PitkernPorto RikoTerritoret palestinezePortugaliPalauParaguaiKatarOqeania e " +
+	"Largët (Lindja e Largët)ReunionRumaniSerbiRusiRuandëArabi SauditeIshujt SolomonS" +
+	"ishelSudanSuediSingaporShën HelenaSlloveniSvalbard e Zhan MajenSllovakiSiera Leo" +
+	"neSan MarinoSenegaliSomaliSurinamiSudani i JugutSao Tome e PrinsipeEl SalvadorSh" +
+	"ën Martin (Sint Maarten-pjesa e Mbretërisë së Holandës)SiriSvazilandëTristan da " +
+	"KunaIshujt Turke dhe KaikeÇadTerritoret Australiane FrancezeTogoTajlandëTaxhikis" +
+	"tanTokelauTimori LindorTurkmenistanTuniziTongaTurqiTrinidad e TobagoTuvaluTajvan" +
+	"TanzaniUkrainëUgandëIshujt periferikë të SHBA-sëShtetet e Bashkuara të AmerikësU" +
+	"ruguaiUzbekistanVatikanShën Vinsent dhe GrenadinetVenezuelëIshujt e Virgjër Brit" +
+	"anikëIshujt e Virgjër AmerikanëVietnamVanuatuUollis e FutinaSamoaKosovëJemenMajo" +
+	"tëAfrika e JugutZambiZimbabveRajon i panjohurBotëAfrikëAmerika e VeriutAmerika e" +
+	" JugutOqeaniAfrika PerëndimoreAmerika QendroreAfrika LindoreAfrika VerioreAfrika" +
+	" e MesmeAfrika JugoreAmerikëAmerika VerioreKaraibeAzia LindoreAzia JugoreAzia Ju" +
+	"g-LindoreEuropa JugoreAustralaziaMelaneziaRajoni MikronezianPolineziaAziAzia Qen" +
+	"droreAzia PerëndimoreEuropëEuropa LindoreEuropa VerioreEuropa PerëndimoreAmerika" +
+	" Latine"
+
+var sqRegionIdx = []uint16{ // 291 entries
+	0x0, 0x11, 0x19, 0x33, 0x3d, 0x4e, 0x56, 0x5f, 0x65, 0x65, 0x6c, 0x77,
+	0x82, 0x91, 0x97, 0x9f, 0xa5, 0xb1, 0xbc, 0xd2, 0xda, 0xe4, 0xed, 0xf9,
+	0x101, 0x108, 0x10e, 0x113, 0x123, 0x12b, 0x131, 0x137, 0x149, 0x14f, 0x156, 0x15b,
+	0x166, 0x16f, 0x179, 0x17e, 0x184, 0x190, 0x19e, 0x1b9, 0x1c9, 0x1d0, 0x1e2, 0x1ec,
+	0x1f0, 0x1f7, 0x1fc, 0x203, 0x215, 0x21f, 0x224, 0x233, 0x23b, 0x252, 0x257, 0x266,
+	0x26e, 0x27a, 0x280, 0x28a, 0x293, 0x2a7, 0x2ae, 0x2bd, 0x2c4, 0x2ca, 0x2d0, 0x2e5,
+	0x2eb, 0x2f2, 0x2f8, 0x309, 0x312, 0x317, 0x326, 0x32f, 0x33b, 0x342, 0x347, 0x35c,
+	0x364, 0x36c, 0x37c, 0x384, 0x389, 0x393, 0x39d, 0x3a2, 0x3a7, 0x3b0, 0x3c3, 0x3c8,
+	0x3f4, 0x3fe, 0x402, 0x40d, 0x415, 0x427, 0x449, 0x451, 0x457, 0x45c, 0x463, 0x471,
+	0x479, 0x481, 0x487, 0x496, 0x49a, 0x4bd, 0x4c1, 0x4c5, 0x4cd, 0x4d2, 0x4d9, 0x4e2,
+	0x4e9, 0x4ef, 0x4f4, 0x4fd, 0x506, 0x50e, 0x514, 0x526, 0x535, 0x543, 0x549, 0x556,
+	0x560, 0x564, 0x569, 0x575, 0x582, 0x58c, 0x592, 0x598, 0x59f, 0x5a9, 0x5af, 0x5b3,
+	0x5b8, 0x5be, 0x5c5, 0x5ce, 0x5da, 0x5e4, 0x5f3, 0x5fb, 0x5ff, 0x60e, 0x615, 0x622,
+	0x63c, 0x650, 0x659, 0x66e, 0x674, 0x67d, 0x684, 0x68a, 0x692, 0x699, 0x6a1, 0x6a7,
+	0x6b5, 0x6ba, 0x6c8, 0x6ce, 0x6d7, 0x6df, 0x6e7, 0x6ec, 0x6f1, 0x6f5, 0x701, 0x705,
+	0x70b, 0x70f, 0x721, 0x733, 0x73b, 0x743, 0x749, 0x760, 0x76e, 0x778, 0x78e, 0x797,
+	0x79c, 0x7a4, 0x7a9, 0x7cd, 0x7d4, 0x7da, 0x7df, 0x7e3, 0x7ea, 0x7f7, 0x805, 0x80b,
+	0x810, 0x815, 0x81d, 0x829, 0x831, 0x846, 0x84e, 0x859, 0x863, 0x86b, 0x871, 0x879,
+	0x887, 0x89a, 0x8a5, 0x8e3, 0x8e7, 0x8f2, 0x901, 0x917, 0x91b, 0x93a, 0x93e, 0x947,
+	0x952, 0x959, 0x966, 0x972, 0x978, 0x97d, 0x982, 0x993, 0x999, 0x99f, 0x9a6, 0x9ae,
+	0x9b5, 0x9d4, 0x9f5, 0x9fc, 0xa06, 0xa0d, 0xa29, 0xa33, 0xa4f, 0xa6b, 0xa72, 0xa79,
+	0xa88, 0xa8d, 0xa94, 0xa99, 0xaa0, 0xaae, 0xab3, 0xabb, 0xacb, 0xad0, 0xad7, 0xae7,
+	0xaf6, 0xafc, 0xb0f, 0xb1f, 0xb2d, 0xb3b, 0xb49, 0xb56, 0xb5e, 0xb6d, 0xb74, 0xb80,
+	0xb8b, 0xb9b, 0xba8, 0xbb3, 0xbbc, 0xbce, 0xbd7, 0xbda, 0xbe7, 0xbf8, 0xbff, 0xc0d,
+	0xc1b, 0xc2e, 0xc3c,
+}
+
+const srRegionStr = "" +
+	"Острво АсенсионАндораУједињени Арапски ЕмиратиАвганистанАнтигве и БарбудаАнгвила" +
+	"АлбанијаАрменијаХоландски АнтилиАнголаАнтарктикАргентинаАмеричка СамоаАустријаАу" +
+	"стралијаАрубаАландска острваАзербејџанБосна и ХерцеговинаБарбадосБангладешБелгиј" +
+	"аБуркина ФасоБугарскаБахреинБурундиБенинСвети БартоломејБермудаБрунејБоливијаКар" +
+	"ипска ХоландијаБразилБахамиБутанБуве ОстрваБоцванаБелорусијаБелизеКанадаКокос (К" +
+	"елинг) ОстрваКонго - КиншасаЦентрално Афричка РепубликаКонго - БразавилШвајцарск" +
+	"аОбала СлоновачеКукова ОстрваЧилеКамерунКинаКолумбијаОстрво КлипертонКостарикаКу" +
+	"баКапе ВердеКурасаоБожићна острваКипарЧешкаНемачкаДијего ГарсијаЏибутиДанскаДоми" +
+	"никаДоминиканска РепубликаАлжирСеута и МелиљаЕквадорЕстонијаЕгипатЗападна Сахара" +
+	"ЕритрејаШпанијаЕтиопијаЕвропска УнијаФинскаФиџиФокландска острваМикронезијаФарск" +
+	"а ОстрваФранцускаГабонВелика БританијаГренадаГрузијаФранцуска ГвајанаГурнсиГанаГ" +
+	"ибралтарГренландГамбијаГвинејаГваделупеЕкваторијална ГвинејаГрчкаЈужна Џорџија и" +
+	" Јужна Сендвич ОстрваГватемалаГуамГвинеја-БисаоГвајанаХонг Конг С. А. Р. КинаХер" +
+	"д и Мекдоналд ОстрваХондурасХрватскаХаитиМађарскаКанарска острваИндонезијаИрскаИ" +
+	"зраелОстрво МанИндијаБританска територија у Индијском океануИракИранИсландИталиј" +
+	"аЏерсиЈамајкаЈорданЈапанКенијаКиргизстанКамбоџаКирибатиКоморска ОстрваСент Китс " +
+	"и НевисСеверна КорејаЈужна КорејаКувајтКајманска ОстрваКазахстанЛаосЛибанСент Лу" +
+	"цијаЛихтенштајнШри ЛанкаЛиберијаЛесотоЛитванијаЛуксембургЛетонијаЛибијаМарокоМон" +
+	"акоМолдавијаЦрна ГораСент МартинМадагаскарМаршалска ОстрваМакедонијаМалиМијанмар" +
+	" (Бурма)МонголијаМакао С. А. Р. КинаСеверна Маријанска ОстрваМартиникМауританија" +
+	"МонсератМалтаМаурицијусМалдивиМалавиМексикоМалезијаМозамбикНамибијаНова Каледони" +
+	"јаНигерНорфолк ОстрвоНигеријаНикарагваХоландијаНорвешкаНепалНауруНиуеНови Зеланд" +
+	"ОманПанамаПеруФранцуска ПолинезијаПапуа Нова ГвинејаФилипиниПакистанПољскаСен Пј" +
+	"ер и МикелонПиткернПорто РикоПалестинске територијеПортугалПалауПарагвајКатарОст" +
+	"ала океанијаРеинионРумунијаСрбијаРусијаРуандаСаудијска АрабијаСоломонска ОстрваС" +
+	"ејшелиСуданШведскаСингапурСвета ЈеленаСловенијаСвалбард и Јанмајен ОстрваСловачк" +
+	"аСијера ЛеонеСан МариноСенегалСомалијаСуринамЈужни СуданСао Томе и ПринципеСалва" +
+	"дорСвети МартинСиријаСвазилендТристан да КуњаТуркс и Кајкос ОстрваЧадФранцуске Ј" +
+	"ужне ТериторијеТогоТајландТаџикистанТокелауИсточни ТиморТуркменистанТунисТонгаТу" +
+	"рскаТринидад и ТобагоТувалуТајванТанзанијаУкрајинаУгандаМања удаљена острва САДС" +
+	"једињене Америчке ДржавеУругвајУзбекистанВатиканСент Винсент и ГренадиниВенецуел" +
+	"аБританска Девичанска ОстрваС.А.Д. Девичанска ОстрваВијетнамВануатуВалис и Футун" +
+	"а ОстрваСамоаКосовоЈеменМајотеЈужноафричка РепубликаЗамбијаЗимбабвеНепозната или" +
+	" неважећа областСветАфрикаСеверноамерички континентЈужна АмерикаОкеанијаЗападна " +
+	"АфрикаЦентрална АмерикаИсточна АфрикаСеверна АфрикаЦентрална АфрикаЈужна АфрикаА" +
+	"мерикеСеверна АмерикаКарибиИсточна АзијаЈужна АзијаЈугоисточна АзијаЈужна Европа" +
+	"Аустралија и Нови ЗеландМеланезијаМикронезијски регионПолинезијаАзијаЦентрална А" +
+	"зијаЗападна АзијаЕвропаИсточна ЕвропаСеверна ЕвропаЗападна ЕвропаЛатинска Америк" +
+	"а"
+
+var srRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1d, 0x29, 0x59, 0x6d, 0x8d, 0x9b, 0xab, 0xbb, 0xda, 0xe6, 0xf8,
+	0x10a, 0x125, 0x135, 0x149, 0x153, 0x170, 0x184, 0x1a8, 0x1b8, 0x1ca, 0x1d8, 0x1ef,
+	0x1ff, 0x20d, 0x21b, 0x225, 0x244, 0x252, 0x25e, 0x26e, 0x291, 0x29d, 0x2a9, 0x2b3,
+	0x2c8, 0x2d6, 0x2ea, 0x2f6, 0x302, 0x328, 0x343, 0x377, 0x394, 0x3a8, 0x3c5, 0x3de,
+	0x3e6, 0x3f4, 0x3fc, 0x40e, 0x42d, 0x43f, 0x447, 0x45a, 0x468, 0x483, 0x48d, 0x497,
+	0x4a5, 0x4c0, 0x4cc, 0x4d8, 0x4e8, 0x513, 0x51d, 0x537, 0x545, 0x555, 0x561, 0x57c,
+	0x58c, 0x59a, 0x5aa, 0x5c5, 0x5d1, 0x5d9, 0x5fa, 0x610, 0x629, 0x63b, 0x645, 0x664,
+	0x672, 0x680, 0x6a1, 0x6ad, 0x6b5, 0x6c7, 0x6d7, 0x6e5, 0x6f3, 0x705, 0x72e, 0x738,
+	0x77b, 0x78d, 0x795, 0x7ae, 0x7bc, 0x7e2, 0x80d, 0x81d, 0x82d, 0x837, 0x847, 0x864,
+	0x878, 0x882, 0x88e, 0x8a1, 0x8ad, 0x8f7, 0x8ff, 0x907, 0x913, 0x921, 0x92b, 0x939,
+	0x945, 0x94f, 0x95b, 0x96f, 0x97d, 0x98d, 0x9aa, 0x9c9, 0x9e4, 0x9fb, 0xa07, 0xa26,
+	0xa38, 0xa40, 0xa4a, 0xa5f, 0xa75, 0xa86, 0xa96, 0xaa2, 0xab4, 0xac8, 0xad8, 0xae4,
+	0xaf0, 0xafc, 0xb0e, 0xb1f, 0xb34, 0xb48, 0xb67, 0xb7b, 0xb83, 0xba0, 0xbb2, 0xbd1,
+	0xc01, 0xc11, 0xc27, 0xc37, 0xc41, 0xc55, 0xc63, 0xc6f, 0xc7d, 0xc8d, 0xc9d, 0xcad,
+	0xcca, 0xcd4, 0xcef, 0xcff, 0xd11, 0xd23, 0xd33, 0xd3d, 0xd47, 0xd4f, 0xd64, 0xd6c,
+	0xd78, 0xd80, 0xda7, 0xdc9, 0xdd9, 0xde9, 0xdf5, 0xe16, 0xe24, 0xe37, 0xe62, 0xe72,
+	0xe7c, 0xe8c, 0xe96, 0xeb3, 0xec1, 0xed1, 0xedd, 0xee9, 0xef5, 0xf16, 0xf37, 0xf45,
+	0xf4f, 0xf5d, 0xf6d, 0xf84, 0xf96, 0xfc7, 0xfd7, 0xfee, 0x1001, 0x100f, 0x101f, 0x102d,
+	0x1042, 0x1065, 0x1075, 0x108c, 0x1098, 0x10aa, 0x10c6, 0x10ed, 0x10f3, 0x1125, 0x112d, 0x113b,
+	0x114f, 0x115d, 0x1176, 0x118e, 0x1198, 0x11a2, 0x11ae, 0x11ce, 0x11da, 0x11e6, 0x11f8, 0x1208,
+	0x1214, 0x123f, 0x126f, 0x127d, 0x1291, 0x129f, 0x12cc, 0x12de, 0x1312, 0x133d, 0x134d, 0x135b,
+	0x1382, 0x138c, 0x1398, 0x13a2, 0x13ae, 0x13d9, 0x13e7, 0x13f7, 0x142e, 0x1436, 0x1442, 0x1473,
+	0x148c, 0x149c, 0x14b7, 0x14d8, 0x14f3, 0x150e, 0x152d, 0x1544, 0x1552, 0x156f, 0x157b, 0x1594,
+	0x15a9, 0x15ca, 0x15e1, 0x160e, 0x1622, 0x1649, 0x165d, 0x1667, 0x1684, 0x169d, 0x16a9, 0x16c4,
+	0x16df, 0x16fa, 0x1719,
+}
+
+const svRegionStr = "" +
+	"AscensionAndorraFörenade ArabemiratenAfghanistanAntigua och BarbudaAnguillaAlban" +
+	"ienArmenienNederländska AntillernaAngolaAntarktisArgentinaAmerikanska SamoaÖster" +
+	"rikeAustralienArubaÅlandAzerbajdzjanBosnien och HercegovinaBarbadosBangladeshBel" +
+	"gienBurkina FasoBulgarienBahrainBurundiBeninS:t BarthélemyBermudaBruneiBoliviaKa" +
+	"ribiska NederländernaBrasilienBahamasBhutanBouvetönBotswanaVitrysslandBelizeKana" +
+	"daKokosöarnaKongo-KinshasaCentralafrikanska republikenKongo-BrazzavilleSchweizEl" +
+	"fenbenskustenCooköarnaChileKamerunKinaColombiaClippertonönCosta RicaKubaKap Verd" +
+	"eCuraçaoJulönCypernTjeckienTysklandDiego GarciaDjiboutiDanmarkDominicaDominikans" +
+	"ka republikenAlgerietCeuta och MelillaEcuadorEstlandEgyptenVästsaharaEritreaSpan" +
+	"ienEtiopienEuropeiska unionenFinlandFijiFalklandsöarnaMikronesienFäröarnaFrankri" +
+	"keGabonStorbritannienGrenadaGeorgienFranska GuyanaGuernseyGhanaGibraltarGrönland" +
+	"GambiaGuineaGuadeloupeEkvatorialguineaGreklandSydgeorgien och SydsandwichöarnaGu" +
+	"atemalaGuamGuinea-BissauGuyanaHongkong (S.A.R. Kina)Heardön och McDonaldöarnaHon" +
+	"durasKroatienHaitiUngernKanarieöarnaIndonesienIrlandIsraelIsle of ManIndienBritt" +
+	"iska territoriet i Indiska oceanenIrakIranIslandItalienJerseyJamaicaJordanienJap" +
+	"anKenyaKirgizistanKambodjaKiribatiKomorernaS:t Kitts och NevisNordkoreaSydkoreaK" +
+	"uwaitCaymanöarnaKazakstanLaosLibanonS:t LuciaLiechtensteinSri LankaLiberiaLesoth" +
+	"oLitauenLuxemburgLettlandLibyenMarockoMonacoMoldavienMontenegroS:t MartinMadagas" +
+	"karMarshallöarnaMakedonienMaliMyanmar (Burma)MongolietMacao (S.A.R. Kina)Nordmar" +
+	"ianernaMartiniqueMauretanienMontserratMaltaMauritiusMaldivernaMalawiMexikoMalays" +
+	"iaMoçambiqueNamibiaNya KaledonienNigerNorfolkönNigeriaNicaraguaNederländernaNorg" +
+	"eNepalNauruNiueNya ZeelandOmanPanamaPeruFranska PolynesienPapua Nya GuineaFilipp" +
+	"inernaPakistanPolenS:t Pierre och MiquelonPitcairnöarnaPuerto RicoPalestinska te" +
+	"rritoriernaPortugalPalauParaguayQataryttre öar i OceanienRéunionRumänienSerbienR" +
+	"ysslandRwandaSaudiarabienSalomonöarnaSeychellernaSudanSverigeSingaporeS:t Helena" +
+	"SlovenienSvalbard och Jan MayenSlovakienSierra LeoneSan MarinoSenegalSomaliaSuri" +
+	"namSydsudanSão Tomé och PríncipeEl SalvadorSint MaartenSyrienSwazilandTristan da" +
+	" CunhaTurks- och CaicosöarnaTchadFranska sydterritoriernaTogoThailandTadzjikista" +
+	"nTokelauÖsttimorTurkmenistanTunisienTongaTurkietTrinidad och TobagoTuvaluTaiwanT" +
+	"anzaniaUkrainaUgandaUSA:s yttre öarUSAUruguayUzbekistanVatikanstatenS:t Vincent " +
+	"och GrenadinernaVenezuelaBrittiska JungfruöarnaAmerikanska JungfruöarnaVietnamVa" +
+	"nuatuWallis- och FutunaöarnaSamoaKosovoJemenMayotteSydafrikaZambiaZimbabweokänd " +
+	"regionvärldenAfrikaNordamerikaSydamerikaOceanienVästafrikaCentralamerikaÖstafrik" +
+	"aNordafrikaCentralafrikasödra AfrikaNord- och Sydamerikanorra AmerikaKaribienÖst" +
+	"asienSydasienSydostasienSydeuropaAustralasienMelanesienMikronesiska öarnaPolynes" +
+	"ienAsienCentralasienVästasienEuropaÖsteuropaNordeuropaVästeuropaLatinamerika"
+
+var svRegionIdx = []uint16{ // 291 entries
+	0x0, 0x9, 0x10, 0x26, 0x31, 0x44, 0x4c, 0x54, 0x5c, 0x74, 0x7a, 0x83,
+	0x8c, 0x9d, 0xa7, 0xb1, 0xb6, 0xbc, 0xc8, 0xdf, 0xe7, 0xf1, 0xf8, 0x104,
+	0x10d, 0x114, 0x11b, 0x120, 0x12f, 0x136, 0x13c, 0x143, 0x15b, 0x164, 0x16b, 0x171,
+	0x17a, 0x182, 0x18d, 0x193, 0x199, 0x1a4, 0x1b2, 0x1ce, 0x1df, 0x1e6, 0x1f5, 0x1ff,
+	0x204, 0x20b, 0x20f, 0x217, 0x224, 0x22e, 0x232, 0x23b, 0x243, 0x249, 0x24f, 0x257,
+	0x25f, 0x26b, 0x273, 0x27a, 0x282, 0x299, 0x2a1, 0x2b2, 0x2b9, 0x2c0, 0x2c7, 0x2d2,
+	0x2d9, 0x2e0, 0x2e8, 0x2fa, 0x301, 0x305, 0x314, 0x31f, 0x329, 0x332, 0x337, 0x345,
+	0x34c, 0x354, 0x362, 0x36a, 0x36f, 0x378, 0x381, 0x387, 0x38d, 0x397, 0x3a7, 0x3af,
+	0x3d0, 0x3d9, 0x3dd, 0x3ea, 0x3f0, 0x406, 0x421, 0x429, 0x431, 0x436, 0x43c, 0x449,
+	0x453, 0x459, 0x45f, 0x46a, 0x470, 0x497, 0x49b, 0x49f, 0x4a5, 0x4ac, 0x4b2, 0x4b9,
+	0x4c2, 0x4c7, 0x4cc, 0x4d7, 0x4df, 0x4e7, 0x4f0, 0x503, 0x50c, 0x514, 0x51a, 0x526,
+	0x52f, 0x533, 0x53a, 0x543, 0x550, 0x559, 0x560, 0x567, 0x56e, 0x577, 0x57f, 0x585,
+	0x58c, 0x592, 0x59b, 0x5a5, 0x5af, 0x5b9, 0x5c7, 0x5d1, 0x5d5, 0x5e4, 0x5ed, 0x600,
+	0x60e, 0x618, 0x623, 0x62d, 0x632, 0x63b, 0x645, 0x64b, 0x651, 0x659, 0x664, 0x66b,
+	0x679, 0x67e, 0x688, 0x68f, 0x698, 0x6a6, 0x6ab, 0x6b0, 0x6b5, 0x6b9, 0x6c4, 0x6c8,
+	0x6ce, 0x6d2, 0x6e4, 0x6f4, 0x700, 0x708, 0x70d, 0x724, 0x732, 0x73d, 0x756, 0x75e,
+	0x763, 0x76b, 0x770, 0x785, 0x78d, 0x796, 0x79d, 0x7a5, 0x7ab, 0x7b7, 0x7c4, 0x7d0,
+	0x7d5, 0x7dc, 0x7e5, 0x7ef, 0x7f8, 0x80e, 0x817, 0x823, 0x82d, 0x834, 0x83b, 0x842,
+	0x84a, 0x862, 0x86d, 0x879, 0x87f, 0x888, 0x898, 0x8af, 0x8b4, 0x8cc, 0x8d0, 0x8d8,
+	0x8e4, 0x8eb, 0x8f4, 0x900, 0x908, 0x90d, 0x914, 0x927, 0x92d, 0x933, 0x93b, 0x942,
+	0x948, 0x958, 0x95b, 0x962, 0x96c, 0x979, 0x995, 0x99e, 0x9b5, 0x9ce, 0x9d5, 0x9dc,
+	0x9f4, 0x9f9, 0x9ff, 0xa04, 0xa0b, 0xa14, 0xa1a, 0xa22, 0xa2f, 0xa37, 0xa3d, 0xa48,
+	0xa52, 0xa5a, 0xa65, 0xa73, 0xa7d, 0xa87, 0xa94, 0xaa1, 0xab5, 0xac2, 0xaca, 0xad3,
+	0xadb, 0xae6, 0xaef, 0xafb, 0xb05, 0xb18, 0xb22, 0xb27, 0xb33, 0xb3d, 0xb43, 0xb4d,
+	0xb57, 0xb62, 0xb6e,
+}
+
+const swRegionStr = "" +
+	"Kisiwa cha AscensionAndoraFalme za KiarabuAfuganistaniAntigua na BarbudaAnguilla" +
+	"AlbaniaArmeniaAntili za UholanziAngolaAntaktikaAjentinaSamoa ya MarekaniAustriaA" +
+	"ustraliaArubaVisiwa vya AlandiAzabajaniBosnia na HezegovinaBabadosiBangladeshiUb" +
+	"elgijiBukinafasoBulgariaBahareniBurundiBeniniSantabathelemiBermudaBruneiBoliviaU" +
+	"holanzi ya KaribianiBraziliBahamaButaniKisiwa cha BouvetBotswanaBelarusiBelizeKa" +
+	"nadaVisiwa vya Cocos (Keeling)Jamhuri ya Kidemokrasia ya KongoJamhuri ya Afrika " +
+	"ya KatiKongo - BrazzavilleUswisiCôte d’IvoireVisiwa vya CookChileKameruniChinaKo" +
+	"lombiaKisiwa cha ClippertonKostarikaKubaKepuvedeKurakaoKisiwa cha KrismasiKupros" +
+	"iJamhuri ya ChekiUjerumaniDiego GarciaJibutiDenmakiDominikaJamhuri ya DominikaAl" +
+	"jeriaCeuta na MelillaEkwadoEstoniaMisriSahara MagharibiEritreaHispaniaUhabeshiUm" +
+	"oja wa UlayaUfiniFijiVisiwa vya FalklandMikronesiaVisiwa vya FaroeUfaransaGaboni" +
+	"UingerezaGrenadaJojiaGwiyana ya UfaransaGuernseyGhanaJibraltaGrinlandiGambiaGine" +
+	"GwadelupeGinekwetaUgirikiJojia Kusini na Visiwa vya Sandwich KusiniGwatemalaGwam" +
+	"GinebisauGuyanaHong Kong SAR ChinaKisiwa cha Heard na Visiwa vya McDonaldHondura" +
+	"siKorasiaHaitiHungariaVisiwa vya KanariIndonesiaAyalandiIsraeliIsle of ManIndiaE" +
+	"neo la Uingereza katika Bahari HindiIrakiUajemiAislandiItaliaJerseyJamaikaYordan" +
+	"iJapaniKenyaKirigizistaniKambodiaKiribatiKomoroSantakitzi na NevisKorea Kaskazin" +
+	"iKorea KusiniKuwaitiVisiwa vya KaymanKazakistaniLaosiLebanoniSantalusiaLishenten" +
+	"iSirilankaLiberiaLesotoLitwaniaLasembagiLativiaLibyaMorokoMonakoMoldovaMontenegr" +
+	"oSaint MartinBukiniVisiwa vya MarshalMasedoniaMaliMyanmar (Burma)MongoliaMacau S" +
+	"AR ChinaVisiwa vya Mariana vya KaskaziniMartinikiMoritaniaMontserratiMaltaMorisi" +
+	"ModivuMalawiMeksikoMalesiaMsumbijiNamibiaNyukaledoniaNijeriKisiwa cha NorfokNige" +
+	"riaNikaragwaUholanziNorweNepaliNauruNiueNyuzilandiOmaniPanamaPeruPolinesia ya Uf" +
+	"aransaPapuaFilipinoPakistaniPolandiSantapieri na MikeloniPitkairniPwetorikoMaene" +
+	"o ya PalestinaUrenoPalauParagwaiKatariOceania ya NjeRiyunioniRomaniaSerbiaUrusiR" +
+	"wandaSaudiVisiwa vya SolomonShelisheliSudaniUswidiSingapooSantahelenaSloveniaSva" +
+	"lbard na Jan MayenSlovakiaSiera LeoniSamarinoSenegaliSomaliaSurinamuSudani Kusin" +
+	"iSão Tomé na PríncipeElsavadoSantamatiniSiriaUswaziTristan da CunhaVisiwa vya Tu" +
+	"rki na KaikoChadiMaeneo ya Kusini ya UfaransaTogoTailandiTajikistaniTokelauTimor" +
+	" ya MasharikiTurukimenistaniTunisiaTongaUturukiTrinidad na TobagoTuvaluTaiwaniTa" +
+	"nzaniaUkrainiUgandaVisiwa Vidogo vya Nje vya MarekaniMarekaniUrugwaiUzibekistani" +
+	"VatikaniSantavisenti na GrenadiniVenezuelaVisiwa vya Virgin vya UingerezaVisiwa " +
+	"vya Virgin vya MarekaniVietinamuVanuatuWalis na FutunaSamoaKosovoYemeniMayotteAf" +
+	"rika KusiniZambiaZimbabweEneo lisilojulikanaDuniaAfrikaAmerika KaskaziniAmerika " +
+	"KusiniOceaniaAfrika ya MagharibiAmerika ya KatiAfrika ya MasharikiAfrika ya Kask" +
+	"aziniAfrika ya KatiAfrika ya KusiniAmerikaAmerika ya KaskaziniKaribiAsia Mashari" +
+	"kiAsia ya KusiniAsia ya Kusini MasharikiUlaya ya KusiniAustralasiaMelanesiaEneo " +
+	"la MikronesiaPolynesiaAsiaAsia ya KatiAsia ya MagharibiUlayaUlaya ya MasharikiUl" +
+	"aya ya KaskaziniUlaya ya MagharibiAmerika ya Kilatini"
+
+var swRegionIdx = []uint16{ // 291 entries
+	0x0, 0x14, 0x1a, 0x2a, 0x36, 0x48, 0x50, 0x57, 0x5e, 0x70, 0x76, 0x7f,
+	0x87, 0x98, 0x9f, 0xa8, 0xad, 0xbe, 0xc7, 0xdb, 0xe3, 0xee, 0xf6, 0x100,
+	0x108, 0x110, 0x117, 0x11d, 0x12b, 0x132, 0x138, 0x13f, 0x154, 0x15b, 0x161, 0x167,
+	0x178, 0x180, 0x188, 0x18e, 0x194, 0x1ae, 0x1ce, 0x1e7, 0x1fa, 0x200, 0x210, 0x21f,
+	0x224, 0x22c, 0x231, 0x239, 0x24e, 0x257, 0x25b, 0x263, 0x26a, 0x27d, 0x284, 0x294,
+	0x29d, 0x2a9, 0x2af, 0x2b6, 0x2be, 0x2d1, 0x2d8, 0x2e8, 0x2ee, 0x2f5, 0x2fa, 0x30a,
+	0x311, 0x319, 0x321, 0x32f, 0x334, 0x338, 0x34b, 0x355, 0x365, 0x36d, 0x373, 0x37c,
+	0x383, 0x388, 0x39b, 0x3a3, 0x3a8, 0x3b0, 0x3b9, 0x3bf, 0x3c3, 0x3cc, 0x3d5, 0x3dc,
+	0x406, 0x40f, 0x413, 0x41c, 0x422, 0x435, 0x45c, 0x465, 0x46c, 0x471, 0x479, 0x48a,
+	0x493, 0x49b, 0x4a2, 0x4ad, 0x4b2, 0x4d7, 0x4dc, 0x4e2, 0x4ea, 0x4f0, 0x4f6, 0x4fd,
+	0x504, 0x50a, 0x50f, 0x51c, 0x524, 0x52c, 0x532, 0x545, 0x554, 0x560, 0x567, 0x578,
+	0x583, 0x588, 0x590, 0x59a, 0x5a4, 0x5ad, 0x5b4, 0x5ba, 0x5c2, 0x5cb, 0x5d2, 0x5d7,
+	0x5dd, 0x5e3, 0x5ea, 0x5f4, 0x600, 0x606, 0x618, 0x621, 0x625, 0x634, 0x63c, 0x64b,
+	0x66b, 0x674, 0x67d, 0x688, 0x68d, 0x693, 0x699, 0x69f, 0x6a6, 0x6ad, 0x6b5, 0x6bc,
+	0x6c8, 0x6ce, 0x6df, 0x6e6, 0x6ef, 0x6f7, 0x6fc, 0x702, 0x707, 0x70b, 0x715, 0x71a,
+	0x720, 0x724, 0x739, 0x73e, 0x746, 0x74f, 0x756, 0x76c, 0x775, 0x77e, 0x791, 0x796,
+	0x79b, 0x7a3, 0x7a9, 0x7b7, 0x7c0, 0x7c7, 0x7cd, 0x7d2, 0x7d8, 0x7dd, 0x7ef, 0x7f9,
+	0x7ff, 0x805, 0x80d, 0x818, 0x820, 0x835, 0x83d, 0x848, 0x850, 0x858, 0x85f, 0x867,
+	0x874, 0x88b, 0x893, 0x89e, 0x8a3, 0x8a9, 0x8b9, 0x8d2, 0x8d7, 0x8f3, 0x8f7, 0x8ff,
+	0x90a, 0x911, 0x923, 0x932, 0x939, 0x93e, 0x945, 0x957, 0x95d, 0x964, 0x96c, 0x973,
+	0x979, 0x99b, 0x9a3, 0x9aa, 0x9b6, 0x9be, 0x9d7, 0x9e0, 0x9ff, 0xa1d, 0xa26, 0xa2d,
+	0xa3c, 0xa41, 0xa47, 0xa4d, 0xa54, 0xa61, 0xa67, 0xa6f, 0xa82, 0xa87, 0xa8d, 0xa9e,
+	0xaac, 0xab3, 0xac6, 0xad5, 0xae8, 0xafb, 0xb09, 0xb19, 0xb20, 0xb34, 0xb3a, 0xb48,
+	0xb56, 0xb6e, 0xb7d, 0xb88, 0xb91, 0xba3, 0xbac, 0xbb0, 0xbbc, 0xbcd, 0xbd2, 0xbe4,
+	0xbf6, 0xc08, 0xc1b,
+}
+
+const taRegionStr = "" +
+	"அஷன்ஷியன் தீவுஅன்டோராஐக்கிய அரபு கூட்டாட்சிஆப்கானிஸ்தான்ஆண்டிகுவா மற்றும் பார்பு" +
+	"டாஅங்குய்லாஅல்பேனியாஆர்மேனியாநெதர்லாந்து ஆண்டில்லெஸ்அங்கோலாஅண்டார்டிகாஅர்ஜென்டின" +
+	"ாஅமெரிக்க சமோவாஆஸ்திரியாஆஸ்திரேலியாஅரூபாஆலந்து தீவுகள்அசர்பைஜான்போஸ்னியா மற்றும்" +
+	" ஹெர்ஸிகோவினாபார்படோஸ்பங்களாதேஷ்பெல்ஜியம்புர்கினா ஃபாஸோபல்கேரியாபஹ்ரைன்புருண்டிப" +
+	"ெனின்செயின்ட் பார்தேலெமிபெர்முடாபுரூனேய்பொலிவியாகரீபியன் நெதர்லாந்துபிரேஸில்பஹாம" +
+	"ாஸ்பூடான்பொவேட் தீவுகள்போட்ஸ்வானாபெலாரூஸ்பெலிஸ்கனடாகாகோஸ் தீவுகள்காங்கோ - கின்ஷா" +
+	"சாமத்திய ஆப்ரிக்கக் குடியரசுகாங்கோ - ப்ராஸாவில்லேஸ்விட்சர்லாந்துஐவரி கோஸ்ட்குக் " +
+	"தீவுகள்சிலிகேமரூன்சீனாகொலம்பியாகிலிப்பர்டன் தீவுகோஸ்டாரிகாகியூபாகேப் வெர்டேகுராக" +
+	"வ்கிறிஸ்துமஸ் தீவுசைப்ரஸ்செக் குடியரசுஜெர்மன்டியகோ கார்ஷியாஜிபௌட்டிடென்மார்க்டொம" +
+	"ினிகாடொமினிகன் குடியரசுஅல்ஜீரியாசியூடா மற்றும் மெலில்லாஈக்வடார்எஸ்டோனியாஎகிப்தும" +
+	"ேற்கு சஹாராஎரிட்ரியாஸ்பெயின்எதியோப்பியாஐரோப்பியன் யூனியன்பின்லாந்துஃபிஜிஃபாக்லாந" +
+	"்து தீவுகள்மைக்ரோனேஷியாஃபெரௌ தீவுகள்பிரான்ஸ்கேபான்பிரிடிஷ் கூட்டரசுகிரனெடாஜார்ஜி" +
+	"யாஃப்ரென்ச் கயானாகெர்ன்சிகானாஜிப்ரால்டர்கிரீன்லாந்துகாம்பியாகினியாக்வாதேலோப்ஈக்க" +
+	"ுவாடோரியல் கினிகிரீஸ்தென் ஜியார்ஜியா மற்றும் தென் சான்ட்விச் தீவுகள்கவுதமாலாகுவா" +
+	"ம்கினி-பிஸ்ஸாவ்கயானாஹாங்காங் சார் சீனாஹேர்ட் மற்றும் மெக்டொனால்டுஹாண்டுராஸ்குரோச" +
+	"ியாஹெய்திஹங்கேரிகேனரி தீவுகள்இந்தோனேஷியாஅயர்லாந்துஇஸ்ரேல்ஐல் ஆஃப் மேன்இந்தியாபிர" +
+	"ிட்டிஷ் இந்தியப் பெருங்கடல் பகுதிஇராக்ஈரான்ஐஸ்லாந்துஇத்தாலிஜெர்சிஜமைகாஜொர்டான்ஜப" +
+	"்பான்கென்யாகிர்கிஸ்தான்கம்போடியாகிரிபடிகோமரோஸ்செயின்ட் கிட்ஸ் மற்றும் நெவிஸ்வட க" +
+	"ொரியாதென் கொரியாகுவைத்துகேமென் தீவுகள்கஸகஸ்தான்லாவோஸ்லெபனான்செயின்ட் லூசியாலிச்ச" +
+	"ெண்ஸ்டெய்ன்இலங்கைலைபீரியாலெசோதோலிதுவேனியாலக்ஸ்சம்பர்க்லாட்வியாலிபியாமொராக்கோமொனா" +
+	"க்கோமால்டோவாமான்டேனெக்ரோசெயின் மார்டீன்மடகாஸ்கர்மார்ஷல் தீவுகள்மாசிடோனியாமாலீமிய" +
+	"ான்மார் (பர்மா)மங்கோலியாமகாவோ சார் சீனாவடக்கு மரியானா தீவுகள்மார்டினிக்மௌரிடானிய" +
+	"ாமௌன்ட்செராட்மால்டாமொரிசியஸ்மாலத்தீவுமாலவிமெக்சிகோமலேஷியாமொசாம்பிக்நமீபியாநியூ க" +
+	"ேலிடோனியாநைஜர்நார்ஃபாக் தீவுகள்நைஜீரியாநிகாரகுவாநெதர்லாந்துநார்வேநேபாளம்நௌருநியூ" +
+	"நியூசிலாந்துஓமன்பனாமாபெருஃப்ரென்ச் பாலினேஷியாபாப்புவா நியூ கினிபிலிப்பைன்ஸ்பாகிஸ" +
+	"்தான்போலந்துசெயின்ட் பியர் மற்றும் மிக்வேலான்பிட்கெய்ர்ன்பியூர்டோ ரிகோபாலஸ்தீனிய" +
+	"ன் மாஹாணங்கள்போர்ச்சுக்கல்பாலோபாரகுவேகதார்ஔட்லையிங் ஓசானியாரீயூனியன்ருமேனியாசெர்" +
+	"பியாரஷ்யாருவான்டாசவூதி அரேபியாசாலமன் தீவுகள்ஸேசேல்ஸ்சூடான்ஸ்வீடன்சிங்கப்பூர்செயி" +
+	"ன்ட் ஹெலெனாஸ்லோவேனியாஸ்வல்பார்டு மற்றும் ஜான் மேயன்ஸ்லோவாகியாசியர்ரா லியோன்சான் " +
+	"மெரினோசெனெகல்சொமாலியாசூரினாம்தெற்கு சூடான்சாவ் தோம் மற்றும் ப்ரின்சிபிஎல் சால்வட" +
+	"ார்செயின்ட் மார்ட்டீன்சிரியாஸ்வாஸிலாண்ட்டிரிஸ்டன் டா குன்ஹாடர்க்ஸ் மற்றும் கைகோஸ" +
+	"் தீவுகள்சாட்ஃப்ரென்ச் தெற்கு மாஹாணங்கள்டோகோதாய்லாந்துதாஜிகிஸ்தான்டோகேலோகிழக்கு " +
+	"தைமூர்துர்க்மெனிஸ்தான்டுனிசியாடோங்காதுருக்கிட்ரினிடாட் மற்றும் டுபாகோதுவாலூதைவான" +
+	"்டான்சானியாஉக்ரைன்உகாண்டாஐக்கிய அமெரிக்காவை சுற்றியுள்ள தீவுகள்ஐக்கிய அமெரிக்க க" +
+	"ுடியரசுஉருகுவேஉஸ்பெகிஸ்தான்வாடிகன் நகரம்செயின் வின்சன்ட் மற்றும் கிரெனடைன்ஸ்வெனஜ" +
+	"ுவேலாபிரிட்டீஷ் கன்னித் தீவுகள்யூ.எஸ் கன்னித் தீவுகள்வியட்நாம்வனுவாட்டுவாலிஸ் மற" +
+	"்றும் ஃபுடுனா தீவுகள்சமோவாகொசோவோஏமன்மயோத்தென் ஆப்பிரிக்காசாம்பியாஜிம்பாப்வேஅறியப" +
+	"்படாத பிரதேசம்உலகம்ஆப்ரிக்காவடக்கு அமெரிக்கா கண்டம்தெற்கு அமெரிக்காஓஷெனியாமேற்கு" +
+	" ஆப்ரிக்காமத்திய அமெரிக்காகிழக்கு ஆப்ரிக்காவடக்கு ஆப்ரிக்காமத்திய ஆப்ரிக்காதென்ன" +
+	"ாப்ரிக்காஅமெரிக்காஸ்வடக்கு அமெரிக்காகரீபியன்கிழக்கு ஆசியாதெற்கு ஆசியாதென்-கிழக்க" +
+	"ு ஆசியாதெற்கு ஐரோப்பாஆஸ்திரலேசியாமெலனேஷியாமைக்ரோ நேஷியா பிரதேசம்பாலினேஷியாஆசியாம" +
+	"த்திய ஆசியாமேற்கு ஆசியாஐரோப்பாகிழக்கு ஐரோப்பாவடக்கு ஐரோப்பாமேற்கு ஐரோப்பாலத்தீன்" +
+	" அமெரிக்கா"
+
+var taRegionIdx = []uint16{ // 291 entries
+	0x0, 0x28, 0x3d, 0x7b, 0xa2, 0xec, 0x107, 0x122, 0x13d, 0x180, 0x195, 0x1b6,
+	0x1d7, 0x1ff, 0x21a, 0x23b, 0x24a, 0x272, 0x290, 0x2e3, 0x2fe, 0x31c, 0x337, 0x35f,
+	0x37a, 0x38f, 0x3a7, 0x3b9, 0x3f0, 0x408, 0x420, 0x438, 0x472, 0x48a, 0x49f, 0x4b1,
+	0x4d9, 0x4f7, 0x50f, 0x521, 0x52d, 0x555, 0x582, 0x5cc, 0x605, 0x632, 0x651, 0x673,
+	0x67f, 0x694, 0x6a0, 0x6bb, 0x6ec, 0x70a, 0x71c, 0x73b, 0x750, 0x77e, 0x793, 0x7b8,
+	0x7cd, 0x7f5, 0x80d, 0x82b, 0x843, 0x877, 0x892, 0x8d3, 0x8eb, 0x906, 0x91b, 0x93d,
+	0x958, 0x970, 0x991, 0x9c5, 0x9e3, 0x9f2, 0xa29, 0xa4d, 0xa72, 0xa8a, 0xa9c, 0xacd,
+	0xae2, 0xafa, 0xb25, 0xb3d, 0xb49, 0xb6a, 0xb8e, 0xba6, 0xbb8, 0xbd6, 0xc0d, 0xc1f,
+	0xca2, 0xcba, 0xccc, 0xcf1, 0xd00, 0xd32, 0xd7f, 0xd9d, 0xdb5, 0xdc7, 0xddc, 0xe01,
+	0xe22, 0xe40, 0xe55, 0xe78, 0xe8d, 0xef3, 0xf02, 0xf11, 0xf2c, 0xf41, 0xf53, 0xf62,
+	0xf7a, 0xf8f, 0xfa1, 0xfc5, 0xfe0, 0xff5, 0x100a, 0x105e, 0x1077, 0x1096, 0x10ae, 0x10d6,
+	0x10f1, 0x1103, 0x1118, 0x1143, 0x1173, 0x1185, 0x119d, 0x11af, 0x11cd, 0x11f4, 0x120c, 0x121e,
+	0x1236, 0x124e, 0x1266, 0x128a, 0x12b5, 0x12d0, 0x12fb, 0x1319, 0x1325, 0x1355, 0x1370, 0x1399,
+	0x13d7, 0x13f5, 0x1413, 0x1437, 0x1449, 0x1464, 0x147f, 0x148e, 0x14a6, 0x14bb, 0x14d9, 0x14ee,
+	0x1519, 0x1528, 0x1559, 0x1571, 0x158c, 0x15ad, 0x15bf, 0x15d4, 0x15e0, 0x15ec, 0x1610, 0x161c,
+	0x162b, 0x1637, 0x1671, 0x16a3, 0x16c7, 0x16e5, 0x16fa, 0x1757, 0x177b, 0x17a0, 0x17e3, 0x180a,
+	0x1816, 0x182b, 0x183a, 0x186b, 0x1886, 0x189e, 0x18b6, 0x18c5, 0x18dd, 0x1902, 0x192a, 0x1942,
+	0x1954, 0x1969, 0x198a, 0x19b5, 0x19d3, 0x1a27, 0x1a45, 0x1a6d, 0x1a8c, 0x1aa1, 0x1ab9, 0x1ad1,
+	0x1af6, 0x1b44, 0x1b69, 0x1ba0, 0x1bb2, 0x1bd6, 0x1c0b, 0x1c5f, 0x1c6b, 0x1cb8, 0x1cc4, 0x1ce2,
+	0x1d06, 0x1d18, 0x1d40, 0x1d70, 0x1d88, 0x1d9a, 0x1db2, 0x1df9, 0x1e0b, 0x1e1d, 0x1e3b, 0x1e50,
+	0x1e65, 0x1ed1, 0x1f15, 0x1f2a, 0x1f51, 0x1f76, 0x1fdc, 0x1ff7, 0x2041, 0x207d, 0x2098, 0x20b3,
+	0x2107, 0x2116, 0x2128, 0x2134, 0x2143, 0x2171, 0x2189, 0x21a7, 0x21de, 0x21ed, 0x2208, 0x2249,
+	0x2277, 0x228c, 0x22ba, 0x22e8, 0x2319, 0x2347, 0x2375, 0x239f, 0x23c0, 0x23ee, 0x2406, 0x242b,
+	0x244d, 0x247f, 0x24a7, 0x24cb, 0x24e6, 0x2524, 0x2542, 0x2551, 0x2573, 0x2595, 0x25aa, 0x25d5,
+	0x25fd, 0x2625, 0x2656,
+}
+
+const teRegionStr = "" +
+	"ఎసెషన్ దీవిఅండొర్రాయునైటెడ్ అరబ్ ఎమిరేట్స్ఆఫ్ఘనిస్తాన్ఆంటిగ్వా మరియు బార్బుడాఆంగ" +
+	"విల్లాఅల్బేనియాఆర్మేనియానేదేర్లేండ్స్ అంటిల్లిస్అంగోలాఅంటార్కటికాఅర్జెంటీనాఅమెరి" +
+	"కన్ సమోవాఆస్ట్రియాఆస్ట్రేలియాఅరుబాఆలేండ్ దీవులుఅజర్బైజాన్బోస్నియా మరియు హెర్జెగొ" +
+	"వీనాబార్బడోస్బంగ్లాదేశ్బెల్జియంబుర్కినా ఫాసోబల్గేరియాబహ్రెయిన్బురుండిబెనిన్సెంట్" +
+	" బర్తేలెమీబెర్ముడాబ్రూనైబొలీవియాకరీబియన్ నెదర్లాండ్స్బ్రెజిల్బహామాస్భూటాన్బొవెట్" +
+	" దీవిబోట్స్వానాబెలారస్బెలిజ్కెనడాకోకోస్ దీవులుకాంగో- కిన్షాసాసెంట్రల్ ఆఫ్రికన్ ర" +
+	"ిపబ్లిక్కాంగో- బ్రాజావిల్లిస్విట్జర్లాండ్ఐవరీ కోస్ట్కుక్ దీవులుచిలీకామెరూన్చైనాక" +
+	"ొలంబియాక్లిప్పర్టన్ దీవికోస్టారికాక్యూబాకేప్ వెర్డేకురాకవోక్రిస్మస్ దీవిసైప్రస్చ" +
+	"ెక్ రిపబ్లిక్జర్మనీడియాగో గార్సియాజిబౌటిడెన్మార్క్డోమెనికడొమెనికన్ రిపబ్లిక్అల్జ" +
+	"ీరియాస్యూటా మరియు మెలిల్లాఈక్వడార్ఎస్టోనియాఈజిప్ట్పడమటి సహారాఎరిట్రియాస్పెయిన్ఇథ" +
+	"ియోపియాయురోపియన్ యునియన్ఫిన్లాండ్ఫిజీఫాక్‌ల్యాండ్ దీవులుమైక్రోనేశియఫారో దీవులుఫ్" +
+	"రాన్స్‌గాబన్యునైటెడ్ కింగ్‌డమ్గ్రెనెడాజార్జియాఫ్రెంచ్ గియానాగ్వేర్నసేఘనాజిబ్రాల్" +
+	"టార్గ్రీన్‌లాండ్గాంబియాగినియాగ్వాడేలోప్ఎక్వేటోరియాల్ గినియాగ్రీస్దక్షిణ జార్జియా" +
+	" మరియు దక్షిణ సాండ్విచ్ దీవులుగ్వాటిమాలగ్వామ్గినియా-బిస్సావ్గయానాహాంకాంగ్ ఎస్ఏఆర" +
+	"్ చైనాహెర్డ్ & మాక్ డోనాల్డ్ దీవులుహోండురాస్క్రోయేషియాహైటిహంగేరీకేనరీ దీవులుఇండో" +
+	"నేషియాఐర్లాండ్ఇజ్రాయిల్ఐల్ ఆఫ్ మాన్భారత దేశంబ్రిటిష్ భారతీయ ఓషన్ ప్రాంతంఇరాక్ఇరా" +
+	"న్ఐస్లాండ్ఇటలీజెర్సీజమైకాజోర్డాన్జపాన్కెన్యాకిర్గిజిస్తాన్కంబోడియాకిరిబాటికొమొరో" +
+	"స్సెంట్ కిట్ట్స్ మరియు నెవిస్ఉత్తర కొరియాదక్షిణ కొరియాకువైట్కేమాన్ దీవులుకజకస్తా" +
+	"న్లావోస్లెబనాన్సెంట్ లూసియాలిక్టెస్టేన్శ్రీలంకలైబీరియాలెసోతోలిథువేనియాలక్సంబర్గ్" +
+	"లాత్వియాలిబియామొరాక్కోమొనాకోమోల్డోవామోంటేనేగ్రోసెంట్ మార్టిన్మడగాస్కర్మార్షల్ దీ" +
+	"వులుమేసిడోనియామాలిమయన్మార్ (బర్మా)మంగోలియామాకావ్ ఎస్ఏఆర్ చైనాఉత్తర మరియానా దీవుల" +
+	"ుమార్టినిక్మౌరిటేనియామోంట్సేర్రాట్మాల్టామారిషస్మాల్దీవులుమాలావిమెక్సికోమలేషియామొ" +
+	"జాంబిక్నమీబియాక్రొత్త కాలెడోనియానైజర్నార్ఫాక్ దీవినైజీరియానికరాగువానెదర్లాండ్స్న" +
+	"ార్వేనేపాల్నౌరునియున్యూజిలాండ్ఒమన్పనామాపెరూఫ్రెంచ్ పోలినిషియాపాపువా న్యు గినియాఫ" +
+	"ిలిప్పీన్స్పాకిస్తాన్పోలాండ్సెంట్ పియెర్ మరియు మికెలాన్పిట్‌కెయిర్న్ దీవులుఫ్యూర" +
+	"్టో రికోపాలిస్తినియాన్ ప్రాంతంపోర్చుగల్పలావుపెరగువేకతర్ఒషీనియా బయటున్నవిరియూనియన" +
+	"్రోమానియాసెర్బియారష్యారువాండాసౌదీ అరేబియాసోలమన్ దీవులుసీషెల్స్సూడాన్స్వీడన్సింగప" +
+	"ూర్సెంట్ హెలినాస్లోవేనియాస్వాల్బార్డ్ మరియు యాన్ మాయేన్స్లోవేకియాసియెర్రా లియాన్" +
+	"సాన్ మారినోసెనెగల్సోమాలియాసురినామ్దక్షిణ సూడాన్సావోటోమ్ మరియు ప్రిన్సిపేఎల్ సాల్" +
+	"వడోర్సింట్ మార్టెన్సిరియాస్వాజీలేండ్ట్రిస్టన్ డ కన్హాతుర్క్ మరియు కాలికోస్ దీవుల" +
+	"ుచాద్ఫ్రెంచ్ దక్షిణ ప్రాంతాలుటోగోథాయిలాండ్తజికిస్తాన్టోకేలావ్టిమోర్-లెస్టెతుర్కమ" +
+	"ేనిస్తాన్ట్యునీషియాటోంగాటర్కీట్రినిడాడ్ మరియు టొబాగోటువాలుతైవాన్టాంజానియాఉక్రెయి" +
+	"న్ఉగాండాసంయుక్త రాజ్య అమెరికా బయట ఉన్న దీవులుఅమెరికా సంయుక్త రాష్ట్రాలుఉరుగువేఉజ" +
+	"్బెకిస్తాన్వాటికన్ నగరంసెంట్ విన్సెంట్ మరియు గ్రెనడీన్స్వెనుజువేలాబ్రిటిష్ వర్జి" +
+	"న్ దీవులుయు.ఎస్. వర్జిన్ దీవులువియత్నాంవనాటువాలిస్ మరియు ఫ్యుత్యునాసమోవాకొసోవోయె" +
+	"మెన్మాయొట్టిదక్షిణ ఆఫ్రికాజాంబియాజింబాబ్వేతెలియని ప్రాంతంప్రపంచంఆఫ్రికాఉత్తర అమె" +
+	"రికాదక్షిణ అమెరికాఒషీనియపశ్చిమ ఆఫ్రికా భూభాగంమధ్యమ అమెరికాతూర్పు ఆఫ్రికాఉత్తర ఆఫ" +
+	"్రికామధ్యమ ఆఫ్రికాదక్షిణ ఆఫ్రికా భూభాగంఅమెరికాలుఉత్తర అమెరికా భూభాగంకరిబ్బియన్తూ" +
+	"ర్పు ఆసియాదక్షిణ ఆసియానైరుతి ఆసియాదక్షిణ ఐరోపాఆస్ట్రేలేసియామెలనేశియమైక్రోనేశియ ప" +
+	"్రాంతంపాలినేషియాఆసియామధ్య ఆసియాపడమటి ఆశియాఐరోపాతూర్పు ఐరోపాఉత్తర ఐరోపాపశ్చిమ ఐరో" +
+	"పాలాటిన్ అమెరికా"
+
+var teRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1f, 0x37, 0x78, 0x9c, 0xdd, 0xf8, 0x113, 0x12e, 0x174, 0x186, 0x1a7,
+	0x1c5, 0x1ed, 0x208, 0x229, 0x238, 0x25d, 0x27b, 0x2c8, 0x2e3, 0x301, 0x319, 0x33e,
+	0x359, 0x374, 0x389, 0x39b, 0x3c6, 0x3de, 0x3f0, 0x408, 0x445, 0x45d, 0x472, 0x484,
+	0x4a3, 0x4c1, 0x4d6, 0x4e8, 0x4f7, 0x51c, 0x545, 0x592, 0x5c7, 0x5f1, 0x610, 0x62f,
+	0x63b, 0x653, 0x65f, 0x677, 0x6a8, 0x6c6, 0x6d8, 0x6f7, 0x70c, 0x734, 0x749, 0x771,
+	0x783, 0x7ae, 0x7c0, 0x7de, 0x7f3, 0x82a, 0x845, 0x880, 0x898, 0x8b3, 0x8c8, 0x8e7,
+	0x902, 0x91a, 0x935, 0x966, 0x981, 0x98d, 0x9c4, 0x9e5, 0xa04, 0xa1f, 0xa2e, 0xa62,
+	0xa7a, 0xa92, 0xaba, 0xad5, 0xade, 0xb02, 0xb26, 0xb3b, 0xb4d, 0xb6b, 0xba5, 0xbb7,
+	0xc34, 0xc4f, 0xc61, 0xc8c, 0xc9b, 0xcd6, 0xd23, 0xd3e, 0xd5c, 0xd68, 0xd7a, 0xd9c,
+	0xdba, 0xdd2, 0xded, 0xe0d, 0xe26, 0xe74, 0xe83, 0xe92, 0xeaa, 0xeb6, 0xec8, 0xed7,
+	0xeef, 0xefe, 0xf10, 0xf3a, 0xf52, 0xf6a, 0xf82, 0xfcd, 0xfef, 0x1014, 0x1026, 0x104b,
+	0x1066, 0x1078, 0x108d, 0x10af, 0x10d3, 0x10e8, 0x1100, 0x1112, 0x1130, 0x114e, 0x1166, 0x1178,
+	0x1190, 0x11a2, 0x11ba, 0x11db, 0x1203, 0x121e, 0x1246, 0x1264, 0x1270, 0x129a, 0x12b2, 0x12e7,
+	0x131f, 0x133d, 0x135b, 0x1382, 0x1394, 0x13a9, 0x13c7, 0x13d9, 0x13f1, 0x1406, 0x1421, 0x1436,
+	0x146a, 0x1479, 0x149e, 0x14b6, 0x14d1, 0x14f5, 0x1507, 0x1519, 0x1525, 0x1531, 0x1552, 0x155e,
+	0x156d, 0x1579, 0x15ad, 0x15df, 0x1603, 0x1621, 0x1636, 0x1681, 0x16bb, 0x16e0, 0x1720, 0x173b,
+	0x174a, 0x175f, 0x176b, 0x179c, 0x17b7, 0x17cf, 0x17e7, 0x17f6, 0x180b, 0x182d, 0x1852, 0x186a,
+	0x187c, 0x1891, 0x18a9, 0x18cb, 0x18e9, 0x193d, 0x195b, 0x1986, 0x19a5, 0x19ba, 0x19d2, 0x19ea,
+	0x1a0f, 0x1a56, 0x1a7b, 0x1aa3, 0x1ab5, 0x1ad6, 0x1b05, 0x1b53, 0x1b5f, 0x1ba3, 0x1baf, 0x1bca,
+	0x1beb, 0x1c03, 0x1c28, 0x1c55, 0x1c73, 0x1c82, 0x1c91, 0x1cd2, 0x1ce4, 0x1cf6, 0x1d11, 0x1d2c,
+	0x1d3e, 0x1da3, 0x1ded, 0x1e02, 0x1e29, 0x1e4b, 0x1ea8, 0x1ec6, 0x1f07, 0x1f41, 0x1f59, 0x1f68,
+	0x1fa9, 0x1fb8, 0x1fca, 0x1fdc, 0x1ff4, 0x201c, 0x2031, 0x204c, 0x2077, 0x208c, 0x20a1, 0x20c6,
+	0x20ee, 0x2100, 0x213b, 0x2160, 0x2188, 0x21ad, 0x21d2, 0x220d, 0x2228, 0x2260, 0x227e, 0x22a0,
+	0x22c2, 0x22e4, 0x2306, 0x232d, 0x2345, 0x237c, 0x239a, 0x23a9, 0x23c5, 0x23e4, 0x23f3, 0x2415,
+	0x2434, 0x2456, 0x247e,
+}
+
+const thRegionStr = "" +
+	"เกาะแอสเซนชันอันดอร์ราสหรัฐอาหรับเอมิเรตส์อัฟกานิสถานแอนติกาและบาร์บูดาแองกวิลลา" +
+	"แอลเบเนียอาร์เมเนียเนเธอร์แลนด์แอนทิลลิสแองโกลาแอนตาร์กติกาอาร์เจนตินาอเมริกันซา" +
+	"มัวออสเตรียออสเตรเลียอารูบาหมู่เกาะโอลันด์อาเซอร์ไบจานบอสเนียและเฮอร์เซโกวีนาบาร" +
+	"์เบโดสบังกลาเทศเบลเยียมบูร์กินาฟาโซบัลแกเรียบาห์เรนบุรุนดีเบนินเซนต์บาร์เธเลมีเบ" +
+	"อร์มิวดาบรูไนโบลิเวียเนเธอร์แลนด์แคริบเบียนบราซิลบาฮามาสภูฏานเกาะบูเวตบอตสวานาเบ" +
+	"ลารุสเบลีซแคนาดาหมู่เกาะโคโคสคองโก-กินชาซาสาธารณรัฐแอฟริกากลางคองโก-บราซซาวิลสวิ" +
+	"ตเซอร์แลนด์ไอวอรี่โคสต์หมู่เกาะคุกชิลีแคเมอรูนจีนโคลอมเบียเกาะคลิปเปอร์ตันคอสตาร" +
+	"ิกาคิวบาเคปเวิร์ดคูราเซาเกาะคริสต์มาสไซปรัสสาธารณรัฐเช็กเยอรมนีดิเอโกการ์เซียจิบ" +
+	"ูตีเดนมาร์กโดมินิกาสาธารณรัฐโดมินิกันแอลจีเรียซีโอตาและเมลิลลาเอกวาดอร์เอสโตเนีย" +
+	"อียิปต์ซาฮาราตะวันตกเอริเทรียสเปนเอธิโอเปียสหภาพยุโรปฟินแลนด์ฟิจิหมู่เกาะฟอล์กแล" +
+	"นด์ไมโครนีเซียหมู่เกาะแฟโรฝรั่งเศสกาบองสหราชอาณาจักรเกรเนดาจอร์เจียเฟรนช์เกียนาเ" +
+	"กิร์นซีย์กานายิบรอลตาร์กรีนแลนด์แกมเบียกินีกวาเดอลูปอิเควทอเรียลกินีกรีซเกาะเซาท" +
+	"์จอร์เจียและหมู่เกาะเซาท์แซนด์วิชกัวเตมาลากวมกินี-บิสเซากายอานาเขตปกครองพิเศษฮ่อ" +
+	"งกงแห่งสาธารณรัฐประชาชนจีนเกาะเฮิร์ดและหมู่เกาะแมกดอนัลด์ฮอนดูรัสโครเอเชียเฮติฮั" +
+	"งการีหมู่เกาะคานารีอินโดนีเซียไอร์แลนด์อิสราเอลเกาะแมนอินเดียบริติชอินเดียนโอเชี" +
+	"ยนเทร์ริทอรีอิรักอิหร่านไอซ์แลนด์อิตาลีเจอร์ซีย์จาเมกาจอร์แดนญี่ปุ่นเคนยาคีร์กีซ" +
+	"สถานกัมพูชาคิริบาสคอโมโรสเซนต์คิตส์และเนวิสเกาหลีเหนือเกาหลีใต้คูเวตหมู่เกาะเคย์" +
+	"แมนคาซัคสถานลาวเลบานอนเซนต์ลูเซียลิกเตนสไตน์ศรีลังกาไลบีเรียเลโซโทลิทัวเนียลักเซ" +
+	"มเบิร์กลัตเวียลิเบียโมร็อกโกโมนาโกมอลโดวามอนเตเนโกรเซนต์มาตินมาดากัสการ์หมู่เกาะ" +
+	"มาร์แชลล์มาซิโดเนียมาลีเมียนม่าร์ (พม่า)มองโกเลียเขตปกครองพิเศษมาเก๊าแห่งสาธารณร" +
+	"ัฐประชาชนจีนหมู่เกาะนอร์เทิร์นมาเรียนามาร์ตินีกมอริเตเนียมอนต์เซอร์รัตมอลตามอริเ" +
+	"ชียสมัลดีฟส์มาลาวีเม็กซิโกมาเลเซียโมซัมบิกนามิเบียนิวแคลิโดเนียไนเจอร์เกาะนอร์ฟอ" +
+	"ล์กไนจีเรียนิการากัวเนเธอร์แลนด์นอร์เวย์เนปาลนาอูรูนีอูเอนิวซีแลนด์โอมานปานามาเป" +
+	"รูเฟรนช์โปลินีเซียปาปัวนิวกินีฟิลิปปินส์ปากีสถานโปแลนด์แซงปีแยร์และมีเกอลงหมู่เก" +
+	"าะพิตแคร์นเปอร์โตริโกดินแดนปาเลสไตน์โปรตุเกสปาเลาปารากวัยกาตาร์เอาต์ไลอิงโอเชียเ" +
+	"นียเรอูนียงโรมาเนียเซอร์เบียรัสเซียรวันดาซาอุดีอาระเบียหมู่เกาะโซโลมอนเซเชลส์ซูด" +
+	"านสวีเดนสิงคโปร์เซนต์เฮเลนาสโลวีเนียสฟาลบาร์และยานไมเอนสโลวะเกียเซียร์ราลีโอนซาน" +
+	"มารีโนเซเนกัลโซมาเลียซูรินาเมซูดานใต้เซาตูเมและปรินซิปีเอลซัลวาดอร์เซนต์มาร์ตินซ" +
+	"ีเรียสวาซิแลนด์ทริสตัน เดอ คูนาหมู่เกาะเติกส์และหมู่เกาะเคคอสชาดเฟรนช์เซาเทิร์นเ" +
+	"ทร์ริทอรีส์โตโกไทยทาจิกิสถานโตเกเลาติมอร์-เลสเตเติร์กเมนิสถานตูนิเซียตองกาตุรกีต" +
+	"รินิแดดและโตเบโกตูวาลูไต้หวันแทนซาเนียยูเครนยูกันดาหมู่เกาะรอบนอกของสหรัฐอเมริกา" +
+	"สหรัฐอเมริกาอุรุกวัยอุซเบกิสถานนครวาติกันเซนต์วินเซนต์และเกรนาดีนส์เวเนซุเอลาหมู" +
+	"่เกาะบริติชเวอร์จินหมู่เกาะยูเอสเวอร์จินเวียดนามวานูอาตูวาลลิสและฟุตูนาซามัวโคโซ" +
+	"โวเยเมนมายอตแอฟริกาใต้แซมเบียซิมบับเวภูมิภาคที่ไม่รู้จักโลกแอฟริกาอเมริกาเหนืออเ" +
+	"มริกาใต้โอเชียเนียแอฟริกาตะวันตกอเมริกากลางแอฟริกาตะวันออกแอฟริกาเหนือแอฟริกากลา" +
+	"งแอฟริกาตอนใต้อเมริกาอเมริกาตอนเหนือแคริบเบียนเอเชียตะวันออกเอเชียใต้เอเชียตะวัน" +
+	"ออกเฉียงใต้ยุโรปใต้ออสตราเลเซียเมลานีเซียเขตไมโครนีเซียโปลินีเซียเอเชียเอเชียกลา" +
+	"งเอเชียตะวันตกยุโรปยุโรปตะวันออกยุโรปเหนือยุโรปตะวันตกละตินอเมริกา"
+
+var thRegionIdx = []uint16{ // 291 entries
+	0x0, 0x27, 0x42, 0x7e, 0x9f, 0xd5, 0xf0, 0x10b, 0x129, 0x168, 0x17d, 0x1a1,
+	0x1c2, 0x1e9, 0x201, 0x21f, 0x231, 0x25e, 0x282, 0x2c7, 0x2e2, 0x2fd, 0x315, 0x339,
+	0x354, 0x369, 0x37e, 0x38d, 0x3ba, 0x3d8, 0x3e7, 0x3ff, 0x441, 0x453, 0x468, 0x477,
+	0x492, 0x4aa, 0x4bf, 0x4ce, 0x4e0, 0x507, 0x52c, 0x568, 0x593, 0x5bd, 0x5e1, 0x602,
+	0x60e, 0x626, 0x62f, 0x64a, 0x67a, 0x695, 0x6a4, 0x6bf, 0x6d4, 0x6fb, 0x70d, 0x734,
+	0x749, 0x773, 0x785, 0x79d, 0x7b5, 0x7eb, 0x806, 0x836, 0x851, 0x86c, 0x881, 0x8a8,
+	0x8c3, 0x8cf, 0x8ed, 0x90b, 0x923, 0x92f, 0x965, 0x986, 0x9aa, 0x9c2, 0x9d1, 0x9f8,
+	0xa0d, 0xa25, 0xa49, 0xa67, 0xa73, 0xa91, 0xaac, 0xac1, 0xacd, 0xae8, 0xb18, 0xb24,
+	0xb9f, 0xbba, 0xbc3, 0xbe2, 0xbf7, 0xc78, 0xcd5, 0xced, 0xd08, 0xd14, 0xd29, 0xd53,
+	0xd74, 0xd8f, 0xda7, 0xdbc, 0xdd1, 0xe2e, 0xe3d, 0xe52, 0xe6d, 0xe7f, 0xe9a, 0xeac,
+	0xec1, 0xed6, 0xee5, 0xf06, 0xf1b, 0xf30, 0xf45, 0xf7b, 0xf9c, 0xfb7, 0xfc6, 0xff3,
+	0x100e, 0x1017, 0x102c, 0x104d, 0x106e, 0x1086, 0x109e, 0x10b0, 0x10cb, 0x10ef, 0x1104, 0x1116,
+	0x112e, 0x1140, 0x1155, 0x1173, 0x1191, 0x11b2, 0x11e5, 0x1203, 0x120f, 0x123c, 0x1257, 0x12d8,
+	0x1326, 0x1341, 0x135f, 0x1386, 0x1395, 0x13b0, 0x13c8, 0x13da, 0x13f2, 0x140a, 0x1422, 0x143a,
+	0x1461, 0x1476, 0x149d, 0x14b5, 0x14d0, 0x14f4, 0x150c, 0x151b, 0x152d, 0x153f, 0x155d, 0x156c,
+	0x157e, 0x158a, 0x15ba, 0x15de, 0x15fc, 0x1614, 0x1629, 0x1662, 0x1692, 0x16b3, 0x16e0, 0x16f8,
+	0x1707, 0x171f, 0x1731, 0x176d, 0x1785, 0x179d, 0x17b8, 0x17cd, 0x17df, 0x1809, 0x1836, 0x184b,
+	0x185a, 0x186c, 0x1884, 0x18a5, 0x18c0, 0x18f9, 0x1914, 0x193b, 0x1956, 0x196b, 0x1983, 0x199b,
+	0x19b3, 0x19e9, 0x1a0d, 0x1a31, 0x1a43, 0x1a61, 0x1a8d, 0x1ae7, 0x1af0, 0x1b41, 0x1b4d, 0x1b56,
+	0x1b74, 0x1b89, 0x1bab, 0x1bd5, 0x1bed, 0x1bfc, 0x1c0b, 0x1c3e, 0x1c50, 0x1c65, 0x1c80, 0x1c92,
+	0x1ca7, 0x1cfe, 0x1d22, 0x1d3a, 0x1d5b, 0x1d79, 0x1dc7, 0x1de5, 0x1e27, 0x1e66, 0x1e7e, 0x1e96,
+	0x1ec3, 0x1ed2, 0x1ee4, 0x1ef3, 0x1f02, 0x1f20, 0x1f35, 0x1f4d, 0x1f86, 0x1f8f, 0x1fa4, 0x1fc8,
+	0x1fe6, 0x2004, 0x202e, 0x204f, 0x207c, 0x20a0, 0x20c1, 0x20e8, 0x20fd, 0x212a, 0x2148, 0x2172,
+	0x218d, 0x21cf, 0x21e7, 0x220b, 0x2229, 0x2253, 0x2271, 0x2283, 0x22a1, 0x22c8, 0x22d7, 0x22fe,
+	0x231c, 0x2340, 0x2364,
+}
+
+const trRegionStr = "" +
+	"Ascension AdasıAndorraBirleşik Arap EmirlikleriAfganistanAntigua ve BarbudaAngui" +
+	"llaArnavutlukErmenistanHollanda AntilleriAngolaAntarktikaArjantinAmerikan Samoas" +
+	"ıAvusturyaAvustralyaArubaÅland AdalarıAzerbaycanBosna HersekBarbadosBangladeşBel" +
+	"çikaBurkina FasoBulgaristanBahreynBurundiBeninSaint BarthelemyBermudaBruneiBoliv" +
+	"yaKarayip HollandaBrezilyaBahamalarButanBouvet AdasıBotsvanaBeyaz RusyaBelizeKan" +
+	"adaCocos (Keeling) AdalarıKongo - KinşasaOrta Afrika CumhuriyetiKongo - Brazavil" +
+	"İsviçreFildişi SahiliCook AdalarıŞiliKamerunÇinKolombiyaClipperton AdasıKosta Ri" +
+	"kaKübaCape VerdeCuraçaoChristmas AdasıGüney Kıbrıs Rum KesimiÇek CumhuriyetiAlma" +
+	"nyaDiego GarciaCibutiDanimarkaDominikaDominik CumhuriyetiCezayirCeuta ve Melilla" +
+	"EkvadorEstonyaMısırBatı SaharaEritreİspanyaEtiyopyaAvrupa BirliğiFinlandiyaFijiF" +
+	"alkland AdalarıMikronezya Federal EyaletleriFaroe AdalarıFransaGabonBirleşik Kra" +
+	"llıkGrenadaGürcistanFransız GuyanasıGuernseyGanaCebelitarıkGrönlandGambiyaGineGu" +
+	"adalupeEkvator GinesiYunanistanGüney Georgia ve Güney Sandwich AdalarıGuatemalaG" +
+	"uamGine-BissauGuyanaÇin Hong Kong ÖYBHeard Adası ve McDonald AdalarıHondurasHırv" +
+	"atistanHaitiMacaristanKanarya AdalarıEndonezyaİrlandaİsrailMan AdasıHindistanİng" +
+	"iliz Hint Okyanusu BölgesiIrakİranİzlandaİtalyaJerseyJamaikaÜrdünJaponyaKenyaKır" +
+	"gızistanKamboçyaKiribatiKomorlarSaint Kitts ve NevisKuzey KoreGüney KoreKuveytCa" +
+	"yman AdalarıKazakistanLaosLübnanSaint LuciaLiechtensteinSri LankaLiberyaLesotoLi" +
+	"tvanyaLüksemburgLetonyaLibyaFasMonakoMoldovaKaradağSaint MartinMadagaskarMarshal" +
+	"l AdalarıMakedonyaMaliMyanmar (Burma)MoğolistanÇin Makao ÖYBKuzey Mariana Adalar" +
+	"ıMartinikMoritanyaMontserratMaltaMauritiusMaldivlerMalaviMeksikaMalezyaMozambikN" +
+	"amibyaYeni KaledonyaNijerNorfolk AdasıNijeryaNikaraguaHollandaNorveçNepalNauruNi" +
+	"ueYeni ZelandaUmmanPanamaPeruFransız PolinezyasıPapua Yeni GineFilipinlerPakista" +
+	"nPolonyaSaint Pierre ve MiquelonPitcairn AdalarıPorto RikoFilistin BölgeleriPort" +
+	"ekizPalauParaguayKatarUzak OkyanusyaRéunionRomanyaSırbistanRusyaRuandaSuudi Arab" +
+	"istanSolomon AdalarıSeyşellerSudanİsveçSingapurSaint HelenaSlovenyaSvalbard ve J" +
+	"an Mayen AdalarıSlovakyaSierra LeoneSan MarinoSenegalSomaliSurinamGüney SudanSão" +
+	" Tomé ve PríncipeEl SalvadorSint MaartenSuriyeSvazilandTristan da CunhaTurks ve " +
+	"Caicos AdalarıÇadFransız Güney BölgeleriTogoTaylandTacikistanTokelauTimor-LesteT" +
+	"ürkmenistanTunusTongaTürkiyeTrinidad ve TobagoTuvaluTayvanTanzanyaUkraynaUgandaA" +
+	"BD Dış AdalarıABDUruguayÖzbekistanVatikanSaint Vincent ve GrenadinlerVenezuelaBr" +
+	"itanya Virjin AdalarıABD Virjin AdalarıVietnamVanuatuWallis ve Futuna AdalarıSam" +
+	"oaKosovaYemenMayotteGüney AfrikaZambiyaZimbabveBilinmeyen BölgeDünyaAfrikaKuzey " +
+	"AmerikaGüney AmerikaOkyanusyaBatı AfrikaOrta AmerikaDoğu AfrikaKuzey AfrikaOrta " +
+	"AfrikaAfrika'nın GüneyiAmerikaAmerika'nın KuzeyiKarayiplerDoğu AsyaGüney AsyaGün" +
+	"eydoğu AsyaGüney AvrupaAvustralasyaMelanezyaMikronezyaPolinezyaAsyaOrta AsyaBatı" +
+	" AsyaAvrupaDoğu AvrupaKuzey AvrupaBatı AvrupaLatin Amerika"
+
+var trRegionIdx = []uint16{ // 291 entries
+	0x0, 0x10, 0x17, 0x31, 0x3b, 0x4d, 0x55, 0x5f, 0x69, 0x7b, 0x81, 0x8b,
+	0x93, 0xa4, 0xad, 0xb7, 0xbc, 0xcb, 0xd5, 0xe1, 0xe9, 0xf3, 0xfb, 0x107,
+	0x112, 0x119, 0x120, 0x125, 0x135, 0x13c, 0x142, 0x149, 0x159, 0x161, 0x16a, 0x16f,
+	0x17c, 0x184, 0x18f, 0x195, 0x19b, 0x1b3, 0x1c3, 0x1da, 0x1ea, 0x1f3, 0x202, 0x20f,
+	0x214, 0x21b, 0x21f, 0x228, 0x239, 0x243, 0x248, 0x252, 0x25a, 0x26a, 0x284, 0x294,
+	0x29b, 0x2a7, 0x2ad, 0x2b6, 0x2be, 0x2d1, 0x2d8, 0x2e8, 0x2ef, 0x2f6, 0x2fd, 0x309,
+	0x30f, 0x317, 0x31f, 0x32e, 0x338, 0x33c, 0x34d, 0x36a, 0x378, 0x37e, 0x383, 0x395,
+	0x39c, 0x3a6, 0x3b8, 0x3c0, 0x3c4, 0x3d0, 0x3d9, 0x3e0, 0x3e4, 0x3ed, 0x3fb, 0x405,
+	0x42f, 0x438, 0x43c, 0x447, 0x44d, 0x460, 0x481, 0x489, 0x495, 0x49a, 0x4a4, 0x4b4,
+	0x4bd, 0x4c5, 0x4cc, 0x4d6, 0x4df, 0x4fe, 0x502, 0x507, 0x50f, 0x516, 0x51c, 0x523,
+	0x52a, 0x531, 0x536, 0x543, 0x54c, 0x554, 0x55c, 0x570, 0x57a, 0x585, 0x58b, 0x59a,
+	0x5a4, 0x5a8, 0x5af, 0x5ba, 0x5c7, 0x5d0, 0x5d7, 0x5dd, 0x5e5, 0x5f0, 0x5f7, 0x5fc,
+	0x5ff, 0x605, 0x60c, 0x614, 0x620, 0x62a, 0x63b, 0x644, 0x648, 0x657, 0x662, 0x671,
+	0x687, 0x68f, 0x698, 0x6a2, 0x6a7, 0x6b0, 0x6b9, 0x6bf, 0x6c6, 0x6cd, 0x6d5, 0x6dc,
+	0x6ea, 0x6ef, 0x6fd, 0x704, 0x70d, 0x715, 0x71c, 0x721, 0x726, 0x72a, 0x736, 0x73b,
+	0x741, 0x745, 0x75a, 0x769, 0x773, 0x77b, 0x782, 0x79a, 0x7ab, 0x7b5, 0x7c8, 0x7d0,
+	0x7d5, 0x7dd, 0x7e2, 0x7f0, 0x7f8, 0x7ff, 0x809, 0x80e, 0x814, 0x823, 0x833, 0x83d,
+	0x842, 0x849, 0x851, 0x85d, 0x865, 0x883, 0x88b, 0x897, 0x8a1, 0x8a8, 0x8ae, 0x8b5,
+	0x8c1, 0x8d8, 0x8e3, 0x8ef, 0x8f5, 0x8fe, 0x90e, 0x926, 0x92a, 0x944, 0x948, 0x94f,
+	0x959, 0x960, 0x96b, 0x978, 0x97d, 0x982, 0x98a, 0x99c, 0x9a2, 0x9a8, 0x9b0, 0x9b7,
+	0x9bd, 0x9cf, 0x9d2, 0x9d9, 0x9e4, 0x9eb, 0xa07, 0xa10, 0xa28, 0xa3b, 0xa42, 0xa49,
+	0xa62, 0xa67, 0xa6d, 0xa72, 0xa79, 0xa86, 0xa8d, 0xa95, 0xaa6, 0xaac, 0xab2, 0xabf,
+	0xacd, 0xad6, 0xae2, 0xaee, 0xafa, 0xb06, 0xb11, 0xb24, 0xb2b, 0xb3e, 0xb48, 0xb52,
+	0xb5d, 0xb6d, 0xb7a, 0xb86, 0xb8f, 0xb99, 0xba2, 0xba6, 0xbaf, 0xbb9, 0xbbf, 0xbcb,
+	0xbd7, 0xbe3, 0xbf0,
+}
+
+const ukRegionStr = "" +
+	"Острів ВознесінняАндорраОбʼєднані Арабські ЕміратиАфганістанАнтигуа і БарбудаАнг" +
+	"ільяАлбаніяВірменіяНідерландські Антильські ОстровиАнголаАнтарктидаАргентинаАмер" +
+	"иканське СамоаАвстріяАвстраліяАрубаАландські островиАзербайджанБоснія і Герцогов" +
+	"инаБарбадосБангладешБельгіяБуркіна-ФасоБолгаріяБахрейнБурундіБенінСен-БартельміБ" +
+	"ермудиБрунейБолівіяНідерландські Карибські островиБразиліяБагамиБутанОстрів Буве" +
+	"БотсванаБілорусьБелізКанадаКокосові островиКонго – КіншасаЦентральноафриканська " +
+	"РеспублікаКонго – БраззавільШвейцаріяКот-д’ІвуарОстрови КукаЧиліКамерунКитайКолу" +
+	"мбіяОстрів КліппертонКоста-РикаКубаКабо-ВердеКюрасаоОстрів РіздваКіпрЧехіяНімечч" +
+	"инаДієґо-ҐарсіяДжібутіДаніяДомінікаДомініканська РеспублікаАлжирСеута і МелільяЕ" +
+	"квадорЕстоніяЄгипетЗахідна СахараЕритреяІспаніяЕфіопіяЄвропейський СоюзФінляндія" +
+	"ФіджіФолклендські островиМікронезіяФарерські островиФранціяГабонВелика БританіяГ" +
+	"ренадаГрузіяФранцузька ГвіанаГернсіГанаГібралтарГренландіяГамбіяГвінеяГваделупаЕ" +
+	"кваторіальна ГвінеяГреціяПівденна Джорджія та Південні Сандвічеві ОстровиГватема" +
+	"лаГуамГвінея-БісауГайанаГонконг, О.А.Р. КитаюОстрови Херд і Мак-ДональдГондурасХ" +
+	"орватіяГаїтіУгорщинаКанарські островиІндонезіяІрландіяІзраїльОстрів МенІндіяБрит" +
+	"анські території Індійського океануІракІранІсландіяІталіяДжерсіЯмайкаЙорданіяЯпо" +
+	"ніяКеніяКиргизстанКамбоджаКірибатіКоморські островиСент-Кітс і НевісПівнічна Кор" +
+	"еяПівденна КореяКувейтКайманові островиКазахстанЛаосЛіванСент-ЛюсіяЛіхтенштейнШр" +
+	"і-ЛанкаЛіберіяЛесотоЛитваЛюксембургЛатвіяЛівіяМароккоМонакоМолдоваЧорногоріяСен-" +
+	"МартенМадагаскарМаршаллові ОстровиМакедоніяМаліМʼянма (Бірма)МонголіяМакао О.А.Р" +
+	". КитаюПівнічні Маріанські ОстровиМартинікаМавританіяМонтсерратМальтаМаврикійМал" +
+	"ьдівиМалавіМексикаМалайзіяМозамбікНамібіяНова КаледоніяНігерОстрів НорфолкНігері" +
+	"яНікарагуаНідерландиНорвегіяНепалНауруНіяНова ЗеландіяОманПанамаПеруФранцузька П" +
+	"олінезіяПапуа Нова ГвінеяФіліппіниПакистанПольщаСен-Пʼєр і МікелонОстрови Піткер" +
+	"нПуерто-РикоПалестинаПортугаліяПалауПарагвайКатарІнша ОкеаніяРеюньйонРумуніяСерб" +
+	"іяРосіяРуандаСаудівська АравіяСоломонові ОстровиСейшельські островиСуданШвеціяСі" +
+	"нгапурОстрів Святої ЄлениСловеніяОстрови Свальбард і Ян-МаєнСловаччинаСьєрра-Лео" +
+	"неСан-МариноСенегалСомаліСуринамПівденний СуданСан-Томе і ПрінсіпіСальвадорСінт-" +
+	"МартенСиріяСвазілендТрістан-да-КуньяТеркс і КайкосЧадФранцузькі Південні Територ" +
+	"іїТогоТаїландТаджикистанТокелауТімор-ЛештіТуркменистанТунісТонгаТуреччинаТриніда" +
+	"д і ТобагоТувалуТайваньТанзаніяУкраїнаУгандаВіддалені Острови СШАСШАУругвайУзбек" +
+	"истанВатиканСент-Вінсент і ГренадиниВенесуелаБританські Віргінські островиВіргін" +
+	"ські острови, СШАВʼєтнамВануатуУолліс і ФутунаСамоаКосовоЄменМайоттаПАРЗамбіяЗім" +
+	"бабвеНевідомий регіонСвітАфрикаПівнічноамериканський континентПівденна АмерикаОк" +
+	"еаніяЗахідна АфрикаЦентральна АмерикаСхідна АфрикаПівнічна АфрикаЦентральна Афри" +
+	"каПівденна АфрикаАмерикаПівнічна АмерикаКарибський басейнСхідна АзіяПівденна Азі" +
+	"яПівденно-Східна АзіяПівденна ЄвропаАвстралія та Нова ЗеландіяМеланезіяМікронезі" +
+	"йський регіонПолінезіяАзіяЦентральна АзіяЗахідна АзіяЄвропаСхідна ЄвропаПівнічна" +
+	" ЄвропаЗахідна ЄвропаЛатинська Америка"
+
+var ukRegionIdx = []uint16{ // 291 entries
+	0x0, 0x21, 0x2f, 0x61, 0x75, 0x95, 0xa3, 0xb1, 0xc1, 0xff, 0x10b, 0x11f,
+	0x131, 0x154, 0x162, 0x174, 0x17e, 0x19f, 0x1b5, 0x1db, 0x1eb, 0x1fd, 0x20b, 0x222,
+	0x232, 0x240, 0x24e, 0x258, 0x271, 0x27f, 0x28b, 0x299, 0x2d5, 0x2e5, 0x2f1, 0x2fb,
+	0x310, 0x320, 0x330, 0x33a, 0x346, 0x365, 0x382, 0x3c1, 0x3e4, 0x3f6, 0x40c, 0x423,
+	0x42b, 0x439, 0x443, 0x453, 0x474, 0x487, 0x48f, 0x4a2, 0x4b0, 0x4c9, 0x4d1, 0x4db,
+	0x4ed, 0x504, 0x512, 0x51c, 0x52c, 0x55b, 0x565, 0x581, 0x58f, 0x59d, 0x5a9, 0x5c4,
+	0x5d2, 0x5e0, 0x5ee, 0x60f, 0x621, 0x62b, 0x652, 0x666, 0x687, 0x695, 0x69f, 0x6bc,
+	0x6ca, 0x6d6, 0x6f7, 0x703, 0x70b, 0x71d, 0x731, 0x73d, 0x749, 0x75b, 0x782, 0x78e,
+	0x7e9, 0x7fb, 0x803, 0x81a, 0x826, 0x84a, 0x87a, 0x88a, 0x89a, 0x8a4, 0x8b4, 0x8d5,
+	0x8e7, 0x8f7, 0x905, 0x918, 0x922, 0x96d, 0x975, 0x97d, 0x98d, 0x999, 0x9a5, 0x9b1,
+	0x9c1, 0x9cd, 0x9d7, 0x9eb, 0x9fb, 0xa0b, 0xa2c, 0xa4b, 0xa66, 0xa81, 0xa8d, 0xaae,
+	0xac0, 0xac8, 0xad2, 0xae5, 0xafb, 0xb0c, 0xb1a, 0xb26, 0xb30, 0xb44, 0xb50, 0xb5a,
+	0xb68, 0xb74, 0xb82, 0xb96, 0xba9, 0xbbd, 0xbe0, 0xbf2, 0xbfa, 0xc13, 0xc23, 0xc42,
+	0xc76, 0xc88, 0xc9c, 0xcb0, 0xcbc, 0xccc, 0xcdc, 0xce8, 0xcf6, 0xd06, 0xd16, 0xd24,
+	0xd3f, 0xd49, 0xd64, 0xd72, 0xd84, 0xd98, 0xda8, 0xdb2, 0xdbc, 0xdc2, 0xddb, 0xde3,
+	0xdef, 0xdf7, 0xe1e, 0xe3e, 0xe50, 0xe60, 0xe6c, 0xe8d, 0xeaa, 0xebf, 0xed1, 0xee5,
+	0xeef, 0xeff, 0xf09, 0xf20, 0xf30, 0xf3e, 0xf4a, 0xf54, 0xf60, 0xf81, 0xfa4, 0xfc9,
+	0xfd3, 0xfdf, 0xfef, 0x1013, 0x1023, 0x1055, 0x1069, 0x1080, 0x1093, 0x10a1, 0x10ad, 0x10bb,
+	0x10d8, 0x10fb, 0x110d, 0x1122, 0x112c, 0x113e, 0x115c, 0x1176, 0x117c, 0x11b4, 0x11bc, 0x11ca,
+	0x11e0, 0x11ee, 0x1203, 0x121b, 0x1225, 0x122f, 0x1241, 0x1261, 0x126d, 0x127b, 0x128b, 0x1299,
+	0x12a5, 0x12cd, 0x12d3, 0x12e1, 0x12f5, 0x1303, 0x1330, 0x1342, 0x137a, 0x13a5, 0x13b3, 0x13c1,
+	0x13dd, 0x13e7, 0x13f3, 0x13fb, 0x1409, 0x140f, 0x141b, 0x142b, 0x144a, 0x1452, 0x145e, 0x149b,
+	0x14ba, 0x14c8, 0x14e3, 0x1506, 0x151f, 0x153c, 0x155d, 0x157a, 0x1588, 0x15a7, 0x15c8, 0x15dd,
+	0x15f6, 0x161c, 0x1639, 0x166a, 0x167c, 0x16a7, 0x16b9, 0x16c1, 0x16de, 0x16f5, 0x1701, 0x171a,
+	0x1737, 0x1752, 0x1773,
+}
+
+const urRegionStr = "" +
+	"اسکینسیئن آئلینڈانڈورامتحدہ عرب اماراتافغانستانانٹیگوا اور باربوداانگوئیلاالبانی" +
+	"ہآرمینیانیدرلینڈز انٹیلیزانگولاانٹارکٹیکاارجنٹیناامریکی ساموآآسٹریاآسٹریلیااروبا" +
+	"آلینڈ آئلینڈزآذربائجانبوسنیا اور ہرزیگوویناباربادوسبنگلہ دیشبیلجیمبرکینا فاسوبلغ" +
+	"اریہبحرینبرونڈیبیننسینٹ برتھلیمیبرمودابرونئیبولیویاکریبیائی نیدرلینڈزبرازیلیبہام" +
+	"اسبھوٹانبؤویٹ آئلینڈبوتسوانابیلاروسبیلائزکینیڈاکوکوس (کیلنگ) جزائرکانگو - کنشاسا" +
+	"وسط افریقی جمہوریہکانگو - برازاویلےسوئٹزر لینڈکوٹ ڈی آئیوریکک آئلینڈزچلیکیمرونچی" +
+	"نکولمبیاکلپّرٹن آئلینڈکوسٹا ریکاکیوباکیپ ورڈیکیوراکاؤکرسمس آئلینڈقبرصچیک جمہوریہ" +
+	"جرمنیڈائجو گارسیاجبوتیڈنمارکڈومنیکاڈومنیکن جمہوریہالجیریاسیئوٹا اور میلیلاایکواڈ" +
+	"وراسٹونیامصرمغربی صحارااریٹیریاہسپانیہایتھوپیایوروپی یونینفن لینڈفجیفاکلینڈ جزائ" +
+	"رمائکرونیشیافروئی آئلینڈزفرانسگیبونسلطنت متحدہغرناطہجارجیافرینچ گیاناگوئرنسیگھان" +
+	"اجبل الطارقگرین لینڈگامبیاگنیگواڈیلوپاستوائی گیانایونانجنوبی جارجیا اور جنوبی سی" +
+	"نڈوچ جزائرگواٹے مالاگوآمگنی بساؤگیاناہانگ کانگ SAR چینہیئرڈ آئلینڈ اور میکڈونالڈ" +
+	" آئلینڈزہونڈاروسکروشیاہیتیہنگریکینری آئلینڈزانڈونیشیاآئرلینڈاسرائیلآئل آف مینبھا" +
+	"رتبرطانوی ہندوستانی سمندری خطہعراقایرانآئس لینڈاٹلیجرسیجمائیکااردنجاپانکینیاکرغز" +
+	"ستانکمبوڈیاکریباتیکوموروسسینٹ کٹس اور نیویسشمالی کوریاجنوبی کوریاکویتکیمین آئلین" +
+	"ڈزقزاخستانلاؤسلبنانسینٹ لوسیالیشٹنسٹائنسری لنکالائبیریالیسوتھولتھوانیالگژمبرگلٹو" +
+	"یالیبیامراقشموناکومالدووامونٹے نیگروسینٹ مارٹنمڈغاسکرمارشل آئلینڈزمقدونیہمالیمیا" +
+	"نمار (برما)منگولیامکاؤ SAR چینشمالی ماریانا آئلینڈزمارٹینکموریطانیہمونٹسیراٹمالٹ" +
+	"اماریشسمالدیوملاویمیکسیکوملیشیاموزمبیقنامیبیانیو کلیڈونیانائجرنار فاک آئلینڈنائج" +
+	"یریانکاراگووانیدر لینڈزناروےنیپالنؤرونیئونیوزی ینڈعمانپنامہپیروفرانسیسی پولینیشی" +
+	"اپاپوآ نیو گنیفلپائنیپاکستانپولینڈسینٹ پیئر اور میکلیئونپٹکائرن جزائرپیورٹو ریکو" +
+	"فلسطینی خطےپرتگالپلاؤپیراگوئےقطربیرونی اوشیانیاری یونینرومانیاسربیاروسیروانڈاسعو" +
+	"دی عربسولومن آئلینڈزسشلیزسوڈانسویڈنسنگاپورسینٹ ہیلیناسلووینیاسوالبرڈ اور جان مای" +
+	"نسلوواکیہسیئر لیونسان مارینوسینیگلصومالیہسورینامجنوبی سوڈانساؤ ٹوم اور پرنسپےال " +
+	"سلواڈورسنٹ مارٹنشامسوازی لینڈٹرسٹن ڈا کیونہاترکس اور کیکاؤس جزائرچاڈفرانسیسی جنو" +
+	"بی خطےٹوگوتھائی لینڈتاجکستانٹوکیلاؤتیمور لیسٹترکمانستانتیونیسیاٹونگاترکیترینیداد" +
+	" اور ٹوباگوٹووالوتائیوانتنزانیہیوکرینیوگانڈاامریکہ سے باہر کے چھوٹے جزائزریاستہا" +
+	"ئے متحدہیوروگوئےازبکستانواٹیکن سٹیسینٹ ونسنٹ اور گرینیڈائنزوینزوئیلابرٹش ورجن آئ" +
+	"لینڈزامریکی ورجن آئلینڈزویتناموینوآٹوویلیز اور فیوٹیوناساموآکوسووویمنمایوٹجنوبی " +
+	"افریقہزامبیازمبابوےنامعلوم علاقہدنیاافریقہشمالی امریکہجنوبی امریکہاوشیانیامغربی " +
+	"افریقہوسطی امریکہمشرقی افریقہشمالی افریقہوسطی افریقہجنوبی افریقہ کے علاقہامیریکا" +
+	"زشمالی امریکہ کا علاقہکریبیائیمشرقی ایشیاجنوبی ایشیاجنوب مشرقی ایشیاجنوبی یورپآس" +
+	"ٹریلیشیامالینیشیامائکرونیشیائی علاقہپولینیشیاایشیاوسطی ایشیامغربی ایشیایوروپمشرق" +
+	"ی یورپشمالی یورپمغربی یورپلاطینی امریکہ"
+
+var urRegionIdx = []uint16{ // 291 entries
+	0x0, 0x1f, 0x2b, 0x49, 0x5b, 0x7f, 0x8f, 0x9d, 0xab, 0xcc, 0xd8, 0xec,
+	0xfc, 0x113, 0x11f, 0x12f, 0x139, 0x152, 0x164, 0x18c, 0x19c, 0x1ad, 0x1b9, 0x1ce,
+	0x1dc, 0x1e6, 0x1f2, 0x1fa, 0x213, 0x21f, 0x22b, 0x239, 0x25c, 0x26a, 0x276, 0x282,
+	0x299, 0x2a9, 0x2b7, 0x2c3, 0x2cf, 0x2f1, 0x30a, 0x32c, 0x34b, 0x360, 0x378, 0x38b,
+	0x391, 0x39d, 0x3a3, 0x3b1, 0x3cc, 0x3df, 0x3e9, 0x3f8, 0x408, 0x41f, 0x427, 0x43c,
+	0x446, 0x45d, 0x467, 0x473, 0x481, 0x49e, 0x4ac, 0x4cc, 0x4dc, 0x4ea, 0x4f0, 0x505,
+	0x515, 0x523, 0x533, 0x54a, 0x557, 0x55d, 0x576, 0x58c, 0x5a5, 0x5af, 0x5b9, 0x5ce,
+	0x5da, 0x5e6, 0x5fb, 0x609, 0x613, 0x626, 0x637, 0x643, 0x649, 0x659, 0x672, 0x67c,
+	0x6bd, 0x6d0, 0x6d8, 0x6e7, 0x6f1, 0x70d, 0x74d, 0x75d, 0x769, 0x771, 0x77b, 0x794,
+	0x7a6, 0x7b4, 0x7c2, 0x7d4, 0x7de, 0x813, 0x81b, 0x825, 0x834, 0x83c, 0x844, 0x852,
+	0x85a, 0x864, 0x86e, 0x87e, 0x88c, 0x89a, 0x8a8, 0x8c9, 0x8de, 0x8f3, 0x8fb, 0x914,
+	0x924, 0x92c, 0x936, 0x949, 0x95d, 0x96c, 0x97c, 0x98a, 0x99a, 0x9a8, 0x9b2, 0x9bc,
+	0x9c6, 0x9d2, 0x9e0, 0x9f5, 0xa08, 0xa16, 0xa2f, 0xa3d, 0xa45, 0xa5e, 0xa6c, 0xa7f,
+	0xaa7, 0xab5, 0xac7, 0xad9, 0xae3, 0xaef, 0xafb, 0xb05, 0xb13, 0xb1f, 0xb2d, 0xb3b,
+	0xb52, 0xb5c, 0xb76, 0xb86, 0xb98, 0xbab, 0xbb5, 0xbbf, 0xbc7, 0xbcf, 0xbe0, 0xbe8,
+	0xbf2, 0xbfa, 0xc1d, 0xc35, 0xc43, 0xc51, 0xc5d, 0xc86, 0xc9f, 0xcb4, 0xcc9, 0xcd5,
+	0xcdd, 0xced, 0xcf3, 0xd10, 0xd1f, 0xd2d, 0xd37, 0xd3f, 0xd4b, 0xd5c, 0xd77, 0xd81,
+	0xd8b, 0xd95, 0xda3, 0xdb8, 0xdc8, 0xded, 0xdfd, 0xe0e, 0xe21, 0xe2d, 0xe3b, 0xe49,
+	0xe5e, 0xe7f, 0xe92, 0xea3, 0xea9, 0xebc, 0xed8, 0xeff, 0xf05, 0xf27, 0xf2f, 0xf42,
+	0xf52, 0xf60, 0xf73, 0xf87, 0xf97, 0xfa1, 0xfa9, 0xfcd, 0xfd9, 0xfe7, 0xff5, 0x1001,
+	0x100f, 0x1044, 0x1061, 0x1071, 0x1081, 0x1094, 0x10c3, 0x10d5, 0x10f5, 0x1119, 0x1125, 0x1133,
+	0x1155, 0x115f, 0x116b, 0x1171, 0x117b, 0x1192, 0x119e, 0x11ac, 0x11c5, 0x11cd, 0x11d9, 0x11f0,
+	0x1207, 0x1217, 0x122e, 0x1243, 0x125a, 0x1271, 0x1286, 0x12ad, 0x12bd, 0x12e4, 0x12f4, 0x1309,
+	0x131e, 0x133c, 0x134f, 0x1363, 0x1375, 0x139a, 0x13ac, 0x13b6, 0x13c9, 0x13de, 0x13e8, 0x13fb,
+	0x140e, 0x1421, 0x143a,
+}
+
+const uzRegionStr = "" +
+	"Vozneseniye oroliAndorraBirlashgan Arab AmirliklariAfgʻonistonAntigua va Barbado" +
+	"sAngilaAlbaniyaArmanistonAngolaAntarktikaArgentinaAmerika SamoasiAvstriyaAvstral" +
+	"iyaArubaAland orollariOzarbayjonBosniya va GertsegovinaBarbadosBangladeshBelgiya" +
+	"Burkina-FasoBolgariyaBahraynBurundiBeninMuqaddas VarfalomeyBermudaBruneyBoliviya" +
+	"Karib NiderlandiyasiBraziliyaBagama orollariButanBuvet oroliBotsvannaBelorusiyaB" +
+	"elizKanadaKokos (Kiling) orollariKongo-KinshasaMarkaziy Afrika RespublikasiKongo" +
+	" BrazzavilShveysariyaKot-d-IvuarKuk orollariChiliKamerunXitoyKolumbiyaKlipperton" +
+	" oroliKosta-RikaKubaKabo-VerdeKurasaoRojdestvo oroliKiprChexiya RespublikasiOlmo" +
+	"niyaDiyego GarsiyaDjibutiDaniyaDominikaDominikan RespublikasiJazoirSeyta va Meli" +
+	"llaEkvadorEstoniyaMisrGʻarbiy Sahroi KabirEritreyaIspaniyaEfiopiyaYevropa Ittifo" +
+	"qiFinlyandiyaFiji orollariFolklend orollariMikroneziyaFarer orollariFransiyaGabo" +
+	"nBirlashgan QirollikGrenadaGruziyaFrantsuz GvianasiGernsiGanaGibraltarGrenlandiy" +
+	"aGambiyaGvineyaGvadelupeEkvatorial GvineyaGretsiyaJanubiy Djordjiya va Janubiy S" +
+	"endvich orollariGvatemalaGuamGvineya-BisauGayanaGonkong Xitoy MMHGerd oroli va M" +
+	"akDonald orollariGondurasXorvatiyaGaitiVengriyaKanar orollariIndoneziyaIrlandiya" +
+	"IsroilMen oroliHindistonBritaniya Hind okeani hududiIroqEronIslandiyaItaliyaDjer" +
+	"siYamaykaIordaniyaYaponiyaKeniyaQirgʻizistonKambodjaKiribatiKomor orollariSent-K" +
+	"its va NevisShimoliy KoreyaJanubiy KoreyaKuvaytKayman orollariQozogʻistonLaosLiv" +
+	"anSent-LyusiyaLixtenshteynShri-LankaLiberiyaLesotoLitvaLyuksemburgLatviyaLiviyaM" +
+	"arokashMonakoMoldovaChernogoriyaSent-MartinMadagaskarMarshal orollariMakedoniyaM" +
+	"aliMyanma (Birma)MugʻulistonMakao Xitoy MMHShimoliy Marianna orollariMartinikaMa" +
+	"vritaniyaMontserratMaltaMavrikiyMaldiv orollariMalaviMeksikaMalayziyaMozambikNam" +
+	"ibiyaYangi KaledoniyaNigerNorfolk orollariNigeriyaNikaraguaNiderlandiyaNorvegiya" +
+	"NepalNauruNiuyeYangi ZelandiyaUmmonPanamaPeruFrantsuz PolineziyasiPapua Yangi Gv" +
+	"ineyaFilippinPokistonPolshaSent-Pyer va MikelonPitkarin orollariPuerto-RikoFalas" +
+	"tin hududiPortugaliyaPalauParagvayQatarYondosh OkeaniyaReyunonRuminiyaSerbiyaRos" +
+	"siyaRuandaSaudiya ArabistoniSolomon orollariSeyshel orollariSudanShvetsiyaSingap" +
+	"urMuqaddas Yelena orollariSloveniyaSavlbard va Jan MayenSlovakiyaSyerra-LeoneSan" +
+	"-MarinoSenegalSomaliSurinamJanubiy SudanSan-Tome va PrinsipiEl-SalvadorSint-Maar" +
+	"tenSuriyaSvazilendTristan de KunaTurks va Kaykos orollariChadFrantsiya janubiy x" +
+	"ududlariTogoTaylandTojikistonTokelauSharqiy-TimorTurkmanistonTunisTongaTurkiyaTr" +
+	"inidad va TobagoTuvaluTayvanTanzaniyaUkrainaUgandaAQSH yondosh orollariQoʻshma S" +
+	"htatlarUrugvayOʻzbekistonVatikanSent-Vinsent va GrenadinVenesuelaBritaniya Virdj" +
+	"iniya orollariAQSH Virdjiniya orollariVyetnamVanuatuUellis va FutunaSamoaKosovoY" +
+	"amanMayottaJanubiy AfrikaZambiyaZimbabveNomaʼlum hududDunyoAfrikaShimoliy Amerik" +
+	"aJanubiy AmerikaOkeaniyaGʻarbiy AfrikaMarkaziy AmerikaSharqiy AfrikaShimoliy Afr" +
+	"ikaMarkaziy AfrikaJanubi-AfrikaAmerikaShimoli-AmerikaKarib havzasiSharqiy OsiyoJ" +
+	"anubiy OsiyoJanubiy-Sharqiy OsiyoJanubiy YevropaAvstralaziyaMelaneziyaMikroneziy" +
+	"a mintaqasiPolineziyaOsiyoMarkaziy OsiyoGʻarbiy OsiyoYevropaSharqiy YevropaShimo" +
+	"liy YevropaGʻarbiy YevropaLotin Amerikasi"
+
+var uzRegionIdx = []uint16{ // 291 entries
+	0x0, 0x11, 0x18, 0x33, 0x3f, 0x52, 0x58, 0x60, 0x6a, 0x6a, 0x70, 0x7a,
+	0x83, 0x92, 0x9a, 0xa4, 0xa9, 0xb7, 0xc1, 0xd8, 0xe0, 0xea, 0xf1, 0xfd,
+	0x106, 0x10d, 0x114, 0x119, 0x12c, 0x133, 0x139, 0x141, 0x155, 0x15e, 0x16d, 0x172,
+	0x17d, 0x186, 0x190, 0x195, 0x19b, 0x1b2, 0x1c0, 0x1dc, 0x1eb, 0x1f6, 0x201, 0x20d,
+	0x212, 0x219, 0x21e, 0x227, 0x237, 0x241, 0x245, 0x24f, 0x256, 0x265, 0x269, 0x27d,
+	0x285, 0x293, 0x29a, 0x2a0, 0x2a8, 0x2be, 0x2c4, 0x2d4, 0x2db, 0x2e3, 0x2e7, 0x2fc,
+	0x304, 0x30c, 0x314, 0x324, 0x32f, 0x33c, 0x34d, 0x358, 0x366, 0x36e, 0x373, 0x386,
+	0x38d, 0x394, 0x3a5, 0x3ab, 0x3af, 0x3b8, 0x3c3, 0x3ca, 0x3d1, 0x3da, 0x3ec, 0x3f4,
+	0x422, 0x42b, 0x42f, 0x43c, 0x442, 0x453, 0x473, 0x47b, 0x484, 0x489, 0x491, 0x49f,
+	0x4a9, 0x4b2, 0x4b8, 0x4c1, 0x4ca, 0x4e6, 0x4ea, 0x4ee, 0x4f7, 0x4fe, 0x504, 0x50b,
+	0x514, 0x51c, 0x522, 0x52f, 0x537, 0x53f, 0x54d, 0x55f, 0x56e, 0x57c, 0x582, 0x591,
+	0x59d, 0x5a1, 0x5a6, 0x5b2, 0x5be, 0x5c8, 0x5d0, 0x5d6, 0x5db, 0x5e6, 0x5ed, 0x5f3,
+	0x5fb, 0x601, 0x608, 0x614, 0x61f, 0x629, 0x639, 0x643, 0x647, 0x655, 0x661, 0x670,
+	0x68a, 0x693, 0x69e, 0x6a8, 0x6ad, 0x6b5, 0x6c4, 0x6ca, 0x6d1, 0x6da, 0x6e2, 0x6ea,
+	0x6fa, 0x6ff, 0x70f, 0x717, 0x720, 0x72c, 0x735, 0x73a, 0x73f, 0x744, 0x753, 0x758,
+	0x75e, 0x762, 0x777, 0x78a, 0x792, 0x79a, 0x7a0, 0x7b4, 0x7c5, 0x7d0, 0x7df, 0x7ea,
+	0x7ef, 0x7f7, 0x7fc, 0x80c, 0x813, 0x81b, 0x822, 0x829, 0x82f, 0x841, 0x851, 0x861,
+	0x866, 0x86f, 0x877, 0x88f, 0x898, 0x8ad, 0x8b6, 0x8c2, 0x8cc, 0x8d3, 0x8d9, 0x8e0,
+	0x8ed, 0x901, 0x90c, 0x918, 0x91e, 0x927, 0x936, 0x94e, 0x952, 0x96d, 0x971, 0x978,
+	0x982, 0x989, 0x996, 0x9a2, 0x9a7, 0x9ac, 0x9b3, 0x9c5, 0x9cb, 0x9d1, 0x9da, 0x9e1,
+	0x9e7, 0x9fc, 0xa0d, 0xa14, 0xa20, 0xa27, 0xa3f, 0xa48, 0xa65, 0xa7d, 0xa84, 0xa8b,
+	0xa9b, 0xaa0, 0xaa6, 0xaab, 0xab2, 0xac0, 0xac7, 0xacf, 0xade, 0xae3, 0xae9, 0xaf9,
+	0xb08, 0xb10, 0xb1f, 0xb2f, 0xb3d, 0xb4c, 0xb5b, 0xb68, 0xb6f, 0xb7e, 0xb8b, 0xb98,
+	0xba5, 0xbba, 0xbc9, 0xbd5, 0xbdf, 0xbf4, 0xbfe, 0xc03, 0xc11, 0xc1f, 0xc26, 0xc35,
+	0xc45, 0xc55, 0xc64,
+}
+
+const viRegionStr = "" +
+	"Đảo AscensionAndorraCác Tiểu Vương quốc Ả Rập Thống nhấtAfghanistanAntigua và Ba" +
+	"rbudaAnguillaAlbaniArmeniaTây Ấn Hà LanAngolaNam CựcArgentinaĐảo Somoa thuộc MỹÁ" +
+	"oÚcArubaQuần đảo ÅlandAzerbaijanBosnia và HerzegovinaBarbadosBangladeshBỉBurkina" +
+	" FasoBungariBahrainBurundiBeninSaint BarthélemyBermudaBruneiBoliviaCaribe Hà Lan" +
+	"BrazilBahamasBhutanĐảo BouvetBotswanaBelarusBelizeCanadaQuần đảo CocosCongo - Ki" +
+	"nshasaCộng hòa Trung PhiCongo - BrazzavilleThụy SĩCôte d’IvoireQuần đảo CookChil" +
+	"eCameroonTrung QuốcColombiaĐảo ClippertonCosta RicaCubaCape VerdeCuraçaoĐảo Gián" +
+	"g SinhSípCộng hòa SécĐứcDiego GarciaDjiboutiĐan MạchDominicaCộng hòa DominicaAlg" +
+	"eriaCeuta và MelillaEcuadorEstoniaAi CậpTây SaharaEritreaTây Ban NhaEthiopiaLiên" +
+	" Minh Châu ÂuPhần LanFijiQuần đảo FalklandMicronesiaQuần đảo FaroePhápGabonVương" +
+	" quốc AnhGrenadaGeorgiaQuiana thuộc PhápGuernseyGhanaGibraltarGreenlandGambiaGui" +
+	"neaGuadeloupeGuinea Xích ĐạoHy LạpQuần đảo Nam Georgia và Nam SandwichGuatemalaG" +
+	"uamGuinea-BissauGuyanaĐặc khu hành chính Hồng Kông - Trung QuốcĐảo Heard và Quần" +
+	" đảo McDonaldHondurasCroatiaHaitiHungariQuần đảo CanaryIndonesiaAi-lenIsraelĐảo " +
+	"ManẤn ĐộThuộc địa Anh tại Ấn Độ DươngI-rắcIranIcelandÝJerseyJamaicaJordanNhật Bả" +
+	"nKenyaKyrgyzstanCampuchiaKiribatiComorosSaint Kitts và NevisTriều TiênHàn QuốcCô" +
+	"-oétQuần đảo CaymanKazakhstanLàoLi-băngSaint LuciaLiechtensteinSri LankaLIberiaL" +
+	"esothoLít-vaLuxembourgLatviaLi-biMa-rốcMonacoMoldovaMontenegroSaint MartinMadaga" +
+	"scarQuần đảo MarshallMacedoniaMaliMyanmar (Miến Điện)Mông CổĐặc khu hành chính M" +
+	"acao - Trung QuốcQuần đảo Bắc MarianaMartiniqueMauritaniaMontserratMaltaMauritiu" +
+	"sMaldivesMalawiMexicoMalaysiaMozambiqueNamibiaNew CaledoniaNigerĐảo NorfolkNiger" +
+	"iaNicaraguaHà LanNa UyNepalNauruNiueNew ZealandOmanPanamaPeruPolynesia thuộc Phá" +
+	"pPapua New GuineaPhilippinPakistanBa LanSaint Pierre và MiquelonQuần đảo Pitcair" +
+	"nPuerto RicoLãnh thổ PalestineBồ Đào NhaPalauParaguayQatarVùng xa xôi thuộc Châu" +
+	" Đại DươngRéunionRomaniaSerbiaNgaRwandaẢ Rập Xê-útQuần đảo SolomonSeychellesSuda" +
+	"nThụy ĐiểnSingaporeSaint HelenaSloveniaSvalbard và Jan MayenSlovakiaSierra Leone" +
+	"San MarinoSenegalSomaliSurinameNam SudanSão Tomé và PríncipeEl SalvadorSint Maar" +
+	"tenSyriaSwazilandTristan da CunhaQuần đảo Turk và CaicosChadThuộc địa Miền Nam c" +
+	"ủa PhápTogoThái LanTajikistanTokelauTimor-LesteTurkmenistanTunisiaTongaThổ Nhĩ K" +
+	"ỳTrinidad và TobagoTuvaluĐài LoanTanzaniaUkrainaUgandaCác đảo nhỏ xa trung tâm t" +
+	"huộc MỹHoa KỳUruguayUzbekistanThành VaticanSaint Vincent và GrenadinesVenezuelaQ" +
+	"uần đảo Virgin thuộc AnhQuần đảo Virgin thuộc MỹViệt NamVanuatuWallis và FutunaS" +
+	"amoaKosovoYemenMayotteNam PhiZambiaZimbabweVùng Chưa biết hoặc không Hợp lệThế g" +
+	"iớiChâu PhiBắc MỹNam MỹChâu Đại DươngTây PhiTrung MỹĐông PhiBắc PhiTrung PhiMiền" +
+	" Nam Châu PhiChâu MỹMiền Bắc Châu MỹCa-ri-bêĐông ÁNam ÁĐông Nam ÁNam ÂuÚc và New" +
+	" ZealandMelanesiaVùng MicronesianPolynesiaChâu ÁTrung ÁTây ÁÂu ChâuĐông ÂuMiền B" +
+	"ắc Châu ÂuTây ÂuChâu Mỹ La-tinh"
+
+var viRegionIdx = []uint16{ // 291 entries
+	0x0, 0x10, 0x17, 0x4a, 0x55, 0x68, 0x70, 0x76, 0x7d, 0x8e, 0x94, 0x9d,
+	0xa6, 0xbf, 0xc2, 0xc5, 0xca, 0xde, 0xe8, 0xfe, 0x106, 0x110, 0x114, 0x120,
+	0x127, 0x12e, 0x135, 0x13a, 0x14b, 0x152, 0x158, 0x15f, 0x16d, 0x173, 0x17a, 0x180,
+	0x18d, 0x195, 0x19c, 0x1a2, 0x1a8, 0x1bb, 0x1cb, 0x1e0, 0x1f3, 0x1fd, 0x20d, 0x21f,
+	0x224, 0x22c, 0x238, 0x240, 0x251, 0x25b, 0x25f, 0x269, 0x271, 0x283, 0x287, 0x297,
+	0x29d, 0x2a9, 0x2b1, 0x2bc, 0x2c4, 0x2d8, 0x2df, 0x2f0, 0x2f7, 0x2fe, 0x306, 0x311,
+	0x318, 0x324, 0x32c, 0x340, 0x34a, 0x34e, 0x364, 0x36e, 0x381, 0x386, 0x38b, 0x39d,
+	0x3a4, 0x3ab, 0x3bf, 0x3c7, 0x3cc, 0x3d5, 0x3de, 0x3e4, 0x3ea, 0x3f4, 0x407, 0x40f,
+	0x439, 0x442, 0x446, 0x453, 0x459, 0x48c, 0x4b3, 0x4bb, 0x4c2, 0x4c7, 0x4ce, 0x4e2,
+	0x4eb, 0x4f1, 0x4f7, 0x501, 0x50b, 0x536, 0x53d, 0x541, 0x548, 0x54a, 0x550, 0x557,
+	0x55d, 0x569, 0x56e, 0x578, 0x581, 0x589, 0x590, 0x5a5, 0x5b2, 0x5bd, 0x5c5, 0x5d9,
+	0x5e3, 0x5e7, 0x5ef, 0x5fa, 0x607, 0x610, 0x617, 0x61e, 0x625, 0x62f, 0x635, 0x63a,
+	0x642, 0x648, 0x64f, 0x659, 0x665, 0x66f, 0x685, 0x68e, 0x692, 0x6aa, 0x6b4, 0x6e0,
+	0x6fb, 0x705, 0x70f, 0x719, 0x71e, 0x727, 0x72f, 0x735, 0x73b, 0x743, 0x74d, 0x754,
+	0x761, 0x766, 0x774, 0x77b, 0x784, 0x78b, 0x790, 0x795, 0x79a, 0x79e, 0x7a9, 0x7ad,
+	0x7b3, 0x7b7, 0x7ce, 0x7de, 0x7e7, 0x7ef, 0x7f5, 0x80e, 0x824, 0x82f, 0x844, 0x852,
+	0x857, 0x85f, 0x864, 0x88e, 0x896, 0x89d, 0x8a3, 0x8a6, 0x8ac, 0x8bd, 0x8d2, 0x8dc,
+	0x8e1, 0x8ef, 0x8f8, 0x904, 0x90c, 0x922, 0x92a, 0x936, 0x940, 0x947, 0x94d, 0x955,
+	0x95e, 0x976, 0x981, 0x98d, 0x992, 0x99b, 0x9ab, 0x9c8, 0x9cc, 0x9f1, 0x9f5, 0x9fe,
+	0xa08, 0xa0f, 0xa1a, 0xa26, 0xa2d, 0xa32, 0xa41, 0xa54, 0xa5a, 0xa64, 0xa6c, 0xa73,
+	0xa79, 0xaa5, 0xaad, 0xab4, 0xabe, 0xacc, 0xae8, 0xaf1, 0xb11, 0xb32, 0xb3c, 0xb43,
+	0xb54, 0xb59, 0xb5f, 0xb64, 0xb6b, 0xb72, 0xb78, 0xb80, 0xbab, 0xbb7, 0xbc0, 0xbca,
+	0xbd2, 0xbe6, 0xbee, 0xbf8, 0xc02, 0xc0b, 0xc14, 0xc28, 0xc32, 0xc49, 0xc52, 0xc5b,
+	0xc61, 0xc6e, 0xc75, 0xc88, 0xc91, 0xca2, 0xcab, 0xcb3, 0xcbb, 0xcc2, 0xccb, 0xcd5,
+	0xceb, 0xcf3, 0xd05,
+}
+
+const zhRegionStr = "" +
+	"阿森松岛安道尔阿拉伯联合酋长国阿富汗安提瓜和巴布达安圭拉阿尔巴尼亚亚美尼亚荷属安的列斯群岛安哥拉南极洲阿根廷美属萨摩亚奥地利澳大利亚阿鲁巴奥兰群岛阿塞拜疆波斯尼" +
+	"亚和黑塞哥维那巴巴多斯孟加拉国比利时布基纳法索保加利亚巴林布隆迪贝宁圣巴泰勒米百慕大文莱玻利维亚荷兰加勒比区巴西巴哈马不丹布维特岛博茨瓦纳白俄罗斯伯利兹加拿大科" +
+	"科斯（基林）群岛刚果（金）中非共和国刚果（布）瑞士科特迪瓦库克群岛智利喀麦隆中国哥伦比亚克利珀顿岛哥斯达黎加古巴佛得角库拉索圣诞岛塞浦路斯捷克共和国德国迪戈加西" +
+	"亚岛吉布提丹麦多米尼克多米尼加共和国阿尔及利亚休达及梅利利亚厄瓜多尔爱沙尼亚埃及西撒哈拉厄立特里亚西班牙埃塞俄比亚欧盟芬兰斐济福克兰群岛密克罗尼西亚法罗群岛法国" +
+	"加蓬英国格林纳达格鲁吉亚法属圭亚那根西岛加纳直布罗陀格陵兰冈比亚几内亚瓜德罗普赤道几内亚希腊南乔治亚岛和南桑威齐群岛危地马拉关岛几内亚比绍圭亚那中国香港特别行政" +
+	"区赫德岛和麦克唐纳群岛洪都拉斯克罗地亚海地匈牙利加纳利群岛印度尼西亚爱尔兰以色列曼岛印度英属印度洋领地伊拉克伊朗冰岛意大利泽西岛牙买加约旦日本肯尼亚吉尔吉斯斯坦" +
+	"柬埔寨基里巴斯科摩罗圣基茨和尼维斯朝鲜韩国科威特开曼群岛哈萨克斯坦老挝黎巴嫩圣卢西亚列支敦士登斯里兰卡利比里亚莱索托立陶宛卢森堡拉脱维亚利比亚摩洛哥摩纳哥摩尔多" +
+	"瓦黑山共和国法属圣马丁马达加斯加马绍尔群岛马其顿马里缅甸蒙古中国澳门特别行政区北马里亚纳群岛马提尼克毛里塔尼亚蒙特塞拉特马耳他毛里求斯马尔代夫马拉维墨西哥马来西" +
+	"亚莫桑比克纳米比亚新喀里多尼亚尼日尔诺福克岛尼日利亚尼加拉瓜荷兰挪威尼泊尔瑙鲁纽埃新西兰阿曼巴拿马秘鲁法属波利尼西亚巴布亚新几内亚菲律宾巴基斯坦波兰圣皮埃尔和密" +
+	"克隆群岛皮特凯恩群岛波多黎各巴勒斯坦领土葡萄牙帕劳巴拉圭卡塔尔大洋洲边远群岛留尼汪罗马尼亚塞尔维亚俄罗斯卢旺达沙特阿拉伯所罗门群岛塞舌尔苏丹瑞典新加坡圣赫勒拿斯" +
+	"洛文尼亚斯瓦尔巴特和扬马延斯洛伐克塞拉利昂圣马力诺塞内加尔索马里苏里南南苏丹圣多美和普林西比萨尔瓦多荷属圣马丁叙利亚斯威士兰特里斯坦-达库尼亚群岛特克斯和凯科斯" +
+	"群岛乍得法属南部领地多哥泰国塔吉克斯坦托克劳东帝汶土库曼斯坦突尼斯汤加土耳其特立尼达和多巴哥图瓦卢台湾坦桑尼亚乌克兰乌干达美国本土外小岛屿美国乌拉圭乌兹别克斯坦" +
+	"梵蒂冈圣文森特和格林纳丁斯委内瑞拉英属维京群岛美属维京群岛越南瓦努阿图瓦利斯和富图纳萨摩亚科索沃也门马约特南非赞比亚津巴布韦未知地区世界非洲北美洲南美洲大洋洲西" +
+	"非中美洲东非北非中非南部非洲美洲美洲北部加勒比地区东亚南亚东南亚南欧澳大拉西亚美拉尼西亚密克罗尼西亚地区玻利尼西亚亚洲中亚西亚欧洲东欧北欧西欧拉丁美洲"
+
+var zhRegionIdx = []uint16{ // 291 entries
+	0x0, 0xc, 0x15, 0x2d, 0x36, 0x4b, 0x54, 0x63, 0x6f, 0x87, 0x90, 0x99,
+	0xa2, 0xb1, 0xba, 0xc6, 0xcf, 0xdb, 0xe7, 0x105, 0x111, 0x11d, 0x126, 0x135,
+	0x141, 0x147, 0x150, 0x156, 0x165, 0x16e, 0x174, 0x180, 0x192, 0x198, 0x1a1, 0x1a7,
+	0x1b3, 0x1bf, 0x1cb, 0x1d4, 0x1dd, 0x1f8, 0x207, 0x216, 0x225, 0x22b, 0x237, 0x243,
+	0x249, 0x252, 0x258, 0x264, 0x273, 0x282, 0x288, 0x291, 0x29a, 0x2a3, 0x2af, 0x2be,
+	0x2c4, 0x2d6, 0x2df, 0x2e5, 0x2f1, 0x306, 0x315, 0x32a, 0x336, 0x342, 0x348, 0x354,
+	0x363, 0x36c, 0x37b, 0x381, 0x387, 0x38d, 0x39c, 0x3ae, 0x3ba, 0x3c0, 0x3c6, 0x3cc,
+	0x3d8, 0x3e4, 0x3f3, 0x3fc, 0x402, 0x40e, 0x417, 0x420, 0x429, 0x435, 0x444, 0x44a,
+	0x46e, 0x47a, 0x480, 0x48f, 0x498, 0x4b3, 0x4d1, 0x4dd, 0x4e9, 0x4ef, 0x4f8, 0x507,
+	0x516, 0x51f, 0x528, 0x52e, 0x534, 0x549, 0x552, 0x558, 0x55e, 0x567, 0x570, 0x579,
+	0x57f, 0x585, 0x58e, 0x5a0, 0x5a9, 0x5b5, 0x5be, 0x5d3, 0x5d9, 0x5df, 0x5e8, 0x5f4,
+	0x603, 0x609, 0x612, 0x61e, 0x62d, 0x639, 0x645, 0x64e, 0x657, 0x660, 0x66c, 0x675,
+	0x67e, 0x687, 0x693, 0x6a2, 0x6b1, 0x6c0, 0x6cf, 0x6d8, 0x6de, 0x6e4, 0x6ea, 0x705,
+	0x71a, 0x726, 0x735, 0x744, 0x74d, 0x759, 0x765, 0x76e, 0x777, 0x783, 0x78f, 0x79b,
+	0x7ad, 0x7b6, 0x7c2, 0x7ce, 0x7da, 0x7e0, 0x7e6, 0x7ef, 0x7f5, 0x7fb, 0x804, 0x80a,
+	0x813, 0x819, 0x82e, 0x843, 0x84c, 0x858, 0x85e, 0x87c, 0x88e, 0x89a, 0x8ac, 0x8b5,
+	0x8bb, 0x8c4, 0x8cd, 0x8e2, 0x8eb, 0x8f7, 0x903, 0x90c, 0x915, 0x924, 0x933, 0x93c,
+	0x942, 0x948, 0x951, 0x95d, 0x96c, 0x987, 0x993, 0x99f, 0x9ab, 0x9b7, 0x9c0, 0x9c9,
+	0x9d2, 0x9ea, 0x9f6, 0xa05, 0xa0e, 0xa1a, 0xa39, 0xa54, 0xa5a, 0xa6c, 0xa72, 0xa78,
+	0xa87, 0xa90, 0xa99, 0xaa8, 0xab1, 0xab7, 0xac0, 0xad8, 0xae1, 0xae7, 0xaf3, 0xafc,
+	0xb05, 0xb1d, 0xb23, 0xb2c, 0xb3e, 0xb47, 0xb65, 0xb71, 0xb83, 0xb95, 0xb9b, 0xba7,
+	0xbbc, 0xbc5, 0xbce, 0xbd4, 0xbdd, 0xbe3, 0xbec, 0xbf8, 0xc04, 0xc0a, 0xc10, 0xc19,
+	0xc22, 0xc2b, 0xc31, 0xc3a, 0xc40, 0xc46, 0xc4c, 0xc58, 0xc5e, 0xc6a, 0xc79, 0xc7f,
+	0xc85, 0xc8e, 0xc94, 0xca3, 0xcb2, 0xcca, 0xcd9, 0xcdf, 0xce5, 0xceb, 0xcf1, 0xcf7,
+	0xcfd, 0xd03, 0xd0f,
+}
+
+const zhHantRegionStr = "" +
+	"阿森松島安道爾阿拉伯聯合大公國阿富汗安地卡及巴布達安圭拉島阿爾巴尼亞亞美尼亞荷屬安地列斯安哥拉南極洲阿根廷美屬薩摩亞群島奧地利澳洲阿路巴奧蘭群島亞塞拜然波士尼亞" +
+	"與赫塞格維納巴貝多孟加拉比利時布吉納法索保加利亞巴林蒲隆地貝寧聖巴瑟米百慕達汶萊玻利維亞荷蘭加勒比區巴西巴哈馬不丹布威島波札那白俄羅斯貝里斯加拿大可可斯群島剛果" +
+	"（金夏沙）中非共和國剛果（布拉薩）瑞士科特迪瓦庫克群島智利喀麥隆中華人民共和國哥倫比亞克里派頓島哥斯大黎加古巴維德角庫拉索聖誕島賽普勒斯捷克共和國德國迪亞哥加西" +
+	"亞島吉布地丹麥多米尼克多明尼加共和國阿爾及利亞休達與梅利利亞厄瓜多愛沙尼亞埃及西撒哈拉厄利垂亞西班牙衣索比亞歐盟芬蘭斐濟福克蘭群島密克羅尼西亞群島法羅群島法國加" +
+	"彭英國格瑞那達喬治亞共和國法屬圭亞那根西島迦納直布羅陀格陵蘭甘比亞幾內亞瓜地洛普赤道幾內亞希臘南喬治亞與南三明治群島瓜地馬拉關島幾內亞比紹蓋亞納中華人民共和國香" +
+	"港特別行政區赫德與麥克當諾群島宏都拉斯克羅埃西亞海地匈牙利加那利群島印尼愛爾蘭以色列曼島印度英屬印度洋領土伊拉克伊朗冰島義大利澤西島牙買加約旦日本肯亞吉爾吉斯柬" +
+	"埔寨吉里巴斯科摩羅群島聖克里斯多福及尼維斯北韓南韓科威特開曼群島哈薩克寮國黎巴嫩聖露西亞列支敦士登斯里蘭卡賴比瑞亞賴索扥立陶宛盧森堡拉脫維亞利比亞摩洛哥摩納哥摩" +
+	"爾多瓦蒙特內哥羅法屬聖馬丁馬達加斯加馬紹爾群島馬其頓馬利緬甸蒙古中華人民共和國澳門特別行政區北馬里亞納群島馬丁尼克島茅利塔尼亞蒙塞拉特島馬爾他模里西斯馬爾地夫馬" +
+	"拉威墨西哥馬來西亞莫三比克納米比亞新喀里多尼亞群島尼日諾福克島奈及利亞尼加拉瓜荷蘭挪威尼泊爾諾魯紐威島紐西蘭阿曼王國巴拿馬秘魯法屬玻里尼西亞巴布亞紐幾內亞菲律賓" +
+	"巴基斯坦波蘭聖皮埃爾和密克隆群島皮特肯群島波多黎各巴勒斯坦自治區葡萄牙帛琉巴拉圭卡達大洋洲邊疆群島留尼旺羅馬尼亞塞爾維亞俄羅斯盧安達沙烏地阿拉伯索羅門群島塞席爾" +
+	"蘇丹瑞典新加坡聖赫勒拿島斯洛維尼亞冷岸及央麥恩群島斯洛伐克獅子山聖馬利諾塞內加爾索馬利亞蘇利南南蘇丹聖多美及普林西比薩爾瓦多荷屬聖馬丁敘利亞史瓦濟蘭特里斯坦達庫" +
+	"尼亞群島土克斯及開科斯群島查德法屬南方屬地多哥共和國泰國塔吉克托克勞群島東帝汶土庫曼突尼西亞東加土耳其千里達及托巴哥吐瓦魯台灣坦尚尼亞烏克蘭烏干達美國本土外小島" +
+	"嶼美國烏拉圭烏茲別克梵蒂岡聖文森及格瑞那丁委內瑞拉英屬維京群島美屬維京群島越南萬那杜瓦利斯和富圖納群島薩摩亞群島科索沃葉門馬約特南非尚比亞辛巴威未知區域世界非洲" +
+	"北美洲南美洲大洋洲西非中美東非北非中非非洲南部美洲北美加勒比海東亞南亞東南亞南歐澳洲與紐西蘭美拉尼西亞密克羅尼西亞玻里尼西亞亞洲中亞西亞歐洲東歐北歐西歐拉丁美洲" +
+	""
+
+var zhHantRegionIdx = []uint16{ // 291 entries
+	0x0, 0xc, 0x15, 0x2d, 0x36, 0x4b, 0x57, 0x66, 0x72, 0x84, 0x8d, 0x96,
+	0x9f, 0xb4, 0xbd, 0xc3, 0xcc, 0xd8, 0xe4, 0x102, 0x10b, 0x114, 0x11d, 0x12c,
+	0x138, 0x13e, 0x147, 0x14d, 0x159, 0x162, 0x168, 0x174, 0x186, 0x18c, 0x195, 0x19b,
+	0x1a4, 0x1ad, 0x1b9, 0x1c2, 0x1cb, 0x1da, 0x1ef, 0x1fe, 0x213, 0x219, 0x225, 0x231,
+	0x237, 0x240, 0x255, 0x261, 0x270, 0x27f, 0x285, 0x28e, 0x297, 0x2a0, 0x2ac, 0x2bb,
+	0x2c1, 0x2d6, 0x2df, 0x2e5, 0x2f1, 0x306, 0x315, 0x32a, 0x333, 0x33f, 0x345, 0x351,
+	0x35d, 0x366, 0x372, 0x378, 0x37e, 0x384, 0x393, 0x3ab, 0x3b7, 0x3bd, 0x3c3, 0x3c9,
+	0x3d5, 0x3e7, 0x3f6, 0x3ff, 0x405, 0x411, 0x41a, 0x423, 0x42c, 0x438, 0x447, 0x44d,
+	0x46e, 0x47a, 0x480, 0x48f, 0x498, 0x4c2, 0x4dd, 0x4e9, 0x4f8, 0x4fe, 0x507, 0x516,
+	0x51c, 0x525, 0x52e, 0x534, 0x53a, 0x54f, 0x558, 0x55e, 0x564, 0x56d, 0x576, 0x57f,
+	0x585, 0x58b, 0x591, 0x59d, 0x5a6, 0x5b2, 0x5c1, 0x5df, 0x5e5, 0x5eb, 0x5f4, 0x600,
+	0x609, 0x60f, 0x618, 0x624, 0x633, 0x63f, 0x64b, 0x654, 0x65d, 0x666, 0x672, 0x67b,
+	0x684, 0x68d, 0x699, 0x6a8, 0x6b7, 0x6c6, 0x6d5, 0x6de, 0x6e4, 0x6ea, 0x6f0, 0x71a,
+	0x72f, 0x73e, 0x74d, 0x75c, 0x765, 0x771, 0x77d, 0x786, 0x78f, 0x79b, 0x7a7, 0x7b3,
+	0x7cb, 0x7d1, 0x7dd, 0x7e9, 0x7f5, 0x7fb, 0x801, 0x80a, 0x810, 0x819, 0x822, 0x82e,
+	0x837, 0x83d, 0x852, 0x867, 0x870, 0x87c, 0x882, 0x8a0, 0x8af, 0x8bb, 0x8d0, 0x8d9,
+	0x8df, 0x8e8, 0x8ee, 0x903, 0x90c, 0x918, 0x924, 0x92d, 0x936, 0x948, 0x957, 0x960,
+	0x966, 0x96c, 0x975, 0x984, 0x993, 0x9ab, 0x9b7, 0x9c0, 0x9cc, 0x9d8, 0x9e4, 0x9ed,
+	0x9f6, 0xa0e, 0xa1a, 0xa29, 0xa32, 0xa3e, 0xa5c, 0xa77, 0xa7d, 0xa8f, 0xa9e, 0xaa4,
+	0xaad, 0xabc, 0xac5, 0xace, 0xada, 0xae0, 0xae9, 0xafe, 0xb07, 0xb0d, 0xb19, 0xb22,
+	0xb2b, 0xb43, 0xb49, 0xb52, 0xb5e, 0xb67, 0xb7f, 0xb8b, 0xb9d, 0xbaf, 0xbb5, 0xbbe,
+	0xbd9, 0xbe8, 0xbf1, 0xbf7, 0xc00, 0xc06, 0xc0f, 0xc18, 0xc24, 0xc2a, 0xc30, 0xc39,
+	0xc42, 0xc4b, 0xc51, 0xc57, 0xc5d, 0xc63, 0xc69, 0xc75, 0xc7b, 0xc81, 0xc8d, 0xc93,
+	0xc99, 0xca2, 0xca8, 0xcba, 0xcc9, 0xcdb, 0xcea, 0xcf0, 0xcf6, 0xcfc, 0xd02, 0xd08,
+	0xd0e, 0xd14, 0xd20,
+}
+
+const zuRegionStr = "" +
+	"i-Ascension Islandi-Andorrai-United Arab Emiratesi-Afghanistani-Antigua and Barb" +
+	"udai-Anguillai-Albaniai-Armeniai-Netherlands Antillesi-Angolai-Antarcticai-Argen" +
+	"tinai-American Samoai-Austriai-Australiai-Arubai-Åland Islandsi-Azerbaijani-Bosn" +
+	"ia ne-Herzegovinai-Barbadosi-Bangladeshi-Belgiumi-Burkina Fasoi-Bulgariai-Bahrai" +
+	"ni-Burundii-Benini-Saint Barthélemyi-Bermudai-Bruneii-Boliviai-Caribbean Netherl" +
+	"andsi-Brazili-Bahamasi-Bhutani-Bouvet Islandi-Botswanai-Belarusi-Belizei-Canadai" +
+	"-Cocos (Keeling) Islandsi-Congo - Kinshasai-Central African Republici-Congo - Br" +
+	"azzavillei-Switzerlandi-Côte d’Ivoirei-Cook Islandsi-Chilei-Camerooni-Chinai-Col" +
+	"ombiai-Clipperton Islandi-Costa Ricai-Cubai-Cape Verdei-Curaçaoi-Christmas Islan" +
+	"di-Cyprusi-Czech Republici-Germanyi-Diego Garciai-Djiboutii-Denmarki-Dominicai-D" +
+	"ominican Republici-Algeriai-Cueta ne-Melillai-Ecuadori-Estoniai-Egypti-Western S" +
+	"aharai-Eritreai-Spaini-Ethiopiai-European Unioni-Finlandi-Fijii-Falkland Islands" +
+	"i-Micronesiai-Faroe Islandsi-Francei-Gaboni-United Kingdomi-Grenadai-Georgiaisi-" +
+	"French Guianai-Guernseyi-Ghanai-Gibraltari-Greenlandi-Gambiai-Guineai-Guadeloupe" +
+	"i-Equatorial Guineai-Greecei-South Georgia ne-South Sandwich Islandsi-Guatemalai" +
+	"-Guami-Guinea-Bissaui-Guyanai-Hong Kong SAR Chinai-Heard Island ne-McDonald Isla" +
+	"ndsi-Hondurasi-Croatiai-Haitii-Hungaryi-Canary Islandsi-Indonesiai-Irelandi-Isra" +
+	"eli-Isle of Mani-Indiai-British Indian Ocean Territoryi-Iraqi-Irani-Icelandi-Ita" +
+	"lyi-Jerseyi-Jamaicai-Jordani-Japani-Kenyai-Kyrgyzstani-Cambodiai-Kiribatii-Comor" +
+	"osi-Saint Kitts ne-Nevisi-North Koreai-South Koreai-Kuwaiti-Cayman Islandsi-Kaza" +
+	"khstani-Laosi-Lebanoni-Saint Luciai-Liechtensteini-Sri Lankai-Liberiai-Lesothoi-" +
+	"Lithuaniai-Luxembourgi-Latviai-Libyai-Moroccoi-Monacoi-Moldovai-Montenegroi-Sain" +
+	"t Martini-Madagascari-Marshall Islandsi-Macedoniai-Malii-Myanmar (Burma)i-Mongol" +
+	"iai-Macau SAR Chinai-Northern Mariana Islandsi-Martiniquei-Mauritaniai-Montserra" +
+	"ti-Maltai-Mauritiusi-Maldivesi-Malawii-Mexicoi-Malaysiai-Mozambiquei-Namibiai-Ne" +
+	"w Caledoniai-Nigeri-Norfolk Islandi-Nigeriai-Nicaraguai-Netherlandsi-Norwayi-Nep" +
+	"ali-Naurui-Niuei-New Zealandi-Omani-Panamai-Perui-French Polynesiai-Papua New Gu" +
+	"ineai-Philippinesi-Pakistani-Polandi-Saint Pierre kanye ne-Miqueloni-Pitcairn Is" +
+	"landsi-Puerto Ricoi-Palestinian Territoriesi-Portugali-Palaui-Paraguayi-Qatari-O" +
+	"utlying Oceaniai-Réunioni-Romaniai-Serbiai-Russiai-Rwandai-Saudi Arabiai-Solomon" +
+	" Islandsi-Seychellesi-Sudani-Swedeni-Singaporei-Saint Helenai-Sloveniai-Svalbard" +
+	" ne-Jan Mayeni-Slovakiai-Sierra Leonei-San Marinoi-Senegali-Somaliai-SurinameiNi" +
+	"ngizimu Sudani-São Tomé kanye ne-Príncipei-El SalvadorI-Sint Maarteni-Syriai-Swa" +
+	"zilandi-Tristan da Cunhai-Turks and Caicos Islandsi-Chadi-French Southern Territ" +
+	"oriesi-Togoi-Thailandi-Tajikistani-Tokelaui-Timor-Lestei-Turkmenistani-Tunisiai-" +
+	"Tongai-Turkeyi-Trinidad ne-Tobagoi-Tuvalui-Taiwani-Tanzaniai-Ukrainei-Ugandai-U." +
+	"S. Minor Outlying Islandsi-United Statesi-Uruguayi-Uzbekistani-Vatican Cityi-Sai" +
+	"nt Vincent ne-Grenadinesi-Venezuelai-British Virgin Islandsi-U.S. Virgin Islands" +
+	"i-Vietnami-Vanuatui-Wallis ne-Futunai-Samoai-Kosovoi-Yemeni-Mayottei-South Afric" +
+	"ai-Zambiai-ZimbabweiSifunda esingaziwaUmhlabai-Africai-North Americai-South Amer" +
+	"icai-Oceaniai-Western Africai-Central Americai-Eastern Africai-Northern Africai-" +
+	"Middle Africai-Southern AfricaAmericasi-Northern Americai-Caribbeani-Eastern Asi" +
+	"ai-Southern Asiai-South-Eastern Asiai-Southern Europei-Australasiai-Melanesiai-M" +
+	"icronesian Regioni-Polynesiai-Asiai-Central Asiai-Western Asiai-Europei-Eastern " +
+	"Europei-Northern Europei-Western Europei-Latin America"
+
+var zuRegionIdx = []uint16{ // 291 entries
+	0x0, 0x12, 0x1b, 0x31, 0x3e, 0x53, 0x5d, 0x66, 0x6f, 0x85, 0x8d, 0x99,
+	0xa4, 0xb4, 0xbd, 0xc8, 0xcf, 0xdf, 0xeb, 0x102, 0x10c, 0x118, 0x121, 0x12f,
+	0x139, 0x142, 0x14b, 0x152, 0x165, 0x16e, 0x176, 0x17f, 0x196, 0x19e, 0x1a7, 0x1af,
+	0x1be, 0x1c8, 0x1d1, 0x1d9, 0x1e1, 0x1fa, 0x20c, 0x226, 0x23b, 0x248, 0x25a, 0x268,
+	0x26f, 0x279, 0x280, 0x28a, 0x29d, 0x2a9, 0x2af, 0x2bb, 0x2c5, 0x2d7, 0x2df, 0x2ef,
+	0x2f8, 0x306, 0x310, 0x319, 0x323, 0x337, 0x340, 0x352, 0x35b, 0x364, 0x36b, 0x37b,
+	0x384, 0x38b, 0x395, 0x3a5, 0x3ae, 0x3b4, 0x3c6, 0x3d2, 0x3e1, 0x3e9, 0x3f0, 0x400,
+	0x409, 0x412, 0x423, 0x42d, 0x434, 0x43f, 0x44a, 0x452, 0x45a, 0x466, 0x479, 0x481,
+	0x4aa, 0x4b5, 0x4bb, 0x4ca, 0x4d2, 0x4e7, 0x509, 0x513, 0x51c, 0x523, 0x52c, 0x53c,
+	0x547, 0x550, 0x558, 0x565, 0x56c, 0x58c, 0x592, 0x598, 0x5a1, 0x5a8, 0x5b0, 0x5b9,
+	0x5c1, 0x5c8, 0x5cf, 0x5db, 0x5e5, 0x5ef, 0x5f8, 0x60e, 0x61b, 0x628, 0x630, 0x640,
+	0x64c, 0x652, 0x65b, 0x668, 0x677, 0x682, 0x68b, 0x694, 0x69f, 0x6ab, 0x6b3, 0x6ba,
+	0x6c3, 0x6cb, 0x6d4, 0x6e0, 0x6ee, 0x6fa, 0x70c, 0x717, 0x71d, 0x72e, 0x738, 0x749,
+	0x763, 0x76f, 0x77b, 0x787, 0x78e, 0x799, 0x7a3, 0x7ab, 0x7b3, 0x7bd, 0x7c9, 0x7d2,
+	0x7e1, 0x7e8, 0x7f8, 0x801, 0x80c, 0x819, 0x821, 0x828, 0x82f, 0x835, 0x842, 0x848,
+	0x850, 0x856, 0x868, 0x87a, 0x887, 0x891, 0x899, 0x8b9, 0x8cb, 0x8d8, 0x8f1, 0x8fb,
+	0x902, 0x90c, 0x913, 0x925, 0x92f, 0x938, 0x940, 0x948, 0x950, 0x95e, 0x96f, 0x97b,
+	0x982, 0x98a, 0x995, 0x9a3, 0x9ad, 0x9c4, 0x9ce, 0x9dc, 0x9e8, 0x9f1, 0x9fa, 0xa04,
+	0xa14, 0xa33, 0xa40, 0xa4e, 0xa55, 0xa60, 0xa72, 0xa8c, 0xa92, 0xaaf, 0xab5, 0xabf,
+	0xacb, 0xad4, 0xae1, 0xaef, 0xaf8, 0xaff, 0xb07, 0xb1b, 0xb23, 0xb2b, 0xb35, 0xb3e,
+	0xb46, 0xb63, 0xb72, 0xb7b, 0xb87, 0xb95, 0xbb2, 0xbbd, 0xbd5, 0xbea, 0xbf3, 0xbfc,
+	0xc0e, 0xc15, 0xc1d, 0xc24, 0xc2d, 0xc3b, 0xc43, 0xc4d, 0xc60, 0xc67, 0xc6f, 0xc7e,
+	0xc8d, 0xc96, 0xca6, 0xcb7, 0xcc7, 0xcd8, 0xce7, 0xcf8, 0xd00, 0xd12, 0xd1d, 0xd2b,
+	0xd3a, 0xd4e, 0xd5f, 0xd6c, 0xd77, 0xd8b, 0xd96, 0xd9c, 0xdaa, 0xdb8, 0xdc0, 0xdd0,
+	0xde1, 0xdf1, 0xe00,
+}
+
+// Total size for region: 403803 bytes (403 KB)
+
+const numSupported = 73
+const supported = "" +
+	"af|am|ar|az|bg|bn|ca|cs|da|de|el|en|en-GB|es|es-419|et|fa|fi|fil|fr|fr-CA|gu|he|" +
+	"hi|hr|hu|hy|id|is|it|ja|ka|kk|km|kn|ko|ky|lo|lt|lv|mk|ml|mn|mr|ms|my|ne|nl|no|" +
+	"pa|pl|pt|pt-PT|ro|ru|si|sk|sl|sq|sr|sv|sw|ta|te|th|tr|uk|ur|uz|vi|zh|zh-Hant|zu|"
+
+// Dictionary entries of frequent languages
+var (
+	af = Dictionary{ // af
+		nil,
+		header{afLangStr, afLangIdx},
+		header{afScriptStr, afScriptIdx},
+		header{afRegionStr, afRegionIdx},
+	}
+	am = Dictionary{ // am
+		nil,
+		header{amLangStr, amLangIdx},
+		header{amScriptStr, amScriptIdx},
+		header{amRegionStr, amRegionIdx},
+	}
+	ar = Dictionary{ // ar
+		nil,
+		header{arLangStr, arLangIdx},
+		header{arScriptStr, arScriptIdx},
+		header{arRegionStr, arRegionIdx},
+	}
+	az = Dictionary{ // az
+		nil,
+		header{azLangStr, azLangIdx},
+		header{azScriptStr, azScriptIdx},
+		header{azRegionStr, azRegionIdx},
+	}
+	bg = Dictionary{ // bg
+		nil,
+		header{bgLangStr, bgLangIdx},
+		header{bgScriptStr, bgScriptIdx},
+		header{bgRegionStr, bgRegionIdx},
+	}
+	bn = Dictionary{ // bn
+		nil,
+		header{bnLangStr, bnLangIdx},
+		header{bnScriptStr, bnScriptIdx},
+		header{bnRegionStr, bnRegionIdx},
+	}
+	ca = Dictionary{ // ca
+		nil,
+		header{caLangStr, caLangIdx},
+		header{caScriptStr, caScriptIdx},
+		header{caRegionStr, caRegionIdx},
+	}
+	cs = Dictionary{ // cs
+		nil,
+		header{csLangStr, csLangIdx},
+		header{csScriptStr, csScriptIdx},
+		header{csRegionStr, csRegionIdx},
+	}
+	da = Dictionary{ // da
+		nil,
+		header{daLangStr, daLangIdx},
+		header{daScriptStr, daScriptIdx},
+		header{daRegionStr, daRegionIdx},
+	}
+	de = Dictionary{ // de
+		nil,
+		header{deLangStr, deLangIdx},
+		header{deScriptStr, deScriptIdx},
+		header{deRegionStr, deRegionIdx},
+	}
+	el = Dictionary{ // el
+		nil,
+		header{elLangStr, elLangIdx},
+		header{elScriptStr, elScriptIdx},
+		header{elRegionStr, elRegionIdx},
+	}
+	en = Dictionary{ // en
+		nil,
+		header{enLangStr, enLangIdx},
+		header{enScriptStr, enScriptIdx},
+		header{enRegionStr, enRegionIdx},
+	}
+	enGB = Dictionary{ // en-GB
+		&en,
+		header{enGBLangStr, enGBLangIdx},
+		header{enGBScriptStr, enGBScriptIdx},
+		header{enGBRegionStr, enGBRegionIdx},
+	}
+	es = Dictionary{ // es
+		nil,
+		header{esLangStr, esLangIdx},
+		header{esScriptStr, esScriptIdx},
+		header{esRegionStr, esRegionIdx},
+	}
+	es419 = Dictionary{ // es-419
+		&es,
+		header{es419LangStr, es419LangIdx},
+		header{es419ScriptStr, es419ScriptIdx},
+		header{es419RegionStr, es419RegionIdx},
+	}
+	et = Dictionary{ // et
+		nil,
+		header{etLangStr, etLangIdx},
+		header{etScriptStr, etScriptIdx},
+		header{etRegionStr, etRegionIdx},
+	}
+	fa = Dictionary{ // fa
+		nil,
+		header{faLangStr, faLangIdx},
+		header{faScriptStr, faScriptIdx},
+		header{faRegionStr, faRegionIdx},
+	}
+	fi = Dictionary{ // fi
+		nil,
+		header{fiLangStr, fiLangIdx},
+		header{fiScriptStr, fiScriptIdx},
+		header{fiRegionStr, fiRegionIdx},
+	}
+	fil = Dictionary{ // fil
+		nil,
+		header{filLangStr, filLangIdx},
+		header{filScriptStr, filScriptIdx},
+		header{filRegionStr, filRegionIdx},
+	}
+	fr = Dictionary{ // fr
+		nil,
+		header{frLangStr, frLangIdx},
+		header{frScriptStr, frScriptIdx},
+		header{frRegionStr, frRegionIdx},
+	}
+	frCA = Dictionary{ // fr-CA
+		&fr,
+		header{frCALangStr, frCALangIdx},
+		header{frCAScriptStr, frCAScriptIdx},
+		header{frCARegionStr, frCARegionIdx},
+	}
+	gu = Dictionary{ // gu
+		nil,
+		header{guLangStr, guLangIdx},
+		header{guScriptStr, guScriptIdx},
+		header{guRegionStr, guRegionIdx},
+	}
+	he = Dictionary{ // he
+		nil,
+		header{heLangStr, heLangIdx},
+		header{heScriptStr, heScriptIdx},
+		header{heRegionStr, heRegionIdx},
+	}
+	hi = Dictionary{ // hi
+		nil,
+		header{hiLangStr, hiLangIdx},
+		header{hiScriptStr, hiScriptIdx},
+		header{hiRegionStr, hiRegionIdx},
+	}
+	hr = Dictionary{ // hr
+		nil,
+		header{hrLangStr, hrLangIdx},
+		header{hrScriptStr, hrScriptIdx},
+		header{hrRegionStr, hrRegionIdx},
+	}
+	hu = Dictionary{ // hu
+		nil,
+		header{huLangStr, huLangIdx},
+		header{huScriptStr, huScriptIdx},
+		header{huRegionStr, huRegionIdx},
+	}
+	hy = Dictionary{ // hy
+		nil,
+		header{hyLangStr, hyLangIdx},
+		header{hyScriptStr, hyScriptIdx},
+		header{hyRegionStr, hyRegionIdx},
+	}
+	id = Dictionary{ // id
+		nil,
+		header{idLangStr, idLangIdx},
+		header{idScriptStr, idScriptIdx},
+		header{idRegionStr, idRegionIdx},
+	}
+	is = Dictionary{ // is
+		nil,
+		header{isLangStr, isLangIdx},
+		header{isScriptStr, isScriptIdx},
+		header{isRegionStr, isRegionIdx},
+	}
+	it = Dictionary{ // it
+		nil,
+		header{itLangStr, itLangIdx},
+		header{itScriptStr, itScriptIdx},
+		header{itRegionStr, itRegionIdx},
+	}
+	ja = Dictionary{ // ja
+		nil,
+		header{jaLangStr, jaLangIdx},
+		header{jaScriptStr, jaScriptIdx},
+		header{jaRegionStr, jaRegionIdx},
+	}
+	ka = Dictionary{ // ka
+		nil,
+		header{kaLangStr, kaLangIdx},
+		header{kaScriptStr, kaScriptIdx},
+		header{kaRegionStr, kaRegionIdx},
+	}
+	kk = Dictionary{ // kk
+		nil,
+		header{kkLangStr, kkLangIdx},
+		header{kkScriptStr, kkScriptIdx},
+		header{kkRegionStr, kkRegionIdx},
+	}
+	km = Dictionary{ // km
+		nil,
+		header{kmLangStr, kmLangIdx},
+		header{kmScriptStr, kmScriptIdx},
+		header{kmRegionStr, kmRegionIdx},
+	}
+	kn = Dictionary{ // kn
+		nil,
+		header{knLangStr, knLangIdx},
+		header{knScriptStr, knScriptIdx},
+		header{knRegionStr, knRegionIdx},
+	}
+	ko = Dictionary{ // ko
+		nil,
+		header{koLangStr, koLangIdx},
+		header{koScriptStr, koScriptIdx},
+		header{koRegionStr, koRegionIdx},
+	}
+	ky = Dictionary{ // ky
+		nil,
+		header{kyLangStr, kyLangIdx},
+		header{kyScriptStr, kyScriptIdx},
+		header{kyRegionStr, kyRegionIdx},
+	}
+	lo = Dictionary{ // lo
+		nil,
+		header{loLangStr, loLangIdx},
+		header{loScriptStr, loScriptIdx},
+		header{loRegionStr, loRegionIdx},
+	}
+	lt = Dictionary{ // lt
+		nil,
+		header{ltLangStr, ltLangIdx},
+		header{ltScriptStr, ltScriptIdx},
+		header{ltRegionStr, ltRegionIdx},
+	}
+	lv = Dictionary{ // lv
+		nil,
+		header{lvLangStr, lvLangIdx},
+		header{lvScriptStr, lvScriptIdx},
+		header{lvRegionStr, lvRegionIdx},
+	}
+	mk = Dictionary{ // mk
+		nil,
+		header{mkLangStr, mkLangIdx},
+		header{mkScriptStr, mkScriptIdx},
+		header{mkRegionStr, mkRegionIdx},
+	}
+	ml = Dictionary{ // ml
+		nil,
+		header{mlLangStr, mlLangIdx},
+		header{mlScriptStr, mlScriptIdx},
+		header{mlRegionStr, mlRegionIdx},
+	}
+	mn = Dictionary{ // mn
+		nil,
+		header{mnLangStr, mnLangIdx},
+		header{mnScriptStr, mnScriptIdx},
+		header{mnRegionStr, mnRegionIdx},
+	}
+	mr = Dictionary{ // mr
+		nil,
+		header{mrLangStr, mrLangIdx},
+		header{mrScriptStr, mrScriptIdx},
+		header{mrRegionStr, mrRegionIdx},
+	}
+	ms = Dictionary{ // ms
+		nil,
+		header{msLangStr, msLangIdx},
+		header{msScriptStr, msScriptIdx},
+		header{msRegionStr, msRegionIdx},
+	}
+	my = Dictionary{ // my
+		nil,
+		header{myLangStr, myLangIdx},
+		header{myScriptStr, myScriptIdx},
+		header{myRegionStr, myRegionIdx},
+	}
+	ne = Dictionary{ // ne
+		nil,
+		header{neLangStr, neLangIdx},
+		header{neScriptStr, neScriptIdx},
+		header{neRegionStr, neRegionIdx},
+	}
+	nl = Dictionary{ // nl
+		nil,
+		header{nlLangStr, nlLangIdx},
+		header{nlScriptStr, nlScriptIdx},
+		header{nlRegionStr, nlRegionIdx},
+	}
+	no = Dictionary{ // no
+		nil,
+		header{noLangStr, noLangIdx},
+		header{noScriptStr, noScriptIdx},
+		header{noRegionStr, noRegionIdx},
+	}
+	pa = Dictionary{ // pa
+		nil,
+		header{paLangStr, paLangIdx},
+		header{paScriptStr, paScriptIdx},
+		header{paRegionStr, paRegionIdx},
+	}
+	pl = Dictionary{ // pl
+		nil,
+		header{plLangStr, plLangIdx},
+		header{plScriptStr, plScriptIdx},
+		header{plRegionStr, plRegionIdx},
+	}
+	pt = Dictionary{ // pt
+		nil,
+		header{ptLangStr, ptLangIdx},
+		header{ptScriptStr, ptScriptIdx},
+		header{ptRegionStr, ptRegionIdx},
+	}
+	ptPT = Dictionary{ // pt-PT
+		&pt,
+		header{ptPTLangStr, ptPTLangIdx},
+		header{ptPTScriptStr, ptPTScriptIdx},
+		header{ptPTRegionStr, ptPTRegionIdx},
+	}
+	ro = Dictionary{ // ro
+		nil,
+		header{roLangStr, roLangIdx},
+		header{roScriptStr, roScriptIdx},
+		header{roRegionStr, roRegionIdx},
+	}
+	ru = Dictionary{ // ru
+		nil,
+		header{ruLangStr, ruLangIdx},
+		header{ruScriptStr, ruScriptIdx},
+		header{ruRegionStr, ruRegionIdx},
+	}
+	si = Dictionary{ // si
+		nil,
+		header{siLangStr, siLangIdx},
+		header{siScriptStr, siScriptIdx},
+		header{siRegionStr, siRegionIdx},
+	}
+	sk = Dictionary{ // sk
+		nil,
+		header{skLangStr, skLangIdx},
+		header{skScriptStr, skScriptIdx},
+		header{skRegionStr, skRegionIdx},
+	}
+	sl = Dictionary{ // sl
+		nil,
+		header{slLangStr, slLangIdx},
+		header{slScriptStr, slScriptIdx},
+		header{slRegionStr, slRegionIdx},
+	}
+	sq = Dictionary{ // sq
+		nil,
+		header{sqLangStr, sqLangIdx},
+		header{sqScriptStr, sqScriptIdx},
+		header{sqRegionStr, sqRegionIdx},
+	}
+	sr = Dictionary{ // sr
+		nil,
+		header{srLangStr, srLangIdx},
+		header{srScriptStr, srScriptIdx},
+		header{srRegionStr, srRegionIdx},
+	}
+	sv = Dictionary{ // sv
+		nil,
+		header{svLangStr, svLangIdx},
+		header{svScriptStr, svScriptIdx},
+		header{svRegionStr, svRegionIdx},
+	}
+	sw = Dictionary{ // sw
+		nil,
+		header{swLangStr, swLangIdx},
+		header{swScriptStr, swScriptIdx},
+		header{swRegionStr, swRegionIdx},
+	}
+	ta = Dictionary{ // ta
+		nil,
+		header{taLangStr, taLangIdx},
+		header{taScriptStr, taScriptIdx},
+		header{taRegionStr, taRegionIdx},
+	}
+	te = Dictionary{ // te
+		nil,
+		header{teLangStr, teLangIdx},
+		header{teScriptStr, teScriptIdx},
+		header{teRegionStr, teRegionIdx},
+	}
+	th = Dictionary{ // th
+		nil,
+		header{thLangStr, thLangIdx},
+		header{thScriptStr, thScriptIdx},
+		header{thRegionStr, thRegionIdx},
+	}
+	tr = Dictionary{ // tr
+		nil,
+		header{trLangStr, trLangIdx},
+		header{trScriptStr, trScriptIdx},
+		header{trRegionStr, trRegionIdx},
+	}
+	uk = Dictionary{ // uk
+		nil,
+		header{ukLangStr, ukLangIdx},
+		header{ukScriptStr, ukScriptIdx},
+		header{ukRegionStr, ukRegionIdx},
+	}
+	ur = Dictionary{ // ur
+		nil,
+		header{urLangStr, urLangIdx},
+		header{urScriptStr, urScriptIdx},
+		header{urRegionStr, urRegionIdx},
+	}
+	uz = Dictionary{ // uz
+		nil,
+		header{uzLangStr, uzLangIdx},
+		header{uzScriptStr, uzScriptIdx},
+		header{uzRegionStr, uzRegionIdx},
+	}
+	vi = Dictionary{ // vi
+		nil,
+		header{viLangStr, viLangIdx},
+		header{viScriptStr, viScriptIdx},
+		header{viRegionStr, viRegionIdx},
+	}
+	zh = Dictionary{ // zh
+		nil,
+		header{zhLangStr, zhLangIdx},
+		header{zhScriptStr, zhScriptIdx},
+		header{zhRegionStr, zhRegionIdx},
+	}
+	zhHant = Dictionary{ // zh-Hant
+		nil,
+		header{zhHantLangStr, zhHantLangIdx},
+		header{zhHantScriptStr, zhHantScriptIdx},
+		header{zhHantRegionStr, zhHantRegionIdx},
+	}
+	zu = Dictionary{ // zu
+		nil,
+		header{zuLangStr, zuLangIdx},
+		header{zuScriptStr, zuScriptIdx},
+		header{zuRegionStr, zuRegionIdx},
+	}
+)
+
+// Total size for 78 entries: 9984 bytes (9 KB)
+
+// Number of keys: 85
+var (
+	selfIndex = tagIndex{
+		"afamarazbgbncacsdadeelenesetfafifrguhehihrhuhyidisitjakakkkmknkokyloltlvmkmlmnmrmsmynenlnopaplptrorusiskslsqsrsvswtatethtrukuruzvizhzu",
+		"fil",
+		"",
+	}
+	selfTagsLong = []string{"ar-001", "de-AT", "de-CH", "en-AU", "en-CA", "en-GB", "en-US", "es-419", "es-ES", "fr-CA", "fr-CH", "nl-BE", "pt-BR", "pt-PT", "ro-MD", "zh-Hans", "zh-Hant"}
+)
+
+var selfHeaders = [1]header{
+	{ // mul
+		"",
+		[]uint16{ // 11 entries
+			0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb,
+		},
+	},
+}
+
+// Total size for self: 634 bytes (0 KB)
+
+// nameData holds 86 names shared by the headers.
+const nameData = "" +
+	"ShqipdanskeestisuomiVlaamsmagyarpolski中文DeutschEnglishcatalàisiZulusvenskaFilipi" +
+	"noTürkçeespañolhrvatskiitalianoromânăاردوAfrikaansKiswahilifrançaislatviešulietu" +
+	"viųíslenskačeštinaไทยລາວဗမာ日本語한국어Nederlandsoʻzbekchaportuguêsעבריתفارسیazərbayca" +
+	"nslovenčinaСрпскимонголአማርኛ简体中文繁體中文Bahasa Melayunorsk bokmålslovenščinaTiếng Việ" +
+	"tmoldoveneascăрусскийհայերենالعربيةBritish Englishमराठीहिंदीবাংলাதமிழ்ಕನ್ನಡසිංහල" +
+	"ខ្មែរAmerican EnglishBahasa IndonesiaCanadian Englishfrançais suisseΕλληνικάкырг" +
+	"ызчаAustralian Englishfrançais canadienportuguês europeuбългарскиनेपालीਪੰਜਾਬੀతెల" +
+	"ుగుമലയാളംespañol de Españaқазақ тіліespañol de Américaportuguês do Brasilмакедон" +
+	"скиукраїнськаSchweizer HochdeutschગુજરાતીქართულიÖsterreichisches Deutschالعربية " +
+	"الرسمية الحديثة"
+
+var nameBlocks = [19]nameBlock{
+	{0x0, 0x0, 0x0},
+	{0x1, 0x5, 0x0},
+	{0x5, 0x6, 0x14},
+	{0x9, 0x7, 0x2c},
+	{0xe, 0x8, 0x4f},
+	{0x15, 0x9, 0x87},
+	{0x21, 0xa, 0xf3},
+	{0x26, 0xb, 0x125},
+	{0x28, 0xc, 0x13b},
+	{0x2d, 0xd, 0x177},
+	{0x30, 0xe, 0x19e},
+	{0x35, 0xf, 0x1e4},
+	{0x3d, 0x10, 0x25c},
+	{0x43, 0x12, 0x2bc},
+	{0x4b, 0x13, 0x34c},
+	{0x4d, 0x14, 0x372},
+	{0x51, 0x15, 0x3c2},
+	{0x54, 0x19, 0x401},
+	{0x55, 0x2c, 0x41a},
+}
+
+// Total size for shared names: 1246 bytes (1 KB)
+
+const indexBlockSize = 8
+
+// indexBlocks holds 12 blocks of name IDs shared by the headers.
+var indexBlocks = [96]uint16{
+	0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x15, 0x2a, 0x34, 0x26,
+	0x46, 0x38, 0xb, 0x1b, 0x2, 0x9, 0x41, 0xa, 0x10, 0x3, 0x25, 0x4,
+	0x17, 0x52, 0x24, 0x37, 0x11, 0x6, 0x33, 0x3e, 0x1a, 0x12, 0x1f, 0x53,
+	0x4c, 0x3c, 0x3a, 0x20, 0x42, 0x1d, 0x19, 0x18, 0x4f, 0x4a, 0x29, 0x36,
+	0x2d, 0x1e, 0x47, 0x21, 0x2e, 0x48, 0x7, 0x23, 0x13, 0x32, 0x3b, 0x27,
+	0x2f, 0x1, 0x28, 0xd, 0x16, 0x39, 0x49, 0x1c, 0xf, 0x50, 0x14, 0x22,
+	0x30, 0x8, 0xc, 0xe, 0x55, 0x54, 0x51, 0x43, 0x3f, 0x35, 0x3d, 0x4d,
+	0x4b, 0x44, 0x40, 0x5, 0x4e, 0x45, 0x31, 0x2b, 0x2c, 0x0, 0x0, 0x0,
+}
+
+// Total size for index blocks: 192 bytes (0 KB)
+
+// Size: 650.7K (666363 bytes)
@@ -177,3 +177,95 @@ func (utf8Validator) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err
 	}
 	return n, n, err
 }
+
+// UTF8Stats holds statistics about the ill-formed UTF-8 encountered by a
+// UTF8Repairer.
+type UTF8Stats struct {
+	// Bytes is the number of source bytes consumed so far.
+	Bytes int64
+
+	// Invalid is the number of source bytes that were not part of a valid
+	// UTF-8 encoding and were replaced or dropped.
+	Invalid int64
+
+	// Offsets holds the source offsets of the first ill-formed bytes, in
+	// increasing order. Only the first MaxOffsets offsets are recorded.
+	Offsets []int64
+}
+
+// A UTF8Repairer is a transformer that copies valid UTF-8 unchanged and
+// replaces each byte that is not part of a valid UTF-8 encoding by a
+// replacement string. It keeps track of the repairs it made, which can be
+// retrieved with Stats. A UTF8Repairer keeps state and should not be used for
+// more than one stream at a time without calling Reset.
+type UTF8Repairer struct {
+	// MaxOffsets is the maximum number of offsets recorded in the statistics.
+	MaxOffsets int
+
+	repl  string
+	stats UTF8Stats
+}
+
+// NewUTF8Repairer returns a UTF8Repairer that replaces ill-formed bytes with
+// repl. Ill-formed bytes are dropped if repl is the empty string. It records
+// the offsets of up to 100 ill-formed bytes.
+func NewUTF8Repairer(repl string) *UTF8Repairer {
+	return &UTF8Repairer{MaxOffsets: 100, repl: repl}
+}
+
+// Stats returns the statistics for the input transformed since the creation
+// of r or the last call to Reset.
+func (r *UTF8Repairer) Stats() UTF8Stats {
+	return r.stats
+}
+
+// Reset clears the statistics so that r can be used for a new stream.
+func (r *UTF8Repairer) Reset() {
+	// Offsets is not reused, as it may be held by the caller of Stats.
+	r.stats = UTF8Stats{}
+}
+
+// Transform implements the transform.Transformer interface.
+func (r *UTF8Repairer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if c := src[nSrc]; c < utf8.RuneSelf {
+			if nDst == len(dst) {
+				err = transform.ErrShortDst
+				break
+			}
+			dst[nDst] = c
+			nDst++
+			nSrc++
+			continue
+		}
+		_, size := utf8.DecodeRune(src[nSrc:])
+		if size == 1 {
+			// All valid runes of size 1 (those below utf8.RuneSelf) were
+			// handled above. We have invalid UTF-8 or we haven't seen the
+			// full character yet.
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				err = transform.ErrShortSrc
+				break
+			}
+			if nDst+len(r.repl) > len(dst) {
+				err = transform.ErrShortDst
+				break
+			}
+			nDst += copy(dst[nDst:], r.repl)
+			if len(r.stats.Offsets) < r.MaxOffsets {
+				r.stats.Offsets = append(r.stats.Offsets, r.stats.Bytes+int64(nSrc))
+			}
+			r.stats.Invalid++
+			nSrc++
+			continue
+		}
+		if nDst+size > len(dst) {
+			err = transform.ErrShortDst
+			break
+		}
+		nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+		nSrc += size
+	}
+	r.stats.Bytes += int64(nSrc)
+	return nDst, nSrc, err
+}
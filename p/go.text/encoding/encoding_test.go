@@ -11,6 +11,7 @@ import (
 	"io/ioutil"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"code.google.com/p/go.text/encoding"
 	"code.google.com/p/go.text/encoding/charmap"
@@ -655,6 +656,58 @@ func TestUTF8Validator(t *testing.T) {
 	}
 }
 
+func TestUTF8Repairer(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		repl    string
+		src     string
+		want    string
+		invalid int64
+		offsets []int64
+	}{
+		{"empty input", "�", "", "", 0, nil},
+		{"valid input", "�", "aሴ\U00012345", "aሴ\U00012345", 0, nil},
+		{"invalid byte", "�", "a\x80b", "a�b", 1, []int64{1}},
+		{"truncated rune at EOF", "�", "a\xe2\x82", "a��", 2, []int64{1, 2}},
+		{"surrogate", "?", "\xed\xa0\x80.", "???.", 3, []int64{0, 1, 2}},
+		{"drop", "", "\xffa\xfeb", "ab", 2, []int64{0, 2}},
+		{"long replacement", "<bad>", "x\xc0", "x<bad>", 1, []int64{1}},
+	}
+	for _, tc := range testCases {
+		r := encoding.NewUTF8Repairer(tc.repl)
+		// Use a small buffer to exercise the handling of short buffers.
+		got, err := ioutil.ReadAll(transform.NewReader(iotest.OneByteReader(strings.NewReader(tc.src)), r))
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tc.desc, err)
+			continue
+		}
+		if string(got) != tc.want {
+			t.Errorf("%s: got %+q; want %+q", tc.desc, got, tc.want)
+		}
+		s := r.Stats()
+		if s.Bytes != int64(len(tc.src)) || s.Invalid != tc.invalid || fmt.Sprint(s.Offsets) != fmt.Sprint(tc.offsets) {
+			t.Errorf("%s: got stats %d, %d, %v; want %d, %d, %v", tc.desc,
+				s.Bytes, s.Invalid, s.Offsets, len(tc.src), tc.invalid, tc.offsets)
+		}
+	}
+
+	r := encoding.NewUTF8Repairer("�")
+	r.MaxOffsets = 2
+	transform.String(r, "\x80\x80\x80\x80")
+	s := r.Stats()
+	if s.Invalid != 4 || len(s.Offsets) != 2 {
+		t.Errorf("MaxOffsets: got %d invalid and offsets %v; want 4 and 2 offsets", s.Invalid, s.Offsets)
+	}
+	r.Reset()
+	if s := r.Stats(); s.Bytes != 0 || s.Invalid != 0 || len(s.Offsets) != 0 {
+		t.Errorf("Reset: got stats %+v; want zero stats", s)
+	}
+	transform.String(r, "a\x80")
+	if got := fmt.Sprint(s.Offsets); got != "[0 1]" {
+		t.Errorf("Reset: earlier offsets changed to %s; want [0 1]", got)
+	}
+}
+
 // TODO: UTF-16-specific tests:
 // - inputs with multiple U+FEFF and U+FFFE runes. These should not be replaced
 //   by U+FFFD.
@@ -0,0 +1,217 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// A CodeWriter accumulates the source of a generated Go file. It keeps track
+// of the size of the tables written with its Write methods and computes a
+// checksum of all code written, which makes it easy to see whether a change to
+// a generator affects the generated tables.
+type CodeWriter struct {
+	// Size is the total size in bytes of the tables written so far.
+	// Generators that write tables directly should add their size to it.
+	Size int
+
+	// BuildTags, if not empty, is written as a +build directive.
+	BuildTags string
+
+	buf  bytes.Buffer
+	hash hash.Hash32
+}
+
+// NewCodeWriter returns a new CodeWriter.
+func NewCodeWriter() *CodeWriter {
+	return &CodeWriter{hash: fnv.New32()}
+}
+
+// Write implements io.Writer.
+func (w *CodeWriter) Write(p []byte) (n int, err error) {
+	w.hash.Write(p)
+	return w.buf.Write(p)
+}
+
+// WriteGoFile writes the accumulated code as package pkg, preceded by the
+// standard header and followed by the total size of the tables and the
+// checksum, to the file given by the -output flag.
+func (w *CodeWriter) WriteGoFile(pkg string) error {
+	src := &bytes.Buffer{}
+	src.WriteString(Header(os.Args[1:]))
+	if w.BuildTags != "" {
+		fmt.Fprintf(src, "// +build %s\n\n", w.BuildTags)
+	}
+	fmt.Fprintf(src, "package %s\n\n", pkg)
+	src.Write(w.buf.Bytes())
+	fmt.Fprintf(src, "\n// Size: %.1fK (%d bytes); Check: %X\n", float32(w.Size)/1024, w.Size, w.hash.Sum32())
+	return WriteGoFile(*outputFile, src.Bytes())
+}
+
+func (w *CodeWriter) printf(f string, x ...interface{}) {
+	fmt.Fprintf(w, f, x...)
+}
+
+// WriteComment writes the formatted text as a comment. Each line of the text
+// is prefixed with "// ".
+func (w *CodeWriter) WriteComment(format string, args ...interface{}) {
+	s := strings.TrimSpace(fmt.Sprintf(format, args...))
+	if s == "" {
+		return
+	}
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimRight(line, " \t"); line == "" {
+			w.printf("//\n")
+		} else {
+			w.printf("// %s\n", line)
+		}
+	}
+}
+
+// WriteConst writes a constant with the given name and value.
+func (w *CodeWriter) WriteConst(name string, x interface{}) {
+	if s, ok := x.(string); ok {
+		w.printf("const %s = %q\n", name, s)
+	} else {
+		w.printf("const %s = %v\n", name, x)
+	}
+}
+
+// WriteVar writes a variable with the given name and value, preceded by a
+// comment stating its size. Slices are written as slices; use WriteArray to
+// write a slice as an array.
+func (w *CodeWriter) WriteVar(name string, x interface{}) {
+	v := reflect.ValueOf(x)
+	size := int(v.Type().Size()) + dataSize(v)
+	switch v.Kind() {
+	case reflect.String:
+		w.addSize(size)
+		w.writeString(name, v.String())
+	case reflect.Slice, reflect.Array:
+		w.addArraySize(size, v.Len())
+		w.printf("var %s = %s{", name, typeName(v.Type()))
+		w.writeElems(v)
+	default:
+		w.addSize(size)
+		w.printf("var %s = %s\n", name, literal(v))
+	}
+}
+
+// WriteArray writes the slice or array x as an array variable with the given
+// name, preceded by a comment stating its size. Using arrays instead of slices
+// saves the space of the slice header and allows the compiler to eliminate
+// bounds checks for constant indexes.
+func (w *CodeWriter) WriteArray(name string, x interface{}) {
+	v := reflect.ValueOf(x)
+	size := dataSize(v) // includes the elements if v is a slice
+	if v.Kind() == reflect.Array {
+		size += int(v.Type().Size())
+	}
+	w.addArraySize(size, v.Len())
+	w.printf("var %s = [%d]%s{", name, v.Len(), typeName(v.Type().Elem()))
+	w.writeElems(v)
+}
+
+func (w *CodeWriter) addSize(s int) {
+	w.Size += s
+	w.printf("// Size: %d bytes\n", s)
+}
+
+func (w *CodeWriter) addArraySize(s, n int) {
+	w.Size += s
+	w.printf("// Size: %d bytes, %d elements\n", s, n)
+}
+
+// writeElems writes the elements of the slice or array v and the closing
+// brace. Integers are written a dozen per line; all other values are written
+// one per line. As with gofmt -s, the type of struct elements is omitted.
+func (w *CodeWriter) writeElems(v reflect.Value) {
+	for i := 0; i < v.Len(); i++ {
+		switch e := v.Index(i); e.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if i%12 == 0 {
+				w.printf("\n\t")
+			}
+			w.printf("%d, ", e.Interface())
+		case reflect.Struct:
+			w.printf("\n\t%s,", strings.TrimPrefix(literal(e), typeName(e.Type())))
+		default:
+			w.printf("\n\t%s,", literal(e))
+		}
+	}
+	w.printf("\n}\n")
+}
+
+// writeString writes a string variable. Long strings are split over
+// multiple lines.
+func (w *CodeWriter) writeString(name, s string) {
+	if len(s) < 40 {
+		w.printf("var %s string = %q\n", name, s)
+		return
+	}
+	const cpl = 60
+	w.printf("var %s string = \"\" +\n", name)
+	for {
+		n := cpl
+		if n > len(s) {
+			n = len(s)
+		}
+		var q string
+		for {
+			q = strconv.Quote(s[:n])
+			if len(q) <= cpl+2 {
+				break
+			}
+			n--
+		}
+		if n < len(s) {
+			w.printf("\t%s +\n", q)
+			s = s[n:]
+		} else {
+			w.printf("\t%s\n", q)
+			break
+		}
+	}
+}
+
+// literal returns the Go literal for v. Types defined in the generator are
+// assumed to be defined with the same name in the generated package.
+func literal(v reflect.Value) string {
+	return strings.Replace(fmt.Sprintf("%#v", v.Interface()), "main.", "", -1)
+}
+
+func typeName(t reflect.Type) string {
+	return strings.Replace(t.String(), "main.", "", -1)
+}
+
+// dataSize returns the number of bytes referred to by v, not including the
+// size of v itself.
+func dataSize(v reflect.Value) int {
+	n := 0
+	switch v.Kind() {
+	case reflect.String:
+		n = v.Len()
+	case reflect.Slice:
+		n = v.Len() * int(v.Type().Elem().Size())
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			n += dataSize(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			n += dataSize(v.Field(i))
+		}
+	}
+	return n
+}
@@ -0,0 +1,70 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"strings"
+	"testing"
+)
+
+type pair struct {
+	a, b uint16
+}
+
+func TestCodeWriter(t *testing.T) {
+	tests := []struct {
+		write func(w *CodeWriter)
+		size  int
+		want  string
+	}{{
+		func(w *CodeWriter) { w.WriteComment("a\n\nb ") },
+		0,
+		"// a\n//\n// b\n",
+	}, {
+		func(w *CodeWriter) { w.WriteConst("c", "x") },
+		0,
+		"const c = \"x\"\n",
+	}, {
+		func(w *CodeWriter) { w.WriteConst("c", 3) },
+		0,
+		"const c = 3\n",
+	}, {
+		func(w *CodeWriter) { w.WriteVar("s", "abc") },
+		16 + 3,
+		"// Size: 19 bytes\nvar s string = \"abc\"\n",
+	}, {
+		func(w *CodeWriter) { w.WriteVar("s", strings.Repeat("a", 100)) },
+		16 + 100,
+		"// Size: 116 bytes\nvar s string = \"\" +\n" +
+			"\t\"" + strings.Repeat("a", 60) + "\" +\n" +
+			"\t\"" + strings.Repeat("a", 40) + "\"\n",
+	}, {
+		func(w *CodeWriter) { w.WriteVar("x", []uint16{1, 2}) },
+		24 + 4,
+		"// Size: 28 bytes, 2 elements\nvar x = []uint16{\n\t1, 2, \n}\n",
+	}, {
+		func(w *CodeWriter) { w.WriteArray("x", []uint8{1, 2, 3}) },
+		3,
+		"// Size: 3 bytes, 3 elements\nvar x = [3]uint8{\n\t1, 2, 3, \n}\n",
+	}, {
+		func(w *CodeWriter) { w.WriteArray("x", []string{"ab", "c"}) },
+		2*16 + 3,
+		"// Size: 35 bytes, 2 elements\nvar x = [2]string{\n\t\"ab\",\n\t\"c\",\n}\n",
+	}, {
+		func(w *CodeWriter) { w.WriteArray("x", []pair{{1, 2}}) },
+		4,
+		"// Size: 4 bytes, 1 elements\nvar x = [1]gen.pair{\n\t{a:0x1, b:0x2},\n}\n",
+	}}
+	for i, tt := range tests {
+		w := NewCodeWriter()
+		tt.write(w)
+		if got := w.buf.String(); got != tt.want {
+			t.Errorf("%d: got %q; want %q", i, got, tt.want)
+		}
+		if w.Size != tt.size {
+			t.Errorf("%d: size was %d; want %d", i, w.Size, tt.size)
+		}
+	}
+}
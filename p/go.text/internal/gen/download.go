@@ -0,0 +1,196 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	cacheDir = flag.String("cache", defaultCacheDir(),
+		"directory in which downloaded data files are cached.")
+	offline = flag.Bool("offline", false,
+		"only use data files from the cache; do not access the network.")
+)
+
+// Checksums maps URLs to the hex-encoded SHA-256 checksum of the data they
+// refer to. Generators may add entries for data files of a known version.
+// Open verifies data for these URLs against the checksum, both when
+// downloaded and when read from the cache.
+var Checksums = map[string]string{}
+
+// ErrOffline is returned by Open if the requested data is not in the cache
+// and the -offline flag was given.
+var ErrOffline = errors.New("gen: data not in cache and running in offline mode")
+
+const (
+	maxAttempts = 4
+	userAgent   = "go.text-maketables"
+)
+
+// retryDelay is the delay before the first retry. It doubles for each
+// subsequent attempt.
+var retryDelay = 2 * time.Second
+
+func defaultCacheDir() string {
+	if dir := os.Getenv("GOTEXT_CACHE"); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.TempDir(), "go.text-cache")
+}
+
+// Open returns a reader for the data at the given URL. Data referred to by
+// http and https URLs is downloaded once and cached in the directory given by
+// the -cache flag, which defaults to $GOTEXT_CACHE or a directory in the
+// system's temporary directory. The size of downloaded data is checked against
+// the announced content length and a checksum is stored next to each cached
+// file, which is used to detect corrupted cache entries. Failed downloads are
+// retried a few times with an increasing delay. With the -offline flag, Open
+// only uses the cache. URLs with the file scheme and plain paths are opened
+// directly and are never cached. With the -local flag, Open opens the file
+// in the current directory with the same base name as the URL instead.
+func Open(rawurl string) (io.ReadCloser, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "":
+		return os.Open(rawurl)
+	case "file":
+		return os.Open(filepath.FromSlash(u.Path))
+	case "http", "https":
+		if *localFiles {
+			return os.Open(path.Base(u.Path))
+		}
+	default:
+		return nil, fmt.Errorf("gen: unsupported URL scheme %q", u.Scheme)
+	}
+
+	host := strings.Replace(u.Host, ":", "_", -1)
+	file := filepath.Join(*cacheDir, host, filepath.FromSlash(u.Path))
+	if u.RawQuery != "" {
+		file += "_" + u.RawQuery
+	}
+	if f, err := openCached(rawurl, file); err == nil {
+		return f, nil
+	} else if !os.IsNotExist(err) {
+		log.Printf("gen: ignoring cached copy of %s: %v", rawurl, err)
+	}
+	if *offline {
+		return nil, ErrOffline
+	}
+	if err := download(rawurl, file); err != nil {
+		return nil, err
+	}
+	return openCached(rawurl, file)
+}
+
+// openCached opens the cached file and verifies its content against the
+// stored checksum.
+func openCached(rawurl, file string) (io.ReadCloser, error) {
+	sum, err := ioutil.ReadFile(file + ".sha256")
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+	if want := strings.TrimSpace(string(sum)); got != want {
+		f.Close()
+		return nil, fmt.Errorf("checksum mismatch: got %s; want %s", got, want)
+	}
+	if want, ok := Checksums[rawurl]; ok && got != want {
+		f.Close()
+		return nil, fmt.Errorf("checksum mismatch: got %s; want %s", got, want)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// download fetches the data at rawurl and stores it in file. It retries on
+// network errors and on server errors.
+func download(rawurl, file string) (err error) {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	delay := retryDelay
+	for i := 1; ; i++ {
+		var retry bool
+		retry, err = fetch(rawurl, file)
+		if err == nil || !retry || i == maxAttempts {
+			break
+		}
+		log.Printf("gen: %v; retrying in %v", err, delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+	if err != nil {
+		return fmt.Errorf("gen: downloading %s: %v", rawurl, err)
+	}
+	return nil
+}
+
+// fetch makes a single attempt to download rawurl. It reports whether the
+// request should be retried in case of an error.
+func fetch(rawurl, file string) (retry bool, err error) {
+	req, err := http.NewRequest("GET", rawurl, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return retry, fmt.Errorf("bad GET status %q", resp.Status)
+	}
+
+	buf := &bytes.Buffer{}
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(buf, h), resp.Body)
+	if err != nil {
+		return true, err
+	}
+	if resp.ContentLength >= 0 && n != resp.ContentLength {
+		return true, fmt.Errorf("read %d bytes; want %d", n, resp.ContentLength)
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	if want, ok := Checksums[rawurl]; ok && sum != want {
+		return false, fmt.Errorf("checksum mismatch: got %s; want %s", sum, want)
+	}
+	if err := writeFileAtomic(file, buf.Bytes()); err != nil {
+		return false, err
+	}
+	return false, writeFileAtomic(file+".sha256", []byte(sum+"\n"))
+}
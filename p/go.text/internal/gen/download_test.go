@@ -0,0 +1,132 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(d string, o bool) { *cacheDir, *offline = d, o }(*cacheDir, *offline)
+	*cacheDir = dir
+	defer func(d time.Duration) { retryDelay = d }(retryDelay)
+	retryDelay = 0
+
+	requests, failures := 0, 1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if failures > 0 {
+			failures--
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "data")
+	}))
+	defer ts.Close()
+
+	read := func(url string) (string, error) {
+		r, err := Open(url)
+		if err != nil {
+			return "", err
+		}
+		defer r.Close()
+		b, err := ioutil.ReadAll(r)
+		return string(b), err
+	}
+
+	url := ts.URL + "/core.zip"
+	if s, err := read(url); err != nil || s != "data" {
+		t.Fatalf("first Open: got %q, %v; want %q, nil", s, err, "data")
+	}
+	if requests != 2 {
+		t.Errorf("got %d requests; want 2 (one retry)", requests)
+	}
+
+	// The second time the data is read from the cache.
+	*offline = true
+	if s, err := read(url); err != nil || s != "data" {
+		t.Fatalf("cached Open: got %q, %v; want %q, nil", s, err, "data")
+	}
+	if requests != 2 {
+		t.Errorf("got %d requests; want 2", requests)
+	}
+	if _, err := read(ts.URL + "/other"); err != ErrOffline {
+		t.Errorf("offline Open: got %v; want %v", err, ErrOffline)
+	}
+
+	// A corrupted cache entry is downloaded again.
+	*offline = false
+	host := strings.Replace(ts.Listener.Addr().String(), ":", "_", -1)
+	file := filepath.Join(dir, host, "core.zip")
+	if err := ioutil.WriteFile(file, []byte("dat"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := read(url); err != nil || s != "data" {
+		t.Fatalf("Open after corruption: got %q, %v; want %q, nil", s, err, "data")
+	}
+	if requests != 3 {
+		t.Errorf("got %d requests; want 3", requests)
+	}
+
+	// Client errors are not retried.
+	if _, err := read(ts.URL + "/missing"); err == nil {
+		t.Errorf("expected error for missing file")
+	}
+	if requests != 4 {
+		t.Errorf("got %d requests; want 4", requests)
+	}
+
+	// Checksums are verified.
+	Checksums[url] = "0000"
+	defer delete(Checksums, url)
+	if _, err := read(url); err == nil {
+		t.Errorf("expected checksum error")
+	}
+
+	// Local files are opened directly.
+	local := filepath.Join(dir, "local.txt")
+	ioutil.WriteFile(local, []byte("local"), 0644)
+	for _, u := range []string{local, "file://" + filepath.ToSlash(local)} {
+		if s, err := read(u); err != nil || s != "local" {
+			t.Errorf("Open(%q): got %q, %v; want %q, nil", u, s, err, "local")
+		}
+	}
+
+	// With -local, data is read from the current directory.
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer func(l bool) { *localFiles = l }(*localFiles)
+	*localFiles = true
+	n := requests
+	if s, err := read(ts.URL + "/data/local.txt"); err != nil || s != "local" {
+		t.Errorf("local Open: got %q, %v; want %q, nil", s, err, "local")
+	}
+	if requests != n {
+		t.Errorf("local Open made a request")
+	}
+}
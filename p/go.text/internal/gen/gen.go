@@ -0,0 +1,103 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gen contains common code for the various table generators in
+// go.text. It is not intended for use outside of go.text.
+//
+// A generator typically calls Init, opens its data files with Open, writes
+// the tables using a CodeWriter and calls WriteGoFile on the CodeWriter to
+// write the result to the file given by the -output flag. Package gen defines
+// the following flags, which are therefore shared by all generators:
+//
+//	-output  name of the output file or "-" to write to stdout
+//	-local   read data files from the current directory
+//	-cache   directory in which downloaded data files are cached
+//	-offline only use data files from the cache
+package gen
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	outputFile = flag.String("output", "tables.go",
+		`name of the output file or "-" to write to stdout.`)
+	localFiles = flag.Bool("local", false,
+		"data files have been copied to the current directory; for debugging only.")
+)
+
+// Init parses the command line flags and sets up logging. It should be called
+// by generators instead of flag.Parse.
+func Init() {
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix(filepath.Base(os.Args[0]) + ": ")
+}
+
+// Header returns the standard header of a generated file. The arguments are
+// the command line arguments, excluding the program name, with which the
+// generator was run. Typically this is os.Args[1:].
+func Header(args []string) string {
+	cmd := "maketables"
+	if len(args) > 0 {
+		cmd += " " + strings.Join(args, " ")
+	}
+	return fmt.Sprintf("// Generated by running\n//\t\t%s\n// DO NOT EDIT\n\n", cmd)
+}
+
+// WriteGoFile formats src with gofmt and writes the result to the file with
+// the given name. If filename is "" or "-", the result is written to standard
+// output instead. The output is first written to a temporary file in the same
+// directory, which is renamed to filename only if all data was written
+// successfully. This ensures that an existing file is never left partially
+// written.
+//
+// If src cannot be formatted, the unformatted source is written to
+// filename + ".broken" to aid debugging and an error is returned.
+func WriteGoFile(filename string, src []byte) error {
+	b, err := format.Source(src)
+	if err != nil {
+		if filename != "" && filename != "-" {
+			ioutil.WriteFile(filename+".broken", src, 0644)
+		}
+		return fmt.Errorf("gen: formatting %s: %v", filename, err)
+	}
+	if filename == "" || filename == "-" {
+		_, err = io.Copy(os.Stdout, bytes.NewReader(b))
+		return err
+	}
+	return writeFileAtomic(filename, b)
+}
+
+func writeFileAtomic(filename string, b []byte) (err error) {
+	f, err := ioutil.TempFile(filepath.Dir(filename), filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(f.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filename)
+}
@@ -0,0 +1,64 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHeader(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{nil, "// Generated by running\n//\t\tmaketables\n// DO NOT EDIT\n\n"},
+		{[]string{"-cldr=x.zip", "-local"}, "// Generated by running\n//\t\tmaketables -cldr=x.zip -local\n// DO NOT EDIT\n\n"},
+	}
+	for i, tt := range tests {
+		if got := Header(tt.args); got != tt.want {
+			t.Errorf("%d: Header(%q) = %q; want %q", i, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestWriteGoFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "tables.go")
+
+	src := Header(nil) + "package x\nvar   a = [ ]int{1,\n2}\n"
+	if err := WriteGoFile(filename, []byte(src)); err != nil {
+		t.Fatalf("WriteGoFile: %v", err)
+	}
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = "// Generated by running\n//\t\tmaketables\n// DO NOT EDIT\n\npackage x\n\nvar a = []int{1,\n\t2}\n"
+	if string(b) != want {
+		t.Errorf("got %q; want %q", b, want)
+	}
+
+	// A file is not overwritten if the source does not compile.
+	if err := WriteGoFile(filename, []byte("package x\nvar a = ")); err == nil {
+		t.Errorf("WriteGoFile: expected error for malformed source")
+	}
+	if b, _ := ioutil.ReadFile(filename); string(b) != want {
+		t.Errorf("malformed source overwrote file: got %q", b)
+	}
+	if _, err := os.Stat(filename + ".broken"); err != nil {
+		t.Errorf("expected .broken file: %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.tmp*"))
+	if len(files) != 0 {
+		t.Errorf("temporary files left behind: %v", files)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package localetree
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// A Builder collects the values of a set of locales and creates a Tree.
+type Builder struct {
+	parents []int
+	values  []map[int]string
+	numKeys int
+}
+
+// NewBuilder returns a Builder for len(parents) locales, where parents[i] is
+// the index of the parent of locale i or -1 if it has none.
+func NewBuilder(parents []int) *Builder {
+	b := &Builder{
+		parents: append([]int(nil), parents...),
+		values:  make([]map[int]string, len(parents)),
+	}
+	for i := range b.values {
+		b.values[i] = make(map[int]string)
+	}
+	return b
+}
+
+// Set sets the value for key defined by locale loc. Setting the empty string
+// removes the value.
+func (b *Builder) Set(loc, key int, value string) {
+	if value == "" {
+		delete(b.values[loc], key)
+		return
+	}
+	b.values[loc][key] = value
+	if key >= b.numKeys {
+		b.numKeys = key + 1
+	}
+}
+
+// lookup returns the value for key of loc or its closest ancestor.
+func (b *Builder) lookup(loc, key int) string {
+	for ; loc >= 0; loc = b.parents[loc] {
+		if s := b.values[loc][key]; s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// Build creates a Tree for the values set so far. A value that equals the value
+// a locale inherits from its ancestors is not stored, so each value is encoded
+// only once for a subtree of locales that share it. Lookup returns the same
+// values for the resulting Tree as for the values set.
+func (b *Builder) Build() (*Tree, error) {
+	if len(b.parents) > 1<<15 {
+		return nil, fmt.Errorf("localetree: too many locales: %d", len(b.parents))
+	}
+	if err := b.checkParents(); err != nil {
+		return nil, err
+	}
+
+	// Remove the values that are inherited.
+	own := make([]map[int]string, len(b.values))
+	for loc, m := range b.values {
+		own[loc] = make(map[int]string)
+		for key, s := range m {
+			if p := b.parents[loc]; p == -1 || b.lookup(p, key) != s {
+				own[loc][key] = s
+			}
+		}
+	}
+
+	t := &Tree{}
+	ids := t.buildData(own)
+	if ids == nil {
+		return nil, errors.New("localetree: too many distinct values")
+	}
+
+	blocks := map[[BlockSize]uint16]uint16{{}: 0}
+	t.Blocks = make([]uint16, BlockSize)
+	t.Offsets = []uint16{0}
+	for loc, m := range own {
+		t.Parents = append(t.Parents, int16(b.parents[loc]))
+		var index []uint16
+		for start := 0; start < b.numKeys; start += BlockSize {
+			var block [BlockSize]uint16
+			for k := range block {
+				block[k] = ids[m[start+k]]
+			}
+			x, ok := blocks[block]
+			if !ok {
+				if len(blocks) == 1<<16 {
+					return nil, errors.New("localetree: too many blocks")
+				}
+				x = uint16(len(blocks))
+				blocks[block] = x
+				t.Blocks = append(t.Blocks, block[:]...)
+			}
+			index = append(index, x)
+		}
+		n := len(index)
+		for ; n > 0 && index[n-1] == 0; n-- {
+		}
+		t.Index = append(t.Index, index[:n]...)
+		if len(t.Index) >= 1<<16 {
+			return nil, errors.New("localetree: index too large")
+		}
+		t.Offsets = append(t.Offsets, uint16(len(t.Index)))
+	}
+	return t, nil
+}
+
+// checkParents verifies that the parent relation is a forest.
+func (b *Builder) checkParents() error {
+	for loc := range b.parents {
+		n := 0
+		for p := loc; p != -1; p = b.parents[p] {
+			if p < -1 || p >= len(b.parents) {
+				return fmt.Errorf("localetree: invalid parent %d of locale %d", p, loc)
+			}
+			if n++; n > len(b.parents) {
+				return fmt.Errorf("localetree: locale %d is its own ancestor", loc)
+			}
+		}
+	}
+	return nil
+}
+
+type bySizeAndValue []string
+
+func (l bySizeAndValue) Len() int      { return len(l) }
+func (l bySizeAndValue) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l bySizeAndValue) Less(i, j int) bool {
+	if len(l[i]) != len(l[j]) {
+		return len(l[i]) < len(l[j])
+	}
+	return l[i] < l[j]
+}
+
+// buildData sets Data and Lengths for the given values and returns a map from
+// each value to its ID. It returns nil if there are too many values.
+func (t *Tree) buildData(values []map[int]string) map[string]uint16 {
+	seen := map[string]bool{"": true}
+	strs := []string{""}
+	for _, m := range values {
+		for _, s := range m {
+			if !seen[s] {
+				seen[s] = true
+				strs = append(strs, s)
+			}
+		}
+	}
+	if len(strs) > 1<<16 {
+		return nil
+	}
+	sort.Sort(bySizeAndValue(strs))
+	ids := make(map[string]uint16)
+	data := []byte{}
+	for i, s := range strs {
+		ids[s] = uint16(i)
+		if i == 0 || len(s) != len(strs[i-1]) {
+			t.Lengths = append(t.Lengths, LengthBlock{uint16(i), uint16(len(s)), uint32(len(data))})
+		}
+		data = append(data, s...)
+	}
+	t.Data = string(data)
+	return ids
+}
+
+// WriteGo writes t as the Go source of a variable with the given name to w. The
+// source refers to the types of this package with the prefix localetree. It
+// returns the number of bytes of the tables, as reported by Size.
+func (t *Tree) WriteGo(w io.Writer, name string) (n int, err error) {
+	p := &printer{w: w}
+	p.printf("// %s: %d locales, %d blocks of value IDs; %d bytes\n",
+		name, len(t.Parents), len(t.Blocks)/BlockSize, t.Size())
+	p.printf("var %s = localetree.Tree{\n", name)
+	p.ints("Parents", "int16", len(t.Parents), func(i int) int64 { return int64(t.Parents[i]) })
+	p.ints("Offsets", "uint16", len(t.Offsets), func(i int) int64 { return int64(t.Offsets[i]) })
+	p.ints("Index", "uint16", len(t.Index), func(i int) int64 { return int64(t.Index[i]) })
+	p.ints("Blocks", "uint16", len(t.Blocks), func(i int) int64 { return int64(t.Blocks[i]) })
+	p.printf("\tData: \"\" +\n")
+	for s := t.Data; ; {
+		n := len(s)
+		if n > 60 {
+			n = 60
+		}
+		// Do not split UTF-8 sequences.
+		for n < len(s) && s[n]&0xC0 == 0x80 {
+			n--
+		}
+		if n == len(s) {
+			p.printf("\t\t%s,\n", strconv.Quote(s))
+			break
+		}
+		p.printf("\t\t%s +\n", strconv.Quote(s[:n]))
+		s = s[n:]
+	}
+	p.printf("\tLengths: []localetree.LengthBlock{\n")
+	for _, b := range t.Lengths {
+		p.printf("\t\t{0x%x, 0x%x, 0x%x},\n", b.ID, b.Size, b.Offset)
+	}
+	p.printf("\t},\n}\n\n")
+	return t.Size(), p.err
+}
+
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+func (p *printer) ints(field, typ string, n int, x func(i int) int64) {
+	p.printf("\t%s: []%s{", field, typ)
+	for i := 0; i < n; i++ {
+		if i%12 == 0 {
+			p.printf("\n\t\t")
+		}
+		p.printf("%d, ", x(i))
+	}
+	p.printf("\n\t},\n")
+}
@@ -0,0 +1,117 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package localetree provides a compact representation of string values that
+// are defined per locale and inherited from parent locales, such as the display
+// names of languages or the patterns for formatting numbers. It is intended to
+// be shared by the packages of go.text that have such data, so that each of
+// them does not have to invent its own table layout.
+//
+// Locales and keys are identified by their index. A generator adds the values
+// for each locale to a Builder and writes the resulting Tree as a Go variable.
+// At run time, Lookup finds the value for a locale, falling back to its
+// ancestors if the locale does not define the value itself.
+package localetree
+
+import "sort"
+
+// BlockSize is the number of value IDs in a block of a Tree's index.
+const BlockSize = 8
+
+// A Tree holds the values for a set of locales. The values of all locales
+// are stored once in Data, sorted by length. Each distinct value is identified
+// by its position in this order, its ID. The ID 0 denotes the empty string,
+// which indicates that a locale does not define the value for a key.
+//
+// For each locale, the IDs of the values of all keys are split in blocks of
+// BlockSize. Identical blocks are stored once in Blocks. The first-level index
+// of locale i, Index[Offsets[i]:Offsets[i+1]], lists the positions of its
+// blocks in Blocks. Trailing blocks of undefined values are omitted.
+//
+// A Tree is created by a Builder. Its fields are exported only so that it can
+// be written as a Go literal; they should not be accessed directly.
+type Tree struct {
+	// Parents[i] is the index of the parent of locale i or -1 if it has none.
+	Parents []int16
+
+	Offsets []uint16
+	Index   []uint16
+	Blocks  []uint16
+
+	// Data holds all distinct values, sorted by length and then by value.
+	// Lengths maps IDs to the position of the values in Data.
+	Data    string
+	Lengths []LengthBlock
+}
+
+// A LengthBlock describes a run of values of equal length in Data. The value
+// with ID ID+k is stored at Data[Offset+k*Size:][:Size].
+type LengthBlock struct {
+	ID     uint16
+	Size   uint16
+	Offset uint32
+}
+
+// NumLocales returns the number of locales in t.
+func (t *Tree) NumLocales() int {
+	return len(t.Parents)
+}
+
+// Parent returns the index of the parent of locale loc or -1 if loc has no
+// parent.
+func (t *Tree) Parent(loc int) int {
+	return int(t.Parents[loc])
+}
+
+// Value returns the value for key defined by locale loc itself. It returns
+// the empty string if loc does not define a value for key.
+func (t *Tree) Value(loc, key int) string {
+	if loc < 0 || loc >= len(t.Parents) || key < 0 {
+		return ""
+	}
+	index := t.Index[t.Offsets[loc]:t.Offsets[loc+1]]
+	b := key / BlockSize
+	if b >= len(index) {
+		return ""
+	}
+	return t.value(t.Blocks[int(index[b])*BlockSize+key%BlockSize])
+}
+
+// Lookup returns the value for key for locale loc. If loc does not define the
+// value, the value of the closest ancestor that does is returned. It returns
+// the empty string if there is no such ancestor.
+func (t *Tree) Lookup(loc, key int) string {
+	s, _ := t.LookupLocale(loc, key)
+	return s
+}
+
+// LookupLocale is like Lookup, but also returns the index of the locale that
+// defines the value, or -1 if no value is found.
+func (t *Tree) LookupLocale(loc, key int) (value string, defined int) {
+	for ; loc >= 0 && loc < len(t.Parents); loc = int(t.Parents[loc]) {
+		if s := t.Value(loc, key); s != "" {
+			return s, loc
+		}
+	}
+	return "", -1
+}
+
+// value returns the value with the given ID.
+func (t *Tree) value(id uint16) string {
+	if id == 0 {
+		return ""
+	}
+	i := sort.Search(len(t.Lengths), func(i int) bool {
+		return t.Lengths[i].ID > id
+	})
+	b := &t.Lengths[i-1]
+	start := b.Offset + uint32(id-b.ID)*uint32(b.Size)
+	return t.Data[start : start+uint32(b.Size)]
+}
+
+// Size returns the number of bytes of the tables of t.
+func (t *Tree) Size() int {
+	return 2*(len(t.Parents)+len(t.Offsets)+len(t.Index)+len(t.Blocks)) +
+		len(t.Data) + 8*len(t.Lengths)
+}
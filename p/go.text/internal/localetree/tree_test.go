@@ -0,0 +1,153 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package localetree
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+const (
+	root = iota
+	en
+	enGB
+	enAU
+	de
+	deCH
+	numLocales
+)
+
+var parents = []int{-1, root, en, enGB, root, de}
+
+func testTree(t *testing.T) (*Builder, *Tree) {
+	b := NewBuilder(parents)
+	values := []struct {
+		loc, key int
+		value    string
+	}{
+		{root, 0, "und"},
+		{en, 1, "English"},
+		{en, 2, "German"},
+		{en, 20, "Swiss"},
+		{enGB, 1, "English"}, // same as parent; dropped
+		{enGB, 20, "Swiss (GB)"},
+		{enAU, 20, "Swiss"}, // differs from parent enGB
+		{de, 1, "Englisch"},
+		{de, 2, "Deutsch"},
+		{deCH, 2, "Deutsch"}, // same as parent; dropped
+		{deCH, 3, "Schwiizertüütsch"},
+		{deCH, 4, "x"},
+		{deCH, 4, ""}, // removes the value
+	}
+	for _, v := range values {
+		b.Set(v.loc, v.key, v.value)
+	}
+	tree, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	return b, tree
+}
+
+func TestLookup(t *testing.T) {
+	b, tree := testTree(t)
+	if n := tree.NumLocales(); n != numLocales {
+		t.Errorf("NumLocales was %d; want %d", n, numLocales)
+	}
+	for loc := -1; loc <= numLocales; loc++ {
+		for key := -1; key < 30; key++ {
+			want := ""
+			if loc >= 0 && loc < numLocales && key >= 0 {
+				want = b.lookup(loc, key)
+			}
+			if got := tree.Lookup(loc, key); got != want {
+				t.Errorf("Lookup(%d, %d) was %q; want %q", loc, key, got, want)
+			}
+		}
+	}
+	tests := []struct {
+		loc, key int
+		value    string
+		defined  int
+	}{
+		{enGB, 1, "English", en},
+		{enGB, 0, "und", root},
+		{enAU, 20, "Swiss", enAU},
+		{deCH, 2, "Deutsch", de},
+		{deCH, 3, "Schwiizertüütsch", deCH},
+		{deCH, 4, "", -1},
+		{deCH, 20, "", -1},
+	}
+	for _, tt := range tests {
+		s, d := tree.LookupLocale(tt.loc, tt.key)
+		if s != tt.value || d != tt.defined {
+			t.Errorf("LookupLocale(%d, %d) was %q, %d; want %q, %d", tt.loc, tt.key, s, d, tt.value, tt.defined)
+		}
+	}
+	if s := tree.Value(enGB, 1); s != "" {
+		t.Errorf("Value(enGB, 1) was %q; want inherited value to be dropped", s)
+	}
+	if p := tree.Parent(deCH); p != de {
+		t.Errorf("Parent(deCH) was %d; want %d", p, de)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	for i, p := range [][]int{
+		{0},
+		{1, 0},
+		{-1, 2},
+		{-2},
+	} {
+		if _, err := NewBuilder(p).Build(); err == nil {
+			t.Errorf("%d: Build succeeded for parents %v", i, p)
+		}
+	}
+}
+
+func TestSharedBlocks(t *testing.T) {
+	// Locales with the same values share all their blocks.
+	b := NewBuilder([]int{-1, -1, -1})
+	for loc := 0; loc < 3; loc++ {
+		for key := 0; key < 4*BlockSize; key++ {
+			if key%3 != 0 {
+				b.Set(loc, key, fmt.Sprint(key))
+			}
+		}
+	}
+	tree, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(tree.Blocks) / BlockSize; n != 5 {
+		t.Errorf("number of blocks was %d; want 5", n)
+	}
+	if n := len(tree.Index); n != 12 {
+		t.Errorf("size of index was %d; want 12", n)
+	}
+}
+
+func TestWriteGo(t *testing.T) {
+	_, tree := testTree(t)
+	var buf bytes.Buffer
+	n, err := tree.WriteGo(&buf, "testTree")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != tree.Size() {
+		t.Errorf("size was %d; want %d", n, tree.Size())
+	}
+	src := "package p\n\n" + buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "", src, 0); err != nil {
+		t.Errorf("generated code does not parse: %v\n%s", err, src)
+	}
+	if !strings.Contains(src, "var testTree = localetree.Tree{") {
+		t.Errorf("variable declaration missing from:\n%s", src)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+// NumCompactTags is the number of tags for which CompactIndex returns a
+// distinct index: Und and the tags of Common.
+const NumCompactTags = 79
+
+// compactIndex maps the tags of Common to their compact index.
+var compactIndex = func() map[Tag]int {
+	m := make(map[Tag]int, len(commonTags))
+	for i, t := range commonTags {
+		m[t] = i + 1
+	}
+	return m
+}()
+
+// CompactIndex returns an index, where 0 <= index < NumCompactTags, for the
+// tag in the set of Und and the tags of Common that is closest to t. The
+// index can be used to store a reference to a tag in a small integer or to
+// index per-language arrays. If t is not in the set, CompactIndex returns the
+// index of its closest ancestor in the set, as determined by Parent, and false
+// for exact. The index of a tag does not change between releases: new tags are
+// only ever added at the end.
+func CompactIndex(t Tag) (index int, exact bool) {
+	exact = true
+	for ; !t.IsRoot(); t = t.Parent() {
+		if t.str == "" {
+			if i, ok := compactIndex[t]; ok {
+				return i, exact
+			}
+		}
+		exact = false
+	}
+	return 0, exact && t.str == ""
+}
+
+// CompactTag returns the tag for the given compact index. It panics if index
+// is not in the range [0, NumCompactTags).
+func CompactTag(index int) Tag {
+	if index == 0 {
+		return Und
+	}
+	return commonTags[index-1]
+}
@@ -0,0 +1,42 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+import "testing"
+
+func TestCompactIndex(t *testing.T) {
+	if n := len(commonTags) + 1; n != NumCompactTags {
+		t.Errorf("NumCompactTags was %d; want %d", NumCompactTags, n)
+	}
+	for i := 0; i < NumCompactTags; i++ {
+		tag := CompactTag(i)
+		if x, exact := CompactIndex(tag); x != i || !exact {
+			t.Errorf("%d:%v: index was %d, %v; want %d, true", i, tag, x, exact, i)
+		}
+	}
+	tests := []struct {
+		tag   string
+		want  string
+		exact bool
+	}{
+		{"und", "und", true},
+		{"en", "en", true},
+		{"en-US", "en-US", true},
+		{"zh-Hant", "zh-Hant", true},
+		{"zh-TW", "zh-Hant", false},
+		{"en-AU", "en-GB", false},
+		{"de-CH", "de", false},
+		{"de-1901", "de", false},
+		{"nl-u-co-phonebk", "nl", false},
+		{"qaa", "und", false},
+		{"gsw", "und", false},
+	}
+	for _, tt := range tests {
+		x, exact := CompactIndex(Raw.MustParse(tt.tag))
+		if got := CompactTag(x).String(); got != tt.want || exact != tt.exact {
+			t.Errorf("%s: was %s, %v; want %s, %v", tt.tag, got, exact, tt.want, tt.exact)
+		}
+	}
+}
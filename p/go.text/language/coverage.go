@@ -31,12 +31,61 @@ var (
 	// Supported defines a Coverage that lists all supported subtags. Tags
 	// always returns nil.
 	Supported Coverage = allSubtags{}
+
+	// Common defines a Coverage that lists the commonly used tags for which
+	// this package defines variables, such as English and SimplifiedChinese.
+	// Scripts and Regions return the subtags explicitly specified by these
+	// tags.
+	Common Coverage = NewCoverage(commonTagList, commonScripts, commonRegions)
 )
 
 // TODO:
 // - Support Currencies, Variants, numbering systems.
 // - CLDR coverage levels.
-// - Set of common tags defined in this package.
+
+// commonTagList returns a copy of commonTags, so that callers cannot modify
+// the list of Common.
+func commonTagList() []Tag {
+	return append([]Tag(nil), commonTags...)
+}
+
+// commonScripts returns the sorted list of scripts specified in commonTags.
+func commonScripts() []Script {
+	var a []Script
+	for _, t := range commonTags {
+		if t.script != 0 {
+			a = append(a, Script{t.script})
+		}
+	}
+	sort.Sort(scripts(a))
+	k := 0
+	for i := 1; i < len(a); i++ {
+		if a[k] != a[i] {
+			k++
+			a[k] = a[i]
+		}
+	}
+	return a[:k+1]
+}
+
+// commonRegions returns the sorted list of regions specified in commonTags.
+func commonRegions() []Region {
+	var a []Region
+	for _, t := range commonTags {
+		if t.region != 0 {
+			a = append(a, Region{t.region})
+		}
+	}
+	sort.Sort(regions(a))
+	k := 0
+	for i := 1; i < len(a); i++ {
+		if a[k] != a[i] {
+			k++
+			a[k] = a[i]
+		}
+	}
+	return a[:k+1]
+}
 
 type allSubtags struct{}
 
@@ -124,6 +173,36 @@ func (b bases) Less(i, j int) bool {
 	return b[i].langID < b[j].langID
 }
 
+// scripts implements sort.Interface and is used to sort scripts.
+type scripts []Script
+
+func (s scripts) Len() int {
+	return len(s)
+}
+
+func (s scripts) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s scripts) Less(i, j int) bool {
+	return s[i].scriptID < s[j].scriptID
+}
+
+// regions implements sort.Interface and is used to sort regions.
+type regions []Region
+
+func (r regions) Len() int {
+	return len(r)
+}
+
+func (r regions) Swap(i, j int) {
+	r[i], r[j] = r[j], r[i]
+}
+
+func (r regions) Less(i, j int) bool {
+	return r[i].regionID < r[j].regionID
+}
+
 // BaseLanguages returns the result from calling s.bases if it is specified or
 // otherwise derives the set of supported base languages from tags.
 func (s *coverage) BaseLanguages() []Base {
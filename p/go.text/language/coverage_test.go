@@ -7,6 +7,8 @@ package language
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -152,3 +154,42 @@ func TestNewCoverage(t *testing.T) {
 		}
 	}
 }
+
+func TestCommon(t *testing.T) {
+	tags := Common.Tags()
+	if len(tags) != len(commonTags) {
+		t.Fatalf("len(Tags()) was %d; want %d", len(tags), len(commonTags))
+	}
+	tags[0] = Und
+	if Common.Tags()[0] != Afrikaans {
+		t.Errorf("modifying the result of Tags changed Common")
+	}
+	seen := map[Tag]bool{}
+	for _, tag := range Common.Tags() {
+		if tag == Und {
+			t.Errorf("Tags included Und")
+		}
+		if seen[tag] {
+			t.Errorf("Tags included %v more than once", tag)
+		}
+		seen[tag] = true
+	}
+	if b := Common.BaseLanguages(); len(b) != 68 {
+		t.Errorf("len(BaseLanguages()) was %d; want 68", len(b))
+	}
+	want := "Hans Hant"
+	var got []string
+	for _, s := range Common.Scripts() {
+		got = append(got, s.String())
+	}
+	if s := strings.Join(got, " "); s != want {
+		t.Errorf("Scripts was %q; want %q", s, want)
+	}
+	got = nil
+	for _, r := range Common.Regions() {
+		got = append(got, r.String())
+	}
+	if len(got) != 8 || !sort.IsSorted(regions(Common.Regions())) {
+		t.Errorf("Regions was %v; want 8 sorted regions", got)
+	}
+}
@@ -80,6 +80,43 @@ func ExampleTag_Region() {
 	// US Low
 }
 
+func ExampleTag_Maximize() {
+	for _, s := range []string{"en", "zh", "zh-TW", "sr-ME", "und-IQ"} {
+		max, _ := language.Make(s).Maximize()
+		fmt.Println(max)
+	}
+	// Output:
+	// en-Latn-US
+	// zh-Hans-CN
+	// zh-Hant-TW
+	// sr-Latn-ME
+	// ar-Arab-IQ
+}
+
+func ExampleTag_Minimize() {
+	for _, s := range []string{"en-Latn-US", "zh-Hans-CN", "zh-Hant-TW", "de-Latn-DE-1901"} {
+		min, _ := language.Make(s).Minimize()
+		fmt.Println(min)
+	}
+	// Output:
+	// en
+	// zh
+	// zh-TW
+	// de-1901
+}
+
+func ExampleUndScript() {
+	for _, s := range []string{"Latn", "Cyrl", "Hant", "Arab"} {
+		max, _ := language.UndScript(language.MustParseScript(s)).Maximize()
+		fmt.Println(max)
+	}
+	// Output:
+	// en-Latn-US
+	// ru-Cyrl-RU
+	// zh-Hant-TW
+	// ar-Arab-EG
+}
+
 func ExampleCompose() {
 	nl, _ := language.ParseBase("nl")
 	us, _ := language.ParseRegion("US")
@@ -0,0 +1,82 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httplang selects the language in which to respond to an HTTP request.
+//
+// The language preferences of a request are typically taken from its
+// Accept-Language header, but applications often allow the user to override
+// these with a query parameter or a cookie. Match consults a chain of such
+// sources and matches the first usable preference against a set of supported
+// languages.
+package httplang
+
+import (
+	"net/http"
+
+	"code.google.com/p/go.text/language"
+)
+
+// A Source returns the language preferences expressed in r, in the format of
+// the value of an Accept-Language header, or "" if there are none. A single
+// language tag, such as "fr-CA", is a valid value.
+type Source func(r *http.Request) string
+
+// Header returns a Source for the header with the given name.
+func Header(name string) Source {
+	return func(r *http.Request) string {
+		return r.Header.Get(name)
+	}
+}
+
+// Query returns a Source for the query parameter with the given name.
+func Query(name string) Source {
+	return func(r *http.Request) string {
+		return r.URL.Query().Get(name)
+	}
+}
+
+// Cookie returns a Source for the value of the cookie with the given name.
+func Cookie(name string) Source {
+	return func(r *http.Request) string {
+		c, err := r.Cookie(name)
+		if err != nil {
+			return ""
+		}
+		return c.Value
+	}
+}
+
+// AcceptLanguage is the Source for the Accept-Language header.
+var AcceptLanguage = Header("Accept-Language")
+
+// Match returns the tag in m that best matches the language preferences of r
+// and the confidence of the match. It consults the given sources in order and
+// returns the result for the first source whose value can be parsed and matches
+// a supported tag with a confidence other than No. Quality values are taken
+// into account as described for language.MatchAcceptLanguage. If no sources
+// are given, only AcceptLanguage is consulted. For example, to allow the
+// preferences of the Accept-Language header to be overridden by a "lang"
+// query parameter or cookie, use
+//
+//	httplang.Match(m, r, httplang.Query("lang"), httplang.Cookie("lang"), httplang.AcceptLanguage)
+//
+// If none of the sources yields a match, Match returns the default tag of m
+// with confidence No.
+func Match(m language.Matcher, r *http.Request, sources ...Source) (language.Tag, language.Confidence) {
+	if len(sources) == 0 {
+		sources = []Source{AcceptLanguage}
+	}
+	for _, src := range sources {
+		s := src(r)
+		if s == "" {
+			continue
+		}
+		tag, _, c, err := language.MatchAcceptLanguage(m, s)
+		if err == nil && c != language.No {
+			return tag, c
+		}
+	}
+	tag, _, c := m.Match()
+	return tag, c
+}
@@ -0,0 +1,58 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httplang
+
+import (
+	"net/http"
+	"testing"
+
+	"code.google.com/p/go.text/language"
+)
+
+func TestMatch(t *testing.T) {
+	m := language.NewMatcher([]language.Tag{
+		language.English,
+		language.French,
+		language.German,
+		language.Dutch,
+	})
+	sources := []Source{Query("lang"), Cookie("lang"), AcceptLanguage}
+	tests := []struct {
+		url, cookie, accept string
+		sources             []Source
+		tag                 string
+		conf                language.Confidence
+	}{
+		{"/", "", "", nil, "en", language.No},
+		{"/", "", "de-DE, fr;q=0.5", nil, "de", language.High},
+		{"/", "", "de;q=0.5, fr", nil, "fr", language.Exact},
+		{"/", "", "de;q=0, nl", nil, "nl", language.Exact},
+		{"/", "", "ja", nil, "en", language.No},
+		{"/", "", "%%%", nil, "en", language.No},
+		{"/?lang=nl", "fr", "de", nil, "de", language.Exact},
+		{"/?lang=nl", "fr", "de", sources, "nl", language.Exact},
+		{"/?lang=ja", "fr", "de", sources, "fr", language.Exact},
+		{"/?lang=%25%25", "fr", "de", sources, "fr", language.Exact},
+		{"/", "fr-CA", "de", sources, "fr", language.High},
+		{"/", "", "de", sources, "de", language.Exact},
+		{"/", "ja", "ko", sources, "en", language.No},
+	}
+	for i, tt := range tests {
+		r, err := http.NewRequest("GET", tt.url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.cookie != "" {
+			r.AddCookie(&http.Cookie{Name: "lang", Value: tt.cookie})
+		}
+		if tt.accept != "" {
+			r.Header.Set("Accept-Language", tt.accept)
+		}
+		tag, conf := Match(m, r, tt.sources...)
+		if tag.String() != tt.tag || conf != tt.conf {
+			t.Errorf("%d: was %s, %v; want %s, %v", i, tag, conf, tt.tag, tt.conf)
+		}
+	}
+}
@@ -22,11 +22,23 @@
 package language
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
+
+	"code.google.com/p/go.text/dataversion"
 )
 
+func init() {
+	dataversion.Register(dataversion.Info{
+		Package: "code.google.com/p/go.text/language",
+		CLDR:    Version,
+	})
+}
+
 const (
 	// maxCoreSize is the maximum size of a BCP 47 tag without variants and
 	// extensions. Equals max lang (3) + script (4) + max reg (3) + 2 dashes.
@@ -145,7 +157,7 @@ func (t Tag) canonicalize(c CanonType) (Tag, bool) {
 	}
 	changed := false
 	if c&SuppressScript != 0 {
-		if t.lang < langNoIndexOffset && uint8(t.script) == suppressScript[t.lang] {
+		if t.script != 0 && t.lang < langNoIndexOffset && uint8(t.script) == suppressScript[t.lang] {
 			t.script = 0
 			changed = true
 		}
@@ -218,11 +230,20 @@ func (t Tag) canonicalize(c CanonType) (Tag, bool) {
 
 // Canonicalize returns the canonicalized equivalent of the tag.
 func (c CanonType) Canonicalize(t Tag) (Tag, error) {
-	t, changed := t.canonicalize(c)
+	t, _, err := t.Canonicalize(c)
+	return t, err
+}
+
+// Canonicalize returns the equivalent of t canonicalized using the
+// canonicalization type c and reports whether this changed t. It can be used
+// to normalize tags that were not created by Parse, Make or Compose, such as
+// tags that were parsed with Raw or decoded from another system.
+func (t Tag) Canonicalize(c CanonType) (tag Tag, changed bool, err error) {
+	t, changed = t.canonicalize(c)
 	if changed {
 		t.remakeString()
 	}
-	return t, nil
+	return t, changed, nil
 }
 
 // Confidence indicates the level of certainty for a given return value.
@@ -294,6 +315,9 @@ func (t *Tag) genCoreBytes(buf []byte) int {
 }
 
 // String returns the canonical string representation of the language tag.
+// The representation is stable: it only depends on the value of t, and
+// parsing it with Raw.Parse yields t again. Use Canonicalize to obtain a tag
+// of which the representation is the same for all equivalent tags.
 func (t Tag) String() string {
 	if t.str != "" {
 		return t.str
@@ -305,6 +329,110 @@ func (t Tag) String() string {
 	return string(buf[:t.genCoreBytes(buf[:])])
 }
 
+// AppendString appends the string representation of t, as returned by String,
+// to dst and returns the extended buffer. Unlike String, it does not allocate
+// if dst has sufficient capacity.
+func (t Tag) AppendString(dst []byte) []byte {
+	if t.str != "" {
+		return append(dst, t.str...)
+	}
+	buf := [maxCoreSize]byte{}
+	return append(dst, buf[:t.genCoreBytes(buf[:])]...)
+}
+
+// Format implements fmt.Formatter. The verbs %s and %v print t as returned
+// by String and %q prints it as a double-quoted string. The plus flag, as in
+// %+s, prints t canonicalized according to BCP 47 instead, which is the same
+// for all equivalent tags. The width flag and the minus flag for padding on
+// the right are supported. %#v prints t as a Go expression.
+func (t Tag) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'q':
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprintf(f, "language.Raw.MustParse(%q)", t.String())
+			return
+		}
+	default:
+		fmt.Fprintf(f, "%%!%c(language.Tag=%s)", verb, t.String())
+		return
+	}
+	if f.Flag('+') {
+		t, _ = BCP47.Canonicalize(t)
+	}
+	buf := [max99thPercentileSize]byte{}
+	b := buf[:0]
+	if verb == 'q' {
+		// Tags only consist of ASCII letters, digits and '-', none of which
+		// need to be escaped.
+		b = append(b, '"')
+		b = t.AppendString(b)
+		b = append(b, '"')
+	} else {
+		b = t.AppendString(b)
+	}
+	if w, ok := f.Width(); ok && w > len(b) {
+		pad := make([]byte, w-len(b))
+		for i := range pad {
+			pad[i] = ' '
+		}
+		if f.Flag('-') {
+			b = append(b, pad...)
+		} else {
+			b = append(pad, b...)
+		}
+	}
+	f.Write(b)
+}
+
+// MarshalText implements encoding.TextMarshaler. The text form of a tag is
+// its string representation.
+func (t Tag) MarshalText() (text []byte, err error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed
+// without canonicalization, so that a tag survives a round trip through its
+// text form unchanged, whichever canonicalization was used to create it.
+func (t *Tag) UnmarshalText(text []byte) error {
+	tag, err := Raw.Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+var errBinary = errors.New("language: invalid binary encoding of Tag")
+
+// binaryVersion is the first byte of the binary form of a Tag. It identifies
+// the format of the remaining bytes.
+const binaryVersion = 1
+
+// MarshalBinary implements encoding.BinaryMarshaler. The binary form of a
+// tag is a version byte, currently 1, followed by the string representation
+// of the tag. The string form, unlike the internal representation of a tag,
+// does not depend on the version of the tables of this package, so that
+// stored tags keep their meaning when the tables are regenerated.
+func (t Tag) MarshalBinary() (data []byte, err error) {
+	return append([]byte{binaryVersion}, t.String()...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It returns an error
+// for data with an unknown version. The tag is parsed without
+// canonicalization, like with UnmarshalText.
+func (t *Tag) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != binaryVersion {
+		return errBinary
+	}
+	tag, err := Raw.Parse(string(data[1:]))
+	if err != nil {
+		return errBinary
+	}
+	*t = tag
+	return nil
+}
+
 // Base returns the base language of the language tag. If the base language is
 // unspecified, an attempt will be made to infer it from the context.
 // It uses a variant of CLDR's Add Likely Subtags algorithm. This is subject to change.
@@ -525,6 +653,51 @@ func (t Tag) Extensions() []Extension {
 	return e
 }
 
+// DeleteExtension returns t without its extension of type x, such as 'u' for
+// the Unicode locale extension or 'x' for the private use extension. It
+// returns t if t has no such extension.
+func (t Tag) DeleteExtension(x byte) Tag {
+	return t.filterExtensions(func(e byte) bool { return e != x })
+}
+
+// KeepExtensions returns t without any of its extensions, except those of the
+// given types. For example, t.KeepExtensions('u') removes all extensions
+// except the Unicode locale extension and t.KeepExtensions() removes all
+// extensions.
+func (t Tag) KeepExtensions(x ...byte) Tag {
+	return t.filterExtensions(func(e byte) bool {
+		return bytes.IndexByte(x, e) != -1
+	})
+}
+
+// filterExtensions returns t with only the extensions for which keep returns
+// true.
+func (t Tag) filterExtensions(keep func(x byte) bool) Tag {
+	if int(t.pExt) >= len(t.str) {
+		return t
+	}
+	b := []byte(t.str[:t.pExt])
+	changed := false
+	for _, e := range t.Extensions() {
+		if !keep(e.Type()) {
+			changed = true
+			continue
+		}
+		if len(b) > 0 {
+			b = append(b, '-')
+		}
+		b = append(b, e.s...)
+	}
+	if !changed {
+		return t
+	}
+	if len(b) == 0 {
+		return Und
+	}
+	t, _ = Raw.Parse(string(b))
+	return t
+}
+
 // TypeForKey returns the type associated with the given key, where key and type
 // are of the allowed values defined for the Unicode locale extension ('u') in
 // http://www.unicode.org/reports/tr35/#Unicode_Language_and_Locale_Identifiers.
@@ -706,6 +879,22 @@ func ParseBase(s string) (Base, error) {
 	return Base{l}, err
 }
 
+// IsDeprecated reports whether b is deprecated in favor of another language,
+// such as "iw", which is replaced by "he".
+func (b Base) IsDeprecated() bool {
+	_, ok := b.ReplacedBy()
+	return ok
+}
+
+// ReplacedBy returns the preferred replacement for b and true if b is
+// deprecated. It returns b and false otherwise.
+func (b Base) ReplacedBy() (Base, bool) {
+	if l := normLang(langOldMap[:], b.langID); l != b.langID {
+		return Base{l}, true
+	}
+	return b, false
+}
+
 // Script is a 4-letter ISO 15924 code for representing scripts.
 // It is idiomatically represented in title case.
 type Script struct {
@@ -724,6 +913,22 @@ func ParseScript(s string) (Script, error) {
 	return Script{sc}, err
 }
 
+// IsDeprecated reports whether s is deprecated in favor of another script,
+// such as "Qaai", which is replaced by "Zinh".
+func (s Script) IsDeprecated() bool {
+	_, ok := s.ReplacedBy()
+	return ok
+}
+
+// ReplacedBy returns the preferred replacement for s and true if s is
+// deprecated. It returns s and false otherwise.
+func (s Script) ReplacedBy() (Script, bool) {
+	if s.scriptID == _Qaai {
+		return Script{_Zinh}, true
+	}
+	return s, false
+}
+
 // Region is an ISO 3166-1 or UN M.49 code for representing countries and regions.
 type Region struct {
 	regionID
@@ -756,12 +961,62 @@ func (r Region) IsCountry() bool {
 	return true
 }
 
+// IsDeprecated reports whether r is deprecated. This includes regions that
+// were split up and have no single replacement, such as "SU" and "YU".
+func (r Region) IsDeprecated() bool {
+	if _, ok := r.ReplacedBy(); ok {
+		return true
+	}
+	i := sort.Search(len(regionDeprecated), func(i int) bool {
+		return regionDeprecated[i] >= uint16(r.regionID)
+	})
+	return i < len(regionDeprecated) && regionDeprecated[i] == uint16(r.regionID)
+}
+
+// ReplacedBy returns the preferred replacement for r and true if r is
+// deprecated and has such a replacement, such as "BU", which is replaced by
+// "MM". It returns r and false otherwise.
+func (r Region) ReplacedBy() (Region, bool) {
+	if rr := normRegion(r.regionID); rr != 0 {
+		return Region{rr}, true
+	}
+	return r, false
+}
+
 // Contains returns whether Region c is contained by Region r. It returns true
 // if c == r.
 func (r Region) Contains(c Region) bool {
 	return r.regionID.contains(c.regionID)
 }
 
+// IsGroup returns whether r is a grouping of regions, such as a continent or
+// a macro-region like 419 (Latin America) or EU, as defined by the CLDR
+// territory containment data.
+func (r Region) IsGroup() bool {
+	return r.regionID != 0 && regionInclusion[r.regionID] < nRegionGroups
+}
+
+// Contained returns the regions contained by r, excluding r itself, in
+// increasing order of their identifiers. It includes both the groups and the
+// countries contained by r, either directly or indirectly. It returns nil if r
+// is not a group.
+func (r Region) Contained() []Region {
+	if !r.IsGroup() {
+		return nil
+	}
+	var a []Region
+	for c := regionID(1); int(c) <= numRegions; c++ {
+		x := Region{c}
+		if c == r.regionID || x.IsPrivateUse() && !x.IsGroup() {
+			continue
+		}
+		if r.regionID.contains(c) {
+			a = append(a, x)
+		}
+	}
+	return a
+}
+
 func (r regionID) contains(c regionID) bool {
 	if r == c {
 		return true
@@ -776,12 +1031,13 @@ func (r regionID) contains(c regionID) bool {
 	b := regionInclusionBits[d]
 
 	// A contained country may belong to multiple disjoint groups. Matching any
-	// of these indicates containment. If the contained region is a group, it
-	// must strictly be a subset.
+	// of these indicates containment. A group may also belong to multiple
+	// groups, so for a contained group we check whether it is in the
+	// transitive closure of r instead.
 	if d >= nRegionGroups {
 		return b&m != 0
 	}
-	return b&^m == 0
+	return m&(1<<d) != 0
 }
 
 // Variant represents a registered variant of a language as defined by BCP 47.
@@ -789,9 +1045,14 @@ type Variant struct {
 	variant string
 }
 
-// ParseVariant parses and returns a Variant. An error is returned if s is not
-// a valid variant.
+// ParseVariant parses and returns a Variant. It returns a ValueError if s is a
+// well-formed variant that is not registered in the IANA language subtag
+// registry or another error if s is not a well-formed variant.
 func ParseVariant(s string) (Variant, error) {
+	n := len(s)
+	if n < 4 || n > 8 || !isAlphaNum([]byte(s)) || n == 4 && isAlpha(s[0]) {
+		return Variant{}, errSyntax
+	}
 	s = strings.ToLower(s)
 	if _, ok := variantIndex[s]; ok {
 		return Variant{s}, nil
@@ -804,6 +1065,19 @@ func (v Variant) String() string {
 	return v.variant
 }
 
+// Prefixes returns the prefixes with which v may be used, as defined in the
+// IANA language subtag registry. For example, the prefix of "rozaj" is "sl",
+// meaning that it should only be used in tags starting with sl, such as
+// sl-rozaj or sl-Latn-rozaj. It returns nil for variants that may be used
+// with any tag, such as "fonipa", and for the zero Variant.
+func (v Variant) Prefixes() []string {
+	i, ok := variantIndex[v.variant]
+	if !ok || variantPrefix[i] == "" {
+		return nil
+	}
+	return strings.Split(variantPrefix[i], " ")
+}
+
 // Currency is an ISO 4217 currency designator.
 type Currency struct {
 	currencyID
@@ -820,3 +1094,138 @@ func ParseCurrency(s string) (Currency, error) {
 	c, err := getCurrencyID(currency, buf[:copy(buf[:], s)])
 	return Currency{c}, err
 }
+
+// Currency returns the currency that is currently legal tender in r. It returns
+// the zero Currency, which prints as XXX, if there is no such currency, for
+// example if r is a group other than EU or a deprecated region.
+func (r Region) Currency() Currency {
+	return Currency{currencyID(regionCurrency[r.regionID])}
+}
+
+// MeasurementSystem identifies a system of measurement as defined by CLDR.
+type MeasurementSystem int
+
+const (
+	MetricSystem MeasurementSystem = iota // the metric system, used by most regions
+	USSystem                              // the system used in the United States
+	UKSystem                              // the mix of metric and imperial units used in the UK
+)
+
+var measurementSystemNames = []string{"metric", "US", "UK"}
+
+// String returns the CLDR name of m: "metric", "US" or "UK".
+func (m MeasurementSystem) String() string {
+	return measurementSystemNames[m]
+}
+
+// MeasurementSystem returns the system of measurement that is commonly used
+// in r. It returns MetricSystem for regions for which no other system is
+// defined, including groups.
+func (r Region) MeasurementSystem() MeasurementSystem {
+	x := uint16(r.regionID)
+	i := sort.Search(len(regionMeasurement), func(i int) bool {
+		return regionMeasurement[i].from >= x
+	})
+	if i < len(regionMeasurement) && regionMeasurement[i].from == x {
+		return MeasurementSystem(regionMeasurement[i].to)
+	}
+	return MetricSystem
+}
+
+// WeekInfo holds the conventions for weeks used in calendars of a region.
+type WeekInfo struct {
+	FirstDay     time.Weekday // the first day of the week
+	WeekendStart time.Weekday // the first day of the weekend
+	WeekendEnd   time.Weekday // the last day of the weekend
+	MinDays      int          // the minimal number of days in the first week of a year
+}
+
+// Week returns the conventions for weeks that are commonly used in r. For
+// regions without specific data, including groups, it returns the values
+// defined for the world: weeks start on Monday, the weekend is Saturday and
+// Sunday and the first week of a year must contain at least one day.
+func (r Region) Week() WeekInfo {
+	x := uint16(r.regionID)
+	v := uint16(1<<9 | time.Sunday<<6 | time.Saturday<<3 | time.Monday)
+	i := sort.Search(len(regionWeek), func(i int) bool {
+		return regionWeek[i].from >= x
+	})
+	if i < len(regionWeek) && regionWeek[i].from == x {
+		v = regionWeek[i].to
+	}
+	return WeekInfo{
+		FirstDay:     time.Weekday(v & 7),
+		WeekendStart: time.Weekday(v >> 3 & 7),
+		WeekendEnd:   time.Weekday(v >> 6 & 7),
+		MinDays:      int(v >> 9),
+	}
+}
+
+// Week returns the conventions for weeks for the region of t, which is
+// inferred as by t.Region if t does not specify one.
+func (t Tag) Week() WeekInfo {
+	r, _ := t.Region()
+	return r.Week()
+}
+
+// dayInWeek returns the number of days from the first day of the week to d.
+func (w WeekInfo) dayInWeek(d time.Weekday) int {
+	return (int(d) - int(w.FirstDay) + 7) % 7
+}
+
+// firstWeek returns the offset, in days from the first of a year or month
+// starting on weekday d, of the start of its first week. The first week is the
+// first week with at least w.MinDays days in the year or month. The offset is
+// negative if this week starts in the preceding year or month.
+func (w WeekInfo) firstWeek(d time.Weekday) int {
+	n := w.dayInWeek(d)
+	if 7-n >= w.MinDays {
+		return -n
+	}
+	return 7 - n
+}
+
+// StartOfWeek returns midnight of the first day of the week containing t,
+// in the location of t.
+func (w WeekInfo) StartOfWeek(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d-w.dayInWeek(t.Weekday()), 0, 0, 0, 0, t.Location())
+}
+
+// Week returns the year and week number in which t occurs. Weeks start on
+// w.FirstDay and week 1 of a year is the first week that has at least
+// w.MinDays days in that year. Days preceding week 1 belong to the last week
+// of the previous year, and days at the end of a year may belong to week 1 of
+// the next year. For the conventions of Germany, which follow ISO 8601, the
+// result equals that of t.ISOWeek. For the United States, Jan 1 is always in
+// week 1.
+func (w WeekInfo) Week(t time.Time) (year, week int) {
+	year = t.Year()
+	day := t.YearDay() - 1
+	start := w.firstWeek(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday())
+	if day < start {
+		return w.Week(time.Date(year, 1, 0, 0, 0, 0, 0, time.UTC))
+	}
+	next := time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	if day >= daysIn(year)+w.firstWeek(next.Weekday()) {
+		return year + 1, 1
+	}
+	return year, (day-start)/7 + 1
+}
+
+// WeekOfMonth returns the week of the month in which t occurs, counting weeks
+// as Week does for years. Days preceding the first week of the month are in
+// week 0.
+func (w WeekInfo) WeekOfMonth(t time.Time) int {
+	y, m, d := t.Date()
+	start := w.firstWeek(time.Date(y, m, 1, 0, 0, 0, 0, time.UTC).Weekday())
+	if d-1 < start {
+		return 0
+	}
+	return (d-1-start)/7 + 1
+}
+
+// daysIn returns the number of days in the given year.
+func daysIn(year int) int {
+	return time.Date(year+1, 1, 0, 0, 0, 0, 0, time.UTC).YearDay()
+}
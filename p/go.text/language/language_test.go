@@ -5,8 +5,13 @@
 package language
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestTagSize(t *testing.T) {
@@ -75,6 +80,95 @@ func TestMakeString(t *testing.T) {
 	}
 }
 
+func TestAppendString(t *testing.T) {
+	buf := make([]byte, 0, max99thPercentileSize)
+	for _, s := range marshalTests {
+		tag := Raw.MustParse(s)
+		if b := tag.AppendString([]byte("x")); string(b) != "x"+s {
+			t.Errorf("%s: was %q; want %q", s, b, "x"+s)
+		}
+		if n := testing.AllocsPerRun(8, func() { tag.AppendString(buf) }); n > 0 {
+			t.Errorf("%s: # allocs got %.1f; want 0", s, n)
+		}
+		if x := Raw.MustParse(tag.String()); x != tag {
+			t.Errorf("%s: String does not round trip: got %#v; want %#v", s, x, tag)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format, tag, out string
+	}{
+		{"%s", "en-US", "en-US"},
+		{"%v", "iw-IL", "iw-IL"},
+		{"%+v", "iw-IL", "he-IL"},
+		{"%+s", "en-Latn-US", "en-US"},
+		{"%+s", "en-US-u-co-phonebk", "en-US-u-co-phonebk"},
+		{"%q", "de-1901", `"de-1901"`},
+		{"%+q", "en-Latn", `"en"`},
+		{"%8s|", "en-US", "   en-US|"},
+		{"%-8s|", "en-US", "en-US   |"},
+		{"%2s", "en-US", "en-US"},
+		{"%#v", "en-US", `language.Raw.MustParse("en-US")`},
+		{"%d", "en", "%!d(language.Tag=en)"},
+	}
+	for _, tt := range tests {
+		if s := fmt.Sprintf(tt.format, Raw.MustParse(tt.tag)); s != tt.out {
+			t.Errorf("%s(%s): was %q; want %q", tt.format, tt.tag, s, tt.out)
+		}
+	}
+}
+
+func TestDeleteExtension(t *testing.T) {
+	tests := []struct {
+		in     string
+		x      byte
+		delete string
+		keep   string
+	}{
+		{"en", 'u', "en", "en"},
+		{"en-US-u-co-phonebk", 'u', "en-US", "en-US-u-co-phonebk"},
+		{"en-US-u-co-phonebk", 'x', "en-US-u-co-phonebk", "en-US"},
+		{"de-1901-a-foo-u-co-phonebk-x-bar", 'a', "de-1901-u-co-phonebk-x-bar", "de-1901-a-foo"},
+		{"de-1901-a-foo-u-co-phonebk-x-bar", 'x', "de-1901-a-foo-u-co-phonebk", "de-1901-x-bar"},
+		{"nl-x-foo-u-co", 'x', "nl", "nl-x-foo-u-co"},
+		{"x-foo", 'x', "und", "x-foo"},
+		{"ja-t-it-m0-bgn", 't', "ja", "ja-t-it-m0-bgn"},
+	}
+	for _, tt := range tests {
+		tag := Raw.MustParse(tt.in)
+		if got, want := tag.DeleteExtension(tt.x), Raw.MustParse(tt.delete); got != want {
+			t.Errorf("%s.DeleteExtension(%c) was %#v; want %#v", tt.in, tt.x, got, want)
+		}
+		if got, want := tag.KeepExtensions(tt.x), Raw.MustParse(tt.keep); got != want {
+			t.Errorf("%s.KeepExtensions(%c) was %#v; want %#v", tt.in, tt.x, got, want)
+		}
+	}
+	tag := Raw.MustParse("de-1901-a-foo-u-co-phonebk-x-bar")
+	if got := tag.KeepExtensions(); got != Raw.MustParse("de-1901") {
+		t.Errorf("KeepExtensions() was %v; want de-1901", got)
+	}
+	if got := tag.KeepExtensions('x', 'u'); got.String() != "de-1901-u-co-phonebk-x-bar" {
+		t.Errorf("KeepExtensions('x', 'u') was %v; want de-1901-u-co-phonebk-x-bar", got)
+	}
+}
+
+func TestUndScriptRegion(t *testing.T) {
+	if tag := UndScript(MustParseScript("Cyrl")); tag != Raw.MustParse("und-Cyrl") {
+		t.Errorf("UndScript was %#v; want und-Cyrl", tag)
+	}
+	if tag := UndRegion(MustParseRegion("CH")); tag != Raw.MustParse("und-CH") {
+		t.Errorf("UndRegion was %#v; want und-CH", tag)
+	}
+	if tag := UndRegion(MustParseRegion("419")); tag.String() != "und-419" {
+		t.Errorf("UndRegion was %v; want und-419", tag)
+	}
+	if !UndScript(Script{}).IsRoot() || !UndRegion(Region{}).IsRoot() {
+		t.Errorf("tags for undefined script or region are not root")
+	}
+}
+
 func TestBase(t *testing.T) {
 	tests := []struct {
 		loc, lang string
@@ -324,6 +418,156 @@ func TestContains(t *testing.T) {
 	}
 }
 
+func TestDeprecated(t *testing.T) {
+	bases := []struct{ in, repl string }{
+		{"iw", "he"},
+		{"in", "id"},
+		{"mo", "ro"},
+		{"ayx", "nun"},
+		{"he", ""},
+		{"en", ""},
+		{"sh", ""},
+	}
+	for _, tt := range bases {
+		b := MustParseBase(tt.in)
+		r, ok := b.ReplacedBy()
+		if want := tt.repl != ""; b.IsDeprecated() != want || ok != want {
+			t.Errorf("%s: IsDeprecated was %v, %v; want %v", tt.in, b.IsDeprecated(), ok, want)
+		}
+		if !ok {
+			tt.repl = tt.in
+		}
+		if r.String() != tt.repl {
+			t.Errorf("%s: ReplacedBy was %s; want %s", tt.in, r, tt.repl)
+		}
+	}
+	scripts := []struct{ in, repl string }{
+		{"Qaai", "Zinh"},
+		{"Zinh", ""},
+		{"Latn", ""},
+	}
+	for _, tt := range scripts {
+		s := MustParseScript(tt.in)
+		r, ok := s.ReplacedBy()
+		if want := tt.repl != ""; s.IsDeprecated() != want || ok != want {
+			t.Errorf("%s: IsDeprecated was %v, %v; want %v", tt.in, s.IsDeprecated(), ok, want)
+		}
+		if !ok {
+			tt.repl = tt.in
+		}
+		if r.String() != tt.repl {
+			t.Errorf("%s: ReplacedBy was %s; want %s", tt.in, r, tt.repl)
+		}
+	}
+	regions := []struct {
+		in, repl   string
+		deprecated bool
+	}{
+		{"BU", "MM", true},
+		{"DD", "DE", true},
+		{"ZR", "CD", true},
+		{"TP", "TL", true},
+		{"SU", "", true},
+		{"YU", "", true},
+		{"CS", "", true},
+		{"AN", "", true},
+		{"NT", "", true},
+		{"US", "", false},
+		{"RS", "", false},
+		{"419", "", false},
+		{"ZZ", "", false},
+	}
+	for _, tt := range regions {
+		r := MustParseRegion(tt.in)
+		if d := r.IsDeprecated(); d != tt.deprecated {
+			t.Errorf("%s: IsDeprecated was %v; want %v", tt.in, d, tt.deprecated)
+		}
+		repl, ok := r.ReplacedBy()
+		if ok != (tt.repl != "") {
+			t.Errorf("%s: ReplacedBy ok was %v; want %v", tt.in, ok, !ok)
+		}
+		if !ok {
+			tt.repl = tt.in
+		}
+		if repl.String() != tt.repl {
+			t.Errorf("%s: ReplacedBy was %s; want %s", tt.in, repl, tt.repl)
+		}
+	}
+	for i := 1; i < len(regionDeprecated); i++ {
+		if regionDeprecated[i-1] >= regionDeprecated[i] {
+			t.Errorf("regionDeprecated not sorted at %d", i)
+		}
+	}
+}
+
+func TestIsGroup(t *testing.T) {
+	tests := []struct {
+		reg   string
+		group bool
+	}{
+		{"001", true},
+		{"419", true},
+		{"150", true},
+		{"EU", true},
+		{"QO", true},
+		{"US", false},
+		{"XK", false},
+		{"AA", false},
+		{"ZZ", false},
+	}
+	for i, tt := range tests {
+		reg, _ := getRegionID([]byte(tt.reg))
+		r := Region{reg}
+		if r.IsGroup() != tt.group {
+			t.Errorf("%d: IsGroup(%s) was %v; want %v", i, tt.reg, r.IsGroup(), tt.group)
+		}
+	}
+}
+
+func TestContained(t *testing.T) {
+	tests := []struct {
+		reg     string
+		in, out []string
+	}{
+		{"419", []string{"005", "013", "029", "AR", "BR", "MX"}, []string{"419", "US", "021", "ES"}},
+		{"EU", []string{"AT", "DE", "FR"}, []string{"EU", "150", "CH", "NO"}},
+		{"001", []string{"002", "419", "EU", "QO", "US", "AQ"}, []string{"001", "AA", "ZZ"}},
+	}
+	for _, tt := range tests {
+		r := MustParseRegion(tt.reg)
+		a := r.Contained()
+		for i, c := range a {
+			if !r.Contains(c) {
+				t.Errorf("%s: Contained included %s, which is not contained", tt.reg, c)
+			}
+			if i > 0 && a[i-1].regionID >= c.regionID {
+				t.Errorf("%s: Contained not sorted at %s", tt.reg, c)
+			}
+		}
+		has := func(s string) bool {
+			for _, c := range a {
+				if c.String() == s {
+					return true
+				}
+			}
+			return false
+		}
+		for _, s := range tt.in {
+			if !has(s) {
+				t.Errorf("%s: Contained did not include %s", tt.reg, s)
+			}
+		}
+		for _, s := range tt.out {
+			if has(s) {
+				t.Errorf("%s: Contained included %s", tt.reg, s)
+			}
+		}
+	}
+	if a := MustParseRegion("US").Contained(); a != nil {
+		t.Errorf("US: Contained was %v; want nil", a)
+	}
+}
+
 func TestParseCurrency(t *testing.T) {
 	tests := []struct {
 		in  string
@@ -345,6 +589,224 @@ func TestParseCurrency(t *testing.T) {
 	}
 }
 
+func TestParseVariant(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+		ok  bool
+	}{
+		{"rozaj", "rozaj", true},
+		{"ROZAJ", "rozaj", true},
+		{"1901", "1901", true},
+		{"fonipa", "fonipa", true},
+		{"abcde", "", false},     // not registered
+		{"1234", "", false},      // not registered
+		{"abcd", "", false},      // ill-formed
+		{"abc", "", false},       // too short
+		{"abcdefghi", "", false}, // too long
+		{"roz-j", "", false},     // ill-formed
+		{"", "", false},
+	}
+	for i, tt := range tests {
+		if v, err := ParseVariant(tt.in); v.String() != tt.out || err == nil != tt.ok {
+			t.Errorf("%d:%s: was %s, %v; want %s, %v", i, tt.in, v, err == nil, tt.out, tt.ok)
+		}
+	}
+}
+
+func TestVariantPrefixes(t *testing.T) {
+	tests := []struct {
+		variant  string
+		prefixes []string
+	}{
+		{"rozaj", []string{"sl"}},
+		{"biske", []string{"sl-rozaj"}},
+		{"1901", []string{"de"}},
+		{"hepburn", []string{"ja-Latn"}},
+		{"heploc", []string{"ja-Latn-hepburn"}},
+		{"pinyin", []string{"zh-Latn", "bo-Latn"}},
+		{"fonipa", nil},
+		{"alalc97", nil},
+	}
+	for _, tt := range tests {
+		if p := MustParseVariant(tt.variant).Prefixes(); !reflect.DeepEqual(p, tt.prefixes) {
+			t.Errorf("%s: Prefixes was %q; want %q", tt.variant, p, tt.prefixes)
+		}
+	}
+	if p := (Variant{}).Prefixes(); p != nil {
+		t.Errorf("zero Variant: Prefixes was %q; want nil", p)
+	}
+	// Verify that all prefixes are well-formed and that exactly the specialized
+	// variants have prefixes.
+	for v, i := range variantIndex {
+		p := MustParseVariant(v).Prefixes()
+		if (int(i) < variantNumSpecialized) != (len(p) > 0) {
+			t.Errorf("%s: specialized is %v, but has %d prefixes", v, int(i) < variantNumSpecialized, len(p))
+		}
+		for _, s := range p {
+			if _, err := Raw.Parse(s); err != nil {
+				t.Errorf("%s: invalid prefix %q: %v", v, s, err)
+			}
+		}
+	}
+}
+
+func TestRegionCurrency(t *testing.T) {
+	tests := []struct{ reg, cur string }{
+		{"US", "USD"},
+		{"DE", "EUR"},
+		{"EU", "EUR"},
+		{"JP", "JPY"},
+		{"CH", "CHF"},
+		{"LI", "CHF"},
+		{"XK", "EUR"},
+		{"001", "XXX"},
+		{"419", "XXX"},
+		{"AQ", "XXX"},
+		{"SU", "XXX"},
+		{"ZZ", "XXX"},
+	}
+	for i, tt := range tests {
+		if c := MustParseRegion(tt.reg).Currency(); c.String() != tt.cur {
+			t.Errorf("%d:%s: Currency was %s; want %s", i, tt.reg, c, tt.cur)
+		}
+	}
+	// Verify that all currencies are valid.
+	for r := 0; r < len(regionCurrency); r++ {
+		if c := regionCurrency[r]; c != 0 {
+			if _, err := ParseCurrency(currencyID(c).String()); err != nil {
+				t.Errorf("%s: invalid currency %d", regionID(r), c)
+			}
+		}
+	}
+}
+
+func TestMeasurementSystem(t *testing.T) {
+	tests := []struct {
+		reg string
+		ms  MeasurementSystem
+	}{
+		{"US", USSystem},
+		{"LR", USSystem},
+		{"MM", USSystem},
+		{"GB", UKSystem},
+		{"DE", MetricSystem},
+		{"CA", MetricSystem},
+		{"001", MetricSystem},
+		{"ZZ", MetricSystem},
+	}
+	for i, tt := range tests {
+		if ms := MustParseRegion(tt.reg).MeasurementSystem(); ms != tt.ms {
+			t.Errorf("%d:%s: MeasurementSystem was %v; want %v", i, tt.reg, ms, tt.ms)
+		}
+	}
+	for i := 1; i < len(regionMeasurement); i++ {
+		if regionMeasurement[i-1].from >= regionMeasurement[i].from {
+			t.Errorf("regionMeasurement not sorted at %d", i)
+		}
+	}
+}
+
+func TestWeek(t *testing.T) {
+	const (
+		sun = time.Sunday
+		mon = time.Monday
+		thu = time.Thursday
+		fri = time.Friday
+		sat = time.Saturday
+	)
+	tests := []struct {
+		reg  string
+		week WeekInfo
+	}{
+		{"001", WeekInfo{mon, sat, sun, 1}},
+		{"ZZ", WeekInfo{mon, sat, sun, 1}},
+		{"150", WeekInfo{mon, sat, sun, 1}},
+		{"US", WeekInfo{sun, sat, sun, 1}},
+		{"DE", WeekInfo{mon, sat, sun, 4}},
+		{"GB", WeekInfo{mon, sat, sun, 4}},
+		{"IE", WeekInfo{sun, sat, sun, 4}},
+		{"BD", WeekInfo{fri, sat, sun, 1}},
+		{"EG", WeekInfo{sat, fri, sat, 1}},
+		{"AF", WeekInfo{sat, thu, fri, 1}},
+		{"IR", WeekInfo{sat, fri, fri, 1}},
+		{"IN", WeekInfo{sun, sun, sun, 1}},
+		{"IL", WeekInfo{sun, fri, sat, 1}},
+	}
+	for i, tt := range tests {
+		if w := MustParseRegion(tt.reg).Week(); w != tt.week {
+			t.Errorf("%d:%s: Week was %v; want %v", i, tt.reg, w, tt.week)
+		}
+	}
+	for i := 1; i < len(regionWeek); i++ {
+		if regionWeek[i-1].from >= regionWeek[i].from {
+			t.Errorf("regionWeek not sorted at %d", i)
+		}
+	}
+	for _, tt := range []struct {
+		tag string
+		day time.Weekday
+	}{
+		{"en", sun},
+		{"en-GB", mon},
+		{"ar", sat},
+		{"ar-MA", sat},
+		{"fr", mon},
+		{"und", sun},
+	} {
+		if d := MustParse(tt.tag).Week().FirstDay; d != tt.day {
+			t.Errorf("%s: FirstDay was %v; want %v", tt.tag, d, tt.day)
+		}
+	}
+}
+
+func TestWeekInfo(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	de := MustParseRegion("DE").Week()
+	us := MustParseRegion("US").Week()
+	eg := MustParseRegion("EG").Week()
+	for d := date(1990, 1, 1); d.Year() < 2030; d = d.AddDate(0, 0, 1) {
+		y, w := de.Week(d)
+		if iy, iw := d.ISOWeek(); y != iy || w != iw {
+			t.Errorf("%s: Week was %d-%d; want ISO week %d-%d", d.Format("2006-01-02"), y, w, iy, iw)
+		}
+	}
+	tests := []struct {
+		week  WeekInfo
+		date  time.Time
+		start time.Time
+		year  int
+		num   int
+		month int
+	}{
+		{de, date(2014, 1, 1), date(2013, 12, 30), 2014, 1, 1},
+		{de, date(2010, 1, 3), date(2009, 12, 28), 2009, 53, 0},
+		{de, date(2014, 3, 1), date(2014, 2, 24), 2014, 9, 0},
+		{de, date(2014, 3, 3), date(2014, 3, 3), 2014, 10, 1},
+		{us, date(2014, 1, 1), date(2013, 12, 29), 2014, 1, 1},
+		{us, date(2013, 12, 29), date(2013, 12, 29), 2014, 1, 5},
+		{us, date(2013, 12, 28), date(2013, 12, 22), 2013, 52, 4},
+		{us, date(2010, 1, 3), date(2010, 1, 3), 2010, 2, 2},
+		{us, date(2014, 3, 1), date(2014, 2, 23), 2014, 9, 1},
+		{us, date(2014, 3, 2), date(2014, 3, 2), 2014, 10, 2},
+		{eg, date(2014, 1, 1), date(2013, 12, 28), 2014, 1, 1},
+		{eg, date(2014, 1, 4), date(2014, 1, 4), 2014, 2, 2},
+	}
+	for i, tt := range tests {
+		if s := tt.week.StartOfWeek(tt.date.Add(13 * time.Hour)); !s.Equal(tt.start) {
+			t.Errorf("%d: StartOfWeek was %v; want %v", i, s, tt.start)
+		}
+		if y, w := tt.week.Week(tt.date); y != tt.year || w != tt.num {
+			t.Errorf("%d: Week was %d-%d; want %d-%d", i, y, w, tt.year, tt.num)
+		}
+		if w := tt.week.WeekOfMonth(tt.date); w != tt.month {
+			t.Errorf("%d: WeekOfMonth was %d; want %d", i, w, tt.month)
+		}
+	}
+}
+
 func TestCanonicalize(t *testing.T) {
 	// TODO: do a full test using CLDR data in a separate regression test.
 	tests := []struct {
@@ -385,6 +847,158 @@ func TestCanonicalize(t *testing.T) {
 	}
 }
 
+func TestTagCanonicalize(t *testing.T) {
+	tests := []struct {
+		in, out string
+		option  CanonType
+		changed bool
+	}{
+		{"en", "en", All, false},
+		{"en-Latn-US", "en-US", All, true},
+		{"en-Latn-US", "en-Latn-US", Raw, false},
+		{"iw-IL", "he-IL", Deprecated, true},
+		{"iw-IL", "iw-IL", Legacy, false},
+		{"tl-PH-x-foo", "fil-PH-x-foo", Legacy, true},
+		{"cmn-Hans-CN-u-co-pinyin", "zh-Hans-CN-u-co-pinyin", Macro, true},
+		{"de-DD-1901", "de-DE-1901", DeprecatedRegion, true},
+		{"x-foo", "x-foo", All, false},
+	}
+	for i, tt := range tests {
+		in := Raw.MustParse(tt.in)
+		out, changed, err := in.Canonicalize(tt.option)
+		if err != nil || out.String() != tt.out || changed != tt.changed {
+			t.Errorf("%d:%s: was %s, %v, %v; want %s, %v, nil", i, tt.in, out, changed, err, tt.out, tt.changed)
+		}
+	}
+}
+
+var marshalTests = []string{
+	"und",
+	"en",
+	"iw",
+	"sh",
+	"en-US",
+	"zh-Hant-TW",
+	"de-1901",
+	"sl-rozaj-biske-1994",
+	"en-US-u-co-phonebk",
+	"nl-x-foo",
+	"x-foo",
+}
+
+func TestMarshalText(t *testing.T) {
+	for _, s := range marshalTests {
+		tag := Raw.MustParse(s)
+		text, err := tag.MarshalText()
+		if err != nil || string(text) != s {
+			t.Errorf("%s: MarshalText was %q, %v; want %q, nil", s, text, err, s)
+		}
+		var got Tag
+		if err := got.UnmarshalText(text); err != nil || got != tag {
+			t.Errorf("%s: UnmarshalText was %#v, %v; want %#v", s, got, err, tag)
+		}
+	}
+	var tag Tag
+	if err := tag.UnmarshalText([]byte("en-$")); err == nil {
+		t.Errorf("UnmarshalText: expected error")
+	}
+
+	// Tags should be usable with encoding/json.
+	in := map[string]Tag{"a": Raw.MustParse("iw-IL"), "b": English}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"a":"iw-IL","b":"en"}`; string(b) != want {
+		t.Errorf("json.Marshal was %s; want %s", b, want)
+	}
+	var out map[string]Tag
+	if err := json.Unmarshal(b, &out); err != nil || !reflect.DeepEqual(out, in) {
+		t.Errorf("json.Unmarshal was %v, %v; want %v", out, err, in)
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	for _, s := range marshalTests {
+		tag := Raw.MustParse(s)
+		data, err := tag.MarshalBinary()
+		if err != nil {
+			t.Errorf("%s: MarshalBinary: %v", s, err)
+		}
+		var got Tag
+		if err := got.UnmarshalBinary(data); err != nil || got != tag {
+			t.Errorf("%s: UnmarshalBinary was %#v, %v; want %#v", s, got, err, tag)
+		}
+	}
+	// The binary form is persisted and must not change.
+	for _, tt := range []struct {
+		tag  Tag
+		data string
+	}{
+		{Und, "\x01und"},
+		{MustParse("en-US"), "\x01en-US"},
+		{Raw.MustParse("iw"), "\x01iw"},
+		{MustParse("de-1901-u-co-phonebk"), "\x01de-1901-u-co-phonebk"},
+	} {
+		if data, _ := tt.tag.MarshalBinary(); string(data) != tt.data {
+			t.Errorf("%s: MarshalBinary was %q; want %q", tt.tag, data, tt.data)
+		}
+	}
+	for _, data := range []string{
+		"",
+		"\x01",
+		"\x00en",
+		"\x02en",
+		"\x01de-$",
+		"en",
+	} {
+		var tag Tag
+		if err := tag.UnmarshalBinary([]byte(data)); err == nil {
+			t.Errorf("%q: expected error", data)
+		}
+	}
+
+	// Tags should be usable with encoding/gob.
+	var buf bytes.Buffer
+	in := []Tag{Raw.MustParse("iw"), MustParse("en-US-u-co-phonebk"), Und}
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out []Tag
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil || !reflect.DeepEqual(out, in) {
+		t.Errorf("gob round trip was %v, %v; want %v", out, err, in)
+	}
+}
+
+func TestExtensions(t *testing.T) {
+	tests := []struct {
+		in  string
+		ext []string
+	}{
+		{"en", nil},
+		{"en-US", nil},
+		{"en-u-co-phonebk", []string{"u-co-phonebk"}},
+		{"de-a-foo-u-co-phonebk-x-bar", []string{"a-foo", "u-co-phonebk", "x-bar"}},
+		{"x-foo-bar", []string{"x-foo-bar"}},
+	}
+	for _, tt := range tests {
+		tag := Make(tt.in)
+		var got []string
+		for _, e := range tag.Extensions() {
+			got = append(got, e.String())
+			if x, ok := tag.Extension(e.Type()); !ok || x != e {
+				t.Errorf("%s: Extension(%q) was %v, %v; want %v, true", tt.in, e.Type(), x, ok, e)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.ext) {
+			t.Errorf("%s: Extensions was %q; want %q", tt.in, got, tt.ext)
+		}
+		if _, ok := tag.Extension('t'); ok {
+			t.Errorf("%s: unexpected -t extension", tt.in)
+		}
+	}
+}
+
 func TestTypeForKey(t *testing.T) {
 	tests := []struct{ key, in, out string }{
 		{"co", "en", ""},
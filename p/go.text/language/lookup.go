@@ -215,6 +215,15 @@ func (b langID) String() string {
 	return l[:2]
 }
 
+// valid reports whether b is an identifier of a known language.
+func (b langID) valid() bool {
+	if b < langNoIndexOffset {
+		return int(b)<<2 < len(lang)
+	}
+	id, err := getLangID([]byte(b.String()))
+	return err == nil && id == b
+}
+
 // ISO3 returns the ISO 639-3 language code.
 func (b langID) ISO3() string {
 	if b == 0 || b >= langNoIndexOffset {
@@ -12,16 +12,12 @@ package main
 import (
 	"bufio"
 	"code.google.com/p/go.text/cldr"
+	"code.google.com/p/go.text/internal/gen"
 	"flag"
 	"fmt"
-	"hash"
-	"hash/fnv"
 	"io"
 	"log"
 	"math"
-	"net/http"
-	"os"
-	"path"
 	"reflect"
 	"sort"
 	"strconv"
@@ -37,8 +33,6 @@ var (
 		"URL of IANA language subtag registry.")
 	test = flag.Bool("test", false,
 		"test existing tables; can be used to compare web data with package data.")
-	localFiles = flag.Bool("local", false,
-		"data files have been copied to the current directory; for debugging only.")
 )
 
 var comment = []string{
@@ -97,6 +91,9 @@ mapped to 2-letter codes using the default algorithm. This is a short list.`,
 altRegionIDs holds a list of regionIDs the positions of which match those
 of the 3-letter ISO codes in altRegionISO3.`,
 	`
+regionDeprecated holds a sorted list of regionIDs of regions that are deprecated
+in the IANA language subtag registry without a preferred replacement.`,
+	`
 variantNumSpecialized is the number of specialized variants in variants.`,
 	`
 currency holds an alphabetically sorted list of canonical 3-letter currency identifiers.
@@ -132,8 +129,27 @@ whether the intelligibility goes one or both ways.`,
 matchScript holds pairs of scriptIDs where readers of one script
 can typically also read the other. Each is associated with a confidence.`,
 	`
+variantPrefix holds, for each variant index, a space-separated list of the
+prefixes with which the variant may be used, as defined in the IANA
+language subtag registry. It is empty for variants that may be used with
+any tag.`,
+	`
 nRegionGroups is the number of region groups.`,
 	`
+regionCurrency maps regionIDs to the currency that is currently legal tender in
+the region.  It is 0 for regions for which there is no such currency.`,
+	`
+regionMeasurement maps regionIDs to the measurement system used in the region,
+as a MeasurementSystem value, for the regions that do not use the metric system.
+It is sorted by regionID.`,
+	`
+regionWeek maps regionIDs to the week conventions used in the region, for
+the regions that differ from the world default. The bits 0-2, 3-5 and 6-8
+hold the first day of the week, the first day of the weekend and the last day
+of the weekend, respectively, as time.Weekday values. The remaining bits hold
+the minimal number of days in the first week of the year. It is sorted by
+regionID.`,
+	`
 regionInclusion maps region identifiers to sets of regions in regionInclusionBits,
 where each set holds all groupings that are directly connected in a region
 containment graph.`,
@@ -318,12 +334,9 @@ type ianaEntry struct {
 }
 
 type builder struct {
-	w      io.Writer   // multi writer
-	out    io.Writer   // set to Stdout
-	hash32 hash.Hash32 // for checking whether tables have changed.
-	size   int
-	data   *cldr.CLDR
-	supp   *cldr.SupplementalData
+	w    *gen.CodeWriter
+	data *cldr.CLDR
+	supp *cldr.SupplementalData
 
 	// indices
 	locale      stringSet // common locales
@@ -343,42 +356,30 @@ type builder struct {
 
 type index uint
 
-func openReader(url *string) io.ReadCloser {
-	if *localFiles {
-		pwd, _ := os.Getwd()
-		*url = "file://" + path.Join(pwd, path.Base(*url))
-	}
-	t := &http.Transport{}
-	t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
-	c := &http.Client{Transport: t}
-	resp, err := c.Get(*url)
+func openReader(url string) io.ReadCloser {
+	r, err := gen.Open(url)
 	failOnError(err)
-	if resp.StatusCode != 200 {
-		log.Fatalf(`bad GET status for "%s": %s`, *url, resp.Status)
-	}
-	return resp.Body
+	return r
 }
 
 func newBuilder() *builder {
-	r := openReader(url)
+	r := openReader(*url)
 	defer r.Close()
 	d := &cldr.Decoder{}
 	d.SetDirFilter("supplemental")
 	data, err := d.DecodeZip(r)
 	failOnError(err)
 	b := builder{
-		out:    os.Stdout,
-		data:   data,
-		supp:   data.Supplemental(),
-		hash32: fnv.New32(),
+		w:    gen.NewCodeWriter(),
+		data: data,
+		supp: data.Supplemental(),
 	}
-	b.w = io.MultiWriter(b.out, b.hash32)
 	b.parseRegistry()
 	return &b
 }
 
 func (b *builder) parseRegistry() {
-	r := openReader(iana)
+	r := openReader(*iana)
 	defer r.Close()
 	b.registry = make(map[string]*ianaEntry)
 
@@ -447,7 +448,7 @@ func init() {
 }
 
 func (b *builder) comment(name string) {
-	fmt.Fprintln(b.out, commentIndex[name])
+	fmt.Fprintln(b.w, commentIndex[name])
 }
 
 func (b *builder) pf(f string, x ...interface{}) {
@@ -460,15 +461,10 @@ func (b *builder) p(x ...interface{}) {
 }
 
 func (b *builder) addSize(s int) {
-	b.size += s
+	b.w.Size += s
 	b.pf("// Size: %d bytes", s)
 }
 
-func (b *builder) addArraySize(s, n int) {
-	b.size += s
-	b.pf("// Size: %d bytes, %d elements", s, n)
-}
-
 func (b *builder) writeConst(name string, x interface{}) {
 	b.comment(name)
 	b.pf("const %s = %v", name, x)
@@ -496,47 +492,8 @@ func (b *builder) writeType(value interface{}) {
 }
 
 func (b *builder) writeSlice(name string, ss interface{}) {
-	b.writeSliceAddSize(name, 0, ss)
-}
-
-func (b *builder) writeSliceAddSize(name string, extraSize int, ss interface{}) {
 	b.comment(name)
-	v := reflect.ValueOf(ss)
-	t := v.Type().Elem()
-	tn := strings.Replace(fmt.Sprintf("%s", t), "main.", "", 1)
-	b.addArraySize(v.Len()*int(t.Size())+extraSize, v.Len())
-	fmt.Fprintf(b.w, `var %s = [%d]%s{`, name, v.Len(), tn)
-	for i := 0; i < v.Len(); i++ {
-		if t.Kind() == reflect.Struct {
-			line := fmt.Sprintf("\n\t%#v, ", v.Index(i).Interface())
-			line = strings.Replace(line, "main.", "", 1)
-			fmt.Fprintf(b.w, line)
-		} else {
-			if i%12 == 0 {
-				fmt.Fprintf(b.w, "\n\t")
-			}
-			fmt.Fprintf(b.w, "%d, ", v.Index(i).Interface())
-		}
-	}
-	b.p("\n}")
-}
-
-// writeStringSlice writes a slice of strings. This produces a lot
-// of overhead. It should typically only be used for debugging.
-// TODO: remove
-func (b *builder) writeStringSlice(name string, ss []string) {
-	b.comment(name)
-	t := reflect.TypeOf(ss).Elem()
-	sz := len(ss) * int(t.Size())
-	for _, s := range ss {
-		sz += len(s)
-	}
-	b.addArraySize(sz, len(ss))
-	b.pf(`var %s = [%d]%s{`, name, len(ss), t)
-	for i := 0; i < len(ss); i++ {
-		b.pf("\t%q,", ss[i])
-	}
-	b.p("}")
+	b.w.WriteArray(name, ss)
 }
 
 type fromTo struct {
@@ -556,34 +513,7 @@ func (b *builder) writeSortedMap(name string, ss *stringSet, index func(s string
 
 func (b *builder) writeString(name, s string) {
 	b.comment(name)
-	b.addSize(len(s) + int(reflect.TypeOf(s).Size()))
-	if len(s) < 40 {
-		b.pf(`var %s string = %q`, name, s)
-		return
-	}
-	const cpl = 60
-	b.pf(`var %s string = "" +`, name)
-	for {
-		n := cpl
-		if n > len(s) {
-			n = len(s)
-		}
-		var q string
-		for {
-			q = strconv.Quote(s[:n])
-			if len(q) <= cpl+2 {
-				break
-			}
-			n--
-		}
-		if n < len(s) {
-			b.pf(`	%s +`, q)
-			s = s[n:]
-		} else {
-			b.pf(`	%s`, q)
-			break
-		}
-	}
+	b.w.WriteVar(name, s)
 }
 
 const base = 'z' - 'a' + 1
@@ -1052,6 +982,19 @@ func (b *builder) writeRegion() {
 	b.writeSortedMap("regionOldMap", &regionOldMap, func(s string) uint16 {
 		return uint16(b.region.index(s))
 	})
+	// Create list of deprecated regions without a replacement.
+	deprecated := []int{}
+	for k, v := range b.registry {
+		if v.typ == "region" && v.deprecated != "" && v.preferred == "" && len(k) == 2 {
+			deprecated = append(deprecated, b.region.index(k))
+		}
+	}
+	sort.Ints(deprecated)
+	regionDeprecated := []uint16{}
+	for _, r := range deprecated {
+		regionDeprecated = append(regionDeprecated, uint16(r))
+	}
+	b.writeSlice("regionDeprecated", regionDeprecated)
 	// 3-digit region lookup, groupings.
 	for i := 1; i < isoOffset; i++ {
 		m := parseM49(b.region.s[i])
@@ -1199,10 +1142,16 @@ func (b *builder) writeVariant() {
 	}
 	b.writeMap("variantIndex", variantIndex)
 	b.writeConst("variantNumSpecialized", numSpecialized)
+
+	variantPrefix := make([]string, len(variantIndex))
+	for v, i := range variantIndex {
+		variantPrefix[i] = strings.Join(b.registry[v].prefix, " ")
+	}
+	b.writeSlice("variantPrefix", variantPrefix)
 }
 
 func (b *builder) writeLocale() {
-	b.writeStringSlice("locale", b.locale.slice())
+	b.writeSlice("locale", b.locale.slice())
 }
 
 func (b *builder) writeLanguageInfo() {
@@ -1211,6 +1160,19 @@ func (b *builder) writeLanguageInfo() {
 func (b *builder) writeCurrencies() {
 	b.writeConsts(b.currency.index, "XTS", "XXX")
 
+	// Select the first currency that is still in use and is legal tender.
+	regionCurrency := make([]uint16, len(b.region.s))
+	for _, reg := range b.supp.CurrencyData.Region {
+		r := b.region.index(reg.Iso3166)
+		for _, cur := range reg.Currency {
+			if cur.To == "" && cur.Tender != "false" {
+				regionCurrency[r] = uint16(b.currency.index(cur.Iso4217))
+				break
+			}
+		}
+	}
+	b.writeSlice("regionCurrency", regionCurrency)
+
 	digits := map[string]uint64{}
 	rounding := map[string]uint64{}
 	for _, info := range b.supp.CurrencyData.Fractions[0].Info {
@@ -1621,7 +1583,6 @@ func (b *builder) writeParents() {
 	parents := []parentRel{}
 
 	// Construct parent overrides.
-	n := 0
 	for _, p := range b.data.Supplemental().ParentLocales.ParentLocale {
 		// Skipping non-standard scripts to root is implemented using addTags.
 		if p.Parent == "root" {
@@ -1645,25 +1606,114 @@ func (b *builder) writeParents() {
 			parent.fromRegion = append(parent.fromRegion, uint16(region))
 		}
 		parents = append(parents, parent)
-		n += len(parent.fromRegion)
 	}
-	b.writeSliceAddSize("parents", n*2, parents)
+	b.writeSlice("parents", parents)
+}
+
+func (b *builder) writeMeasurementData() {
+	m := []fromTo{}
+	for _, ms := range b.supp.MeasurementData.MeasurementSystem {
+		sys, ok := measurementSystems[ms.Type]
+		if !ok {
+			log.Fatalf("unknown measurement system %q", ms.Type)
+		}
+		if sys == 0 {
+			continue // metric is the default.
+		}
+		for _, r := range strings.Split(ms.Territories, " ") {
+			m = append(m, fromTo{uint16(b.region.index(r)), uint16(sys)})
+		}
+	}
+	sort.Sort(fromToSorter(m))
+	b.writeSlice("regionMeasurement", m)
+}
+
+// measurementSystems maps the CLDR names of measurement systems to the values
+// of MeasurementSystem.
+var measurementSystems = map[string]int{"metric": 0, "US": 1, "UK": 2}
+
+// writeWeekData writes the first day of the week, the weekend and the minimal
+// number of days in the first week for the regions for which these differ
+// from the values for the world (001).
+func (b *builder) writeWeekData() {
+	// fields holds, for each territory, the CLDR values for the first day of
+	// the week, the start and end of the weekend and the minimal number of days
+	// in the first week, in that order.
+	fields := map[string]*[4]string{}
+	add := func(i int, territories, value, alt string) {
+		if alt != "" {
+			return
+		}
+		for _, r := range strings.Split(territories, " ") {
+			if fields[r] == nil {
+				fields[r] = &[4]string{}
+			}
+			fields[r][i] = value
+		}
+	}
+	wd := b.supp.WeekData
+	for _, x := range wd.FirstDay {
+		add(0, x.Territories, x.Day, x.Alt)
+	}
+	for _, x := range wd.WeekendStart {
+		add(1, x.Territories, x.Day, x.Alt)
+	}
+	for _, x := range wd.WeekendEnd {
+		add(2, x.Territories, x.Day, x.Alt)
+	}
+	for _, x := range wd.MinDays {
+		add(3, x.Territories, x.Count, x.Alt)
+	}
+	world := fields["001"]
+	encode := func(f *[4]string) uint16 {
+		v := uint16(0)
+		for i, s := range f {
+			if s == "" {
+				s = world[i]
+			}
+			var x uint64
+			if i < 3 {
+				d, ok := weekdays[s]
+				if !ok {
+					log.Fatalf("unknown day %q", s)
+				}
+				x = uint64(d)
+			} else {
+				var err error
+				x, err = strconv.ParseUint(s, 10, 3)
+				failOnError(err)
+			}
+			v |= uint16(x) << (3 * uint(i))
+		}
+		return v
+	}
+	def := encode(world)
+	m := []fromTo{}
+	for r, f := range fields {
+		if v := encode(f); r != "001" && v != def {
+			m = append(m, fromTo{uint16(b.region.index(r)), v})
+		}
+	}
+	sort.Sort(fromToSorter(m))
+	b.writeSlice("regionWeek", m)
 }
 
-var header = `// Generated by running
-//		maketables -url=%s -iana=%s
-// DO NOT EDIT
+// weekdays maps the CLDR names of days to their time.Weekday values.
+var weekdays = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
 
-package language
+type fromToSorter []fromTo
 
-// Version is the version of CLDR used to generate the data in this package.
-const Version = %q
-`
+func (s fromToSorter) Len() int           { return len(s) }
+func (s fromToSorter) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s fromToSorter) Less(i, j int) bool { return s[i].from < s[j].from }
 
 func main() {
-	flag.Parse()
+	gen.Init()
 	b := newBuilder()
-	fmt.Fprintf(b.out, header, *url, *iana, cldr.Version)
+	b.w.WriteComment("Version is the version of CLDR used to generate the data in this package.")
+	b.w.WriteConst("Version", cldr.Version)
 
 	b.parseIndices()
 	b.writeType(fromTo{})
@@ -1678,6 +1728,8 @@ func main() {
 	b.writeMatchData()
 	b.writeRegionInclusionData()
 	b.writeParents()
+	b.writeMeasurementData()
+	b.writeWeekData()
 
-	fmt.Fprintf(b.out, "\n// Size: %.1fK (%d bytes); Check: %X\n", float32(b.size)/1024, b.size, b.hash32.Sum32())
+	failOnError(b.w.WriteGoFile("language"))
 }
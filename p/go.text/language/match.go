@@ -6,6 +6,8 @@ package language
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 // Matcher is the interface that wraps the Match method.
@@ -17,6 +19,16 @@ type Matcher interface {
 	Match(t ...Tag) (tag Tag, index int, c Confidence)
 }
 
+// WeightedMatcher is implemented by Matchers that can take a weight for each
+// tag, such as the quality values returned by ParseAcceptLanguage. The
+// Matchers returned by NewMatcher implement WeightedMatcher.
+//
+// MatchWeighted is like Match, but takes a weight for each tag.
+type WeightedMatcher interface {
+	Matcher
+	MatchWeighted(t []Tag, q []float32) (tag Tag, index int, c Confidence)
+}
+
 // ComprehensibleTo returns the confidence score for speaker being able to
 // comprehend the (written) language t. It uses a Matcher under the hood.
 func (t Tag) ComprehensibleTo(speaker Tag) Confidence {
@@ -25,6 +37,59 @@ func (t Tag) ComprehensibleTo(speaker Tag) Confidence {
 	return c
 }
 
+// Distance returns a measure of how far the written language a is from the
+// language b of a reader. It is 0 if a and b are identical and increases as a
+// becomes a worse match for b. For a given b, ordering candidate tags by
+// increasing distance ranks them in the same way as a Matcher created with
+// NewMatcher without options would. Tags that are not comprehensible to the
+// reader at all, that is, for which ComprehensibleTo returns No, all have the
+// same, largest distance. Apart from this ordering, the magnitude of a
+// distance has no meaning.
+func Distance(a, b Tag) int {
+	have, c := newMatcher([]Tag{a}).getBest(nil, b)
+	if have == nil {
+		return distance(No)
+	}
+	if c == Exact && have.tag.lang == b.lang {
+		return 0
+	}
+	// Recompute the tie-breaker values of the match.
+	max := b
+	if b.lang != 0 {
+		max, _ = b.canonicalize(Legacy | Deprecated)
+	}
+	max, _ = addTags(max)
+	best := bestMatch{}
+	best.update(have, b, max.script, max.region)
+	if best.have == nil {
+		return distance(No)
+	}
+	// The confidence of the match and each of the tie-breaker rules in the
+	// order in which they are applied are assigned a range of bits.
+	d := distance(best.conf)
+	if b.lang != 0 && have.tag.lang != b.lang {
+		d |= 1 << 19
+	}
+	if b.region != 0 && have.tag.region != b.region {
+		d |= 1 << 18
+	}
+	d |= int(best.regDist) << 10
+	if b.script != 0 && have.tag.script != b.script {
+		d |= 1 << 9
+	}
+	if have.tag.region != b.region {
+		d |= int(best.parentDist)
+	}
+	return d
+}
+
+// distance returns the lowest distance for a match with confidence c. An
+// Exact confidence returned by bestMatch.update denotes an exact match of the
+// maximized tags and is therefore ranked behind an exact match of the tags.
+func distance(c Confidence) int {
+	return int(Exact-c+1) << 20
+}
+
 // NewMatcher returns a Matcher that finds the best match for a tag
 // based on written intelligibility. The index returned by the Match
 // method corresponds to the index of the matched tag in the given list.
@@ -37,18 +102,175 @@ func (t Tag) ComprehensibleTo(speaker Tag) Confidence {
 // Various factors such as deprecated variants of tags, legacy mappings
 // and information based on mutual intelligibility defined in CLDR
 // are considered to determine equivalence.
-func NewMatcher(t []Tag) Matcher {
-	return newMatcher(t)
+//
+// The behavior of the Matcher can be adjusted with options, such as
+// MinConfidence and PreferRegion.
+func NewMatcher(t []Tag, options ...MatchOption) Matcher {
+	m := newMatcher(t)
+	for _, o := range options {
+		o(m)
+	}
+	return m
+}
+
+// NewMatcherFromStrings is like NewMatcher, but parses the supported tags from
+// the given strings. Duplicates are removed after canonicalization, keeping
+// the first occurrence. The index returned by the Match method corresponds to
+// the index of the matched string in the given list. Entries that cannot be
+// parsed, or contain unknown subtags, are skipped and reported in an ErrorList.
+// The returned Matcher uses the remaining entries, even if err is not nil.
+func NewMatcherFromStrings(s []string, options ...MatchOption) (m Matcher, err error) {
+	var (
+		tags  []Tag
+		index []int
+		errs  ErrorList
+	)
+outer:
+	for i, str := range s {
+		t, err := Parse(str)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("language: invalid entry %d %q: %v", i, str, err))
+			continue
+		}
+		for _, x := range tags {
+			if x == t {
+				continue outer
+			}
+		}
+		tags = append(tags, t)
+		index = append(index, i)
+	}
+	mm := newMatcher(tags)
+	mm.remapIndex(index)
+	for _, o := range options {
+		o(mm)
+	}
+	if errs != nil {
+		return mm, errs
+	}
+	return mm, nil
+}
+
+// remapIndex replaces the index i of each supported tag with index[i]. The
+// index of the default is set to -1 if there are no supported tags.
+func (m *matcher) remapIndex(index []int) {
+	if len(index) == 0 {
+		m.default_.index = -1
+		return
+	}
+	for _, h := range m.index {
+		for i := range h.exact {
+			h.exact[i].index = index[h.exact[i].index]
+		}
+		for i := range h.max {
+			h.max[i].index = index[h.max[i].index]
+		}
+	}
+}
+
+// A MatchOption configures a Matcher created by NewMatcher.
+type MatchOption func(m *matcher)
+
+// MinConfidence returns a MatchOption that causes matches with a confidence
+// below c to be treated as no match. Instead of falling back to the first
+// supported tag, such a Matcher returns Und, an index of -1 and No if no match
+// reaches c. This allows, for example, a web server to detect that there is no
+// acceptable language and respond accordingly.
+func MinConfidence(c Confidence) MatchOption {
+	return func(m *matcher) {
+		m.minConf = c
+		m.noDefault = true
+	}
+}
+
+// PreferRegion returns a MatchOption that causes a supported tag with the same
+// region as the one explicitly specified in a desired tag to be preferred over
+// other tags of the same language, even if these match the script of the
+// desired tag better. The script of the preferred tag still needs to be
+// intelligible to readers of the desired script.
+func PreferRegion() MatchOption {
+	return func(m *matcher) {
+		m.preferRegion = true
+	}
 }
 
 func (m *matcher) Match(want ...Tag) (Tag, int, Confidence) {
-	match, c := m.getBest(want...)
-	if match == nil {
-		return m.default_.tag, 0, c
+	return m.result(m.getBest(nil, want...))
+}
+
+// result returns the values to be returned by Match for the given match.
+func (m *matcher) result(match *haveTag, c Confidence) (Tag, int, Confidence) {
+	if match == nil || c < m.minConf {
+		if m.noDefault {
+			return Und, -1, No
+		}
+		return m.default_.tag, m.default_.index, No
 	}
 	return match.tag, match.index, c
 }
 
+// MatchWeighted considers the tags in t in order of decreasing weight and, for
+// equal weights, in the given order. A weight of 0 or less marks a tag as not
+// acceptable: a supported tag that equals such a tag or has it as a prefix,
+// such as en-GB for en, is never returned, except as the default value if
+// there is no match. MatchWeighted panics if t and q differ in length.
+func (m *matcher) MatchWeighted(t []Tag, q []float32) (Tag, int, Confidence) {
+	if len(t) != len(q) {
+		panic("language: number of tags and weights differ")
+	}
+	var want, exclude []Tag
+	var w []float32
+	for i, tag := range t {
+		if q[i] <= 0 {
+			exclude = append(exclude, tag)
+		} else {
+			want = append(want, tag)
+			w = append(w, q[i])
+		}
+	}
+	sortStable(&tagSort{want, w})
+	return m.result(m.getBest(exclude, want...))
+}
+
+// MatchAcceptLanguage parses s as the contents of an Accept-Language header
+// and returns the best match in m for the parsed tags and quality values. If m
+// implements WeightedMatcher, it returns the result of calling m.MatchWeighted.
+// Unlike with ParseAcceptLanguage, tags with a quality value of 0 are passed
+// on, so that they are excluded from matching. Otherwise, it calls m.Match
+// with the tags ordered by quality value, omitting those with a value of 0.
+// The index is -1 if s cannot be parsed.
+func MatchAcceptLanguage(m Matcher, s string) (tag Tag, index int, c Confidence, err error) {
+	if wm, ok := m.(WeightedMatcher); ok {
+		t, q, err := parseAcceptLanguage(s, true)
+		if err != nil {
+			return Und, -1, No, err
+		}
+		tag, index, c = wm.MatchWeighted(t, q)
+		return tag, index, c, nil
+	}
+	t, _, err := ParseAcceptLanguage(s)
+	if err != nil {
+		return Und, -1, No, err
+	}
+	tag, index, c = m.Match(t...)
+	return tag, index, c, nil
+}
+
+// excluded reports whether have is excluded by any of the tags in exclude,
+// which is the case if a tag equals have or is a prefix of it.
+func excluded(have Tag, exclude []Tag) bool {
+	if len(exclude) == 0 {
+		return false
+	}
+	hs := have.String()
+	for _, x := range exclude {
+		if xs := x.String(); hs == xs || strings.HasPrefix(hs, xs+"-") {
+			return true
+		}
+	}
+	return false
+}
+
 type scriptRegionFlags uint8
 
 const (
@@ -94,6 +316,24 @@ func (t Tag) addLikelySubtags() (Tag, error) {
 	return id, nil
 }
 
+// Maximize returns t with its undefined script and region subtags set to their
+// most likely values, as defined by the CLDR likely subtags data, and with a
+// region group replaced by the most likely region, if applicable. For example,
+// it returns zh-Hans-CN for zh and en-Latn-US for en. Private use tags are
+// returned unchanged. It returns ErrMissingLikelyTagsData, along with t, if
+// t cannot be expanded.
+func (t Tag) Maximize() (Tag, error) {
+	return t.addLikelySubtags()
+}
+
+// Minimize returns the shortest tag that maximizes to the same tag as t, by
+// removing the script and region subtags that are implied by the others. For
+// example, it returns zh for zh-Hans-CN and zh-TW for zh-Hant-TW. Variants and
+// extensions are preserved.
+func (t Tag) Minimize() (Tag, error) {
+	return t.minimize()
+}
+
 // specializeRegion attempts to specialize a group region.
 func specializeRegion(t *Tag) bool {
 	if i := regionInclusion[t.region]; i < nRegionGroups {
@@ -380,6 +620,11 @@ func minimizeTags(t Tag) (Tag, error) {
 type matcher struct {
 	default_ *haveTag
 	index    map[langID]*matchHeader
+
+	// Options set by MatchOptions.
+	minConf      Confidence
+	noDefault    bool
+	preferRegion bool
 }
 
 // matchHeader has the lists of tags for exact matches and matches based on
@@ -557,9 +802,10 @@ func newMatcher(supported []Tag) *matcher {
 }
 
 // getBest gets the best matching tag in m for any of the given tags, taking into
-// account the order of preference of the given tags.
-func (m *matcher) getBest(want ...Tag) (*haveTag, Confidence) {
-	best := bestMatch{}
+// account the order of preference of the given tags. Supported tags that are
+// excluded by the tags in exclude are skipped.
+func (m *matcher) getBest(exclude []Tag, want ...Tag) (*haveTag, Confidence) {
+	best := bestMatch{preferRegion: m.preferRegion}
 	for _, w := range want {
 		var max Tag
 		// Check for exact match first.
@@ -571,7 +817,7 @@ func (m *matcher) getBest(want ...Tag) (*haveTag, Confidence) {
 			}
 			for i := range h.exact {
 				have := &h.exact[i]
-				if have.tag.equalsRest(w) {
+				if have.tag.equalsRest(w) && !excluded(have.tag, exclude) {
 					return have, Exact
 				}
 			}
@@ -582,7 +828,7 @@ func (m *matcher) getBest(want ...Tag) (*haveTag, Confidence) {
 			if h != nil {
 				for i := range h.exact {
 					have := &h.exact[i]
-					if have.tag.equalsRest(w) {
+					if have.tag.equalsRest(w) && !excluded(have.tag, exclude) {
 						return have, Exact
 					}
 				}
@@ -600,11 +846,16 @@ func (m *matcher) getBest(want ...Tag) (*haveTag, Confidence) {
 		// Check for match based on maximized tag.
 		for i := range h.max {
 			have := &h.max[i]
+			if excluded(have.tag, exclude) {
+				continue
+			}
 			best.update(have, w, max.script, max.region)
 			if best.conf == Exact {
 				for have.nextMax != 0 {
 					have = &h.max[have.nextMax]
-					best.update(have, w, max.script, max.region)
+					if !excluded(have.tag, exclude) {
+						best.update(have, w, max.script, max.region)
+					}
 				}
 				return best.have, High
 			}
@@ -626,6 +877,10 @@ type bestMatch struct {
 	regDist    uint8
 	origScript bool
 	parentDist uint8 // 255 if have is not an ancestor of want tag.
+
+	// preferRegion is set if a match of an explicitly specified region should
+	// take precedence over the confidence of the match.
+	preferRegion bool
 }
 
 // update updates the existing best match if the new pair is considered to be a
@@ -636,16 +891,22 @@ type bestMatch struct {
 // a series of tie-breaker rules. If there is no conclusive winner after applying
 // the tie-breaker rules, it leaves the current match as the preferred match.
 func (m *bestMatch) update(have *haveTag, tag Tag, maxScript scriptID, maxRegion regionID) {
+	// If regions take precedence, a match of an explicitly specified region
+	// beats a current best match that does not have one, whatever its
+	// confidence.
+	origReg := have.tag.region == tag.region && tag.region != 0
+	regionWins := m.preferRegion && origReg && !m.origReg
+
 	// Bail if the maximum attainable confidence is below that of the current best match.
 	c := have.conf
-	if c < m.conf {
+	if c < m.conf && !regionWins {
 		return
 	}
 	if have.maxScript != maxScript {
 		// There is usually very little comprehension between different scripts.
 		// In a few cases there may still be Low comprehension. This possibility is
 		// pre-computed and stored in have.altScript.
-		if Low < m.conf || have.altScript != maxScript {
+		if Low < m.conf && !regionWins || have.altScript != maxScript {
 			return
 		}
 		c = Low
@@ -662,7 +923,12 @@ func (m *bestMatch) update(have *haveTag, tag Tag, maxScript scriptID, maxRegion
 	// we have a winner, but we do still need to do the tie-breaker computations.
 	// We use "beaten" to keep track if we still need to do the checks.
 	beaten := false // true if the new pair defeats the current one.
-	if c != m.conf {
+	if m.preferRegion && m.origReg != origReg {
+		if m.origReg {
+			return
+		}
+		beaten = true
+	} else if c != m.conf {
 		if c < m.conf {
 			return
 		}
@@ -680,7 +946,6 @@ func (m *bestMatch) update(have *haveTag, tag Tag, maxScript scriptID, maxRegion
 	}
 
 	// We prefer if the pre-maximized region was specified and identical.
-	origReg := have.tag.region == tag.region && tag.region != 0
 	if !beaten && m.origReg != origReg {
 		if m.origReg {
 			return
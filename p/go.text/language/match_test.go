@@ -262,7 +262,7 @@ func TestBestMatch(t *testing.T) {
 		}
 		for _, tm := range tt.test {
 			desired := parse(tm.desired)
-			id, conf := m.getBest(desired...)
+			id, conf := m.getBest(nil, desired...)
 			tag := supported[0]
 			if id != nil {
 				tag = id.tag
@@ -274,6 +274,59 @@ func TestBestMatch(t *testing.T) {
 	}
 }
 
+func TestDistance(t *testing.T) {
+	for i, tt := range matchTests {
+		supported := mkList(tt.supported)
+		for _, tm := range tt.test {
+			if strings.Contains(tm.desired, ",") {
+				continue
+			}
+			desired := mk(tm.desired)
+			best, min := supported[0], distance(No)
+			for _, s := range supported {
+				if d := Distance(s, desired); d < min {
+					best, min = s, d
+				}
+			}
+			if best.String() != tm.match {
+				t.Errorf("%d:%s: closest to %s in %q was %s; want %s", i, tt.comment, desired, tt.supported, best, tm.match)
+			}
+		}
+	}
+	tests := []struct {
+		a, b string
+		d    int
+	}{
+		{"en", "en", 0},
+		{"en-US-u-co-phonebk", "en-US-u-co-phonebk", 0},
+		{"und", "und", 0},
+		{"ja", "en", distance(No)},
+		{"da", "nn", distance(No)},
+		{"en", "und", distance(No)},
+	}
+	for _, tt := range tests {
+		if d := Distance(mk(tt.a), mk(tt.b)); d != tt.d {
+			t.Errorf("Distance(%s, %s) was %x; want %x", tt.a, tt.b, d, tt.d)
+		}
+	}
+	// Each group lists tags in order of increasing distance to the first.
+	groups := [][]string{
+		{"en-AU", "en-AU", "en-GB", "en", "en-US", "fr"},
+		{"sr", "sr", "sr-Cyrl-RS", "sr-Latn", "hr"},
+		{"nn", "nn", "nb", "da"},
+		{"he", "he", "iw", "ar"},
+		{"es-MX", "es-MX", "es-419", "es", "es-ES", "pt"},
+	}
+	for _, g := range groups {
+		b := mk(g[0])
+		for i := 2; i < len(g); i++ {
+			if p, q := Distance(mk(g[i-1]), b), Distance(mk(g[i]), b); p >= q {
+				t.Errorf("%s: Distance(%s) = %x; want < Distance(%s) = %x", b, g[i-1], p, g[i], q)
+			}
+		}
+	}
+}
+
 var benchHave = []Tag{
 	mk("en"),
 	mk("en-GB"),
@@ -339,11 +392,163 @@ var benchWant = [][]Tag{
 	},
 }
 
+// mkList returns the tags of a comma-separated list.
+func mkList(list string) (out []Tag) {
+	for _, s := range strings.Split(list, ",") {
+		out = append(out, mk(strings.TrimSpace(s)))
+	}
+	return out
+}
+
+func TestMatchWeighted(t *testing.T) {
+	m := NewMatcher(mkList("en, en-GB, fr, de, nl")).(WeightedMatcher)
+	tests := []struct {
+		desired string
+		q       []float32
+		match   string
+	}{
+		{"fr, de", []float32{1, 1}, "fr"},
+		{"fr, de", []float32{0.5, 1}, "de"},
+		{"fr, de, nl", []float32{0.2, 0.8, 0.8}, "de"},
+		{"en-GB, fr", []float32{1, 0.5}, "en-GB"},
+		// en-GB is not acceptable, but en is.
+		{"en-GB, en, fr", []float32{0, 0.5, 1}, "fr"},
+		{"en-GB, en-AU", []float32{0, 1}, "en"},
+		// en excludes all English variants.
+		{"en, en-AU, de", []float32{0, 1, 0.1}, "de"},
+		{"fr", []float32{0}, "en"},
+	}
+	for i, tt := range tests {
+		tag, _, _ := m.MatchWeighted(mkList(tt.desired), tt.q)
+		if tag.String() != tt.match {
+			t.Errorf("%d:%s %v: was %s; want %s", i, tt.desired, tt.q, tag, tt.match)
+		}
+	}
+}
+
+func TestMatchAcceptLanguage(t *testing.T) {
+	m := NewMatcher(mkList("en, en-GB, fr, de"))
+	tests := []struct {
+		header string
+		match  string
+		err    bool
+	}{
+		{"fr;q=0.5, de", "de", false},
+		{"en-GB;q=0, en-US, fr;q=0.9", "en", false},
+		{"en;q=0, fr;q=0.1", "fr", false},
+		{"de;q=0", "en", false},
+		{"en-GB;q=x", "en", true},
+	}
+	for i, tt := range tests {
+		tag, index, _, err := MatchAcceptLanguage(m, tt.header)
+		if (err != nil) != tt.err {
+			t.Errorf("%d:%s: error was %v; want error %v", i, tt.header, err, tt.err)
+		}
+		if tt.err && index != -1 {
+			t.Errorf("%d:%s: index was %d; want -1", i, tt.header, index)
+		}
+		if tag.String() != tt.match && !tt.err {
+			t.Errorf("%d:%s: was %s; want %s", i, tt.header, tag, tt.match)
+		}
+	}
+	// Matchers that do not implement WeightedMatcher are passed the tags
+	// in order of quality, without those with a quality value of 0.
+	tag, _, _, err := MatchAcceptLanguage(plainMatcher{m}, "en-GB;q=0, fr;q=0.5, de")
+	if err != nil || tag.String() != "de" {
+		t.Errorf("plain matcher: was %s, %v; want de", tag, err)
+	}
+}
+
+// plainMatcher hides the MatchWeighted method of a Matcher.
+type plainMatcher struct {
+	m Matcher
+}
+
+func (p plainMatcher) Match(t ...Tag) (Tag, int, Confidence) {
+	return p.m.Match(t...)
+}
+
+func TestNewMatcherFromStrings(t *testing.T) {
+	supported := []string{"en", "x-", "iw", "he", "de-DD", "de-DE", "xx", "fr"}
+	m, err := NewMatcherFromStrings(supported)
+	if errs, ok := err.(ErrorList); !ok || len(errs) != 2 {
+		t.Errorf("error was %v; want ErrorList of 2 errors", err)
+	}
+	tests := []struct {
+		desired string
+		match   string
+		index   int
+		conf    Confidence
+	}{
+		{"en", "en", 0, Exact},
+		{"he", "he", 2, Exact},
+		{"iw", "he", 2, Exact},
+		{"de", "de-DE", 4, High},
+		{"fr", "fr", 7, Exact},
+		{"ja", "en", 0, No},
+	}
+	for i, tt := range tests {
+		tag, index, conf := m.Match(Make(tt.desired))
+		if tag.String() != tt.match || index != tt.index || conf != tt.conf {
+			t.Errorf("%d:%s: was %s, %d, %v; want %s, %d, %v", i, tt.desired, tag, index, conf, tt.match, tt.index, tt.conf)
+		}
+	}
+
+	m, err = NewMatcherFromStrings([]string{"x-", "nl", "fr"}, MinConfidence(High))
+	if err == nil {
+		t.Errorf("error was nil; want error")
+	}
+	if tag, index, conf := m.Match(Make("nl-BE")); tag != Make("nl") || index != 1 || conf != High {
+		t.Errorf("was %s, %d, %v; want nl, 1, High", tag, index, conf)
+	}
+	if tag, index, conf := m.Match(Make("de")); tag != Und || index != -1 || conf != No {
+		t.Errorf("was %s, %d, %v; want und, -1, No", tag, index, conf)
+	}
+
+	m, err = NewMatcherFromStrings([]string{"x-"})
+	if err == nil {
+		t.Errorf("error was nil; want error")
+	}
+	if tag, index, conf := m.Match(Make("en")); tag != Und || index != -1 || conf != No {
+		t.Errorf("was %s, %d, %v; want und, -1, No", tag, index, conf)
+	}
+}
+
+func TestMatchOptions(t *testing.T) {
+	tests := []struct {
+		supported string
+		options   []MatchOption
+		desired   string
+		match     string
+		index     int
+		conf      Confidence
+	}{
+		{"en, fr", nil, "de", "en", 0, No},
+		{"en, fr", []MatchOption{MinConfidence(Low)}, "de", "und", -1, No},
+		{"en, fr", []MatchOption{MinConfidence(High)}, "en-US", "en", 0, High},
+		{"en, fr", []MatchOption{MinConfidence(Exact)}, "en-US", "und", -1, No},
+		{"en, fr", []MatchOption{MinConfidence(Exact)}, "fr", "fr", 1, Exact},
+		{"en, sr-Cyrl-ME, sr-Latn-RS", nil, "sr-ME", "sr-Latn-RS", 2, High},
+		{"en, sr-Cyrl-ME, sr-Latn-RS", []MatchOption{PreferRegion()}, "sr-ME", "sr-Cyrl-ME", 1, Low},
+		{"en, zh-Hans-TW, zh-Hant-HK", nil, "zh-TW", "zh-Hant-HK", 2, High},
+		{"en, zh-Hans-TW, zh-Hant-HK", []MatchOption{PreferRegion()}, "zh-TW", "zh-Hans-TW", 1, Low},
+		{"en, zh-Hans-TW, zh-Hant-HK", []MatchOption{PreferRegion(), MinConfidence(High)}, "zh-TW", "und", -1, No},
+		{"en, pt-BR, pt-PT", []MatchOption{PreferRegion()}, "pt-AO", "pt-PT", 2, High},
+	}
+	for i, tt := range tests {
+		m := NewMatcher(mkList(tt.supported), tt.options...)
+		tag, index, conf := m.Match(Make(tt.desired))
+		if tag.String() != tt.match || index != tt.index || conf != tt.conf {
+			t.Errorf("%d:%s in %q: was %s, %d, %v; want %s, %d, %v", i, tt.desired, tt.supported, tag, index, conf, tt.match, tt.index, tt.conf)
+		}
+	}
+}
+
 func BenchmarkMatch(b *testing.B) {
 	m := newMatcher(benchHave)
 	for i := 0; i < b.N; i++ {
 		for _, want := range benchWant {
-			m.getBest(want...)
+			m.getBest(nil, want...)
 		}
 	}
 }
@@ -352,7 +557,7 @@ func BenchmarkMatchExact(b *testing.B) {
 	want := mk("en")
 	m := newMatcher(benchHave)
 	for i := 0; i < b.N; i++ {
-		m.getBest(want)
+		m.getBest(nil, want)
 	}
 }
 
@@ -360,7 +565,7 @@ func BenchmarkMatchAltLanguagePresent(b *testing.B) {
 	want := mk("hr")
 	m := newMatcher(benchHave)
 	for i := 0; i < b.N; i++ {
-		m.getBest(want)
+		m.getBest(nil, want)
 	}
 }
 
@@ -368,7 +573,7 @@ func BenchmarkMatchAltLanguageNotPresent(b *testing.B) {
 	want := mk("nn")
 	m := newMatcher(benchHave)
 	for i := 0; i < b.N; i++ {
-		m.getBest(want)
+		m.getBest(nil, want)
 	}
 }
 
@@ -376,7 +581,7 @@ func BenchmarkMatchAltScriptPresent(b *testing.B) {
 	want := mk("zh-Hant-CN")
 	m := newMatcher(benchHave)
 	for i := 0; i < b.N; i++ {
-		m.getBest(want)
+		m.getBest(nil, want)
 	}
 }
 
@@ -384,7 +589,7 @@ func BenchmarkMatchAltScriptNotPresent(b *testing.B) {
 	want := mk("fr-Cyrl")
 	m := newMatcher(benchHave)
 	for i := 0; i < b.N; i++ {
-		m.getBest(want)
+		m.getBest(nil, want)
 	}
 }
 
@@ -392,6 +597,6 @@ func BenchmarkMatchLimitedExact(b *testing.B) {
 	want := []Tag{mk("he-NL"), mk("iw-NL")}
 	m := newMatcher(benchHave)
 	for i := 0; i < b.N; i++ {
-		m.getBest(want...)
+		m.getBest(nil, want...)
 	}
 }
@@ -76,6 +76,10 @@ type scanner struct {
 	next  int // next point for scan
 	err   error
 	done  bool
+
+	// errs collects all errors encountered if all is set.
+	errs []error
+	all  bool
 }
 
 func makeScannerString(s string) scanner {
@@ -117,6 +121,9 @@ func (s *scanner) toLower(start, end int) {
 }
 
 func (s *scanner) setError(e error) {
+	if s.all && e != nil {
+		s.errs = appendError(s.errs, e)
+	}
 	if s.err == nil || (e == errSyntax && s.err != errSyntax) {
 		s.err = e
 	}
@@ -237,6 +244,12 @@ func Parse(s string) (t Tag, err error) {
 // http://www.unicode.org/reports/tr35/#Unicode_Language_and_Locale_Identifiers.
 // The resulting tag is canonicalized using the the canonicalization type c.
 func (c CanonType) Parse(s string) (t Tag, err error) {
+	return c.parse(s, false)
+}
+
+// parse implements Parse. If all is true, the returned error, if any, is an
+// ErrorList holding all errors encountered.
+func (c CanonType) parse(s string, all bool) (t Tag, err error) {
 	// TODO: consider supporting old-style locale key-value pairs.
 	if s == "" {
 		return und, errSyntax
@@ -247,6 +260,7 @@ func (c CanonType) Parse(s string) (t Tag, err error) {
 		return
 	}
 	scan := makeScannerString(s)
+	scan.all = all
 	if len(scan.token) >= 4 {
 		if !strings.EqualFold(s, "root") {
 			return und, errSyntax
@@ -258,6 +272,9 @@ func (c CanonType) Parse(s string) (t Tag, err error) {
 	if changed {
 		t.remakeString()
 	}
+	if all && err != nil {
+		err = ErrorList(appendError(scan.errs, err))
+	}
 	return t, err
 }
 
@@ -273,7 +290,7 @@ func parse(scan *scanner, s string) (t Tag, err error) {
 	} else if n >= 4 {
 		return und, errSyntax
 	} else { // the usual case
-		t, end = parseTag(scan)
+		t, end = parseTag(scan, false)
 		if n := len(scan.token); n == 1 {
 			t.pExt = uint16(end)
 			end = parseExtensions(scan)
@@ -299,7 +316,9 @@ func parse(scan *scanner, s string) (t Tag, err error) {
 
 // parseTag parses language, script, region and variants.
 // It returns a Tag and the end position in the input that was parsed.
-func parseTag(scan *scanner) (t Tag, end int) {
+// If tSource is set, the tag is the source tag of a transformed content
+// extension.
+func parseTag(scan *scanner, tSource bool) (t Tag, end int) {
 	var e error
 	// TODO: set an error if an unknown lang, script or region is encountered.
 	t.lang, e = getLangID(scan.token)
@@ -327,7 +346,9 @@ func parseTag(scan *scanner) (t Tag, end int) {
 		}
 		end = scan.scan()
 	}
-	if n := len(scan.token); n >= 2 && n <= 3 {
+	// In the source tag of a transformed content extension, a 2-letter token
+	// with a digit is not a region, but the key of the field that follows.
+	if n := len(scan.token); n >= 2 && n <= 3 && (n == 3 || !tSource || isAlpha(scan.token[1])) {
 		t.region, e = getRegionID(scan.token)
 		if t.region == 0 {
 			scan.gobble(e)
@@ -539,7 +560,7 @@ func parseExtension(scan *scanner) int {
 	case 't':
 		scan.scan()
 		if n := len(scan.token); n >= 2 && n <= 3 && isAlpha(scan.token[1]) {
-			_, end = parseTag(scan)
+			_, end = parseTag(scan, true)
 			scan.toLower(start, end)
 		}
 		for len(scan.token) == 2 && !isAlpha(scan.token[1]) {
@@ -769,6 +790,12 @@ var (
 // Tags with a weight of zero will be dropped. An error will be returned if the
 // input could not be parsed.
 func ParseAcceptLanguage(s string) (tag []Tag, q []float32, err error) {
+	return parseAcceptLanguage(s, false)
+}
+
+// parseAcceptLanguage implements ParseAcceptLanguage. Tags with a weight of
+// zero are dropped unless keepZero is true.
+func parseAcceptLanguage(s string, keepZero bool) (tag []Tag, q []float32, err error) {
 	for start, end := 0, 0; start < len(s); start = end + 1 {
 		for end = start; end < len(s) && s[end] != ','; end++ {
 		}
@@ -783,7 +810,7 @@ func ParseAcceptLanguage(s string) (tag []Tag, q []float32, err error) {
 					return nil, nil, err
 				}
 				// Drop tags with a quality weight of 0.
-				if w <= 0 {
+				if w <= 0 && !keepZero {
 					continue
 				}
 			}
@@ -830,3 +857,346 @@ func (s *tagSort) Swap(i, j int) {
 	s.tag[i], s.tag[j] = s.tag[j], s.tag[i]
 	s.q[i], s.q[j] = s.q[j], s.q[i]
 }
+
+// An ErrorList is returned by the parsing methods of Limits and holds all
+// errors encountered during parsing.
+type ErrorList []error
+
+// Error implements the error interface.
+func (e ErrorList) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// appendError appends err to list if it is not already in it.
+func appendError(list []error, err error) []error {
+	for _, e := range list {
+		if e == err {
+			return list
+		}
+	}
+	return append(list, err)
+}
+
+// SubtagKind identifies the part of a tag in which a subtag occurs.
+type SubtagKind int
+
+const (
+	BaseSubtag      SubtagKind = iota // language and extended language subtags
+	ScriptSubtag                      // script subtags
+	RegionSubtag                      // region subtags
+	VariantSubtag                     // variant subtags
+	ExtensionSubtag                   // extension and private use subtags
+)
+
+var subtagKindName = []string{"base", "script", "region", "variant", "extension"}
+
+func (k SubtagKind) String() string {
+	return subtagKindName[k]
+}
+
+// A SubtagError is returned by ParseStrict for each subtag that is ill-formed
+// or unknown.
+type SubtagError struct {
+	Pos    int        // byte offset of the subtag in the input
+	Kind   SubtagKind // the part of the tag in which the subtag occurs
+	Subtag string     // the subtag as it occurs in the input
+	Err    error      // a ValueError if the subtag is unknown
+}
+
+// Error implements the error interface.
+func (e *SubtagError) Error() string {
+	reason := "ill-formed"
+	if _, ok := e.Err.(ValueError); ok {
+		reason = "unknown"
+	}
+	return fmt.Sprintf("language: %s %s subtag %q at offset %d", reason, e.Kind, e.Subtag, e.Pos)
+}
+
+// ParseStrict is like Parse, but if s is not a valid tag, the returned error
+// is an ErrorList holding a *SubtagError for each ill-formed or unknown subtag
+// of s. This makes it suitable for validating user-provided tags, as all
+// problems can be reported at once. As with Parse, the returned Tag holds
+// any part of the tag that could be parsed.
+func ParseStrict(s string) (Tag, error) {
+	t, err := Parse(s)
+	if _, ok := tagAlias[s]; ok || strings.EqualFold(s, "root") {
+		// Grandfathered tags do not consist of regular subtags.
+		return t, err
+	}
+	// Parse accepts some tags that are not valid, such as tags with a
+	// duplicate variant or extension, so the subtags are always checked.
+	if errs := checkSubtags(s); len(errs) > 0 {
+		return t, errs
+	}
+	if err != nil {
+		return t, ErrorList{err}
+	}
+	return t, nil
+}
+
+// checkSubtags returns a *SubtagError for each subtag of s that does not fit
+// the BCP 47 grammar or is not a known value.
+func checkSubtags(s string) (errs ErrorList) {
+	add := func(pos int, k SubtagKind, sub string, err error) {
+		errs = append(errs, &SubtagError{pos, k, sub, err})
+	}
+	if s == "" {
+		add(0, BaseSubtag, "", errSyntax)
+		return errs
+	}
+	// Split s into subtags, recording their positions.
+	var subtags []string
+	var pos []int
+	for start, i := 0, 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == '-' || s[i] == '_' {
+			subtags = append(subtags, s[start:i])
+			pos = append(pos, start)
+			start = i + 1
+		}
+	}
+	i := 0
+	wellFormed := func(lo, hi int) bool {
+		n := len(subtags[i])
+		return lo <= n && n <= hi && isAlphaNum([]byte(subtags[i]))
+	}
+	isAlphaToken := func() bool {
+		for _, c := range []byte(subtags[i]) {
+			if !('a' <= c|0x20 && c|0x20 <= 'z') {
+				return false
+			}
+		}
+		return true
+	}
+	if len(subtags[0]) == 1 {
+		// A tag may only start with a private use extension.
+		if subtags[0] != "x" && subtags[0] != "X" {
+			add(pos[0], BaseSubtag, subtags[0], errSyntax)
+		}
+	} else {
+		// Language and extended language subtags.
+		if n := len(subtags[0]); (n == 2 || n == 3) && isAlphaToken() {
+			if _, err := getLangID([]byte(strings.ToLower(subtags[0]))); err != nil {
+				add(pos[0], BaseSubtag, subtags[0], err)
+			}
+		} else {
+			// The remaining subtags cannot be classified reliably.
+			add(pos[0], BaseSubtag, subtags[0], errSyntax)
+			return errs
+		}
+		for i = 1; i < len(subtags) && i <= 3 && len(subtags[i]) == 3 && isAlphaToken(); i++ {
+			if _, err := getLangID([]byte(strings.ToLower(subtags[i]))); err != nil {
+				add(pos[i], BaseSubtag, subtags[i], err)
+			}
+		}
+		if i < len(subtags) && len(subtags[i]) == 4 && isAlphaToken() {
+			if _, err := getScriptID(script, []byte(subtags[i])); err != nil {
+				add(pos[i], ScriptSubtag, subtags[i], err)
+			}
+			i++
+		}
+		if i < len(subtags) && wellFormed(2, 3) {
+			sub := strings.ToUpper(subtags[i])
+			if _, err := getRegionID([]byte(sub)); err != nil {
+				add(pos[i], RegionSubtag, subtags[i], err)
+			}
+			i++
+		}
+		variants := map[string]bool{}
+		for ; i < len(subtags) && len(subtags[i]) != 1; i++ {
+			v := strings.ToLower(subtags[i])
+			if _, err := ParseVariant(subtags[i]); err != nil {
+				add(pos[i], VariantSubtag, subtags[i], err)
+			} else if variants[v] {
+				add(pos[i], VariantSubtag, subtags[i], errSyntax)
+			}
+			variants[v] = true
+		}
+	}
+	// Extensions and private use subtags.
+	seen := map[byte]bool{}
+	for i < len(subtags) {
+		single := subtags[i]
+		private := single == "x" || single == "X"
+		end := i + 1
+		for end < len(subtags) && (private || len(subtags[end]) != 1) {
+			end++
+		}
+		// An extension may occur only once and needs at least one subtag.
+		// A first subtag other than x has already been reported above.
+		if (i > 0 || private) && (!wellFormed(1, 1) || seen[single[0]|0x20] || end == i+1) {
+			add(pos[i], ExtensionSubtag, single, errSyntax)
+		}
+		seen[single[0]|0x20] = true
+		for i++; i < end; i++ {
+			if private && !wellFormed(1, 8) || !private && !wellFormed(2, 8) {
+				add(pos[i], ExtensionSubtag, subtags[i], errSyntax)
+			}
+		}
+	}
+	return errs
+}
+
+var (
+	errTagTooLong      = errors.New("language: tag too long")
+	errTooManySubtags  = errors.New("language: tag has too many subtags")
+	errHeaderTooLong   = errors.New("language: Accept-Language value too long")
+	errTooManyLanguage = errors.New("language: Accept-Language value has too many entries")
+)
+
+// Limits defines a parsing mode suitable for untrusted input, such as HTTP
+// headers. The parsing methods of Limits reject input that exceeds the given
+// limits before doing any further work, so that the time spent parsing is
+// linear in the size of the input, and report all errors encountered as an
+// ErrorList, instead of only the first. A limit of zero or less selects the
+// corresponding limit of DefaultLimits. Canon is not a limit: its zero value
+// is Raw, so set it explicitly to obtain the canonicalization of DefaultLimits.
+type Limits struct {
+	// MaxTagLen is the maximum length of a tag or extension in bytes.
+	MaxTagLen int
+
+	// MaxSubtags is the maximum number of subtags of a tag or extension.
+	MaxSubtags int
+
+	// MaxLen is the maximum length of an Accept-Language value in bytes.
+	MaxLen int
+
+	// MaxEntries is the maximum number of entries, including the ones with
+	// a quality weight of zero, of an Accept-Language value.
+	MaxEntries int
+
+	// Canon is the canonicalization applied to parsed tags. The zero value,
+	// Raw, applies none.
+	Canon CanonType
+}
+
+// DefaultLimits are limits that accept all tags in common use.
+var DefaultLimits = Limits{
+	MaxTagLen:  128,
+	MaxSubtags: 24,
+	MaxLen:     4096,
+	MaxEntries: 32,
+	Canon:      Default,
+}
+
+func (l *Limits) init() {
+	if l.MaxTagLen <= 0 {
+		l.MaxTagLen = DefaultLimits.MaxTagLen
+	}
+	if l.MaxSubtags <= 0 {
+		l.MaxSubtags = DefaultLimits.MaxSubtags
+	}
+	if l.MaxLen <= 0 {
+		l.MaxLen = DefaultLimits.MaxLen
+	}
+	if l.MaxEntries <= 0 {
+		l.MaxEntries = DefaultLimits.MaxEntries
+	}
+}
+
+// check verifies that s does not exceed the limits for a single tag.
+func (l *Limits) check(s string) error {
+	if len(s) > l.MaxTagLen {
+		return errTagTooLong
+	}
+	if strings.Count(s, "-")+strings.Count(s, "_") >= l.MaxSubtags {
+		return errTooManySubtags
+	}
+	return nil
+}
+
+// Parse is like Parse, but enforces the limits of l and returns an ErrorList
+// on error. As with Parse, the returned Tag holds any part of the tag that
+// could be parsed, unless a limit was exceeded.
+func (l Limits) Parse(s string) (t Tag, err error) {
+	l.init()
+	if err := l.check(s); err != nil {
+		return und, ErrorList{err}
+	}
+	t, err = l.Canon.parse(s, true)
+	if _, ok := err.(ErrorList); err != nil && !ok {
+		err = ErrorList{err}
+	}
+	return t, err
+}
+
+// ParseExtension is like ParseExtension, but enforces the limits of l and
+// returns an ErrorList on error.
+func (l Limits) ParseExtension(s string) (e Extension, err error) {
+	l.init()
+	if err := l.check(s); err != nil {
+		return Extension{}, ErrorList{err}
+	}
+	scan := makeScannerString(s)
+	scan.all = true
+	if len(scan.token) != 1 {
+		return Extension{}, ErrorList{errSyntax}
+	}
+	scan.toLower(0, len(scan.b))
+	end := parseExtension(&scan)
+	if end != len(s) || end < 3 || (s[0] != 'x' && end < 4) {
+		scan.setError(errSyntax)
+	}
+	if len(scan.errs) > 0 {
+		return Extension{}, ErrorList(scan.errs)
+	}
+	return Extension{string(scan.b)}, nil
+}
+
+// ParseAcceptLanguage is like ParseAcceptLanguage, but enforces the limits
+// of l. Instead of failing on the first malformed entry, it skips such entries
+// and returns the tags of all other entries together with an ErrorList
+// holding the errors for the skipped ones. No tags are returned if the value
+// as a whole exceeds a limit.
+func (l Limits) ParseAcceptLanguage(s string) (tag []Tag, q []float32, err error) {
+	l.init()
+	if len(s) > l.MaxLen {
+		return nil, nil, ErrorList{errHeaderTooLong}
+	}
+	if strings.Count(s, ",") >= l.MaxEntries {
+		return nil, nil, ErrorList{errTooManyLanguage}
+	}
+	var errs ErrorList
+	for _, entry := range strings.Split(s, ",") {
+		m := acceptRe.FindStringSubmatch(entry)
+		if m == nil {
+			errs = appendError(errs, errSyntax)
+			continue
+		}
+		if len(m[1]) == 0 {
+			continue
+		}
+		w := 1.0
+		if len(m[2]) > 0 {
+			var err error
+			if w, err = strconv.ParseFloat(m[2], 32); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if w <= 0 {
+				continue
+			}
+		}
+		t, err := l.Parse(m[1])
+		if err != nil {
+			id, ok := acceptFallback[m[1]]
+			if !ok {
+				for _, e := range err.(ErrorList) {
+					errs = appendError(errs, e)
+				}
+				continue
+			}
+			t = Tag{lang: id}
+		}
+		tag = append(tag, t)
+		q = append(q, float32(w))
+	}
+	sortStable(&tagSort{tag, q})
+	if len(errs) > 0 {
+		return tag, q, errs
+	}
+	return tag, q, nil
+}
@@ -6,6 +6,8 @@ package language
 
 import (
 	"bytes"
+	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -296,7 +298,7 @@ func TestParseTag(t *testing.T) {
 			return Tag{}, true
 		}
 		scan := makeScannerString(tt.in)
-		id, end := parseTag(&scan)
+		id, end := parseTag(&scan, false)
 		id.str = string(scan.b[:end])
 		tt.ext = ""
 		tt.extList = []string{}
@@ -332,6 +334,21 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestParseTSource(t *testing.T) {
+	// Only in the source tag of a transformed content extension is a 2-letter
+	// token with a digit not taken to be a region.
+	tests := []struct{ in, out string }{
+		{"en-a1-x-foo", "en-x-foo"},
+		{"en-t-nl-t0-abc", "en-t-nl-t0-abc"},
+		{"en-t-nl-be-t0-abc", "en-t-nl-be-t0-abc"},
+	}
+	for _, tt := range tests {
+		if tag, _ := Raw.Parse(tt.in); tag.String() != tt.out {
+			t.Errorf("%s: was %s; want %s", tt.in, tag, tt.out)
+		}
+	}
+}
+
 func TestErrors(t *testing.T) {
 	mkInvalid := func(s string) error {
 		return mkErrInvalid([]byte(s))
@@ -513,3 +530,135 @@ func TestParseAcceptLanguage(t *testing.T) {
 		}
 	}
 }
+
+func TestLimits(t *testing.T) {
+	mkInvalid := func(s string) error {
+		return mkErrInvalid([]byte(s))
+	}
+	small := Limits{MaxTagLen: 12, MaxSubtags: 3}
+	tests := []struct {
+		l    Limits
+		in   string
+		out  string
+		errs ErrorList
+	}{
+		{DefaultLimits, "en-US", "en-US", nil},
+		{Limits{}, "en-US", "en-US", nil},
+		{DefaultLimits, "iw", "he", nil},
+		{Limits{}, "iw", "iw", nil},
+		{DefaultLimits, "", "und", ErrorList{errSyntax}},
+		{DefaultLimits, "ac-Uuuu-AB", "und", ErrorList{mkInvalid("ac"), mkInvalid("Uuuu"), mkInvalid("AB")}},
+		{DefaultLimits, "ac-u-ca", "und", ErrorList{mkInvalid("ac"), errSyntax}},
+		{small, "en-Latn-US", "en-Latn-US", nil},
+		{small, "en-Latn-US-x-a", "und", ErrorList{errTagTooLong}},
+		{small, "en-Latn-US-x", "und", ErrorList{errTooManySubtags}},
+		{DefaultLimits, "en" + strings.Repeat("-abcdefgh", 15), "und", ErrorList{errTagTooLong}},
+	}
+	for _, tt := range tests {
+		tag, err := tt.l.Parse(tt.in)
+		if tag.String() != tt.out {
+			t.Errorf("%q: tag was %s; want %s", tt.in, tag, tt.out)
+		}
+		if tt.errs == nil {
+			if err != nil {
+				t.Errorf("%q: unexpected error %v", tt.in, err)
+			}
+		} else if errs, ok := err.(ErrorList); !ok || !reflect.DeepEqual(errs, tt.errs) {
+			t.Errorf("%q: error was %#v; want %#v", tt.in, err, tt.errs)
+		}
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		in   string
+		errs string // kind@pos:subtag, with a * marking unknown subtags
+	}{
+		{"en-US", ""},
+		{"i-klingon", ""},
+		{"x-foo", ""},
+		{"", "base@0:"},
+		{"ac-Uuuu-AB", "base@0:ac* script@3:Uuuu* region@8:AB*"},
+		{"en-Uuuu-US-abcde", "script@3:Uuuu* variant@11:abcde*"},
+		{"en-US-ab-rozaj", "variant@6:ab"},
+		{"en_Latn_US_1902", "variant@11:1902*"},
+		{"abcd-US", "base@0:abcd"},
+		{"en-u", "extension@3:u"},
+		{"en-US-a-u-co-phonebk", "extension@6:a"},
+		{"en-u-co-a", "extension@8:a"},
+		{"en-x-abcdefghi", "extension@5:abcdefghi"},
+		{"u-co-phonebk", "base@0:u"},
+		{"e", "base@0:e"},
+		{"x", "extension@0:x"},
+		{"en-a-bc-a-de", "extension@8:a"},
+		{"de-1901-1901", "variant@8:1901"},
+		{"toolongsubtag-en", "base@0:toolongsubtag"},
+	}
+	for _, tt := range tests {
+		_, err := ParseStrict(tt.in)
+		var got []string
+		if err != nil {
+			errs, ok := err.(ErrorList)
+			if !ok {
+				t.Errorf("%q: error %#v is not an ErrorList", tt.in, err)
+				continue
+			}
+			for _, e := range errs {
+				se, ok := e.(*SubtagError)
+				if !ok {
+					t.Errorf("%q: error %#v is not a *SubtagError", tt.in, e)
+					continue
+				}
+				s := fmt.Sprintf("%v@%d:%s", se.Kind, se.Pos, se.Subtag)
+				if _, ok := se.Err.(ValueError); ok {
+					s += "*"
+				}
+				got = append(got, s)
+			}
+		}
+		if s := strings.Join(got, " "); s != tt.errs {
+			t.Errorf("%q: errors were %q; want %q", tt.in, s, tt.errs)
+		}
+	}
+	_, err := ParseStrict("en-Uuuu")
+	if want := `language: unknown script subtag "Uuuu" at offset 3`; err == nil || err.Error() != want {
+		t.Errorf("error was %v; want %s", err, want)
+	}
+}
+
+func TestLimitsExtension(t *testing.T) {
+	if e, err := DefaultLimits.ParseExtension("u-co-phonebk"); err != nil || e.String() != "u-co-phonebk" {
+		t.Errorf("got %v, %v; want u-co-phonebk, nil", e, err)
+	}
+	for _, s := range []string{"", "u", "uu-co", "u-co-$", "u-co-phonebk" + strings.Repeat("-ca", 30)} {
+		if _, err := DefaultLimits.ParseExtension(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		} else if _, ok := err.(ErrorList); !ok {
+			t.Errorf("%q: error %#v is not an ErrorList", s, err)
+		}
+	}
+}
+
+func TestLimitsAcceptLanguage(t *testing.T) {
+	tags, q, err := DefaultLimits.ParseAcceptLanguage("en;q=0.5, ac, de, $, nl;q=x, aa-AB")
+	if want := "[de en]"; fmt.Sprint(tags) != want {
+		t.Errorf("tags were %v; want %s", tags, want)
+	}
+	if want := "[1 0.5]"; fmt.Sprint(q) != want {
+		t.Errorf("weights were %v; want %s", q, want)
+	}
+	errs, ok := err.(ErrorList)
+	if !ok || len(errs) != 3 {
+		t.Errorf("got error %v; want 3 errors", err)
+	}
+
+	l := Limits{MaxLen: 10, MaxEntries: 2}
+	for _, s := range []string{"en,de,fr", "en-US,de-DE"} {
+		if tags, _, err := l.ParseAcceptLanguage(s); err == nil || tags != nil {
+			t.Errorf("%q: got %v, %v; want error", s, tags, err)
+		}
+	}
+	if tags, _, err := l.ParseAcceptLanguage("en,de"); err != nil || len(tags) != 2 {
+		t.Errorf("got %v, %v; want 2 tags", tags, err)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is maintained by hand. It holds the tables that maketables
+// generates, transcribed from plurals.xml and ordinals.xml of CLDR 25 as CLDR
+// could not be downloaded. "make tables" writes the generated tables to
+// tables.go and removes this file.
+
+package plural
+
+// Version is the version of CLDR from which the data in this package was taken.
+const Version = "25"
+
+// cardinalData holds the cardinal plural rules. Each entry lists the locales to
+// which the rules apply and the conditions, in CLDR syntax, for each plural
+// form other than "other", separated by semicolons.
+var cardinalData = [35]ruleSet{
+	{locales: "bm bo dz id ig ii in ja jbo jv jw kde kea km ko lkt lo ms my nqo root sah ses sg th to vi wo yo zh", rules: ""},
+	{locales: "am bn fa gu hi kn mr zu", rules: "one: i = 0 or n = 1"},
+	{locales: "ff fr hy kab", rules: "one: i = 0,1"},
+	{locales: "ast ca de en et fi fy gl it ji nl sv sw ur yi", rules: "one: i = 1 and v = 0"},
+	{locales: "si", rules: "one: n = 0,1 or i = 0 and f = 1"},
+	{locales: "ak bh guw ln mg nso pa ti wa", rules: "one: n = 0..1"},
+	{locales: "tzm", rules: "one: n = 0..1 or n = 11..99"},
+	{locales: "pt", rules: "one: n = 0..2 and n != 2"},
+	{locales: "af asa az bem bez bg brx cgg chr ckb dv ee el eo es eu fo fur gsw ha haw hu jgo jmc ka kaj kcg kk kkj kl ks ksb ku ky lb lg mas mgo ml mn nah nb nd ne nn nnh no nr ny nyn om or os pap ps rm rof rwk saq seh sn so sq ss ssy st syr ta te teo tig tk tn tr ts uz ve vo vun wae xh xog", rules: "one: n = 1"},
+	{locales: "pt-PT", rules: "one: n = 1 and v = 0"},
+	{locales: "da", rules: "one: n = 1 or t != 0 and i = 0,1"},
+	{locales: "is", rules: "one: t = 0 and i % 10 = 1 and i % 100 != 11 or t != 0"},
+	{locales: "mk", rules: "one: v = 0 and i % 10 = 1 or f % 10 = 1"},
+	{locales: "fil tl", rules: "one: v = 0 and i = 1,2,3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9"},
+	{locales: "lv", rules: "zero: n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19; one: n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1"},
+	{locales: "lag", rules: "zero: n = 0; one: i = 0,1 and n != 0"},
+	{locales: "ksh", rules: "zero: n = 0; one: n = 1"},
+	{locales: "iu kw naq se sma smi smj smn sms", rules: "one: n = 1; two: n = 2"},
+	{locales: "shi", rules: "one: i = 0 or n = 1; few: n = 2..10"},
+	{locales: "mo ro", rules: "one: i = 1 and v = 0; few: v != 0 or n = 0 or n != 1 and n % 100 = 1..19"},
+	{locales: "bs hr sh sr", rules: "one: v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11; few: v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14"},
+	{locales: "gd", rules: "one: n = 1,11; two: n = 2,12; few: n = 3..10,13..19"},
+	{locales: "sl", rules: "one: v = 0 and i % 100 = 1; two: v = 0 and i % 100 = 2; few: v = 0 and i % 100 = 3..4 or v != 0"},
+	{locales: "he iw", rules: "one: i = 1 and v = 0; two: i = 2 and v = 0; many: v = 0 and n != 0..10 and n % 10 = 0"},
+	{locales: "cs sk", rules: "one: i = 1 and v = 0; few: i = 2..4 and v = 0; many: v != 0"},
+	{locales: "pl", rules: "one: i = 1 and v = 0; few: v = 0 and i % 10 = 2..4 and i % 100 != 12..14; many: v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14"},
+	{locales: "be", rules: "one: n % 10 = 1 and n % 100 != 11; few: n % 10 = 2..4 and n % 100 != 12..14; many: n % 10 = 0 or n % 10 = 5..9 or n % 100 = 11..14"},
+	{locales: "lt", rules: "one: n % 10 = 1 and n % 100 != 11..19; few: n % 10 = 2..9 and n % 100 != 11..19; many: f != 0"},
+	{locales: "mt", rules: "one: n = 1; few: n = 0 or n % 100 = 2..10; many: n % 100 = 11..19"},
+	{locales: "ru uk", rules: "one: v = 0 and i % 10 = 1 and i % 100 != 11; few: v = 0 and i % 10 = 2..4 and i % 100 != 12..14; many: v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14"},
+	{locales: "br", rules: "one: n % 10 = 1 and n % 100 != 11,71,91; two: n % 10 = 2 and n % 100 != 12,72,92; few: n % 10 = 3..4,9 and n % 100 != 10..19,70..79,90..99; many: n != 0 and n % 1000000 = 0"},
+	{locales: "ga", rules: "one: n = 1; two: n = 2; few: n = 3..6; many: n = 7..10"},
+	{locales: "gv", rules: "one: v = 0 and i % 10 = 1; two: v = 0 and i % 10 = 2; few: v = 0 and i % 100 = 0,20,40,60,80; many: v != 0"},
+	{locales: "ar", rules: "zero: n = 0; one: n = 1; two: n = 2; few: n % 100 = 3..10; many: n % 100 = 11..99"},
+	{locales: "cy", rules: "zero: n = 0; one: n = 1; two: n = 2; few: n = 3; many: n = 6"},
+}
+
+// ordinalData holds the ordinal plural rules. Each entry lists the locales to
+// which the rules apply and the conditions, in CLDR syntax, for each plural
+// form other than "other", separated by semicolons.
+var ordinalData = [18]ruleSet{
+	{locales: "af am ar bg bs cs da de el es et eu fa fi fy gl he hr id in is iw ja km kn ko ky lt lv ml mn my nb nl pa pl pt root ru sh si sk sl sr sw ta te th tr ur uz zh zu", rules: ""},
+	{locales: "sv", rules: "one: n % 10 = 1,2 and n % 100 != 11,12"},
+	{locales: "fil fr hy lo mo ms ro tl vi", rules: "one: n = 1"},
+	{locales: "hu", rules: "one: n = 1,5"},
+	{locales: "ne", rules: "one: n = 1..4"},
+	{locales: "uk", rules: "few: n % 10 = 3 and n % 100 != 13"},
+	{locales: "kk", rules: "many: n % 10 = 6 or n % 10 = 9 or n % 10 = 0 and n != 0"},
+	{locales: "it", rules: "many: n = 11,8,80,800"},
+	{locales: "ka", rules: "one: i = 1; many: i = 0 or i % 100 = 2..20,40,60,80"},
+	{locales: "sq", rules: "one: n = 1; many: n % 10 = 4 and n % 100 != 14"},
+	{locales: "en", rules: "one: n % 10 = 1 and n % 100 != 11; two: n % 10 = 2 and n % 100 != 12; few: n % 10 = 3 and n % 100 != 13"},
+	{locales: "mr", rules: "one: n = 1; two: n = 2,3; few: n = 4"},
+	{locales: "ca", rules: "one: n = 1,3; two: n = 2; few: n = 4"},
+	{locales: "mk", rules: "one: i % 10 = 1 and i % 100 != 11; two: i % 10 = 2 and i % 100 != 12; many: i % 10 = 7,8 and i % 100 != 17,18"},
+	{locales: "az", rules: "one: i % 10 = 1,2,5,7,8 or i % 100 = 20,50,70,80; few: i % 10 = 3,4 or i % 1000 = 100,200,300,400,500,600,700,800,900; many: i = 0 or i % 10 = 6 or i % 100 = 40,60,90"},
+	{locales: "gu hi", rules: "one: n = 1; two: n = 2,3; few: n = 4; many: n = 6"},
+	{locales: "as bn", rules: "one: n = 1,5,7,8,9,10; two: n = 2,3; few: n = 4; many: n = 6"},
+	{locales: "cy", rules: "zero: n = 0,7,8,9; one: n = 1; two: n = 2; few: n = 3,4; many: n = 5,6"},
+}
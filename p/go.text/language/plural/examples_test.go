@@ -0,0 +1,46 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plural_test
+
+import (
+	"fmt"
+
+	"code.google.com/p/go.text/language"
+	"code.google.com/p/go.text/language/plural"
+)
+
+func ExampleRules_Select() {
+	r := plural.Cardinal(language.Russian)
+	for _, s := range []string{"1", "3", "5", "21", "1.5"} {
+		o, _ := plural.ParseOperands(s)
+		fmt.Println(s, r.Select(o))
+	}
+	// Output:
+	// 1 one
+	// 3 few
+	// 5 many
+	// 21 one
+	// 1.5 other
+}
+
+func ExampleOrdinal() {
+	r := plural.Ordinal(language.English)
+	suffix := map[plural.Form]string{
+		plural.One:   "st",
+		plural.Two:   "nd",
+		plural.Few:   "rd",
+		plural.Other: "th",
+	}
+	for _, n := range []int{1, 2, 3, 4, 11, 22} {
+		fmt.Printf("%d%s\n", n, suffix[r.SelectInt(n)])
+	}
+	// Output:
+	// 1st
+	// 2nd
+	// 3rd
+	// 4th
+	// 11th
+	// 22nd
+}
@@ -0,0 +1,85 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// Generator for plural rule tables.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"code.google.com/p/go.text/cldr"
+	"code.google.com/p/go.text/internal/gen"
+)
+
+var url = flag.String("cldr",
+	"http://www.unicode.org/Public/cldr/"+cldr.Version+"/core.zip",
+	"URL of CLDR archive.")
+
+// ruleSet must be kept in sync with the definition in plural.go.
+type ruleSet struct {
+	locales string
+	rules   string
+}
+
+func main() {
+	gen.Init()
+
+	r, err := gen.Open(*url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	d := &cldr.Decoder{}
+	d.SetDirFilter("supplemental")
+	data, err := d.DecodeZip(r)
+	if err != nil {
+		log.Fatalf("DecodeZip: %v", err)
+	}
+	supp := data.Supplemental()
+
+	w := gen.NewCodeWriter()
+	w.WriteComment("Version is the version of CLDR used to generate the data in this package.")
+	w.WriteConst("Version", cldr.Version)
+	fmt.Fprintln(w)
+
+	for _, typ := range []string{"cardinal", "ordinal"} {
+		sets := []ruleSet{}
+		for _, p := range supp.Plurals {
+			if t := p.Type; t != typ && !(t == "" && typ == "cardinal") {
+				continue
+			}
+			for _, pr := range p.PluralRules {
+				rules := []string{}
+				for _, x := range pr.PluralRule {
+					rule, err := cldr.ParsePluralRule(x.Count, x.Data())
+					if err != nil {
+						log.Fatal(err)
+					}
+					if rule.Condition != "" {
+						rules = append(rules, rule.Category+": "+rule.Condition)
+					}
+				}
+				locales := strings.Replace(strings.Join(strings.Fields(pr.Locales), " "), "_", "-", -1)
+				sets = append(sets, ruleSet{locales, strings.Join(rules, "; ")})
+			}
+		}
+		w.WriteComment(`
+%[1]sData holds the %[1]s plural rules. Each entry lists the locales to
+which the rules apply and the conditions, in CLDR syntax, for each plural
+form other than "other", separated by semicolons.`, typ)
+		w.WriteArray(typ+"Data", sets)
+		fmt.Fprintln(w)
+	}
+
+	if err := w.WriteGoFile("plural"); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package plural implements the CLDR plural rules, which determine the plural
+// form to use for a number in a given language, such as "one" for 1 book and
+// "other" for 2 books in English.
+//
+// See http://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules
+// for details.
+package plural
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"code.google.com/p/go.text/dataversion"
+	"code.google.com/p/go.text/language"
+)
+
+// Form is a plural form, also called plural category.
+type Form byte
+
+const (
+	Other Form = iota
+	Zero
+	One
+	Two
+	Few
+	Many
+)
+
+var formNames = []string{"other", "zero", "one", "two", "few", "many"}
+
+func (f Form) String() string {
+	if int(f) < len(formNames) {
+		return formNames[f]
+	}
+	return "Form(" + strconv.Itoa(int(f)) + ")"
+}
+
+// Operands holds the operands of a number to which the plural rules are
+// applied. The number of visible fraction digits affects the result for some
+// languages: in English, for example, "1 star" uses a different form than
+// "1.0 stars".
+type Operands struct {
+	N float64 // absolute value of the number
+	I int64   // integer digits of N
+	V int     // number of visible fraction digits of N, with trailing zeros
+	W int     // number of visible fraction digits of N, without trailing zeros
+	F int64   // visible fraction digits of N, with trailing zeros
+	T int64   // visible fraction digits of N, without trailing zeros
+}
+
+// IntOperands returns the operands for the integer n.
+func IntOperands(n int) Operands {
+	if n < 0 {
+		n = -n
+	}
+	return Operands{N: float64(n), I: int64(n)}
+}
+
+var errSyntax = errors.New("plural: invalid decimal number")
+
+// ParseOperands returns the operands for the decimal number s, such as "1",
+// "-3.50" or "0.1". All digits after the decimal point are taken to be
+// visible.
+func ParseOperands(s string) (o Operands, err error) {
+	s = strings.TrimPrefix(s, "-")
+	intPart, frac := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		intPart, frac = s[:i], s[i+1:]
+		if frac == "" {
+			return Operands{}, errSyntax
+		}
+	}
+	if intPart == "" || strings.IndexFunc(s, notDigitOrPoint) >= 0 {
+		return Operands{}, errSyntax
+	}
+	if o.I, err = strconv.ParseInt(intPart, 10, 64); err != nil {
+		return Operands{}, errSyntax
+	}
+	if o.N, err = strconv.ParseFloat(s, 64); err != nil {
+		return Operands{}, errSyntax
+	}
+	if frac != "" {
+		t := strings.TrimRight(frac, "0")
+		o.V, o.W = len(frac), len(t)
+		if o.F, err = strconv.ParseInt(frac, 10, 64); err != nil {
+			return Operands{}, errSyntax
+		}
+		if t != "" {
+			o.T, _ = strconv.ParseInt(t, 10, 64)
+		}
+	}
+	return o, nil
+}
+
+func notDigitOrPoint(r rune) bool {
+	return (r < '0' || '9' < r) && r != '.'
+}
+
+// Rules holds the plural rules of a language for either cardinal numbers,
+// such as "3 books", or ordinal numbers, such as "the 3rd book".
+type Rules struct {
+	forms []Form
+	conds []condition // conds[i] is the condition for forms[i]
+}
+
+// Forms returns the plural forms used by r, ending with Other.
+func (r *Rules) Forms() []Form {
+	return append(append([]Form(nil), r.forms...), Other)
+}
+
+// Select returns the plural form for the number with the given operands.
+func (r *Rules) Select(o Operands) Form {
+	for i, c := range r.conds {
+		if c.match(&o) {
+			return r.forms[i]
+		}
+	}
+	return Other
+}
+
+// SelectInt returns the plural form for the integer n.
+func (r *Rules) SelectInt(n int) Form {
+	return r.Select(IntOperands(n))
+}
+
+// ruleSet holds the rules for a set of locales as generated by maketables.
+type ruleSet struct {
+	locales string
+	rules   string
+}
+
+func init() {
+	dataversion.Register(dataversion.Info{
+		Package: "code.google.com/p/go.text/language/plural",
+		CLDR:    Version,
+	})
+}
+
+var (
+	cardinal = makeRules(cardinalData[:])
+	ordinal  = makeRules(ordinalData[:])
+)
+
+// makeRules compiles the given rule sets and returns a map from locale to
+// rules.
+func makeRules(sets []ruleSet) map[string]*Rules {
+	m := make(map[string]*Rules)
+	for _, s := range sets {
+		r := &Rules{}
+		for _, rule := range strings.Split(s.rules, ";") {
+			if rule = strings.TrimSpace(rule); rule == "" {
+				continue
+			}
+			i := strings.Index(rule, ":")
+			if i < 0 {
+				panic("plural: invalid rule " + rule)
+			}
+			f := formByName(rule[:i])
+			c, err := parseCondition(rule[i+1:])
+			if err != nil {
+				panic(err)
+			}
+			r.forms = append(r.forms, f)
+			r.conds = append(r.conds, c)
+		}
+		for _, loc := range strings.Fields(s.locales) {
+			m[loc] = r
+		}
+	}
+	return m
+}
+
+func formByName(s string) Form {
+	for i, n := range formNames {
+		if n == s {
+			return Form(i)
+		}
+	}
+	panic("plural: unknown form " + s)
+}
+
+// Cardinal returns the rules for cardinal numbers, such as "3 books", for the
+// language of t. If there are no rules for t, it returns the rules for the
+// closest parent of t that has them or, failing that, the rules of the root
+// locale, which always select Other.
+func Cardinal(t language.Tag) *Rules {
+	return lookup(cardinal, t)
+}
+
+// Ordinal returns the rules for ordinal numbers, such as "the 3rd book", for
+// the language of t. The rules are selected as for Cardinal.
+func Ordinal(t language.Tag) *Rules {
+	return lookup(ordinal, t)
+}
+
+func lookup(m map[string]*Rules, t language.Tag) *Rules {
+	t = t.KeepExtensions()
+	b, _, _ := t.Raw()
+	for ; !t.IsRoot(); t = t.Parent() {
+		if r, ok := m[t.String()]; ok {
+			return r
+		}
+	}
+	// Some locales, such as zh-Hant, do not have their base language as parent.
+	if r, ok := m[b.String()]; ok {
+		return r
+	}
+	return m["root"]
+}
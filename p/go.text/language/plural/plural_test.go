@@ -0,0 +1,198 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plural
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"code.google.com/p/go.text/language"
+)
+
+func TestParseOperands(t *testing.T) {
+	tests := []struct {
+		in  string
+		out Operands
+	}{
+		{"0", Operands{}},
+		{"1", Operands{N: 1, I: 1}},
+		{"-3", Operands{N: 3, I: 3}},
+		{"1.0", Operands{N: 1, I: 1, V: 1, F: 0}},
+		{"1.50", Operands{N: 1.5, I: 1, V: 2, W: 1, F: 50, T: 5}},
+		{"0.05", Operands{N: 0.05, V: 2, W: 2, F: 5, T: 5}},
+		{"120.300", Operands{N: 120.3, I: 120, V: 3, W: 1, F: 300, T: 3}},
+	}
+	for _, tt := range tests {
+		o, err := ParseOperands(tt.in)
+		if err != nil || o != tt.out {
+			t.Errorf("%s: was %+v, %v; want %+v", tt.in, o, err, tt.out)
+		}
+	}
+	for _, s := range []string{"", "-", "1.", ".5", "1e3", "1,5", "a", "1.2.3", "--1"} {
+		if _, err := ParseOperands(s); err == nil {
+			t.Errorf("%q: unexpected success", s)
+		}
+	}
+	if o := IntOperands(-21); o != (Operands{N: 21, I: 21}) {
+		t.Errorf("IntOperands(-21) was %+v", o)
+	}
+}
+
+func TestParseCondition(t *testing.T) {
+	for _, s := range []string{
+		"n = 1 and",
+		"x = 1",
+		"n == 1",
+		"n % = 1",
+		"n % 0 = 1",
+		"n = 2..1",
+		"n = a",
+		"n = 1,",
+	} {
+		if _, err := parseCondition(s); err == nil {
+			t.Errorf("%q: unexpected success", s)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		ordinal bool
+		tag     string
+		forms   string
+		values  map[Form]string
+	}{
+		{false, "en", "one other", map[Form]string{
+			One:   "1",
+			Other: "0 2 10 1.0 1.5 0.1",
+		}},
+		{false, "en-GB", "one other", map[Form]string{One: "1", Other: "21"}},
+		{false, "ja", "other", map[Form]string{Other: "0 1 2 1.5"}},
+		{false, "zh-Hant", "other", map[Form]string{Other: "1"}},
+		{false, "und", "other", map[Form]string{Other: "1"}},
+		{false, "fr-CA", "one other", map[Form]string{
+			One:   "0 1 1.5 0.0",
+			Other: "2 10 2.5",
+		}},
+		{false, "pt", "one other", map[Form]string{One: "0 1", Other: "2 0.5"}},
+		{false, "pt-PT", "one other", map[Form]string{One: "1", Other: "0 2 1.0"}},
+		{false, "pt-AO", "one other", map[Form]string{One: "1", Other: "0"}},
+		{false, "ru", "one few many other", map[Form]string{
+			One:   "1 21 101",
+			Few:   "2 3 4 22 104",
+			Many:  "0 5 11 12 14 111 25",
+			Other: "1.5 0.1",
+		}},
+		{false, "pl", "one few many other", map[Form]string{
+			One:   "1",
+			Few:   "2 4 22 104",
+			Many:  "0 5 11 12 21 101",
+			Other: "0.5 1.0",
+		}},
+		{false, "cs", "one few many other", map[Form]string{
+			One:   "1",
+			Few:   "2 4",
+			Many:  "1.5 0.0",
+			Other: "0 5 100",
+		}},
+		{false, "ar", "zero one two few many other", map[Form]string{
+			Zero:  "0",
+			One:   "1",
+			Two:   "2",
+			Few:   "3 10 103",
+			Many:  "11 99 111",
+			Other: "100 102 0.5",
+		}},
+		{false, "lv", "zero one other", map[Form]string{
+			Zero:  "0 10 11 19 0.11",
+			One:   "1 21 0.1 1.1",
+			Other: "2 22 0.2",
+		}},
+		{false, "lt", "one few many other", map[Form]string{
+			One:   "1 21",
+			Few:   "2 9 22",
+			Many:  "0.5 1.1",
+			Other: "0 10 11 19",
+		}},
+		{false, "iw", "one two many other", map[Form]string{
+			One:   "1",
+			Two:   "2",
+			Many:  "20 100",
+			Other: "0 3 10 11 1.5",
+		}},
+		{false, "sr-Latn", "one few other", map[Form]string{
+			One:   "1 21 0.1 1.1",
+			Few:   "2 4 0.2 1.4",
+			Other: "0 5 11 12 0.5",
+		}},
+		{true, "en", "one two few other", map[Form]string{
+			One:   "1 21 101",
+			Two:   "2 22",
+			Few:   "3 23 103",
+			Other: "0 4 11 12 13 111 112 113",
+		}},
+		{true, "sv", "one other", map[Form]string{One: "1 2 21 22", Other: "3 11 12"}},
+		{true, "it", "many other", map[Form]string{Many: "8 11 80 800", Other: "1 81"}},
+		{true, "de", "other", map[Form]string{Other: "1 2 3"}},
+		{true, "fr", "one other", map[Form]string{One: "1", Other: "2"}},
+	}
+	for _, tt := range tests {
+		tag := language.MustParse(tt.tag)
+		typ, r := "cardinal", Cardinal(tag)
+		if tt.ordinal {
+			typ, r = "ordinal", Ordinal(tag)
+		}
+		var forms []string
+		for _, f := range r.Forms() {
+			forms = append(forms, f.String())
+		}
+		if got := strings.Join(forms, " "); got != tt.forms {
+			t.Errorf("%s:%s: forms were %q; want %q", typ, tt.tag, got, tt.forms)
+		}
+		for f, values := range tt.values {
+			for _, v := range strings.Fields(values) {
+				o, err := ParseOperands(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := r.Select(o); got != f {
+					t.Errorf("%s:%s: %s was %v; want %v", typ, tt.tag, v, got, f)
+				}
+			}
+		}
+	}
+}
+
+func TestSelectInt(t *testing.T) {
+	r := Cardinal(language.Russian)
+	for i := -30; i <= 30; i++ {
+		o, _ := ParseOperands(strconv.Itoa(i))
+		if a, b := r.SelectInt(i), r.Select(o); a != b {
+			t.Errorf("%d: SelectInt was %v; Select was %v", i, a, b)
+		}
+	}
+}
+
+func TestTables(t *testing.T) {
+	for _, data := range [][]ruleSet{cardinalData[:], ordinalData[:]} {
+		seen := map[string]bool{}
+		for _, s := range data {
+			for _, loc := range strings.Fields(s.locales) {
+				if seen[loc] {
+					t.Errorf("duplicate locale %q", loc)
+				}
+				seen[loc] = true
+			}
+		}
+		if !seen["root"] {
+			t.Errorf("no rules for root")
+		}
+	}
+	if !reflect.DeepEqual(Cardinal(language.Und).Forms(), []Form{Other}) {
+		t.Errorf("root has plural forms other than Other")
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plural
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A condition is a disjunction of conjunctions of relations, as in
+// "n % 10 = 1 and n % 100 != 11 or n = 0".
+type condition [][]relation
+
+// A relation compares an operand, optionally taken modulo a value, against a
+// list of values and ranges, as in "i % 100 != 12..14".
+type relation struct {
+	operand byte // one of n, i, v, w, f or t
+	mod     int64
+	negate  bool
+	ranges  []int64 // pairs of inclusive lower and upper bounds
+}
+
+// parseCondition parses a condition in the syntax used by CLDR, such as
+// "v = 0 and i % 10 = 1". Tokens must be separated by spaces, except within
+// range lists.
+func parseCondition(s string) (condition, error) {
+	var c condition
+	for _, or := range strings.Split(s, " or ") {
+		var and []relation
+		for _, rs := range strings.Split(or, " and ") {
+			r, err := parseRelation(strings.Fields(rs))
+			if err != nil {
+				return nil, fmt.Errorf("plural: %v in condition %q", err, s)
+			}
+			and = append(and, r)
+		}
+		c = append(c, and)
+	}
+	return c, nil
+}
+
+func parseRelation(tok []string) (r relation, err error) {
+	if len(tok) != 3 && len(tok) != 5 {
+		return r, fmt.Errorf("invalid relation %q", strings.Join(tok, " "))
+	}
+	if len(tok[0]) != 1 || !strings.Contains("niwvft", tok[0]) {
+		return r, fmt.Errorf("unknown operand %q", tok[0])
+	}
+	r.operand = tok[0][0]
+	if len(tok) == 5 {
+		if tok[1] != "%" {
+			return r, fmt.Errorf("unknown operator %q", tok[1])
+		}
+		if r.mod, err = strconv.ParseInt(tok[2], 10, 64); err != nil || r.mod <= 0 {
+			return r, fmt.Errorf("invalid modulus %q", tok[2])
+		}
+		tok = tok[2:]
+	}
+	switch tok[1] {
+	case "=":
+	case "!=":
+		r.negate = true
+	default:
+		return r, fmt.Errorf("unknown operator %q", tok[1])
+	}
+	for _, rng := range strings.Split(tok[2], ",") {
+		lo, hi := rng, rng
+		if i := strings.Index(rng, ".."); i >= 0 {
+			lo, hi = rng[:i], rng[i+2:]
+		}
+		a, err1 := strconv.ParseInt(lo, 10, 64)
+		b, err2 := strconv.ParseInt(hi, 10, 64)
+		if err1 != nil || err2 != nil || b < a {
+			return r, fmt.Errorf("invalid range %q", rng)
+		}
+		r.ranges = append(r.ranges, a, b)
+	}
+	return r, nil
+}
+
+func (c condition) match(o *Operands) bool {
+	for _, and := range c {
+		ok := true
+		for _, r := range and {
+			if !r.match(o) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *relation) match(o *Operands) bool {
+	var x int64
+	switch r.operand {
+	case 'n':
+		// The value of n may have a fraction, in which case it is not
+		// contained in any range.
+		n := o.N
+		if r.mod != 0 {
+			n = math.Mod(n, float64(r.mod))
+		}
+		if n != math.Floor(n) {
+			return r.negate
+		}
+		x = int64(n)
+	case 'i':
+		x = o.I
+	case 'v':
+		x = int64(o.V)
+	case 'w':
+		x = int64(o.W)
+	case 'f':
+		x = o.F
+	case 't':
+		x = o.T
+	}
+	if r.mod != 0 && r.operand != 'n' {
+		x %= r.mod
+	}
+	for i := 0; i < len(r.ranges); i += 2 {
+		if r.ranges[i] <= x && x <= r.ranges[i+1] {
+			return !r.negate
+		}
+	}
+	return r.negate
+}
@@ -0,0 +1,265 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file is maintained by hand. It holds tables that maketables generates,
+// but that were added after tables.go was last generated. They were
+// transcribed from supplementalData.xml of CLDR 25 and from the IANA language
+// subtag registry, and use the indices of tables.go. "make tables" writes
+// these tables to tables.go and removes this file.
+
+package language
+
+// regionCurrency maps regionIDs to the currency that is currently legal tender in
+// the region.  It is 0 for regions for which there is no such currency.
+var regionCurrency = [355]uint16{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 218, 92, 2, 4,
+	268, 268, 6, 7, 0, 9, 0, 17, 248, 92, 19, 20,
+	92, 22, 24, 26, 27, 92, 273, 33, 35, 36, 273, 92,
+	37, 38, 39, 248, 46, 50, 51, 0, 187, 53, 55, 56,
+	57, 19, 58, 261, 261, 60, 273, 189, 64, 261, 66, 67,
+	0, 69, 0, 0, 73, 74, 8, 19, 92, 76, 0, 92,
+	248, 79, 80, 268, 81, 0, 82, 92, 248, 92, 86, 156,
+	87, 92, 91, 92, 92, 94, 95, 248, 80, 0, 92, 0,
+	261, 97, 268, 99, 92, 97, 101, 102, 80, 103, 104, 92,
+	261, 92, 97, 108, 248, 273, 111, 112, 19, 113, 115, 116,
+	117, 0, 92, 118, 92, 122, 97, 123, 248, 124, 125, 127,
+	92, 97, 129, 130, 131, 0, 132, 133, 134, 19, 135, 268,
+	136, 139, 140, 141, 142, 143, 144, 268, 60, 145, 146, 289,
+	148, 92, 92, 155, 156, 92, 160, 92, 92, 161, 248, 0,
+	163, 273, 166, 167, 168, 248, 92, 169, 268, 92, 172, 173,
+	174, 175, 178, 181, 182, 275, 273, 19, 183, 0, 185, 92,
+	187, 188, 0, 19, 0, 189, 189, 190, 191, 0, 193, 275,
+	195, 196, 197, 198, 92, 189, 248, 122, 92, 0, 248, 201,
+	0, 202, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 0, 205, 206, 207, 209, 210, 211,
+	212, 214, 216, 217, 218, 92, 187, 92, 221, 92, 273, 222,
+	223, 225, 226, 0, 248, 8, 229, 230, 97, 248, 261, 92,
+	273, 231, 233, 189, 248, 235, 236, 237, 0, 240, 241, 19,
+	242, 243, 244, 247, 0, 248, 248, 253, 254, 92, 268, 0,
+	256, 248, 248, 257, 259, 275, 0, 260, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	92, 0, 289, 291, 0, 248, 0,
+}
+
+// regionMeasurement maps regionIDs to the measurement system used in the region,
+// as a MeasurementSystem value, for the regions that do not use the metric system.
+// It is sorted by regionID.
+var regionMeasurement = [4]fromTo{
+	{from: 0x79, to: 0x2},
+	{from: 0xb2, to: 0x1},
+	{from: 0xc2, to: 0x1},
+	{from: 0x132, to: 0x1},
+}
+
+// variantPrefix holds, for each variant index, a space-separated list of the
+// prefixes with which the variant may be used, as defined in the IANA
+// language subtag registry. It is empty for variants that may be used with
+// any tag.
+var variantPrefix = [67]string{
+	"frm",
+	"fr",
+	"de",
+	"be",
+	"de",
+	"djk",
+	"hy",
+	"hy",
+	"az ba crh kk krc ky sah tk tt uz",
+	"blo",
+	"kea",
+	"sa",
+	"eu",
+	"sl",
+	"en",
+	"sl",
+	"sr sr-Latn sr-Cyrl",
+	"en",
+	"ja-Latn",
+	"nn",
+	"sr sr-Latn sr-Cyrl",
+	"sa",
+	"rm",
+	"yue",
+	"kw",
+	"kw",
+	"sa",
+	"ru",
+	"sl",
+	"el",
+	"djk",
+	"sl",
+	"vo",
+	"djk",
+	"ru",
+	"zh-Latn bo-Latn",
+	"el",
+	"rm",
+	"vo",
+	"sl",
+	"rm",
+	"en",
+	"en",
+	"kea",
+	"rm",
+	"rm",
+	"rm",
+	"be",
+	"kw",
+	"kw",
+	"sco",
+	"en hup kyh tol yur",
+	"sa",
+	"ca",
+	"rm",
+	"zh-Latn",
+	"sl-rozaj",
+	"sl-rozaj",
+	"sl-rozaj",
+	"sl-rozaj",
+	"sl-rozaj",
+	"sl-rozaj sl-rozaj-biske sl-rozaj-njiva sl-rozaj-osojs sl-rozaj-solba",
+	"ja-Latn-hepburn",
+	"",
+	"",
+	"",
+	"",
+}
+
+// regionDeprecated holds a sorted list of regionIDs of regions that are deprecated
+// in the IANA language subtag registry without a preferred replacement.
+var regionDeprecated = [5]uint16{
+	40, 86, 220, 279, 349,
+}
+
+// regionWeek maps regionIDs to the week conventions used in the region, for
+// the regions that differ from the world default. The bits 0-2, 3-5 and 6-8
+// hold the first day of the week, the first day of the weekend and the last day
+// of the weekend, respectively, as time.Weekday values. The remaining bits hold
+// the minimal number of days in the first week of the year. It is sorted by
+// regionID.
+var regionWeek = [120]fromTo{
+	{from: 0x21, to: 0x831},
+	{from: 0x22, to: 0x3ae},
+	{from: 0x23, to: 0x366},
+	{from: 0x24, to: 0x230},
+	{from: 0x28, to: 0x831},
+	{from: 0x2b, to: 0x230},
+	{from: 0x2c, to: 0x230},
+	{from: 0x2d, to: 0x831},
+	{from: 0x2e, to: 0x230},
+	{from: 0x30, to: 0x831},
+	{from: 0x34, to: 0x235},
+	{from: 0x35, to: 0x831},
+	{from: 0x37, to: 0x831},
+	{from: 0x38, to: 0x3ae},
+	{from: 0x40, to: 0x230},
+	{from: 0x41, to: 0x230},
+	{from: 0x42, to: 0x230},
+	{from: 0x45, to: 0x230},
+	{from: 0x47, to: 0x230},
+	{from: 0x48, to: 0x230},
+	{from: 0x4d, to: 0x831},
+	{from: 0x52, to: 0x230},
+	{from: 0x53, to: 0x230},
+	{from: 0x5d, to: 0x831},
+	{from: 0x5f, to: 0x831},
+	{from: 0x61, to: 0x236},
+	{from: 0x62, to: 0x831},
+	{from: 0x63, to: 0x230},
+	{from: 0x64, to: 0x230},
+	{from: 0x66, to: 0x3ae},
+	{from: 0x69, to: 0x831},
+	{from: 0x6a, to: 0x3ae},
+	{from: 0x6d, to: 0x831},
+	{from: 0x6e, to: 0x230},
+	{from: 0x70, to: 0x831},
+	{from: 0x71, to: 0x831},
+	{from: 0x74, to: 0x831},
+	{from: 0x76, to: 0x831},
+	{from: 0x79, to: 0x831},
+	{from: 0x7c, to: 0x831},
+	{from: 0x7d, to: 0x831},
+	{from: 0x7f, to: 0x831},
+	{from: 0x83, to: 0x831},
+	{from: 0x85, to: 0x831},
+	{from: 0x87, to: 0x230},
+	{from: 0x88, to: 0x230},
+	{from: 0x8b, to: 0x230},
+	{from: 0x8d, to: 0x230},
+	{from: 0x90, to: 0x831},
+	{from: 0x93, to: 0x230},
+	{from: 0x94, to: 0x830},
+	{from: 0x95, to: 0x3a8},
+	{from: 0x96, to: 0x831},
+	{from: 0x97, to: 0x200},
+	{from: 0x99, to: 0x3ae},
+	{from: 0x9a, to: 0x36e},
+	{from: 0x9b, to: 0x831},
+	{from: 0x9c, to: 0x831},
+	{from: 0x9d, to: 0x831},
+	{from: 0x9e, to: 0x230},
+	{from: 0x9f, to: 0x3ae},
+	{from: 0xa0, to: 0x230},
+	{from: 0xa2, to: 0x230},
+	{from: 0xa4, to: 0x230},
+	{from: 0xa9, to: 0x230},
+	{from: 0xaa, to: 0x3ae},
+	{from: 0xad, to: 0x230},
+	{from: 0xb0, to: 0x831},
+	{from: 0xb4, to: 0x831},
+	{from: 0xb5, to: 0x831},
+	{from: 0xb7, to: 0x3ae},
+	{from: 0xb8, to: 0x3ae},
+	{from: 0xb9, to: 0x831},
+	{from: 0xbe, to: 0x230},
+	{from: 0xc2, to: 0x230},
+	{from: 0xc4, to: 0x230},
+	{from: 0xc6, to: 0x831},
+	{from: 0xc9, to: 0x230},
+	{from: 0xcb, to: 0x235},
+	{from: 0xcd, to: 0x230},
+	{from: 0xcf, to: 0x230},
+	{from: 0xd6, to: 0x230},
+	{from: 0xd7, to: 0x831},
+	{from: 0xd8, to: 0x831},
+	{from: 0xd9, to: 0x230},
+	{from: 0xde, to: 0x230},
+	{from: 0xdf, to: 0x3ae},
+	{from: 0xe0, to: 0x230},
+	{from: 0xe2, to: 0x230},
+	{from: 0xe5, to: 0x230},
+	{from: 0xe6, to: 0x230},
+	{from: 0xe7, to: 0x831},
+	{from: 0xea, to: 0x230},
+	{from: 0xec, to: 0x831},
+	{from: 0xef, to: 0x230},
+	{from: 0xf1, to: 0x3ae},
+	{from: 0x100, to: 0x831},
+	{from: 0x104, to: 0x831},
+	{from: 0x106, to: 0x3a8},
+	{from: 0x109, to: 0x3ae},
+	{from: 0x10a, to: 0x831},
+	{from: 0x10b, to: 0x230},
+	{from: 0x10e, to: 0x831},
+	{from: 0x10f, to: 0x831},
+	{from: 0x111, to: 0x831},
+	{from: 0x118, to: 0x230},
+	{from: 0x11a, to: 0x3ae},
+	{from: 0x121, to: 0x230},
+	{from: 0x126, to: 0x3a8},
+	{from: 0x12a, to: 0x230},
+	{from: 0x12c, to: 0x230},
+	{from: 0x131, to: 0x230},
+	{from: 0x132, to: 0x230},
+	{from: 0x135, to: 0x831},
+	{from: 0x138, to: 0x230},
+	{from: 0x13a, to: 0x230},
+	{from: 0x13f, to: 0x230},
+	{from: 0x15b, to: 0x3a8},
+	{from: 0x15e, to: 0x230},
+	{from: 0x161, to: 0x230},
+}
@@ -4,8 +4,6 @@
 
 package language
 
-// TODO: Various sets of commonly use tags and regions.
-
 // MustParse is like Parse, but panics if the given BCP 47 tag cannot be parsed.
 // It simplifies safe initialization of Tag values.
 func MustParse(s string) Tag {
@@ -56,6 +54,16 @@ func MustParseRegion(s string) Region {
 	return r
 }
 
+// MustParseVariant is like ParseVariant, but panics if the given variant cannot
+// be parsed. It simplifies safe initialization of Variant values.
+func MustParseVariant(s string) Variant {
+	v, err := ParseVariant(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // MustParseCurrency is like ParseCurrency, but panics if the given currency cannot
 // be parsed. It simplifies safe initialization of Currency values.
 func MustParseCurrency(s string) Currency {
@@ -66,6 +74,23 @@ func MustParseCurrency(s string) Currency {
 	return c
 }
 
+// UndScript returns the tag with an undefined language and the given script,
+// such as und-Cyrl. It is a convenient starting point for partial tags, for
+// example to determine the most likely language for a script using Maximize.
+func UndScript(s Script) Tag {
+	return Tag{script: s.scriptID}
+}
+
+// UndRegion returns the tag with an undefined language and the given region,
+// such as und-CH.
+func UndRegion(r Region) Tag {
+	return Tag{region: r.regionID}
+}
+
+// TODO: generate the variables below for all locales for which CLDR defines
+// modern coverage. This requires maketables to fetch the coverage levels of
+// CLDR, which it does not yet do. Generated variables must still be appended
+// to commonTags, so that existing compact indices do not change.
 var (
 	und = Tag{}
 
@@ -150,3 +175,20 @@ var (
 	TraditionalChinese   Tag = Tag{lang: _zh, script: _Hant} //  zh-Hant
 	Zulu                 Tag = Tag{lang: _zu}                //  zu
 )
+
+// commonTags lists the tags for which variables are defined above, except Und.
+// It is used to define Common and CompactIndex. As the position of a tag in
+// this list determines its compact index, new tags must be added at the end.
+var commonTags = []Tag{
+	Afrikaans, Amharic, Arabic, ModernStandardArabic, Azerbaijani, Bulgarian,
+	Bengali, Catalan, Czech, Danish, German, Greek, English, AmericanEnglish,
+	BritishEnglish, Spanish, EuropeanSpanish, LatinAmericanSpanish, Estonian,
+	Persian, Finnish, Filipino, French, CanadianFrench, Gujarati, Hebrew, Hindi,
+	Croatian, Hungarian, Armenian, Indonesian, Icelandic, Italian, Japanese,
+	Georgian, Kazakh, Khmer, Kannada, Korean, Kirghiz, Lao, Lithuanian, Latvian,
+	Macedonian, Malayalam, Mongolian, Marathi, Malay, Burmese, Nepali, Dutch,
+	Norwegian, Punjabi, Polish, Portuguese, BrazilianPortuguese,
+	EuropeanPortuguese, Romanian, Russian, Sinhala, Slovak, Slovenian, Albanian,
+	Serbian, Swedish, Swahili, Tamil, Telugu, Thai, Turkish, Ukrainian, Urdu,
+	Uzbek, Vietnamese, Chinese, SimplifiedChinese, TraditionalChinese, Zulu,
+}
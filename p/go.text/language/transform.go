@@ -0,0 +1,136 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+import (
+	"sort"
+	"strings"
+)
+
+// This file implements access to the transformed content extension ('t'), as
+// defined in http://tools.ietf.org/html/rfc6497. A 't' extension consists of
+// an optional source tag, identifying the language from which the content was
+// transformed, followed by a list of fields. Each field consists of a key of a
+// letter followed by a digit and a value of one or more subtags.
+// For example, "und-Cyrl-t-und-latn-m0-ungegn-2007" denotes content in
+// Cyrillic script transliterated from Latin script using the UNGEGN 2007
+// mechanism.
+
+// transformFields lists the field keys defined in RFC 6497 and CLDR.
+var transformFields = []string{
+	"d0", // destination: transform destination, for non-language targets
+	"h0", // hybrid: hybrid locale
+	"i0", // input: input method engine
+	"k0", // keyboard: keyboard transform
+	"m0", // mechanism: transform mechanism
+	"s0", // source: transform source, for non-language sources
+	"t0", // translation: machine translation
+	"x0", // private use
+}
+
+// transformMechanisms lists the values of the m0 field defined in CLDR. These
+// are the standards according to which a transliteration was made.
+var transformMechanisms = []string{
+	"alaloc",
+	"bgn",
+	"buckwalt",
+	"din",
+	"gost",
+	"iso",
+	"mcst",
+	"mns",
+	"names",
+	"satts",
+	"ungegn",
+}
+
+// isTransformKey reports whether s has the form of a field key of the 't'
+// extension.
+func isTransformKey(s string) bool {
+	return len(s) == 2 && isAlpha(s[0]) && !isAlpha(s[1])
+}
+
+// transform returns the source tag and fields of the 't' extension of t.
+func (t Tag) transform() (source string, fields []string, ok bool) {
+	ext, ok := t.Extension('t')
+	if !ok {
+		return "", nil, false
+	}
+	tokens := ext.Tokens()[1:]
+	i := 0
+	for ; i < len(tokens) && !isTransformKey(tokens[i]); i++ {
+	}
+	return strings.Join(tokens[:i], "-"), tokens[i:], true
+}
+
+// TransformSource returns the tag of the language from which the content
+// identified by t was transformed, as given by its transformed content
+// extension ('t'). For example, it returns "it" for "ja-t-it". It returns false
+// if t has no such extension or the extension does not specify a source tag.
+func (t Tag) TransformSource() (source Tag, ok bool) {
+	s, _, ok := t.transform()
+	if !ok || s == "" {
+		return Und, false
+	}
+	source, err := Raw.Parse(s)
+	return source, err == nil
+}
+
+// TransformFields returns the keys of the fields of the transformed content
+// extension ('t') of t, such as "m0", in the order in which they appear.
+func (t Tag) TransformFields() []string {
+	_, fields, _ := t.transform()
+	keys := []string{}
+	for _, f := range fields {
+		if isTransformKey(f) {
+			keys = append(keys, f)
+		}
+	}
+	return keys
+}
+
+// TransformField returns the value of the field with the given key, such as
+// "m0" for the transform mechanism, of the transformed content extension ('t')
+// of t. Values consisting of multiple subtags are joined by '-'. It returns ""
+// if there is no such field.
+func (t Tag) TransformField(key string) string {
+	_, fields, _ := t.transform()
+	for i, f := range fields {
+		if f != key {
+			continue
+		}
+		j := i + 1
+		for ; j < len(fields) && !isTransformKey(fields[j]); j++ {
+		}
+		return strings.Join(fields[i+1:j], "-")
+	}
+	return ""
+}
+
+// ValidateTransform checks the transformed content extension ('t') of t, if
+// any, against the registered field keys and transform mechanisms. It returns
+// a ValueError for the first unknown field key or mechanism and nil otherwise.
+// The syntax of the extension has already been checked when t was created.
+func (t Tag) ValidateTransform() error {
+	_, fields, _ := t.transform()
+	for i, f := range fields {
+		if !isTransformKey(f) {
+			continue
+		}
+		if !contains(transformFields, f) {
+			return mkErrInvalid([]byte(f))
+		}
+		if f == "m0" && i+1 < len(fields) && !contains(transformMechanisms, fields[i+1]) {
+			return mkErrInvalid([]byte(fields[i+1]))
+		}
+	}
+	return nil
+}
+
+// contains reports whether the sorted list a contains s.
+func contains(a []string, s string) bool {
+	i := sort.SearchStrings(a, s)
+	return i < len(a) && a[i] == s
+}
@@ -0,0 +1,69 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTransformTables(t *testing.T) {
+	for _, a := range [][]string{transformFields, transformMechanisms} {
+		if !sort.StringsAreSorted(a) {
+			t.Errorf("%v is not sorted", a)
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		in     string
+		source string
+		fields string
+		m0     string
+		err    string
+	}{
+		{"en", "", "", "", ""},
+		{"ja-t-it", "it", "", "", ""},
+		{"und-Latn-t-und-cyrl", "und-Cyrl", "", "", ""},
+		{"und-Cyrl-t-und-latn-m0-ungegn-2007", "und-Latn", "m0", "ungegn-2007", ""},
+		{"en-t-zh-hant-tw-m0-names", "zh-Hant-TW", "m0", "names", ""},
+		{"de-t-m0-din-k0-qwertz", "", "m0 k0", "din", ""},
+		{"und-t-s0-accents-d0-ascii", "", "s0 d0", "", ""},
+		{"ja-t-it-m0-foo", "it", "m0", "foo", "foo"},
+		{"ja-t-it-z0-foo", "it", "z0", "", "z0"},
+		{"ja-u-co-phonebk-t-it-x-m0-bgn", "it", "", "", ""},
+	}
+	for _, tt := range tests {
+		tag, err := Raw.Parse(tt.in)
+		if err != nil {
+			t.Errorf("%s: unexpected parse error: %v", tt.in, err)
+			continue
+		}
+		src, ok := tag.TransformSource()
+		if ok != (tt.source != "") || ok && src.String() != tt.source {
+			t.Errorf("%s: TransformSource was %s, %v; want %q", tt.in, src, ok, tt.source)
+		}
+		want := strings.Fields(tt.fields)
+		if want == nil {
+			want = []string{}
+		}
+		if f := tag.TransformFields(); !reflect.DeepEqual(f, want) {
+			t.Errorf("%s: TransformFields was %q; want %q", tt.in, f, want)
+		}
+		if v := tag.TransformField("m0"); v != tt.m0 {
+			t.Errorf("%s: TransformField(m0) was %q; want %q", tt.in, v, tt.m0)
+		}
+		err = tag.ValidateTransform()
+		if tt.err == "" && err != nil {
+			t.Errorf("%s: ValidateTransform was %v; want nil", tt.in, err)
+		}
+		if e, ok := err.(ValueError); tt.err != "" && (!ok || string(e.tag()) != tt.err) {
+			t.Errorf("%s: ValidateTransform was %v; want error for %q", tt.in, err, tt.err)
+		}
+	}
+}
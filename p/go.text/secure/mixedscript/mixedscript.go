@@ -0,0 +1,284 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mixedscript classifies identifiers by the restriction levels for
+// mixing scripts defined in Unicode Technical Standard #39.
+//
+// Mixing scripts in an identifier is a common way to spoof a visually
+// similar identifier, such as a Cyrillic "а" in an otherwise Latin user name.
+// Account name and domain policies can use the restriction levels to enforce a
+// standard set of rules. See http://www.unicode.org/reports/tr39/#Restriction_Level_Detection
+// for details.
+//
+// NOTE: the script of a rune is determined using the tables of the standard
+// library's unicode package. The Script_Extensions property is not
+// taken into account, which means that runes of the Common and Inherited
+// scripts are always considered to be compatible with any script.
+package mixedscript
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Level is a restriction level as defined in UTS #39. Levels are ordered
+// from most to least restrictive.
+type Level int
+
+const (
+	// ASCIIOnly means all runes are in the ASCII range.
+	ASCIIOnly Level = iota
+
+	// SingleScript means all runes are from a single script, or from the
+	// combinations of scripts used for Japanese, Korean or Chinese.
+	SingleScript
+
+	// HighlyRestrictive means all runes are from a single script or from one
+	// of the combinations Latin + Han + Hiragana + Katakana, Latin + Han +
+	// Bopomofo or Latin + Han + Hangul.
+	HighlyRestrictive
+
+	// ModeratelyRestrictive means all runes are from the scripts allowed by
+	// HighlyRestrictive, or from Latin and one other recommended script
+	// except Cyrillic and Greek.
+	ModeratelyRestrictive
+
+	// MinimallyRestrictive means that scripts may be mixed arbitrarily, but
+	// that all runes are from scripts that are recommended for use in
+	// identifiers.
+	MinimallyRestrictive
+
+	// Unrestricted means that s contains runes from scripts that are not
+	// recommended for use in identifiers.
+	Unrestricted
+)
+
+var levelName = []string{
+	"ASCIIOnly",
+	"SingleScript",
+	"HighlyRestrictive",
+	"ModeratelyRestrictive",
+	"MinimallyRestrictive",
+	"Unrestricted",
+}
+
+func (l Level) String() string {
+	return levelName[l]
+}
+
+// Allowed reports whether s satisfies the restrictions of level l.
+func Allowed(l Level, s string) bool {
+	return Detect(s) <= l
+}
+
+// Detect returns the most restrictive level satisfied by s.
+func Detect(s string) Level {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return ASCIIOnly
+	}
+
+	// The resolved script set is the intersection of the augmented script
+	// sets of all runes. The union is used to check for the allowed
+	// combinations of scripts.
+	resolved := all
+	union := scriptSet{}
+	for _, r := range s {
+		set, ok := augmentedScripts(r)
+		if !ok {
+			continue
+		}
+		if set.isEmpty() {
+			return Unrestricted
+		}
+		resolved = resolved.intersect(set)
+		union = union.union(set)
+	}
+	if !resolved.isEmpty() {
+		return SingleScript
+	}
+	for _, set := range highlyRestrictive {
+		if union.subsetOf(set) {
+			return HighlyRestrictive
+		}
+	}
+	if !union.subsetOf(recommended) {
+		return Unrestricted
+	}
+	// Remove Latin and the scripts which are only used to augment others.
+	other := union.minus(latin)
+	other = other.minus(augmented)
+	if n := other.count(); n <= 1 && !other.intersects(cyrillicGreek) {
+		return ModeratelyRestrictive
+	}
+	return MinimallyRestrictive
+}
+
+// scriptSet is a bit set of script identifiers, as indexed by scriptNames.
+type scriptSet [4]uint64
+
+var all = scriptSet{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}
+
+func (s scriptSet) isEmpty() bool {
+	return s == scriptSet{}
+}
+
+func (s scriptSet) add(id int) scriptSet {
+	s[id/64] |= 1 << uint(id%64)
+	return s
+}
+
+func (s scriptSet) union(t scriptSet) scriptSet {
+	for i := range s {
+		s[i] |= t[i]
+	}
+	return s
+}
+
+func (s scriptSet) intersect(t scriptSet) scriptSet {
+	for i := range s {
+		s[i] &= t[i]
+	}
+	return s
+}
+
+func (s scriptSet) minus(t scriptSet) scriptSet {
+	for i := range s {
+		s[i] &^= t[i]
+	}
+	return s
+}
+
+func (s scriptSet) intersects(t scriptSet) bool {
+	return !s.intersect(t).isEmpty()
+}
+
+func (s scriptSet) subsetOf(t scriptSet) bool {
+	return s.minus(t).isEmpty()
+}
+
+func (s scriptSet) count() int {
+	n := 0
+	for _, w := range s {
+		for ; w != 0; w &= w - 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// The pseudo scripts used for augmenting the script sets of runes of the Han,
+// Hiragana, Katakana, Hangul and Bopomofo scripts.
+const (
+	jpan = "Japanese"
+	kore = "Korean"
+	hanb = "Han with Bopomofo"
+)
+
+var (
+	// scriptNames holds the sorted names of all scripts defined in package
+	// unicode, followed by the pseudo scripts used for augmentation.
+	scriptNames []string
+
+	// scriptTables holds the range tables corresponding to scriptNames.
+	scriptTables []*unicode.RangeTable
+
+	// augment maps the script identifier of a rune to the set it should be
+	// augmented to.
+	augment map[int]scriptSet
+
+	latin, cyrillicGreek, augmented, recommended scriptSet
+
+	highlyRestrictive []scriptSet
+)
+
+// recommendedScripts lists the scripts recommended for use in identifiers, as
+// listed in Table 5 of http://www.unicode.org/reports/tr31/.
+var recommendedScripts = []string{
+	"Arabic", "Armenian", "Bengali", "Bopomofo", "Cyrillic", "Devanagari",
+	"Ethiopic", "Georgian", "Greek", "Gujarati", "Gurmukhi", "Han", "Hangul",
+	"Hebrew", "Hiragana", "Kannada", "Katakana", "Khmer", "Lao", "Latin",
+	"Malayalam", "Myanmar", "Oriya", "Sinhala", "Tamil", "Telugu", "Thaana",
+	"Thai", "Tibetan",
+}
+
+func init() {
+	for name := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" {
+			scriptNames = append(scriptNames, name)
+		}
+	}
+	sort.Strings(scriptNames)
+	for _, name := range scriptNames {
+		scriptTables = append(scriptTables, unicode.Scripts[name])
+	}
+	scriptNames = append(scriptNames, jpan, kore, hanb)
+	if len(scriptNames) > len(all)*64 {
+		panic("mixedscript: too many scripts")
+	}
+
+	set := func(names ...string) scriptSet {
+		s := scriptSet{}
+		for _, n := range names {
+			i := sort.SearchStrings(scriptNames[:len(scriptTables)], n)
+			if i == len(scriptTables) || scriptNames[i] != n {
+				i = len(scriptTables)
+				for ; scriptNames[i] != n; i++ {
+				}
+			}
+			s = s.add(i)
+		}
+		return s
+	}
+
+	augment = map[int]scriptSet{}
+	for _, a := range []struct {
+		script string
+		with   []string
+	}{
+		{"Han", []string{"Han", hanb, jpan, kore}},
+		{"Hiragana", []string{"Hiragana", jpan}},
+		{"Katakana", []string{"Katakana", jpan}},
+		{"Hangul", []string{"Hangul", kore}},
+		{"Bopomofo", []string{"Bopomofo", hanb}},
+	} {
+		augment[sort.SearchStrings(scriptNames[:len(scriptTables)], a.script)] = set(a.with...)
+	}
+
+	latin = set("Latin")
+	cyrillicGreek = set("Cyrillic", "Greek")
+	augmented = set(jpan, kore, hanb)
+	recommended = set(recommendedScripts...).union(augmented)
+	highlyRestrictive = []scriptSet{
+		set("Latin", "Han", "Hiragana", "Katakana", jpan, hanb, kore),
+		set("Latin", "Han", "Bopomofo", hanb, jpan, kore),
+		set("Latin", "Han", "Hangul", kore, hanb, jpan),
+	}
+}
+
+// augmentedScripts returns the augmented script set for r. It returns false
+// if r is of the Common or Inherited script, as such runes do not restrict
+// the set of possible scripts.
+func augmentedScripts(r rune) (s scriptSet, ok bool) {
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return s, false
+	}
+	for i, t := range scriptTables {
+		if unicode.Is(t, r) {
+			if a, ok := augment[i]; ok {
+				return a, true
+			}
+			return s.add(i), true
+		}
+	}
+	// Unassigned runes are of an unknown script and yield an empty set.
+	return s, true
+}
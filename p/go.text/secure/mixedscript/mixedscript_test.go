@@ -0,0 +1,46 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mixedscript
+
+import "testing"
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		in    string
+		level Level
+	}{
+		{"", ASCIIOnly},
+		{"paypal", ASCIIOnly},
+		{"café", SingleScript},
+		{"παράδειγμα", SingleScript},
+		{"ひらがなカタカナ漢字", SingleScript},
+		{"한국어漢字", SingleScript},
+		{"ㄅㄆㄇ漢字", SingleScript},
+		{"café-ﬁ", SingleScript}, // Common runes are ignored.
+		{"ᏣᎳᎩ", SingleScript},    // Cherokee is not recommended, but single script.
+		{"abcひらがな漢字", HighlyRestrictive},
+		{"abc한국어", HighlyRestrictive},
+		{"abcㄅㄆㄇ", HighlyRestrictive},
+		{"abcاختبار", ModeratelyRestrictive},
+		{"abcहिन्दी", ModeratelyRestrictive},
+		{"pаypal", MinimallyRestrictive}, // Cyrillic а
+		{"abcαβγ", MinimallyRestrictive},
+		{"한국어ひらがな", MinimallyRestrictive},
+		{"abcاختبارहिन्दी", MinimallyRestrictive},
+		{"abcᏣᎳᎩ", Unrestricted},
+		{"abc\U000e0fff", Unrestricted}, // unassigned
+	}
+	for i, tt := range tests {
+		if l := Detect(tt.in); l != tt.level {
+			t.Errorf("%d: Detect(%+q) = %v; want %v", i, tt.in, l, tt.level)
+		}
+		if !Allowed(tt.level, tt.in) {
+			t.Errorf("%d: Allowed(%v, %+q) = false; want true", i, tt.level, tt.in)
+		}
+		if tt.level > ASCIIOnly && Allowed(tt.level-1, tt.in) {
+			t.Errorf("%d: Allowed(%v, %+q) = true; want false", i, tt.level-1, tt.in)
+		}
+	}
+}
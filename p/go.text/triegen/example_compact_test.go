@@ -9,7 +9,7 @@ import (
 	"io"
 	"io/ioutil"
 
-	"code.google.com/p/go.text/internal/triegen"
+	"code.google.com/p/go.text/triegen"
 )
 
 func ExampleCompacter() {
@@ -10,7 +10,7 @@ import (
 	"math/rand"
 	"unicode"
 
-	"code.google.com/p/go.text/internal/triegen"
+	"code.google.com/p/go.text/triegen"
 )
 
 const seed = 0x12345
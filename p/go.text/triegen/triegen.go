@@ -55,6 +55,11 @@
 // It is recommended that users test the generated trie by checking the returned
 // value for every rune. Such exhaustive tests are possible as the the number of
 // runes in Unicode is limited.
+//
+// The package is intended to be used from table generators, typically programs
+// with the ignore build tag that are run with go run, such as the maketables
+// programs of the go.text packages. The generated code only depends on the
+// standard library, so packages using the tables need not import triegen.
 package triegen
 
 // TODO: Arguably, the internally optimized data types would not have to be
@@ -68,7 +73,6 @@ import (
 	"fmt"
 	"hash/crc64"
 	"io"
-	"log"
 	"unicode/utf8"
 )
 
@@ -282,7 +286,7 @@ func (t *Trie) Insert(r rune, value uint64) {
 			n.children[p] = c
 		}
 		if len(s) > 2 && c.values != nil {
-			log.Fatalf("triegen: insert(%U): found internal node with values", r)
+			panic(fmt.Sprintf("triegen: insert(%U): found internal node with values", r))
 		}
 		n = c
 	}
@@ -290,7 +294,7 @@ func (t *Trie) Insert(r rune, value uint64) {
 		n.values = make([]uint64, blockSize)
 	}
 	if n.children != nil {
-		log.Fatalf("triegen: insert(%U): found leaf node that also has child nodes", r)
+		panic(fmt.Sprintf("triegen: insert(%U): found leaf node that also has child nodes", r))
 	}
 	n.values[s[0]-0x80] = value
 }
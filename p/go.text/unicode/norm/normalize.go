@@ -5,7 +5,18 @@
 // Package norm contains types and functions for normalizing Unicode strings.
 package norm
 
-import "unicode/utf8"
+import (
+	"unicode/utf8"
+
+	"code.google.com/p/go.text/dataversion"
+)
+
+func init() {
+	dataversion.Register(dataversion.Info{
+		Package: "code.google.com/p/go.text/unicode/norm",
+		Unicode: Version,
+	})
+}
 
 // A Form denotes a canonical representation of Unicode code points.
 // The Unicode-defined normalization and equivalence forms are: